@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// runAffected implements `bv affected [<id>...] [--since REV] [--json]`.
+func runAffected(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("affected", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	since := fs.String("since", "", "Treat issues added, closed or modified since this git revision as changed")
+	asJSON := fs.Bool("json", false, "Emit JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv affected [<id>...] [--since REV] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Lists the changed issues and everything downstream of them: issues they")
+		fmt.Fprintln(stderr, "block or parent, directly or transitively. Name the changed issues, or")
+		fmt.Fprintln(stderr, "use --since to take them from the beads history in git.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+
+	// Allow the IDs before or after flags
+	var ids []string
+	for len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		ids, args = append(ids, args[0]), args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ids = append(ids, fs.Args()...)
+	if len(ids) == 0 && *since == "" {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	known := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		known[issue.ID] = true
+	}
+	for _, id := range ids {
+		if !known[id] {
+			return fmt.Errorf("unknown issue %q", id)
+		}
+	}
+
+	if *since != "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("getting working directory: %w", err)
+		}
+		historical, err := loader.NewGitLoader(cwd).LoadAt(*since)
+		if err != nil {
+			return fmt.Errorf("loading beads at %s: %w", *since, err)
+		}
+		diff := analysis.CompareSnapshots(analysis.NewSnapshot(historical), analysis.NewSnapshot(issues))
+		for _, issue := range diff.NewIssues {
+			ids = append(ids, issue.ID)
+		}
+		for _, issue := range diff.ClosedIssues {
+			ids = append(ids, issue.ID)
+		}
+		for _, mod := range diff.ModifiedIssues {
+			ids = append(ids, mod.IssueID)
+		}
+	}
+
+	cone := sortedCone(analysis.AffectedCone(issues, ids))
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(cone); err != nil {
+			return fmt.Errorf("encoding affected issues: %w", err)
+		}
+		return nil
+	}
+	writeAffected(stdout, cone, issues)
+	return nil
+}
+
+// sortedCone orders the cone by depth, then source, then ID
+func sortedCone(cone map[string]analysis.AffectedIssue) []analysis.AffectedIssue {
+	out := make([]analysis.AffectedIssue, 0, len(cone))
+	for _, a := range cone {
+		out = append(out, a)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Depth != out[j].Depth {
+			return out[i].Depth < out[j].Depth
+		}
+		if out[i].Source != out[j].Source {
+			return out[i].Source < out[j].Source
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// writeAffected prints the changed issues, then the downstream ones with
+// the change they trace back to
+func writeAffected(out io.Writer, cone []analysis.AffectedIssue, issues []model.Issue) {
+	if len(cone) == 0 {
+		fmt.Fprintln(out, "Nothing changed.")
+		return
+	}
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+
+	changed := 0
+	for _, a := range cone {
+		if a.Depth == 0 {
+			changed++
+		}
+	}
+	fmt.Fprintf(out, "Changed (%d):\n", changed)
+	for _, a := range cone[:changed] {
+		issue := byID[a.ID]
+		fmt.Fprintf(out, "  %s %s [%s]\n", a.ID, issue.Title, issue.Status)
+	}
+	if changed == len(cone) {
+		fmt.Fprintln(out, "\nNothing downstream.")
+		return
+	}
+	fmt.Fprintf(out, "\nPotentially affected (%d):\n", len(cone)-changed)
+	for _, a := range cone[changed:] {
+		issue := byID[a.ID]
+		fmt.Fprintf(out, "  %s %s [%s] ← %s, %s\n", a.ID, issue.Title, issue.Status, a.Source, hopCount(a.Depth))
+	}
+}
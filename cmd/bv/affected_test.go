@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestWriteAffected(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Schema", Status: model.StatusOpen},
+		{ID: "bv-2", Title: "API", Status: model.StatusInProgress, Dependencies: []*model.Dependency{
+			{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+		{ID: "bv-3", Title: "Ship", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{IssueID: "bv-3", DependsOnID: "bv-2", Type: model.DepBlocks}}},
+		{ID: "bv-4", Title: "Docs", Status: model.StatusOpen},
+	}
+
+	tests := []struct {
+		changed []string
+		want    string
+	}{
+		{[]string{"bv-1"},
+			"Changed (1):\n" +
+				"  bv-1 Schema [open]\n\n" +
+				"Potentially affected (2):\n" +
+				"  bv-2 API [in_progress] ← bv-1, 1 hop\n" +
+				"  bv-3 Ship [open] ← bv-1, 2 hops\n"},
+		{[]string{"bv-4"}, "Changed (1):\n  bv-4 Docs [open]\n\nNothing downstream.\n"},
+		{nil, "Nothing changed.\n"},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		writeAffected(&out, sortedCone(analysis.AffectedCone(issues, tt.changed)), issues)
+		if out.String() != tt.want {
+			t.Errorf("%v:\n%s\nwant:\n%s", tt.changed, out.String(), tt.want)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/claims"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// runClaim implements `bv claim TARGET [--as OWNER] [--note TEXT] [--exclusive] [--release]`.
+func runClaim(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("claim", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	owner := fs.String("as", claims.DefaultOwner(), "Who is claiming (default $BV_AGENT, then $USER)")
+	note := fs.String("note", "", "What the claim is for")
+	exclusive := fs.Bool("exclusive", false, "Refuse the claim if it overlaps another owner's")
+	release := fs.Bool("release", false, "Drop the claim instead (--as '' drops everyone's)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv claim ISSUE-ID|label:NAME [--as OWNER] [--note TEXT] [--exclusive] [--release]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Marks an issue and its descendants (an epic's whole workstream), or every")
+		fmt.Fprintln(stderr, "issue with a label, as taken. Claims are kept in .beads/claims.json and")
+		fmt.Fprintln(stderr, "shown as ⚑ badges in the TUI; overlaps with other owners are reported.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+
+	// Allow the target before or after flags
+	var target string
+	if len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		target, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if target == "" && fs.NArg() > 0 {
+		target = fs.Arg(0)
+	}
+	if target == "" || (!*release && strings.TrimSpace(*owner) == "") {
+		fs.Usage()
+		return errUsage
+	}
+
+	beadsDir, err := loader.GetBeadsDir("")
+	if err != nil {
+		return err
+	}
+	store, err := claims.Load(beadsDir)
+	if err != nil {
+		return err
+	}
+
+	if *release {
+		n := store.Release(target, *owner)
+		if n == 0 {
+			return fmt.Errorf("no claim on %s to release", target)
+		}
+		if err := store.Save(beadsDir); err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "Released %d claim(s) on %s\n", n, target)
+		return nil
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	if err := checkClaimTarget(issues, target); err != nil {
+		return err
+	}
+
+	claim := claims.Claim{Target: target, Owner: *owner, ClaimedAt: time.Now(), Note: *note}
+	store.Add(claim)
+	overlaps := claimOverlaps(store, issues, claim)
+	if *exclusive && len(overlaps) > 0 {
+		writeOverlaps(stderr, overlaps)
+		return fmt.Errorf("%s overlaps other claims; not claimed", target)
+	}
+	if err := store.Save(beadsDir); err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "%s claimed %s\n", *owner, target)
+	if len(overlaps) > 0 {
+		fmt.Fprint(stderr, "Warning: ")
+		writeOverlaps(stderr, overlaps)
+	}
+	return nil
+}
+
+// checkClaimTarget rejects targets that name no issue or unused label
+func checkClaimTarget(issues []model.Issue, target string) error {
+	if label, ok := (claims.Claim{Target: target}).Label(); ok {
+		for _, issue := range issues {
+			if slices.Contains(issue.Labels, label) {
+				return nil
+			}
+		}
+		return fmt.Errorf("no issue has label %q", label)
+	}
+	for _, issue := range issues {
+		if issue.ID == target {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown issue %q (use label:NAME for a label)", target)
+}
+
+// claimOverlaps lists the overlaps involving the issues claim covers
+func claimOverlaps(store *claims.Store, issues []model.Issue, claim claims.Claim) []claims.Overlap {
+	var mine []claims.Overlap
+	for _, o := range claims.Overlaps(store.Coverage(issues)) {
+		for _, c := range o.Claims {
+			if c.Target == claim.Target && c.Owner == claim.Owner {
+				mine = append(mine, o)
+				break
+			}
+		}
+	}
+	return mine
+}
+
+// writeOverlaps prints one line per contested issue
+func writeOverlaps(out io.Writer, overlaps []claims.Overlap) {
+	fmt.Fprintf(out, "%d issue(s) claimed by more than one owner:\n", len(overlaps))
+	for _, o := range overlaps {
+		var parts []string
+		for _, c := range o.Claims {
+			parts = append(parts, fmt.Sprintf("%s (%s)", c.Owner, c.Target))
+		}
+		fmt.Fprintf(out, "  %s: %s\n", o.IssueID, strings.Join(parts, ", "))
+	}
+}
+
+// claimsOutput is the --json shape of `bv claims`
+type claimsOutput struct {
+	Claims   []claimSummary   `json:"claims"`
+	Overlaps []claims.Overlap `json:"overlaps"`
+}
+
+// claimSummary is one claim with how many issues it covers
+type claimSummary struct {
+	claims.Claim
+	Issues int `json:"issues"`
+}
+
+// runClaims implements `bv claims [--owner O] [--json]`.
+func runClaims(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("claims", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	ownerFilter := fs.String("owner", "", "Only claims by this owner")
+	asJSON := fs.Bool("json", false, "Emit JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv claims [--owner O] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Lists claims with the number of issues each covers, then every issue")
+		fmt.Fprintln(stderr, "claimed by more than one owner.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	beadsDir, err := loader.GetBeadsDir("")
+	if err != nil {
+		return err
+	}
+	store, err := claims.Load(beadsDir)
+	if err != nil {
+		return err
+	}
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+
+	coverage := store.Coverage(issues)
+	out := claimsOutput{Claims: []claimSummary{}, Overlaps: []claims.Overlap{}}
+	for _, c := range store.Claims {
+		if *ownerFilter != "" && c.Owner != *ownerFilter {
+			continue
+		}
+		n := 0
+		for _, cs := range coverage {
+			if slices.Contains(cs, c) {
+				n++
+			}
+		}
+		out.Claims = append(out.Claims, claimSummary{Claim: c, Issues: n})
+	}
+	for _, o := range claims.Overlaps(coverage) {
+		if *ownerFilter == "" || slices.Contains(o.Owners, *ownerFilter) {
+			out.Overlaps = append(out.Overlaps, o)
+		}
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(out); err != nil {
+			return fmt.Errorf("encoding claims: %w", err)
+		}
+		return nil
+	}
+	writeClaims(stdout, out)
+	return nil
+}
+
+// writeClaims prints the claims table followed by any overlaps
+func writeClaims(out io.Writer, c claimsOutput) {
+	if len(c.Claims) == 0 {
+		fmt.Fprintln(out, "No claims.")
+		return
+	}
+	fmt.Fprintf(out, "%-16s %-24s %6s  %-10s  %s\n", "OWNER", "TARGET", "ISSUES", "SINCE", "NOTE")
+	for _, s := range c.Claims {
+		line := fmt.Sprintf("%-16s %-24s %6d  %-10s  %s", truncateTitle(s.Owner, 16), truncateTitle(s.Target, 24),
+			s.Issues, s.ClaimedAt.Format("2006-01-02"), s.Note)
+		fmt.Fprintln(out, strings.TrimRight(line, " "))
+	}
+	if len(c.Overlaps) > 0 {
+		fmt.Fprintln(out)
+		fmt.Fprint(out, "! ")
+		writeOverlaps(out, c.Overlaps)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/claims"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestCheckClaimTarget(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Labels: []string{"api"}}}
+	for target, ok := range map[string]bool{"bv-1": true, "label:api": true, "bv-2": false, "label:ui": false} {
+		if err := checkClaimTarget(issues, target); (err == nil) != ok {
+			t.Errorf("checkClaimTarget(%q) = %v, want ok=%v", target, err, ok)
+		}
+	}
+}
+
+func TestWriteClaims(t *testing.T) {
+	since := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	alice := claims.Claim{Target: "bv-1", Owner: "alice", ClaimedAt: since, Note: "auth epic"}
+	bob := claims.Claim{Target: "label:api", Owner: "bob", ClaimedAt: since}
+
+	var out bytes.Buffer
+	writeClaims(&out, claimsOutput{
+		Claims:   []claimSummary{{Claim: alice, Issues: 3}, {Claim: bob, Issues: 2}},
+		Overlaps: []claims.Overlap{{IssueID: "bv-2", Owners: []string{"alice", "bob"}, Claims: []claims.Claim{alice, bob}}},
+	})
+	want := "OWNER            TARGET                   ISSUES  SINCE       NOTE\n" +
+		"alice            bv-1                          3  2025-03-01  auth epic\n" +
+		"bob              label:api                     2  2025-03-01\n" +
+		"\n" +
+		"! 1 issue(s) claimed by more than one owner:\n" +
+		"  bv-2: alice (bv-1), bob (label:api)\n"
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	writeClaims(&out, claimsOutput{})
+	if out.String() != "No claims.\n" {
+		t.Errorf("empty = %q", out.String())
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+)
+
+// errProblemsFound makes `bv doctor` exit non-zero without another message:
+// the report already said what is wrong.
+var errProblemsFound = fmt.Errorf("%w: integrity problems found", errUsage)
+
+// runDoctor implements `bv doctor [--json]`.
+func runDoctor(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	asJSON := fs.Bool("json", false, "Emit JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv doctor [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Checks the tracker's integrity: dependencies on missing issues, issues")
+		fmt.Fprintln(stderr, "still marked blocked after their blockers closed, unfinished epics without")
+		fmt.Fprintln(stderr, "children, and parentless issues sharing a label with a closed epic.")
+		fmt.Fprintln(stderr, "Exits 1 when it finds any.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	report := analysis.CheckIntegrity(issues)
+
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return fmt.Errorf("encoding integrity report: %w", err)
+		}
+	} else {
+		writeDoctor(stdout, report)
+	}
+	if len(report.Problems) > 0 {
+		return errProblemsFound
+	}
+	return nil
+}
+
+// writeDoctor prints the problems grouped by kind, then a count
+func writeDoctor(out io.Writer, report analysis.IntegrityReport) {
+	if len(report.Problems) == 0 {
+		fmt.Fprintln(out, "No integrity problems found.")
+		return
+	}
+	idWidth := 0
+	for _, p := range report.Problems {
+		idWidth = max(idWidth, len(p.IssueID))
+	}
+	for i, p := range report.Problems {
+		if i == 0 || report.Problems[i-1].Kind != p.Kind {
+			if i > 0 {
+				fmt.Fprintln(out)
+			}
+			fmt.Fprintf(out, "%s (%d)\n", p.Kind.Title(), report.Counts[p.Kind])
+		}
+		fmt.Fprintf(out, "  %-*s  %s: %s\n", idWidth, p.IssueID, truncateTitle(p.Title, 40), p.Message)
+	}
+	fmt.Fprintf(out, "\n%d problems.\n", len(report.Problems))
+}
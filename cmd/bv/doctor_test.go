@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestWriteDoctor(t *testing.T) {
+	report := analysis.CheckIntegrity([]model.Issue{
+		{ID: "bv-1", Title: "Task", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "bv-1", DependsOnID: "bv-9", Type: model.DepBlocks}}},
+		{ID: "bv-10", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+	})
+
+	var out bytes.Buffer
+	writeDoctor(&out, report)
+	want := "Dangling dependencies (1)\n" +
+		"  bv-1   Task: blocks dependency on missing issue bv-9\n" +
+		"\n" +
+		"Epics without children (1)\n" +
+		"  bv-10  Epic: Epic has no child issues\n" +
+		"\n" +
+		"2 problems.\n"
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	writeDoctor(&out, analysis.IntegrityReport{})
+	if out.String() != "No integrity problems found.\n" {
+		t.Errorf("clean report = %q", out.String())
+	}
+}
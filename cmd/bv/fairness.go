@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+)
+
+// runFairness implements `bv fairness [--starve-days N] [--no-history] [--json]`.
+func runFairness(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("fairness", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	starveDays := fs.Int("starve-days", analysis.DefaultStarvationDays, "Flag labels whose ready issues sat untouched longer than N days")
+	noHistory := fs.Bool("no-history", false, "Skip reading claim times from the beads history in git")
+	asJSON := fs.Bool("json", false, "Emit JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv fairness [--starve-days N] [--no-history] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "How long ready issues wait per label before someone picks them up.")
+		fmt.Fprintln(stderr, "Claim times come from the beads history in git; labels whose ready")
+		fmt.Fprintln(stderr, "issues sit untouched past --starve-days are flagged as starved.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 || *starveDays < 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+
+	var claimedAt map[string]time.Time
+	if !*noHistory {
+		claimedAt, err = loadClaimTimes()
+		if err != nil {
+			fmt.Fprintf(stderr, "Warning: no claim history (%v); showing current waits only\n", err)
+		}
+	}
+	report := analysis.ComputeReadyFairness(issues, claimedAt, *starveDays, time.Now())
+
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return fmt.Errorf("encoding fairness report: %w", err)
+		}
+		return nil
+	}
+	writeFairness(stdout, report)
+	return nil
+}
+
+// loadClaimTimes returns when each bead last moved to in_progress, read from
+// the beads file's git history
+func loadClaimTimes() (map[string]time.Time, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("getting working directory: %w", err)
+	}
+	if err := correlation.ValidateRepository(cwd); err != nil {
+		return nil, err
+	}
+	beadsDir, err := loader.GetBeadsDir("")
+	if err != nil {
+		return nil, err
+	}
+	beadsPath, err := loader.FindJSONLPath(beadsDir)
+	if err != nil {
+		return nil, err
+	}
+	events, err := correlation.NewExtractor(cwd, beadsPath).Extract(correlation.ExtractOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	claimedAt := make(map[string]time.Time)
+	for _, ev := range events {
+		if ev.EventType == correlation.EventClaimed && ev.Timestamp.After(claimedAt[ev.BeadID]) {
+			claimedAt[ev.BeadID] = ev.Timestamp
+		}
+	}
+	return claimedAt, nil
+}
+
+// writeFairness prints one row per label, starved labels first
+func writeFairness(out io.Writer, report analysis.ReadyFairness) {
+	if len(report.Labels) == 0 {
+		fmt.Fprintln(out, "Nothing is ready or has been picked up.")
+		return
+	}
+
+	fmt.Fprintf(out, "%-20s %5s %8s %9s %6s  %s\n", "LABEL", "READY", "MEDIAN", "PICKUP", "CLAIMS", "LONGEST WAITING")
+	starved := 0
+	for _, lf := range report.Labels {
+		label := lf.Label
+		if lf.Starved {
+			label = "! " + label
+			starved++
+		}
+		median, pickup, oldest := "-", "-", ""
+		if lf.Ready > 0 {
+			median = formatWaitDays(lf.MedianWaitDays)
+			oldest = fmt.Sprintf("%s %s (%s)", lf.Oldest.ID, lf.Oldest.Title, formatWaitDays(lf.Oldest.WaitDays))
+		}
+		if lf.PickedUp > 0 {
+			pickup = formatWaitDays(lf.MedianPickupDays)
+		}
+		line := fmt.Sprintf("%-20s %5d %8s %9s %6d  %s", truncateTitle(label, 20), lf.Ready, median, pickup, lf.PickedUp, oldest)
+		fmt.Fprintln(out, strings.TrimRight(line, " "))
+	}
+
+	fmt.Fprintln(out)
+	if starved > 0 {
+		fmt.Fprintf(out, "! %d starved: ready issues waiting over %dd with nothing claimed in that time.\n", starved, report.StarvationDays)
+	}
+	if !report.HasHistory {
+		fmt.Fprintln(out, "No claim history: PICKUP and CLAIMS need the beads file's git history.")
+	}
+}
+
+// formatWaitDays renders a wait as "3.5d", or hours under a day
+func formatWaitDays(days float64) string {
+	if days < 1 {
+		return fmt.Sprintf("%.0fh", days*24)
+	}
+	return fmt.Sprintf("%.1fd", days)
+}
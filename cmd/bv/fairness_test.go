@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+func TestWriteFairness(t *testing.T) {
+	picked := time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)
+	report := analysis.ReadyFairness{
+		StarvationDays: 14,
+		HasHistory:     true,
+		Labels: []analysis.LabelFairness{
+			{Label: "docs", Ready: 2, MedianWaitDays: 12.5, Oldest: &analysis.ReadyWait{ID: "bv-1", Title: "Guide", WaitDays: 20}, Starved: true},
+			{Label: "api", Ready: 1, MedianWaitDays: 0.25, Oldest: &analysis.ReadyWait{ID: "bv-2", Title: "Auth", WaitDays: 0.25},
+				PickedUp: 3, MedianPickupDays: 2, LastPickup: &picked},
+			{Label: "ops", PickedUp: 1, MedianPickupDays: 4, LastPickup: &picked},
+		},
+	}
+
+	var out bytes.Buffer
+	writeFairness(&out, report)
+	want := "LABEL                READY   MEDIAN    PICKUP CLAIMS  LONGEST WAITING\n" +
+		"! docs                   2    12.5d         -      0  bv-1 Guide (20.0d)\n" +
+		"api                      1       6h      2.0d      3  bv-2 Auth (6h)\n" +
+		"ops                      0        -      4.0d      1\n" +
+		"\n" +
+		"! 1 starved: ready issues waiting over 14d with nothing claimed in that time.\n"
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	writeFairness(&out, analysis.ReadyFairness{})
+	if out.String() != "Nothing is ready or has been picked up.\n" {
+		t.Errorf("empty report = %q", out.String())
+	}
+}
@@ -15,12 +15,14 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/term"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
@@ -38,6 +40,14 @@ import (
 )
 
 func main() {
+	// Custom statuses must be known before any issue is loaded and validated
+	model.RegisterCustomStatuses(loadProjectConfig().CustomStatuses())
+
+	// Positional subcommands (bv retro ...) have their own flag sets
+	if handled, code := runSubcommand(os.Args[1:]); handled {
+		os.Exit(code)
+	}
+
 	help := flag.Bool("help", false, "Show help")
 	versionFlag := flag.Bool("version", false, "Show version")
 	// Update flags (bv-182)
@@ -87,6 +97,14 @@ func main() {
 	alertLabel := flag.String("alert-label", "", "Filter robot alerts by label match")
 	recipeName := flag.String("recipe", "", "Apply named recipe (e.g., triage, actionable, high-impact)")
 	recipeShort := flag.String("r", "", "Shorthand for --recipe")
+	inline := flag.Bool("inline", false, "Render inline instead of on the alternate screen, leaving the last frame in the scrollback")
+	inlineHeight := flag.Int("inline-height", ui.DefaultInlineHeight, "Rows to render with --inline (0 = full terminal height)")
+	dryRun := flag.Bool("dry-run", false, "Rehearse: collect every write (relabels, review saves, pins, saved views) as pending changes instead of saving it; W lists them and exports "+loader.DryRunFile)
+	printOnExit := flag.Bool("print-on-exit", false, "Print a summary of the last view (counts, top ready issues) to stdout on quit")
+	epicRollup := flag.Bool("epic-rollup", false, "Derive each epic's status from its children and flag epics whose declared status disagrees")
+	asciiFlag := flag.Bool("ascii", false, "Draw icons, trees and borders with ASCII characters (for fonts that render Unicode glyphs badly)")
+	themeFlag := flag.String("theme", "", "Terminal background the colors are tuned for: auto (detect), dark or light (overrides theme in .bv.yaml)")
+	viewName := flag.String("view", "", "Open the lens selector with a saved view restored (see ~/.config/bv/views.yaml)")
 	semanticQuery := flag.String("search", "", "Semantic search query (vector-based; builds/updates index on first run)")
 	robotSearch := flag.Bool("robot-search", false, "Output semantic search results as JSON for AI agents (use with --search)")
 	searchLimit := flag.Int("search-limit", 10, "Max results for --search/--robot-search")
@@ -100,7 +118,9 @@ func main() {
 	profileJSON := flag.Bool("profile-json", false, "Output profile in JSON format (use with --profile-startup)")
 	noHooks := flag.Bool("no-hooks", false, "Skip running hooks during export")
 	workspaceConfig := flag.String("workspace", "", "Load issues from workspace config file (.bv/workspace.yaml)")
-	repoFilter := flag.String("repo", "", "Filter issues by repository prefix (e.g., 'api-' or 'api')")
+	streamLoad := flag.Bool("stream", false, "Open the TUI while issues are still loading (automatic for JSONL files over 16 MiB)")
+	var repoArgs repoFlag
+	flag.Var(&repoArgs, "repo", "Repository path to aggregate (repeatable), or issue ID prefix to filter by (e.g., 'api-' or 'api')")
 	saveBaseline := flag.String("save-baseline", "", "Save current metrics as baseline with optional description")
 	baselineInfo := flag.Bool("baseline-info", false, "Show information about the current baseline")
 	checkDrift := flag.Bool("check-drift", false, "Check for drift from baseline (exit codes: 0=OK, 1=critical, 2=warning)")
@@ -203,6 +223,17 @@ func main() {
 	_ = labelScope
 	_ = agentBrief
 
+	var dry *loader.DryRun // Pending changes of a --dry-run session
+	if *dryRun {
+		dry = loader.NewDryRun()
+	}
+	switch strings.ToLower(*themeFlag) {
+	case "", "auto", "dark", "light":
+	default:
+		fmt.Fprintf(os.Stderr, "Invalid theme: %s (use: auto, dark, light)\n", *themeFlag)
+		os.Exit(1)
+	}
+
 	envRobot := os.Getenv("BV_ROBOT") == "1"
 	stdoutIsTTY := term.IsTerminal(int(os.Stdout.Fd()))
 
@@ -443,7 +474,9 @@ func main() {
 		fmt.Println("")
 		fmt.Println("  --robot-forecast <id|all>")
 		fmt.Println("      Outputs ETA forecast for a specific bead or all open issues.")
-		fmt.Println("      Returns estimated completion date, confidence, and factors.")
+		fmt.Println("      Returns estimated completion date, confidence (with an A-D grade), and factors.")
+		fmt.Println("      A top-level quality grade rates the data behind the forecast: estimate")
+		fmt.Println("      coverage, recent closures, and how much open work is brand new.")
 		fmt.Println("      Options:")
 		fmt.Println("        --forecast-label=X    Filter by label")
 		fmt.Println("        --forecast-sprint=Y   Filter by sprint")
@@ -635,6 +668,104 @@ func main() {
 		fmt.Println("      Example: bv --recipe actionable")
 		fmt.Println("      Built-in recipes: default, actionable, recent, blocked, high-impact, stale")
 		fmt.Println("")
+		fmt.Println("  --view NAME")
+		fmt.Println("      Open the lens selector with a saved view (scope, search mode, depth, layout).")
+		fmt.Println("      Save views from the lens selector with 'w'; they live in ~/.config/bv/views.yaml.")
+		fmt.Println("      Example: bv --view backend")
+		fmt.Println("")
+		fmt.Println("  --inline [--inline-height N]")
+		fmt.Println("      Run the TUI without the alternate screen, N rows tall (default 24, 0 = full")
+		fmt.Println("      height), so the last frame stays in the scrollback or CI log after quitting.")
+		fmt.Println("      Mouse capture is off so the wheel scrolls the terminal.")
+		fmt.Println("")
+		fmt.Println("  --print-on-exit")
+		fmt.Println("      On quit, print a summary of the last lens viewed (or the list) to stdout:")
+		fmt.Println("      status counts and the top ready issues. Set print_on_exit in .bv.yaml to")
+		fmt.Println("      make it the default.")
+		fmt.Println("")
+		fmt.Println("  --epic-rollup")
+		fmt.Println("      Derive each epic's status from its children (closed when all are closed,")
+		fmt.Println("      blocked when every open child is blocked, in progress once work started)")
+		fmt.Println("      and show it beside the declared status in lens trees, flagging epics where")
+		fmt.Println("      the two disagree. Set epic_rollup in .bv.yaml to make it the default.")
+		fmt.Println("")
+		fmt.Println("  --ascii")
+		fmt.Println("      Draw icons, tree lines, arrows and borders with ASCII characters, for fonts")
+		fmt.Println("      and terminals that render symbols like ◆ ▸ └─ poorly. Set ascii in .bv.yaml to")
+		fmt.Println("      make it the default.")
+		fmt.Println("")
+		fmt.Println("  --theme auto|dark|light")
+		fmt.Println("      Terminal background the colors are tuned for. auto (the default) asks the")
+		fmt.Println("      terminal; set dark or light when it cannot tell, e.g. over SSH or in tmux.")
+		fmt.Println("      Overrides theme in .bv.yaml; Ctrl+T picks the palette.")
+		fmt.Println("")
+		fmt.Println("  retro EPIC-ID [--md FILE]")
+		fmt.Println("      Planned-vs-actual report for an epic: first-to-last closure span, issues")
+		fmt.Println("      added mid-flight, longest-blocked items, and cycle time distribution.")
+		fmt.Println("      Opens in the TUI (press 'x' to export Markdown) or writes FILE with --md.")
+		fmt.Println("      Example: bv retro bv-42 --md retro.md")
+		fmt.Println("")
+		fmt.Println("  review ID | --label L | --resume [--type T] [--reviewer NAME]")
+		fmt.Println("      Opens the review dashboard for an issue and its descendants, or a label.")
+		fmt.Println("      Progress is kept in .beads/bv-session.json as you go; --resume restores")
+		fmt.Println("      the cursor, filters and unsaved reviews after a crash or a stray Q.")
+		fmt.Println("      Example: bv review bv-42 --type implementation")
+		fmt.Println("")
+		fmt.Println("  ready [--label L] [--assignee A] [--json]")
+		fmt.Println("      Lists issues with no open blockers, sorted by priority then PageRank.")
+		fmt.Println("      Headless: suited to scripts and shell prompts.")
+		fmt.Println("      Example: bv ready --label backend --json | jq -r '.[0].id'")
+		fmt.Println("")
+		fmt.Println("  fairness [--starve-days N] [--no-history] [--json]")
+		fmt.Println("      Per label: how many issues are ready, how long they have waited, and how")
+		fmt.Println("      long past ones waited before being claimed (from the beads git history).")
+		fmt.Println("      Labels whose ready issues sit untouched past --starve-days are starved.")
+		fmt.Println("      Example: bv fairness --starve-days 7")
+		fmt.Println("")
+		fmt.Println("  claim ID|label:NAME [--as OWNER] [--note TEXT] [--exclusive] [--release]")
+		fmt.Println("      Marks an issue and its descendants (an epic's workstream), or a label, as")
+		fmt.Println("      taken by OWNER (default $BV_AGENT, then $USER) in .beads/claims.json.")
+		fmt.Println("      Warns when other owners' claims cover the same issues; --exclusive refuses.")
+		fmt.Println("      Example: BV_AGENT=agent-2 bv claim bv-42 --exclusive")
+		fmt.Println("")
+		fmt.Println("  claims [--owner O] [--json]")
+		fmt.Println("      Lists claims with the issues each covers, then any overlaps.")
+		fmt.Println("")
+		fmt.Println("  stats [--top N] [--json]")
+		fmt.Println("      Dependency graph health: issue and edge counts, density, orphans, cycles,")
+		fmt.Println("      the longest blocker chains, and the top issues by PageRank, betweenness,")
+		fmt.Println("      in-degree and out-degree. JSON output suits tracking in CI over time.")
+		fmt.Println("      Example: bv stats --json > graph-stats.json")
+		fmt.Println("")
+		fmt.Println("  path FROM-ID TO-ID [--limit N] [--all-types] [--json]")
+		fmt.Println("      Answers \"why is FROM waiting on TO?\": the shortest chain of blockers")
+		fmt.Println("      between them, then every chain up to --limit, shortest first. Checks the")
+		fmt.Println("      other direction when FROM does not wait on TO.")
+		fmt.Println("      Example: bv path bv-42 bv-7")
+		fmt.Println("")
+		fmt.Println("  affected [ID...] [--since REV] [--json]")
+		fmt.Println("      Lists the changed issues and everything downstream of them (issues they")
+		fmt.Println("      block or parent, transitively), each traced to its nearest change.")
+		fmt.Println("      --since takes the changes from git; in the TUI, press A in time-travel.")
+		fmt.Println("      Example: bv affected --since HEAD~10")
+		fmt.Println("")
+		fmt.Println("  repl")
+		fmt.Println("      Loads the beads once and answers one query per line with a table:")
+		fmt.Println("      status:open label:api p<=1, (type:bug or blocked) sort:-updated limit:10.")
+		fmt.Println("      \\export FILE saves the last result as CSV or JSON; \\help lists the fields.")
+		fmt.Println("      Example: echo 'ready label:backend' | bv repl")
+		fmt.Println("")
+		fmt.Println("  watch [--format text|json] [--poll]")
+		fmt.Println("      Streams tracker changes until interrupted: issues created, deleted, closed")
+		fmt.Println("      or moved, blockers resolved, and ready-set changes. --format=json prints")
+		fmt.Println("      one JSON event per line for agents and scripts to react to.")
+		fmt.Println("      Example: bv watch --format=json | jq -c 'select(.type == \"ready_changed\")'")
+		fmt.Println("")
+		fmt.Println("  version [--check] [--json]")
+		fmt.Println("      Prints the version; --check compares against the latest release and")
+		fmt.Println("      prints upgrade instructions. The TUI also checks at startup unless")
+		fmt.Println("      BV_NO_UPDATE_CHECK=1 is set or the project config has no_update_check: true.")
+		fmt.Println("")
 		fmt.Println("  --profile-startup")
 		fmt.Println("      Outputs detailed startup timing profile for diagnostics.")
 		fmt.Println("      Shows Phase 1 (blocking) and Phase 2 (async) breakdown.")
@@ -647,8 +778,14 @@ func main() {
 		fmt.Println("      Aggregates issues from multiple repositories with namespaced IDs.")
 		fmt.Println("      Example: bv --workspace .bv/workspace.yaml")
 		fmt.Println("")
+		fmt.Println("  --repo PATH (repeatable)")
+		fmt.Println("      Aggregate several beads-tracked repos without a workspace file.")
+		fmt.Println("      IDs are prefixed with the directory name (../api -> api-12), and")
+		fmt.Println("      cross-repo dependencies using those prefixes become real edges.")
+		fmt.Println("      Example: bv --repo ../api --repo ../web")
+		fmt.Println("")
 		fmt.Println("  --repo PREFIX")
-		fmt.Println("      Filter issues by repository prefix.")
+		fmt.Println("      Filter issues by repository prefix (values that aren't repo paths).")
 		fmt.Println("      Use with --workspace to focus on one repo in a multi-repo view.")
 		fmt.Println("      Matches ID prefixes like 'api-', 'web-', or partial 'api'.")
 		fmt.Println("      Example: bv --workspace .bv/workspace.yaml --repo api")
@@ -707,7 +844,7 @@ func main() {
 	}
 
 	if *versionFlag {
-		fmt.Printf("bv %s\n", version.Version)
+		fmt.Printf("bv %s\n", version.Info())
 		os.Exit(0)
 	}
 
@@ -940,12 +1077,22 @@ func main() {
 		}
 	}
 
+	// --repo values naming a beads-tracked directory select repos to aggregate;
+	// anything else (and everything in --workspace mode) filters by ID prefix
+	var repoPaths []string
+	repoFilter := repoArgs.last()
+	if *workspaceConfig == "" {
+		repoPaths, repoFilter = repoArgs.split()
+	}
+
 	// Load issues from current directory or workspace (with timing for profile)
 	loadStart := time.Now()
 	var issues []model.Issue
 	var beadsPath string
 	var workspaceInfo *workspace.LoadSummary
 	var asOfResolved string // Resolved commit SHA when using --as-of (for robot output metadata)
+	var issueBatches <-chan loader.StreamBatch // Rest of a streaming load (TUI only)
+	var firstBatch loader.StreamBatch
 
 	if *asOf != "" {
 		// Time-travel mode: load historical issues from git
@@ -953,6 +1100,9 @@ func main() {
 		if *workspaceConfig != "" {
 			fmt.Fprintf(os.Stderr, "Warning: --workspace is ignored when --as-of is specified\n")
 		}
+		if len(repoPaths) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: --repo paths are ignored when --as-of is specified\n")
+		}
 		cwd, err := os.Getwd()
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
@@ -975,9 +1125,16 @@ func main() {
 				fmt.Fprintf(os.Stderr, "Loaded %d issues from %s\n", len(issues), *asOf)
 			}
 		}
-	} else if *workspaceConfig != "" {
-		// Load from workspace configuration
-		loadedIssues, results, err := workspace.LoadAllFromConfig(context.Background(), *workspaceConfig)
+	} else if *workspaceConfig != "" || len(repoPaths) > 0 {
+		// Load from workspace configuration, or an ad-hoc workspace of --repo paths
+		var loadedIssues []model.Issue
+		var results []workspace.LoadResult
+		var err error
+		if *workspaceConfig != "" {
+			loadedIssues, results, err = workspace.LoadAllFromConfig(context.Background(), *workspaceConfig)
+		} else {
+			loadedIssues, results, err = workspace.LoadAllFromPaths(context.Background(), repoPaths)
+		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading workspace: %v\n", err)
 			os.Exit(1)
@@ -1000,15 +1157,27 @@ func main() {
 
 		// Automatically ensure .bv/ is in .gitignore at workspace root
 		// Workspace config is typically at .bv/workspace.yaml, so project root is two levels up
-		workspaceRoot := filepath.Dir(filepath.Dir(*workspaceConfig))
-		_ = loader.EnsureBVInGitignore(workspaceRoot)
+		if *workspaceConfig != "" {
+			workspaceRoot := filepath.Dir(filepath.Dir(*workspaceConfig))
+			_ = loader.EnsureBVInGitignore(workspaceRoot)
+		}
 	} else {
-		// Load from single repo (original behavior)
+		// Load from single repo (original behavior). Plain TUI runs on a
+		// large file stream the issues in behind the first batch.
 		var err error
-		issues, err = loader.LoadIssues("")
+		if path, ok := streamLoadPath(*streamLoad, robotMode); ok {
+			issues, issueBatches, firstBatch, err = startIssueStream(path)
+		} else {
+			issues, err = loader.LoadIssues("")
+		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading beads: %v\n", err)
-			fmt.Fprintln(os.Stderr, "Make sure you are in a project initialized with 'bd init'.")
+			if loader.BdAvailable() {
+				fmt.Fprintln(os.Stderr, "Make sure you are in a project initialized with 'bd init'.")
+			} else {
+				fmt.Fprintln(os.Stderr, "No .beads JSONL found here, and the bd CLI is not installed.")
+				fmt.Fprintln(os.Stderr, "Run bv inside a repo that has .beads/ (or set BEADS_DIR), or install bd: github.com/steveyegge/beads")
+			}
 			os.Exit(1)
 		}
 		// Get beads file path for live reload (respects BEADS_DIR env var)
@@ -1024,8 +1193,8 @@ func main() {
 	loadDuration := time.Since(loadStart)
 
 	// Apply --repo filter if specified
-	if *repoFilter != "" {
-		issues = filterByRepo(issues, *repoFilter)
+	if repoFilter != "" {
+		issues = filterByRepo(issues, repoFilter)
 	}
 
 	issuesForSearch := issues
@@ -1285,7 +1454,7 @@ func main() {
 		if !*pagesIncludeClosed {
 			var openIssues []model.Issue
 			for _, issue := range issues {
-				if issue.Status != model.StatusClosed {
+				if !issue.Status.IsClosed() {
 					openIssues = append(openIssues, issue)
 				}
 			}
@@ -3860,21 +4029,23 @@ func main() {
 			LatestETA     time.Time `json:"latest_eta"`
 		}
 		type ForecastOutput struct {
-			GeneratedAt   time.Time              `json:"generated_at"`
-			Agents        int                    `json:"agents"`
-			Filters       map[string]string      `json:"filters,omitempty"`
-			ForecastCount int                    `json:"forecast_count"`
-			Forecasts     []analysis.ETAEstimate `json:"forecasts"`
-			Summary       *ForecastSummary       `json:"summary,omitempty"`
+			GeneratedAt   time.Time                `json:"generated_at"`
+			Agents        int                      `json:"agents"`
+			Filters       map[string]string        `json:"filters,omitempty"`
+			ForecastCount int                      `json:"forecast_count"`
+			Forecasts     []analysis.ETAEstimate   `json:"forecasts"`
+			Quality       analysis.ForecastQuality `json:"quality"`
+			Summary       *ForecastSummary         `json:"summary,omitempty"`
 		}
 
 		var forecasts []analysis.ETAEstimate
+		var forecastIssues []model.Issue
 		var outputErr error
 
 		if *robotForecast == "all" {
 			// Forecast all open issues
 			for _, iss := range targetIssues {
-				if iss.Status == model.StatusClosed {
+				if iss.Status.IsClosed() {
 					continue
 				}
 				eta, err := analysis.EstimateETAForIssue(issues, &graphStats, iss.ID, agents, now)
@@ -3882,6 +4053,7 @@ func main() {
 					continue
 				}
 				forecasts = append(forecasts, eta)
+				forecastIssues = append(forecastIssues, iss)
 			}
 		} else {
 			// Single issue forecast
@@ -3891,6 +4063,12 @@ func main() {
 				os.Exit(1)
 			}
 			forecasts = append(forecasts, eta)
+			for _, iss := range issues {
+				if iss.ID == *robotForecast {
+					forecastIssues = append(forecastIssues, iss)
+					break
+				}
+			}
 		}
 
 		// Build summary if multiple forecasts
@@ -3933,6 +4111,7 @@ func main() {
 			Agents:        agents,
 			ForecastCount: len(forecasts),
 			Forecasts:     forecasts,
+			Quality:       analysis.AssessForecastQuality(issues, forecastIssues, now),
 			Summary:       summary,
 		}
 		if len(filters) > 0 {
@@ -3974,7 +4153,7 @@ func main() {
 		issueMap := make(map[string]model.Issue)
 		for _, iss := range targetIssues {
 			issueMap[iss.ID] = iss
-			if iss.Status != model.StatusClosed {
+			if !iss.Status.IsClosed() {
 				openIssues = append(openIssues, iss)
 			}
 		}
@@ -4020,7 +4199,7 @@ func main() {
 		for _, iss := range openIssues {
 			hasOpenBlocker := false
 			for _, depID := range blockedBy[iss.ID] {
-				if dep, ok := issueMap[depID]; ok && dep.Status != model.StatusClosed {
+				if dep, ok := issueMap[depID]; ok && !dep.Status.IsClosed() {
 					hasOpenBlocker = true
 					break
 				}
@@ -4045,7 +4224,7 @@ func main() {
 				copy(longestChain, path)
 			}
 			for _, nextID := range blocks[id] {
-				if dep, ok := issueMap[nextID]; ok && dep.Status != model.StatusClosed {
+				if dep, ok := issueMap[nextID]; ok && !dep.Status.IsClosed() {
 					dfs(nextID, path)
 				}
 			}
@@ -4120,6 +4299,8 @@ func main() {
 			ActionableCount   int          `json:"actionable_count"`
 			Actionable        []string     `json:"actionable,omitempty"`
 			Bottlenecks       []Bottleneck `json:"bottlenecks,omitempty"`
+			// Quality grades how trustworthy EstimatedDays is
+			Quality analysis.ForecastQuality `json:"quality"`
 		}
 
 		output := CapacityOutput{
@@ -4137,6 +4318,7 @@ func main() {
 			ActionableCount:   len(actionable),
 			Actionable:        actionable,
 			Bottlenecks:       bottlenecks,
+			Quality:           analysis.AssessForecastQuality(issues, openIssues, now),
 		}
 		if *capacityLabel != "" {
 			output.Label = *capacityLabel
@@ -4230,8 +4412,18 @@ func main() {
 		}
 
 		// Launch TUI with historical issues (already loaded, no live reload)
-		m := ui.NewModel(issues, activeRecipe, "")
-		p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
+		asOfOpts := []ui.ModelOption{
+			ui.WithProjectConfig(loadProjectConfig()),
+			ui.WithASCII(*asciiFlag),
+			ui.WithEpicRollup(*epicRollup),
+			ui.WithTheme(*themeFlag),
+			ui.WithDryRun(dry),
+		}
+		if *inline {
+			asOfOpts = append(asOfOpts, ui.WithInlineHeight(*inlineHeight))
+		}
+		m := ui.NewModel(issues, activeRecipe, "", asOfOpts...)
+		p := tea.NewProgram(m, programOptions(*inline)...)
 
 		// Optional auto-quit for automated tests: set BV_TUI_AUTOCLOSE_MS
 		if v := os.Getenv("BV_TUI_AUTOCLOSE_MS"); v != "" {
@@ -4246,10 +4438,13 @@ func main() {
 				}()
 			}
 		}
-		if _, err := p.Run(); err != nil {
+		final, err := p.Run()
+		if err != nil {
 			fmt.Printf("Error running beads viewer: %v\n", err)
 			os.Exit(1)
 		}
+		printExitSummary(final, *printOnExit)
+		printDryRunSummary(dry)
 		os.Exit(0)
 	}
 
@@ -4315,9 +4510,28 @@ func main() {
 	}
 
 	// Initial Model with live reload support
-	m := ui.NewModel(issues, activeRecipe, beadsPath)
+	modelOpts := []ui.ModelOption{
+		ui.WithProjectConfig(loadProjectConfig()),
+		ui.WithASCII(*asciiFlag),
+		ui.WithEpicRollup(*epicRollup),
+		ui.WithTheme(*themeFlag),
+		ui.WithDryRun(dry),
+	}
+	if issueBatches != nil {
+		modelOpts = append(modelOpts, ui.WithIssueStream(issueBatches, firstBatch))
+	}
+	if *inline {
+		modelOpts = append(modelOpts, ui.WithInlineHeight(*inlineHeight))
+	}
+	m := ui.NewModel(issues, activeRecipe, beadsPath, modelOpts...)
 	defer m.Stop() // Clean up file watcher
 
+	// Without bd we can still browse the JSONL, but nothing can be written back.
+	// A dry run writes nothing either way, so it needs no bd.
+	if !*dryRun && !loader.BdAvailable() {
+		m.EnableReadOnlyMode()
+	}
+
 	// Enable workspace mode if loading from workspace config
 	if workspaceInfo != nil {
 		m.EnableWorkspaceMode(ui.WorkspaceInfo{
@@ -4329,6 +4543,14 @@ func main() {
 		})
 	}
 
+	// Restore a saved lens view
+	if *viewName != "" {
+		if err := m.OpenSavedView(*viewName); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	// Debug render mode - output a view to file and exit
 	if *debugRender != "" {
 		output := m.RenderDebugView(*debugRender, *debugWidth, *debugHeight)
@@ -4337,7 +4559,7 @@ func main() {
 	}
 
 	// Run Program
-	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
+	p := tea.NewProgram(m, programOptions(*inline)...)
 
 	// Optional auto-quit for automated tests: set BV_TUI_AUTOCLOSE_MS
 	if v := os.Getenv("BV_TUI_AUTOCLOSE_MS"); v != "" {
@@ -4352,12 +4574,56 @@ func main() {
 			}()
 		}
 	}
-	if _, err := p.Run(); err != nil {
+	final, err := p.Run()
+	if err != nil {
 		fmt.Printf("Error running beads viewer: %v\n", err)
 		os.Exit(1)
 	}
+	printExitSummary(final, *printOnExit)
+	printDryRunSummary(dry)
 }
 
+// printDryRunSummary reminds the user, after a --dry-run session, that the
+// changes they made were never saved
+func printDryRunSummary(d *loader.DryRun) {
+	if d != nil && d.Len() > 0 {
+		fmt.Fprintf(os.Stderr, "Dry run: %d pending change(s) discarded, nothing was saved (export them with W, e before quitting)\n", d.Len())
+	}
+}
+
+// printExitSummary prints the final view's summary when --print-on-exit or
+// print_on_exit asks for it. It runs after the TUI has released the terminal.
+func printExitSummary(final tea.Model, flagSet bool) {
+	if !flagSet && !loadProjectConfig().PrintOnExit {
+		return
+	}
+	if fm, ok := final.(ui.Model); ok {
+		fmt.Print(fm.ExitSummary())
+	}
+}
+
+// programOptions returns the bubbletea options for the TUI. Inline mode skips
+// the alternate screen so the last frame stays in the scrollback, and mouse
+// capture so the wheel keeps scrolling the terminal.
+func programOptions(inline bool) []tea.ProgramOption {
+	if inline {
+		return nil
+	}
+	return []tea.ProgramOption{tea.WithAltScreen(), tea.WithMouseCellMotion()}
+}
+
+// loadProjectConfig reads .bv.yaml (or .beads/bv.toml) from the working
+// directory once. A broken file is reported and the built-in defaults are used.
+var loadProjectConfig = sync.OnceValue(func() *config.Config {
+	cwd, _ := os.Getwd()
+	cfg, err := config.Load(cwd)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v (using defaults)\n", err)
+		return &config.Config{}
+	}
+	return cfg
+})
+
 // countEdges counts blocking dependencies for config sizing
 func countEdges(issues []model.Issue) int {
 	count := 0
@@ -4554,7 +4820,7 @@ func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 	// Build a set of open blocker IDs for actionable filtering
 	openBlockers := make(map[string]bool)
 	for _, issue := range issues {
-		if issue.Status != model.StatusClosed {
+		if !issue.Status.IsClosed() {
 			openBlockers[issue.ID] = true
 		}
 	}
@@ -4982,6 +5248,37 @@ func generateProfileRecommendations(profile *analysis.StartupProfile, loadDurati
 	return recs
 }
 
+// repoFlag collects repeated --repo values.
+type repoFlag []string
+
+func (r *repoFlag) String() string { return strings.Join(*r, ",") }
+
+func (r *repoFlag) Set(v string) error {
+	*r = append(*r, v)
+	return nil
+}
+
+// last returns the final value, or "" if none was given.
+func (r repoFlag) last() string {
+	if len(r) == 0 {
+		return ""
+	}
+	return r[len(r)-1]
+}
+
+// split separates repository paths (directories containing .beads) from the
+// ID prefix filter, which is the last value that is not such a directory.
+func (r repoFlag) split() (paths []string, filter string) {
+	for _, v := range r {
+		if info, err := os.Stat(filepath.Join(v, ".beads")); err == nil && info.IsDir() {
+			paths = append(paths, v)
+		} else {
+			filter = v
+		}
+	}
+	return paths, filter
+}
+
 // filterByRepo filters issues to only include those from a specific repository.
 // The filter matches issue IDs that start with the given prefix.
 // If the prefix doesn't end with a separator character, it normalizes by checking
@@ -5631,7 +5928,7 @@ func runPagesWizard(issues []model.Issue, beadsPath string) error {
 	if !config.IncludeClosed {
 		var openIssues []model.Issue
 		for _, issue := range issues {
-			if issue.Status != model.StatusClosed {
+			if !issue.Status.IsClosed() {
 				openIssues = append(openIssues, issue)
 			}
 		}
@@ -6064,7 +6361,7 @@ func calculateBurndownAt(sprint *model.Sprint, issues []model.Issue, now time.Ti
 	totalIssues := len(sprintIssues)
 	completedIssues := 0
 	for _, iss := range sprintIssues {
-		if iss.Status == model.StatusClosed {
+		if iss.Status.IsClosed() {
 			completedIssues++
 		}
 	}
@@ -6159,7 +6456,7 @@ func generateDailyBurndown(sprint *model.Sprint, issues []model.Issue, now time.
 		completed := 0
 
 		for _, iss := range issues {
-			if iss.Status == model.StatusClosed && iss.ClosedAt != nil && !iss.ClosedAt.After(dayEnd) {
+			if iss.Status.IsClosed() && iss.ClosedAt != nil && !iss.ClosedAt.After(dayEnd) {
 				completed++
 			}
 		}
@@ -6366,7 +6663,7 @@ func generateHistoryForExport(issues []model.Issue) (*TimeTravelHistory, error)
 	// Check for closed beads (status = closed)
 	issueStatusMap := make(map[string]bool)
 	for _, issue := range issues {
-		issueStatusMap[issue.ID] = issue.Status == model.StatusClosed
+		issueStatusMap[issue.ID] = issue.Status.IsClosed()
 	}
 
 	// Convert map to sorted slice
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// runNext implements `bv next [-n N] [--label L] [--json]`.
+func runNext(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("next", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	limit := fs.Int("n", 5, "How many suggestions to show")
+	label := fs.String("label", "", "Only suggest issues with this label")
+	asJSON := fs.Bool("json", false, "Emit JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv next [-n N] [--label L] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "What to work on next: the ready issues ranked by the triage score of")
+		fmt.Fprintln(stderr, "--robot-triage (impact, unblocks, centrality, priority, staleness),")
+		fmt.Fprintln(stderr, "each with its main reason.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 || *limit < 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+
+	// Score against the whole graph, as --robot-triage does, so blockers and
+	// dependents outside --label count; then keep the ready picks in scope
+	triage := analysis.ComputeTriageWithOptions(issues, analysis.TriageOptions{TopN: len(issues), WaitForPhase2: true})
+	ready := make(map[string]bool)
+	for _, issue := range analysis.ReadyIssues(issues, nil) {
+		if *label == "" || slices.Contains(issue.Labels, *label) {
+			ready[issue.ID] = true
+		}
+	}
+	picks := make([]analysis.Recommendation, 0, *limit)
+	for _, rec := range triage.Recommendations {
+		if ready[rec.ID] && len(picks) < *limit {
+			picks = append(picks, rec)
+		}
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(picks); err != nil {
+			return fmt.Errorf("encoding suggestions: %w", err)
+		}
+		return nil
+	}
+	if len(picks) == 0 {
+		fmt.Fprintln(stdout, nothingNext(issues, *label))
+		return nil
+	}
+	writeNext(stdout, picks)
+	return nil
+}
+
+// nothingNext explains an empty suggestion list: no issues in scope, none
+// open, or all of the open ones blocked
+func nothingNext(issues []model.Issue, label string) string {
+	scope, inScope, open := "", 0, 0
+	if label != "" {
+		scope = fmt.Sprintf(" with label %q", label)
+	}
+	for _, issue := range issues {
+		if label != "" && !slices.Contains(issue.Labels, label) {
+			continue
+		}
+		inScope++
+		if !issue.Status.IsClosed() {
+			open++
+		}
+	}
+	switch {
+	case inScope == 0:
+		return fmt.Sprintf("No issues%s.", scope)
+	case open == 0:
+		return fmt.Sprintf("Nothing%s is open.", scope)
+	}
+	return fmt.Sprintf("Nothing%s is ready: every open issue%s is blocked.", scope, scope)
+}
+
+// writeNext prints each pick with its triage score and, indented below, the
+// main reason it was picked
+func writeNext(out io.Writer, picks []analysis.Recommendation) {
+	idWidth := 0
+	for _, rec := range picks {
+		idWidth = max(idWidth, len(rec.ID))
+	}
+	for i, rec := range picks {
+		fmt.Fprintf(out, "%2d. %-*s  P%d  %-50s %.2f\n", i+1, idWidth, rec.ID, rec.Priority, truncateTitle(rec.Title, 50), rec.Score)
+		if len(rec.Reasons) > 0 {
+			fmt.Fprintf(out, "    %s\n", rec.Reasons[0])
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestWriteNext(t *testing.T) {
+	picks := []analysis.Recommendation{
+		{ID: "bv-12", Title: "Auth token refresh", Priority: 2, Score: 0.68, Reasons: []string{"🔓 Unblocks 1 item(s): bv-13", "✅ Currently unclaimed - available for work"}},
+		{ID: "bv-3", Title: "Crash on empty config", Priority: 0, Score: 0.41, Reasons: []string{"🚨 High priority (P0) - prioritize this work"}},
+	}
+
+	var out bytes.Buffer
+	writeNext(&out, picks)
+	want := " 1. bv-12  P2  Auth token refresh                                 0.68\n" +
+		"    🔓 Unblocks 1 item(s): bv-13\n" +
+		" 2. bv-3   P0  Crash on empty config                              0.41\n" +
+		"    🚨 High priority (P0) - prioritize this work\n"
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestNothingNext(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Status: model.StatusOpen, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "bv-1", DependsOnID: "bv-2", Type: model.DepBlocks}}},
+		{ID: "bv-2", Status: model.StatusBlocked, Labels: []string{"api"}},
+		{ID: "bv-3", Status: model.StatusClosed, Labels: []string{"ui"}},
+	}
+	cases := map[string]string{
+		"":    "Nothing is ready: every open issue is blocked.",
+		"api": `Nothing with label "api" is ready: every open issue with label "api" is blocked.`,
+		"ui":  `Nothing with label "ui" is open.`,
+		"db":  `No issues with label "db".`,
+	}
+	for label, want := range cases {
+		if got := nothingNext(issues, label); got != want {
+			t.Errorf("label %q: %q, want %q", label, got, want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// runPath implements `bv path <from> <to> [--limit N] [--all-types] [--json]`.
+func runPath(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("path", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	limit := fs.Int("limit", 10, "List at most N paths")
+	allTypes := fs.Bool("all-types", false, "Follow parent-child, related and discovered-from links too, not just blockers")
+	asJSON := fs.Bool("json", false, "Emit JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv path <from-id> <to-id> [--limit N] [--all-types] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Explains why one issue is waiting on another: the shortest chain of")
+		fmt.Fprintln(stderr, "blockers from <from-id> to <to-id>, then every chain, shortest first.")
+		fmt.Fprintln(stderr, "If <from-id> does not wait on <to-id>, checks the other direction.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+
+	// Allow the IDs before or after flags
+	var ids []string
+	for len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		ids, args = append(ids, args[0]), args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ids = append(ids, fs.Args()...)
+	if len(ids) != 2 || *limit < 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	result, err := analysis.FindDependencyPaths(issues, ids[0], ids[1], analysis.PathOptions{AllTypes: *allTypes, MaxPaths: *limit})
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(result); err != nil {
+			return fmt.Errorf("encoding paths: %w", err)
+		}
+		return nil
+	}
+	writeDependencyPaths(stdout, result, issues)
+	return nil
+}
+
+// writeDependencyPaths prints result: the shortest path with titles and
+// statuses, one issue per line, then every path on one line each
+func writeDependencyPaths(out io.Writer, result analysis.DependencyPathsResult, issues []model.Issue) {
+	if result.Shortest == nil {
+		fmt.Fprintf(out, "No dependency path between %s and %s in either direction.\n", result.From, result.To)
+		return
+	}
+
+	from, to := result.From, result.To
+	if result.Reversed {
+		fmt.Fprintf(out, "%s does not wait on %s, but %s waits on %s.\n\n", from, to, to, from)
+		from, to = to, from
+	}
+
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+
+	fmt.Fprintf(out, "%s waits on %s (shortest: %s):\n", from, to, hopCount(result.Shortest.Hops()))
+	for i, id := range result.Shortest.IDs {
+		issue := byID[id]
+		line := fmt.Sprintf("%s %s [%s]", id, issue.Title, issue.Status)
+		if i == 0 {
+			fmt.Fprintf(out, "  %s\n", line)
+			continue
+		}
+		via := ""
+		if typ := result.Shortest.Types[i-1]; typ != model.DepBlocks {
+			via = " (" + string(typ) + ")"
+		}
+		fmt.Fprintf(out, "  %s└▸ %s%s\n", strings.Repeat("  ", i-1), line, via)
+	}
+
+	if len(result.Paths) > 1 {
+		more := ""
+		if result.Truncated {
+			more = ", more not shown"
+		}
+		fmt.Fprintf(out, "\nAll paths (%d%s):\n", len(result.Paths), more)
+		for i, p := range result.Paths {
+			var b strings.Builder
+			b.WriteString(p.IDs[0])
+			for j, id := range p.IDs[1:] {
+				if typ := p.Types[j]; typ != model.DepBlocks {
+					b.WriteString(" →(" + string(typ) + ") " + id)
+				} else {
+					b.WriteString(" → " + id)
+				}
+			}
+			fmt.Fprintf(out, "  %d. %s\n", i+1, b.String())
+		}
+	}
+}
+
+// hopCount renders "1 hop" or "n hops"
+func hopCount(n int) string {
+	if n == 1 {
+		return "1 hop"
+	}
+	return fmt.Sprintf("%d hops", n)
+}
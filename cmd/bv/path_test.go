@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestWriteDependencyPaths(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Schema", Status: model.StatusOpen},
+		{ID: "bv-2", Title: "API", Status: model.StatusInProgress, Dependencies: []*model.Dependency{
+			{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+		{ID: "bv-3", Title: "Ship", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{IssueID: "bv-3", DependsOnID: "bv-2", Type: model.DepBlocks},
+			{IssueID: "bv-3", DependsOnID: "bv-1", Type: model.DepParentChild}}},
+	}
+
+	tests := []struct {
+		from, to string
+		opts     analysis.PathOptions
+		want     string
+	}{
+		{"bv-1", "bv-3", analysis.PathOptions{AllTypes: true},
+			"bv-1 does not wait on bv-3, but bv-3 waits on bv-1.\n\n" +
+				"bv-3 waits on bv-1 (shortest: 1 hop):\n" +
+				"  bv-3 Ship [open]\n" +
+				"  └▸ bv-1 Schema [open] (parent-child)\n\n" +
+				"All paths (2):\n" +
+				"  1. bv-3 →(parent-child) bv-1\n" +
+				"  2. bv-3 → bv-2 → bv-1\n"},
+		{"bv-3", "bv-1", analysis.PathOptions{},
+			"bv-3 waits on bv-1 (shortest: 2 hops):\n" +
+				"  bv-3 Ship [open]\n" +
+				"  └▸ bv-2 API [in_progress]\n" +
+				"    └▸ bv-1 Schema [open]\n"},
+	}
+	for _, tt := range tests {
+		result, err := analysis.FindDependencyPaths(issues, tt.from, tt.to, tt.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out bytes.Buffer
+		writeDependencyPaths(&out, result, issues)
+		if out.String() != tt.want {
+			t.Errorf("%s → %s:\n%s\nwant:\n%s", tt.from, tt.to, out.String(), tt.want)
+		}
+	}
+}
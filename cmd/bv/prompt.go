@@ -0,0 +1,223 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// planningScope is the part of the tracker a planning prompt covers: an
+// epic's descendants or every issue with a label.
+type planningScope struct {
+	epic   *model.Issue // nil for a label
+	label  string
+	issues []model.Issue
+}
+
+// runPrompt implements `bv prompt <epic-id> | --label L [--limit N]`.
+func runPrompt(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("prompt", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	label := fs.String("label", "", "Plan the issues with this label instead of an epic")
+	limit := fs.Int("limit", 10, "Maximum issues listed per section")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv prompt <epic-id> [--limit N]")
+		fmt.Fprintln(stderr, "       bv prompt --label L [--limit N]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Prints a Markdown planning prompt for an epic or label: where it stands,")
+		fmt.Fprintln(stderr, "what is ready, what is blocked and on what, and the recommended next")
+		fmt.Fprintln(stderr, "actions. Pipe it into an LLM, e.g. bv prompt bv-42 | llm.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+
+	// Allow the epic ID before or after flags
+	var epicID string
+	if len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		epicID, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if epicID == "" && fs.NArg() > 0 {
+		epicID = fs.Arg(0)
+	}
+	if (epicID == "") == (*label == "") || fs.NArg() > 1 || *limit < 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	scope := planningScope{label: *label}
+	if epicID != "" {
+		tree, err := loader.LoadReviewTree(epicID, issues)
+		if err != nil {
+			return err
+		}
+		scope.epic = tree.Root
+		for _, issue := range tree.Descendants {
+			scope.issues = append(scope.issues, *issue)
+		}
+	} else {
+		for _, issue := range issues {
+			if slices.Contains(issue.Labels, *label) {
+				scope.issues = append(scope.issues, issue)
+			}
+		}
+		if len(scope.issues) == 0 {
+			return fmt.Errorf("no issues with label %q", *label)
+		}
+	}
+
+	// Rank against the whole graph so cross-scope blockers count
+	triage := analysis.ComputeTriageWithOptions(issues, analysis.TriageOptions{TopN: len(issues), WaitForPhase2: true})
+	writePlanningPrompt(stdout, scope, issues, triage.Recommendations, *limit)
+	return nil
+}
+
+// writePlanningPrompt prints the prompt: instructions, the scope's state,
+// its ready, blocked and in-progress issues, and the top recommendations
+// within it. all is the whole tracker, for blockers outside the scope.
+func writePlanningPrompt(out io.Writer, scope planningScope, all []model.Issue, recs []analysis.Recommendation, limit int) {
+	byID := make(map[string]model.Issue, len(all))
+	for _, issue := range all {
+		byID[issue.ID] = issue
+	}
+	inScope := make(map[string]bool, len(scope.issues))
+	for _, issue := range scope.issues {
+		inScope[issue.ID] = true
+	}
+	ref := func(id string) string {
+		issue, ok := byID[id]
+		if !ok {
+			return "`" + id + "`"
+		}
+		s := fmt.Sprintf("`%s` %s [%s]", id, issue.Title, issue.Status)
+		if !inScope[id] {
+			s += " (outside this scope)"
+		}
+		return s
+	}
+	list := func(heading string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(out, "\n## %s\n\n", heading)
+		for i, item := range items {
+			if i == limit {
+				fmt.Fprintf(out, "- …and %d more\n", len(items)-limit)
+				break
+			}
+			fmt.Fprintf(out, "- %s\n", item)
+		}
+	}
+
+	if scope.epic != nil {
+		fmt.Fprintf(out, "# Planning: Epic `%s` %s\n\n", scope.epic.ID, scope.epic.Title)
+	} else {
+		fmt.Fprintf(out, "# Planning: Label `%s`\n\n", scope.label)
+	}
+	fmt.Fprintln(out, "You are planning the next steps for this part of a beads issue tracker.")
+	fmt.Fprintln(out, "Using the state below, propose an ordered plan: which ready issues to start,")
+	fmt.Fprintln(out, "how to clear the blockers, and any issues that should be split, re-scoped or")
+	fmt.Fprintln(out, "added. Make tracker changes with `bd` commands.")
+	if scope.epic != nil && scope.epic.Description != "" {
+		fmt.Fprintf(out, "\n## Epic Description\n\n%s\n", strings.TrimSpace(scope.epic.Description))
+	}
+
+	// Sort the scope into ready, blocked and in progress. Blockers come from
+	// the whole graph, so ready means nothing open anywhere holds it up.
+	blockedBy := analysis.BlockedByMap(all)
+	var ready, blocked, inProgress []string
+	for _, issue := range analysis.ReadyIssues(scope.issues, nil) {
+		if issue.Status.Column() == model.StatusOpen && len(blockedBy[issue.ID]) == 0 {
+			ready = append(ready, readyLine(issue))
+		}
+	}
+	counts := make(map[model.Status]int)
+	closed := 0
+	for _, issue := range scope.issues {
+		counts[issue.Status.Column()]++
+		switch {
+		case issue.Status.IsClosed():
+			closed++
+		case len(blockedBy[issue.ID]) > 0 || issue.Status.Column() == model.StatusBlocked:
+			var on []string
+			for _, id := range blockedBy[issue.ID] {
+				on = append(on, ref(id))
+			}
+			line := fmt.Sprintf("`%s` %s", issue.ID, issue.Title)
+			if len(on) > 0 {
+				line += " — waiting on " + strings.Join(on, ", ")
+			} else {
+				line += " — marked blocked"
+			}
+			blocked = append(blocked, line)
+		case issue.Status.Column() == model.StatusInProgress:
+			line := fmt.Sprintf("`%s` %s", issue.ID, issue.Title)
+			if issue.Assignee != "" {
+				line += " @" + issue.Assignee
+			}
+			inProgress = append(inProgress, line)
+		}
+	}
+
+	fmt.Fprintln(out, "\n## Current State")
+	fmt.Fprintln(out)
+	total := len(scope.issues)
+	done := 0.0
+	if total > 0 {
+		done = float64(closed) / float64(total) * 100
+	}
+	fmt.Fprintf(out, "- **Issues:** %d by status: %d open, %d in progress, %d blocked, %d closed (%.0f%% done)\n",
+		total, counts[model.StatusOpen], counts[model.StatusInProgress], counts[model.StatusBlocked], closed, done)
+	fmt.Fprintf(out, "- **Ready to start:** %d\n", len(ready))
+	fmt.Fprintf(out, "- **Blocked now:** %d\n", len(blocked))
+
+	list("Ready to Start", ready)
+	list("Blocked", blocked)
+	list("In Progress", inProgress)
+
+	var next []string
+	for _, rec := range recs {
+		if !inScope[rec.ID] {
+			continue
+		}
+		line := fmt.Sprintf("`%s` %s — %s", rec.ID, rec.Title, rec.Action)
+		if len(rec.Reasons) > 0 {
+			line += " (" + strings.Join(rec.Reasons, "; ") + ")"
+		}
+		next = append(next, line)
+	}
+	if len(next) > 0 {
+		fmt.Fprintln(out, "\n## Recommended Next Actions")
+		fmt.Fprintln(out)
+		for i, line := range next {
+			if i == limit {
+				break
+			}
+			fmt.Fprintf(out, "%d. %s\n", i+1, line)
+		}
+	}
+}
+
+// readyLine describes a ready issue: priority, type, title, owner and labels
+func readyLine(issue model.Issue) string {
+	line := fmt.Sprintf("`%s` [P%d %s] %s", issue.ID, issue.Priority, issue.IssueType, issue.Title)
+	if issue.Assignee != "" {
+		line += " @" + issue.Assignee
+	}
+	if len(issue.Labels) > 0 {
+		line += " [" + strings.Join(issue.Labels, ", ") + "]"
+	}
+	return line
+}
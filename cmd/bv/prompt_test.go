@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestWritePlanningPrompt(t *testing.T) {
+	deps := func(id, parent string, blockers ...string) []*model.Dependency {
+		out := []*model.Dependency{{IssueID: id, DependsOnID: parent, Type: model.DepParentChild}}
+		for _, b := range blockers {
+			out = append(out, &model.Dependency{IssueID: id, DependsOnID: b, Type: model.DepBlocks})
+		}
+		return out
+	}
+	all := []model.Issue{
+		{ID: "bv-1", Title: "Auth", Status: model.StatusOpen, IssueType: model.TypeEpic, Description: "Single sign-on."},
+		{ID: "bv-2", Title: "Token refresh", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 1,
+			Labels: []string{"api"}, Dependencies: deps("bv-2", "bv-1")},
+		{ID: "bv-3", Title: "Login page", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: deps("bv-3", "bv-1", "bv-2", "bv-9")},
+		{ID: "bv-4", Title: "Session store", Status: model.StatusInProgress, IssueType: model.TypeTask, Assignee: "alice",
+			Dependencies: deps("bv-4", "bv-1")},
+		{ID: "bv-5", Title: "Spike", Status: model.StatusClosed, IssueType: model.TypeTask, Dependencies: deps("bv-5", "bv-1")},
+		{ID: "bv-6", Title: "Docs", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: deps("bv-6", "bv-1", "bv-9")},
+		{ID: "bv-9", Title: "Gateway", Status: model.StatusOpen, IssueType: model.TypeTask},
+	}
+	scope := planningScope{epic: &all[0], issues: all[1:6]}
+	recs := []analysis.Recommendation{
+		{ID: "bv-9", Title: "Gateway", Action: "Start work on this issue"},
+		{ID: "bv-2", Title: "Token refresh", Action: "Start work on this issue", Reasons: []string{"Unblocks 1"}},
+	}
+
+	var out bytes.Buffer
+	writePlanningPrompt(&out, scope, all, recs, 1)
+	got := out.String()
+	for _, want := range []string{
+		"# Planning: Epic `bv-1` Auth",
+		"## Epic Description\n\nSingle sign-on.",
+		"- **Issues:** 5 by status: 3 open, 1 in progress, 0 blocked, 1 closed (20% done)",
+		"- **Ready to start:** 1",
+		"- **Blocked now:** 2",
+		"## Ready to Start\n\n- `bv-2` [P1 task] Token refresh [api]\n",
+		"- `bv-3` Login page — waiting on `bv-2` Token refresh [open], `bv-9` Gateway [open] (outside this scope)\n- …and 1 more",
+		"## In Progress\n\n- `bv-4` Session store @alice",
+		"## Recommended Next Actions\n\n1. `bv-2` Token refresh — Start work on this issue (Unblocks 1)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("prompt missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Gateway — Start") {
+		t.Errorf("recommendation outside the scope leaked in:\n%s", got)
+	}
+}
+
+func TestWritePlanningPromptCustomStatuses(t *testing.T) {
+	model.RegisterCustomStatuses([]model.CustomStatus{
+		{Name: "triage", Column: model.StatusOpen},
+		{Name: "review", Column: model.StatusInProgress},
+	})
+	defer model.RegisterCustomStatuses(nil)
+
+	all := []model.Issue{
+		{ID: "bv-1", Title: "Intake", Status: "triage", IssueType: model.TypeTask},
+		{ID: "bv-2", Title: "Polish", Status: "review", IssueType: model.TypeTask},
+	}
+	var out bytes.Buffer
+	writePlanningPrompt(&out, planningScope{issues: all}, all, nil, 1)
+	got := out.String()
+	for _, want := range []string{
+		"- **Issues:** 2 by status: 1 open, 1 in progress, 0 blocked, 0 closed (0% done)",
+		"## Ready to Start\n\n- `bv-1`",
+		"## In Progress\n\n- `bv-2` Polish",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("prompt missing %q:\n%s", want, got)
+		}
+	}
+}
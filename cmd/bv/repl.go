@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/query"
+	"golang.org/x/term"
+)
+
+// runRepl implements `bv repl`.
+func runRepl(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("repl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv repl")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Loads the beads once and runs one query per line against them, printing")
+		fmt.Fprintln(stderr, "a table of matches. \\export FILE writes the last result (.csv or JSON);")
+		fmt.Fprintln(stderr, "\\help shows the query syntax, \\q quits. Reads stdin, so queries can be")
+		fmt.Fprintln(stderr, "piped in too.")
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+
+	prompt := ""
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		prompt = "bv> "
+		fmt.Fprintf(stdout, "%d issues loaded. \\help for the query syntax, \\q to quit.\n", len(issues))
+	}
+	return replSession(os.Stdin, stdout, issues, prompt)
+}
+
+// replSession reads queries and backslash commands from in until EOF or \q.
+// Query and export errors are printed and the session carries on.
+func replSession(in io.Reader, out io.Writer, issues []model.Issue, prompt string) error {
+	var last []model.Issue
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, prompt)
+		if !scanner.Scan() {
+			break
+		}
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case line == `\q` || line == `\quit`:
+			return nil
+		case line == `\help` || line == `\h` || line == `\?`:
+			printQueryHelp(out)
+		case line == `\export` || strings.HasPrefix(line, `\export `):
+			path := strings.TrimSpace(strings.TrimPrefix(line, `\export`))
+			switch {
+			case path == "":
+				fmt.Fprintln(out, `usage: \export FILE (.csv, anything else is JSON)`)
+			case last == nil:
+				fmt.Fprintln(out, "nothing to export yet: run a query first")
+			default:
+				if err := exportQueryResult(path, last); err != nil {
+					fmt.Fprintf(out, "error: %v\n", err)
+				} else {
+					fmt.Fprintf(out, "exported %s to %s\n", issueCount(len(last)), path)
+				}
+			}
+		case strings.HasPrefix(line, `\`):
+			fmt.Fprintf(out, "unknown command %s (\\help, \\export FILE, \\q)\n", strings.Fields(line)[0])
+		default:
+			q, err := query.Parse(line)
+			if err != nil {
+				fmt.Fprintf(out, "error: %v\n", err)
+				continue
+			}
+			last = q.Run(issues, time.Now())
+			if last == nil {
+				last = []model.Issue{}
+			}
+			printQueryTable(out, last)
+		}
+	}
+	if prompt != "" {
+		fmt.Fprintln(out)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading input: %w", err)
+	}
+	return nil
+}
+
+// printQueryTable prints issues as aligned columns and a count
+func printQueryTable(out io.Writer, issues []model.Issue) {
+	rows := [][]string{{"ID", "P", "STATUS", "TYPE", "ASSIGNEE", "TITLE"}}
+	for _, issue := range issues {
+		title := issue.Title
+		if r := []rune(title); len(r) > 60 {
+			title = string(r[:59]) + "…"
+		}
+		rows = append(rows, []string{issue.ID, fmt.Sprintf("P%d", issue.Priority), string(issue.Status),
+			string(issue.IssueType), issue.Assignee, title})
+	}
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], len([]rune(cell)))
+		}
+	}
+	if len(issues) > 0 {
+		for _, row := range rows {
+			var line strings.Builder
+			for i, cell := range row[:len(row)-1] {
+				line.WriteString(cell + strings.Repeat(" ", widths[i]-len([]rune(cell))+2))
+			}
+			line.WriteString(row[len(row)-1])
+			fmt.Fprintln(out, line.String())
+		}
+	}
+	fmt.Fprintf(out, "(%s)\n", issueCount(len(issues)))
+}
+
+// issueCount renders "1 issue" or "n issues"
+func issueCount(n int) string {
+	if n == 1 {
+		return "1 issue"
+	}
+	return fmt.Sprintf("%d issues", n)
+}
+
+// printQueryHelp prints the query syntax and fields
+func printQueryHelp(out io.Writer) {
+	fmt.Fprintln(out, "Queries: terms side by side must all match; 'or' and parentheses combine,")
+	fmt.Fprintln(out, "'not' or a leading '-' negates. A bare word matches IDs and titles.")
+	fmt.Fprintln(out, "  status:open label:api p<=1 -assignee:alice")
+	fmt.Fprintln(out, "  (type:bug or type:feature) blocked sort:-updated limit:10")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "Operators: : or = (equals), !=, ~ (contains), <, <=, >, >=")
+	fmt.Fprintln(out, "Fields:")
+	for _, f := range query.Fields {
+		fmt.Fprintf(out, "  %-26s %s\n", f.Name, f.Help)
+	}
+	fmt.Fprintln(out, "sort:FIELD (sort:-FIELD descending) and limit:N may go anywhere.")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, `Commands: \export FILE (.csv, anything else is JSON), \help, \q`)
+}
+
+// exportQueryResult writes issues to path as CSV or, for any other
+// extension, as a JSON array
+func exportQueryResult(path string, issues []model.Issue) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", path, err)
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		w := csv.NewWriter(f)
+		_ = w.Write([]string{"id", "title", "status", "type", "priority", "assignee", "labels", "created_at", "updated_at"})
+		for _, issue := range issues {
+			_ = w.Write([]string{issue.ID, issue.Title, string(issue.Status), string(issue.IssueType),
+				strconv.Itoa(issue.Priority), issue.Assignee, strings.Join(issue.Labels, ";"),
+				issue.CreatedAt.Format(time.RFC3339), issue.UpdatedAt.Format(time.RFC3339)})
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return fmt.Errorf("writing %s: %w", path, err)
+		}
+		return f.Close()
+	}
+
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(issues); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestReplSession(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "API", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 1, Assignee: "alice"},
+		{ID: "bv-2", Title: "UI", Status: model.StatusOpen, IssueType: model.TypeFeature, Priority: 2,
+			Dependencies: []*model.Dependency{{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+		{ID: "bv-3", Title: "Docs", Status: model.StatusClosed, IssueType: model.TypeChore, Priority: 3},
+	}
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "ready.csv")
+	jsonPath := filepath.Join(dir, "blocked.json")
+
+	input := strings.Join([]string{
+		`\export ` + csvPath,
+		"ready",
+		`\export ` + csvPath,
+		"status:open or (",
+		"blocked",
+		`\export ` + jsonPath,
+		`\frobnicate`,
+		`\q`,
+		"status:closed", // Never reached
+	}, "\n")
+	var out bytes.Buffer
+	if err := replSession(strings.NewReader(input), &out, issues, ""); err != nil {
+		t.Fatalf("replSession: %v", err)
+	}
+
+	want := strings.Join([]string{
+		"nothing to export yet: run a query first",
+		"ID    P   STATUS  TYPE  ASSIGNEE  TITLE",
+		"bv-1  P1  open    task  alice     API",
+		"(1 issue)",
+		"exported 1 issue to " + csvPath,
+		"error: expected a term at the end",
+		"ID    P   STATUS  TYPE     ASSIGNEE  TITLE",
+		"bv-2  P2  open    feature            UI",
+		"(1 issue)",
+		"exported 1 issue to " + jsonPath,
+		`unknown command \frobnicate (\help, \export FILE, \q)`,
+		"",
+	}, "\n")
+	if out.String() != want {
+		t.Errorf("session output:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	f, err := os.Open(csvPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil || len(records) != 2 || records[1][0] != "bv-1" || records[1][5] != "alice" {
+		t.Errorf("csv export = %v (err %v)", records, err)
+	}
+
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exported []model.Issue
+	if err := json.Unmarshal(data, &exported); err != nil || len(exported) != 1 || exported[0].ID != "bv-2" {
+		t.Errorf("json export = %s (err %v)", data, err)
+	}
+}
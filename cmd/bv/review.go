@@ -0,0 +1,312 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/review"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// runReview implements `bv review <id> | --label L | --resume`,
+// `bv review export` and `bv review coverage`.
+func runReview(args []string, stdout, stderr io.Writer) error {
+	if len(args) > 0 && args[0] == "export" {
+		return runReviewExport(args[1:], stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == "coverage" {
+		return runReviewCoverage(args[1:], stdout, stderr)
+	}
+
+	fs := flag.NewFlagSet("review", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	label := fs.String("label", "", "Review every issue with this label, grouped under their epics")
+	reviewType := fs.String("type", model.ReviewTypePlan, "Review type: plan, implementation or security")
+	reviewer := fs.String("reviewer", "", "Name recorded on the reviews")
+	resume := fs.Bool("resume", false, "Resume the last unfinished review session")
+	dryRun := fs.Bool("dry-run", false, "Print the bd comments saving would write instead of writing them")
+	ascii := fs.Bool("ascii", false, "Draw icons, trees and borders with ASCII characters")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv review <issue-id> [--type T] [--reviewer NAME]")
+		fmt.Fprintln(stderr, "       bv review --label L [--type T] [--reviewer NAME]")
+		fmt.Fprintln(stderr, "       bv review --resume")
+		fmt.Fprintln(stderr, "       bv review export <issue-id>... | --label L [--json]")
+		fmt.Fprintln(stderr, "       bv review coverage [<epic-id>...] [--all] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Opens the review dashboard for an issue and its descendants, or for a")
+		fmt.Fprintln(stderr, "label. Progress is kept in .beads/"+ui.ReviewSessionFile+" as you go, so")
+		fmt.Fprintln(stderr, "--resume restores the cursor, filters and unsaved reviews after a crash")
+		fmt.Fprintln(stderr, "or an accidental Q.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+
+	// Allow the issue ID before or after flags
+	var rootID string
+	if len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		rootID, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if rootID == "" && fs.NArg() > 0 {
+		rootID = fs.Arg(0)
+	}
+	targets := 0
+	for _, set := range []bool{rootID != "", *label != "", *resume} {
+		if set {
+			targets++
+		}
+	}
+	if targets != 1 || fs.NArg() > 1 || !model.IsValidReviewType(*reviewType) {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	beadsDir, err := loader.GetBeadsDir("")
+	if err != nil {
+		return err
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+	sessionPath := ui.ReviewSessionPath(beadsDir)
+	theme := ui.DefaultTheme(lipgloss.DefaultRenderer())
+	theme.ASCII = *ascii || loadProjectConfig().ASCII
+
+	var dashboard *ui.ReviewDashboardModel
+	switch {
+	case *resume:
+		session, err := ui.LoadReviewSession(sessionPath)
+		if err != nil {
+			return err
+		}
+		dashboard, err = ui.NewReviewDashboardFromSession(session, issues, theme, cwd)
+		if err != nil {
+			return fmt.Errorf("resuming review: %w", err)
+		}
+	case *label != "":
+		dashboard, err = ui.NewLabelReviewDashboardModel(*label, issues, *reviewer, *reviewType, theme, cwd)
+	default:
+		dashboard, err = ui.NewReviewDashboardModel(rootID, issues, *reviewer, *reviewType, theme, cwd)
+	}
+	if err != nil {
+		return err
+	}
+	dashboard.SetNoteTemplates(loadProjectConfig().ReviewTemplates)
+	dashboard.SetSessionPath(sessionPath)
+	var dry *loader.DryRun
+	if *dryRun {
+		dry = loader.NewDryRun()
+		dashboard.SetDryRun(dry)
+	}
+
+	program := ui.NewReviewProgram(dashboard)
+	program.SetReadOnly(!loader.BdAvailable() && !*dryRun)
+	if _, err := tea.NewProgram(program, tea.WithAltScreen(), tea.WithMouseCellMotion()).Run(); err != nil {
+		return fmt.Errorf("running review: %w", err)
+	}
+	if msg, isErr := program.Finish(); msg != "" {
+		if isErr {
+			fmt.Fprintln(stderr, msg)
+		} else {
+			fmt.Fprintln(stdout, msg)
+		}
+	}
+	if dry != nil {
+		for _, c := range dry.Changes() {
+			fmt.Fprintln(stdout, "  "+c.Summary)
+		}
+	}
+	return nil
+}
+
+// reviewExportOutput is the --json shape of `bv review export`
+type reviewExportOutput struct {
+	GeneratedAt time.Time           `json:"generated_at"`
+	ReviewType  string              `json:"review_type,omitempty"` // Empty: reviews of every type count
+	Trees       []review.TreeExport `json:"trees"`
+}
+
+// runReviewExport implements `bv review export <id>... | --label L [--type T] [--json]`.
+func runReviewExport(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("review export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	label := fs.String("label", "", "Export the label's review tree instead of issue trees")
+	reviewType := fs.String("type", "", "Only count reviews of this type: plan, implementation or security")
+	asJSON := fs.Bool("json", false, "Emit JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv review export <issue-id>... [--type T] [--json]")
+		fmt.Fprintln(stderr, "       bv review export --label L [--type T] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Prints each review tree with every node's latest review status, reviewer")
+		fmt.Fprintln(stderr, "and timestamps, read from the review comments saved through bd. Pass")
+		fmt.Fprintln(stderr, "several epics to compare their plan-review coverage.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+
+	// Allow issue IDs before or after flags
+	var rootIDs []string
+	for len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		rootIDs, args = append(rootIDs, args[0]), args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	rootIDs = append(rootIDs, fs.Args()...)
+	if (len(rootIDs) == 0) == (*label == "") || (*reviewType != "" && !model.IsValidReviewType(*reviewType)) {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	out := reviewExportOutput{GeneratedAt: time.Now().UTC(), ReviewType: *reviewType, Trees: []review.TreeExport{}}
+	if *label != "" {
+		tree, err := loader.LoadLabelReviewTree(*label, issues)
+		if err != nil {
+			return err
+		}
+		out.Trees = append(out.Trees, review.ExportTree(tree, *reviewType))
+	}
+	for _, id := range rootIDs {
+		tree, err := loader.LoadReviewTree(id, issues)
+		if err != nil {
+			return err
+		}
+		out.Trees = append(out.Trees, review.ExportTree(tree, *reviewType))
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(out); err != nil {
+			return fmt.Errorf("encoding review export: %w", err)
+		}
+		return nil
+	}
+	writeReviewExport(stdout, out.Trees)
+	return nil
+}
+
+// writeReviewExport prints each tree's coverage line, then its nodes indented
+// under their parents
+func writeReviewExport(out io.Writer, trees []review.TreeExport) {
+	for i, tree := range trees {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+		s := tree.Summary
+		fmt.Fprintf(out, "%s %s: %d/%d reviewed (%.0f%%), %d approved, %d needs revision, %d deferred\n",
+			tree.Root, tree.Title, s.Reviewed, s.Total, s.Coverage*100, s.Approved, s.NeedsRevision, s.Deferred)
+		for _, node := range tree.Nodes {
+			line := fmt.Sprintf("%s%s %s  %s", strings.Repeat("  ", node.Depth+1), node.ID, truncateTitle(node.Title, 50), node.ReviewStatus)
+			if node.ReviewedAt != nil {
+				line += fmt.Sprintf(" by %s on %s", node.Reviewer, node.ReviewedAt.Format("2006-01-02"))
+			}
+			fmt.Fprintln(out, line)
+		}
+	}
+}
+
+// reviewCoverageOutput is the JSON shape of `bv review coverage --json`
+type reviewCoverageOutput struct {
+	GeneratedAt time.Time             `json:"generated_at"`
+	Epics       []review.EpicCoverage `json:"epics"`
+}
+
+// runReviewCoverage implements `bv review coverage [<epic-id>...] [--all] [--json]`.
+func runReviewCoverage(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("review coverage", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	all := fs.Bool("all", false, "Include closed epics")
+	asJSON := fs.Bool("json", false, "Emit JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv review coverage [<epic-id>...] [--all] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "For each epic, counts the descendants approved, sent back for revision")
+		fmt.Fprintln(stderr, "and not yet reviewed, per review type. Without IDs every open epic is")
+		fmt.Fprintln(stderr, "listed, least plan-reviewed first.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+
+	// Allow epic IDs before or after flags
+	var epicIDs []string
+	for len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		epicIDs, args = append(epicIDs, args[0]), args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	epicIDs = append(epicIDs, fs.Args()...)
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	out := reviewCoverageOutput{GeneratedAt: time.Now().UTC(), Epics: []review.EpicCoverage{}}
+	if len(epicIDs) == 0 {
+		out.Epics = append(out.Epics, review.ComputeAllEpicCoverage(issues, *all)...)
+	}
+	for _, id := range epicIDs {
+		c, err := review.ComputeEpicCoverage(id, issues)
+		if err != nil {
+			return err
+		}
+		out.Epics = append(out.Epics, c)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(out); err != nil {
+			return fmt.Errorf("encoding review coverage: %w", err)
+		}
+		return nil
+	}
+	writeReviewCoverage(stdout, out.Epics)
+	return nil
+}
+
+// writeReviewCoverage prints one row per epic with approved/needs
+// revision/unreviewed counts and the reviewed share for each review type
+func writeReviewCoverage(out io.Writer, epics []review.EpicCoverage) {
+	if len(epics) == 0 {
+		fmt.Fprintln(out, "No epics with child issues.")
+		return
+	}
+	fmt.Fprintf(out, "%-12s %5s", "EPIC", "KIDS")
+	for _, reviewType := range review.CoverageTypes {
+		fmt.Fprintf(out, "  %-16s", strings.ToUpper(reviewType))
+	}
+	fmt.Fprintln(out, "  TITLE")
+	for _, epic := range epics {
+		fmt.Fprintf(out, "%-12s %5d", epic.EpicID, epic.Descendants)
+		for _, reviewType := range review.CoverageTypes {
+			c := epic.ByType[reviewType]
+			cell := fmt.Sprintf("%d/%d/%d %3.0f%%", c.Approved, c.NeedsRevision, c.Unreviewed, c.Coverage*100)
+			fmt.Fprintf(out, "  %-16s", cell)
+		}
+		fmt.Fprintf(out, "  %s\n", truncateTitle(epic.Title, 40))
+	}
+	fmt.Fprintln(out, "\nCounts are approved/needs revision/unreviewed; % is the share reviewed.")
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+)
+
+// runStats implements `bv stats [--top N] [--json]`.
+func runStats(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	top := fs.Int("top", 5, "Entries per ranking and number of chains listed")
+	asJSON := fs.Bool("json", false, "Emit JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv stats [--top N] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Dependency graph health: size, density, orphans, cycles, the longest")
+		fmt.Fprintln(stderr, "blocker chains, and the top issues by PageRank, betweenness and degree.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 || *top < 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	analyzer := analysis.NewCachedAnalyzer(issues, nil)
+	analyzer.SetDiskCache(analysis.NewDiskCache(analysis.DefaultDiskCacheDir()))
+	stats := analyzer.AnalyzeAsync(context.Background())
+	stats.WaitForPhase2()
+	summary := analysis.SummarizeGraph(issues, stats, *top)
+
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(summary); err != nil {
+			return fmt.Errorf("encoding graph stats: %w", err)
+		}
+		return nil
+	}
+	writeStats(stdout, summary)
+	return nil
+}
+
+// writeStats prints the summary as headline numbers followed by one table
+// per ranking and the longest chains
+func writeStats(out io.Writer, s analysis.GraphSummary) {
+	fmt.Fprintf(out, "Issues %d · Edges %d · Density %.4f · Orphans %d · Cycles %d · Longest chain %d\n",
+		s.Issues, s.Edges, s.Density, s.Orphans, s.Cycles, s.LongestChain)
+
+	rankings := []struct {
+		name   string
+		format string
+		items  []analysis.GraphRank
+	}{
+		{"PAGERANK", "%.4f", s.PageRank},
+		{"BETWEENNESS", "%.4f", s.Betweenness},
+		{"IN-DEGREE (depended on)", "%.0f", s.InDegree},
+		{"OUT-DEGREE (dependencies)", "%.0f", s.OutDegree},
+	}
+	for _, r := range rankings {
+		if len(r.items) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "\n%s\n", r.name)
+		idWidth := 0
+		for _, item := range r.items {
+			idWidth = max(idWidth, len(item.ID))
+		}
+		for _, item := range r.items {
+			line := fmt.Sprintf("  %-*s  %8s  %s", idWidth, item.ID, fmt.Sprintf(r.format, item.Value), truncateTitle(item.Title, 60))
+			fmt.Fprintln(out, strings.TrimRight(line, " "))
+		}
+	}
+
+	if len(s.Chains) > 0 {
+		fmt.Fprintln(out, "\nLONGEST CHAINS (first blocker → waiting issue)")
+		for _, chain := range s.Chains {
+			fmt.Fprintf(out, "  %3d  %s\n", len(chain), strings.Join(chain, " → "))
+		}
+	}
+}
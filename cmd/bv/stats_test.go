@@ -0,0 +1,34 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+func TestWriteStats(t *testing.T) {
+	summary := analysis.GraphSummary{
+		Issues: 4, Edges: 3, Density: 0.25, Orphans: 1, LongestChain: 3,
+		PageRank: []analysis.GraphRank{{ID: "bv-1", Title: "Schema", Value: 0.41}},
+		InDegree: []analysis.GraphRank{{ID: "bv-1", Title: "Schema", Value: 2}, {ID: "bv-10", Title: "API", Value: 1}},
+		Chains:   [][]string{{"bv-1", "bv-10", "bv-3"}},
+	}
+
+	var out bytes.Buffer
+	writeStats(&out, summary)
+	want := "Issues 4 · Edges 3 · Density 0.2500 · Orphans 1 · Cycles 0 · Longest chain 3\n" +
+		"\n" +
+		"PAGERANK\n" +
+		"  bv-1    0.4100  Schema\n" +
+		"\n" +
+		"IN-DEGREE (depended on)\n" +
+		"  bv-1          2  Schema\n" +
+		"  bv-10         1  API\n" +
+		"\n" +
+		"LONGEST CHAINS (first blocker → waiting issue)\n" +
+		"    3  bv-1 → bv-10 → bv-3\n"
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
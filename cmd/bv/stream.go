@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// streamLoadPath returns the JSONL file to stream into the TUI, if any.
+// Only plain interactive runs stream: every other flag either leaves the
+// TUI or shapes the issue set before it opens, and needs all of it. Files
+// of at least loader.StreamThreshold stream unless --stream is the reason.
+func streamLoadPath(forced, robotMode bool) (string, bool) {
+	if robotMode || !onlyFlagsSet("stream") {
+		return "", false
+	}
+	beadsDir, err := loader.GetBeadsDir("")
+	if err != nil {
+		return "", false
+	}
+	path, err := loader.FindJSONLPath(beadsDir)
+	if err != nil {
+		return "", false
+	}
+	if !forced {
+		info, err := os.Stat(path)
+		if err != nil || info.Size() < loader.StreamThreshold {
+			return "", false
+		}
+	}
+	return path, true
+}
+
+// onlyFlagsSet reports whether no flag other than the named ones was given
+func onlyFlagsSet(names ...string) bool {
+	allowed := make(map[string]bool, len(names))
+	for _, name := range names {
+		allowed[name] = true
+	}
+	only := true
+	flag.Visit(func(f *flag.Flag) {
+		if !allowed[f.Name] {
+			only = false
+		}
+	})
+	return only
+}
+
+// startIssueStream starts streaming path and waits for the first batch,
+// which is enough to draw the TUI. batches is nil when that batch was the
+// whole file.
+func startIssueStream(path string) (issues []model.Issue, batches <-chan loader.StreamBatch, first loader.StreamBatch, err error) {
+	// Warnings are counted into the "Loaded" status instead of printed
+	// under the TUI
+	ch, err := loader.StreamIssuesFromFile(context.Background(), path, loader.StreamOptions{
+		ParseOptions: loader.ParseOptions{WarningHandler: func(string) {}},
+	})
+	if err != nil {
+		return nil, nil, first, err
+	}
+	first, ok := <-ch
+	switch {
+	case !ok:
+		return nil, nil, first, errors.New("issue stream closed before the first batch")
+	case first.Err != nil:
+		return nil, nil, first, first.Err
+	case first.Done:
+		return first.Issues, nil, first, nil
+	}
+	return first.Issues, ch, first, nil
+}
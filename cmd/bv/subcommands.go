@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/updater"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/version"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// subcommand is a positional command (bv <name> ...) with its own flag set.
+type subcommand struct {
+	summary string
+	run     func(args []string, stdout, stderr io.Writer) error
+}
+
+// subcommands maps positional command names to their handlers.
+var subcommands = map[string]subcommand{
+	"affected": {summary: "List what is downstream of changed issues", run: runAffected},
+	"claim":    {summary: "Claim an issue, epic workstream or label for an agent or person", run: runClaim},
+	"claims":   {summary: "List claims and the issues more than one owner claimed", run: runClaims},
+	"doctor":   {summary: "Check for dangling dependencies, stale blocks, empty epics and orphans", run: runDoctor},
+	"fairness": {summary: "Show how long ready issues wait per label, flagging starved ones", run: runFairness},
+	"next":     {summary: "Suggest what to work on next, with the reason for each pick", run: runNext},
+	"path":     {summary: "Show the dependency paths between two issues", run: runPath},
+	"prompt":   {summary: "Print an agent-ready planning prompt for an epic or label", run: runPrompt},
+	"ready":    {summary: "List actionable issues without opening the TUI", run: runReady},
+	"repl":     {summary: "Run successive queries against the loaded beads", run: runRepl},
+	"retro":    {summary: "Planned-vs-actual retrospective for an epic", run: runRetro},
+	"review":   {summary: "Review an issue tree or label, resume the last review, export trees, or report per-epic coverage", run: runReview},
+	"stats":    {summary: "Print dependency graph health metrics (--json for CI)", run: runStats},
+	"tree":     {summary: "Print an issue's blockers and descendants as a tree", run: runTree},
+	"version":  {summary: "Print the version, optionally checking for a newer release", run: runVersion},
+	"watch":    {summary: "Stream tracker changes as events (--format=json for agents)", run: runWatch},
+}
+
+// errUsage signals that the subcommand already printed its usage.
+var errUsage = errors.New("usage")
+
+// runSubcommand dispatches os.Args to a subcommand. It returns false when
+// args[0] is not a subcommand, so flag parsing proceeds as usual.
+func runSubcommand(args []string) (handled bool, exitCode int) {
+	if len(args) == 0 {
+		return false, 0
+	}
+	cmd, ok := subcommands[args[0]]
+	if !ok {
+		return false, 0
+	}
+	if err := cmd.run(args[1:], os.Stdout, os.Stderr); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return true, 0
+		}
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintf(os.Stderr, "bv %s: %v\n", args[0], err)
+		}
+		return true, 1
+	}
+	return true, 0
+}
+
+// checkLatestRelease is swapped out in tests to avoid network access.
+var checkLatestRelease = updater.CheckUpdateAvailable
+
+// versionOutput is the --json shape of `bv version`.
+type versionOutput struct {
+	version.BuildInfo
+	Latest          string `json:"latest,omitempty"`
+	UpdateAvailable bool   `json:"update_available"`
+	ReleaseURL      string `json:"release_url,omitempty"`
+}
+
+// runVersion implements `bv version [--check] [--json]`.
+func runVersion(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("version", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	check := fs.Bool("check", false, "Compare against the latest GitHub release")
+	asJSON := fs.Bool("json", false, "Emit JSON instead of text")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv version [--check] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Prints the bv version. With --check, compares it against the latest")
+		fmt.Fprintln(stderr, "release and prints upgrade instructions when a newer one exists.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	out := versionOutput{BuildInfo: version.Info()}
+	if *check {
+		available, latest, url, err := checkLatestRelease()
+		if err != nil {
+			return fmt.Errorf("checking for updates: %w", err)
+		}
+		out.UpdateAvailable = available
+		out.Latest = latest
+		out.ReleaseURL = url
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+
+	fmt.Fprintf(stdout, "bv %s\n", out.BuildInfo)
+	if !*check {
+		return nil
+	}
+	if !out.UpdateAvailable {
+		fmt.Fprintln(stdout, "Up to date.")
+		return nil
+	}
+	fmt.Fprintf(stdout, "New version available: %s\n", out.Latest)
+	if out.ReleaseURL != "" {
+		fmt.Fprintf(stdout, "Release notes: %s\n", out.ReleaseURL)
+	}
+	fmt.Fprintln(stdout)
+	fmt.Fprint(stdout, updater.UpgradeInstructions(out.Latest))
+	return nil
+}
+
+// runRetro implements `bv retro <epic-id> [--md FILE]`.
+func runRetro(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("retro", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	mdFile := fs.String("md", "", "Write the retro as Markdown to FILE instead of opening the TUI")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv retro <epic-id> [--md FILE]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Planned-vs-actual report for an epic: first-to-last closure span,")
+		fmt.Fprintln(stderr, "issues added mid-flight, longest-blocked items, and cycle times.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+
+	// Allow the epic ID before or after flags
+	var epicID string
+	if len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		epicID, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if epicID == "" && fs.NArg() > 0 {
+		epicID = fs.Arg(0)
+	}
+	if epicID == "" {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	retro, err := analysis.ComputeEpicRetro(epicID, issues, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if *mdFile != "" {
+		if err := export.SaveRetroMarkdown(retro, *mdFile); err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "Wrote retro for %s to %s\n", epicID, *mdFile)
+		return nil
+	}
+
+	m := ui.NewRetroModel(retro, ui.DefaultTheme(lipgloss.DefaultRenderer()))
+	if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+		return fmt.Errorf("running retro view: %w", err)
+	}
+	return nil
+}
+
+// readyIssueOutput is one entry of `bv ready --json`.
+type readyIssueOutput struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Status    string   `json:"status"`
+	IssueType string   `json:"issue_type"`
+	Priority  int      `json:"priority"`
+	Assignee  string   `json:"assignee,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	PageRank  float64  `json:"pagerank"`
+}
+
+// runReady implements `bv ready [--label L] [--assignee A] [--json]`.
+func runReady(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("ready", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	label := fs.String("label", "", "Only issues with this label")
+	assignee := fs.String("assignee", "", "Only issues assigned to this user")
+	asJSON := fs.Bool("json", false, "Emit JSON instead of a plain list")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv ready [--label L] [--assignee A] [--json]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Issues that are not closed and have no open blockers, sorted by")
+		fmt.Fprintln(stderr, "priority then PageRank.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+
+	// Blocking is judged against the whole graph; filters only narrow the output
+	analyzer := analysis.NewCachedAnalyzer(issues, nil)
+	analyzer.SetDiskCache(analysis.NewDiskCache(analysis.DefaultDiskCacheDir()))
+	stats := analyzer.AnalyzeAsync(context.Background())
+	stats.WaitForPhase2()
+	pageRank := stats.PageRank()
+
+	var ready []readyIssueOutput
+	for _, issue := range analysis.ReadyIssues(issues, pageRank) {
+		if *label != "" && !slices.Contains(issue.Labels, *label) {
+			continue
+		}
+		if *assignee != "" && issue.Assignee != *assignee {
+			continue
+		}
+		ready = append(ready, readyIssueOutput{
+			ID:        issue.ID,
+			Title:     issue.Title,
+			Status:    string(issue.Status),
+			IssueType: string(issue.IssueType),
+			Priority:  issue.Priority,
+			Assignee:  issue.Assignee,
+			Labels:    issue.Labels,
+			PageRank:  pageRank[issue.ID],
+		})
+	}
+
+	if *asJSON {
+		if ready == nil {
+			ready = []readyIssueOutput{}
+		}
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(ready); err != nil {
+			return fmt.Errorf("encoding ready issues: %w", err)
+		}
+		return nil
+	}
+
+	idWidth := 0
+	for _, r := range ready {
+		idWidth = max(idWidth, len(r.ID))
+	}
+	for _, r := range ready {
+		line := fmt.Sprintf("%-*s  P%d  %-7s  %s", idWidth, r.ID, r.Priority, r.IssueType, r.Title)
+		if r.Assignee != "" {
+			line += "  @" + r.Assignee
+		}
+		if len(r.Labels) > 0 {
+			line += "  [" + strings.Join(r.Labels, ", ") + "]"
+		}
+		fmt.Fprintln(stdout, line)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/version"
+)
+
+func stubLatestRelease(t *testing.T, available bool, latest string, err error) {
+	t.Helper()
+	orig := checkLatestRelease
+	checkLatestRelease = func() (bool, string, string, error) {
+		return available, latest, "https://example.invalid/release", err
+	}
+	t.Cleanup(func() { checkLatestRelease = orig })
+}
+
+func TestRunVersion(t *testing.T) {
+	t.Run("plain", func(t *testing.T) {
+		stubLatestRelease(t, false, "", errors.New("must not be called"))
+		var out, errOut bytes.Buffer
+		if err := runVersion(nil, &out, &errOut); err != nil {
+			t.Fatalf("runVersion: %v", err)
+		}
+		if got := out.String(); !strings.HasPrefix(got, "bv "+version.Version+" (") {
+			t.Errorf("output = %q", got)
+		}
+	})
+
+	t.Run("check newer", func(t *testing.T) {
+		stubLatestRelease(t, true, "v99.0.0", nil)
+		var out, errOut bytes.Buffer
+		if err := runVersion([]string{"--check"}, &out, &errOut); err != nil {
+			t.Fatalf("runVersion: %v", err)
+		}
+		for _, want := range []string{"New version available: v99.0.0", "bv --update", "go install"} {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("output missing %q:\n%s", want, out.String())
+			}
+		}
+	})
+
+	t.Run("check json up to date", func(t *testing.T) {
+		stubLatestRelease(t, false, "", nil)
+		var out, errOut bytes.Buffer
+		if err := runVersion([]string{"--check", "--json"}, &out, &errOut); err != nil {
+			t.Fatalf("runVersion: %v", err)
+		}
+		var got versionOutput
+		if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+			t.Fatalf("invalid JSON %q: %v", out.String(), err)
+		}
+		if got.Version != version.Version || got.UpdateAvailable {
+			t.Errorf("unexpected output %+v", got)
+		}
+	})
+
+	t.Run("check error", func(t *testing.T) {
+		stubLatestRelease(t, false, "", errors.New("offline"))
+		var out, errOut bytes.Buffer
+		err := runVersion([]string{"--check"}, &out, &errOut)
+		if err == nil || !strings.Contains(err.Error(), "offline") {
+			t.Errorf("expected wrapped network error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// runTree implements `bv tree <issue-id> [--depth N|all] [--no-color] [--ascii]`.
+func runTree(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("tree", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	depthFlag := fs.String("depth", "2", "Levels below the issue to show: 1, 2, 3 or all")
+	noColor := fs.Bool("no-color", false, "Print without colors (also when stdout is not a terminal)")
+	ascii := fs.Bool("ascii", false, "Draw icons and tree connectors with ASCII characters")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv tree <issue-id> [--depth N|all] [--no-color] [--ascii]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Prints the tree the epic or bead dashboard shows for an issue: what")
+		fmt.Fprintln(stderr, "blocks it, then its children and the issues it blocks, for pasting")
+		fmt.Fprintln(stderr, "into PRs and chat.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+
+	// Allow the issue ID before or after flags
+	var issueID string
+	if len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		issueID, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if issueID == "" && fs.NArg() > 0 {
+		issueID = fs.Arg(0)
+	}
+	if issueID == "" || fs.NArg() > 1 {
+		fs.Usage()
+		return errUsage
+	}
+	depth, err := parseTreeDepth(*depthFlag)
+	if err != nil {
+		return err
+	}
+
+	issues, err := loader.LoadIssues("")
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+
+	// A renderer on stdout drops colors by itself when it isn't a terminal
+	renderer := lipgloss.NewRenderer(stdout)
+	if *noColor {
+		renderer = lipgloss.NewRenderer(io.Discard)
+	}
+	theme := ui.DefaultTheme(renderer)
+	theme.ASCII = *ascii || loadProjectConfig().ASCII
+	tree, err := ui.RenderIssueTree(issueID, issues, depth, theme)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(stdout, tree)
+	return err
+}
+
+// parseTreeDepth reads --depth: 1, 2, 3 or all
+func parseTreeDepth(s string) (ui.DepthOption, error) {
+	if s == "all" {
+		return ui.DepthAll, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > 3 {
+		return 0, fmt.Errorf("--depth must be 1, 2, 3 or all, not %q", s)
+	}
+	return ui.DepthOption(n), nil
+}
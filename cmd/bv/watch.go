@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/watcher"
+)
+
+// runWatch implements `bv watch [--format text|json] [--poll]`.
+func runWatch(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("watch", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "text", "Output format: text or json (one event per line)")
+	poll := fs.Bool("poll", false, "Poll the file instead of using filesystem notifications")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv watch [--format text|json] [--poll]")
+		fmt.Fprintln(stderr, "")
+		fmt.Fprintln(stderr, "Tails the beads data and prints an event for every change: issues")
+		fmt.Fprintln(stderr, "created, deleted, closed or moved to another status, blockers resolved,")
+		fmt.Fprintln(stderr, "and changes to the ready set. Runs until interrupted.")
+		fmt.Fprintln(stderr, "")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 || (*format != "text" && *format != "json") {
+		fs.Usage()
+		return errUsage
+	}
+
+	beadsDir, err := loader.GetBeadsDir("")
+	if err != nil {
+		return fmt.Errorf("finding beads directory: %w", err)
+	}
+	path, err := loader.FindJSONLPath(beadsDir)
+	if err != nil {
+		return fmt.Errorf("finding beads data: %w", err)
+	}
+
+	w, err := watcher.NewWatcher(path,
+		watcher.WithDebounceDuration(200*time.Millisecond),
+		watcher.WithForcePoll(*poll),
+	)
+	if err != nil {
+		return fmt.Errorf("watching %s: %w", path, err)
+	}
+	if err := w.Start(); err != nil {
+		return fmt.Errorf("watching %s: %w", path, err)
+	}
+	defer w.Stop()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	emit := textEventEmitter(stdout)
+	if *format == "json" {
+		enc := json.NewEncoder(stdout)
+		emit = func(ev analysis.TrackerEvent) error { return enc.Encode(ev) }
+	}
+	return watchIssues(ctx, path, w.Changed(), emit, stderr)
+}
+
+// watchIssues loads path, emits a watching event, then diffs every reload
+// signalled on changed against the previous one and emits the events, until
+// ctx ends. A load that fails (e.g. the file is briefly missing while bd
+// replaces it) is reported on stderr and the next change is diffed against
+// the last good load.
+func watchIssues(ctx context.Context, path string, changed <-chan struct{}, emit func(analysis.TrackerEvent) error, stderr io.Writer) error {
+	load := func() ([]model.Issue, error) {
+		return loader.LoadIssuesFromFileWithOptions(path, loader.ParseOptions{
+			WarningHandler: func(msg string) { fmt.Fprintln(stderr, "bv watch:", msg) },
+		})
+	}
+
+	issues, err := load()
+	if err != nil {
+		return fmt.Errorf("loading beads: %w", err)
+	}
+	checksum, _ := loader.FileChecksum(path)
+	if err := emit(analysis.TrackerEvent{Type: analysis.EventWatching, Time: time.Now(), Path: path, Count: len(issues)}); err != nil {
+		return fmt.Errorf("writing event: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		}
+
+		// bd rewrites its export on every sync, even when nothing moved
+		if sum, err := loader.FileChecksum(path); err == nil && sum == checksum {
+			continue
+		} else if err == nil {
+			checksum = sum
+		}
+
+		next, err := load()
+		if err != nil {
+			fmt.Fprintf(stderr, "bv watch: %v\n", err)
+			continue
+		}
+		for _, ev := range analysis.DiffTrackerEvents(issues, next, time.Now()) {
+			if err := emit(ev); err != nil {
+				return fmt.Errorf("writing event: %w", err)
+			}
+		}
+		issues = next
+	}
+}
+
+// textEventEmitter writes events as one readable line each
+func textEventEmitter(out io.Writer) func(analysis.TrackerEvent) error {
+	return func(ev analysis.TrackerEvent) error {
+		var line string
+		switch ev.Type {
+		case analysis.EventWatching:
+			line = fmt.Sprintf("watching %s (%d issues)", ev.Path, ev.Count)
+		case analysis.EventIssueCreated:
+			line = fmt.Sprintf("created  %s %s [%s]", ev.ID, ev.Title, ev.To)
+		case analysis.EventIssueDeleted:
+			line = fmt.Sprintf("deleted  %s %s", ev.ID, ev.Title)
+		case analysis.EventIssueClosed:
+			line = fmt.Sprintf("closed   %s %s", ev.ID, ev.Title)
+		case analysis.EventStatusChanged:
+			line = fmt.Sprintf("status   %s %s: %s → %s", ev.ID, ev.Title, ev.From, ev.To)
+		case analysis.EventBlockerResolved:
+			line = fmt.Sprintf("unblock  %s %s (%s no longer blocks it)", ev.ID, ev.Title, ev.BlockerID)
+		case analysis.EventReadyChanged:
+			var parts []string
+			if len(ev.Added) > 0 {
+				parts = append(parts, "+"+strings.Join(ev.Added, " +"))
+			}
+			if len(ev.Removed) > 0 {
+				parts = append(parts, "-"+strings.Join(ev.Removed, " -"))
+			}
+			line = "ready    " + strings.Join(parts, " ")
+		default:
+			line = string(ev.Type) + " " + ev.ID
+		}
+		_, err := fmt.Fprintf(out, "%s %s\n", ev.Time.Format("15:04:05"), line)
+		return err
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+func TestWatchIssuesEmitsChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	write := func(lines ...string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(
+		`{"id":"bv-1","title":"API","status":"open"}`,
+		`{"id":"bv-2","title":"UI","status":"open","dependencies":[{"issue_id":"bv-2","depends_on_id":"bv-1","type":"blocks"}]}`,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changed := make(chan struct{})
+	events := make(chan analysis.TrackerEvent, 16)
+	done := make(chan error, 1)
+	var stderr bytes.Buffer
+	go func() {
+		done <- watchIssues(ctx, path, changed, func(ev analysis.TrackerEvent) error {
+			events <- ev
+			return nil
+		}, &stderr)
+	}()
+
+	next := func() analysis.TrackerEvent {
+		t.Helper()
+		select {
+		case ev := <-events:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an event")
+			return analysis.TrackerEvent{}
+		}
+	}
+
+	if ev := next(); ev.Type != analysis.EventWatching || ev.Count != 2 {
+		t.Fatalf("first event = %+v, want watching 2 issues", ev)
+	}
+
+	// A rewrite with the same content emits nothing; a missing file is
+	// reported and skipped; the close is then diffed against the last good load
+	changed <- struct{}{}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	changed <- struct{}{}
+	write(
+		`{"id":"bv-1","title":"API","status":"closed"}`,
+		`{"id":"bv-2","title":"UI","status":"open","dependencies":[{"issue_id":"bv-2","depends_on_id":"bv-1","type":"blocks"}]}`,
+	)
+	changed <- struct{}{}
+
+	var got []string
+	for _, want := range []analysis.TrackerEventType{analysis.EventIssueClosed, analysis.EventBlockerResolved, analysis.EventReadyChanged} {
+		ev := next()
+		data, _ := json.Marshal(ev)
+		got = append(got, string(data))
+		if ev.Type != want {
+			t.Fatalf("event %d = %s, want %s (so far %v)", len(got), ev.Type, want, got)
+		}
+	}
+	if !strings.Contains(got[2], `"added":["bv-2"]`) || !strings.Contains(got[2], `"removed":["bv-1"]`) {
+		t.Errorf("ready_changed = %s", got[2])
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("watchIssues: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "bv watch:") {
+		t.Errorf("the missing file should be reported, stderr = %q", stderr.String())
+	}
+}
+
+func TestTextEventEmitter(t *testing.T) {
+	var out bytes.Buffer
+	emit := textEventEmitter(&out)
+	at := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+	_ = emit(analysis.TrackerEvent{Type: analysis.EventStatusChanged, Time: at, ID: "bv-3", Title: "Docs", From: "open", To: "in_progress"})
+	_ = emit(analysis.TrackerEvent{Type: analysis.EventReadyChanged, Time: at, Added: []string{"bv-4", "bv-5"}, Removed: []string{"bv-3"}})
+
+	want := "09:30:00 status   bv-3 Docs: open → in_progress\n09:30:00 ready    +bv-4 +bv-5 -bv-3\n"
+	if out.String() != want {
+		t.Errorf("output:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
@@ -340,7 +340,7 @@ func (a *Analyzer) generateTopKSet(k int) *TopKSetResult {
 	// Get actionable (non-closed) issues as candidates
 	var candidates []string
 	for id, issue := range a.issueMap {
-		if issue.Status != model.StatusClosed {
+		if !issue.Status.IsClosed() {
 			candidates = append(candidates, id)
 		}
 	}
@@ -425,7 +425,7 @@ func (a *Analyzer) computeMarginalUnblocks(issueID string, alreadyCompleted map[
 
 	for _, issue := range a.issueMap {
 		// Skip closed issues
-		if issue.Status == model.StatusClosed {
+		if issue.Status.IsClosed() {
 			continue
 		}
 		// Skip if already "completed" in our simulation
@@ -456,7 +456,7 @@ func (a *Analyzer) computeMarginalUnblocks(issueID string, alreadyCompleted map[
 
 			// Check if there's another open blocker (not already completed)
 			if blocker, exists := a.issueMap[dep.DependsOnID]; exists {
-				if blocker.Status != model.StatusClosed && !alreadyCompleted[dep.DependsOnID] {
+				if !blocker.Status.IsClosed() && !alreadyCompleted[dep.DependsOnID] {
 					wouldBeBlocked = true
 					break
 				}
@@ -484,14 +484,14 @@ func (a *Analyzer) generateCoverageSet(limit int) *CoverageSetResult {
 	type edge struct{ from, to string }
 	var edges []edge
 	for id, issue := range a.issueMap {
-		if issue.Status == model.StatusClosed {
+		if issue.Status.IsClosed() {
 			continue
 		}
 		for _, dep := range issue.Dependencies {
 			if dep == nil || dep.Type != model.DepBlocks {
 				continue
 			}
-			if target, ok := a.issueMap[dep.DependsOnID]; ok && target.Status != model.StatusClosed {
+			if target, ok := a.issueMap[dep.DependsOnID]; ok && !target.Status.IsClosed() {
 				edges = append(edges, edge{from: id, to: dep.DependsOnID})
 			}
 		}
@@ -606,7 +606,7 @@ func (a *Analyzer) generateKPaths(k int, pathLengthCap int) *KPathsResult {
 
 	// Collect non-closed issues
 	for id, issue := range a.issueMap {
-		if issue.Status != model.StatusClosed {
+		if !issue.Status.IsClosed() {
 			idToIndex[id] = len(nodes)
 			nodes = append(nodes, nodeInfo{id: id, index: len(nodes)})
 		}
@@ -812,7 +812,7 @@ func (a *Analyzer) generateParallelCut(limit int) *ParallelCutResult {
 	// Build map of non-closed issues
 	openIssues := make(map[string]bool)
 	for id, issue := range a.issueMap {
-		if issue.Status != model.StatusClosed {
+		if !issue.Status.IsClosed() {
 			openIssues[id] = true
 		}
 	}
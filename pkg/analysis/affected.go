@@ -0,0 +1,73 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// AffectedIssue explains why an issue is in the affected cone
+type AffectedIssue struct {
+	ID     string `json:"id"`
+	Source string `json:"source"` // Nearest changed issue it sits downstream of; itself for changed issues
+	Depth  int    `json:"depth"`  // Dependency hops from Source; 0 for changed issues
+}
+
+// AffectedCone tags every issue downstream of the changed ones as
+// potentially affected: anything blocked by a changed issue, or a child of
+// one, directly or transitively. Changed issues are in the cone at depth 0.
+// Each issue is attributed to its nearest changed issue; ties go to the
+// lowest ID. Unknown changed IDs are ignored.
+func AffectedCone(issues []model.Issue, changed []string) map[string]AffectedIssue {
+	known := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		known[issue.ID] = true
+	}
+
+	// dependents[id] lists the issues that wait on id or are children of it
+	dependents := make(map[string][]string)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !known[dep.DependsOnID] || dep.DependsOnID == issue.ID {
+				continue
+			}
+			if dep.Type.IsBlocking() || dep.Type == model.DepParentChild {
+				dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], issue.ID)
+			}
+		}
+	}
+	for id := range dependents {
+		sort.Strings(dependents[id])
+	}
+
+	seeds := make([]string, 0, len(changed))
+	for _, id := range changed {
+		if known[id] {
+			seeds = append(seeds, id)
+		}
+	}
+	sort.Strings(seeds)
+
+	// Breadth-first from every changed issue at once, so the first visit
+	// is the nearest source
+	cone := make(map[string]AffectedIssue, len(seeds))
+	queue := make([]string, 0, len(seeds))
+	for _, id := range seeds {
+		if _, seen := cone[id]; !seen {
+			cone[id] = AffectedIssue{ID: id, Source: id}
+			queue = append(queue, id)
+		}
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		from := cone[id]
+		for _, d := range dependents[id] {
+			if _, seen := cone[d]; !seen {
+				cone[d] = AffectedIssue{ID: d, Source: from.Source, Depth: from.Depth + 1}
+				queue = append(queue, d)
+			}
+		}
+	}
+	return cone
+}
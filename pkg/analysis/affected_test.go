@@ -0,0 +1,49 @@
+package analysis_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestAffectedCone(t *testing.T) {
+	// api waits on schema, ui on api; task is a child of epic; notes is
+	// only related to schema, so it is not downstream of it
+	issues := []model.Issue{
+		{ID: "schema"},
+		{ID: "api", Dependencies: blockedBy("schema")},
+		{ID: "ui", Dependencies: blockedBy("api")},
+		{ID: "epic"},
+		{ID: "task", Dependencies: []*model.Dependency{{DependsOnID: "epic", Type: model.DepParentChild}}},
+		{ID: "notes", Dependencies: []*model.Dependency{{DependsOnID: "schema", Type: model.DepRelated}}},
+		{ID: "ship", Dependencies: blockedBy("ui", "task")},
+	}
+
+	got := analysis.AffectedCone(issues, []string{"schema", "epic", "ghost"})
+	want := map[string]analysis.AffectedIssue{
+		"schema": {ID: "schema", Source: "schema"},
+		"api":    {ID: "api", Source: "schema", Depth: 1},
+		"ui":     {ID: "ui", Source: "schema", Depth: 2},
+		"epic":   {ID: "epic", Source: "epic"},
+		"task":   {ID: "task", Source: "epic", Depth: 1},
+		"ship":   {ID: "ship", Source: "epic", Depth: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AffectedCone = %v\nwant %v", got, want)
+	}
+
+	// A changed issue inside another's cone is its own source
+	got = analysis.AffectedCone(issues, []string{"schema", "ui"})
+	if a := got["ui"]; a.Source != "ui" || a.Depth != 0 {
+		t.Errorf("ui = %+v, want its own source", a)
+	}
+	if a := got["ship"]; a.Source != "ui" || a.Depth != 1 {
+		t.Errorf("ship = %+v, want one hop from ui", a)
+	}
+
+	if got := analysis.AffectedCone(issues, nil); len(got) != 0 {
+		t.Errorf("no changes gave %v", got)
+	}
+}
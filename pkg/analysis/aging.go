@@ -0,0 +1,122 @@
+package analysis
+
+import (
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// AgeBucket is a half-open range of issue ages in days: [MinDays, MaxDays).
+// MaxDays < 0 means the bucket is unbounded above.
+type AgeBucket struct {
+	Label   string `json:"label"`
+	MinDays int    `json:"min_days"`
+	MaxDays int    `json:"max_days"`
+}
+
+// Contains reports whether an age (in days) falls into the bucket.
+func (b AgeBucket) Contains(days float64) bool {
+	if days < float64(b.MinDays) {
+		return false
+	}
+	return b.MaxDays < 0 || days < float64(b.MaxDays)
+}
+
+// DefaultAgeBuckets are the buckets used by the stats dashboard aging chart.
+var DefaultAgeBuckets = []AgeBucket{
+	{Label: "0-7d", MinDays: 0, MaxDays: 7},
+	{Label: "7-30d", MinDays: 7, MaxDays: 30},
+	{Label: "30-90d", MinDays: 30, MaxDays: 90},
+	{Label: "90d+", MinDays: 90, MaxDays: -1},
+}
+
+// AgingStatuses is the display order of open statuses in the aging histogram.
+var AgingStatuses = []model.Status{
+	model.StatusOpen,
+	model.StatusInProgress,
+	model.StatusBlocked,
+}
+
+// AgingHistogram buckets open issues by age since creation, split by status.
+type AgingHistogram struct {
+	Buckets       []AgeBucket            `json:"buckets"`
+	Statuses      []model.Status         `json:"statuses"`
+	Counts        map[model.Status][]int `json:"counts"` // status -> count per bucket
+	Totals        []int                  `json:"totals"` // count per bucket across statuses
+	Total         int                    `json:"total"`
+	OldestID      string                 `json:"oldest_id,omitempty"`
+	OldestAgeDays int                    `json:"oldest_age_days,omitempty"`
+}
+
+// BucketTotal returns the number of issues in bucket i across all statuses.
+func (h AgingHistogram) BucketTotal(i int) int {
+	if i < 0 || i >= len(h.Totals) {
+		return 0
+	}
+	return h.Totals[i]
+}
+
+// MaxBucketTotal returns the largest bucket total (used to scale bars).
+func (h AgingHistogram) MaxBucketTotal() int {
+	maxTotal := 0
+	for _, t := range h.Totals {
+		if t > maxTotal {
+			maxTotal = t
+		}
+	}
+	return maxTotal
+}
+
+// ComputeAgingHistogram buckets every non-closed issue by how long ago it was
+// created. Issues without a creation timestamp are skipped. Statuses outside
+// AgingStatuses are folded into StatusOpen so nothing silently disappears.
+func ComputeAgingHistogram(issues []model.Issue, buckets []AgeBucket, now time.Time) AgingHistogram {
+	if len(buckets) == 0 {
+		buckets = DefaultAgeBuckets
+	}
+
+	h := AgingHistogram{
+		Buckets:  buckets,
+		Statuses: AgingStatuses,
+		Counts:   make(map[model.Status][]int, len(AgingStatuses)),
+		Totals:   make([]int, len(buckets)),
+	}
+	for _, s := range AgingStatuses {
+		h.Counts[s] = make([]int, len(buckets))
+	}
+
+	var oldestAt time.Time
+	for _, iss := range issues {
+		if iss.Status.IsClosed() || iss.CreatedAt.IsZero() {
+			continue
+		}
+
+		days := now.Sub(iss.CreatedAt).Hours() / 24.0
+		if days < 0 {
+			days = 0
+		}
+
+		// Custom statuses count in their column's bar
+		status := iss.Status.Column()
+		if _, ok := h.Counts[status]; !ok {
+			status = model.StatusOpen
+		}
+
+		for b, bucket := range buckets {
+			if bucket.Contains(days) {
+				h.Counts[status][b]++
+				h.Totals[b]++
+				h.Total++
+				break
+			}
+		}
+
+		if oldestAt.IsZero() || iss.CreatedAt.Before(oldestAt) {
+			oldestAt = iss.CreatedAt
+			h.OldestID = iss.ID
+			h.OldestAgeDays = int(days)
+		}
+	}
+
+	return h
+}
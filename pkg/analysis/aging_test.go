@@ -0,0 +1,76 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeAgingHistogram(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.Add(-time.Duration(d) * 24 * time.Hour) }
+
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, CreatedAt: daysAgo(1)},
+		{ID: "b", Status: model.StatusInProgress, CreatedAt: daysAgo(3)},
+		{ID: "c", Status: model.StatusOpen, CreatedAt: daysAgo(10)},
+		{ID: "d", Status: model.StatusBlocked, CreatedAt: daysAgo(45)},
+		{ID: "e", Status: model.StatusOpen, CreatedAt: daysAgo(200)},
+		{ID: "f", Status: model.StatusClosed, CreatedAt: daysAgo(300)},
+		{ID: "g", Status: model.StatusOpen}, // no created_at: skipped
+	}
+
+	h := ComputeAgingHistogram(issues, nil, now)
+
+	if h.Total != 5 {
+		t.Fatalf("Total = %d, want 5", h.Total)
+	}
+	wantTotals := []int{2, 1, 1, 1}
+	for i, want := range wantTotals {
+		if got := h.BucketTotal(i); got != want {
+			t.Errorf("bucket %s total = %d, want %d", h.Buckets[i].Label, got, want)
+		}
+	}
+	if got := h.Counts[model.StatusInProgress][0]; got != 1 {
+		t.Errorf("in_progress 0-7d = %d, want 1", got)
+	}
+	if got := h.Counts[model.StatusBlocked][2]; got != 1 {
+		t.Errorf("blocked 30-90d = %d, want 1", got)
+	}
+	if h.MaxBucketTotal() != 2 {
+		t.Errorf("MaxBucketTotal = %d, want 2", h.MaxBucketTotal())
+	}
+	if h.OldestID != "e" || h.OldestAgeDays != 200 {
+		t.Errorf("oldest = %s (%dd), want e (200d)", h.OldestID, h.OldestAgeDays)
+	}
+}
+
+func TestAgingHistogramCustomStatuses(t *testing.T) {
+	model.RegisterCustomStatuses([]model.CustomStatus{{Name: "review", Column: model.StatusInProgress}})
+	defer model.RegisterCustomStatuses(nil)
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "a", Status: "review", CreatedAt: now.Add(-48 * time.Hour)},
+		{ID: "b", Status: "unregistered", CreatedAt: now.Add(-48 * time.Hour)},
+	}
+	h := ComputeAgingHistogram(issues, nil, now)
+	if got := h.Counts[model.StatusInProgress][0]; got != 1 {
+		t.Errorf("review should land in the in_progress bar, got %d", got)
+	}
+	if got := h.Counts[model.StatusOpen][0]; got != 1 {
+		t.Errorf("an unknown status should land in the open bar, got %d", got)
+	}
+}
+
+func TestAgeBucketBoundaries(t *testing.T) {
+	b := AgeBucket{Label: "7-30d", MinDays: 7, MaxDays: 30}
+	if b.Contains(6.99) || !b.Contains(7) || !b.Contains(29.9) || b.Contains(30) {
+		t.Error("bounded bucket should be [7, 30)")
+	}
+	open := AgeBucket{Label: "90d+", MinDays: 90, MaxDays: -1}
+	if open.Contains(89) || !open.Contains(10000) {
+		t.Error("unbounded bucket should accept anything >= 90")
+	}
+}
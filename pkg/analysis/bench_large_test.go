@@ -0,0 +1,143 @@
+package analysis_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+)
+
+// ============================================================================
+// Large Dataset Smoke Benchmarks
+//
+// Lens-view workloads on project-sized inputs from the shared synthetic
+// generator. TestLargeDatasetBudgets turns them into regression checks.
+// ============================================================================
+
+// largeLensLabel is the label the lens workloads are scoped to
+const largeLensLabel = "backend"
+
+var largeFixtures = map[int][]model.Issue{}
+
+// largeFixture returns testutil.QuickProject(n), built once per size
+func largeFixture(n int) []model.Issue {
+	if issues, ok := largeFixtures[n]; ok {
+		return issues
+	}
+	issues := testutil.QuickProject(n)
+	largeFixtures[n] = issues
+	return issues
+}
+
+// largePrimaryIDs returns the IDs of issues carrying largeLensLabel
+func largePrimaryIDs(issues []model.Issue) map[string]bool {
+	ids := make(map[string]bool)
+	for _, issue := range issues {
+		for _, label := range issue.Labels {
+			if label == largeLensLabel {
+				ids[issue.ID] = true
+				break
+			}
+		}
+	}
+	return ids
+}
+
+func BenchmarkDetectWorkstreams_Large1000(b *testing.B) {
+	benchDetectWorkstreams(b, largeFixture(1000))
+}
+
+func BenchmarkDetectWorkstreams_Large5000(b *testing.B) {
+	benchDetectWorkstreams(b, largeFixture(5000))
+}
+
+func benchDetectWorkstreams(b *testing.B, issues []model.Issue) {
+	primaryIDs := largePrimaryIDs(issues)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = analysis.DetectWorkstreams(issues, primaryIDs, largeLensLabel)
+	}
+}
+
+func BenchmarkSubdivideAll_Large1000(b *testing.B) {
+	benchSubdivideAll(b, largeFixture(1000))
+}
+
+func BenchmarkSubdivideAll_Large5000(b *testing.B) {
+	benchSubdivideAll(b, largeFixture(5000))
+}
+
+func benchSubdivideAll(b *testing.B, issues []model.Issue) {
+	primaryIDs := largePrimaryIDs(issues)
+	workstreams := analysis.DetectWorkstreams(issues, primaryIDs, largeLensLabel)
+	ptrs := make([]*analysis.Workstream, len(workstreams))
+	for i := range workstreams {
+		ptrs[i] = &workstreams[i]
+	}
+	opts := analysis.DefaultGroupingOptions()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, ws := range ptrs {
+			ws.SubWorkstreams = nil
+		}
+		analysis.SubdivideAll(ptrs, primaryIDs, opts)
+	}
+}
+
+func BenchmarkPageRank_Large1000(b *testing.B) {
+	benchAnalyzerPageRank(b, largeFixture(1000))
+}
+
+func BenchmarkPageRank_Large5000(b *testing.B) {
+	benchAnalyzerPageRank(b, largeFixture(5000))
+}
+
+// benchAnalyzerPageRank measures PageRank through the analyzer, graph
+// construction included, rather than raw gonum
+func benchAnalyzerPageRank(b *testing.B, issues []model.Issue) {
+	cfg := analysis.AnalysisConfig{ComputePageRank: true, PageRankTimeout: time.Minute}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stats := analysis.NewAnalyzer(issues).AnalyzeWithConfig(cfg)
+		_ = stats.PageRank()
+	}
+}
+
+// largeBudgets are regression thresholds in ns/op, several times the time
+// measured on a laptop so that only real regressions trip them
+var largeBudgets = []struct {
+	name   string
+	bench  func(*testing.B)
+	budget time.Duration
+}{
+	{"DetectWorkstreams_Large5000", BenchmarkDetectWorkstreams_Large5000, 150 * time.Millisecond},
+	{"SubdivideAll_Large5000", BenchmarkSubdivideAll_Large5000, 60 * time.Millisecond},
+	{"PageRank_Large5000", BenchmarkPageRank_Large5000, 750 * time.Millisecond},
+}
+
+// TestLargeDatasetBudgets fails when a large dataset benchmark exceeds its
+// budget. Timing depends on the machine, so it only runs when asked:
+//
+//	BV_BENCH_BUDGETS=1 go test -run TestLargeDatasetBudgets ./pkg/analysis/
+func TestLargeDatasetBudgets(t *testing.T) {
+	if os.Getenv("BV_BENCH_BUDGETS") != "1" {
+		t.Skip("Skipping benchmark budgets (set BV_BENCH_BUDGETS=1 to run)")
+	}
+	for _, bb := range largeBudgets {
+		result := testing.Benchmark(bb.bench)
+		got := time.Duration(result.NsPerOp())
+		t.Logf("%s: %v/op (budget %v)", bb.name, got, bb.budget)
+		if got > bb.budget {
+			t.Errorf("%s regressed: %v/op exceeds the %v budget", bb.name, got, bb.budget)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// BuildOrder lays a set of issues out in topological order of their blocking
+// dependencies. Each wave can be worked in parallel once the waves before it
+// are closed: wave 1 is ready now, wave 2 unblocks after wave 1, and so on.
+type BuildOrder struct {
+	Waves [][]model.Issue
+	// Waiting holds open issues no wave reaches: marked blocked, blocked by an
+	// open issue outside the set, in a dependency cycle, or behind any of those
+	Waiting []model.Issue
+	Done    []model.Issue // Closed issues of the set
+}
+
+// ComputeBuildOrder computes the build order of issues. all is every known
+// issue and resolves blockers outside the set: a closed or unknown blocker
+// counts as done, an open one leaves the issue waiting.
+func ComputeBuildOrder(issues, all []model.Issue) BuildOrder {
+	var order BuildOrder
+
+	open := make(map[string]model.Issue)
+	for _, iss := range issues {
+		if iss.Status.IsClosed() {
+			order.Done = append(order.Done, iss)
+			continue
+		}
+		open[iss.ID] = iss
+	}
+	external := make(map[string]bool) // Open issues outside the set
+	for _, iss := range all {
+		if _, inSet := open[iss.ID]; !inSet && !iss.Status.IsClosed() {
+			external[iss.ID] = true
+		}
+	}
+
+	// Blocker edges within the set; stuck issues can't start whatever happens
+	// inside it
+	blockers := make(map[string]int, len(open))
+	dependents := make(map[string][]string)
+	stuck := make(map[string]bool)
+	for id, iss := range open {
+		if iss.Status.Column() == model.StatusBlocked {
+			stuck[id] = true
+		}
+		seen := make(map[string]bool)
+		for _, dep := range iss.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || dep.DependsOnID == id || seen[dep.DependsOnID] {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			if _, inSet := open[dep.DependsOnID]; inSet {
+				blockers[id]++
+				dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], id)
+			} else if external[dep.DependsOnID] {
+				stuck[id] = true
+			}
+		}
+	}
+
+	// Kahn's algorithm, one layer at a time
+	var wave []string
+	for id := range open {
+		if blockers[id] == 0 && !stuck[id] {
+			wave = append(wave, id)
+		}
+	}
+	placed := make(map[string]bool, len(open))
+	for len(wave) > 0 {
+		issues := make([]model.Issue, 0, len(wave))
+		var next []string
+		for _, id := range wave {
+			placed[id] = true
+			issues = append(issues, open[id])
+			for _, dependent := range dependents[id] {
+				blockers[dependent]--
+				if blockers[dependent] == 0 && !stuck[dependent] {
+					next = append(next, dependent)
+				}
+			}
+		}
+		sortBuildOrder(issues)
+		order.Waves = append(order.Waves, issues)
+		wave = next
+	}
+
+	for id, iss := range open {
+		if !placed[id] {
+			order.Waiting = append(order.Waiting, iss)
+		}
+	}
+	sortBuildOrder(order.Waiting)
+	sortBuildOrder(order.Done)
+	return order
+}
+
+// sortBuildOrder sorts issues by priority, then ID
+func sortBuildOrder(issues []model.Issue) {
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Priority != issues[j].Priority {
+			return issues[i].Priority < issues[j].Priority
+		}
+		return issues[i].ID < issues[j].ID
+	})
+}
@@ -0,0 +1,60 @@
+package analysis_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeBuildOrder(t *testing.T) {
+	issue := func(id string, status model.Status, priority int, blockers ...string) model.Issue {
+		iss := model.Issue{ID: id, Status: status, Priority: priority}
+		for _, b := range blockers {
+			iss.Dependencies = append(iss.Dependencies, &model.Dependency{IssueID: id, DependsOnID: b, Type: model.DepBlocks})
+		}
+		return iss
+	}
+	lens := []model.Issue{
+		issue("a", model.StatusOpen, 2),
+		issue("b", model.StatusInProgress, 1),
+		issue("c", model.StatusOpen, 1, "a", "b"),
+		issue("d", model.StatusOpen, 0, "a", "done"),
+		issue("e", model.StatusOpen, 1, "c", "d"),
+		issue("f", model.StatusOpen, 1, "outside"),        // Open blocker outside the lens
+		issue("g", model.StatusOpen, 1, "f"),              // Behind a waiting issue
+		issue("h", model.StatusBlocked, 1),                // Marked blocked
+		issue("x", model.StatusOpen, 1, "y"),              // Cycle
+		issue("y", model.StatusOpen, 1, "x"),              // Cycle
+		issue("z", model.StatusOpen, 3, "closed-outside"), // Closed blocker outside
+		issue("done", model.StatusClosed, 1),
+	}
+	all := append([]model.Issue{
+		issue("outside", model.StatusOpen, 1),
+		issue("closed-outside", model.StatusClosed, 1),
+	}, lens...)
+
+	order := analysis.ComputeBuildOrder(lens, all)
+	ids := func(issues []model.Issue) []string {
+		out := make([]string, len(issues))
+		for i, iss := range issues {
+			out[i] = iss.ID
+		}
+		return out
+	}
+	var waves [][]string
+	for _, w := range order.Waves {
+		waves = append(waves, ids(w))
+	}
+	want := [][]string{{"b", "a", "z"}, {"d", "c"}, {"e"}}
+	if !reflect.DeepEqual(waves, want) {
+		t.Errorf("waves = %v, want %v", waves, want)
+	}
+	if got := ids(order.Waiting); !reflect.DeepEqual(got, []string{"f", "g", "h", "x", "y"}) {
+		t.Errorf("waiting = %v", got)
+	}
+	if got := ids(order.Done); !reflect.DeepEqual(got, []string{"done"}) {
+		t.Errorf("done = %v", got)
+	}
+}
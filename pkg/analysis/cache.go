@@ -233,6 +233,11 @@ type CachedAnalyzer struct {
 	dataHash   string // Hash of the issue data
 	configHash string // Hash of the configuration
 	cacheHit   bool   // Set by AnalyzeAsync to track if it was a cache hit
+
+	// Optional persistent cache shared across runs
+	diskCache      *DiskCache
+	dependents     map[string]int
+	dependentsOnce sync.Once
 }
 
 // NewCachedAnalyzer creates an analyzer that checks the cache before computing.
@@ -258,6 +263,12 @@ func (ca *CachedAnalyzer) SetConfig(config *AnalysisConfig) {
 	ca.configHash = ComputeConfigHash(config)
 }
 
+// SetDiskCache enables the persistent cache, consulted after the in-memory
+// cache misses and written once Phase 2 completes. A nil cache disables it.
+func (ca *CachedAnalyzer) SetDiskCache(d *DiskCache) {
+	ca.diskCache = d
+}
+
 // AnalyzeAsync returns cached stats if available, otherwise computes and caches.
 func (ca *CachedAnalyzer) AnalyzeAsync(ctx context.Context) *GraphStats {
 	// Combined key: dataHash|configHash
@@ -269,6 +280,14 @@ func (ca *CachedAnalyzer) AnalyzeAsync(ctx context.Context) *GraphStats {
 		return stats
 	}
 
+	// Then the persistent cache from earlier runs
+	if stats, dependents, ok := ca.diskCache.Load(ca.dataHash, ca.configHash); ok {
+		ca.cacheHit = true
+		ca.dependentsOnce.Do(func() { ca.dependents = dependents })
+		ca.cache.SetByHash(fullHash, stats)
+		return stats
+	}
+
 	// Cache miss - compute fresh
 	ca.cacheHit = false
 	stats := ca.Analyzer.AnalyzeAsync(ctx)
@@ -277,11 +296,24 @@ func (ca *CachedAnalyzer) AnalyzeAsync(ctx context.Context) *GraphStats {
 	go func() {
 		stats.WaitForPhase2()
 		ca.cache.SetByHash(fullHash, stats)
+		if ca.diskCache != nil && ctx.Err() == nil {
+			// Best effort: a failed write only costs the next launch a recompute
+			_ = ca.diskCache.Save(ca.dataHash, ca.configHash, stats, ca.TransitiveDependentCounts())
+		}
 	}()
 
 	return stats
 }
 
+// TransitiveDependentCounts returns the cached dependent counts when the
+// analysis came from disk, otherwise computes them once.
+func (ca *CachedAnalyzer) TransitiveDependentCounts() map[string]int {
+	ca.dependentsOnce.Do(func() {
+		ca.dependents = ca.Analyzer.TransitiveDependentCounts()
+	})
+	return ca.dependents
+}
+
 // Analyze returns cached stats if available, otherwise computes synchronously.
 // Note: This returns a value copy that shares map references with the original.
 // This is safe because the maps are immutable after Phase 2 completion.
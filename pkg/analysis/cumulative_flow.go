@@ -0,0 +1,172 @@
+package analysis
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DefaultCumulativeFlowDays is the window shown by the stats dashboard.
+const DefaultCumulativeFlowDays = 60
+
+// FlowPoint is one day of a cumulative flow diagram. Counts are cumulative:
+// Created includes every issue created on or before Date, Closed every issue
+// closed on or before Date, and Open is the difference (the backlog size).
+// InProgress and Blocked are the parts of Open in those states; with no status
+// history, an issue counts in its current state from its last update onward.
+type FlowPoint struct {
+	Date       time.Time `json:"date"`
+	Created    int       `json:"created"`
+	Closed     int       `json:"closed"`
+	Open       int       `json:"open"`
+	InProgress int       `json:"in_progress"`
+	Blocked    int       `json:"blocked"`
+}
+
+// CumulativeFlow is a daily series of created vs closed totals.
+type CumulativeFlow struct {
+	Points []FlowPoint `json:"points"`
+}
+
+// MaxCreated returns the largest cumulative created count (used to scale charts).
+func (cf CumulativeFlow) MaxCreated() int {
+	maxCreated := 0
+	for _, p := range cf.Points {
+		if p.Created > maxCreated {
+			maxCreated = p.Created
+		}
+	}
+	return maxCreated
+}
+
+// NetChange returns how much the backlog grew (positive) or shrank (negative)
+// over the window.
+func (cf CumulativeFlow) NetChange() int {
+	if len(cf.Points) == 0 {
+		return 0
+	}
+	return cf.Points[len(cf.Points)-1].Open - cf.Points[0].Open
+}
+
+// WriteCSV writes the series as CSV with a header row.
+func (cf CumulativeFlow) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"date", "created", "closed", "open", "in_progress", "blocked"}); err != nil {
+		return fmt.Errorf("writing csv header: %w", err)
+	}
+	for _, p := range cf.Points {
+		row := []string{
+			p.Date.Format("2006-01-02"),
+			strconv.Itoa(p.Created),
+			strconv.Itoa(p.Closed),
+			strconv.Itoa(p.Open),
+			strconv.Itoa(p.InProgress),
+			strconv.Itoa(p.Blocked),
+		}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("writing csv row: %w", err)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// issueClosedAt returns when an issue was closed, falling back to UpdatedAt for
+// closed issues that predate closed_at tracking. Returns zero time if open.
+func issueClosedAt(iss model.Issue) time.Time {
+	if iss.ClosedAt != nil {
+		return *iss.ClosedAt
+	}
+	if iss.Status.IsClosed() {
+		return iss.UpdatedAt
+	}
+	return time.Time{}
+}
+
+// ComputeCumulativeFlow builds a daily cumulative flow series covering the
+// `days` days ending at now. Issues created before the window are included in
+// the baseline so the first point reflects the backlog at that time.
+func ComputeCumulativeFlow(issues []model.Issue, days int, now time.Time) CumulativeFlow {
+	if days <= 0 {
+		days = DefaultCumulativeFlowDays
+	}
+
+	// Day boundaries: point i covers everything up to the end of that day.
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	first := today.AddDate(0, 0, -(days - 1))
+
+	createdPerDay := make([]int, days)
+	closedPerDay := make([]int, days)
+	progressPerDay := make([]int, days)
+	blockedPerDay := make([]int, days)
+	baseCreated, baseClosed, baseProgress, baseBlocked := 0, 0, 0, 0
+
+	dayIndex := func(ts time.Time) int {
+		ts = ts.In(now.Location())
+		d := time.Date(ts.Year(), ts.Month(), ts.Day(), 0, 0, 0, 0, now.Location())
+		return int(d.Sub(first).Hours() / 24)
+	}
+
+	for _, iss := range issues {
+		if iss.CreatedAt.IsZero() {
+			continue
+		}
+		if idx := dayIndex(iss.CreatedAt); idx < 0 {
+			baseCreated++
+		} else if idx < days {
+			createdPerDay[idx]++
+		}
+
+		closedAt := issueClosedAt(iss)
+		if closedAt.IsZero() {
+			// Still open: in progress or blocked since the last update
+			since := iss.UpdatedAt
+			if since.Before(iss.CreatedAt) {
+				since = iss.CreatedAt
+			}
+			switch iss.Status.Column() {
+			case model.StatusInProgress:
+				if idx := dayIndex(since); idx < 0 {
+					baseProgress++
+				} else if idx < days {
+					progressPerDay[idx]++
+				}
+			case model.StatusBlocked:
+				if idx := dayIndex(since); idx < 0 {
+					baseBlocked++
+				} else if idx < days {
+					blockedPerDay[idx]++
+				}
+			}
+			continue
+		}
+		if idx := dayIndex(closedAt); idx < 0 {
+			baseClosed++
+		} else if idx < days {
+			closedPerDay[idx]++
+		}
+	}
+
+	cf := CumulativeFlow{Points: make([]FlowPoint, days)}
+	created, closed := baseCreated, baseClosed
+	progress, blocked := baseProgress, baseBlocked
+	for i := 0; i < days; i++ {
+		created += createdPerDay[i]
+		closed += closedPerDay[i]
+		progress += progressPerDay[i]
+		blocked += blockedPerDay[i]
+		cf.Points[i] = FlowPoint{
+			Date:       first.AddDate(0, 0, i),
+			Created:    created,
+			Closed:     closed,
+			Open:       created - closed,
+			InProgress: progress,
+			Blocked:    blocked,
+		}
+	}
+	return cf
+}
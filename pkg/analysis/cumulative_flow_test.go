@@ -0,0 +1,72 @@
+package analysis
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeCumulativeFlow(t *testing.T) {
+	now := time.Date(2025, 6, 10, 15, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.Add(-time.Duration(d) * 24 * time.Hour) }
+	closed := func(d int) *time.Time { ts := daysAgo(d); return &ts }
+
+	issues := []model.Issue{
+		{ID: "old", Status: model.StatusClosed, CreatedAt: daysAgo(30), ClosedAt: closed(20)}, // all before window
+		{ID: "base", Status: model.StatusOpen, CreatedAt: daysAgo(10)},                        // baseline open
+		{ID: "a", Status: model.StatusClosed, CreatedAt: daysAgo(3), ClosedAt: closed(2)},
+		{ID: "b", Status: model.StatusOpen, CreatedAt: daysAgo(3)},
+		{ID: "c", Status: model.StatusClosed, CreatedAt: daysAgo(1), UpdatedAt: daysAgo(0)}, // no closed_at: falls back to updated_at
+		{ID: "nodate", Status: model.StatusOpen},
+		{ID: "wip", Status: model.StatusInProgress, CreatedAt: daysAgo(8), UpdatedAt: daysAgo(1)},
+		{ID: "stuck", Status: model.StatusBlocked, CreatedAt: daysAgo(2)}, // no updated_at: blocked since creation
+	}
+
+	cf := ComputeCumulativeFlow(issues, 5, now)
+	if len(cf.Points) != 5 {
+		t.Fatalf("len(Points) = %d, want 5", len(cf.Points))
+	}
+
+	first := cf.Points[0]
+	if first.Created != 3 || first.Closed != 1 || first.Open != 2 || first.InProgress != 0 || first.Blocked != 0 {
+		t.Errorf("first point = %+v, want created 3, closed 1, open 2, none in progress or blocked", first)
+	}
+	if p := cf.Points[2]; p.InProgress != 0 || p.Blocked != 1 {
+		t.Errorf("points[2] = %+v, want 1 blocked and none in progress yet", p)
+	}
+	last := cf.Points[4]
+	if last.Created != 7 || last.Closed != 3 || last.Open != 4 || last.InProgress != 1 || last.Blocked != 1 {
+		t.Errorf("last point = %+v, want created 7, closed 3, open 4, 1 in progress, 1 blocked", last)
+	}
+	if !last.Date.Equal(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("last date = %v, want 2025-06-10", last.Date)
+	}
+	if cf.NetChange() != 2 {
+		t.Errorf("NetChange = %d, want 2", cf.NetChange())
+	}
+	if cf.MaxCreated() != 7 {
+		t.Errorf("MaxCreated = %d, want 7", cf.MaxCreated())
+	}
+}
+
+func TestCumulativeFlowWriteCSV(t *testing.T) {
+	now := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	cf := ComputeCumulativeFlow([]model.Issue{
+		{ID: "a", Status: model.StatusOpen, CreatedAt: now},
+	}, 2, now)
+
+	var buf bytes.Buffer
+	if err := cf.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	want := "date,created,closed,open,in_progress,blocked\n2025-06-09,0,0,0,0,0\n2025-06-10,1,0,1,0,0\n"
+	if got := buf.String(); got != want {
+		t.Errorf("csv =\n%s\nwant\n%s", got, want)
+	}
+	if !strings.HasPrefix(buf.String(), "date,") {
+		t.Error("expected header row")
+	}
+}
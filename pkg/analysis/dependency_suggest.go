@@ -152,7 +152,7 @@ func DetectMissingDependencies(issues []model.Issue, config DependencySuggestion
 			issue2 := &issues[j]
 
 			// Skip if both closed
-			if issue1.Status == model.StatusClosed && issue2.Status == model.StatusClosed {
+			if issue1.Status.IsClosed() && issue2.Status.IsClosed() {
 				continue
 			}
 
@@ -0,0 +1,138 @@
+package analysis
+
+// TransitiveDependentCounts returns, for every open issue, how many distinct open
+// issues it blocks directly or through a chain of open blockers. Closed issues
+// neither count nor propagate: once a blocker is closed it no longer gates the
+// work behind it. Issues that block nothing are omitted from the map.
+//
+// The count is a leverage signal for the UI ("↑N"): finishing an issue with a
+// high count eventually frees up that many other items.
+func (a *Analyzer) TransitiveDependentCounts() map[string]int {
+	counts := make(map[string]int)
+	visited := make(map[int64]bool)
+	var stack []int64
+
+	for id, nodeID := range a.idToNode {
+		if a.issueMap[id].Status.IsClosed() {
+			continue
+		}
+		if a.g.To(nodeID).Len() == 0 {
+			continue
+		}
+
+		clear(visited)
+		visited[nodeID] = true
+		stack = append(stack[:0], nodeID)
+		count := 0
+
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			// Edges point from dependent to dependency, so To() yields dependents
+			dependents := a.g.To(current)
+			for dependents.Next() {
+				next := dependents.Node().ID()
+				if visited[next] {
+					continue
+				}
+				visited[next] = true
+				if a.issueMap[a.nodeToID[next]].Status.IsClosed() {
+					continue
+				}
+				count++
+				stack = append(stack, next)
+			}
+		}
+
+		if count > 0 {
+			counts[id] = count
+		}
+	}
+	return counts
+}
+
+// EffectivePriority is the most urgent priority an issue inherits from the
+// open work it blocks. InheritedFrom is the blocked issue that set it.
+type EffectivePriority struct {
+	Priority      int    `json:"priority"`
+	InheritedFrom string `json:"inherited_from"`
+}
+
+// EffectivePriorities returns, for every open issue that transitively blocks
+// more urgent open work than its own priority says, the most urgent priority
+// among those dependents (lowest number wins, ties go to the smallest ID).
+// As with TransitiveDependentCounts, closed issues neither count nor
+// propagate. Issues whose own priority already covers their dependents are
+// omitted from the map.
+//
+// Sorting by the effective priority floats a P3 that gates P0 work up next to
+// that P0.
+func (a *Analyzer) EffectivePriorities() map[string]EffectivePriority {
+	result := make(map[string]EffectivePriority)
+	visited := make(map[int64]bool)
+	var stack []int64
+
+	for id, nodeID := range a.idToNode {
+		issue := a.issueMap[id]
+		if issue.Status.IsClosed() {
+			continue
+		}
+		if a.g.To(nodeID).Len() == 0 {
+			continue
+		}
+
+		clear(visited)
+		visited[nodeID] = true
+		stack = append(stack[:0], nodeID)
+		best := EffectivePriority{Priority: issue.Priority}
+
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			dependents := a.g.To(current)
+			for dependents.Next() {
+				next := dependents.Node().ID()
+				if visited[next] {
+					continue
+				}
+				visited[next] = true
+				dep := a.issueMap[a.nodeToID[next]]
+				if dep.Status.IsClosed() {
+					continue
+				}
+				if dep.Priority < best.Priority || (dep.Priority == best.Priority && best.InheritedFrom != "" && dep.ID < best.InheritedFrom) {
+					best = EffectivePriority{Priority: dep.Priority, InheritedFrom: dep.ID}
+				}
+				stack = append(stack, next)
+			}
+		}
+
+		if best.InheritedFrom != "" {
+			result[id] = best
+		}
+	}
+	return result
+}
+
+// Leverage returns, for every issue in counts (as from
+// TransitiveDependentCounts), the open issues it gates per hour of its
+// estimate. Unestimated issues count as the median estimate. A small task
+// that frees up a lot of work scores highest.
+func (a *Analyzer) Leverage(counts map[string]int) map[string]float64 {
+	median := a.computeMedianEstimatedMinutes()
+	leverage := make(map[string]float64, len(counts))
+	for id, n := range counts {
+		issue, ok := a.issueMap[id]
+		if !ok {
+			continue
+		}
+		minutes := median
+		if issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0 {
+			minutes = *issue.EstimatedMinutes
+		}
+		leverage[id] = float64(n) * 60 / float64(minutes)
+	}
+	return leverage
+}
@@ -0,0 +1,115 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func blockedBy(ids ...string) []*model.Dependency {
+	deps := make([]*model.Dependency, len(ids))
+	for i, id := range ids {
+		deps[i] = &model.Dependency{DependsOnID: id, Type: model.DepBlocks}
+	}
+	return deps
+}
+
+func TestTransitiveDependentCounts(t *testing.T) {
+	// Diamond: B and C depend on A, D depends on both B and C.
+	// E depends on D; F is related (non-blocking) to A.
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen},
+		{ID: "B", Status: model.StatusOpen, Dependencies: blockedBy("A")},
+		{ID: "C", Status: model.StatusOpen, Dependencies: blockedBy("A")},
+		{ID: "D", Status: model.StatusOpen, Dependencies: blockedBy("B", "C")},
+		{ID: "E", Status: model.StatusOpen, Dependencies: blockedBy("D")},
+		{ID: "F", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepRelated}}},
+	}
+
+	counts := analysis.NewAnalyzer(issues).TransitiveDependentCounts()
+
+	want := map[string]int{"A": 4, "B": 2, "C": 2, "D": 1}
+	for id, n := range want {
+		if counts[id] != n {
+			t.Errorf("counts[%s] = %d, want %d", id, counts[id], n)
+		}
+	}
+	if _, ok := counts["E"]; ok {
+		t.Error("leaf issue should be omitted")
+	}
+	if len(counts) != len(want) {
+		t.Errorf("unexpected entries: %v", counts)
+	}
+}
+
+func TestTransitiveDependentCountsStopsAtClosed(t *testing.T) {
+	// A blocks closed B, which "blocks" C: closing B already released C.
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen},
+		{ID: "B", Status: model.StatusClosed, Dependencies: blockedBy("A")},
+		{ID: "C", Status: model.StatusOpen, Dependencies: blockedBy("B")},
+		{ID: "X", Status: model.StatusClosed},
+		{ID: "Y", Status: model.StatusOpen, Dependencies: blockedBy("X")},
+	}
+
+	counts := analysis.NewAnalyzer(issues).TransitiveDependentCounts()
+	if len(counts) != 0 {
+		t.Errorf("expected no counts through closed issues, got %v", counts)
+	}
+}
+
+func TestLeverage(t *testing.T) {
+	minutes := func(n int) *int { return &n }
+	// Big blocks three issues in 8h; Small blocks two in 30m; Plain has no
+	// estimate and takes the 2h median
+	issues := []model.Issue{
+		{ID: "Big", Status: model.StatusOpen, EstimatedMinutes: minutes(480)},
+		{ID: "Small", Status: model.StatusOpen, EstimatedMinutes: minutes(30)},
+		{ID: "Plain", Status: model.StatusOpen},
+		{ID: "A", Status: model.StatusOpen, EstimatedMinutes: minutes(120), Dependencies: blockedBy("Big", "Small")},
+		{ID: "B", Status: model.StatusOpen, EstimatedMinutes: minutes(120), Dependencies: blockedBy("Big", "Small", "Plain")},
+		{ID: "C", Status: model.StatusOpen, EstimatedMinutes: minutes(120), Dependencies: blockedBy("Big")},
+	}
+	a := analysis.NewAnalyzer(issues)
+	leverage := a.Leverage(a.TransitiveDependentCounts())
+
+	want := map[string]float64{"Big": 3.0 / 8, "Small": 4, "Plain": 0.5}
+	for id, w := range want {
+		if leverage[id] != w {
+			t.Errorf("leverage[%s] = %v, want %v", id, leverage[id], w)
+		}
+	}
+	if len(leverage) != len(want) {
+		t.Errorf("unexpected entries: %v", leverage)
+	}
+}
+
+func TestEffectivePriorities(t *testing.T) {
+	// low (P3) blocks mid (P2), which blocks urgent (P0) and also-urgent (P0).
+	// done (P0) is closed; side (P4) blocks a P4 and inherits nothing.
+	issues := []model.Issue{
+		{ID: "low", Priority: 3, Status: model.StatusOpen},
+		{ID: "mid", Priority: 2, Status: model.StatusOpen, Dependencies: blockedBy("low")},
+		{ID: "urgent", Priority: 0, Status: model.StatusOpen, Dependencies: blockedBy("mid")},
+		{ID: "also-urgent", Priority: 0, Status: model.StatusInProgress, Dependencies: blockedBy("mid")},
+		{ID: "gate", Priority: 1, Status: model.StatusOpen},
+		{ID: "done", Priority: 0, Status: model.StatusClosed, Dependencies: blockedBy("gate")},
+		{ID: "side", Priority: 4, Status: model.StatusOpen},
+		{ID: "peer", Priority: 4, Status: model.StatusOpen, Dependencies: blockedBy("side")},
+	}
+
+	got := analysis.NewAnalyzer(issues).EffectivePriorities()
+	want := map[string]analysis.EffectivePriority{
+		"low": {Priority: 0, InheritedFrom: "also-urgent"},
+		"mid": {Priority: 0, InheritedFrom: "also-urgent"},
+	}
+	if len(got) != len(want) {
+		t.Errorf("effective priorities = %v, want %v", got, want)
+	}
+	for id, ep := range want {
+		if got[id] != ep {
+			t.Errorf("%s = %+v, want %+v", id, got[id], ep)
+		}
+	}
+}
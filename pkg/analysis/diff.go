@@ -169,10 +169,10 @@ func CompareSnapshots(from, to *Snapshot) *SnapshotDiff {
 
 		// Check for status changes
 		isStatusChange := false
-		if fromIssue.Status != model.StatusClosed && toIssue.Status == model.StatusClosed {
+		if !fromIssue.Status.IsClosed() && toIssue.Status.IsClosed() {
 			diff.ClosedIssues = append(diff.ClosedIssues, toIssue)
 			isStatusChange = true
-		} else if fromIssue.Status == model.StatusClosed && toIssue.Status != model.StatusClosed {
+		} else if fromIssue.Status.IsClosed() && !toIssue.Status.IsClosed() {
 			diff.ReopenedIssues = append(diff.ReopenedIssues, toIssue)
 			isStatusChange = true
 		}
@@ -0,0 +1,296 @@
+package analysis
+
+import (
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DiskCacheVersion is bumped whenever the on-disk entry layout changes;
+// entries written by other versions are ignored.
+const DiskCacheVersion = 1
+
+// DefaultDiskCacheEntries is how many analysis results are kept on disk.
+const DefaultDiskCacheEntries = 8
+
+// DiskCache persists completed analysis results between runs, keyed by the
+// content hash of the issue data and the analysis configuration, so launching
+// on an unchanged repository skips centrality computation entirely. Only
+// launch-time results are stored; lens workstreams depend on the selection and
+// are computed on demand.
+type DiskCache struct {
+	dir        string
+	maxEntries int
+	readOnly   bool // Save does nothing
+}
+
+// diskCacheEntry is the gob-encoded file layout.
+type diskCacheEntry struct {
+	Version    int
+	DataHash   string
+	ConfigHash string
+	CreatedAt  time.Time
+	Stats      graphStatsSnapshot
+	Dependents map[string]int
+}
+
+// graphStatsSnapshot holds every GraphStats field in exported form for encoding.
+type graphStatsSnapshot struct {
+	OutDegree         map[string]int
+	InDegree          map[string]int
+	TopologicalOrder  []string
+	Density           float64
+	NodeCount         int
+	EdgeCount         int
+	Config            AnalysisConfig
+	PageRank          map[string]float64
+	Betweenness       map[string]float64
+	Eigenvector       map[string]float64
+	Hubs              map[string]float64
+	Authorities       map[string]float64
+	CriticalPathScore map[string]float64
+	CoreNumber        map[string]int
+	Articulation      map[string]bool
+	Slack             map[string]float64
+	Cycles            [][]string
+	PageRankRank      map[string]int
+	BetweennessRank   map[string]int
+	EigenvectorRank   map[string]int
+	HubsRank          map[string]int
+	AuthoritiesRank   map[string]int
+	CriticalPathRank  map[string]int
+	InDegreeRank      map[string]int
+	OutDegreeRank     map[string]int
+	Status            MetricStatus
+}
+
+// DefaultDiskCacheDir returns the analysis cache directory: $BV_CACHE_DIR if
+// set, else the user cache dir (e.g. ~/.cache/bv/analysis). Returns "" when
+// caching is disabled with BV_NO_CACHE=1 or no cache dir is available.
+func DefaultDiskCacheDir() string {
+	if os.Getenv("BV_NO_CACHE") == "1" {
+		return ""
+	}
+	if dir := os.Getenv("BV_CACHE_DIR"); dir != "" {
+		return dir
+	}
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(base, "bv", "analysis")
+}
+
+// NewDiskCache creates a disk cache in dir. A nil cache is returned for an
+// empty dir; all methods treat a nil cache as disabled.
+func NewDiskCache(dir string) *DiskCache {
+	if dir == "" {
+		return nil
+	}
+	return &DiskCache{dir: dir, maxEntries: DefaultDiskCacheEntries}
+}
+
+// ReadOnly returns a copy of d that loads entries but never saves one, for
+// sessions that must not write anything.
+func (d *DiskCache) ReadOnly() *DiskCache {
+	if d == nil {
+		return nil
+	}
+	c := *d
+	c.readOnly = true
+	return &c
+}
+
+// Dir returns the directory entries are stored in.
+func (d *DiskCache) Dir() string {
+	if d == nil {
+		return ""
+	}
+	return d.dir
+}
+
+func (d *DiskCache) entryPath(dataHash, configHash string) string {
+	return filepath.Join(d.dir, dataHash+"-"+configHash+".gob")
+}
+
+// Load returns the cached analysis and transitive dependent counts for the
+// given hashes. Missing, stale-version or unreadable entries are cache misses.
+func (d *DiskCache) Load(dataHash, configHash string) (*GraphStats, map[string]int, bool) {
+	if d == nil {
+		return nil, nil, false
+	}
+	f, err := os.Open(d.entryPath(dataHash, configHash))
+	if err != nil {
+		return nil, nil, false
+	}
+	defer f.Close()
+
+	var entry diskCacheEntry
+	if err := gob.NewDecoder(f).Decode(&entry); err != nil {
+		return nil, nil, false
+	}
+	if entry.Version != DiskCacheVersion || entry.DataHash != dataHash || entry.ConfigHash != configHash {
+		return nil, nil, false
+	}
+	return entry.Stats.restore(), entry.Dependents, true
+}
+
+// Save writes a completed analysis to disk (atomically) and prunes old entries.
+// Results with timed-out metrics are not persisted, so a slow run never pins
+// degraded scores for later launches.
+func (d *DiskCache) Save(dataHash, configHash string, stats *GraphStats, dependents map[string]int) error {
+	if d == nil || d.readOnly || stats == nil {
+		return nil
+	}
+	if stats.hasTimeouts() {
+		return nil
+	}
+	if err := os.MkdirAll(d.dir, 0755); err != nil {
+		return fmt.Errorf("creating cache dir: %w", err)
+	}
+
+	entry := diskCacheEntry{
+		Version:    DiskCacheVersion,
+		DataHash:   dataHash,
+		ConfigHash: configHash,
+		CreatedAt:  time.Now(),
+		Stats:      stats.snapshot(),
+		Dependents: dependents,
+	}
+
+	tmp, err := os.CreateTemp(d.dir, ".entry-*.tmp")
+	if err != nil {
+		return fmt.Errorf("creating cache entry: %w", err)
+	}
+	if err := gob.NewEncoder(tmp).Encode(&entry); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("encoding cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("writing cache entry: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), d.entryPath(dataHash, configHash)); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("installing cache entry: %w", err)
+	}
+
+	d.prune()
+	return nil
+}
+
+// prune removes all but the most recently written entries.
+func (d *DiskCache) prune() {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return
+	}
+	type cacheFile struct {
+		path    string
+		modTime time.Time
+	}
+	var files []cacheFile
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".gob") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, cacheFile{path: filepath.Join(d.dir, e.Name()), modTime: info.ModTime()})
+	}
+	if len(files) <= d.maxEntries {
+		return
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.After(files[j].modTime) })
+	for _, f := range files[d.maxEntries:] {
+		os.Remove(f.path)
+	}
+}
+
+// hasTimeouts reports whether any Phase 2 metric hit its time budget.
+func (s *GraphStats) hasTimeouts() bool {
+	st := s.Status()
+	for _, e := range []statusEntry{st.PageRank, st.Betweenness, st.Eigenvector, st.HITS, st.Critical, st.Cycles, st.KCore, st.Articulation, st.Slack} {
+		if e.State == "timeout" {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshot copies the stats into an encodable form. Call after Phase 2.
+func (s *GraphStats) snapshot() graphStatsSnapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return graphStatsSnapshot{
+		OutDegree:         s.OutDegree,
+		InDegree:          s.InDegree,
+		TopologicalOrder:  s.TopologicalOrder,
+		Density:           s.Density,
+		NodeCount:         s.NodeCount,
+		EdgeCount:         s.EdgeCount,
+		Config:            s.Config,
+		PageRank:          s.pageRank,
+		Betweenness:       s.betweenness,
+		Eigenvector:       s.eigenvector,
+		Hubs:              s.hubs,
+		Authorities:       s.authorities,
+		CriticalPathScore: s.criticalPathScore,
+		CoreNumber:        s.coreNumber,
+		Articulation:      s.articulation,
+		Slack:             s.slack,
+		Cycles:            s.cycles,
+		PageRankRank:      s.pageRankRank,
+		BetweennessRank:   s.betweennessRank,
+		EigenvectorRank:   s.eigenvectorRank,
+		HubsRank:          s.hubsRank,
+		AuthoritiesRank:   s.authoritiesRank,
+		CriticalPathRank:  s.criticalPathRank,
+		InDegreeRank:      s.inDegreeRank,
+		OutDegreeRank:     s.outDegreeRank,
+		Status:            s.status,
+	}
+}
+
+// restore rebuilds Phase-2-complete GraphStats from a snapshot.
+func (snap graphStatsSnapshot) restore() *GraphStats {
+	stats := &GraphStats{
+		OutDegree:         snap.OutDegree,
+		InDegree:          snap.InDegree,
+		TopologicalOrder:  snap.TopologicalOrder,
+		Density:           snap.Density,
+		NodeCount:         snap.NodeCount,
+		EdgeCount:         snap.EdgeCount,
+		Config:            snap.Config,
+		phase2Ready:       true,
+		phase2Done:        make(chan struct{}),
+		pageRank:          snap.PageRank,
+		betweenness:       snap.Betweenness,
+		eigenvector:       snap.Eigenvector,
+		hubs:              snap.Hubs,
+		authorities:       snap.Authorities,
+		criticalPathScore: snap.CriticalPathScore,
+		coreNumber:        snap.CoreNumber,
+		articulation:      snap.Articulation,
+		slack:             snap.Slack,
+		cycles:            snap.Cycles,
+		pageRankRank:      snap.PageRankRank,
+		betweennessRank:   snap.BetweennessRank,
+		eigenvectorRank:   snap.EigenvectorRank,
+		hubsRank:          snap.HubsRank,
+		authoritiesRank:   snap.AuthoritiesRank,
+		criticalPathRank:  snap.CriticalPathRank,
+		inDegreeRank:      snap.InDegreeRank,
+		outDegreeRank:     snap.OutDegreeRank,
+		status:            snap.Status,
+	}
+	close(stats.phase2Done)
+	return stats
+}
@@ -0,0 +1,131 @@
+package analysis_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func diskCacheIssues() []model.Issue {
+	return []model.Issue{
+		{ID: "A", Title: "Root", Status: model.StatusOpen},
+		{ID: "B", Title: "Mid", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "B", DependsOnID: "A", Type: model.DepBlocks}}},
+		{ID: "C", Title: "Leaf", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "C", DependsOnID: "B", Type: model.DepBlocks}}},
+	}
+}
+
+func waitForCacheFiles(t *testing.T, dir string, want int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		matches, _ := filepath.Glob(filepath.Join(dir, "*.gob"))
+		if len(matches) >= want {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected %d cache entries in %s", want, dir)
+}
+
+func TestDiskCache_WarmStartRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	issues := diskCacheIssues()
+
+	cold := analysis.NewCachedAnalyzer(issues, analysis.NewCache(time.Minute))
+	cold.SetDiskCache(analysis.NewDiskCache(dir))
+	coldStats := cold.AnalyzeAsync(context.Background())
+	coldStats.WaitForPhase2()
+	if cold.WasCacheHit() {
+		t.Fatal("first run should miss")
+	}
+	waitForCacheFiles(t, dir, 1)
+
+	// Fresh in-memory cache simulates a new process
+	warm := analysis.NewCachedAnalyzer(issues, analysis.NewCache(time.Minute))
+	warm.SetDiskCache(analysis.NewDiskCache(dir))
+	warmStats := warm.AnalyzeAsync(context.Background())
+	if !warm.WasCacheHit() {
+		t.Fatal("second run should load from disk")
+	}
+	if !warmStats.IsPhase2Ready() {
+		t.Fatal("restored stats should be Phase 2 complete")
+	}
+	warmStats.WaitForPhase2() // Must not block
+
+	if !reflect.DeepEqual(coldStats.PageRank(), warmStats.PageRank()) {
+		t.Errorf("PageRank mismatch: %v vs %v", coldStats.PageRank(), warmStats.PageRank())
+	}
+	if !reflect.DeepEqual(coldStats.CriticalPathScore(), warmStats.CriticalPathScore()) {
+		t.Errorf("critical path mismatch")
+	}
+	if !reflect.DeepEqual(coldStats.TopologicalOrder, warmStats.TopologicalOrder) {
+		t.Errorf("topological order mismatch")
+	}
+	if got := warm.TransitiveDependentCounts(); got["A"] != 2 || got["B"] != 1 {
+		t.Errorf("dependents = %v, want A:2 B:1", got)
+	}
+}
+
+func TestDiskCache_MissOnChangedData(t *testing.T) {
+	dir := t.TempDir()
+	issues := diskCacheIssues()
+	cache := analysis.NewDiskCache(dir)
+
+	ca := analysis.NewCachedAnalyzer(issues, analysis.NewCache(time.Minute))
+	stats := ca.Analyze()
+	if err := cache.Save(ca.DataHash(), "dynamic", &stats, nil); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	issues[0].Title = "Root (renamed)"
+	changed := analysis.NewCachedAnalyzer(issues, analysis.NewCache(time.Minute))
+	if _, _, ok := cache.Load(changed.DataHash(), "dynamic"); ok {
+		t.Error("edited data should not hit the cache")
+	}
+	if _, _, ok := cache.Load(ca.DataHash(), "dynamic"); !ok {
+		t.Error("original data should still hit")
+	}
+}
+
+func TestDiskCache_PrunesOldEntries(t *testing.T) {
+	dir := t.TempDir()
+	cache := analysis.NewDiskCache(dir)
+	stats := analysis.NewCachedAnalyzer(diskCacheIssues(), analysis.NewCache(time.Minute)).Analyze()
+
+	for i := 0; i < analysis.DefaultDiskCacheEntries+3; i++ {
+		if err := cache.Save(fmt.Sprintf("hash%02d", i), "dynamic", &stats, nil); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+		// Distinct mtimes so pruning order is deterministic
+		old := time.Now().Add(time.Duration(i-100) * time.Minute)
+		os.Chtimes(filepath.Join(dir, fmt.Sprintf("hash%02d-dynamic.gob", i)), old, old)
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "*.gob"))
+	if len(matches) != analysis.DefaultDiskCacheEntries {
+		t.Fatalf("expected %d entries after pruning, got %d", analysis.DefaultDiskCacheEntries, len(matches))
+	}
+	if _, _, ok := cache.Load("hash00", "dynamic"); ok {
+		t.Error("oldest entry should have been pruned")
+	}
+}
+
+func TestDiskCache_NilIsDisabled(t *testing.T) {
+	var cache *analysis.DiskCache
+	if cache != analysis.NewDiskCache("") {
+		t.Error("empty dir should yield a nil cache")
+	}
+	if _, _, ok := cache.Load("x", "y"); ok {
+		t.Error("nil cache should never hit")
+	}
+	if err := cache.Save("x", "y", nil, nil); err != nil {
+		t.Errorf("nil cache Save should be a no-op, got %v", err)
+	}
+}
@@ -138,7 +138,7 @@ func DetectDuplicates(issues []model.Issue, config DuplicateConfig) []Suggestion
 
 			// Skip closed vs open pairs if configured
 			if config.IgnoreClosedVsOpen {
-				if (issue1.Status == model.StatusClosed) != (issue2.Status == model.StatusClosed) {
+				if (issue1.Status.IsClosed()) != (issue2.Status.IsClosed()) {
 					continue
 				}
 			}
@@ -185,7 +185,7 @@ func DetectDuplicates(issues []model.Issue, config DuplicateConfig) []Suggestion
 		).WithRelatedBead(pair.Issue2).WithMetadata("method", pair.Method)
 
 		// Add action command if both are open
-		if issue1.Status != model.StatusClosed && issue2.Status != model.StatusClosed {
+		if !issue1.Status.IsClosed() && !issue2.Status.IsClosed() {
 			sug = sug.WithAction(fmt.Sprintf("bd dep add %s %s --type=related", pair.Issue1, pair.Issue2))
 		}
 
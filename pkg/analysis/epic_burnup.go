@@ -0,0 +1,134 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DefaultBurnupEpics caps how many epics get a burnup chart.
+const DefaultBurnupEpics = 4
+
+// EpicBurnup tracks an epic's completed work against its total scope week by
+// week, so scope creep and progress show up in one picture.
+type EpicBurnup struct {
+	EpicID     string      `json:"epic_id"`
+	Title      string      `json:"title"`
+	WeekStarts []time.Time `json:"week_starts"`
+	Scope      []int       `json:"scope"` // Issues in the epic at the end of each week
+	Done       []int       `json:"done"`  // Of those, closed by the end of each week
+
+	// From the kickoff snapshot; Planned is 0 for epics without one
+	KickoffAt time.Time `json:"kickoff_at,omitzero"`
+	Planned   int       `json:"planned"`
+}
+
+// Added returns how far the current scope exceeds the kickoff plan (negative
+// when it shrank), or 0 without a kickoff snapshot.
+func (b EpicBurnup) Added() int {
+	if b.Planned == 0 || len(b.Scope) == 0 {
+		return 0
+	}
+	return b.Scope[len(b.Scope)-1] - b.Planned
+}
+
+// ComputeEpicBurnups returns weekly burnup series for the epics with the most
+// work in the window (open descendants now plus descendants closed during
+// it), capped at limit epics. Scope counts the epic's current descendants
+// from their creation; descendants recorded in the kickoff snapshot but
+// since moved out count until their last update. scope may be nil.
+func ComputeEpicBurnups(issues []model.Issue, scope *EpicScopeData, weeks int, now time.Time, limit int) []EpicBurnup {
+	if weeks <= 0 {
+		weeks = DefaultTrendWeeks
+	}
+	starts := trendWeekStarts(weeks, now)
+	children := parentChildIndex(issues)
+	byID := make(map[string]model.Issue, len(issues))
+	for _, iss := range issues {
+		byID[iss.ID] = iss
+	}
+
+	type candidate struct {
+		burnup   EpicBurnup
+		activity int
+	}
+	var candidates []candidate
+	for _, epic := range issues {
+		if epic.IssueType != model.TypeEpic {
+			continue
+		}
+		idx := descendantIndexes(epic.ID, issues, children)
+		if len(idx) == 0 {
+			continue
+		}
+
+		// Members and, for those moved out after kickoff, when they left
+		members := make([]model.Issue, 0, len(idx))
+		leftAt := make(map[string]time.Time)
+		current := make(map[string]bool, len(idx))
+		for _, j := range idx {
+			members = append(members, issues[j])
+			current[issues[j].ID] = true
+		}
+		b := EpicBurnup{EpicID: epic.ID, Title: epic.Title, WeekStarts: starts, Scope: make([]int, weeks), Done: make([]int, weeks)}
+		if scope != nil {
+			if snap := scope.Epics[epic.ID]; snap != nil && !snap.KickoffAt.IsZero() {
+				b.KickoffAt = snap.KickoffAt
+				b.Planned = len(snap.Members)
+				for _, id := range snap.Members {
+					if removed, ok := byID[id]; ok && !current[id] {
+						members = append(members, removed)
+						leftAt[id] = removed.UpdatedAt
+					}
+				}
+			}
+		}
+
+		activity := 0
+		for _, iss := range members {
+			if _, gone := leftAt[iss.ID]; gone {
+				continue
+			}
+			closedAt := issueClosedAt(iss)
+			if closedAt.IsZero() || !closedAt.Before(starts[0]) {
+				activity++
+			}
+		}
+		if activity == 0 {
+			continue
+		}
+
+		for i := range starts {
+			end := weekEnd(starts, i, now)
+			for _, iss := range members {
+				if iss.CreatedAt.IsZero() || !iss.CreatedAt.Before(end) {
+					continue
+				}
+				if left, gone := leftAt[iss.ID]; gone && left.Before(end) {
+					continue
+				}
+				b.Scope[i]++
+				if closedAt := issueClosedAt(iss); !closedAt.IsZero() && closedAt.Before(end) {
+					b.Done[i]++
+				}
+			}
+		}
+		candidates = append(candidates, candidate{burnup: b, activity: activity})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].activity != candidates[j].activity {
+			return candidates[i].activity > candidates[j].activity
+		}
+		return candidates[i].burnup.EpicID < candidates[j].burnup.EpicID
+	})
+	var result []EpicBurnup
+	for _, c := range candidates {
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+		result = append(result, c.burnup)
+	}
+	return result
+}
@@ -0,0 +1,54 @@
+package analysis
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeEpicBurnups(t *testing.T) {
+	now := time.Date(2025, 6, 11, 12, 0, 0, 0, time.UTC) // Wednesday
+	daysAgo := func(d int) time.Time { return now.Add(-time.Duration(d) * 24 * time.Hour) }
+	closed := func(d int) *time.Time { ts := daysAgo(d); return &ts }
+	childOf := func(parent string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: parent, Type: model.DepParentChild}}
+	}
+
+	issues := []model.Issue{
+		{ID: "E", Title: "Epic", IssueType: model.TypeEpic, Status: model.StatusOpen, CreatedAt: daysAgo(30)},
+		{ID: "a", Status: model.StatusClosed, CreatedAt: daysAgo(20), ClosedAt: closed(9), Dependencies: childOf("E")},
+		{ID: "b", Status: model.StatusOpen, CreatedAt: daysAgo(20), Dependencies: childOf("E")},
+		{ID: "c", Status: model.StatusOpen, CreatedAt: daysAgo(1), Dependencies: childOf("E")},
+		// Planned at kickoff, moved out of the epic eight days ago
+		{ID: "gone", Status: model.StatusOpen, CreatedAt: daysAgo(20), UpdatedAt: daysAgo(8)},
+		{ID: "Done", Title: "Finished", IssueType: model.TypeEpic, Status: model.StatusClosed, CreatedAt: daysAgo(90)},
+		{ID: "old", Status: model.StatusClosed, CreatedAt: daysAgo(90), ClosedAt: closed(80), Dependencies: childOf("Done")},
+	}
+	scope := &EpicScopeData{Epics: map[string]*EpicScopeSnapshot{
+		"E": {KickoffAt: daysAgo(10), Members: []string{"a", "b", "gone"}},
+	}}
+
+	got := ComputeEpicBurnups(issues, scope, 3, now, 5)
+	if len(got) != 1 || got[0].EpicID != "E" {
+		t.Fatalf("burnups = %+v, want only E (inactive epic dropped)", got)
+	}
+	b := got[0]
+	// Weeks: May 26 (a, b, gone open), Jun 2 (a closed, gone left), Jun 9 (c added)
+	if want := []int{3, 2, 3}; !slices.Equal(b.Scope, want) {
+		t.Errorf("scope = %v, want %v", b.Scope, want)
+	}
+	if want := []int{0, 1, 1}; !slices.Equal(b.Done, want) {
+		t.Errorf("done = %v, want %v", b.Done, want)
+	}
+	if b.Planned != 3 || b.Added() != 0 || !b.KickoffAt.Equal(daysAgo(10)) {
+		t.Errorf("planned=%d added=%d kickoff=%v, want 3, 0, ten days ago", b.Planned, b.Added(), b.KickoffAt)
+	}
+
+	// Without snapshots only the current descendants count
+	got = ComputeEpicBurnups(issues, nil, 3, now, 5)
+	if want := []int{2, 2, 3}; !slices.Equal(got[0].Scope, want) || got[0].Planned != 0 || got[0].Added() != 0 {
+		t.Errorf("without scope: %+v, want scope %v and no plan", got[0], want)
+	}
+}
@@ -0,0 +1,109 @@
+package analysis
+
+import "github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+// EpicRollup compares an epic's declared status with the status its direct
+// children imply. Child epics count with their own derived status.
+type EpicRollup struct {
+	Declared model.Status
+	Derived  model.Status
+
+	Children   int
+	Closed     int
+	InProgress int
+	Blocked    int // Open children marked blocked or waiting on an open blocker
+}
+
+// Mismatch reports whether the declared status disagrees with the children
+func (r EpicRollup) Mismatch() bool {
+	return r.Declared.Column() != r.Derived
+}
+
+// ComputeEpicRollups derives the effective status of every epic with
+// children, keyed by epic ID:
+//   - closed when every child is closed
+//   - blocked when every open child is blocked
+//   - in_progress when a child is in progress or some are already closed
+//   - open otherwise
+func ComputeEpicRollups(issues []model.Issue) map[string]EpicRollup {
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	children := parentChildIndex(issues)
+
+	rollups := make(map[string]EpicRollup)
+	visiting := make(map[string]bool)
+
+	// effective is an issue's own status, or its derived status if it is an
+	// epic with children; open issues with an open blocker count as blocked
+	var rollup func(epic *model.Issue) (EpicRollup, bool)
+	effective := func(iss *model.Issue) model.Status {
+		if iss.IssueType == model.TypeEpic {
+			if r, ok := rollup(iss); ok {
+				return r.Derived
+			}
+		}
+		status := iss.Status.Column()
+		if status == model.StatusOpen {
+			for _, dep := range iss.Dependencies {
+				if dep == nil || !dep.Type.IsBlocking() {
+					continue
+				}
+				if blocker, ok := byID[dep.DependsOnID]; ok && !blocker.Status.IsClosed() {
+					return model.StatusBlocked
+				}
+			}
+		}
+		return status
+	}
+	rollup = func(epic *model.Issue) (EpicRollup, bool) {
+		if r, ok := rollups[epic.ID]; ok {
+			return r, true
+		}
+		if visiting[epic.ID] || len(children[epic.ID]) == 0 {
+			return EpicRollup{}, false // Childless, or a parent-child cycle
+		}
+		visiting[epic.ID] = true
+		defer delete(visiting, epic.ID)
+
+		r := EpicRollup{Declared: epic.Status}
+		for _, i := range children[epic.ID] {
+			child := &issues[i]
+			if child.ID == epic.ID {
+				continue
+			}
+			r.Children++
+			switch effective(child) {
+			case model.StatusClosed:
+				r.Closed++
+			case model.StatusInProgress:
+				r.InProgress++
+			case model.StatusBlocked:
+				r.Blocked++
+			}
+		}
+		open := r.Children - r.Closed
+		switch {
+		case r.Children == 0:
+			return EpicRollup{}, false
+		case open == 0:
+			r.Derived = model.StatusClosed
+		case r.Blocked == open:
+			r.Derived = model.StatusBlocked
+		case r.InProgress > 0 || r.Closed > 0:
+			r.Derived = model.StatusInProgress
+		default:
+			r.Derived = model.StatusOpen
+		}
+		rollups[epic.ID] = r
+		return r, true
+	}
+
+	for i := range issues {
+		if issues[i].IssueType == model.TypeEpic {
+			rollup(&issues[i])
+		}
+	}
+	return rollups
+}
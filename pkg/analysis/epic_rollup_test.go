@@ -0,0 +1,75 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeEpicRollups(t *testing.T) {
+	child := func(id, parent string, status model.Status, blockers ...string) model.Issue {
+		iss := model.Issue{ID: id, Status: status, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: id, DependsOnID: parent, Type: model.DepParentChild}}}
+		for _, b := range blockers {
+			iss.Dependencies = append(iss.Dependencies, &model.Dependency{IssueID: id, DependsOnID: b, Type: model.DepBlocks})
+		}
+		return iss
+	}
+	epic := func(id string, status model.Status) model.Issue {
+		return model.Issue{ID: id, Status: status, IssueType: model.TypeEpic}
+	}
+	sub := epic("sub", model.StatusOpen)
+	sub.Dependencies = []*model.Dependency{{IssueID: "sub", DependsOnID: "mixed", Type: model.DepParentChild}}
+
+	issues := []model.Issue{
+		epic("done", model.StatusOpen),
+		child("d1", "done", model.StatusClosed),
+		child("d2", "done", model.StatusClosed),
+
+		epic("stuck", model.StatusInProgress),
+		child("s1", "stuck", model.StatusBlocked),
+		child("s2", "stuck", model.StatusOpen, "outside"),
+		child("s3", "stuck", model.StatusClosed),
+		{ID: "outside", Status: model.StatusOpen},
+
+		epic("mixed", model.StatusInProgress),
+		child("m1", "mixed", model.StatusOpen),
+		sub,
+		child("x1", "sub", model.StatusInProgress),
+
+		epic("fresh", model.StatusOpen),
+		child("f1", "fresh", model.StatusOpen, "d1"), // Closed blocker
+
+		epic("empty", model.StatusOpen),
+	}
+
+	rollups := analysis.ComputeEpicRollups(issues)
+	tests := []struct {
+		id       string
+		derived  model.Status
+		mismatch bool
+	}{
+		{"done", model.StatusClosed, true},
+		{"stuck", model.StatusBlocked, true},
+		{"sub", model.StatusInProgress, true},
+		{"mixed", model.StatusInProgress, false}, // Via the in-progress sub-epic
+		{"fresh", model.StatusOpen, false},
+	}
+	for _, tt := range tests {
+		r, ok := rollups[tt.id]
+		if !ok {
+			t.Errorf("%s: no rollup", tt.id)
+			continue
+		}
+		if r.Derived != tt.derived || r.Mismatch() != tt.mismatch {
+			t.Errorf("%s: derived %s mismatch %v, want %s %v", tt.id, r.Derived, r.Mismatch(), tt.derived, tt.mismatch)
+		}
+	}
+	if r := rollups["stuck"]; r.Children != 3 || r.Closed != 1 || r.Blocked != 2 {
+		t.Errorf("stuck counts = %+v", r)
+	}
+	if _, ok := rollups["empty"]; ok {
+		t.Error("epics without children have no rollup")
+	}
+}
@@ -0,0 +1,192 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// EpicScopeFile is the name of the epic scope snapshot sidecar file
+const EpicScopeFile = "epic_scope.json"
+
+// EpicScopeSnapshot records which issues belonged to an epic when work started.
+// Until kickoff the member list follows the epic (planning is not scope change);
+// once KickoffAt is set the list is frozen and later differences are reported.
+type EpicScopeSnapshot struct {
+	KickoffAt time.Time `json:"kickoff_at,omitempty"`
+	Members   []string  `json:"members"`
+}
+
+// EpicScopeData holds scope snapshots for every epic in a repository
+type EpicScopeData struct {
+	Version   string                        `json:"version"`
+	UpdatedAt time.Time                     `json:"updated_at"`
+	Epics     map[string]*EpicScopeSnapshot `json:"epics"`
+}
+
+// EpicScopeChange lists issues added to or removed from an epic since kickoff
+type EpicScopeChange struct {
+	KickoffAt time.Time `json:"kickoff_at"`
+	Planned   int       `json:"planned"` // Members at kickoff
+	Added     []string  `json:"added,omitempty"`
+	Removed   []string  `json:"removed,omitempty"`
+}
+
+// HasChanges reports whether the epic's scope moved since kickoff
+func (c EpicScopeChange) HasChanges() bool {
+	return len(c.Added) > 0 || len(c.Removed) > 0
+}
+
+// LoadEpicScope loads epic scope snapshots from the beads directory.
+// A missing file yields empty data.
+func LoadEpicScope(beadsDir string) (*EpicScopeData, error) {
+	empty := &EpicScopeData{Version: "1.0", Epics: make(map[string]*EpicScopeSnapshot)}
+	data, err := os.ReadFile(filepath.Join(beadsDir, EpicScopeFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return empty, nil
+		}
+		return nil, fmt.Errorf("failed to read epic scope file: %w", err)
+	}
+
+	var scope EpicScopeData
+	if err := json.Unmarshal(data, &scope); err != nil {
+		return nil, fmt.Errorf("failed to parse epic scope file: %w", err)
+	}
+	if scope.Epics == nil {
+		scope.Epics = make(map[string]*EpicScopeSnapshot)
+	}
+	return &scope, nil
+}
+
+// Save persists epic scope snapshots to the beads directory
+func (d *EpicScopeData) Save(beadsDir string) error {
+	d.UpdatedAt = time.Now()
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal epic scope: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(beadsDir, EpicScopeFile), data, 0644); err != nil {
+		return fmt.Errorf("failed to write epic scope file: %w", err)
+	}
+	return nil
+}
+
+// epicWorkStarted returns when work on an epic began: the earliest closure or
+// in-progress update among its descendants. Zero if nothing has started.
+func epicWorkStarted(descendants []model.Issue) time.Time {
+	var started time.Time
+	for _, iss := range descendants {
+		ts := issueClosedAt(iss)
+		if ts.IsZero() && iss.Status.Column() == model.StatusInProgress {
+			ts = iss.UpdatedAt
+		}
+		if !ts.IsZero() && (started.IsZero() || ts.Before(started)) {
+			started = ts
+		}
+	}
+	return started
+}
+
+// Observe snapshots every epic's current membership and reports whether the
+// data changed (and should be saved). Epics first seen after work started get
+// a baseline reconstructed from creation times.
+func (d *EpicScopeData) Observe(issues []model.Issue) bool {
+	children := parentChildIndex(issues)
+	changed := false
+
+	for _, epic := range issues {
+		if epic.IssueType != model.TypeEpic {
+			continue
+		}
+		idx := descendantIndexes(epic.ID, issues, children)
+		if len(idx) == 0 {
+			continue
+		}
+		descendants := make([]model.Issue, len(idx))
+		for i, j := range idx {
+			descendants[i] = issues[j]
+		}
+		started := epicWorkStarted(descendants)
+
+		snap := d.Epics[epic.ID]
+		if snap != nil && !snap.KickoffAt.IsZero() {
+			continue // Frozen at kickoff
+		}
+		if snap == nil {
+			snap = &EpicScopeSnapshot{}
+			d.Epics[epic.ID] = snap
+			changed = true
+		}
+
+		// Before kickoff the snapshot tracks the plan as it evolves; at kickoff
+		// anything created later is scope change, not plan.
+		members := make(map[string]bool, len(snap.Members)+len(descendants))
+		if !started.IsZero() {
+			for _, id := range snap.Members {
+				members[id] = true
+			}
+		}
+		for _, iss := range descendants {
+			if started.IsZero() || !iss.CreatedAt.After(started) {
+				members[iss.ID] = true
+			}
+		}
+		ids := make([]string, 0, len(members))
+		for id := range members {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		if !started.IsZero() {
+			snap.KickoffAt = started
+			changed = true
+		}
+		if !slices.Equal(snap.Members, ids) {
+			snap.Members = ids
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Changes compares each kicked-off epic's snapshot with its current
+// descendants. Epics without a kickoff are omitted.
+func (d *EpicScopeData) Changes(issues []model.Issue) map[string]EpicScopeChange {
+	if d == nil {
+		return nil
+	}
+	children := parentChildIndex(issues)
+	result := make(map[string]EpicScopeChange)
+	for epicID, snap := range d.Epics {
+		if snap.KickoffAt.IsZero() {
+			continue
+		}
+		current := make(map[string]bool)
+		for _, i := range descendantIndexes(epicID, issues, children) {
+			current[issues[i].ID] = true
+		}
+		planned := make(map[string]bool, len(snap.Members))
+		change := EpicScopeChange{KickoffAt: snap.KickoffAt, Planned: len(snap.Members)}
+		for _, id := range snap.Members {
+			planned[id] = true
+			if !current[id] {
+				change.Removed = append(change.Removed, id)
+			}
+		}
+		for id := range current {
+			if !planned[id] {
+				change.Added = append(change.Added, id)
+			}
+		}
+		sort.Strings(change.Added)
+		result[epicID] = change
+	}
+	return result
+}
@@ -0,0 +1,152 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestEpicScopeObserveAndChanges(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return base.Add(time.Duration(n) * 24 * time.Hour) }
+	child := []*model.Dependency{{DependsOnID: "E", Type: model.DepParentChild}}
+
+	issues := []model.Issue{
+		{ID: "E", IssueType: model.TypeEpic, Status: model.StatusOpen, CreatedAt: day(0)},
+		{ID: "a", Status: model.StatusOpen, CreatedAt: day(0), Dependencies: child},
+		{ID: "b", Status: model.StatusOpen, CreatedAt: day(1), Dependencies: child},
+	}
+
+	scope := &EpicScopeData{Epics: make(map[string]*EpicScopeSnapshot)}
+	if !scope.Observe(issues) {
+		t.Fatal("first observation should record a snapshot")
+	}
+	if scope.Observe(issues) {
+		t.Error("unchanged issues should not change the snapshot")
+	}
+	if len(scope.Changes(issues)) != 0 {
+		t.Error("epics without kickoff should report no changes")
+	}
+
+	// Planning before kickoff: c joins the plan, b is dropped
+	issues[2].Dependencies = nil
+	issues = append(issues, model.Issue{ID: "c", Status: model.StatusOpen, CreatedAt: day(2), Dependencies: child})
+	scope.Observe(issues)
+
+	// Kickoff: a closes on day 3; d is added afterwards and c is re-parented away
+	closed := day(3)
+	issues[1].Status, issues[1].ClosedAt = model.StatusClosed, &closed
+	scope.Observe(issues)
+	issues[3].Dependencies = nil
+	issues = append(issues, model.Issue{ID: "d", Status: model.StatusOpen, CreatedAt: day(5), Dependencies: child})
+	scope.Observe(issues)
+
+	change, ok := scope.Changes(issues)["E"]
+	if !ok {
+		t.Fatal("expected a scope change entry for E")
+	}
+	if !change.KickoffAt.Equal(day(3)) || change.Planned != 2 {
+		t.Errorf("kickoff=%v planned=%d, want day 3 and 2 planned", change.KickoffAt, change.Planned)
+	}
+	if len(change.Added) != 1 || change.Added[0] != "d" || len(change.Removed) != 1 || change.Removed[0] != "c" {
+		t.Errorf("added=%v removed=%v, want [d] and [c]", change.Added, change.Removed)
+	}
+
+	dir := t.TempDir()
+	if err := scope.Save(dir); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	loaded, err := LoadEpicScope(dir)
+	if err != nil {
+		t.Fatalf("LoadEpicScope: %v", err)
+	}
+	if got := loaded.Changes(issues)["E"]; len(got.Added) != 1 || len(got.Removed) != 1 {
+		t.Errorf("reloaded change = %+v", got)
+	}
+}
+
+func TestEpicScopeFirstSeenAfterKickoff(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	closed := base.Add(48 * time.Hour)
+	child := []*model.Dependency{{DependsOnID: "E", Type: model.DepParentChild}}
+	issues := []model.Issue{
+		{ID: "E", IssueType: model.TypeEpic, Status: model.StatusOpen, CreatedAt: base},
+		{ID: "a", Status: model.StatusClosed, CreatedAt: base, ClosedAt: &closed, Dependencies: child},
+		{ID: "late", Status: model.StatusOpen, CreatedAt: base.Add(96 * time.Hour), Dependencies: child},
+	}
+
+	scope, err := LoadEpicScope(t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadEpicScope: %v", err)
+	}
+	scope.Observe(issues)
+	change := scope.Changes(issues)["E"]
+	if change.Planned != 1 || len(change.Added) != 1 || change.Added[0] != "late" {
+		t.Errorf("reconstructed change = %+v, want late added to 1 planned", change)
+	}
+}
+
+func TestEpicScopeReloadObservesNoChange(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	closed := base.Add(48 * time.Hour)
+	child := func(epic string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: epic, Type: model.DepParentChild}}
+	}
+	issues := []model.Issue{
+		{ID: "P", IssueType: model.TypeEpic, Status: model.StatusOpen, CreatedAt: base},
+		{ID: "p1", Status: model.StatusOpen, CreatedAt: base, Dependencies: child("P")},
+		{ID: "K", IssueType: model.TypeEpic, Status: model.StatusOpen, CreatedAt: base},
+		{ID: "k1", Status: model.StatusClosed, CreatedAt: base, ClosedAt: &closed, Dependencies: child("K")},
+		{ID: "k2", Status: model.StatusOpen, CreatedAt: base.Add(96 * time.Hour), Dependencies: child("K")},
+		{ID: "E", IssueType: model.TypeEpic, Status: model.StatusOpen, CreatedAt: base},
+	}
+
+	dir := t.TempDir()
+	scope, err := LoadEpicScope(dir)
+	if err != nil {
+		t.Fatalf("LoadEpicScope: %v", err)
+	}
+	if !scope.Observe(issues) {
+		t.Fatal("first observation should record snapshots")
+	}
+	if err := scope.Save(dir); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// A later session sees the same issues: neither the planning snapshot (P)
+	// nor the reconstructed baseline (K) should ask for another write
+	loaded, err := LoadEpicScope(dir)
+	if err != nil {
+		t.Fatalf("LoadEpicScope: %v", err)
+	}
+	if loaded.Observe(issues) {
+		t.Errorf("reloaded scope reported a change: %+v", loaded.Epics)
+	}
+	if _, ok := loaded.Epics["E"]; ok {
+		t.Error("an epic without children should not be snapshotted")
+	}
+}
+
+func TestEpicScopeCustomInProgressStartsWork(t *testing.T) {
+	model.RegisterCustomStatuses([]model.CustomStatus{{Name: "review", Column: model.StatusInProgress}})
+	defer model.RegisterCustomStatuses(nil)
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	child := []*model.Dependency{{DependsOnID: "E", Type: model.DepParentChild}}
+	issues := []model.Issue{
+		{ID: "E", IssueType: model.TypeEpic, Status: model.StatusOpen, CreatedAt: base},
+		{ID: "a", Status: "review", CreatedAt: base, UpdatedAt: base.Add(48 * time.Hour), Dependencies: child},
+		{ID: "late", Status: model.StatusOpen, CreatedAt: base.Add(96 * time.Hour), Dependencies: child},
+	}
+
+	scope, err := LoadEpicScope(t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadEpicScope: %v", err)
+	}
+	scope.Observe(issues)
+	change := scope.Changes(issues)["E"]
+	if change.Planned != 1 || len(change.Added) != 1 || change.Added[0] != "late" {
+		t.Errorf("change = %+v, want review to start work and late added to 1 planned", change)
+	}
+}
@@ -18,7 +18,8 @@ type ETAEstimate struct {
 	ETADate               time.Time `json:"eta_date"`
 	ETADateLow            time.Time `json:"eta_date_low,omitempty"`
 	ETADateHigh           time.Time `json:"eta_date_high,omitempty"`
-	Confidence            float64   `json:"confidence"` // 0..1
+	Confidence            float64   `json:"confidence"`       // 0..1
+	ConfidenceGrade       string    `json:"confidence_grade"` // A..D (see ConfidenceGrade)
 	VelocityMinutesPerDay float64   `json:"velocity_minutes_per_day"`
 	Agents                int       `json:"agents"`
 	Factors               []string  `json:"factors,omitempty"`
@@ -85,6 +86,7 @@ func EstimateETAForIssue(issues []model.Issue, stats *GraphStats, issueID string
 		ETADateLow:            etaLow,
 		ETADateHigh:           etaHigh,
 		Confidence:            confidence,
+		ConfidenceGrade:       ConfidenceGrade(confidence),
 		VelocityMinutesPerDay: velocityPerDay,
 		Agents:                agents,
 		Factors:               factors,
@@ -187,7 +189,7 @@ func velocityMinutesPerDayForLabel(issues []model.Issue, label string, since tim
 	samples := 0
 
 	for _, iss := range issues {
-		if iss.Status != model.StatusClosed {
+		if !iss.Status.IsClosed() {
 			continue
 		}
 
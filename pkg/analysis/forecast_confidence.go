@@ -0,0 +1,98 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// forecastVolatilityDays is how recent an issue must be to count as churn.
+const forecastVolatilityDays = 14
+
+// forecastFullSamples is the number of recent closures at which history is
+// considered fully representative (matches the ETA velocity window's top tier).
+const forecastFullSamples = 15
+
+// ForecastQuality grades how meaningful a set of forecasts is, based on the
+// data they were derived from rather than on the forecasts themselves.
+type ForecastQuality struct {
+	Grade            string   `json:"grade"`             // A (trustworthy) .. D (guesswork)
+	Score            float64  `json:"score"`             // 0..1
+	EstimateCoverage float64  `json:"estimate_coverage"` // Share of forecast issues with explicit estimates
+	VelocitySamples  int      `json:"velocity_samples"`  // Closures in the last 30 days
+	Volatility       float64  `json:"volatility"`        // Share of open work created in the last 14 days
+	Reasons          []string `json:"reasons,omitempty"` // What is holding the grade down
+}
+
+// ConfidenceGrade maps a 0..1 confidence score to a letter grade.
+func ConfidenceGrade(score float64) string {
+	switch {
+	case score >= 0.75:
+		return "A"
+	case score >= 0.55:
+		return "B"
+	case score >= 0.35:
+		return "C"
+	default:
+		return "D"
+	}
+}
+
+// AssessForecastQuality scores forecasts for targets (the open issues being
+// forecast) by estimate coverage, recent closure history across all issues,
+// and how much of the open work is brand new (a graph still being shaped).
+func AssessForecastQuality(issues, targets []model.Issue, now time.Time) ForecastQuality {
+	var q ForecastQuality
+
+	open, estimated, recent := 0, 0, 0
+	volatilitySince := now.Add(-forecastVolatilityDays * 24 * time.Hour)
+	for _, iss := range targets {
+		if iss.Status.IsClosed() {
+			continue
+		}
+		open++
+		if iss.EstimatedMinutes != nil && *iss.EstimatedMinutes > 0 {
+			estimated++
+		}
+		if iss.CreatedAt.After(volatilitySince) {
+			recent++
+		}
+	}
+	if open > 0 {
+		q.EstimateCoverage = float64(estimated) / float64(open)
+		q.Volatility = float64(recent) / float64(open)
+	}
+
+	velocitySince := now.Add(-30 * 24 * time.Hour)
+	for _, iss := range issues {
+		if closedAt := issueClosedAt(iss); !closedAt.IsZero() && !closedAt.Before(velocitySince) {
+			q.VelocitySamples++
+		}
+	}
+
+	sampleScore := min(1.0, float64(q.VelocitySamples)/forecastFullSamples)
+	q.Score = 0.4*q.EstimateCoverage + 0.35*sampleScore + 0.25*(1-q.Volatility)
+	q.Grade = ConfidenceGrade(q.Score)
+
+	if q.EstimateCoverage < 0.5 {
+		q.Reasons = append(q.Reasons, fmt.Sprintf("%.0f%% of issues have estimates", q.EstimateCoverage*100))
+	}
+	if q.VelocitySamples < 5 {
+		q.Reasons = append(q.Reasons, fmt.Sprintf("only %d closures in 30d", q.VelocitySamples))
+	}
+	if q.Volatility > 0.3 {
+		q.Reasons = append(q.Reasons, fmt.Sprintf("%.0f%% of open work is under %dd old", q.Volatility*100, forecastVolatilityDays))
+	}
+	return q
+}
+
+// Summary renders the grade with its main caveats, e.g.
+// "C (40% of issues have estimates; only 3 closures in 30d)".
+func (q ForecastQuality) Summary() string {
+	if len(q.Reasons) == 0 {
+		return q.Grade
+	}
+	return fmt.Sprintf("%s (%s)", q.Grade, strings.Join(q.Reasons, "; "))
+}
@@ -0,0 +1,53 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestConfidenceGrade(t *testing.T) {
+	cases := map[float64]string{0.9: "A", 0.75: "A", 0.6: "B", 0.4: "C", 0.1: "D"}
+	for score, want := range cases {
+		if got := ConfidenceGrade(score); got != want {
+			t.Errorf("ConfidenceGrade(%v) = %s, want %s", score, got, want)
+		}
+	}
+}
+
+func TestAssessForecastQuality(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.Add(-time.Duration(d) * 24 * time.Hour) }
+	est := func(m int) *int { return &m }
+
+	var history []model.Issue
+	for i := 0; i < forecastFullSamples; i++ {
+		closedAt := daysAgo(i + 1)
+		history = append(history, model.Issue{ID: "done", Status: model.StatusClosed, CreatedAt: daysAgo(60), ClosedAt: &closedAt})
+	}
+
+	// Well-estimated, established backlog with plenty of history
+	solid := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, CreatedAt: daysAgo(40), EstimatedMinutes: est(60)},
+		{ID: "b", Status: model.StatusOpen, CreatedAt: daysAgo(40), EstimatedMinutes: est(90)},
+	}
+	q := AssessForecastQuality(append(history, solid...), solid, now)
+	if q.Grade != "A" || len(q.Reasons) != 0 || q.Summary() != "A" {
+		t.Errorf("solid forecast = %+v, want grade A without caveats", q)
+	}
+
+	// No estimates, no history, all brand new
+	shaky := []model.Issue{
+		{ID: "x", Status: model.StatusOpen, CreatedAt: daysAgo(1)},
+		{ID: "y", Status: model.StatusOpen, CreatedAt: daysAgo(2)},
+	}
+	q = AssessForecastQuality(shaky, shaky, now)
+	if q.Grade != "D" || q.EstimateCoverage != 0 || q.Volatility != 1 {
+		t.Errorf("shaky forecast = %+v, want grade D, no coverage, full volatility", q)
+	}
+	if s := q.Summary(); !strings.HasPrefix(s, "D (") || !strings.Contains(s, "only 0 closures") {
+		t.Errorf("Summary = %q", s)
+	}
+}
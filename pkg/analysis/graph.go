@@ -1415,7 +1415,7 @@ func (a *Analyzer) GetActionableIssues() []model.Issue {
 
 	for _, id := range ids {
 		issue := a.issueMap[id]
-		if issue.Status == model.StatusClosed {
+		if issue.Status.IsClosed() {
 			continue
 		}
 
@@ -1430,7 +1430,7 @@ func (a *Analyzer) GetActionableIssues() []model.Issue {
 				continue
 			}
 
-			if blocker.Status != model.StatusClosed {
+			if !blocker.Status.IsClosed() {
 				isBlocked = true
 				break
 			}
@@ -1481,7 +1481,7 @@ func (a *Analyzer) GetOpenBlockers(issueID string) []string {
 	for _, dep := range issue.Dependencies {
 		if dep != nil && dep.Type.IsBlocking() {
 			if blocker, exists := a.issueMap[dep.DependsOnID]; exists {
-				if blocker.Status != model.StatusClosed {
+				if !blocker.Status.IsClosed() {
 					openBlockers = append(openBlockers, dep.DependsOnID)
 				}
 			}
@@ -1629,7 +1629,7 @@ func (a *Analyzer) GetBlockerChain(issueID string) *BlockerChainResult {
 func (a *Analyzer) countBlockedBy(issueID string) int {
 	count := 0
 	for _, issue := range a.issueMap {
-		if issue.Status == model.StatusClosed {
+		if issue.Status.IsClosed() {
 			continue
 		}
 		for _, dep := range issue.Dependencies {
@@ -0,0 +1,180 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// GraphRank is one entry of a GraphSummary top list
+type GraphRank struct {
+	ID    string  `json:"id"`
+	Title string  `json:"title"`
+	Value float64 `json:"value"`
+}
+
+// GraphSummary condenses GraphStats into repository health numbers that can
+// be tracked over time (bv stats)
+type GraphSummary struct {
+	Issues       int     `json:"issues"`
+	Edges        int     `json:"edges"` // Blocking dependencies
+	Density      float64 `json:"density"`
+	Orphans      int     `json:"orphans"` // Issues with no blocking link either way
+	Cycles       int     `json:"cycles"`
+	LongestChain int     `json:"longest_chain"` // Issues on the longest blocker chain
+
+	PageRank    []GraphRank `json:"pagerank"`
+	Betweenness []GraphRank `json:"betweenness"`
+	InDegree    []GraphRank `json:"in_degree"`  // Most depended on
+	OutDegree   []GraphRank `json:"out_degree"` // Most dependencies
+
+	// Chains are the longest blocker chains, each listed from the first
+	// blocker to the issue waiting at the end. Chains never share an issue.
+	Chains [][]string `json:"longest_chains"`
+}
+
+// SummarizeGraph builds the summary from finished stats (Phase 2 included),
+// keeping top entries per list
+func SummarizeGraph(issues []model.Issue, stats *GraphStats, top int) GraphSummary {
+	titles := make(map[string]string, len(issues))
+	for _, issue := range issues {
+		titles[issue.ID] = issue.Title
+	}
+	toFloat := func(m map[string]int) map[string]float64 {
+		f := make(map[string]float64, len(m))
+		for id, v := range m {
+			f[id] = float64(v)
+		}
+		return f
+	}
+
+	summary := GraphSummary{
+		Issues:      stats.NodeCount,
+		Edges:       stats.EdgeCount,
+		Density:     stats.Density,
+		Cycles:      len(stats.Cycles()),
+		PageRank:    rankIssues(stats.PageRank(), titles, top),
+		Betweenness: rankIssues(stats.Betweenness(), titles, top),
+		InDegree:    rankIssues(toFloat(stats.InDegree), titles, top),
+		OutDegree:   rankIssues(toFloat(stats.OutDegree), titles, top),
+	}
+	for _, issue := range issues {
+		if stats.InDegree[issue.ID] == 0 && stats.OutDegree[issue.ID] == 0 {
+			summary.Orphans++
+		}
+	}
+	summary.Chains = longestChains(issues, top)
+	if len(summary.Chains) > 0 {
+		summary.LongestChain = len(summary.Chains[0])
+	}
+	return summary
+}
+
+// rankIssues returns the top non-zero scores, highest first then by ID
+func rankIssues(scores map[string]float64, titles map[string]string, top int) []GraphRank {
+	ranked := make([]GraphRank, 0, len(scores))
+	for id, v := range scores {
+		if v > 0 {
+			ranked = append(ranked, GraphRank{ID: id, Title: titles[id], Value: v})
+		}
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Value != ranked[j].Value {
+			return ranked[i].Value > ranked[j].Value
+		}
+		return ranked[i].ID < ranked[j].ID
+	})
+	if top > 0 && len(ranked) > top {
+		ranked = ranked[:top]
+	}
+	return ranked
+}
+
+// longestChains finds up to limit disjoint blocker chains of two or more
+// issues, longest first. Edges that close a cycle are ignored.
+func longestChains(issues []model.Issue, limit int) [][]string {
+	known := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		known[issue.ID] = true
+	}
+	blockers := make(map[string][]string)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type.IsBlocking() && known[dep.DependsOnID] && dep.DependsOnID != issue.ID {
+				blockers[issue.ID] = append(blockers[issue.ID], dep.DependsOnID)
+			}
+		}
+	}
+
+	// depth[id] is the length of the longest chain ending at id; next[id] is
+	// the blocker that chain continues through
+	depth := make(map[string]int, len(issues))
+	next := make(map[string]string)
+	onStack := make(map[string]bool)
+	var visit func(id string) int
+	visit = func(id string) int {
+		if d, ok := depth[id]; ok {
+			return d
+		}
+		onStack[id] = true
+		best, via := 0, ""
+		for _, b := range blockers[id] {
+			if onStack[b] {
+				continue
+			}
+			if d := visit(b); d > best || (d == best && b < via) {
+				best, via = d, b
+			}
+		}
+		onStack[id] = false
+		depth[id] = best + 1
+		if via != "" {
+			next[id] = via
+		}
+		return depth[id]
+	}
+
+	ends := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		visit(issue.ID)
+		ends = append(ends, issue.ID)
+	}
+	sort.Slice(ends, func(i, j int) bool {
+		if depth[ends[i]] != depth[ends[j]] {
+			return depth[ends[i]] > depth[ends[j]]
+		}
+		return ends[i] < ends[j]
+	})
+
+	used := make(map[string]bool)
+	var chains [][]string
+	for _, end := range ends {
+		if limit > 0 && len(chains) >= limit {
+			break
+		}
+		if depth[end] < 2 {
+			break
+		}
+		if used[end] {
+			continue
+		}
+		var chain []string
+		for id := end; id != "" && !used[id]; id = next[id] {
+			chain = append(chain, id)
+		}
+		if len(chain) < 2 {
+			continue
+		}
+		for _, id := range chain {
+			used[id] = true
+		}
+		// Walked from the waiting issue back; report blocker first
+		for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+			chain[i], chain[j] = chain[j], chain[i]
+		}
+		chains = append(chains, chain)
+	}
+	// A chain cut short by an earlier one can be shorter than a later chain
+	sort.SliceStable(chains, func(i, j int) bool { return len(chains[i]) > len(chains[j]) })
+	return chains
+}
@@ -0,0 +1,52 @@
+package analysis_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestSummarizeGraph(t *testing.T) {
+	// schema → api → ui → ship is the longest chain; docs → guide is a
+	// second one; x and y wait on each other; lone touches nothing
+	issues := []model.Issue{
+		{ID: "schema", Title: "Schema"},
+		{ID: "api", Dependencies: blockedBy("schema")},
+		{ID: "ui", Dependencies: blockedBy("api")},
+		{ID: "ship", Dependencies: blockedBy("ui", "api")},
+		{ID: "docs"},
+		{ID: "guide", Dependencies: blockedBy("docs")},
+		{ID: "x", Dependencies: blockedBy("y")},
+		{ID: "y", Dependencies: blockedBy("x")},
+		{ID: "lone"},
+	}
+	stats := analysis.NewAnalyzer(issues).AnalyzeAsync(context.Background())
+	stats.WaitForPhase2()
+
+	got := analysis.SummarizeGraph(issues, stats, 2)
+	if got.Issues != 9 || got.Edges != 7 {
+		t.Errorf("issues, edges = %d, %d, want 9, 7", got.Issues, got.Edges)
+	}
+	if got.Orphans != 1 {
+		t.Errorf("orphans = %d, want 1 (lone)", got.Orphans)
+	}
+	if got.Cycles != 1 {
+		t.Errorf("cycles = %d, want 1", got.Cycles)
+	}
+	wantChains := [][]string{{"schema", "api", "ui", "ship"}, {"docs", "guide"}}
+	if !reflect.DeepEqual(got.Chains, wantChains) {
+		t.Errorf("chains = %v, want %v", got.Chains, wantChains)
+	}
+	if got.LongestChain != 4 {
+		t.Errorf("longest chain = %d, want 4", got.LongestChain)
+	}
+	if len(got.InDegree) != 2 || got.InDegree[0].ID != "api" || got.InDegree[0].Value != 2 {
+		t.Errorf("in-degree top = %+v, want api (2) first", got.InDegree)
+	}
+	if len(got.PageRank) != 2 || got.PageRank[0].Value < got.PageRank[1].Value {
+		t.Errorf("pagerank top = %+v", got.PageRank)
+	}
+}
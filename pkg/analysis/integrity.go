@@ -0,0 +1,171 @@
+package analysis
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// IntegrityKind names one class of tracker integrity problem
+type IntegrityKind string
+
+const (
+	// IntegrityDanglingDep is a dependency on an ID no loaded issue has
+	IntegrityDanglingDep IntegrityKind = "dangling_dependency"
+	// IntegrityStaleBlock is an issue marked blocked whose blockers are all closed
+	IntegrityStaleBlock IntegrityKind = "stale_block"
+	// IntegrityEmptyEpic is an unfinished epic without children
+	IntegrityEmptyEpic IntegrityKind = "empty_epic"
+	// IntegrityOrphan is an unfinished issue without a parent that carries a
+	// label of a closed epic, likely left behind when the epic was closed
+	IntegrityOrphan IntegrityKind = "orphan"
+)
+
+// IntegrityKinds lists the kinds in report order
+var IntegrityKinds = []IntegrityKind{IntegrityDanglingDep, IntegrityStaleBlock, IntegrityEmptyEpic, IntegrityOrphan}
+
+// Title heads a group of problems of this kind in reports
+func (k IntegrityKind) Title() string {
+	switch k {
+	case IntegrityDanglingDep:
+		return "Dangling dependencies"
+	case IntegrityStaleBlock:
+		return "Blocked by closed issues"
+	case IntegrityEmptyEpic:
+		return "Epics without children"
+	case IntegrityOrphan:
+		return "Orphans of closed epics"
+	}
+	return string(k)
+}
+
+// IntegrityProblem is one finding of CheckIntegrity
+type IntegrityProblem struct {
+	Kind       IntegrityKind `json:"kind"`
+	IssueID    string        `json:"issue_id"`
+	Title      string        `json:"title"`
+	RelatedIDs []string      `json:"related_ids,omitempty"` // Missing target, closed blockers or closed epics
+	Message    string        `json:"message"`
+}
+
+// IntegrityReport is the outcome of CheckIntegrity (`bv doctor`)
+type IntegrityReport struct {
+	Problems []IntegrityProblem    `json:"problems"` // By kind, then issue ID
+	Counts   map[IntegrityKind]int `json:"counts"`
+}
+
+// CheckIntegrity finds dependencies pointing at nonexistent issues, issues
+// still marked blocked after every blocker closed, unfinished epics with no
+// children, and parentless unfinished issues sharing a label with a closed
+// epic.
+func CheckIntegrity(issues []model.Issue) IntegrityReport {
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	hasChildren := make(map[string]bool)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				hasChildren[dep.DependsOnID] = true
+			}
+		}
+	}
+	closedEpicsByLabel := make(map[string][]string)
+	for _, issue := range issues {
+		if issue.IssueType == model.TypeEpic && issue.Status.IsClosed() {
+			for _, label := range issue.Labels {
+				closedEpicsByLabel[label] = append(closedEpicsByLabel[label], issue.ID)
+			}
+		}
+	}
+
+	report := IntegrityReport{Problems: []IntegrityProblem{}, Counts: make(map[IntegrityKind]int)}
+	add := func(kind IntegrityKind, issue model.Issue, related []string, format string, args ...any) {
+		report.Problems = append(report.Problems, IntegrityProblem{
+			Kind:       kind,
+			IssueID:    issue.ID,
+			Title:      issue.Title,
+			RelatedIDs: related,
+			Message:    fmt.Sprintf(format, args...),
+		})
+		report.Counts[kind]++
+	}
+
+	for _, issue := range issues {
+		hasParent := false
+		var blockers, closedBlockers []string
+		for _, dep := range issue.Dependencies {
+			if dep == nil || dep.DependsOnID == "" {
+				continue
+			}
+			target, ok := byID[dep.DependsOnID]
+			if !ok {
+				kind := dep.Type
+				if kind == "" {
+					kind = model.DepBlocks
+				}
+				add(IntegrityDanglingDep, issue, []string{dep.DependsOnID},
+					"%s dependency on missing issue %s", kind, dep.DependsOnID)
+				if dep.Type.IsBlocking() {
+					blockers = append(blockers, dep.DependsOnID) // Unknown, so not known to be closed
+				}
+				continue
+			}
+			switch {
+			case dep.Type == model.DepParentChild:
+				hasParent = true
+			case dep.Type.IsBlocking():
+				blockers = append(blockers, target.ID)
+				if target.Status.IsClosed() {
+					closedBlockers = append(closedBlockers, target.ID)
+				}
+			}
+		}
+
+		if issue.Status.IsClosed() {
+			continue
+		}
+		if issue.Status.Column() == model.StatusBlocked && len(blockers) > 0 && len(closedBlockers) == len(blockers) {
+			add(IntegrityStaleBlock, issue, closedBlockers,
+				"Marked blocked, but its blockers are closed: %s", strings.Join(closedBlockers, ", "))
+		}
+		if issue.IssueType == model.TypeEpic {
+			if !hasChildren[issue.ID] {
+				add(IntegrityEmptyEpic, issue, nil, "Epic has no child issues")
+			}
+			continue
+		}
+		if !hasParent {
+			var epics, labels []string
+			for _, label := range issue.Labels {
+				if ids := closedEpicsByLabel[label]; len(ids) > 0 {
+					labels = append(labels, label)
+					epics = append(epics, ids...)
+				}
+			}
+			if len(epics) > 0 {
+				slices.Sort(epics)
+				epics = slices.Compact(epics)
+				add(IntegrityOrphan, issue, epics, "No parent; shares label %s with closed epic %s",
+					strings.Join(labels, ", "), strings.Join(epics, ", "))
+			}
+		}
+	}
+
+	rank := make(map[IntegrityKind]int, len(IntegrityKinds))
+	for i, kind := range IntegrityKinds {
+		rank[kind] = i
+	}
+	sort.SliceStable(report.Problems, func(i, j int) bool {
+		a, b := report.Problems[i], report.Problems[j]
+		if a.Kind != b.Kind {
+			return rank[a.Kind] < rank[b.Kind]
+		}
+		return a.IssueID < b.IssueID
+	})
+	return report
+}
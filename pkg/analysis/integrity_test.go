@@ -0,0 +1,54 @@
+package analysis
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestCheckIntegrity(t *testing.T) {
+	dep := func(id, on string, typ model.DependencyType) *model.Dependency {
+		return &model.Dependency{IssueID: id, DependsOnID: on, Type: typ}
+	}
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Shipped epic", Status: model.StatusClosed, IssueType: model.TypeEpic, Labels: []string{"auth"}},
+		{ID: "bv-2", Title: "Child", Status: model.StatusClosed, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{dep("bv-2", "bv-1", model.DepParentChild)}},
+		{ID: "bv-3", Title: "Left behind", Status: model.StatusOpen, IssueType: model.TypeTask, Labels: []string{"auth", "ui"}},
+		{ID: "bv-4", Title: "Still blocked", Status: model.StatusBlocked, IssueType: model.TypeTask, Labels: []string{"auth"},
+			Dependencies: []*model.Dependency{dep("bv-4", "bv-2", model.DepBlocks), dep("bv-4", "bv-9", model.DepBlocks), dep("bv-4", "bv-5", model.DepParentChild)}},
+		{ID: "bv-5", Title: "Planned epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "bv-6", Title: "Empty epic", Status: model.StatusOpen, IssueType: model.TypeEpic, Labels: []string{"auth"}},
+		{ID: "bv-7", Title: "Really blocked", Status: model.StatusBlocked, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{dep("bv-7", "bv-3", model.DepBlocks)}},
+		{ID: "bv-8", Title: "Closed orphan", Status: model.StatusClosed, IssueType: model.TypeTask, Labels: []string{"auth"}},
+	}
+
+	report := CheckIntegrity(issues)
+	var got []string
+	for _, p := range report.Problems {
+		got = append(got, string(p.Kind)+":"+p.IssueID)
+	}
+	want := []string{"dangling_dependency:bv-4", "empty_epic:bv-6", "orphan:bv-3"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("problems = %v, want %v", got, want)
+	}
+	if p := report.Problems[0]; p.Message != "blocks dependency on missing issue bv-9" || p.RelatedIDs[0] != "bv-9" {
+		t.Errorf("dangling = %+v", p)
+	}
+	if p := report.Problems[2]; p.Message != "No parent; shares label auth with closed epic bv-1" {
+		t.Errorf("orphan message = %q", p.Message)
+	}
+	if report.Counts[IntegrityOrphan] != 1 || report.Counts[IntegrityStaleBlock] != 0 {
+		t.Errorf("counts = %v", report.Counts)
+	}
+
+	// Once the missing blocker is gone, bv-4 is only waiting on a closed issue
+	issues[3].Dependencies = issues[3].Dependencies[:1]
+	issues[3].Dependencies = append(issues[3].Dependencies, dep("bv-4", "bv-5", model.DepParentChild))
+	report = CheckIntegrity(issues)
+	if report.Counts[IntegrityStaleBlock] != 1 || report.Problems[0].Message != "Marked blocked, but its blockers are closed: bv-2" {
+		t.Errorf("stale block not reported: %+v", report.Problems)
+	}
+}
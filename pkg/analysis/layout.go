@@ -0,0 +1,270 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// layoutSweeps is the number of barycenter passes (alternating down and up)
+// used to reduce edge crossings. A handful is enough for issue graphs.
+const layoutSweeps = 8
+
+// LayoutNode is a node placed by LayeredLayout. Virtual nodes stand in for
+// the intermediate layers crossed by an edge spanning more than one layer.
+type LayoutNode struct {
+	ID      string // Issue ID; empty for virtual nodes
+	Layer   int    // 0 = top; blockers sit above what they block
+	Pos     int    // Index within the layer, left to right
+	Virtual bool
+}
+
+// LayoutEdge joins two nodes in adjacent layers, by index into
+// GraphLayout.Nodes. A dependency spanning several layers becomes a chain of
+// edges through virtual nodes; every edge in the chain carries the same
+// Source (blocker) and Target (blocked) issue IDs.
+type LayoutEdge struct {
+	From, To int
+	Source   string
+	Target   string
+	Reversed bool // Drawn against the dependency to break a cycle
+}
+
+// GraphLayout is a layered (Sugiyama-style) drawing of the blocking graph.
+type GraphLayout struct {
+	Nodes  []LayoutNode
+	Edges  []LayoutEdge
+	Layers [][]int // Node indexes per layer, in Pos order
+
+	index map[string]int
+}
+
+// NodeIndex returns the index in Nodes of the issue with the given ID.
+func (l *GraphLayout) NodeIndex(id string) (int, bool) {
+	if l == nil {
+		return 0, false
+	}
+	i, ok := l.index[id]
+	return i, ok
+}
+
+// LayeredLayout lays out the blocking dependencies among issues in layers:
+// cycles are broken by reversing DFS back edges, each issue is placed one
+// layer below its deepest blocker, long edges are routed through virtual
+// nodes, and nodes within a layer are ordered by repeated barycenter sweeps
+// to reduce crossings. Dependencies on issues outside the slice are ignored.
+// The result is deterministic for a given input.
+func LayeredLayout(issues []model.Issue) *GraphLayout {
+	layout := &GraphLayout{index: make(map[string]int, len(issues))}
+
+	ids := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		if _, dup := layout.index[issue.ID]; dup {
+			continue
+		}
+		layout.index[issue.ID] = -1
+		ids = append(ids, issue.ID)
+	}
+	sort.Strings(ids)
+	if len(ids) == 0 {
+		return layout
+	}
+
+	// Blocking edges, blocker -> blocked, deduplicated
+	succ := make(map[string][]string, len(ids))
+	seen := make(map[[2]string]bool)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || dep.DependsOnID == issue.ID {
+				continue
+			}
+			if _, ok := layout.index[dep.DependsOnID]; !ok {
+				continue
+			}
+			key := [2]string{dep.DependsOnID, issue.ID}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			succ[dep.DependsOnID] = append(succ[dep.DependsOnID], issue.ID)
+		}
+	}
+	for _, id := range ids {
+		sort.Strings(succ[id])
+	}
+
+	edges := breakCycles(ids, succ)
+
+	// Longest-path layering over the now acyclic graph
+	preds := make(map[string][]string, len(ids))
+	for _, e := range edges {
+		preds[e.to] = append(preds[e.to], e.from)
+	}
+	layerOf := make(map[string]int, len(ids))
+	var visit func(id string) int
+	visiting := make(map[string]bool)
+	visit = func(id string) int {
+		if l, ok := layerOf[id]; ok {
+			return l
+		}
+		visiting[id] = true
+		layer := 0
+		for _, p := range preds[id] {
+			if visiting[p] {
+				continue // Unreachable after breakCycles; guards against bad input
+			}
+			if l := visit(p) + 1; l > layer {
+				layer = l
+			}
+		}
+		visiting[id] = false
+		layerOf[id] = layer
+		return layer
+	}
+	maxLayer := 0
+	for _, id := range ids {
+		if l := visit(id); l > maxLayer {
+			maxLayer = l
+		}
+	}
+
+	// Real nodes, then virtual nodes for long edges
+	layout.Layers = make([][]int, maxLayer+1)
+	addNode := func(n LayoutNode) int {
+		idx := len(layout.Nodes)
+		layout.Nodes = append(layout.Nodes, n)
+		layout.Layers[n.Layer] = append(layout.Layers[n.Layer], idx)
+		return idx
+	}
+	for _, id := range ids {
+		layout.index[id] = addNode(LayoutNode{ID: id, Layer: layerOf[id]})
+	}
+	for _, e := range edges {
+		source, target := e.from, e.to
+		if e.reversed {
+			source, target = target, source
+		}
+		prev := layout.index[e.from]
+		for l := layerOf[e.from] + 1; l < layerOf[e.to]; l++ {
+			v := addNode(LayoutNode{Layer: l, Virtual: true})
+			layout.Edges = append(layout.Edges, LayoutEdge{From: prev, To: v, Source: source, Target: target, Reversed: e.reversed})
+			prev = v
+		}
+		layout.Edges = append(layout.Edges, LayoutEdge{From: prev, To: layout.index[e.to], Source: source, Target: target, Reversed: e.reversed})
+	}
+
+	layout.orderLayers()
+	return layout
+}
+
+// layoutEdge is a blocking edge after cycle breaking.
+type layoutEdge struct {
+	from, to string
+	reversed bool
+}
+
+// breakCycles returns the edges of succ with DFS back edges reversed, so the
+// result is acyclic. ids and each succ list must be sorted for determinism.
+func breakCycles(ids []string, succ map[string][]string) []layoutEdge {
+	const (
+		unvisited = iota
+		onStack
+		done
+	)
+	state := make(map[string]int, len(ids))
+	var out []layoutEdge
+	var dfs func(id string)
+	dfs = func(id string) {
+		state[id] = onStack
+		for _, next := range succ[id] {
+			switch state[next] {
+			case onStack:
+				out = append(out, layoutEdge{from: next, to: id, reversed: true})
+			case unvisited:
+				out = append(out, layoutEdge{from: id, to: next})
+				dfs(next)
+			default:
+				out = append(out, layoutEdge{from: id, to: next})
+			}
+		}
+		state[id] = done
+	}
+	for _, id := range ids {
+		if state[id] == unvisited {
+			dfs(id)
+		}
+	}
+	return out
+}
+
+// orderLayers assigns Pos within each layer using barycenter sweeps.
+func (l *GraphLayout) orderLayers() {
+	up := make([][]int, len(l.Nodes))
+	down := make([][]int, len(l.Nodes))
+	for _, e := range l.Edges {
+		down[e.From] = append(down[e.From], e.To)
+		up[e.To] = append(up[e.To], e.From)
+	}
+	setPos := func(layer []int) {
+		for i, n := range layer {
+			l.Nodes[n].Pos = i
+		}
+	}
+	for _, layer := range l.Layers {
+		setPos(layer)
+	}
+
+	reorder := func(layer []int, neighbors [][]int) {
+		bary := make(map[int]float64, len(layer))
+		for _, n := range layer {
+			if len(neighbors[n]) == 0 {
+				bary[n] = float64(l.Nodes[n].Pos) // Keep unconnected nodes in place
+				continue
+			}
+			sum := 0
+			for _, nb := range neighbors[n] {
+				sum += l.Nodes[nb].Pos
+			}
+			bary[n] = float64(sum) / float64(len(neighbors[n]))
+		}
+		sort.SliceStable(layer, func(i, j int) bool {
+			return bary[layer[i]] < bary[layer[j]]
+		})
+		setPos(layer)
+	}
+
+	for sweep := 0; sweep < layoutSweeps; sweep++ {
+		if sweep%2 == 0 {
+			for i := 1; i < len(l.Layers); i++ {
+				reorder(l.Layers[i], up)
+			}
+		} else {
+			for i := len(l.Layers) - 2; i >= 0; i-- {
+				reorder(l.Layers[i], down)
+			}
+		}
+	}
+}
+
+// Crossings counts edge crossings between adjacent layers. It is exposed so
+// callers and tests can judge layout quality.
+func (l *GraphLayout) Crossings() int {
+	byLayer := make(map[int][]LayoutEdge)
+	for _, e := range l.Edges {
+		layer := l.Nodes[e.From].Layer
+		byLayer[layer] = append(byLayer[layer], e)
+	}
+	count := 0
+	for _, es := range byLayer {
+		for i := 0; i < len(es); i++ {
+			for j := i + 1; j < len(es); j++ {
+				a1, a2 := l.Nodes[es[i].From].Pos, l.Nodes[es[i].To].Pos
+				b1, b2 := l.Nodes[es[j].From].Pos, l.Nodes[es[j].To].Pos
+				if (a1 < b1 && a2 > b2) || (a1 > b1 && a2 < b2) {
+					count++
+				}
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,112 @@
+package analysis_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func layoutIssue(id string, blockers ...string) model.Issue {
+	return model.Issue{ID: id, Title: id, Status: model.StatusOpen, Dependencies: blockedBy(blockers...)}
+}
+
+func layerOf(t *testing.T, l *analysis.GraphLayout, id string) int {
+	t.Helper()
+	idx, ok := l.NodeIndex(id)
+	if !ok {
+		t.Fatalf("node %s missing from layout", id)
+	}
+	return l.Nodes[idx].Layer
+}
+
+func TestLayeredLayout_LayersAndVirtualNodes(t *testing.T) {
+	// A -> B -> C, and A -> C directly (spans two layers)
+	l := analysis.LayeredLayout([]model.Issue{
+		layoutIssue("C", "B", "A"),
+		layoutIssue("B", "A"),
+		layoutIssue("A"),
+		layoutIssue("Solo"),
+	})
+
+	for id, want := range map[string]int{"A": 0, "B": 1, "C": 2, "Solo": 0} {
+		if got := layerOf(t, l, id); got != want {
+			t.Errorf("layer(%s) = %d, want %d", id, got, want)
+		}
+	}
+
+	virtual := 0
+	for _, n := range l.Nodes {
+		if n.Virtual {
+			virtual++
+			if n.Layer != 1 {
+				t.Errorf("virtual node for A->C should sit in layer 1, got %d", n.Layer)
+			}
+		}
+	}
+	if virtual != 1 {
+		t.Errorf("expected 1 virtual node, got %d", virtual)
+	}
+	for _, e := range l.Edges {
+		if l.Nodes[e.To].Layer != l.Nodes[e.From].Layer+1 {
+			t.Errorf("edge %s->%s does not join adjacent layers", e.Source, e.Target)
+		}
+	}
+	for i, layer := range l.Layers {
+		for pos, n := range layer {
+			if l.Nodes[n].Layer != i || l.Nodes[n].Pos != pos {
+				t.Errorf("Layers[%d][%d] disagrees with node %+v", i, pos, l.Nodes[n])
+			}
+		}
+	}
+}
+
+func TestLayeredLayout_CyclesAndDeterminism(t *testing.T) {
+	issues := []model.Issue{
+		layoutIssue("X", "Z"),
+		layoutIssue("Y", "X"),
+		layoutIssue("Z", "Y"),
+		layoutIssue("W", "missing-outside-slice"),
+	}
+	l := analysis.LayeredLayout(issues)
+
+	reversed := 0
+	for _, e := range l.Edges {
+		if e.Reversed {
+			reversed++
+		}
+	}
+	if reversed == 0 {
+		t.Error("a 3-cycle needs at least one reversed edge")
+	}
+	if len(l.Layers) != 3 {
+		t.Errorf("cycle should be laid out over 3 layers, got %d", len(l.Layers))
+	}
+
+	again := analysis.LayeredLayout(issues)
+	if !reflect.DeepEqual(l.Nodes, again.Nodes) || !reflect.DeepEqual(l.Edges, again.Edges) {
+		t.Error("layout should be deterministic")
+	}
+}
+
+func TestLayeredLayout_ReducesCrossings(t *testing.T) {
+	// Without reordering, A1..A3 in ID order point at B3..B1: every pair crosses.
+	l := analysis.LayeredLayout([]model.Issue{
+		layoutIssue("A1"), layoutIssue("A2"), layoutIssue("A3"),
+		layoutIssue("B1", "A3"), layoutIssue("B2", "A2"), layoutIssue("B3", "A1"),
+	})
+	if got := l.Crossings(); got != 0 {
+		t.Errorf("Crossings() = %d, want 0 after barycenter ordering", got)
+	}
+}
+
+func TestLayeredLayout_Empty(t *testing.T) {
+	l := analysis.LayeredLayout(nil)
+	if len(l.Nodes) != 0 || len(l.Layers) != 0 {
+		t.Errorf("empty input should give an empty layout: %+v", l)
+	}
+	if _, ok := l.NodeIndex("A"); ok {
+		t.Error("NodeIndex on an empty layout should miss")
+	}
+}
@@ -0,0 +1,195 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DuplicateLabel marks an issue as a duplicate of the issue it relates to
+const DuplicateLabel = "duplicate"
+
+// NearDuplicateConfig configures FindNearDuplicates
+type NearDuplicateConfig struct {
+	// Threshold is the minimum cosine similarity (0.0-1.0)
+	// Default: 0.5
+	Threshold float64
+
+	// MinTerms is the fewest distinct terms an issue needs to be compared
+	// Default: 2
+	MinTerms int
+
+	// IncludeClosed also pairs closed issues with each other and with open ones
+	// Default: false
+	IncludeClosed bool
+
+	// MaxPairs limits the number of pairs returned (0 = no limit)
+	// Default: 50
+	MaxPairs int
+}
+
+// DefaultNearDuplicateConfig returns sensible defaults
+func DefaultNearDuplicateConfig() NearDuplicateConfig {
+	return NearDuplicateConfig{
+		Threshold: 0.5,
+		MinTerms:  2,
+		MaxPairs:  50,
+	}
+}
+
+// maxPostings caps how many issues a term may pair up. A term that common
+// carries almost no weight, and would otherwise make the pass quadratic.
+const maxPostings = 200
+
+// FindNearDuplicates pairs issues whose title and description read alike,
+// by TF-IDF cosine similarity over their keywords. Title words count twice,
+// and words many issues use count for little. Pairs already linked by a
+// dependency, or where one is labeled DuplicateLabel, are left out: someone
+// has decided about them. Pairs come back most similar first, Issue1 being
+// the older of the two.
+func FindNearDuplicates(issues []model.Issue, config NearDuplicateConfig) []DuplicatePair {
+	var candidates []*model.Issue
+	for i := range issues {
+		issue := &issues[i]
+		if (config.IncludeClosed || !issue.Status.IsClosed()) && !hasLabel(issue.Labels, DuplicateLabel) {
+			candidates = append(candidates, issue)
+		}
+	}
+	if len(candidates) < 2 {
+		return nil
+	}
+
+	// Term frequencies, then document frequencies over the candidates
+	counts := make([]map[string]float64, len(candidates))
+	df := make(map[string]int)
+	for i, issue := range candidates {
+		tf := make(map[string]float64)
+		for _, w := range extractKeywords(issue.Title, "") {
+			tf[w] += 2
+		}
+		for _, w := range extractKeywords("", issue.Description) {
+			tf[w]++
+		}
+		if len(tf) < config.MinTerms {
+			continue
+		}
+		counts[i] = tf
+		for w := range tf {
+			df[w]++
+		}
+	}
+
+	// Unit TF-IDF vectors and an inverted index to find pairs sharing a term
+	n := float64(len(candidates))
+	vectors := make([]map[string]float64, len(candidates))
+	index := make(map[string][]int)
+	for i, tf := range counts {
+		if tf == nil {
+			continue
+		}
+		vec := make(map[string]float64, len(tf))
+		var norm float64
+		for w, c := range tf {
+			weight := c * (math.Log((1+n)/(1+float64(df[w]))) + 1)
+			vec[w] = weight
+			norm += weight * weight
+		}
+		norm = math.Sqrt(norm)
+		for w := range vec {
+			vec[w] /= norm
+			if df[w] <= maxPostings {
+				index[w] = append(index[w], i)
+			}
+		}
+		vectors[i] = vec
+	}
+
+	linked := linkedPairs(issues)
+	var pairs []DuplicatePair
+	for i, vec := range vectors {
+		if vec == nil {
+			continue
+		}
+		seen := make(map[int]bool)
+		for w := range vec {
+			for _, j := range index[w] {
+				if j <= i || seen[j] {
+					continue
+				}
+				seen[j] = true
+				a, b := candidates[i], candidates[j]
+				if linked[pairKey(a.ID, b.ID)] {
+					continue
+				}
+
+				var sim float64
+				var shared []string
+				for term, weight := range vec {
+					if other, ok := vectors[j][term]; ok {
+						sim += weight * other
+						shared = append(shared, term)
+					}
+				}
+				if sim < config.Threshold {
+					continue
+				}
+
+				// Strongest shared terms first
+				sort.Slice(shared, func(x, y int) bool {
+					wx := vec[shared[x]] * vectors[j][shared[x]]
+					wy := vec[shared[y]] * vectors[j][shared[y]]
+					if wx != wy {
+						return wx > wy
+					}
+					return shared[x] < shared[y]
+				})
+				if b.CreatedAt.Before(a.CreatedAt) {
+					a, b = b, a
+				}
+				pairs = append(pairs, DuplicatePair{
+					Issue1:     a.ID,
+					Issue2:     b.ID,
+					Similarity: math.Min(sim, 1),
+					Method:     "tfidf",
+					Keywords:   truncateStringSlice(shared, 5),
+				})
+			}
+		}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Similarity != pairs[j].Similarity {
+			return pairs[i].Similarity > pairs[j].Similarity
+		}
+		if pairs[i].Issue1 != pairs[j].Issue1 {
+			return pairs[i].Issue1 < pairs[j].Issue1
+		}
+		return pairs[i].Issue2 < pairs[j].Issue2
+	})
+	if config.MaxPairs > 0 && len(pairs) > config.MaxPairs {
+		pairs = pairs[:config.MaxPairs]
+	}
+	return pairs
+}
+
+// linkedPairs collects the issue pairs joined by a dependency of any type
+func linkedPairs(issues []model.Issue) map[string]bool {
+	linked := make(map[string]bool)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep != nil {
+				linked[pairKey(issue.ID, dep.DependsOnID)] = true
+			}
+		}
+	}
+	return linked
+}
+
+// pairKey names an unordered pair of issues
+func pairKey(a, b string) string {
+	if b < a {
+		a, b = b, a
+	}
+	return a + "\x00" + b
+}
@@ -0,0 +1,48 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestFindNearDuplicates(t *testing.T) {
+	day := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "bv-2", Title: "Login page crashes on Safari", Description: "Blank screen after submitting credentials", Status: model.StatusOpen, CreatedAt: day.Add(24 * time.Hour)},
+		{ID: "bv-1", Title: "Safari login crashes", Description: "Submitting credentials leaves a blank screen", Status: model.StatusOpen, CreatedAt: day},
+		{ID: "bv-3", Title: "Export burndown chart as PNG", Description: "Add a download button to the chart", Status: model.StatusOpen, CreatedAt: day},
+		{ID: "bv-4", Title: "Safari login crashes", Description: "Blank screen after credentials", Status: model.StatusClosed, CreatedAt: day},
+	}
+
+	pairs := FindNearDuplicates(issues, DefaultNearDuplicateConfig())
+	if len(pairs) != 1 {
+		t.Fatalf("pairs = %+v, want only bv-1/bv-2", pairs)
+	}
+	p := pairs[0]
+	if p.Issue1 != "bv-1" || p.Issue2 != "bv-2" || p.Method != "tfidf" {
+		t.Errorf("pair = %+v, want the older issue first", p)
+	}
+	if p.Similarity < 0.5 || p.Similarity > 1 || len(p.Keywords) == 0 {
+		t.Errorf("similarity %.2f, keywords %v", p.Similarity, p.Keywords)
+	}
+
+	// Closed issues join in when asked
+	config := DefaultNearDuplicateConfig()
+	config.IncludeClosed = true
+	if pairs := FindNearDuplicates(issues, config); len(pairs) != 3 {
+		t.Errorf("with closed: %d pairs, want 3", len(pairs))
+	}
+
+	// A pair already decided about drops out
+	issues[0].Dependencies = []*model.Dependency{{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepRelated}}
+	if pairs := FindNearDuplicates(issues, DefaultNearDuplicateConfig()); len(pairs) != 0 {
+		t.Errorf("linked pair still reported: %+v", pairs)
+	}
+	issues[0].Dependencies = nil
+	issues[0].Labels = []string{DuplicateLabel}
+	if pairs := FindNearDuplicates(issues, DefaultNearDuplicateConfig()); len(pairs) != 0 {
+		t.Errorf("labeled duplicate still reported: %+v", pairs)
+	}
+}
@@ -0,0 +1,169 @@
+package analysis
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// PathOptions tunes FindDependencyPaths
+type PathOptions struct {
+	AllTypes bool // Follow every dependency type, not just blocking ones
+	MaxPaths int  // Cap on the simple paths listed; <= 0 means 10
+}
+
+// DependencyPath is a chain of dependencies: IDs[i] depends on IDs[i+1]
+// through a dependency of Types[i]
+type DependencyPath struct {
+	IDs   []string               `json:"ids"`
+	Types []model.DependencyType `json:"types"`
+}
+
+// Hops is the number of dependencies along the path
+func (p DependencyPath) Hops() int {
+	return len(p.Types)
+}
+
+// DependencyPathsResult answers "why is From waiting on To?"
+type DependencyPathsResult struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	// Reversed is set when From does not depend on To but To depends on
+	// From; the paths then run from To to From
+	Reversed bool `json:"reversed"`
+
+	Shortest  *DependencyPath  `json:"shortest,omitempty"`
+	Paths     []DependencyPath `json:"paths"`     // Every simple path, shortest first
+	Truncated bool             `json:"truncated"` // More paths exist than MaxPaths
+}
+
+// FindDependencyPaths finds how from depends on to: the shortest chain of
+// dependencies and the opts.MaxPaths shortest simple chains, shortest first
+// (the first is Shortest).
+// If from does not depend on to, it looks the other way and sets Reversed.
+// Only blocking dependencies are followed unless opts.AllTypes is set.
+func FindDependencyPaths(issues []model.Issue, from, to string, opts PathOptions) (DependencyPathsResult, error) {
+	if opts.MaxPaths <= 0 {
+		opts.MaxPaths = 10
+	}
+
+	// deps[id] lists what id depends on, sorted so results are stable
+	type edge struct {
+		to  string
+		typ model.DependencyType
+	}
+	known := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		known[issue.ID] = true
+	}
+	for _, id := range []string{from, to} {
+		if !known[id] {
+			return DependencyPathsResult{}, fmt.Errorf("unknown issue %q", id)
+		}
+	}
+	deps := make(map[string][]edge)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !known[dep.DependsOnID] || dep.DependsOnID == issue.ID {
+				continue
+			}
+			if !opts.AllTypes && !dep.Type.IsBlocking() {
+				continue
+			}
+			typ := dep.Type
+			if typ == "" {
+				typ = model.DepBlocks
+			}
+			deps[issue.ID] = append(deps[issue.ID], edge{to: dep.DependsOnID, typ: typ})
+		}
+	}
+	for id := range deps {
+		sort.Slice(deps[id], func(i, j int) bool { return deps[id][i].to < deps[id][j].to })
+	}
+
+	// search lists the paths from src to dst, or nothing if there are none
+	search := func(src, dst string) (*DependencyPath, []DependencyPath, bool) {
+		// Only issues that can reach dst are worth walking through; dist is
+		// how many hops each is from it
+		dependents := make(map[string][]string)
+		for id, edges := range deps {
+			for _, e := range edges {
+				dependents[e.to] = append(dependents[e.to], id)
+			}
+		}
+		dist := map[string]int{dst: 0}
+		queue := []string{dst}
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			for _, d := range dependents[id] {
+				if _, ok := dist[d]; !ok {
+					dist[d] = dist[id] + 1
+					queue = append(queue, d)
+				}
+			}
+		}
+		if _, ok := dist[src]; src == dst || !ok {
+			return nil, nil, false
+		}
+
+		// Simple paths in order of length: a depth-first walk per length,
+		// from the shortest possible up to one through every issue that
+		// reaches dst, pruned by the distance left. It stops once there are
+		// more than MaxPaths so the caller can say the list is cut short.
+		var paths []DependencyPath
+		onPath := map[string]bool{src: true}
+		ids := []string{src}
+		var types []model.DependencyType
+		var walk func(id string, left int)
+		walk = func(id string, left int) {
+			for _, e := range deps[id] {
+				if len(paths) > opts.MaxPaths {
+					return
+				}
+				d, ok := dist[e.to]
+				if !ok || onPath[e.to] || d > left-1 {
+					continue
+				}
+				ids = append(ids, e.to)
+				types = append(types, e.typ)
+				if e.to == dst {
+					if left == 1 {
+						paths = append(paths, DependencyPath{IDs: slices.Clone(ids), Types: slices.Clone(types)})
+					}
+				} else {
+					onPath[e.to] = true
+					walk(e.to, left-1)
+					delete(onPath, e.to)
+				}
+				ids = ids[:len(ids)-1]
+				types = types[:len(types)-1]
+			}
+		}
+		for hops := dist[src]; hops < len(dist) && len(paths) <= opts.MaxPaths; hops++ {
+			walk(src, hops)
+		}
+
+		truncated := len(paths) > opts.MaxPaths
+		if truncated {
+			paths = paths[:opts.MaxPaths]
+		}
+		shortest := paths[0]
+		return &shortest, paths, truncated
+	}
+
+	result := DependencyPathsResult{From: from, To: to, Paths: []DependencyPath{}}
+	shortest, paths, truncated := search(from, to)
+	if shortest == nil {
+		if shortest, paths, truncated = search(to, from); shortest != nil {
+			result.Reversed = true
+		}
+	}
+	if shortest != nil {
+		result.Shortest, result.Paths, result.Truncated = shortest, paths, truncated
+	}
+	return result, nil
+}
@@ -0,0 +1,102 @@
+package analysis_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// pathSummary renders paths as "a>b>c" with non-blocking hops marked
+func pathSummary(paths []analysis.DependencyPath) string {
+	var parts []string
+	for _, p := range paths {
+		var b strings.Builder
+		b.WriteString(p.IDs[0])
+		for i, id := range p.IDs[1:] {
+			if p.Types[i] != model.DepBlocks {
+				fmt.Fprintf(&b, ">(%s)", p.Types[i])
+			} else {
+				b.WriteString(">")
+			}
+			b.WriteString(id)
+		}
+		parts = append(parts, b.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestFindDependencyPaths(t *testing.T) {
+	// ship waits on api directly and through docs and ui; docs is also a
+	// child of epic, which waits on schema
+	issues := []model.Issue{
+		{ID: "schema"},
+		{ID: "api", Dependencies: blockedBy("schema")},
+		{ID: "ui", Dependencies: blockedBy("api")},
+		{ID: "docs", Dependencies: append(blockedBy("ui"), &model.Dependency{DependsOnID: "epic", Type: model.DepParentChild})},
+		{ID: "epic", Dependencies: blockedBy("schema")},
+		{ID: "ship", Dependencies: blockedBy("docs", "api")},
+		{ID: "island"},
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		opts     analysis.PathOptions
+		reversed bool
+		shortest string
+		paths    string
+		more     bool
+	}{
+		{name: "all paths, shortest first", from: "ship", to: "schema",
+			shortest: "ship>api>schema", paths: "ship>api>schema ship>docs>ui>api>schema"},
+		{name: "all dependency types", from: "ship", to: "schema", opts: analysis.PathOptions{AllTypes: true},
+			shortest: "ship>api>schema",
+			paths:    "ship>api>schema ship>docs>(parent-child)epic>schema ship>docs>ui>api>schema"},
+		{name: "limited", from: "ship", to: "schema", opts: analysis.PathOptions{AllTypes: true, MaxPaths: 2},
+			shortest: "ship>api>schema", paths: "ship>api>schema ship>docs>(parent-child)epic>schema", more: true},
+		{name: "other direction", from: "api", to: "ship", reversed: true,
+			shortest: "ship>api", paths: "ship>api ship>docs>ui>api"},
+		{name: "unrelated", from: "island", to: "ship"},
+		{name: "itself", from: "api", to: "api"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := analysis.FindDependencyPaths(issues, tt.from, tt.to, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			shortest := ""
+			if got.Shortest != nil {
+				shortest = pathSummary([]analysis.DependencyPath{*got.Shortest})
+			}
+			if got.Reversed != tt.reversed || shortest != tt.shortest || pathSummary(got.Paths) != tt.paths || got.Truncated != tt.more {
+				t.Errorf("got reversed=%v shortest=%q paths=%q truncated=%v\nwant reversed=%v shortest=%q paths=%q truncated=%v",
+					got.Reversed, shortest, pathSummary(got.Paths), got.Truncated, tt.reversed, tt.shortest, tt.paths, tt.more)
+			}
+		})
+	}
+
+	if _, err := analysis.FindDependencyPaths(issues, "ship", "nope", analysis.PathOptions{}); err == nil || !strings.Contains(err.Error(), `"nope"`) {
+		t.Errorf("unknown ID error = %v", err)
+	}
+
+	// A cut-short list still holds the shortest paths, even when longer
+	// ones are met first: a's two ways round b sort ahead of its direct edge
+	chain := []model.Issue{
+		{ID: "a", Dependencies: blockedBy("b", "d")},
+		{ID: "b", Dependencies: blockedBy("c", "e")},
+		{ID: "c", Dependencies: blockedBy("d")},
+		{ID: "e", Dependencies: blockedBy("d")},
+		{ID: "d"},
+	}
+	got, err := analysis.FindDependencyPaths(chain, "a", "d", analysis.PathOptions{MaxPaths: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pathSummary(got.Paths) != "a>d" || pathSummary([]analysis.DependencyPath{*got.Shortest}) != "a>d" || !got.Truncated {
+		t.Errorf("capped paths = %q (truncated=%v), want a>d cut short", pathSummary(got.Paths), got.Truncated)
+	}
+}
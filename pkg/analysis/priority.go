@@ -123,7 +123,7 @@ func (a *Analyzer) ComputeImpactScoresFromStats(stats *GraphStats, now time.Time
 
 	for id, issue := range a.issueMap {
 		// Skip closed issues
-		if issue.Status == model.StatusClosed {
+		if issue.Status.IsClosed() {
 			continue
 		}
 
@@ -779,7 +779,7 @@ func (a *Analyzer) computeWhatIfDelta(issueID string) *WhatIfDelta {
 	blockedReduction := 0
 	for _, unblockID := range directUnblocks {
 		if issue, ok := a.issueMap[unblockID]; ok {
-			if issue.Status == model.StatusBlocked {
+			if issue.Status.Column() == model.StatusBlocked {
 				blockedReduction++
 			}
 		}
@@ -853,7 +853,7 @@ func (a *Analyzer) countTransitiveUnblocks(issueID string) int {
 			if simulatedClosed[depID] {
 				continue
 			}
-			if issue, exists := a.issueMap[depID]; exists && issue.Status == model.StatusClosed {
+			if issue, exists := a.issueMap[depID]; exists && issue.Status.IsClosed() {
 				continue
 			}
 
@@ -869,7 +869,7 @@ func (a *Analyzer) countTransitiveUnblocks(issueID string) int {
 				isClosed := false
 				if simulatedClosed[blockerID] {
 					isClosed = true
-				} else if bIssue, ok := a.issueMap[blockerID]; ok && bIssue.Status == model.StatusClosed {
+				} else if bIssue, ok := a.issueMap[blockerID]; ok && bIssue.Status.IsClosed() {
 					isClosed = true
 				}
 
@@ -0,0 +1,62 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// BlockedByMap maps each issue ID to the IDs of its open blockers: issues it
+// has a "blocks" dependency on that are not yet closed. Issues with no open
+// blockers are absent from the map.
+func BlockedByMap(issues []model.Issue) map[string][]string {
+	open := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		if !issue.Status.IsClosed() {
+			open[issue.ID] = true
+		}
+	}
+
+	blockedBy := make(map[string][]string)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep == nil || dep.Type != model.DepBlocks {
+				continue
+			}
+			if open[dep.DependsOnID] {
+				blockedBy[issue.ID] = append(blockedBy[issue.ID], dep.DependsOnID)
+			}
+		}
+	}
+	return blockedBy
+}
+
+// ReadyIssues returns the issues that can be worked on now (not closed, not
+// marked blocked, no open blockers), most important first: by priority, then
+// PageRank (descending), then ID. pageRank may be nil.
+func ReadyIssues(issues []model.Issue, pageRank map[string]float64) []model.Issue {
+	blockedBy := BlockedByMap(issues)
+
+	var ready []model.Issue
+	for _, issue := range issues {
+		if issue.Status.IsClosed() || issue.Status.Column() == model.StatusBlocked {
+			continue
+		}
+		if len(blockedBy[issue.ID]) > 0 {
+			continue
+		}
+		ready = append(ready, issue)
+	}
+
+	sort.SliceStable(ready, func(i, j int) bool {
+		if ready[i].Priority != ready[j].Priority {
+			return ready[i].Priority < ready[j].Priority
+		}
+		pi, pj := pageRank[ready[i].ID], pageRank[ready[j].ID]
+		if pi != pj {
+			return pi > pj
+		}
+		return ready[i].ID < ready[j].ID
+	})
+	return ready
+}
@@ -0,0 +1,201 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DefaultStarvationDays is how long a label's ready issues may sit untouched
+// before the label counts as starved.
+const DefaultStarvationDays = 14
+
+// UnlabeledFairnessLabel groups ready issues that carry no label.
+const UnlabeledFairnessLabel = "(unlabeled)"
+
+// ReadyWait is an issue that is ready to work on and how long it has been.
+type ReadyWait struct {
+	ID         string    `json:"id"`
+	Title      string    `json:"title"`
+	Priority   int       `json:"priority"`
+	ReadySince time.Time `json:"ready_since"`
+	WaitDays   float64   `json:"wait_days"`
+}
+
+// LabelFairness summarizes how a label's ready queue is being served: how
+// long its ready issues have waited so far, and how long past ones waited
+// before someone claimed them.
+type LabelFairness struct {
+	Label          string     `json:"label"`
+	Ready          int        `json:"ready"`            // Issues ready now
+	MedianWaitDays float64    `json:"median_wait_days"` // Among issues ready now
+	Oldest         *ReadyWait `json:"oldest,omitempty"` // Longest-waiting ready issue
+
+	PickedUp         int        `json:"picked_up"`          // Issues claimed once ready
+	MedianPickupDays float64    `json:"median_pickup_days"` // Ready → claimed
+	LastPickup       *time.Time `json:"last_pickup,omitempty"`
+
+	// Starved is set when the oldest ready issue has waited longer than the
+	// starvation window and nothing in the label was claimed within it
+	Starved bool `json:"starved"`
+}
+
+// ReadyFairness is the ready-queue fairness report across labels.
+type ReadyFairness struct {
+	GeneratedAt    time.Time       `json:"generated_at"`
+	StarvationDays int             `json:"starvation_days"`
+	HasHistory     bool            `json:"has_history"` // Claim times were available
+	Labels         []LabelFairness `json:"labels"`      // Starved first, then longest wait
+}
+
+// ComputeReadyFairness reports, per label, how long ready issues wait before
+// being picked up. An issue is ready from its creation or the closure of its
+// last blocker, whichever is later. claimedAt holds when each issue moved to
+// in_progress, from status history; without it only the current waits are
+// reported. Issues with several labels count toward each of them.
+func ComputeReadyFairness(issues []model.Issue, claimedAt map[string]time.Time, starvationDays int, now time.Time) ReadyFairness {
+	if starvationDays <= 0 {
+		starvationDays = DefaultStarvationDays
+	}
+	report := ReadyFairness{
+		GeneratedAt:    now,
+		StarvationDays: starvationDays,
+		HasHistory:     len(claimedAt) > 0,
+	}
+
+	byID := make(map[string]model.Issue, len(issues))
+	for _, iss := range issues {
+		byID[iss.ID] = iss
+	}
+	blockedBy := BlockedByMap(issues)
+
+	type labelAcc struct {
+		waits   []ReadyWait
+		pickups []float64
+		last    time.Time
+	}
+	acc := make(map[string]*labelAcc)
+	labelsOf := func(iss model.Issue) []string {
+		if len(iss.Labels) == 0 {
+			return []string{UnlabeledFairnessLabel}
+		}
+		return iss.Labels
+	}
+	get := func(label string) *labelAcc {
+		a, ok := acc[label]
+		if !ok {
+			a = &labelAcc{}
+			acc[label] = a
+		}
+		return a
+	}
+
+	for _, iss := range issues {
+		if iss.CreatedAt.IsZero() {
+			continue
+		}
+		since := readySince(iss, byID)
+
+		if claimed, ok := claimedAt[iss.ID]; ok && !claimed.IsZero() {
+			days := max(claimed.Sub(since).Hours()/24, 0)
+			for _, label := range labelsOf(iss) {
+				a := get(label)
+				a.pickups = append(a.pickups, days)
+				if claimed.After(a.last) {
+					a.last = claimed
+				}
+			}
+		}
+
+		if iss.Status.Column() != model.StatusOpen || len(blockedBy[iss.ID]) > 0 {
+			continue
+		}
+		wait := ReadyWait{
+			ID:         iss.ID,
+			Title:      iss.Title,
+			Priority:   iss.Priority,
+			ReadySince: since,
+			WaitDays:   max(now.Sub(since).Hours()/24, 0),
+		}
+		for _, label := range labelsOf(iss) {
+			a := get(label)
+			a.waits = append(a.waits, wait)
+		}
+	}
+
+	window := time.Duration(starvationDays) * 24 * time.Hour
+	for label, a := range acc {
+		lf := LabelFairness{Label: label, Ready: len(a.waits), PickedUp: len(a.pickups)}
+		if len(a.waits) > 0 {
+			sort.SliceStable(a.waits, func(i, j int) bool { return a.waits[i].WaitDays > a.waits[j].WaitDays })
+			oldest := a.waits[0]
+			lf.Oldest = &oldest
+			days := make([]float64, len(a.waits))
+			for i, w := range a.waits {
+				days[i] = w.WaitDays
+			}
+			lf.MedianWaitDays = medianDays(days)
+		}
+		if len(a.pickups) > 0 {
+			lf.MedianPickupDays = medianDays(a.pickups)
+			last := a.last
+			lf.LastPickup = &last
+		}
+		lf.Starved = lf.Oldest != nil && lf.Oldest.WaitDays > float64(starvationDays) &&
+			(lf.LastPickup == nil || now.Sub(*lf.LastPickup) > window)
+		report.Labels = append(report.Labels, lf)
+	}
+
+	sort.Slice(report.Labels, func(i, j int) bool {
+		a, b := report.Labels[i], report.Labels[j]
+		if a.Starved != b.Starved {
+			return a.Starved
+		}
+		if wa, wb := oldestWait(a), oldestWait(b); wa != wb {
+			return wa > wb
+		}
+		return a.Label < b.Label
+	})
+	return report
+}
+
+// readySince is when iss became workable: its creation, or the closure of
+// its last blocker if that came later
+func readySince(iss model.Issue, byID map[string]model.Issue) time.Time {
+	since := iss.CreatedAt
+	for _, dep := range iss.Dependencies {
+		if dep == nil || dep.Type != model.DepBlocks {
+			continue
+		}
+		blocker, ok := byID[dep.DependsOnID]
+		if !ok {
+			continue
+		}
+		if closedAt := issueClosedAt(blocker); closedAt.After(since) {
+			since = closedAt
+		}
+	}
+	return since
+}
+
+// oldestWait is how long the label's longest-waiting ready issue has waited
+func oldestWait(lf LabelFairness) float64 {
+	if lf.Oldest == nil {
+		return 0
+	}
+	return lf.Oldest.WaitDays
+}
+
+// medianDays returns the median of days, sorting it in place.
+func medianDays(days []float64) float64 {
+	if len(days) == 0 {
+		return 0
+	}
+	sort.Float64s(days)
+	mid := len(days) / 2
+	if len(days)%2 == 0 {
+		return (days[mid-1] + days[mid]) / 2
+	}
+	return days[mid]
+}
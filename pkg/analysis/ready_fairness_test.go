@@ -0,0 +1,97 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeReadyFairness(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return base.Add(time.Duration(n) * 24 * time.Hour) }
+	closed := func(n int) *time.Time { ts := day(n); return &ts }
+	blockedBy := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+
+	issues := []model.Issue{
+		// api: served promptly, one issue ready for two days
+		{ID: "api-1", Status: model.StatusClosed, Labels: []string{"api"}, CreatedAt: day(0), ClosedAt: closed(10)},
+		{ID: "api-2", Status: model.StatusInProgress, Labels: []string{"api"}, CreatedAt: day(20)},
+		{ID: "api-3", Status: model.StatusOpen, Labels: []string{"api"}, CreatedAt: day(28)},
+		// docs: ready since its blocker closed on day 10, nothing claimed
+		{ID: "docs-1", Status: model.StatusOpen, Labels: []string{"docs"}, CreatedAt: day(0), Dependencies: blockedBy("api-1")},
+		{ID: "docs-2", Status: model.StatusOpen, Labels: []string{"docs"}, CreatedAt: day(25)},
+		// Still blocked: not ready
+		{ID: "docs-3", Status: model.StatusOpen, Labels: []string{"docs"}, CreatedAt: day(0), Dependencies: blockedBy("api-2")},
+		{ID: "misc", Status: model.StatusOpen, CreatedAt: day(29)},
+	}
+	claimedAt := map[string]time.Time{"api-1": day(3), "api-2": day(21)}
+	now := day(30)
+
+	r := ComputeReadyFairness(issues, claimedAt, 14, now)
+	if !r.HasHistory || r.StarvationDays != 14 {
+		t.Errorf("history/window = %v/%d, want true/14", r.HasHistory, r.StarvationDays)
+	}
+	if len(r.Labels) != 3 {
+		t.Fatalf("labels = %+v, want api, docs and unlabeled", r.Labels)
+	}
+
+	docs := r.Labels[0]
+	if docs.Label != "docs" || !docs.Starved {
+		t.Fatalf("first label = %s (starved %v), want starved docs", docs.Label, docs.Starved)
+	}
+	if docs.Ready != 2 || docs.Oldest.ID != "docs-1" || docs.Oldest.WaitDays != 20 {
+		t.Errorf("docs ready=%d oldest=%+v, want 2 and docs-1 waiting 20d", docs.Ready, docs.Oldest)
+	}
+	if !docs.Oldest.ReadySince.Equal(day(10)) {
+		t.Errorf("docs-1 ready since %v, want its blocker's closure", docs.Oldest.ReadySince)
+	}
+	if docs.MedianWaitDays != 12.5 {
+		t.Errorf("docs median wait = %v, want 12.5", docs.MedianWaitDays)
+	}
+
+	api := r.Labels[1]
+	if api.Label != "api" || api.Starved {
+		t.Fatalf("second label = %s (starved %v), want unstarved api", api.Label, api.Starved)
+	}
+	if api.Ready != 1 || api.PickedUp != 2 || api.MedianPickupDays != 2 {
+		t.Errorf("api ready=%d picked=%d pickup=%v, want 1, 2, 2d", api.Ready, api.PickedUp, api.MedianPickupDays)
+	}
+	if api.LastPickup == nil || !api.LastPickup.Equal(day(21)) {
+		t.Errorf("api last pickup = %v, want day 21", api.LastPickup)
+	}
+
+	if r.Labels[2].Label != UnlabeledFairnessLabel || r.Labels[2].Ready != 1 {
+		t.Errorf("third label = %+v, want one unlabeled ready issue", r.Labels[2])
+	}
+}
+
+func TestComputeReadyFairnessWithoutHistory(t *testing.T) {
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, Labels: []string{"ops"}, CreatedAt: now.Add(-20 * 24 * time.Hour)},
+	}
+	r := ComputeReadyFairness(issues, nil, 0, now)
+	if r.HasHistory || r.StarvationDays != DefaultStarvationDays {
+		t.Errorf("history/window = %v/%d, want false/%d", r.HasHistory, r.StarvationDays, DefaultStarvationDays)
+	}
+	if len(r.Labels) != 1 || !r.Labels[0].Starved || r.Labels[0].PickedUp != 0 {
+		t.Errorf("labels = %+v, want one starved ops label", r.Labels)
+	}
+}
+
+func TestComputeReadyFairnessCustomOpenStatus(t *testing.T) {
+	model.RegisterCustomStatuses([]model.CustomStatus{{Name: "triage", Column: model.StatusOpen}})
+	defer model.RegisterCustomStatuses(nil)
+
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "a", Status: "triage", Labels: []string{"ops"}, CreatedAt: now.Add(-20 * 24 * time.Hour)},
+	}
+	r := ComputeReadyFairness(issues, nil, 0, now)
+	if len(r.Labels) != 1 || !r.Labels[0].Starved {
+		t.Errorf("labels = %+v, want triage counted as ready and ops starved", r.Labels)
+	}
+}
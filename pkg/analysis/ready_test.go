@@ -0,0 +1,56 @@
+package analysis_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestBlockedByMap(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen},
+		{ID: "B", Status: model.StatusClosed},
+		{ID: "C", Status: model.StatusOpen, Dependencies: blockedBy("A", "B")},
+		{ID: "D", Status: model.StatusOpen, Dependencies: blockedBy("B")},
+		{ID: "E", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepParentChild}}},
+	}
+
+	got := analysis.BlockedByMap(issues)
+	want := map[string][]string{"C": {"A"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BlockedByMap = %v, want %v", got, want)
+	}
+}
+
+func TestReadyIssues(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "low", Status: model.StatusOpen, Priority: 3},
+		{ID: "hub", Status: model.StatusOpen, Priority: 1},
+		{ID: "side", Status: model.StatusInProgress, Priority: 1},
+		{ID: "waiting", Status: model.StatusOpen, Priority: 0, Dependencies: blockedBy("hub")},
+		{ID: "flagged", Status: model.StatusBlocked, Priority: 0},
+		{ID: "done", Status: model.StatusClosed, Priority: 0},
+	}
+	pageRank := map[string]float64{"hub": 0.4, "side": 0.1}
+
+	var ids []string
+	for _, iss := range analysis.ReadyIssues(issues, pageRank) {
+		ids = append(ids, iss.ID)
+	}
+	want := []string{"hub", "side", "low"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("ReadyIssues = %v, want %v", ids, want)
+	}
+
+	// Without PageRank, ties fall back to ID order
+	ids = ids[:0]
+	for _, iss := range analysis.ReadyIssues(issues, nil) {
+		ids = append(ids, iss.ID)
+	}
+	want = []string{"hub", "side", "low"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("ReadyIssues(nil) = %v, want %v", ids, want)
+	}
+}
@@ -0,0 +1,280 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// RetroTopN is how many entries the retro keeps for ranked lists.
+const RetroTopN = 5
+
+// RetroIssue is a lightweight issue reference used in retro lists.
+type RetroIssue struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RetroBlocked describes how long an issue waited on its blockers.
+type RetroBlocked struct {
+	ID           string        `json:"id"`
+	Title        string        `json:"title"`
+	BlockedBy    string        `json:"blocked_by"` // The blocker that released it last
+	Waited       time.Duration `json:"waited"`
+	StillBlocked bool          `json:"still_blocked"`
+}
+
+// CycleTimeBucket counts closed issues whose created→closed time falls in
+// (previous bucket's Max, Max]. A zero Max means unbounded.
+type CycleTimeBucket struct {
+	Label string        `json:"label"`
+	Max   time.Duration `json:"max"`
+	Count int           `json:"count"`
+}
+
+// DefaultCycleTimeBuckets are the cycle time ranges used by the retro.
+var DefaultCycleTimeBuckets = []CycleTimeBucket{
+	{Label: "<1d", Max: 24 * time.Hour},
+	{Label: "1-3d", Max: 3 * 24 * time.Hour},
+	{Label: "3-7d", Max: 7 * 24 * time.Hour},
+	{Label: "1-2w", Max: 14 * 24 * time.Hour},
+	{Label: "2-4w", Max: 28 * 24 * time.Hour},
+	{Label: "4w+"},
+}
+
+// EpicRetro is a planned-vs-actual retrospective for an epic's descendants.
+type EpicRetro struct {
+	EpicID      string       `json:"epic_id"`
+	EpicTitle   string       `json:"epic_title"`
+	EpicStatus  model.Status `json:"epic_status"`
+	GeneratedAt time.Time    `json:"generated_at"`
+
+	Total   int `json:"total"`   // Descendants of the epic
+	Closed  int `json:"closed"`  // Closed descendants
+	Planned int `json:"planned"` // Descendants that existed when the first one closed
+
+	// Issues created after work started (the first closure)
+	AddedMidFlight []RetroIssue `json:"added_mid_flight"`
+
+	FirstClosure time.Time     `json:"first_closure"`
+	LastClosure  time.Time     `json:"last_closure"`
+	Span         time.Duration `json:"span"` // LastClosure - FirstClosure
+
+	LongestBlocked []RetroBlocked    `json:"longest_blocked"`
+	CycleTimes     []CycleTimeBucket `json:"cycle_times"`
+	MedianCycle    time.Duration     `json:"median_cycle"`
+	P90Cycle       time.Duration     `json:"p90_cycle"`
+}
+
+// IsComplete reports whether every descendant is closed.
+func (r *EpicRetro) IsComplete() bool {
+	return r.Total > 0 && r.Closed == r.Total
+}
+
+// MaxCycleBucket returns the largest bucket count (used to scale bars).
+func (r *EpicRetro) MaxCycleBucket() int {
+	maxCount := 0
+	for _, b := range r.CycleTimes {
+		if b.Count > maxCount {
+			maxCount = b.Count
+		}
+	}
+	return maxCount
+}
+
+// parentChildIndex maps each parent ID to the indexes of its direct children.
+func parentChildIndex(issues []model.Issue) map[string][]int {
+	children := make(map[string][]int)
+	for i, iss := range issues {
+		for _, dep := range iss.Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				children[dep.DependsOnID] = append(children[dep.DependsOnID], i)
+			}
+		}
+	}
+	return children
+}
+
+// descendantIndexes walks parent-child links from rootID and returns the
+// indexes of every reachable issue, in input order.
+func descendantIndexes(rootID string, issues []model.Issue, children map[string][]int) []int {
+	seen := map[string]bool{rootID: true}
+	var idx []int
+	queue := []string{rootID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, i := range children[current] {
+			id := issues[i].ID
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			idx = append(idx, i)
+			queue = append(queue, id)
+		}
+	}
+	sort.Ints(idx)
+	return idx
+}
+
+// epicDescendants returns every issue reachable from epicID through
+// parent-child dependencies, in input order.
+func epicDescendants(epicID string, issues []model.Issue) []model.Issue {
+	idx := descendantIndexes(epicID, issues, parentChildIndex(issues))
+	result := make([]model.Issue, len(idx))
+	for i, j := range idx {
+		result[i] = issues[j]
+	}
+	return result
+}
+
+// ComputeEpicRetro builds a retrospective for the epic's descendants.
+//
+// Without status history, "work started" is the first closure: issues created
+// after it count as added mid-flight. Blocked time is how long an issue existed
+// before its last blocker closed (capped at its own closure, or now if a
+// blocker is still open). Cycle time is created→closed.
+func ComputeEpicRetro(epicID string, issues []model.Issue, now time.Time) (*EpicRetro, error) {
+	issueMap := make(map[string]model.Issue, len(issues))
+	for _, iss := range issues {
+		issueMap[iss.ID] = iss
+	}
+	epic, ok := issueMap[epicID]
+	if !ok {
+		return nil, fmt.Errorf("issue %q not found", epicID)
+	}
+
+	descendants := epicDescendants(epicID, issues)
+	if len(descendants) == 0 {
+		return nil, fmt.Errorf("%s has no child issues", epicID)
+	}
+
+	r := &EpicRetro{
+		EpicID:      epic.ID,
+		EpicTitle:   epic.Title,
+		EpicStatus:  epic.Status,
+		GeneratedAt: now,
+		Total:       len(descendants),
+	}
+
+	var cycles []time.Duration
+	for _, iss := range descendants {
+		closedAt := issueClosedAt(iss)
+		if closedAt.IsZero() {
+			continue
+		}
+		r.Closed++
+		if r.FirstClosure.IsZero() || closedAt.Before(r.FirstClosure) {
+			r.FirstClosure = closedAt
+		}
+		if closedAt.After(r.LastClosure) {
+			r.LastClosure = closedAt
+		}
+		if !iss.CreatedAt.IsZero() && closedAt.After(iss.CreatedAt) {
+			cycles = append(cycles, closedAt.Sub(iss.CreatedAt))
+		}
+	}
+	if !r.FirstClosure.IsZero() {
+		r.Span = r.LastClosure.Sub(r.FirstClosure)
+	}
+
+	// Planned vs added mid-flight
+	for _, iss := range descendants {
+		if !r.FirstClosure.IsZero() && iss.CreatedAt.After(r.FirstClosure) {
+			r.AddedMidFlight = append(r.AddedMidFlight, RetroIssue{ID: iss.ID, Title: iss.Title, CreatedAt: iss.CreatedAt})
+			continue
+		}
+		r.Planned++
+	}
+	sort.SliceStable(r.AddedMidFlight, func(i, j int) bool {
+		return r.AddedMidFlight[i].CreatedAt.Before(r.AddedMidFlight[j].CreatedAt)
+	})
+
+	r.LongestBlocked = longestBlocked(descendants, issueMap, now)
+
+	r.CycleTimes = make([]CycleTimeBucket, len(DefaultCycleTimeBuckets))
+	copy(r.CycleTimes, DefaultCycleTimeBuckets)
+	for _, c := range cycles {
+		for i := range r.CycleTimes {
+			if r.CycleTimes[i].Max == 0 || c <= r.CycleTimes[i].Max {
+				r.CycleTimes[i].Count++
+				break
+			}
+		}
+	}
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i] < cycles[j] })
+	r.MedianCycle = durationPercentile(cycles, 0.5)
+	r.P90Cycle = durationPercentile(cycles, 0.9)
+
+	return r, nil
+}
+
+// longestBlocked ranks issues by how long they waited on blocking dependencies.
+func longestBlocked(descendants []model.Issue, issueMap map[string]model.Issue, now time.Time) []RetroBlocked {
+	var blocked []RetroBlocked
+	for _, iss := range descendants {
+		if iss.CreatedAt.IsZero() {
+			continue
+		}
+		end := now
+		if closedAt := issueClosedAt(iss); !closedAt.IsZero() {
+			end = closedAt
+		}
+
+		var worst RetroBlocked
+		for _, dep := range iss.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			blocker, ok := issueMap[dep.DependsOnID]
+			if !ok {
+				continue
+			}
+			released := issueClosedAt(blocker)
+			stillBlocked := released.IsZero()
+			if stillBlocked || released.After(end) {
+				released = end
+			}
+			waited := released.Sub(iss.CreatedAt)
+			if waited > worst.Waited {
+				worst = RetroBlocked{
+					ID:           iss.ID,
+					Title:        iss.Title,
+					BlockedBy:    blocker.ID,
+					Waited:       waited,
+					StillBlocked: stillBlocked && !iss.Status.IsClosed(),
+				}
+			}
+		}
+		if worst.Waited > 0 {
+			blocked = append(blocked, worst)
+		}
+	}
+
+	sort.SliceStable(blocked, func(i, j int) bool {
+		return blocked[i].Waited > blocked[j].Waited
+	})
+	if len(blocked) > RetroTopN {
+		blocked = blocked[:RetroTopN]
+	}
+	return blocked
+}
+
+// durationPercentile returns the nearest-rank percentile of sorted durations.
+func durationPercentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(p*float64(len(sorted))+0.5) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
@@ -0,0 +1,79 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeEpicRetro(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return base.Add(time.Duration(n) * 24 * time.Hour) }
+	closed := func(n int) *time.Time { ts := day(n); return &ts }
+	childOf := func(parent string, blockers ...string) []*model.Dependency {
+		deps := []*model.Dependency{{DependsOnID: parent, Type: model.DepParentChild}}
+		for _, b := range blockers {
+			deps = append(deps, &model.Dependency{DependsOnID: b, Type: model.DepBlocks})
+		}
+		return deps
+	}
+
+	issues := []model.Issue{
+		{ID: "E", Title: "Epic", IssueType: model.TypeEpic, Status: model.StatusOpen, CreatedAt: day(0)},
+		{ID: "a", Status: model.StatusClosed, CreatedAt: day(0), ClosedAt: closed(2), Dependencies: childOf("E")},
+		{ID: "b", Status: model.StatusClosed, CreatedAt: day(1), ClosedAt: closed(12), Dependencies: childOf("E", "a")},
+		{ID: "sub", Status: model.StatusOpen, CreatedAt: day(1), Dependencies: childOf("E")},
+		{ID: "c", Status: model.StatusOpen, CreatedAt: day(5), Dependencies: childOf("sub", "b")},
+		{ID: "other", Status: model.StatusOpen, CreatedAt: day(0)},
+	}
+	now := day(20)
+
+	r, err := ComputeEpicRetro("E", issues, now)
+	if err != nil {
+		t.Fatalf("ComputeEpicRetro: %v", err)
+	}
+
+	if r.Total != 4 || r.Closed != 2 {
+		t.Errorf("total/closed = %d/%d, want 4/2", r.Total, r.Closed)
+	}
+	if r.IsComplete() {
+		t.Error("epic with open children should not be complete")
+	}
+	if !r.FirstClosure.Equal(day(2)) || !r.LastClosure.Equal(day(12)) || r.Span != 10*24*time.Hour {
+		t.Errorf("closure span = %v → %v (%v)", r.FirstClosure, r.LastClosure, r.Span)
+	}
+	if r.Planned != 3 || len(r.AddedMidFlight) != 1 || r.AddedMidFlight[0].ID != "c" {
+		t.Errorf("planned=%d added=%+v, want 3 planned and c added", r.Planned, r.AddedMidFlight)
+	}
+
+	// b waited 1 day for a; c waited 7 days for b
+	if len(r.LongestBlocked) != 2 || r.LongestBlocked[0].ID != "c" || r.LongestBlocked[0].Waited != 7*24*time.Hour {
+		t.Fatalf("longest blocked = %+v", r.LongestBlocked)
+	}
+	if r.LongestBlocked[0].StillBlocked {
+		t.Error("c's blocker is closed, it should not be still blocked")
+	}
+
+	// Cycle times: a = 2d (1-3d), b = 11d (1-2w)
+	counts := map[string]int{}
+	for _, b := range r.CycleTimes {
+		counts[b.Label] = b.Count
+	}
+	if counts["1-3d"] != 1 || counts["1-2w"] != 1 {
+		t.Errorf("cycle buckets = %v", counts)
+	}
+	if r.MedianCycle != 2*24*time.Hour || r.P90Cycle != 11*24*time.Hour {
+		t.Errorf("median/p90 = %v/%v", r.MedianCycle, r.P90Cycle)
+	}
+}
+
+func TestComputeEpicRetroErrors(t *testing.T) {
+	issues := []model.Issue{{ID: "lonely", Status: model.StatusOpen}}
+	if _, err := ComputeEpicRetro("missing", issues, time.Now()); err == nil {
+		t.Error("expected error for unknown epic")
+	}
+	if _, err := ComputeEpicRetro("lonely", issues, time.Now()); err == nil {
+		t.Error("expected error for epic without children")
+	}
+}
@@ -342,7 +342,7 @@ func ComputeAllRiskSignals(
 	weights := DefaultRiskWeights()
 
 	for id, issue := range issues {
-		if issue.Status == model.StatusClosed {
+		if issue.Status.IsClosed() {
 			continue // Skip closed issues
 		}
 		result[id] = ComputeRiskSignalsWithWeights(&issue, stats, issues, now, weights)
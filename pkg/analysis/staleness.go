@@ -0,0 +1,83 @@
+package analysis
+
+import (
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// StaleLevel grades how long an open issue has gone without an update
+type StaleLevel int
+
+const (
+	Fresh StaleLevel = iota // Updated within the stale threshold
+	Aging                   // Untouched for at least the threshold
+	Stale                   // Untouched for at least twice the threshold
+)
+
+// String returns the level name used in robot output
+func (s StaleLevel) String() string {
+	switch s {
+	case Aging:
+		return "aging"
+	case Stale:
+		return "stale"
+	default:
+		return "fresh"
+	}
+}
+
+// MarshalText encodes the level by name, so JSON reads "stale" rather than 2
+func (s StaleLevel) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// IssueAge holds the computed age of an issue
+type IssueAge struct {
+	AgeDays         int        `json:"age_days"`          // Days since creation
+	DaysSinceUpdate int        `json:"days_since_update"` // Days since the last update (or creation)
+	Staleness       StaleLevel `json:"staleness"`         // Always Fresh for closed issues
+}
+
+// ComputeIssueAge computes an issue's age and staleness at now. staleDays is
+// the inactivity that makes an open issue Aging; if 0, uses
+// DefaultStaleThresholdDays.
+func ComputeIssueAge(issue model.Issue, now time.Time, staleDays int) IssueAge {
+	if staleDays <= 0 {
+		staleDays = DefaultStaleThresholdDays
+	}
+
+	lastUpdate := LastUpdate(issue)
+	age := IssueAge{
+		AgeDays:         daysBetween(issue.CreatedAt, now),
+		DaysSinceUpdate: daysBetween(lastUpdate, now),
+	}
+
+	if issue.Status.IsClosed() || lastUpdate.IsZero() {
+		return age
+	}
+	switch {
+	case age.DaysSinceUpdate >= 2*staleDays:
+		age.Staleness = Stale
+	case age.DaysSinceUpdate >= staleDays:
+		age.Staleness = Aging
+	}
+	return age
+}
+
+// LastUpdate returns when an issue last changed: its update time, or its
+// creation time when it was never updated
+func LastUpdate(issue model.Issue) time.Time {
+	if issue.UpdatedAt.IsZero() || issue.UpdatedAt.Before(issue.CreatedAt) {
+		return issue.CreatedAt
+	}
+	return issue.UpdatedAt
+}
+
+// daysBetween returns the whole days from t to now, 0 when t is unset or later
+func daysBetween(t, now time.Time) int {
+	if t.IsZero() || !now.After(t) {
+		return 0
+	}
+	return int(now.Sub(t).Hours() / 24)
+}
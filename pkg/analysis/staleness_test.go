@@ -0,0 +1,76 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeIssueAge(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.AddDate(0, 0, -d) }
+
+	tests := []struct {
+		name      string
+		issue     model.Issue
+		staleDays int
+		wantAge   int
+		wantIdle  int
+		want      StaleLevel
+	}{
+		{"fresh", model.Issue{Status: model.StatusOpen, CreatedAt: daysAgo(40), UpdatedAt: daysAgo(3)}, 0, 40, 3, Fresh},
+		{"aging at default threshold", model.Issue{Status: model.StatusOpen, CreatedAt: daysAgo(40), UpdatedAt: daysAgo(14)}, 0, 40, 14, Aging},
+		{"stale at twice the threshold", model.Issue{Status: model.StatusInProgress, CreatedAt: daysAgo(40), UpdatedAt: daysAgo(28)}, 0, 40, 28, Stale},
+		{"custom threshold", model.Issue{Status: model.StatusOpen, CreatedAt: daysAgo(10), UpdatedAt: daysAgo(10)}, 5, 10, 10, Stale},
+		{"never updated falls back to creation", model.Issue{Status: model.StatusOpen, CreatedAt: daysAgo(20)}, 0, 20, 20, Aging},
+		{"closed is never stale", model.Issue{Status: model.StatusClosed, CreatedAt: daysAgo(90), UpdatedAt: daysAgo(90)}, 0, 90, 90, Fresh},
+		{"no timestamps", model.Issue{Status: model.StatusOpen}, 0, 0, 0, Fresh},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ComputeIssueAge(tt.issue, now, tt.staleDays)
+			if got.AgeDays != tt.wantAge || got.DaysSinceUpdate != tt.wantIdle || got.Staleness != tt.want {
+				t.Errorf("got %+v, want age %d, idle %d, %s", got, tt.wantAge, tt.wantIdle, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeWorkstreamStall(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.AddDate(0, 0, -d) }
+	closedAgo := func(d int) *time.Time { c := daysAgo(d); return &c }
+
+	tests := []struct {
+		name     string
+		issues   []model.Issue
+		wantIdle int
+		want     StaleLevel
+	}{
+		{"recent update", []model.Issue{
+			{Status: model.StatusOpen, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(40)},
+			{Status: model.StatusInProgress, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(2)},
+		}, 2, Fresh},
+		{"recent closure", []model.Issue{
+			{Status: model.StatusOpen, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(40)},
+			{Status: model.StatusClosed, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(50), ClosedAt: closedAgo(5)},
+		}, 5, Fresh},
+		{"stalled", []model.Issue{
+			{Status: model.StatusOpen, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(20)},
+			{Status: model.StatusBlocked, CreatedAt: daysAgo(18)},
+		}, 18, Aging},
+		{"long stalled", []model.Issue{
+			{Status: model.StatusOpen, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(30)},
+		}, 30, Stale},
+		{"nothing open", []model.Issue{
+			{Status: model.StatusClosed, CreatedAt: daysAgo(90), ClosedAt: closedAgo(60)},
+		}, 60, Fresh},
+	}
+	for _, tt := range tests {
+		got := ComputeWorkstreamStall(Workstream{Issues: tt.issues}, now, 0)
+		if got.IdleDays != tt.wantIdle || got.Level != tt.want {
+			t.Errorf("%s: got %+v, want idle %d, %s", tt.name, got, tt.wantIdle, tt.want)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// TrackerEventType names a kind of change between two loads of the tracker
+type TrackerEventType string
+
+const (
+	EventWatching        TrackerEventType = "watching"         // Watch started; Path and Count are set
+	EventIssueCreated    TrackerEventType = "issue_created"    // A new issue appeared
+	EventIssueDeleted    TrackerEventType = "issue_deleted"    // An issue disappeared
+	EventIssueClosed     TrackerEventType = "issue_closed"     // An issue moved to closed
+	EventStatusChanged   TrackerEventType = "status_changed"   // Any other status move, reopening included
+	EventBlockerResolved TrackerEventType = "blocker_resolved" // BlockerID no longer blocks ID
+	EventReadyChanged    TrackerEventType = "ready_changed"    // The ready set gained Added and lost Removed
+)
+
+// TrackerEvent is one change in the tracker, as emitted by `bv watch`
+type TrackerEvent struct {
+	Type      TrackerEventType `json:"type"`
+	Time      time.Time        `json:"time"`
+	ID        string           `json:"id,omitempty"`
+	Title     string           `json:"title,omitempty"`
+	From      model.Status     `json:"from,omitempty"`       // Previous status
+	To        model.Status     `json:"to,omitempty"`         // New status
+	BlockerID string           `json:"blocker_id,omitempty"` // For blocker_resolved
+	Added     []string         `json:"added,omitempty"`      // For ready_changed
+	Removed   []string         `json:"removed,omitempty"`    // For ready_changed
+	Path      string           `json:"path,omitempty"`       // For watching
+	Count     int              `json:"count,omitempty"`      // For watching: issues loaded
+}
+
+// DiffTrackerEvents returns the events that turn prev into next, stamped at.
+// Events come grouped by type (created, deleted, status moves, resolved
+// blockers, then the ready set) and sorted by issue ID within a group, so the
+// same change always yields the same stream.
+func DiffTrackerEvents(prev, next []model.Issue, at time.Time) []TrackerEvent {
+	prevByID := make(map[string]model.Issue, len(prev))
+	for _, issue := range prev {
+		prevByID[issue.ID] = issue
+	}
+	nextByID := make(map[string]model.Issue, len(next))
+	for _, issue := range next {
+		nextByID[issue.ID] = issue
+	}
+
+	var created, deleted, moved, resolved []TrackerEvent
+	for _, issue := range next {
+		old, ok := prevByID[issue.ID]
+		if !ok {
+			created = append(created, TrackerEvent{Type: EventIssueCreated, Time: at, ID: issue.ID, Title: issue.Title, To: issue.Status})
+			continue
+		}
+		if old.Status != issue.Status {
+			ev := TrackerEvent{Type: EventStatusChanged, Time: at, ID: issue.ID, Title: issue.Title, From: old.Status, To: issue.Status}
+			if issue.Status.IsClosed() && !old.Status.IsClosed() {
+				ev.Type = EventIssueClosed
+			}
+			moved = append(moved, ev)
+		}
+	}
+	for _, issue := range prev {
+		if _, ok := nextByID[issue.ID]; !ok {
+			deleted = append(deleted, TrackerEvent{Type: EventIssueDeleted, Time: at, ID: issue.ID, Title: issue.Title, From: issue.Status})
+		}
+	}
+
+	// A blocker is resolved when it stops blocking an issue that is still
+	// tracked: it closed, or the dependency was dropped
+	prevBlockedBy := BlockedByMap(prev)
+	nextBlockedBy := BlockedByMap(next)
+	for id, blockers := range prevBlockedBy {
+		issue, ok := nextByID[id]
+		if !ok {
+			continue
+		}
+		still := make(map[string]bool, len(nextBlockedBy[id]))
+		for _, b := range nextBlockedBy[id] {
+			still[b] = true
+		}
+		for _, b := range blockers {
+			if !still[b] {
+				resolved = append(resolved, TrackerEvent{Type: EventBlockerResolved, Time: at, ID: id, Title: issue.Title, BlockerID: b})
+			}
+		}
+	}
+
+	byID := func(evs []TrackerEvent) {
+		sort.Slice(evs, func(i, j int) bool {
+			if evs[i].ID != evs[j].ID {
+				return evs[i].ID < evs[j].ID
+			}
+			return evs[i].BlockerID < evs[j].BlockerID
+		})
+	}
+	byID(created)
+	byID(deleted)
+	byID(moved)
+	byID(resolved)
+
+	events := append(append(append(created, deleted...), moved...), resolved...)
+	if added, removed := readySetChange(prev, next); len(added) > 0 || len(removed) > 0 {
+		events = append(events, TrackerEvent{Type: EventReadyChanged, Time: at, Added: added, Removed: removed})
+	}
+	return events
+}
+
+// readySetChange returns the IDs that joined and left the ready set, sorted
+func readySetChange(prev, next []model.Issue) (added, removed []string) {
+	wasReady := make(map[string]bool)
+	for _, issue := range ReadyIssues(prev, nil) {
+		wasReady[issue.ID] = true
+	}
+	isReady := make(map[string]bool)
+	for _, issue := range ReadyIssues(next, nil) {
+		isReady[issue.ID] = true
+		if !wasReady[issue.ID] {
+			added = append(added, issue.ID)
+		}
+	}
+	for id := range wasReady {
+		if !isReady[id] {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
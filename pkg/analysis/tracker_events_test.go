@@ -0,0 +1,92 @@
+package analysis_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// eventSummary renders events compactly for comparison
+func eventSummary(events []analysis.TrackerEvent) string {
+	var parts []string
+	for _, ev := range events {
+		switch ev.Type {
+		case analysis.EventStatusChanged, analysis.EventIssueClosed:
+			parts = append(parts, fmt.Sprintf("%s %s %s->%s", ev.Type, ev.ID, ev.From, ev.To))
+		case analysis.EventBlockerResolved:
+			parts = append(parts, fmt.Sprintf("%s %s by %s", ev.Type, ev.ID, ev.BlockerID))
+		case analysis.EventReadyChanged:
+			parts = append(parts, fmt.Sprintf("%s +%v -%v", ev.Type, ev.Added, ev.Removed))
+		default:
+			parts = append(parts, fmt.Sprintf("%s %s", ev.Type, ev.ID))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+func TestDiffTrackerEvents(t *testing.T) {
+	prev := []model.Issue{
+		{ID: "api", Status: model.StatusOpen},
+		{ID: "ui", Status: model.StatusOpen, Dependencies: blockedBy("api")},
+		{ID: "docs", Status: model.StatusOpen, Dependencies: blockedBy("spike")},
+		{ID: "spike", Status: model.StatusInProgress},
+		{ID: "old", Status: model.StatusClosed},
+		{ID: "gone", Status: model.StatusOpen},
+	}
+
+	tests := []struct {
+		name string
+		next []model.Issue
+		want string
+	}{
+		{
+			name: "unchanged",
+			next: prev,
+			want: "",
+		},
+		{
+			name: "closing a blocker frees its dependent",
+			next: []model.Issue{
+				{ID: "api", Status: model.StatusClosed},
+				{ID: "ui", Status: model.StatusOpen, Dependencies: blockedBy("api")},
+				{ID: "docs", Status: model.StatusOpen, Dependencies: blockedBy("spike")},
+				{ID: "spike", Status: model.StatusInProgress},
+				{ID: "old", Status: model.StatusClosed},
+				{ID: "gone", Status: model.StatusOpen},
+			},
+			want: "issue_closed api open->closed; blocker_resolved ui by api; ready_changed +[ui] -[api]",
+		},
+		{
+			name: "dropped dependency, reopen, create and delete",
+			next: []model.Issue{
+				{ID: "api", Status: model.StatusOpen},
+				{ID: "ui", Status: model.StatusOpen, Dependencies: blockedBy("api")},
+				{ID: "docs", Status: model.StatusOpen},
+				{ID: "spike", Status: model.StatusInProgress},
+				{ID: "old", Status: model.StatusOpen},
+				{ID: "new", Status: model.StatusBlocked},
+			},
+			want: "issue_created new; issue_deleted gone; status_changed old closed->open; " +
+				"blocker_resolved docs by spike; ready_changed +[docs old] -[gone]",
+		},
+	}
+
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := analysis.DiffTrackerEvents(prev, tt.next, at)
+			if got := eventSummary(events); got != tt.want {
+				t.Errorf("events:\n got %s\nwant %s", got, tt.want)
+			}
+			for _, ev := range events {
+				if !ev.Time.Equal(at) {
+					t.Errorf("%s stamped %v, want %v", ev.Type, ev.Time, at)
+				}
+			}
+		})
+	}
+}
@@ -163,7 +163,7 @@ func ComputeProjectVelocity(issues []model.Issue, now time.Time, weeks int) *Vel
 	monthAgo := now.Add(-30 * 24 * time.Hour)
 
 	for _, iss := range issues {
-		if iss.Status != model.StatusClosed {
+		if !iss.Status.IsClosed() {
 			continue
 		}
 
@@ -457,7 +457,7 @@ func buildUnblocksMap(analyzer *Analyzer, issues []model.Issue) map[string][]str
 	openBlockerCount := make(map[string]int, len(issues))
 
 	for _, dependent := range issues {
-		if dependent.Status == model.StatusClosed {
+		if dependent.Status.IsClosed() {
 			continue
 		}
 		if len(dependent.Dependencies) == 0 {
@@ -483,7 +483,7 @@ func buildUnblocksMap(analyzer *Analyzer, issues []model.Issue) map[string][]str
 			seen[blockerID] = struct{}{}
 
 			dependentsByBlocker[blockerID] = append(dependentsByBlocker[blockerID], dependent.ID)
-			if blocker.Status != model.StatusClosed {
+			if !blocker.Status.IsClosed() {
 				openBlockerCount[dependent.ID]++
 			}
 		}
@@ -491,14 +491,14 @@ func buildUnblocksMap(analyzer *Analyzer, issues []model.Issue) map[string][]str
 
 	unblocksMap := make(map[string][]string, len(issues))
 	for _, blocker := range issues {
-		if blocker.Status == model.StatusClosed {
+		if blocker.Status.IsClosed() {
 			continue
 		}
 
 		var unblocks []string
 		for _, dependentID := range dependentsByBlocker[blocker.ID] {
 			depIssue, ok := issueByID[dependentID]
-			if !ok || depIssue.Status == model.StatusClosed {
+			if !ok || depIssue.Status.IsClosed() {
 				continue
 			}
 			if openBlockerCount[dependentID] == 1 {
@@ -533,7 +533,7 @@ func computeCounts(issues []model.Issue, analyzer *Analyzer) HealthCounts {
 		counts.ByType[string(issue.IssueType)]++
 		counts.ByPriority[issue.Priority]++
 
-		if issue.Status == model.StatusClosed {
+		if issue.Status.IsClosed() {
 			counts.Closed++
 		} else {
 			counts.Open++
@@ -682,7 +682,7 @@ func buildBlockersToClear(analyzer *Analyzer, unblocksMap map[string][]string, l
 			continue
 		}
 		issue := analyzer.GetIssue(id)
-		if issue == nil || issue.Status == model.StatusClosed {
+		if issue == nil || issue.Status.IsClosed() {
 			continue
 		}
 		blockers = append(blockers, blocker{
@@ -904,7 +904,7 @@ func computeSingleTriageScore(base ImpactScore, unblocksMap map[string][]string,
 	// Calculate quick-win boost
 	// Quick wins are items with low blocker depth but high impact
 	blockerDepth := analyzer.GetBlockerDepth(base.IssueID)
-	if issue := analyzer.GetIssue(base.IssueID); issue == nil || issue.Status != model.StatusInProgress {
+	if issue := analyzer.GetIssue(base.IssueID); issue == nil || issue.Status.Column() != model.StatusInProgress {
 		if blockerDepth <= opts.QuickWinMaxDepth && blockerDepth >= 0 {
 			// Lower depth = higher quick win potential
 			depthFactor := 1.0 - float64(blockerDepth)/float64(opts.QuickWinMaxDepth+1)
@@ -1042,7 +1042,7 @@ func GenerateTriageReasons(ctx TriageReasonContext) TriageReasons {
 	var reasons []string
 	primary := ""
 	actionHint := "Start work on this issue"
-	if ctx.Issue != nil && ctx.Issue.Status == model.StatusInProgress {
+	if ctx.Issue != nil && ctx.Issue.Status.Column() == model.StatusInProgress {
 		actionHint = "Continue work on this issue"
 	}
 
@@ -1091,13 +1091,13 @@ func GenerateTriageReasons(ctx TriageReasonContext) TriageReasons {
 	if ctx.DaysSinceUpdate > 14 {
 		reason := fmt.Sprintf("🕐 No activity in %d days - may need review", ctx.DaysSinceUpdate)
 		reasons = append(reasons, reason)
-		if ctx.Issue != nil && ctx.Issue.Status == model.StatusInProgress {
+		if ctx.Issue != nil && ctx.Issue.Status.Column() == model.StatusInProgress {
 			actionHint = "Check if this is stuck and needs help"
 		}
 	} else if ctx.DaysSinceUpdate > 7 {
 		reason := fmt.Sprintf("📅 Last updated %d days ago", ctx.DaysSinceUpdate)
 		reasons = append(reasons, reason)
-		if ctx.Issue != nil && ctx.Issue.Status == model.StatusInProgress {
+		if ctx.Issue != nil && ctx.Issue.Status.Column() == model.StatusInProgress {
 			actionHint = "Continue work on this issue"
 		}
 	}
@@ -1111,7 +1111,7 @@ func GenerateTriageReasons(ctx TriageReasonContext) TriageReasons {
 		}
 
 		// Update action hint unless in-progress (keep work/review guidance) or critically stale
-		isInProgress := ctx.Issue != nil && ctx.Issue.Status == model.StatusInProgress
+		isInProgress := ctx.Issue != nil && ctx.Issue.Status.Column() == model.StatusInProgress
 		isCriticalStale := isInProgress && ctx.DaysSinceUpdate > 14
 		if !isInProgress && !isCriticalStale {
 			actionHint = "Quick win - start here for fast progress"
@@ -1119,7 +1119,7 @@ func GenerateTriageReasons(ctx TriageReasonContext) TriageReasons {
 	}
 
 	// 6. Agent claim status
-	isInProgress := ctx.Issue != nil && ctx.Issue.Status == model.StatusInProgress
+	isInProgress := ctx.Issue != nil && ctx.Issue.Status.Column() == model.StatusInProgress
 	if isInProgress {
 		if ctx.ClaimedByAgent != "" {
 			reason := fmt.Sprintf("👤 Claimed by %s", ctx.ClaimedByAgent)
@@ -779,6 +779,24 @@ func TestGenerateTriageReasons_ClaimStatus(t *testing.T) {
 	}
 }
 
+func TestGenerateTriageReasons_CustomInProgressStatus(t *testing.T) {
+	model.RegisterCustomStatuses([]model.CustomStatus{{Name: "review", Column: model.StatusInProgress}})
+	defer model.RegisterCustomStatuses(nil)
+
+	ctx := TriageReasonContext{
+		Issue: &model.Issue{Status: "review"},
+	}
+	reasons := GenerateTriageReasons(ctx)
+	if reasons.ActionHint != "Continue work on this issue" {
+		t.Errorf("ActionHint = %q, want a custom in-progress status to continue work", reasons.ActionHint)
+	}
+	for _, r := range reasons.All {
+		if contains(r, "unclaimed") {
+			t.Fatalf("did not expect custom in-progress issue to be described as unclaimed; reasons=%v", reasons.All)
+		}
+	}
+}
+
 func TestGenerateTriageReasons_BlockedBy(t *testing.T) {
 	ctx := TriageReasonContext{
 		BlockedByIDs: []string{"bv-10", "bv-11"},
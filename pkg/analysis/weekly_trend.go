@@ -0,0 +1,219 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DefaultTrendWeeks is the window shown by the stats dashboard velocity charts.
+const DefaultTrendWeeks = 12
+
+// DefaultBurndownLabels caps how many labels get a burndown row.
+const DefaultBurndownLabels = 8
+
+// WeekTrend is one Monday-aligned week of issue flow.
+type WeekTrend struct {
+	WeekStart time.Time `json:"week_start"`
+	Opened    int       `json:"opened"`      // Issues created during the week
+	Closed    int       `json:"closed"`      // Issues closed during the week
+	OpenAtEnd int       `json:"open_at_end"` // Backlog size when the week ended (or now)
+}
+
+// WeeklyTrend is a series of weeks ordered oldest to newest; the last entry
+// is the current (partial) week.
+type WeeklyTrend struct {
+	Weeks []WeekTrend `json:"weeks"`
+}
+
+// ClosedSeries returns the per-week closed counts.
+func (wt WeeklyTrend) ClosedSeries() []int {
+	series := make([]int, len(wt.Weeks))
+	for i, w := range wt.Weeks {
+		series[i] = w.Closed
+	}
+	return series
+}
+
+// OpenedSeries returns the per-week created counts.
+func (wt WeeklyTrend) OpenedSeries() []int {
+	series := make([]int, len(wt.Weeks))
+	for i, w := range wt.Weeks {
+		series[i] = w.Opened
+	}
+	return series
+}
+
+// BacklogSeries returns the open count at the end of each week.
+func (wt WeeklyTrend) BacklogSeries() []int {
+	series := make([]int, len(wt.Weeks))
+	for i, w := range wt.Weeks {
+		series[i] = w.OpenAtEnd
+	}
+	return series
+}
+
+// MaxWeekly returns the largest weekly opened or closed count (used to scale charts).
+func (wt WeeklyTrend) MaxWeekly() int {
+	maxCount := 0
+	for _, w := range wt.Weeks {
+		maxCount = max(maxCount, w.Opened, w.Closed)
+	}
+	return maxCount
+}
+
+// AverageClosed returns mean closures per week over the last n completed
+// weeks (the current partial week is excluded).
+func (wt WeeklyTrend) AverageClosed(n int) float64 {
+	complete := len(wt.Weeks) - 1
+	if n > complete {
+		n = complete
+	}
+	if n <= 0 {
+		return 0
+	}
+	sum := 0
+	for _, w := range wt.Weeks[complete-n : complete] {
+		sum += w.Closed
+	}
+	return float64(sum) / float64(n)
+}
+
+// LabelBurndown tracks the remaining open issues of one label week by week.
+type LabelBurndown struct {
+	Label     string `json:"label"`
+	Remaining []int  `json:"remaining"` // Open at the end of each week, oldest first
+	Closed    int    `json:"closed"`    // Closed within the window
+}
+
+// Burned returns how much the label's backlog shrank over the window
+// (negative when it grew).
+func (lb LabelBurndown) Burned() int {
+	if len(lb.Remaining) == 0 {
+		return 0
+	}
+	return lb.Remaining[0] - lb.Remaining[len(lb.Remaining)-1]
+}
+
+// trendWeekStarts returns Monday-aligned week starts, oldest first, ending with
+// the week containing now.
+func trendWeekStarts(weeks int, now time.Time) []time.Time {
+	weekday := int(now.Weekday())
+	if weekday == 0 {
+		weekday = 7 // Sunday ends the week
+	}
+	current := time.Date(now.Year(), now.Month(), now.Day()-(weekday-1), 0, 0, 0, 0, now.Location())
+	starts := make([]time.Time, weeks)
+	for i := range starts {
+		starts[i] = current.AddDate(0, 0, -7*(weeks-1-i))
+	}
+	return starts
+}
+
+// weekEnd returns the exclusive end of week i, capped at now for the current week.
+func weekEnd(starts []time.Time, i int, now time.Time) time.Time {
+	if i == len(starts)-1 {
+		return now
+	}
+	return starts[i+1]
+}
+
+// openAt reports whether an issue existed and was not yet closed at t.
+func openAt(iss model.Issue, t time.Time) bool {
+	if iss.CreatedAt.IsZero() || !iss.CreatedAt.Before(t) {
+		return false
+	}
+	closedAt := issueClosedAt(iss)
+	return closedAt.IsZero() || !closedAt.Before(t)
+}
+
+// ComputeWeeklyTrend buckets created and closed timestamps into the last
+// `weeks` Monday-aligned weeks and records the backlog at each week's end.
+func ComputeWeeklyTrend(issues []model.Issue, weeks int, now time.Time) WeeklyTrend {
+	if weeks <= 0 {
+		weeks = DefaultTrendWeeks
+	}
+	starts := trendWeekStarts(weeks, now)
+	wt := WeeklyTrend{Weeks: make([]WeekTrend, weeks)}
+
+	weekIndex := func(ts time.Time) int {
+		if ts.IsZero() || ts.Before(starts[0]) || ts.After(now) {
+			return -1
+		}
+		return sort.Search(len(starts), func(i int) bool { return starts[i].After(ts) }) - 1
+	}
+
+	for i := range wt.Weeks {
+		wt.Weeks[i].WeekStart = starts[i]
+	}
+	for _, iss := range issues {
+		if idx := weekIndex(iss.CreatedAt); idx >= 0 {
+			wt.Weeks[idx].Opened++
+		}
+		if idx := weekIndex(issueClosedAt(iss)); idx >= 0 {
+			wt.Weeks[idx].Closed++
+		}
+		for i := range wt.Weeks {
+			if openAt(iss, weekEnd(starts, i, now)) {
+				wt.Weeks[i].OpenAtEnd++
+			}
+		}
+	}
+	return wt
+}
+
+// ComputeLabelBurndowns returns weekly remaining-open series for the labels
+// with the most work in the window (open now or closed during it), capped at
+// limit labels. Labels are ordered by that activity, then name.
+func ComputeLabelBurndowns(issues []model.Issue, weeks int, now time.Time, limit int) []LabelBurndown {
+	if weeks <= 0 {
+		weeks = DefaultTrendWeeks
+	}
+	starts := trendWeekStarts(weeks, now)
+
+	byLabel := make(map[string]*LabelBurndown)
+	for _, iss := range issues {
+		closedAt := issueClosedAt(iss)
+		closedInWindow := !closedAt.IsZero() && !closedAt.Before(starts[0])
+		for _, label := range iss.Labels {
+			lb, ok := byLabel[label]
+			if !ok {
+				lb = &LabelBurndown{Label: label, Remaining: make([]int, weeks)}
+				byLabel[label] = lb
+			}
+			if closedInWindow {
+				lb.Closed++
+			}
+			for i := range starts {
+				if openAt(iss, weekEnd(starts, i, now)) {
+					lb.Remaining[i]++
+				}
+			}
+		}
+	}
+
+	activity := func(lb *LabelBurndown) int {
+		return lb.Remaining[weeks-1] + lb.Closed
+	}
+	var result []LabelBurndown
+	var sorted []*LabelBurndown
+	for _, lb := range byLabel {
+		if activity(lb) > 0 {
+			sorted = append(sorted, lb)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if ai, aj := activity(sorted[i]), activity(sorted[j]); ai != aj {
+			return ai > aj
+		}
+		return sorted[i].Label < sorted[j].Label
+	})
+	for _, lb := range sorted {
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+		result = append(result, *lb)
+	}
+	return result
+}
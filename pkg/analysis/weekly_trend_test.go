@@ -0,0 +1,75 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeWeeklyTrend(t *testing.T) {
+	// Wednesday; the current week started Monday June 9
+	now := time.Date(2025, 6, 11, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.Add(-time.Duration(d) * 24 * time.Hour) }
+	closed := func(d int) *time.Time { ts := daysAgo(d); return &ts }
+
+	issues := []model.Issue{
+		{ID: "old", Status: model.StatusOpen, CreatedAt: daysAgo(60)},
+		{ID: "a", Status: model.StatusClosed, CreatedAt: daysAgo(9), ClosedAt: closed(7)},
+		{ID: "b", Status: model.StatusClosed, CreatedAt: daysAgo(9), ClosedAt: closed(1)},
+		{ID: "c", Status: model.StatusOpen, CreatedAt: daysAgo(1)},
+	}
+
+	wt := ComputeWeeklyTrend(issues, 3, now)
+	if len(wt.Weeks) != 3 {
+		t.Fatalf("len(Weeks) = %d, want 3", len(wt.Weeks))
+	}
+	if !wt.Weeks[2].WeekStart.Equal(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("current week start = %v, want 2025-06-09", wt.Weeks[2].WeekStart)
+	}
+
+	// Week of June 2: a and b created, a closed; current week: c created, b closed
+	prev, cur := wt.Weeks[1], wt.Weeks[2]
+	if prev.Opened != 2 || prev.Closed != 1 || prev.OpenAtEnd != 2 {
+		t.Errorf("previous week = %+v, want opened 2, closed 1, open 2", prev)
+	}
+	if cur.Opened != 1 || cur.Closed != 1 || cur.OpenAtEnd != 2 {
+		t.Errorf("current week = %+v, want opened 1, closed 1, open 2", cur)
+	}
+	if wt.Weeks[0].OpenAtEnd != 1 {
+		t.Errorf("oldest week backlog = %d, want 1", wt.Weeks[0].OpenAtEnd)
+	}
+	if got := wt.AverageClosed(4); got != 0.5 {
+		t.Errorf("AverageClosed = %v, want 0.5 (partial week excluded)", got)
+	}
+	if wt.MaxWeekly() != 2 {
+		t.Errorf("MaxWeekly = %d, want 2", wt.MaxWeekly())
+	}
+}
+
+func TestComputeLabelBurndowns(t *testing.T) {
+	now := time.Date(2025, 6, 11, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.Add(-time.Duration(d) * 24 * time.Hour) }
+	closed := func(d int) *time.Time { ts := daysAgo(d); return &ts }
+
+	issues := []model.Issue{
+		{ID: "a", Labels: []string{"api"}, Status: model.StatusClosed, CreatedAt: daysAgo(20), ClosedAt: closed(2)},
+		{ID: "b", Labels: []string{"api"}, Status: model.StatusOpen, CreatedAt: daysAgo(20)},
+		{ID: "c", Labels: []string{"ui"}, Status: model.StatusOpen, CreatedAt: daysAgo(1)},
+		{ID: "d", Labels: []string{"done"}, Status: model.StatusClosed, CreatedAt: daysAgo(90), ClosedAt: closed(80)},
+	}
+
+	got := ComputeLabelBurndowns(issues, 3, now, 5)
+	if len(got) != 2 || got[0].Label != "api" || got[1].Label != "ui" {
+		t.Fatalf("burndowns = %+v, want api then ui (inactive label dropped)", got)
+	}
+	if r := got[0].Remaining; r[0] != 2 || r[2] != 1 || got[0].Burned() != 1 || got[0].Closed != 1 {
+		t.Errorf("api = %+v, want 2 → 1 remaining with 1 closed", got[0])
+	}
+	if got[1].Burned() != -1 {
+		t.Errorf("ui burned = %d, want -1 (grew)", got[1].Burned())
+	}
+	if limited := ComputeLabelBurndowns(issues, 3, now, 1); len(limited) != 1 {
+		t.Errorf("limit ignored: %d rows", len(limited))
+	}
+}
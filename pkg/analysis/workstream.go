@@ -37,6 +37,15 @@ type Workstream struct {
 	InProgressCount int
 	ClosedCount     int
 
+	// Estimate-aware scheduling (see scheduleWorkstream)
+	RemainingMinutes int              // Sum of estimates over open issues
+	FinishMinutes    int              // Earliest finish of the whole workstream (dependency critical path)
+	UnestimatedCount int              // Open issues whose estimate was defaulted
+	Schedule         []ScheduledIssue // Open issues in earliest-finish order
+
+	// Completion date range at the labels' closure velocity (see ForecastWorkstreams)
+	Forecast CompletionForecast
+
 	// Related labels (excluding the selected one)
 	RelatedLabels []string
 
@@ -733,6 +742,9 @@ func DetectWorkstreams(issues []model.Issue, primaryIDs map[string]bool, selecte
 	// Assign context issues to workstreams
 	assignContextIssues(workstreams, context, graph)
 
+	// Name the catch-all stream after what its issues share
+	nameGenericWorkstreams(workstreams, selectedLabel, globalIssueMap)
+
 	// Compute stats for each workstream (using global issue map for cross-workstream blockers)
 	for i := range workstreams {
 		computeWorkstreamStats(&workstreams[i], primaryIDs, globalIssueMap)
@@ -989,7 +1001,7 @@ func computeWorkstreamStats(ws *Workstream, primaryIDs map[string]bool, globalIs
 	if ws.PrimaryCount > 0 {
 		primaryClosed := 0
 		for _, issue := range ws.Issues {
-			if primaryIDs[issue.ID] && issue.Status == model.StatusClosed {
+			if primaryIDs[issue.ID] && issue.Status.IsClosed() {
 				primaryClosed++
 			}
 		}
@@ -1007,13 +1019,15 @@ func computeWorkstreamStats(ws *Workstream, primaryIDs map[string]bool, globalIs
 		}
 	}
 	ws.RelatedLabels = topLabels(labelCounts, 3)
+
+	scheduleWorkstream(ws)
 }
 
 func isBlockedByDeps(issue model.Issue, issueMap map[string]model.Issue) bool {
 	for _, dep := range issue.Dependencies {
 		if dep.Type == model.DepBlocks || dep.Type == "" {
 			if blocker, exists := issueMap[dep.DependsOnID]; exists {
-				if blocker.Status != model.StatusClosed {
+				if !blocker.Status.IsClosed() {
 					return true
 				}
 			}
@@ -1043,7 +1057,7 @@ func detectCrossWorkstreamDeps(workstreams []Workstream, graph *dependencyGraph)
 
 		for _, issue := range ws.Issues {
 			// Skip closed issues
-			if issue.Status == model.StatusClosed {
+			if issue.Status.IsClosed() {
 				continue
 			}
 
@@ -1054,7 +1068,7 @@ func detectCrossWorkstreamDeps(workstreams []Workstream, graph *dependencyGraph)
 					continue
 				}
 				// Check blocker is open
-				if blocker := graph.issues[blockerID]; blocker != nil && blocker.Status != model.StatusClosed {
+				if blocker := graph.issues[blockerID]; blocker != nil && !blocker.Status.IsClosed() {
 					blockedBySet[workstreams[blockerWSIdx].Name] = true
 					ws.CrossBlockedBy = append(ws.CrossBlockedBy, CrossWorkstreamBlocker{
 						BlockerID:         blockerID,
@@ -1341,6 +1355,9 @@ func DetectWorkstreamsWithOptions(issues []model.Issue, primaryIDs map[string]bo
 	// Assign context issues to workstreams
 	assignContextIssues(workstreams, context, graph)
 
+	// Name the catch-all stream after what its issues share
+	nameGenericWorkstreams(workstreams, ctx.SelectedLabel, globalIssueMap)
+
 	// Compute stats for each workstream (using global issue map for cross-workstream blockers)
 	for i := range workstreams {
 		computeWorkstreamStats(&workstreams[i], primaryIDs, globalIssueMap)
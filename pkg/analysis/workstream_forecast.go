@@ -0,0 +1,146 @@
+package analysis
+
+import (
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// forecastWindowDays is the closure history the forecast velocity is measured over
+const forecastWindowDays = 30
+
+// CompletionForecast is a date range for closing a set of open issues at the
+// recent closure velocity of their labels. The zero value (OpenCount 0) means
+// there is nothing left to forecast.
+type CompletionForecast struct {
+	OpenCount        int
+	RemainingMinutes int // Estimates of the open issues, median-filled
+
+	Optimistic  time.Time
+	Expected    time.Time
+	Pessimistic time.Time
+
+	VelocityMinutesPerDay float64
+	VelocitySamples       int    // Closures in the window the velocity came from
+	VelocityBasis         string // "labels", "global" or "default"
+	Confidence            float64
+	Grade                 string // A..D (see ConfidenceGrade)
+}
+
+// ForecastCompletion forecasts when the open issues among issues will all be
+// closed. Velocity is the estimated minutes per day closed over the last 30
+// days by issues sharing a label with the open ones (history is every issue,
+// not just these), falling back to all closures and then to one median issue
+// per work week. The range widens as confidence drops, twice as far on the
+// pessimistic side since schedules slip more often than they gain.
+func ForecastCompletion(history, issues []model.Issue, now time.Time) CompletionForecast {
+	var f CompletionForecast
+
+	median := computeMedianEstimatedMinutes(issues)
+	labels := make(map[string]bool)
+	estimated := 0
+	for _, iss := range issues {
+		if iss.Status.IsClosed() {
+			continue
+		}
+		f.OpenCount++
+		if iss.EstimatedMinutes != nil && *iss.EstimatedMinutes > 0 {
+			f.RemainingMinutes += *iss.EstimatedMinutes
+			estimated++
+		} else {
+			f.RemainingMinutes += median
+		}
+		for _, label := range iss.Labels {
+			labels[label] = true
+		}
+	}
+	if f.OpenCount == 0 {
+		return f
+	}
+
+	since := now.Add(-forecastWindowDays * 24 * time.Hour)
+	f.VelocityBasis = "labels"
+	if len(labels) > 0 {
+		f.VelocityMinutesPerDay, f.VelocitySamples = closureVelocity(history, labels, since, median)
+	}
+	if f.VelocitySamples == 0 {
+		f.VelocityBasis = "global"
+		f.VelocityMinutesPerDay, f.VelocitySamples = closureVelocity(history, nil, since, median)
+	}
+	if f.VelocitySamples == 0 {
+		f.VelocityBasis = "default"
+		f.VelocityMinutesPerDay = float64(median) / 5.0
+	}
+
+	f.Confidence = forecastConfidence(float64(estimated)/float64(f.OpenCount), f.VelocitySamples, f.VelocityBasis)
+	f.Grade = ConfidenceGrade(f.Confidence)
+
+	days := float64(f.RemainingMinutes) / f.VelocityMinutesPerDay
+	delta := max(0.5, days*(1-f.Confidence)*0.8)
+	f.Expected = now.Add(durationDays(days))
+	f.Optimistic = now.Add(durationDays(max(0, days-delta)))
+	f.Pessimistic = now.Add(durationDays(days + 2*delta))
+	return f
+}
+
+// ForecastWorkstreams sets the Forecast of each workstream from history
+func ForecastWorkstreams(workstreams []Workstream, history []model.Issue, now time.Time) {
+	for i := range workstreams {
+		workstreams[i].Forecast = ForecastCompletion(history, workstreams[i].Issues, now)
+	}
+}
+
+// closureVelocity returns the estimated minutes closed per day since since by
+// issues carrying any of labels (every issue when labels is nil), and how
+// many closures that was
+func closureVelocity(history []model.Issue, labels map[string]bool, since time.Time, median int) (float64, int) {
+	total, samples := 0, 0
+	for _, iss := range history {
+		closedAt := issueClosedAt(iss)
+		if !iss.Status.IsClosed() || closedAt.Before(since) {
+			continue
+		}
+		if labels != nil && !anyLabel(iss.Labels, labels) {
+			continue
+		}
+		minutes := median
+		if iss.EstimatedMinutes != nil && *iss.EstimatedMinutes > 0 {
+			minutes = *iss.EstimatedMinutes
+		}
+		total += minutes
+		samples++
+	}
+	if samples == 0 {
+		return 0, 0
+	}
+	return float64(total) / forecastWindowDays, samples
+}
+
+func anyLabel(labels []string, set map[string]bool) bool {
+	for _, l := range labels {
+		if set[l] {
+			return true
+		}
+	}
+	return false
+}
+
+// forecastConfidence mirrors estimateETAConfidence for a group of issues:
+// estimate coverage and closure samples raise it, borrowed velocity lowers it
+func forecastConfidence(coverage float64, samples int, basis string) float64 {
+	conf := 0.25 + 0.25*coverage
+	switch {
+	case samples >= forecastFullSamples:
+		conf += 0.30
+	case samples >= 5:
+		conf += 0.20
+	case samples >= 1:
+		conf += 0.10
+	default:
+		conf -= 0.05
+	}
+	if basis != "labels" {
+		conf -= 0.05
+	}
+	return clampFloat(conf, 0.10, 0.90)
+}
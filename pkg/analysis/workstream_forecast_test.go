@@ -0,0 +1,57 @@
+package analysis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestForecastCompletion(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	closed := func(id, label string, daysAgo, minutes int) model.Issue {
+		at := now.AddDate(0, 0, -daysAgo)
+		return model.Issue{ID: id, Status: model.StatusClosed, ClosedAt: &at, Labels: []string{label}, EstimatedMinutes: estimate(minutes)}
+	}
+	// api closed 30h in the window (60 min/day); ui closed 300h, which must not count
+	history := []model.Issue{
+		closed("c1", "api", 2, 600), closed("c2", "api", 10, 600), closed("c3", "api", 20, 600),
+		closed("c4", "api", 45, 6000), // Outside the 30-day window
+		closed("c5", "ui", 3, 18000),
+	}
+	work := []model.Issue{
+		{ID: "o1", Status: model.StatusOpen, Labels: []string{"api"}, EstimatedMinutes: estimate(300)},
+		{ID: "o2", Status: model.StatusInProgress, Labels: []string{"api"}, EstimatedMinutes: estimate(300)},
+		{ID: "o3", Status: model.StatusClosed, Labels: []string{"api"}, EstimatedMinutes: estimate(900)},
+	}
+
+	f := analysis.ForecastCompletion(append(history, work...), work, now)
+	if f.OpenCount != 2 || f.RemainingMinutes != 600 {
+		t.Errorf("open %d, remaining %dm, want 2 and 600m", f.OpenCount, f.RemainingMinutes)
+	}
+	// o3 has no ClosedAt and dates from its zero UpdatedAt, so only c1-c3 count
+	if f.VelocityBasis != "labels" || f.VelocitySamples != 3 || f.VelocityMinutesPerDay != 60 {
+		t.Errorf("velocity %.1f from %d %s samples, want 60 from 3 labels samples", f.VelocityMinutesPerDay, f.VelocitySamples, f.VelocityBasis)
+	}
+	if want := now.Add(10 * 24 * time.Hour); !f.Expected.Equal(want) {
+		t.Errorf("expected %v, want %v (600m at 60m/day)", f.Expected, want)
+	}
+	if !f.Optimistic.Before(f.Expected) || !f.Pessimistic.After(f.Expected) {
+		t.Errorf("range %v .. %v should surround %v", f.Optimistic, f.Pessimistic, f.Expected)
+	}
+	if f.Expected.Sub(f.Optimistic)*2 != f.Pessimistic.Sub(f.Expected) {
+		t.Errorf("the pessimistic side should be twice as wide: %v vs %v", f.Expected.Sub(f.Optimistic), f.Pessimistic.Sub(f.Expected))
+	}
+
+	// Without label history the forecast borrows the global velocity, with less confidence
+	unlabeled := []model.Issue{{ID: "x", Status: model.StatusOpen, Labels: []string{"docs"}, EstimatedMinutes: estimate(300)}}
+	g := analysis.ForecastCompletion(history, unlabeled, now)
+	if g.VelocityBasis != "global" || g.Confidence >= f.Confidence {
+		t.Errorf("basis %s at %.2f, want global below %.2f", g.VelocityBasis, g.Confidence, f.Confidence)
+	}
+
+	if d := analysis.ForecastCompletion(history, work[2:], now); d.OpenCount != 0 || !d.Expected.IsZero() {
+		t.Errorf("nothing open should leave the zero forecast, got %+v", d)
+	}
+}
@@ -0,0 +1,127 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// nameGenericWorkstreams gives the catch-all Standalone streams a name from
+// their members (see SuggestWorkstreamName), leaving their ID alone. Names
+// other streams already use are skipped.
+func nameGenericWorkstreams(workstreams []Workstream, selectedLabel string, issueMap map[string]model.Issue) {
+	taken := make(map[string]bool, len(workstreams))
+	for _, ws := range workstreams {
+		taken[strings.ToLower(ws.Name)] = true
+	}
+	for i := range workstreams {
+		if workstreams[i].ID != "standalone" {
+			continue
+		}
+		if name := SuggestWorkstreamName(workstreams[i].Issues, selectedLabel, issueMap, taken); name != "" {
+			workstreams[i].Name = name
+			taken[strings.ToLower(name)] = true
+		}
+	}
+}
+
+// SuggestWorkstreamName names a group of issues after what most of them
+// share, trying in turn:
+//   - a label on more than half of them (other than selectedLabel)
+//   - an epic more than half of them sit under (or are)
+//   - the longest common prefix of their titles, e.g. "Auth:" in
+//     "Auth: login" and "Auth: logout"
+//
+// issueMap resolves parent epics. Names in taken (lowercased) are skipped.
+// It returns "" when nothing fits.
+func SuggestWorkstreamName(issues []model.Issue, selectedLabel string, issueMap map[string]model.Issue, taken map[string]bool) string {
+	if len(issues) < 2 {
+		return ""
+	}
+	usable := func(name string) bool {
+		return name != "" && !taken[strings.ToLower(name)]
+	}
+
+	// Shared label
+	labelCounts := make(map[string]int)
+	for _, issue := range issues {
+		for _, label := range issue.Labels {
+			if label != selectedLabel && label != DuplicateLabel {
+				labelCounts[label]++
+			}
+		}
+	}
+	if label := dominantKey(labelCounts, len(issues)); label != "" && usable(formatWorkstreamName(label)) {
+		return formatWorkstreamName(label)
+	}
+
+	// Shared epic
+	epicCounts := make(map[string]int)
+	for _, issue := range issues {
+		seen := make(map[string]bool)
+		if issue.IssueType == model.TypeEpic {
+			seen[issue.ID] = true
+		}
+		for _, dep := range issue.Dependencies {
+			if dep == nil || dep.Type != model.DepParentChild {
+				continue
+			}
+			if parent, ok := issueMap[dep.DependsOnID]; ok && parent.IssueType == model.TypeEpic {
+				seen[parent.ID] = true
+			}
+		}
+		for id := range seen {
+			epicCounts[id]++
+		}
+	}
+	if epicID := dominantKey(epicCounts, len(issues)); epicID != "" && usable(issueMap[epicID].Title) {
+		return issueMap[epicID].Title
+	}
+
+	// Common title prefix
+	if prefix := commonTitlePrefix(issues); usable(prefix) {
+		return prefix
+	}
+	return ""
+}
+
+// dominantKey returns the key counted for more than half of total (and at
+// least twice), the most counted first, then alphabetically
+func dominantKey(counts map[string]int, total int) string {
+	keys := make([]string, 0, len(counts))
+	for key, n := range counts {
+		if n >= 2 && n*2 > total {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	if len(keys) == 0 {
+		return ""
+	}
+	return keys[0]
+}
+
+// commonTitlePrefix returns the whole words all titles start with, without
+// trailing separators, or "" when that leaves fewer than three characters
+func commonTitlePrefix(issues []model.Issue) string {
+	prefix := strings.Fields(issues[0].Title)
+	for _, issue := range issues[1:] {
+		words := strings.Fields(issue.Title)
+		n := 0
+		for n < len(prefix) && n < len(words) && strings.EqualFold(prefix[n], words[n]) {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+	name := strings.TrimRight(strings.Join(prefix, " "), " :-–—/|,.")
+	if len([]rune(name)) < 3 {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,63 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestSuggestWorkstreamName(t *testing.T) {
+	epic := model.Issue{ID: "bv-1", Title: "Payments revamp", IssueType: model.TypeEpic}
+	under := func(id, title string, labels ...string) model.Issue {
+		return model.Issue{ID: id, Title: title, Labels: labels, Dependencies: []*model.Dependency{
+			{IssueID: id, DependsOnID: "bv-1", Type: model.DepParentChild}}}
+	}
+	issueMap := map[string]model.Issue{epic.ID: epic}
+
+	tests := []struct {
+		name   string
+		issues []model.Issue
+		taken  map[string]bool
+		want   string
+	}{
+		{"shared label", []model.Issue{
+			under("bv-2", "Refunds", "api", "billing"),
+			under("bv-3", "Invoices", "api", "billing"),
+			under("bv-4", "Receipts", "api"),
+		}, nil, "Billing"},
+		{"epic when no label dominates", []model.Issue{
+			under("bv-2", "Refunds", "api", "billing"),
+			under("bv-3", "Invoices", "api"),
+		}, nil, "Payments revamp"},
+		{"label name taken falls to the epic", []model.Issue{
+			under("bv-2", "Refunds", "billing"),
+			under("bv-3", "Invoices", "billing"),
+		}, map[string]bool{"billing": true}, "Payments revamp"},
+		{"title prefix", []model.Issue{
+			{ID: "bv-5", Title: "Auth: login form"},
+			{ID: "bv-6", Title: "auth: logout"},
+			{ID: "bv-7", Title: "Auth: session expiry"},
+		}, nil, "Auth"},
+		{"nothing shared", []model.Issue{
+			{ID: "bv-5", Title: "Login form"},
+			{ID: "bv-6", Title: "Export chart"},
+		}, nil, ""},
+	}
+	for _, tt := range tests {
+		if got := SuggestWorkstreamName(tt.issues, "api", issueMap, tt.taken); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDetectWorkstreamsNamesStandalone(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Search: index builder", Labels: []string{"api"}},
+		{ID: "bv-2", Title: "Search: query parser", Labels: []string{"api"}},
+	}
+	primary := map[string]bool{"bv-1": true, "bv-2": true}
+	ws := DetectWorkstreams(issues, primary, "api")
+	if len(ws) != 1 || ws[0].ID != "standalone" || ws[0].Name != "Search" {
+		t.Fatalf("workstreams = %+v", ws)
+	}
+}
@@ -0,0 +1,68 @@
+package analysis
+
+import (
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ApplyWorkstreamOverrides moves issues into the workstreams chosen for them
+// by hand (issue ID -> workstream ID), then recounts the streams and their
+// cross-stream dependencies. A move to a stream that wasn't detected is
+// ignored. Streams keep their order, even one a move leaves empty, so its
+// issues can be moved back. It returns the streams and the IDs of the issues
+// moved.
+func ApplyWorkstreamOverrides(workstreams []Workstream, moves map[string]string, primaryIDs map[string]bool) ([]Workstream, map[string]bool) {
+	moved := make(map[string]bool)
+	if len(moves) == 0 || len(workstreams) < 2 {
+		return workstreams, moved
+	}
+	index := make(map[string]int, len(workstreams))
+	for i, ws := range workstreams {
+		index[ws.ID] = i
+	}
+
+	for i := range workstreams {
+		kept := workstreams[i].Issues[:0:0]
+		for _, issue := range workstreams[i].Issues {
+			to, ok := index[moves[issue.ID]]
+			if !ok || to == i {
+				kept = append(kept, issue)
+				continue
+			}
+			workstreams[to].Issues = append(workstreams[to].Issues, issue)
+			moved[issue.ID] = true
+		}
+		workstreams[i].Issues = kept
+	}
+	if len(moved) == 0 {
+		return workstreams, moved
+	}
+
+	var all []model.Issue
+	for i := range workstreams {
+		ws := &workstreams[i]
+		ws.IssueIDs = make([]string, len(ws.Issues))
+		for j, issue := range ws.Issues {
+			ws.IssueIDs[j] = issue.ID
+		}
+		ws.CrossBlockedBy, ws.CrossBlocks = nil, nil
+		all = append(all, ws.Issues...)
+	}
+
+	globalIssueMap := make(map[string]model.Issue, len(all))
+	for _, issue := range all {
+		globalIssueMap[issue.ID] = issue
+	}
+	for i := range workstreams {
+		if ws := &workstreams[i]; len(ws.Issues) == 0 {
+			// computeWorkstreamStats leaves an empty stream's counts alone
+			ws.PrimaryCount, ws.ContextCount, ws.Progress, ws.IsBlocked = 0, 0, 0, false
+			ws.ReadyCount, ws.BlockedCount, ws.InProgressCount, ws.ClosedCount = 0, 0, 0, 0
+			ws.RemainingMinutes, ws.FinishMinutes, ws.UnestimatedCount, ws.Schedule = 0, 0, 0, nil
+			ws.RelatedLabels = nil
+			continue
+		}
+		computeWorkstreamStats(&workstreams[i], primaryIDs, globalIssueMap)
+	}
+	detectCrossWorkstreamDeps(workstreams, buildDependencyGraph(all))
+	return workstreams, moved
+}
@@ -0,0 +1,42 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestApplyWorkstreamOverrides(t *testing.T) {
+	ws := []Workstream{
+		{ID: "ws:api", Name: "API", Issues: []model.Issue{
+			{ID: "bv-1", Status: model.StatusOpen},
+			{ID: "bv-2", Status: model.StatusClosed},
+		}},
+		{ID: "ws:ui", Name: "UI", Issues: []model.Issue{
+			{ID: "bv-3", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+				{IssueID: "bv-3", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+		}},
+	}
+	primary := map[string]bool{"bv-1": true, "bv-2": true, "bv-3": true}
+
+	got, moved := ApplyWorkstreamOverrides(ws, map[string]string{"bv-2": "ws:ui", "bv-3": "ws:gone"}, primary)
+	if !reflect.DeepEqual(moved, map[string]bool{"bv-2": true}) {
+		t.Fatalf("moved = %v, want only bv-2 (ws:gone wasn't detected)", moved)
+	}
+	if !reflect.DeepEqual(got[0].IssueIDs, []string{"bv-1"}) || !reflect.DeepEqual(got[1].IssueIDs, []string{"bv-3", "bv-2"}) {
+		t.Fatalf("issues = %v / %v", got[0].IssueIDs, got[1].IssueIDs)
+	}
+	if got[1].ClosedCount != 1 || got[1].BlockedCount != 1 || got[1].Progress != 0.5 {
+		t.Errorf("ui counts: closed %d, blocked %d, progress %v", got[1].ClosedCount, got[1].BlockedCount, got[1].Progress)
+	}
+	if len(got[1].CrossBlockedBy) != 1 || got[1].CrossBlockedBy[0].BlockerID != "bv-1" {
+		t.Errorf("cross deps: %v", got[1].CrossBlockedBy)
+	}
+
+	// A stream a move empties stays, so issues can go back to it
+	got, _ = ApplyWorkstreamOverrides(got, map[string]string{"bv-1": "ws:ui"}, primary)
+	if len(got) != 2 || len(got[0].Issues) != 0 || got[0].ReadyCount != 0 || len(got[1].Issues) != 3 {
+		t.Errorf("after emptying API: %+v", got)
+	}
+}
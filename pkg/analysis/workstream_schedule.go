@@ -0,0 +1,154 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ScheduledIssue is one open issue placed on a workstream's schedule.
+// Offsets are in estimated minutes from now, assuming unlimited parallelism:
+// an issue starts as soon as every in-workstream blocker has finished.
+type ScheduledIssue struct {
+	IssueID       string
+	Minutes       int  // Effort for this issue
+	Estimated     bool // True if Minutes came from estimated_minutes, false if defaulted
+	StartMinutes  int  // Earliest start offset
+	FinishMinutes int  // Earliest finish offset
+}
+
+// scheduleWorkstream fills the estimate-derived fields of ws: remaining effort,
+// earliest possible finish, and a topological schedule of open issues ordered
+// by earliest finish. Issues without an estimate use the median of the
+// workstream's estimates (or DefaultEstimatedMinutes) and are counted in
+// UnestimatedCount so the UI can flag the total as approximate.
+func scheduleWorkstream(ws *Workstream) {
+	ws.RemainingMinutes = 0
+	ws.FinishMinutes = 0
+	ws.UnestimatedCount = 0
+	ws.Schedule = nil
+
+	fallback := computeMedianEstimatedMinutes(ws.Issues)
+
+	open := make(map[string]*ScheduledIssue)
+	var order []string
+	priority := make(map[string]int)
+	for _, issue := range ws.Issues {
+		if issue.Status.IsClosed() {
+			continue
+		}
+		if _, dup := open[issue.ID]; dup {
+			continue
+		}
+		item := &ScheduledIssue{IssueID: issue.ID, Minutes: fallback}
+		if issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0 {
+			item.Minutes = *issue.EstimatedMinutes
+			item.Estimated = true
+		} else {
+			ws.UnestimatedCount++
+		}
+		open[issue.ID] = item
+		order = append(order, issue.ID)
+		priority[issue.ID] = issue.Priority
+		ws.RemainingMinutes += item.Minutes
+	}
+	if len(order) == 0 {
+		return
+	}
+
+	// Blocking edges between open issues of this workstream. Blockers outside
+	// the workstream are surfaced via CrossBlockedBy, not scheduled here.
+	blockers := make(map[string][]string)
+	dependents := make(map[string][]string)
+	indegree := make(map[string]int, len(order))
+	for _, issue := range ws.Issues {
+		if open[issue.ID] == nil {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, dep := range issue.Dependencies {
+			if dep == nil || (dep.Type != model.DepBlocks && dep.Type != "") {
+				continue
+			}
+			if open[dep.DependsOnID] == nil || dep.DependsOnID == issue.ID || seen[dep.DependsOnID] {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			blockers[issue.ID] = append(blockers[issue.ID], dep.DependsOnID)
+			dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], issue.ID)
+			indegree[issue.ID]++
+		}
+	}
+
+	// Kahn's algorithm; the queue is kept sorted so the result is deterministic.
+	var queue []string
+	for _, id := range order {
+		if indegree[id] == 0 {
+			queue = append(queue, id)
+		}
+	}
+	sort.Strings(queue)
+
+	placed := make(map[string]bool, len(order))
+	place := func(id string) {
+		item := open[id]
+		for _, b := range blockers[id] {
+			if placed[b] && open[b].FinishMinutes > item.StartMinutes {
+				item.StartMinutes = open[b].FinishMinutes
+			}
+		}
+		item.FinishMinutes = item.StartMinutes + item.Minutes
+		placed[id] = true
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		place(id)
+		var next []string
+		for _, d := range dependents[id] {
+			indegree[d]--
+			if indegree[d] == 0 {
+				next = append(next, d)
+			}
+		}
+		sort.Strings(next)
+		queue = append(queue, next...)
+	}
+
+	// Issues on a dependency cycle never reach indegree 0. Schedule them after
+	// whatever of their blockers could be placed, in input order.
+	for _, id := range order {
+		if !placed[id] {
+			place(id)
+		}
+	}
+
+	ws.Schedule = make([]ScheduledIssue, 0, len(order))
+	for _, id := range order {
+		item := *open[id]
+		if item.FinishMinutes > ws.FinishMinutes {
+			ws.FinishMinutes = item.FinishMinutes
+		}
+		ws.Schedule = append(ws.Schedule, item)
+	}
+	sort.SliceStable(ws.Schedule, func(i, j int) bool {
+		a, b := ws.Schedule[i], ws.Schedule[j]
+		if a.FinishMinutes != b.FinishMinutes {
+			return a.FinishMinutes < b.FinishMinutes
+		}
+		if priority[a.IssueID] != priority[b.IssueID] {
+			return priority[a.IssueID] < priority[b.IssueID]
+		}
+		return a.IssueID < b.IssueID
+	})
+}
+
+// ScheduleWorkstream recomputes the estimate-derived fields of ws. Workstreams
+// built by DetectWorkstreams are already scheduled; this is for callers that
+// assemble a Workstream by hand.
+func ScheduleWorkstream(ws *Workstream) {
+	if ws == nil {
+		return
+	}
+	scheduleWorkstream(ws)
+}
@@ -0,0 +1,93 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func estimate(minutes int) *int { return &minutes }
+
+func TestScheduleWorkstream(t *testing.T) {
+	// A (2h) -> C (1h); B (30m) independent; D closed; E unestimated, blocked by C
+	ws := analysis.Workstream{Issues: []model.Issue{
+		{ID: "A", Status: model.StatusOpen, EstimatedMinutes: estimate(120)},
+		{ID: "B", Status: model.StatusOpen, EstimatedMinutes: estimate(30)},
+		{ID: "C", Status: model.StatusOpen, EstimatedMinutes: estimate(60), Dependencies: blockedBy("A")},
+		{ID: "D", Status: model.StatusClosed, EstimatedMinutes: estimate(600)},
+		{ID: "E", Status: model.StatusInProgress, Dependencies: blockedBy("C", "OUTSIDE")},
+	}}
+	analysis.ScheduleWorkstream(&ws)
+
+	// E defaults to the median of the explicit estimates (30, 60, 120, 600 -> 90)
+	if ws.RemainingMinutes != 120+30+60+90 {
+		t.Errorf("RemainingMinutes = %d, want %d", ws.RemainingMinutes, 300)
+	}
+	if ws.UnestimatedCount != 1 {
+		t.Errorf("UnestimatedCount = %d, want 1", ws.UnestimatedCount)
+	}
+	if ws.FinishMinutes != 120+60+90 {
+		t.Errorf("FinishMinutes = %d, want 270 (A -> C -> E)", ws.FinishMinutes)
+	}
+
+	want := []struct {
+		id            string
+		start, finish int
+	}{
+		{"B", 0, 30},
+		{"A", 0, 120},
+		{"C", 120, 180},
+		{"E", 180, 270},
+	}
+	if len(ws.Schedule) != len(want) {
+		t.Fatalf("schedule has %d entries, want %d: %+v", len(ws.Schedule), len(want), ws.Schedule)
+	}
+	for i, w := range want {
+		got := ws.Schedule[i]
+		if got.IssueID != w.id || got.StartMinutes != w.start || got.FinishMinutes != w.finish {
+			t.Errorf("schedule[%d] = %s %d..%d, want %s %d..%d",
+				i, got.IssueID, got.StartMinutes, got.FinishMinutes, w.id, w.start, w.finish)
+		}
+	}
+	if ws.Schedule[3].Estimated {
+		t.Error("E should be marked as using a defaulted estimate")
+	}
+}
+
+func TestScheduleWorkstreamCycleAndEmpty(t *testing.T) {
+	ws := analysis.Workstream{Issues: []model.Issue{
+		{ID: "X", Status: model.StatusOpen, EstimatedMinutes: estimate(60), Dependencies: blockedBy("Y")},
+		{ID: "Y", Status: model.StatusOpen, EstimatedMinutes: estimate(60), Dependencies: blockedBy("X")},
+	}}
+	analysis.ScheduleWorkstream(&ws)
+	if len(ws.Schedule) != 2 || ws.RemainingMinutes != 120 {
+		t.Fatalf("cycle members should still be scheduled: %+v", ws)
+	}
+	if ws.FinishMinutes != 120 {
+		t.Errorf("FinishMinutes = %d, want 120 for a serialized cycle", ws.FinishMinutes)
+	}
+
+	done := analysis.Workstream{Issues: []model.Issue{{ID: "Z", Status: model.StatusClosed}}}
+	analysis.ScheduleWorkstream(&done)
+	if done.RemainingMinutes != 0 || done.FinishMinutes != 0 || done.Schedule != nil {
+		t.Errorf("closed workstream should have no schedule: %+v", done)
+	}
+	analysis.ScheduleWorkstream(nil)
+}
+
+func TestDetectWorkstreamsSchedulesEstimates(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen, EstimatedMinutes: estimate(45)},
+		{ID: "B", Status: model.StatusOpen, EstimatedMinutes: estimate(15), Dependencies: blockedBy("A")},
+	}
+	primary := map[string]bool{"A": true, "B": true}
+	streams := analysis.DetectWorkstreams(issues, primary, "")
+	total := 0
+	for _, ws := range streams {
+		total += ws.RemainingMinutes
+	}
+	if total != 60 {
+		t.Errorf("remaining minutes across workstreams = %d, want 60", total)
+	}
+}
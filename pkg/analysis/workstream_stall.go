@@ -0,0 +1,51 @@
+package analysis
+
+import (
+	"time"
+)
+
+// WorkstreamStall is how long a workstream has gone without progress
+type WorkstreamStall struct {
+	IdleDays int        // Days since any of its issues last changed or closed
+	Level    StaleLevel // Aging past the stale threshold, Stale past twice it
+}
+
+// Stalled reports whether the stream has gone a stale threshold without progress
+func (s WorkstreamStall) Stalled() bool {
+	return s.Level != Fresh
+}
+
+// ComputeWorkstreamStall grades a workstream by the time since any of its
+// issues last changed (an update or a closure), on the same thresholds as
+// ComputeIssueAge. A stream with nothing open is never stalled.
+func ComputeWorkstreamStall(ws Workstream, now time.Time, staleDays int) WorkstreamStall {
+	if staleDays <= 0 {
+		staleDays = DefaultStaleThresholdDays
+	}
+
+	var last time.Time
+	open := false
+	for _, issue := range ws.Issues {
+		if !issue.Status.IsClosed() {
+			open = true
+		}
+		if t := LastUpdate(issue); t.After(last) {
+			last = t
+		}
+		if issue.ClosedAt != nil && issue.ClosedAt.After(last) {
+			last = *issue.ClosedAt
+		}
+	}
+
+	stall := WorkstreamStall{IdleDays: daysBetween(last, now)}
+	if !open || last.IsZero() {
+		return stall
+	}
+	switch {
+	case stall.IdleDays >= 2*staleDays:
+		stall.Level = Stale
+	case stall.IdleDays >= staleDays:
+		stall.Level = Aging
+	}
+	return stall
+}
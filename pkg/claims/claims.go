@@ -0,0 +1,197 @@
+// Package claims records who is working on which workstream, so several agents
+// (or people) sharing one beads repository can see each other's territory. A
+// claim covers one issue and its parent-child descendants (so claiming an epic
+// claims its workstream), or every issue carrying a label. Claims live in a
+// sidecar file next to the beads data and never touch the issues themselves.
+package claims
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// FileName is the claims sidecar file inside the beads directory
+const FileName = "claims.json"
+
+// labelPrefix marks a label target ("label:backend")
+const labelPrefix = "label:"
+
+// Claim marks Target as taken by Owner
+type Claim struct {
+	Target    string    `json:"target"` // Issue ID, or "label:NAME"
+	Owner     string    `json:"owner"`
+	ClaimedAt time.Time `json:"claimed_at"`
+	Note      string    `json:"note,omitempty"`
+}
+
+// Label returns the label a label claim covers
+func (c Claim) Label() (string, bool) {
+	return strings.CutPrefix(c.Target, labelPrefix)
+}
+
+// Overlap is an issue covered by claims from more than one owner
+type Overlap struct {
+	IssueID string   `json:"issue_id"`
+	Owners  []string `json:"owners"`
+	Claims  []Claim  `json:"claims"`
+}
+
+// Store holds every claim in a repository
+type Store struct {
+	Version   string    `json:"version"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Claims    []Claim   `json:"claims"`
+}
+
+// Load reads the claims in beadsDir. A missing file yields an empty store.
+func Load(beadsDir string) (*Store, error) {
+	s := &Store{Version: "1.0"}
+	data, err := os.ReadFile(filepath.Join(beadsDir, FileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("reading claims file: %w", err)
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("parsing claims file: %w", err)
+	}
+	return s, nil
+}
+
+// Save writes the claims to beadsDir
+func (s *Store) Save(beadsDir string) error {
+	s.UpdatedAt = time.Now()
+	if s.Claims == nil {
+		s.Claims = []Claim{}
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding claims: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(beadsDir, FileName), data, 0644); err != nil {
+		return fmt.Errorf("writing claims file: %w", err)
+	}
+	return nil
+}
+
+// LabelTarget is the claim target for a label
+func LabelTarget(label string) string {
+	return labelPrefix + label
+}
+
+// Add records c, replacing the owner's earlier claim on the same target.
+// It reports whether the claim is new.
+func (s *Store) Add(c Claim) bool {
+	for i, existing := range s.Claims {
+		if existing.Target == c.Target && existing.Owner == c.Owner {
+			s.Claims[i] = c
+			return false
+		}
+	}
+	s.Claims = append(s.Claims, c)
+	return true
+}
+
+// Release drops owner's claim on target, or every claim on target when
+// owner is empty. It returns how many claims were dropped.
+func (s *Store) Release(target, owner string) int {
+	before := len(s.Claims)
+	s.Claims = slices.DeleteFunc(s.Claims, func(c Claim) bool {
+		return c.Target == target && (owner == "" || c.Owner == owner)
+	})
+	return before - len(s.Claims)
+}
+
+// Coverage maps each claimed issue to the claims covering it, oldest first
+func (s *Store) Coverage(issues []model.Issue) map[string][]Claim {
+	if s == nil || len(s.Claims) == 0 {
+		return nil
+	}
+
+	children := make(map[string][]string)
+	byLabel := make(map[string][]string)
+	known := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		known[issue.ID] = true
+		for _, l := range issue.Labels {
+			byLabel[l] = append(byLabel[l], issue.ID)
+		}
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				children[dep.DependsOnID] = append(children[dep.DependsOnID], issue.ID)
+			}
+		}
+	}
+
+	coverage := make(map[string][]Claim)
+	for _, c := range s.Claims {
+		var covered []string
+		if label, ok := c.Label(); ok {
+			covered = byLabel[label]
+		} else if known[c.Target] {
+			seen := map[string]bool{c.Target: true}
+			queue := []string{c.Target}
+			for len(queue) > 0 {
+				id := queue[0]
+				queue = queue[1:]
+				covered = append(covered, id)
+				for _, child := range children[id] {
+					if !seen[child] {
+						seen[child] = true
+						queue = append(queue, child)
+					}
+				}
+			}
+		}
+		for _, id := range covered {
+			coverage[id] = append(coverage[id], c)
+		}
+	}
+	for id := range coverage {
+		sort.SliceStable(coverage[id], func(i, j int) bool {
+			return coverage[id][i].ClaimedAt.Before(coverage[id][j].ClaimedAt)
+		})
+	}
+	return coverage
+}
+
+// Owners returns the distinct owners of claims, in claim order
+func Owners(claims []Claim) []string {
+	var owners []string
+	for _, c := range claims {
+		if !slices.Contains(owners, c.Owner) {
+			owners = append(owners, c.Owner)
+		}
+	}
+	return owners
+}
+
+// Overlaps lists the issues more than one owner has claimed, by ID
+func Overlaps(coverage map[string][]Claim) []Overlap {
+	var overlaps []Overlap
+	for id, cs := range coverage {
+		if owners := Owners(cs); len(owners) > 1 {
+			overlaps = append(overlaps, Overlap{IssueID: id, Owners: owners, Claims: cs})
+		}
+	}
+	sort.Slice(overlaps, func(i, j int) bool { return overlaps[i].IssueID < overlaps[j].IssueID })
+	return overlaps
+}
+
+// DefaultOwner names the claimant when none is given: $BV_AGENT, then $USER
+func DefaultOwner() string {
+	if agent := strings.TrimSpace(os.Getenv("BV_AGENT")); agent != "" {
+		return agent
+	}
+	return os.Getenv("USER")
+}
@@ -0,0 +1,81 @@
+package claims
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func childOf(parent string) []*model.Dependency {
+	return []*model.Dependency{{DependsOnID: parent, Type: model.DepParentChild}}
+}
+
+func TestCoverageAndOverlaps(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "epic"},
+		{ID: "task", Dependencies: childOf("epic"), Labels: []string{"api"}},
+		{ID: "sub", Dependencies: childOf("task")},
+		{ID: "other", Labels: []string{"api"}},
+		{ID: "lone"},
+	}
+	t0 := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	s := &Store{}
+	s.Add(Claim{Target: "epic", Owner: "alice", ClaimedAt: t0})
+	s.Add(Claim{Target: LabelTarget("api"), Owner: "bob", ClaimedAt: t0.Add(time.Hour)})
+	s.Add(Claim{Target: "other", Owner: "bob", ClaimedAt: t0.Add(2 * time.Hour)})
+
+	coverage := s.Coverage(issues)
+	owners := func(id string) []string { return Owners(coverage[id]) }
+	if got := owners("sub"); !reflect.DeepEqual(got, []string{"alice"}) {
+		t.Errorf("sub claimed by %v, want alice through the epic", got)
+	}
+	if got := owners("task"); !reflect.DeepEqual(got, []string{"alice", "bob"}) {
+		t.Errorf("task claimed by %v, want alice then bob", got)
+	}
+	if got := owners("other"); !reflect.DeepEqual(got, []string{"bob"}) {
+		t.Errorf("other claimed by %v, want bob once", got)
+	}
+	if _, ok := coverage["lone"]; ok {
+		t.Error("lone should be unclaimed")
+	}
+
+	overlaps := Overlaps(coverage)
+	if len(overlaps) != 1 || overlaps[0].IssueID != "task" {
+		t.Errorf("overlaps = %+v, want only task", overlaps)
+	}
+
+	// Re-claiming replaces; release by owner or for everyone
+	if s.Add(Claim{Target: "epic", Owner: "alice", Note: "phase 2"}) {
+		t.Error("re-claim should replace, not add")
+	}
+	if n := s.Release(LabelTarget("api"), "carol"); n != 0 {
+		t.Errorf("released %d of carol's claims, want 0", n)
+	}
+	if n := s.Release(LabelTarget("api"), ""); n != 1 {
+		t.Errorf("released %d, want 1", n)
+	}
+	if len(Overlaps(s.Coverage(issues))) != 0 {
+		t.Error("overlap should be gone")
+	}
+}
+
+func TestLoadSave(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Load(dir)
+	if err != nil || len(s.Claims) != 0 {
+		t.Fatalf("Load missing = %+v, %v", s, err)
+	}
+	s.Add(Claim{Target: "bv-1", Owner: "agent-1", ClaimedAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Note: "auth"})
+	if err := s.Save(dir); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	loaded, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Claims, s.Claims) {
+		t.Errorf("round trip = %+v, want %+v", loaded.Claims, s.Claims)
+	}
+}
@@ -0,0 +1,462 @@
+// Package config loads per-project defaults for the TUI from .bv.yaml in the
+// project root, or .beads/bv.toml when there is no .bv.yaml.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"gopkg.in/yaml.v3"
+)
+
+// File names, relative to the project root
+const (
+	YAMLFilename = ".bv.yaml"
+	TOMLFilename = "bv.toml" // Inside .beads/
+)
+
+// MaxReviewTemplates is how many review templates have a key
+const MaxReviewTemplates = 9
+
+// Config holds project defaults. Empty fields mean "use the built-in default".
+type Config struct {
+	// Theme selects the color variant: auto (detect, default), dark or light
+	Theme string `yaml:"theme,omitempty"`
+
+	// ColorTheme names the color palette: default, a built-in one (nord,
+	// gruvbox, solarized, high-contrast) or a file in ~/.config/bv/themes/.
+	// The UI checks the name, since user themes live outside the project.
+	ColorTheme string `yaml:"color_theme,omitempty"`
+
+	// ASCII draws icons, trees and borders with ASCII characters, for fonts
+	// and terminals that render the Unicode ones badly (same as --ascii)
+	ASCII bool `yaml:"ascii,omitempty"`
+
+	// Depth is the lens dependency depth: 1, 2, 3 or all
+	Depth string `yaml:"depth,omitempty"`
+
+	// ViewType is the lens layout: flat, workstream or grouped
+	ViewType string `yaml:"view_type,omitempty"`
+
+	// Sort is the order within status sections per lens layout, e.g.
+	// flat: "updated desc". The TUI saves it when o or O changes the order.
+	Sort map[string]string `yaml:"sort,omitempty"`
+
+	// PinnedLenses are labels, epic IDs or issue IDs listed first in the lens selector
+	PinnedLenses []string `yaml:"pinned_lenses,omitempty"`
+
+	// WorkstreamNames renames lens workstreams, keyed by the lens (label, epic
+	// or issue ID) and the workstream's ID, e.g. "api/standalone": "Billing".
+	// The TUI saves it when n renames a workstream.
+	WorkstreamNames map[string]string `yaml:"workstream_names,omitempty"`
+
+	// WorkstreamOverrides pins issues to lens workstreams, keyed like
+	// WorkstreamNames but by issue ID, e.g. "api/bv-42": "ws:billing". The
+	// TUI saves it when X and p move an issue.
+	WorkstreamOverrides map[string]string `yaml:"workstream_overrides,omitempty"`
+
+	// Keybindings maps a key to the built-in key it acts as, e.g. "ctrl+n": "j".
+	// The UI adds the key to every Keymap action the built-in key triggers.
+	Keybindings map[string]string `yaml:"keybindings,omitempty"`
+
+	// Keymap replaces the keys of a named action, e.g. "list.page_down": ["ctrl+f"].
+	// Action names are checked by the UI, which owns the binding table.
+	Keymap map[string][]string `yaml:"keymap,omitempty"`
+
+	// StaleDays is the inactivity after which open issues are badged as aging
+	// (twice that marks them stale). 0 uses the default of 14 days.
+	StaleDays int `yaml:"stale_days,omitempty"`
+
+	// EpicRollup derives each epic's status from its children and shows it
+	// beside the declared one in lens trees, flagging mismatches (same as
+	// --epic-rollup)
+	EpicRollup bool `yaml:"epic_rollup,omitempty"`
+
+	// ReviewTemplates are canned review notes, inserted with alt+1..alt+9 in
+	// the review note modal. Empty keeps the built-in set.
+	ReviewTemplates []string `yaml:"review_templates,omitempty"`
+
+	// Statuses adds workflow statuses beyond open, in_progress, blocked and
+	// closed. YAML only: the TOML reader has no arrays of tables.
+	Statuses []StatusConfig `yaml:"statuses,omitempty"`
+
+	// Actions are shell commands bound to keys and run on the selected
+	// issue, e.g. open it in an editor or create a PR for it. YAML only,
+	// like Statuses.
+	Actions []ActionConfig `yaml:"actions,omitempty"`
+
+	// PrintOnExit prints a summary of the last view to stdout when the TUI
+	// quits (same as --print-on-exit)
+	PrintOnExit bool `yaml:"print_on_exit,omitempty"`
+
+	// Notify is how a long background job (reload, graph metrics, history,
+	// relabel) that finishes while you are on another screen is announced:
+	// flash (default), bell, both or off. A toast is shown for all but off.
+	Notify string `yaml:"notify,omitempty"`
+
+	// NotifyAfterSeconds is how long a job must run to be announced.
+	// 0 uses the default of 5 seconds.
+	NotifyAfterSeconds int `yaml:"notify_after_seconds,omitempty"`
+
+	// NotifyDesktop also sends each toast to the desktop as a terminal
+	// notification (OSC 9), for terminals that turn it into one
+	NotifyDesktop bool `yaml:"notify_desktop,omitempty"`
+
+	// NoUpdateCheck skips the TUI's check for a newer release at startup
+	// (same as BV_NO_UPDATE_CHECK=1); bv version --check still works
+	NoUpdateCheck bool `yaml:"no_update_check,omitempty"`
+
+	// IDDisplay is how issue IDs are shown: full (default), short (the
+	// common project prefix stripped) or number (#1, #2… by creation
+	// order). Copies and exports always use the real ID.
+	IDDisplay string `yaml:"id_display,omitempty"`
+
+	// IDPrefix is the prefix id_display: short strips, e.g. "bv-".
+	// Empty detects the prefix every issue ID shares.
+	IDPrefix string `yaml:"id_prefix,omitempty"`
+
+	// Path is the file the config was read from ("" when none was found)
+	Path string `yaml:"-"`
+}
+
+// StatusConfig defines one custom status
+type StatusConfig struct {
+	Name   string `yaml:"name"`
+	Column string `yaml:"column,omitempty"` // open (default), in_progress, blocked or closed
+	Color  string `yaml:"color,omitempty"`  // #RGB or #RRGGBB
+	Order  int    `yaml:"order,omitempty"`  // open 10, in_progress 20, blocked 30, closed 40; 0 = just after column
+}
+
+// ActionConfig defines one custom action. The command runs through the
+// shell in the project directory with the selected issue's fields in
+// BV_ISSUE_* variables, e.g. `code "$BV_ISSUE_ID.md"`.
+type ActionConfig struct {
+	Name           string `yaml:"name"`
+	Key            string `yaml:"key"` // As printed by bubbletea, e.g. "ctrl+e" or "alt+p"
+	Command        string `yaml:"command"`
+	Interactive    bool   `yaml:"interactive,omitempty"`     // Hand it the terminal (editors, pagers) instead of capturing its output
+	TimeoutSeconds int    `yaml:"timeout_seconds,omitempty"` // Captured commands only; 0 = 30 seconds
+}
+
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Load reads the project config from projectDir. .bv.yaml wins over
+// .beads/bv.toml; when neither exists an empty config is returned.
+func Load(projectDir string) (*Config, error) {
+	candidates := []struct {
+		path  string
+		parse func([]byte, *Config) error
+	}{
+		{filepath.Join(projectDir, YAMLFilename), parseYAML},
+		{filepath.Join(projectDir, ".beads", TOMLFilename), parseTOML},
+	}
+
+	for _, c := range candidates {
+		data, err := os.ReadFile(c.path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("reading project config: %w", err)
+		}
+
+		cfg := &Config{}
+		if err := c.parse(data, cfg); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", c.path, err)
+		}
+		if err := cfg.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", c.path, err)
+		}
+		cfg.Path = c.path
+		return cfg, nil
+	}
+	return &Config{}, nil
+}
+
+// Clone returns a copy of c that shares no maps or slices with it, so the
+// TUI can save pins, sorts and names without touching the loaded config
+func (c *Config) Clone() *Config {
+	clone := *c
+	clone.Sort = maps.Clone(c.Sort)
+	clone.PinnedLenses = slices.Clone(c.PinnedLenses)
+	clone.WorkstreamNames = maps.Clone(c.WorkstreamNames)
+	clone.WorkstreamOverrides = maps.Clone(c.WorkstreamOverrides)
+	clone.Keybindings = maps.Clone(c.Keybindings)
+	if c.Keymap != nil {
+		clone.Keymap = make(map[string][]string, len(c.Keymap))
+		for action, keys := range c.Keymap {
+			clone.Keymap[action] = slices.Clone(keys)
+		}
+	}
+	clone.ReviewTemplates = slices.Clone(c.ReviewTemplates)
+	clone.Statuses = slices.Clone(c.Statuses)
+	clone.Actions = slices.Clone(c.Actions)
+	return &clone
+}
+
+// Validate checks that every set field holds a known value
+func (c *Config) Validate() error {
+	switch strings.ToLower(c.Theme) {
+	case "", "auto", "dark", "light":
+	default:
+		return fmt.Errorf("theme must be auto, dark or light, got %q", c.Theme)
+	}
+	switch strings.ToLower(c.Depth) {
+	case "", "1", "2", "3", "all":
+	default:
+		return fmt.Errorf("depth must be 1, 2, 3 or all, got %q", c.Depth)
+	}
+	switch strings.ToLower(c.ViewType) {
+	case "", "flat", "workstream", "grouped":
+	default:
+		return fmt.Errorf("view_type must be flat, workstream or grouped, got %q", c.ViewType)
+	}
+	for _, layout := range slices.Sorted(maps.Keys(c.Sort)) {
+		if err := validateSortOrder(layout, c.Sort[layout]); err != nil {
+			return err
+		}
+	}
+	if c.StaleDays < 0 {
+		return fmt.Errorf("stale_days must not be negative, got %d", c.StaleDays)
+	}
+	switch strings.ToLower(c.Notify) {
+	case "", "flash", "bell", "both", "off":
+	default:
+		return fmt.Errorf("notify must be flash, bell, both or off, got %q", c.Notify)
+	}
+	switch strings.ToLower(c.IDDisplay) {
+	case "", "full", "short", "number":
+	default:
+		return fmt.Errorf("id_display must be full, short or number, got %q", c.IDDisplay)
+	}
+	if c.NotifyAfterSeconds < 0 {
+		return fmt.Errorf("notify_after_seconds must not be negative, got %d", c.NotifyAfterSeconds)
+	}
+	if len(c.ReviewTemplates) > MaxReviewTemplates {
+		return fmt.Errorf("review_templates: at most %d (one per alt+1..alt+%d), got %d", MaxReviewTemplates, MaxReviewTemplates, len(c.ReviewTemplates))
+	}
+	for i, tpl := range c.ReviewTemplates {
+		if strings.TrimSpace(tpl) == "" {
+			return fmt.Errorf("review_templates: entry %d is empty", i+1)
+		}
+	}
+	seen := make(map[string]bool, len(c.Statuses))
+	for i, st := range c.Statuses {
+		name := strings.TrimSpace(st.Name)
+		switch {
+		case name == "":
+			return fmt.Errorf("statuses: entry %d has no name", i+1)
+		case isBuiltinStatus(name):
+			return fmt.Errorf("statuses: %q is built in", name)
+		case seen[name]:
+			return fmt.Errorf("statuses: %q is defined twice", name)
+		}
+		seen[name] = true
+		if st.Column != "" && !isBuiltinStatus(st.Column) {
+			return fmt.Errorf("statuses: %q column must be open, in_progress, blocked or closed, got %q", name, st.Column)
+		}
+		if st.Color != "" && !hexColor.MatchString(st.Color) {
+			return fmt.Errorf("statuses: %q color must be #RGB or #RRGGBB, got %q", name, st.Color)
+		}
+		if st.Order < 0 {
+			return fmt.Errorf("statuses: %q order must not be negative, got %d", name, st.Order)
+		}
+	}
+	keys := make(map[string]string, len(c.Actions))
+	for i, a := range c.Actions {
+		name := strings.TrimSpace(a.Name)
+		key := strings.TrimSpace(a.Key)
+		switch {
+		case name == "":
+			return fmt.Errorf("actions: entry %d has no name", i+1)
+		case key == "":
+			return fmt.Errorf("actions: %q has no key", name)
+		case strings.TrimSpace(a.Command) == "":
+			return fmt.Errorf("actions: %q has no command", name)
+		case keys[key] != "":
+			return fmt.Errorf("actions: %q and %q are both bound to %s", keys[key], name, key)
+		case a.TimeoutSeconds < 0:
+			return fmt.Errorf("actions: %q timeout_seconds must not be negative, got %d", name, a.TimeoutSeconds)
+		}
+		keys[key] = name
+	}
+	for key, action := range c.Keybindings {
+		if strings.TrimSpace(key) == "" || strings.TrimSpace(action) == "" {
+			return fmt.Errorf("keybinding %q = %q: key and action must not be empty", key, action)
+		}
+	}
+	for action, keys := range c.Keymap {
+		if strings.TrimSpace(action) == "" || len(keys) == 0 {
+			return fmt.Errorf("keymap %q: action and keys must not be empty", action)
+		}
+		for _, key := range keys {
+			if strings.TrimSpace(key) == "" {
+				return fmt.Errorf("keymap %q: keys must not be empty", action)
+			}
+		}
+	}
+	return nil
+}
+
+// CustomStatuses converts Statuses for model.RegisterCustomStatuses
+func (c *Config) CustomStatuses() []model.CustomStatus {
+	defs := make([]model.CustomStatus, 0, len(c.Statuses))
+	for _, st := range c.Statuses {
+		defs = append(defs, model.CustomStatus{
+			Name:   model.Status(strings.TrimSpace(st.Name)),
+			Column: model.Status(st.Column),
+			Color:  st.Color,
+			Order:  st.Order,
+		})
+	}
+	return defs
+}
+
+func isBuiltinStatus(s string) bool {
+	switch model.Status(s) {
+	case model.StatusOpen, model.StatusInProgress, model.StatusBlocked, model.StatusClosed:
+		return true
+	}
+	return false
+}
+
+// DarkBackground reports the background the theme should assume. ok is false
+// for auto, meaning the terminal is asked.
+func (c *Config) DarkBackground() (dark, ok bool) {
+	switch strings.ToLower(c.Theme) {
+	case "dark":
+		return true, true
+	case "light":
+		return false, true
+	}
+	return false, false
+}
+
+func parseYAML(data []byte, cfg *Config) error {
+	return yaml.Unmarshal(data, cfg)
+}
+
+// parseTOML reads the small TOML subset the config needs: top-level
+// `key = value` pairs (strings, integers, booleans, single-line string arrays) and the
+// [keybindings], [keymap], [sort], [workstream_names] and
+// [workstream_overrides] tables. The values are then decoded through the
+// YAML tags so both formats share one schema.
+func parseTOML(data []byte, cfg *Config) error {
+	root := make(map[string]any)
+	table := root
+	for i, raw := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(stripTOMLComment(raw))
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name != "keybindings" && name != "keymap" && name != "sort" &&
+				name != "workstream_names" && name != "workstream_overrides" {
+				return fmt.Errorf("line %d: unknown table [%s]", i+1, name)
+			}
+			sub := make(map[string]any)
+			root[name] = sub
+			table = sub
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return fmt.Errorf("line %d: expected key = value", i+1)
+		}
+		k, err := parseTOMLKey(strings.TrimSpace(key))
+		if err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+		v, err := parseTOMLValue(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+		table[k] = v
+	}
+
+	encoded, err := yaml.Marshal(root)
+	if err != nil {
+		return fmt.Errorf("converting toml: %w", err)
+	}
+	return yaml.Unmarshal(encoded, cfg)
+}
+
+// stripTOMLComment drops a trailing # comment that is not inside a string
+func stripTOMLComment(line string) string {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+func parseTOMLKey(s string) (string, error) {
+	if strings.HasPrefix(s, `"`) || strings.HasPrefix(s, "'") {
+		return parseTOMLString(s)
+	}
+	if s == "" {
+		return "", fmt.Errorf("empty key")
+	}
+	return s, nil
+}
+
+func parseTOMLValue(s string) (any, error) {
+	switch {
+	case strings.HasPrefix(s, `"`) || strings.HasPrefix(s, "'"):
+		return parseTOMLString(s)
+	case strings.HasPrefix(s, "["):
+		if !strings.HasSuffix(s, "]") {
+			return nil, fmt.Errorf("arrays must be on one line")
+		}
+		var items []string
+		for _, part := range strings.Split(s[1:len(s)-1], ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			item, err := parseTOMLString(part)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case s == "true" || s == "false":
+		return s == "true", nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, nil
+	}
+	return nil, fmt.Errorf("unsupported value %q", s)
+}
+
+func parseTOMLString(s string) (string, error) {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return s[1 : len(s)-1], nil
+	}
+	unquoted, err := strconv.Unquote(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid string %s", s)
+	}
+	return unquoted, nil
+}
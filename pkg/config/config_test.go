@@ -0,0 +1,239 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadMissingReturnsEmpty(t *testing.T) {
+	cfg, err := Load(t.TempDir())
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !reflect.DeepEqual(cfg, &Config{}) {
+		t.Errorf("expected empty config, got %+v", cfg)
+	}
+}
+
+func TestLoadYAML(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, YAMLFilename), `
+theme: light
+color_theme: nord
+depth: 3
+view_type: workstream
+pinned_lenses: [backend, EPIC-1]
+keybindings:
+  ctrl+n: j
+  ctrl+p: k
+print_on_exit: true
+`)
+	cfg, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	want := &Config{
+		Theme:        "light",
+		ColorTheme:   "nord",
+		Depth:        "3",
+		ViewType:     "workstream",
+		PinnedLenses: []string{"backend", "EPIC-1"},
+		Keybindings:  map[string]string{"ctrl+n": "j", "ctrl+p": "k"},
+		PrintOnExit:  true,
+		Path:         filepath.Join(dir, YAMLFilename),
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("got %+v\nwant %+v", cfg, want)
+	}
+	if dark, ok := cfg.DarkBackground(); !ok || dark {
+		t.Errorf("light theme: DarkBackground() = %v, %v", dark, ok)
+	}
+}
+
+func TestLoadTOML(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, ".beads", TOMLFilename), `
+# Project defaults
+theme = "dark"   # always dark
+depth = "all"
+view_type = 'grouped'
+pinned_lenses = ["api", "ui#2"]
+stale_days = 21
+epic_rollup = true
+review_templates = ["Missing acceptance criteria", "Split into smaller beads"]
+print_on_exit = true
+ascii = true
+notify = "both"
+notify_after_seconds = 10
+notify_desktop = true
+no_update_check = true
+id_display = "short"
+id_prefix = "bv-"
+
+[keybindings]
+"ctrl+n" = "j"
+x = "esc"
+`)
+	cfg, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	want := &Config{
+		Theme:              "dark",
+		Depth:              "all",
+		ViewType:           "grouped",
+		PinnedLenses:       []string{"api", "ui#2"},
+		Keybindings:        map[string]string{"ctrl+n": "j", "x": "esc"},
+		StaleDays:          21,
+		EpicRollup:         true,
+		ReviewTemplates:    []string{"Missing acceptance criteria", "Split into smaller beads"},
+		PrintOnExit:        true,
+		ASCII:              true,
+		Notify:             "both",
+		NotifyAfterSeconds: 10,
+		NotifyDesktop:      true,
+		NoUpdateCheck:      true,
+		IDDisplay:          "short",
+		IDPrefix:           "bv-",
+		Path:               filepath.Join(dir, ".beads", TOMLFilename),
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("got %+v\nwant %+v", cfg, want)
+	}
+}
+
+func TestLoadKeymap(t *testing.T) {
+	want := map[string][]string{"list.page_down": {"ctrl+f", "pgdown"}, "global.board": {"B"}}
+
+	yamlDir := t.TempDir()
+	writeFile(t, filepath.Join(yamlDir, YAMLFilename), `
+keymap:
+  list.page_down: [ctrl+f, pgdown]
+  global.board: [B]
+`)
+	tomlDir := t.TempDir()
+	writeFile(t, filepath.Join(tomlDir, ".beads", TOMLFilename), `
+[keymap]
+"list.page_down" = ["ctrl+f", "pgdown"]
+"global.board" = ["B"]
+`)
+	for _, dir := range []string{yamlDir, tomlDir} {
+		cfg, err := Load(dir)
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if !reflect.DeepEqual(cfg.Keymap, want) {
+			t.Errorf("%s: keymap = %v, want %v", filepath.Base(cfg.Path), cfg.Keymap, want)
+		}
+	}
+}
+
+func TestLoadStatuses(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, YAMLFilename), `
+statuses:
+  - name: review
+    column: in_progress
+    color: "#FFB86C"
+  - name: done
+    column: closed
+    order: 45
+`)
+	cfg, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	want := []model.CustomStatus{
+		{Name: "review", Column: model.StatusInProgress, Color: "#FFB86C"},
+		{Name: "done", Column: model.StatusClosed, Order: 45},
+	}
+	if got := cfg.CustomStatuses(); !reflect.DeepEqual(got, want) {
+		t.Errorf("CustomStatuses() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadPrefersYAML(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, YAMLFilename), "depth: 1\n")
+	writeFile(t, filepath.Join(dir, ".beads", TOMLFilename), "depth = 3\n")
+
+	cfg, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Depth != "1" || filepath.Base(cfg.Path) != YAMLFilename {
+		t.Errorf("expected .bv.yaml to win, got depth %q from %s", cfg.Depth, cfg.Path)
+	}
+}
+
+func TestLoadRejectsInvalid(t *testing.T) {
+	cases := map[string]struct {
+		file, content, wantErr string
+	}{
+		"bad theme":      {YAMLFilename, "theme: neon\n", "theme must be"},
+		"bad depth":      {YAMLFilename, "depth: 7\n", "depth must be"},
+		"bad view":       {YAMLFilename, "view_type: kanban\n", "view_type must be"},
+		"sort layout":    {YAMLFilename, "sort:\n  board: priority\n", "layout must be"},
+		"sort key":       {YAMLFilename, "sort:\n  flat: size\n", "flat must be one of"},
+		"sort direction": {YAMLFilename, "sort:\n  flat: priority up\n", "flat must be one of"},
+		"empty action":   {YAMLFilename, "keybindings:\n  x: ''\n", "must not be empty"},
+		"empty keymap":   {YAMLFilename, "keymap:\n  list.sort: []\n", "must not be empty"},
+		"stale days":     {YAMLFilename, "stale_days: -3\n", "stale_days must not be negative"},
+		"bad notify":     {YAMLFilename, "notify: siren\n", "notify must be"},
+		"notify after":   {YAMLFilename, "notify_after_seconds: -1\n", "notify_after_seconds must not be negative"},
+		"bad id display": {YAMLFilename, "id_display: tiny\n", "id_display must be"},
+		"empty template": {YAMLFilename, "review_templates: [ok, ' ']\n", "entry 2 is empty"},
+		"ten templates":  {YAMLFilename, "review_templates: [a, b, c, d, e, f, g, h, i, j]\n", "at most 9"},
+		"builtin status": {YAMLFilename, "statuses:\n  - name: closed\n", "is built in"},
+		"status column":  {YAMLFilename, "statuses:\n  - name: review\n    column: qa\n", "column must be"},
+		"status color":   {YAMLFilename, "statuses:\n  - name: review\n    color: orange\n", "color must be"},
+		"status twice":   {YAMLFilename, "statuses:\n  - name: review\n  - name: review\n", "defined twice"},
+		"action command": {YAMLFilename, "actions:\n  - name: PR\n    key: ctrl+r\n", "has no command"},
+		"action key":     {YAMLFilename, "actions:\n  - {name: a, key: ctrl+r, command: x}\n  - {name: b, key: ctrl+r, command: y}\n", "both bound to ctrl+r"},
+		"toml table":     {filepath.Join(".beads", TOMLFilename), "[colors]\n", "unknown table"},
+		"toml syntax":    {filepath.Join(".beads", TOMLFilename), "theme dark\n", "expected key = value"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, filepath.Join(dir, tc.file), tc.content)
+			_, err := Load(dir)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCloneSharesNothing(t *testing.T) {
+	cfg := &Config{
+		Sort:         map[string]string{"flat": "id"},
+		PinnedLenses: []string{"api"},
+		Keymap:       map[string][]string{"list.down": {"j"}},
+	}
+	clone := cfg.Clone()
+	if !reflect.DeepEqual(clone, cfg) {
+		t.Fatalf("clone = %+v, want %+v", clone, cfg)
+	}
+	clone.Sort["flat"] = "priority"
+	clone.PinnedLenses[0] = "ui"
+	clone.Keymap["list.down"][0] = "n"
+	if cfg.Sort["flat"] != "id" || cfg.PinnedLenses[0] != "api" || cfg.Keymap["list.down"][0] != "j" {
+		t.Errorf("editing the clone changed the original: %+v", cfg)
+	}
+}
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WritePath is the file changes made from the TUI go to: the file the config
+// was read from, or a new .bv.yaml in projectDir when there was none
+func (c *Config) WritePath(projectDir string) string {
+	if c != nil && c.Path != "" {
+		return c.Path
+	}
+	return filepath.Join(projectDir, YAMLFilename)
+}
+
+// SavePinnedLenses replaces pinned_lenses in the config file at path,
+// creating the file if needed. Other settings and comments are kept; an
+// empty list removes the key.
+func SavePinnedLenses(path string, pinned []string) error {
+	return rewriteConfig(path,
+		func(data []byte) ([]byte, error) { return setYAMLPinned(data, pinned) },
+		func(data []byte) []byte { return setTOMLPinned(data, pinned) })
+}
+
+// rewriteConfig applies one edit to the config file at path, the YAML or the
+// TOML one depending on its extension, creating the file if needed
+func rewriteConfig(path string, editYAML func([]byte) ([]byte, error), editTOML func([]byte) []byte) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("reading project config: %w", err)
+	}
+
+	var out []byte
+	if strings.HasSuffix(path, ".toml") {
+		out = editTOML(data)
+	} else if out, err = editYAML(data); err != nil {
+		return fmt.Errorf("updating %s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating config directory: %w", err)
+	}
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("writing project config: %w", err)
+	}
+	return nil
+}
+
+// setYAMLPinned edits the document as a node tree so comments and key order
+// survive
+func setYAMLPinned(data []byte, pinned []string) ([]byte, error) {
+	doc, root, err := parseYAMLDoc(data)
+	if err != nil {
+		return nil, err
+	}
+
+	seq := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	for _, p := range pinned {
+		seq.Content = append(seq.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: p})
+	}
+
+	found := false
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value != "pinned_lenses" {
+			continue
+		}
+		found = true
+		if len(pinned) == 0 {
+			root.Content = append(root.Content[:i], root.Content[i+2:]...)
+		} else {
+			root.Content[i+1] = seq
+		}
+		break
+	}
+	if !found && len(pinned) > 0 {
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "pinned_lenses"}, seq)
+	}
+
+	return encodeYAMLDoc(doc, root)
+}
+
+// parseYAMLDoc parses a config document for editing and returns it with its
+// top-level mapping; an empty document gets an empty mapping
+func parseYAMLDoc(data []byte) (*yaml.Node, *yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+	if len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("top level is not a mapping")
+	}
+	return &doc, root, nil
+}
+
+// encodeYAMLDoc writes an edited document back out; an empty top-level
+// mapping makes an empty file
+func encodeYAMLDoc(doc, root *yaml.Node) ([]byte, error) {
+	if len(root.Content) == 0 {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// setTOMLPinned rewrites the top-level pinned_lenses line, or adds one before
+// the first table
+func setTOMLPinned(data []byte, pinned []string) []byte {
+	var line string
+	if len(pinned) > 0 {
+		quoted := make([]string, len(pinned))
+		for i, p := range pinned {
+			quoted[i] = strconv.Quote(p)
+		}
+		line = "pinned_lenses = [" + strings.Join(quoted, ", ") + "]"
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(data) == 0 {
+		lines = nil
+	}
+	insertAt := len(lines)
+	for i, raw := range lines {
+		trimmed := strings.TrimSpace(stripTOMLComment(raw))
+		if strings.HasPrefix(trimmed, "[") {
+			insertAt = i
+			break
+		}
+		if key, _, ok := strings.Cut(trimmed, "="); ok && strings.Trim(strings.TrimSpace(key), `"'`) == "pinned_lenses" {
+			if line == "" {
+				lines = append(lines[:i], lines[i+1:]...)
+			} else {
+				lines[i] = line
+			}
+			return []byte(strings.Join(lines, "\n") + "\n")
+		}
+	}
+	if line == "" {
+		return data
+	}
+	// Keep a blank line between the new key and a following table
+	added := []string{line}
+	if insertAt < len(lines) {
+		if insertAt > 0 && strings.TrimSpace(lines[insertAt-1]) == "" {
+			insertAt--
+		} else {
+			added = append(added, "")
+		}
+	}
+	lines = append(lines[:insertAt], append(added, lines[insertAt:]...)...)
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
@@ -0,0 +1,197 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSavePinnedLensesYAML(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, YAMLFilename)
+
+	// A missing file is created
+	if err := SavePinnedLenses(path, []string{"api"}); err != nil {
+		t.Fatalf("SavePinnedLenses: %v", err)
+	}
+	cfg, err := Load(dir)
+	if err != nil || !reflect.DeepEqual(cfg.PinnedLenses, []string{"api"}) {
+		t.Fatalf("after create: pinned = %v, err = %v", cfg.PinnedLenses, err)
+	}
+
+	// Existing settings and comments survive a replace
+	writeFile(t, path, "# team defaults\ntheme: dark\npinned_lenses: [old]\ndepth: 2\n")
+	if err := SavePinnedLenses(path, []string{"api", "bv-42"}); err != nil {
+		t.Fatalf("SavePinnedLenses: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), "# team defaults") {
+		t.Errorf("comment lost:\n%s", data)
+	}
+	cfg, err = Load(dir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Theme != "dark" || cfg.Depth != "2" || !reflect.DeepEqual(cfg.PinnedLenses, []string{"api", "bv-42"}) {
+		t.Errorf("after replace: %+v", cfg)
+	}
+
+	// Unpinning everything drops the key
+	if err := SavePinnedLenses(path, nil); err != nil {
+		t.Fatalf("SavePinnedLenses: %v", err)
+	}
+	data, _ = os.ReadFile(path)
+	if strings.Contains(string(data), "pinned_lenses") {
+		t.Errorf("pinned_lenses left behind:\n%s", data)
+	}
+}
+
+func TestSavePinnedLensesTOML(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".beads", TOMLFilename)
+	writeFile(t, path, "theme = \"dark\" # always\n\n[keybindings]\nx = \"esc\"\n")
+
+	if err := SavePinnedLenses(path, []string{"api", `say "hi"`}); err != nil {
+		t.Fatalf("SavePinnedLenses: %v", err)
+	}
+	want := "theme = \"dark\" # always\npinned_lenses = [\"api\", \"say \\\"hi\\\"\"]\n\n[keybindings]\nx = \"esc\"\n"
+	if data, _ := os.ReadFile(path); string(data) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", data, want)
+	}
+	cfg, err := Load(dir)
+	if err != nil || !reflect.DeepEqual(cfg.PinnedLenses, []string{"api", `say "hi"`}) {
+		t.Fatalf("pinned = %v, err = %v", cfg.PinnedLenses, err)
+	}
+
+	// Replacing rewrites the line in place
+	if err := SavePinnedLenses(path, []string{"ui"}); err != nil {
+		t.Fatalf("SavePinnedLenses: %v", err)
+	}
+	cfg, _ = Load(dir)
+	if !reflect.DeepEqual(cfg.PinnedLenses, []string{"ui"}) || cfg.Keybindings["x"] != "esc" {
+		t.Errorf("after replace: %+v", cfg)
+	}
+
+	if got := (&Config{}).WritePath(dir); got != filepath.Join(dir, YAMLFilename) {
+		t.Errorf("WritePath without a file = %q", got)
+	}
+	if got := cfg.WritePath(dir); got != path {
+		t.Errorf("WritePath = %q, want %q", got, path)
+	}
+}
+
+func TestSaveSortOrder(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, YAMLFilename)
+	writeFile(t, path, "# team defaults\ntheme: dark\n")
+
+	if err := SaveSortOrder(path, "flat", "updated"); err != nil {
+		t.Fatalf("SaveSortOrder: %v", err)
+	}
+	if err := SaveSortOrder(path, "grouped", "id desc"); err != nil {
+		t.Fatalf("SaveSortOrder: %v", err)
+	}
+	if err := SaveSortOrder(path, "flat", "priority desc"); err != nil {
+		t.Fatalf("SaveSortOrder: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), "# team defaults") {
+		t.Errorf("comment lost:\n%s", data)
+	}
+	cfg, err := Load(dir)
+	want := map[string]string{"flat": "priority desc", "grouped": "id desc"}
+	if err != nil || cfg.Theme != "dark" || !reflect.DeepEqual(cfg.Sort, want) {
+		t.Fatalf("sort = %v, theme = %q, err = %v", cfg.Sort, cfg.Theme, err)
+	}
+
+	tomlDir := t.TempDir()
+	tomlPath := filepath.Join(tomlDir, ".beads", TOMLFilename)
+	writeFile(t, tomlPath, "theme = \"dark\"\n\n[keybindings]\nx = \"esc\"\n")
+	for _, s := range [][2]string{{"flat", "updated"}, {"workstream", "impact asc"}, {"flat", "created"}} {
+		if err := SaveSortOrder(tomlPath, s[0], s[1]); err != nil {
+			t.Fatalf("SaveSortOrder: %v", err)
+		}
+	}
+	wantTOML := "theme = \"dark\"\n\n[keybindings]\nx = \"esc\"\n\n[sort]\nflat = \"created\"\nworkstream = \"impact asc\"\n"
+	if data, _ := os.ReadFile(tomlPath); string(data) != wantTOML {
+		t.Errorf("got:\n%s\nwant:\n%s", data, wantTOML)
+	}
+	cfg, err = Load(tomlDir)
+	if err != nil || cfg.Sort["workstream"] != "impact asc" || cfg.Keybindings["x"] != "esc" {
+		t.Errorf("toml: %+v, err = %v", cfg, err)
+	}
+}
+
+func TestSaveWorkstreamName(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, YAMLFilename)
+	writeFile(t, path, "theme: dark\n")
+	key := WorkstreamNameKey("api", "standalone")
+	if err := SaveWorkstreamName(path, key, "Billing"); err != nil {
+		t.Fatalf("SaveWorkstreamName: %v", err)
+	}
+	if err := SaveWorkstreamName(path, WorkstreamNameKey("api", "ws:ui"), "Frontend"); err != nil {
+		t.Fatalf("SaveWorkstreamName: %v", err)
+	}
+	if err := SaveWorkstreamName(path, WorkstreamNameKey("api", "ws:ui"), ""); err != nil {
+		t.Fatalf("SaveWorkstreamName: %v", err)
+	}
+	cfg, err := Load(dir)
+	if want := map[string]string{key: "Billing"}; err != nil || !reflect.DeepEqual(cfg.WorkstreamNames, want) {
+		t.Fatalf("workstream_names = %v, err = %v", cfg.WorkstreamNames, err)
+	}
+
+	tomlDir := t.TempDir()
+	tomlPath := filepath.Join(tomlDir, ".beads", TOMLFilename)
+	writeFile(t, tomlPath, "theme = \"dark\"\n")
+	for _, name := range []string{"Billing", "Invoicing"} {
+		if err := SaveWorkstreamName(tomlPath, key, name); err != nil {
+			t.Fatalf("SaveWorkstreamName: %v", err)
+		}
+	}
+	want := "theme = \"dark\"\n\n[workstream_names]\n\"api/standalone\" = \"Invoicing\"\n"
+	if data, _ := os.ReadFile(tomlPath); string(data) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", data, want)
+	}
+	cfg, err = Load(tomlDir)
+	if err != nil || cfg.WorkstreamNames[key] != "Invoicing" {
+		t.Errorf("toml: %+v, err = %v", cfg.WorkstreamNames, err)
+	}
+}
+
+func TestSaveWorkstreamOverride(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, YAMLFilename)
+	writeFile(t, path, "theme: dark\n")
+	key := WorkstreamOverrideKey("api", "bv-7")
+	if err := SaveWorkstreamOverride(path, key, "ws:ui"); err != nil {
+		t.Fatalf("SaveWorkstreamOverride: %v", err)
+	}
+	if err := SaveWorkstreamOverride(path, WorkstreamOverrideKey("api", "bv-8"), "standalone"); err != nil {
+		t.Fatalf("SaveWorkstreamOverride: %v", err)
+	}
+	if err := SaveWorkstreamOverride(path, WorkstreamOverrideKey("api", "bv-8"), ""); err != nil {
+		t.Fatalf("SaveWorkstreamOverride: %v", err)
+	}
+	cfg, err := Load(dir)
+	if want := map[string]string{key: "ws:ui"}; err != nil || !reflect.DeepEqual(cfg.WorkstreamOverrides, want) {
+		t.Fatalf("workstream_overrides = %v, err = %v", cfg.WorkstreamOverrides, err)
+	}
+
+	tomlDir := t.TempDir()
+	tomlPath := filepath.Join(tomlDir, ".beads", TOMLFilename)
+	writeFile(t, tomlPath, "theme = \"dark\"\n")
+	if err := SaveWorkstreamOverride(tomlPath, key, "ws:ui"); err != nil {
+		t.Fatalf("SaveWorkstreamOverride: %v", err)
+	}
+	want := "theme = \"dark\"\n\n[workstream_overrides]\n\"api/bv-7\" = \"ws:ui\"\n"
+	if data, _ := os.ReadFile(tomlPath); string(data) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", data, want)
+	}
+	cfg, err = Load(tomlDir)
+	if err != nil || cfg.WorkstreamOverrides[key] != "ws:ui" {
+		t.Errorf("toml: %+v, err = %v", cfg.WorkstreamOverrides, err)
+	}
+}
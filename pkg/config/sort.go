@@ -0,0 +1,142 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SortLayouts are the lens layouts a sort order can be set for
+var SortLayouts = []string{"flat", "workstream", "grouped"}
+
+// SortKeys are the keys a lens sort order can use
+var SortKeys = []string{"blockers", "priority", "created", "updated", "id", "impact", "pagerank"}
+
+// validateSortOrder checks a sort value: a key, optionally followed by asc
+// or desc
+func validateSortOrder(layout, value string) error {
+	if !slices.Contains(SortLayouts, layout) {
+		return fmt.Errorf("sort: layout must be flat, workstream or grouped, got %q", layout)
+	}
+	fields := strings.Fields(strings.ToLower(value))
+	if len(fields) == 0 || len(fields) > 2 || !slices.Contains(SortKeys, fields[0]) ||
+		(len(fields) == 2 && fields[1] != "asc" && fields[1] != "desc") {
+		return fmt.Errorf("sort: %s must be one of %s, optionally followed by asc or desc, got %q",
+			layout, strings.Join(SortKeys, ", "), value)
+	}
+	return nil
+}
+
+// SaveSortOrder sets the sort order of one lens layout in the config file at
+// path, creating the file if needed. Other settings and comments are kept.
+func SaveSortOrder(path, layout, order string) error {
+	return rewriteConfig(path,
+		func(data []byte) ([]byte, error) { return setYAMLMapEntry(data, "sort", layout, order) },
+		func(data []byte) []byte { return setTOMLTableEntry(data, "sort", layout, order) })
+}
+
+// setYAMLMapEntry sets <mapKey>.<key>, adding the mapping if needed; an
+// empty value removes the key instead
+func setYAMLMapEntry(data []byte, mapKey, key, value string) ([]byte, error) {
+	doc, root, err := parseYAMLDoc(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var mapping *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == mapKey {
+			mapping = root.Content[i+1]
+			break
+		}
+	}
+	if mapping == nil {
+		if value == "" {
+			return encodeYAMLDoc(doc, root)
+		}
+		mapping = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: mapKey}, mapping)
+	} else if mapping.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%s is not a mapping", mapKey)
+	}
+
+	node := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			if value == "" {
+				mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			} else {
+				mapping.Content[i+1] = node
+			}
+			return encodeYAMLDoc(doc, root)
+		}
+	}
+	if value != "" {
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, node)
+	}
+	return encodeYAMLDoc(doc, root)
+}
+
+// setTOMLTableEntry rewrites the key's line in the [table] table, adding the
+// line or the table as needed; an empty value drops the line
+func setTOMLTableEntry(data []byte, table, key, value string) []byte {
+	bare := key
+	if strings.ContainsFunc(key, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-')
+	}) {
+		bare = strconv.Quote(key)
+	}
+	line := bare + " = " + strconv.Quote(value)
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(data) == 0 {
+		lines = nil
+	}
+	inTable := false
+	insertAt := -1
+	for i, raw := range lines {
+		trimmed := strings.TrimSpace(stripTOMLComment(raw))
+		if strings.HasPrefix(trimmed, "[") {
+			inTable = trimmed == "["+table+"]"
+			if inTable {
+				insertAt = i + 1
+			}
+			continue
+		}
+		if !inTable || trimmed == "" {
+			continue
+		}
+		insertAt = i + 1
+		if k, _, ok := strings.Cut(trimmed, "="); ok && parsedTOMLKey(strings.TrimSpace(k)) == key {
+			if value == "" {
+				lines = append(lines[:i], lines[i+1:]...)
+			} else {
+				lines[i] = line
+			}
+			return []byte(strings.Join(lines, "\n") + "\n")
+		}
+	}
+	if value == "" {
+		return data
+	}
+	if insertAt < 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "["+table+"]", line)
+	} else {
+		lines = append(lines[:insertAt], append([]string{line}, lines[insertAt:]...)...)
+	}
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+// parsedTOMLKey is the key a TOML line's key part names, quoted or not
+func parsedTOMLKey(s string) string {
+	if key, err := parseTOMLKey(s); err == nil {
+		return key
+	}
+	return s
+}
@@ -0,0 +1,30 @@
+package config
+
+// WorkstreamNameKey is the workstream_names key of workstream wsID in the
+// lens for lens (a label, epic ID or issue ID)
+func WorkstreamNameKey(lens, wsID string) string {
+	return lens + "/" + wsID
+}
+
+// WorkstreamOverrideKey is the workstream_overrides key of issueID in the
+// lens for lens
+func WorkstreamOverrideKey(lens, issueID string) string {
+	return lens + "/" + issueID
+}
+
+// SaveWorkstreamName sets the name of one lens workstream in the config file
+// at path, creating the file if needed; an empty name goes back to the
+// detected one. Other settings and comments are kept.
+func SaveWorkstreamName(path, key, name string) error {
+	return rewriteConfig(path,
+		func(data []byte) ([]byte, error) { return setYAMLMapEntry(data, "workstream_names", key, name) },
+		func(data []byte) []byte { return setTOMLTableEntry(data, "workstream_names", key, name) })
+}
+
+// SaveWorkstreamOverride pins an issue to a lens workstream in the config
+// file at path, creating the file if needed; an empty wsID unpins it.
+func SaveWorkstreamOverride(path, key, wsID string) error {
+	return rewriteConfig(path,
+		func(data []byte) ([]byte, error) { return setYAMLMapEntry(data, "workstream_overrides", key, wsID) },
+		func(data []byte) []byte { return setTOMLTableEntry(data, "workstream_overrides", key, wsID) })
+}
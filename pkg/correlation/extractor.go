@@ -20,6 +20,10 @@ type ExtractOptions struct {
 	Until  *time.Time // Only commits before this time (nil = no limit)
 	Limit  int        // Max commits to process (0 = no limit)
 	BeadID string     // Filter to single bead ID (empty = all beads)
+
+	// FieldChanges records the old and new text of edited title,
+	// description, design, acceptance criteria and notes on each event
+	FieldChanges bool
 }
 
 // Extractor extracts bead lifecycle events from git history
@@ -78,6 +82,12 @@ type beadSnapshot struct {
 	ID     string
 	Status string
 	Title  string
+
+	// Long-text fields, compared only for ExtractOptions.FieldChanges
+	Description        string
+	Design             string
+	AcceptanceCriteria string
+	Notes              string
 }
 
 // Extract extracts bead lifecycle events from git history
@@ -101,7 +111,7 @@ func (e *Extractor) Extract(opts ExtractOptions) ([]BeadEvent, error) {
 	}
 
 	// Parse output stream
-	events, parseErr := e.parseGitLogOutput(stdout, opts.BeadID)
+	events, parseErr := e.parseGitLogOutput(stdout, opts)
 
 	// If parsing failed, ensure we drain the pipe or kill the process to avoid deadlock
 	// where git log is blocked writing to full pipe while we wait for it to exit.
@@ -184,7 +194,7 @@ func insertBefore(slice []string, marker, value string) []string {
 }
 
 // parseGitLogOutput parses the combined commit info and diff output from a stream
-func (e *Extractor) parseGitLogOutput(r io.Reader, filterBeadID string) ([]BeadEvent, error) {
+func (e *Extractor) parseGitLogOutput(r io.Reader, opts ExtractOptions) ([]BeadEvent, error) {
 	var events []BeadEvent
 
 	// Use bufio.Reader instead of Scanner to handle long lines
@@ -201,7 +211,7 @@ func (e *Extractor) parseGitLogOutput(r io.Reader, filterBeadID string) ([]BeadE
 		}
 		diffBytes := diffBuffer.Bytes()
 		if len(diffBytes) > 0 {
-			diffEvents := e.parseDiff(diffBytes, *currentCommit, filterBeadID)
+			diffEvents := e.parseDiff(diffBytes, *currentCommit, opts)
 			events = append(events, diffEvents...)
 		}
 		diffBuffer.Reset()
@@ -293,8 +303,9 @@ func parseCommitInfo(line string) (commitInfo, error) {
 }
 
 // parseDiff extracts bead events from a diff section
-func (e *Extractor) parseDiff(diffData []byte, info commitInfo, filterBeadID string) []BeadEvent {
+func (e *Extractor) parseDiff(diffData []byte, info commitInfo, opts ExtractOptions) []BeadEvent {
 	var events []BeadEvent
+	filterBeadID := opts.BeadID
 
 	// Track old and new bead states for status change detection
 	oldBeads := make(map[string]beadSnapshot)
@@ -365,6 +376,9 @@ func (e *Extractor) parseDiff(diffData []byte, info commitInfo, filterBeadID str
 			event.EventType = EventCreated
 			events = append(events, event)
 		} else if hadOld && hasNew {
+			if opts.FieldChanges {
+				event.FieldChanges = diffFields(oldSnap, newSnap)
+			}
 			// Check for status change
 			if oldSnap.Status != newSnap.Status {
 				event.EventType = determineStatusEvent(oldSnap.Status, newSnap.Status)
@@ -384,9 +398,13 @@ func (e *Extractor) parseDiff(diffData []byte, info commitInfo, filterBeadID str
 // parseBeadJSON extracts minimal bead info from a JSON line
 func parseBeadJSON(jsonStr string) (beadSnapshot, bool) {
 	var partial struct {
-		ID     string `json:"id"`
-		Status string `json:"status"`
-		Title  string `json:"title"`
+		ID                 string `json:"id"`
+		Status             string `json:"status"`
+		Title              string `json:"title"`
+		Description        string `json:"description"`
+		Design             string `json:"design"`
+		AcceptanceCriteria string `json:"acceptance_criteria"`
+		Notes              string `json:"notes"`
 	}
 
 	if err := json.Unmarshal([]byte(jsonStr), &partial); err != nil {
@@ -398,12 +416,32 @@ func parseBeadJSON(jsonStr string) (beadSnapshot, bool) {
 	}
 
 	return beadSnapshot{
-		ID:     partial.ID,
-		Status: partial.Status,
-		Title:  partial.Title,
+		ID:                 partial.ID,
+		Status:             partial.Status,
+		Title:              partial.Title,
+		Description:        partial.Description,
+		Design:             partial.Design,
+		AcceptanceCriteria: partial.AcceptanceCriteria,
+		Notes:              partial.Notes,
 	}, true
 }
 
+// diffFields lists the text fields that differ between two snapshots of a bead
+func diffFields(oldSnap, newSnap beadSnapshot) []FieldChange {
+	var changes []FieldChange
+	add := func(field, oldText, newText string) {
+		if oldText != newText {
+			changes = append(changes, FieldChange{Field: field, Old: oldText, New: newText})
+		}
+	}
+	add("title", oldSnap.Title, newSnap.Title)
+	add("description", oldSnap.Description, newSnap.Description)
+	add("design", oldSnap.Design, newSnap.Design)
+	add("acceptance_criteria", oldSnap.AcceptanceCriteria, newSnap.AcceptanceCriteria)
+	add("notes", oldSnap.Notes, newSnap.Notes)
+	return changes
+}
+
 // determineStatusEvent determines the appropriate event type for a status transition
 func determineStatusEvent(oldStatus, newStatus string) EventType {
 	switch newStatus {
@@ -2,6 +2,7 @@ package correlation
 
 import (
 	"bytes"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -24,7 +25,7 @@ diff --git a/.beads/beads.jsonl b/.beads/beads.jsonl
 `)
 
 	e := NewExtractor("/tmp/test", "")
-	events, err := e.parseGitLogOutput(bytes.NewReader(data), "")
+	events, err := e.parseGitLogOutput(bytes.NewReader(data), ExtractOptions{})
 	if err != nil {
 		t.Fatalf("parseGitLogOutput failed: %v", err)
 	}
@@ -379,7 +380,7 @@ func TestParseDiff(t *testing.T) {
 +{"id":"bv-new","title":"New bead","status":"open"}
 `)
 
-		events := e.parseDiff(diffData, info, "")
+		events := e.parseDiff(diffData, info, ExtractOptions{})
 
 		if len(events) != 1 {
 			t.Fatalf("Expected 1 event, got %d", len(events))
@@ -400,7 +401,7 @@ func TestParseDiff(t *testing.T) {
 +{"id":"bv-123","title":"Test","status":"in_progress"}
 `)
 
-		events := e.parseDiff(diffData, info, "")
+		events := e.parseDiff(diffData, info, ExtractOptions{})
 
 		if len(events) != 1 {
 			t.Fatalf("Expected 1 event, got %d", len(events))
@@ -418,7 +419,7 @@ func TestParseDiff(t *testing.T) {
 +{"id":"bv-123","title":"Test","status":"closed"}
 `)
 
-		events := e.parseDiff(diffData, info, "")
+		events := e.parseDiff(diffData, info, ExtractOptions{})
 
 		if len(events) != 1 {
 			t.Fatalf("Expected 1 event, got %d", len(events))
@@ -436,7 +437,7 @@ func TestParseDiff(t *testing.T) {
 +{"id":"bv-123","title":"Test","status":"open"}
 `)
 
-		events := e.parseDiff(diffData, info, "")
+		events := e.parseDiff(diffData, info, ExtractOptions{})
 
 		if len(events) != 1 {
 			t.Fatalf("Expected 1 event, got %d", len(events))
@@ -452,7 +453,7 @@ func TestParseDiff(t *testing.T) {
 +{"id":"bv-002","title":"Second","status":"open"}
 `)
 
-		events := e.parseDiff(diffData, info, "bv-001")
+		events := e.parseDiff(diffData, info, ExtractOptions{BeadID: "bv-001"})
 
 		if len(events) != 1 {
 			t.Fatalf("Expected 1 event (filtered), got %d", len(events))
@@ -469,7 +470,7 @@ func TestParseDiff(t *testing.T) {
 +{"id":"bv-003","title":"Third","status":"open"}
 `)
 
-		events := e.parseDiff(diffData, info, "")
+		events := e.parseDiff(diffData, info, ExtractOptions{})
 
 		if len(events) != 3 {
 			t.Fatalf("Expected 3 events, got %d", len(events))
@@ -483,7 +484,7 @@ func TestParseDiff(t *testing.T) {
 +{"id":"bv-also-good","title":"Also Good","status":"open"}
 `)
 
-		events := e.parseDiff(diffData, info, "")
+		events := e.parseDiff(diffData, info, ExtractOptions{})
 
 		if len(events) != 2 {
 			t.Fatalf("Expected 2 events (skipping malformed), got %d", len(events))
@@ -496,7 +497,7 @@ func TestParseDiff(t *testing.T) {
 +{"id":"bv-123","title":"New Title","status":"open"}
 `)
 
-		events := e.parseDiff(diffData, info, "")
+		events := e.parseDiff(diffData, info, ExtractOptions{})
 
 		if len(events) != 1 {
 			t.Fatalf("Expected 1 event, got %d", len(events))
@@ -506,11 +507,31 @@ func TestParseDiff(t *testing.T) {
 		}
 	})
 
+	t.Run("field changes", func(t *testing.T) {
+		diffData := []byte(`diff --git a/.beads/beads.jsonl b/.beads/beads.jsonl
+-{"id":"bv-123","title":"Login","status":"open","description":"Add login","notes":"n"}
++{"id":"bv-123","title":"Login","status":"open","description":"Add login\nand logout","design":"JWT","notes":"n"}
+`)
+
+		if events := e.parseDiff(diffData, info, ExtractOptions{}); events[0].FieldChanges != nil {
+			t.Errorf("FieldChanges recorded without being asked for: %+v", events[0].FieldChanges)
+		}
+
+		events := e.parseDiff(diffData, info, ExtractOptions{FieldChanges: true})
+		want := []FieldChange{
+			{Field: "description", Old: "Add login", New: "Add login\nand logout"},
+			{Field: "design", Old: "", New: "JWT"},
+		}
+		if len(events) != 1 || !reflect.DeepEqual(events[0].FieldChanges, want) {
+			t.Errorf("FieldChanges = %+v, want %+v", events, want)
+		}
+	})
+
 	t.Run("empty diff", func(t *testing.T) {
 		diffData := []byte(`diff --git a/.beads/beads.jsonl b/.beads/beads.jsonl
 `)
 
-		events := e.parseDiff(diffData, info, "")
+		events := e.parseDiff(diffData, info, ExtractOptions{})
 
 		if len(events) != 0 {
 			t.Errorf("Expected 0 events for empty diff, got %d", len(events))
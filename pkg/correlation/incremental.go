@@ -265,7 +265,7 @@ func extractEventsFromCommits(extractor *Extractor, commitSHAs []string, filterB
 		return nil, fmt.Errorf("git log for commits failed: %w", err)
 	}
 
-	events, err := extractor.parseGitLogOutput(bytes.NewReader(out), filterBeadID)
+	events, err := extractor.parseGitLogOutput(bytes.NewReader(out), ExtractOptions{BeadID: filterBeadID})
 	if err != nil {
 		return nil, err
 	}
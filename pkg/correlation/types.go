@@ -45,6 +45,17 @@ type BeadEvent struct {
 	CommitMsg   string    `json:"commit_message"`
 	Author      string    `json:"author"`
 	AuthorEmail string    `json:"author_email"`
+
+	// FieldChanges holds the before and after text of edited fields, when
+	// extracted with ExtractOptions.FieldChanges
+	FieldChanges []FieldChange `json:"field_changes,omitempty"`
+}
+
+// FieldChange is one text field of a bead as it read before and after a commit
+type FieldChange struct {
+	Field string `json:"field"` // JSON name, e.g. "acceptance_criteria"
+	Old   string `json:"old"`
+	New   string `json:"new"`
 }
 
 // CorrelationMethod describes how a commit was linked to a bead
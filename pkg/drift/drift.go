@@ -399,7 +399,7 @@ func (c *Calculator) checkStaleness(result *Result) {
 	}
 	now := time.Now().UTC()
 	for _, issue := range c.issues {
-		if issue.Status == model.StatusClosed {
+		if issue.Status.IsClosed() {
 			continue
 		}
 
@@ -417,7 +417,7 @@ func (c *Calculator) checkStaleness(result *Result) {
 		crit := float64(critDays)
 
 		// Tighten thresholds for in-progress items
-		if issue.Status == model.StatusInProgress && inProgressMult > 0 {
+		if issue.Status.Column() == model.StatusInProgress && inProgressMult > 0 {
 			warn *= inProgressMult
 			crit *= inProgressMult
 		}
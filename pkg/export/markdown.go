@@ -286,8 +286,8 @@ func SaveMarkdownToFile(issues []model.Issue, filename string) error {
 
 	// Sort issues for the report: Open first, then priority, then date
 	sort.Slice(issuesCopy, func(i, j int) bool {
-		iClosed := issuesCopy[i].Status == model.StatusClosed
-		jClosed := issuesCopy[j].Status == model.StatusClosed
+		iClosed := issuesCopy[i].Status.IsClosed()
+		jClosed := issuesCopy[j].Status.IsClosed()
 		if iClosed != jClosed {
 			return !iClosed
 		}
@@ -322,7 +322,7 @@ func generateQuickActions(issues []model.Issue) string {
 		case model.StatusBlocked:
 			blockedIDs = append(blockedIDs, escapedID)
 		}
-		if i.Status != model.StatusClosed && i.Priority <= 1 {
+		if !i.Status.IsClosed() && i.Priority <= 1 {
 			highPriorityIDs = append(highPriorityIDs, escapedID)
 		}
 	}
@@ -373,7 +373,7 @@ func generateIssueCommands(issue model.Issue) string {
 	var sb strings.Builder
 
 	// Skip command snippets for closed issues
-	if issue.Status == model.StatusClosed {
+	if issue.Status.IsClosed() {
 		return ""
 	}
 
@@ -0,0 +1,98 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+// GenerateRetroMarkdown renders an epic retrospective as Markdown
+func GenerateRetroMarkdown(r *analysis.EpicRetro) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# Retro: %s %s\n\n", r.EpicID, r.EpicTitle))
+	sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", r.GeneratedAt.Format(time.RFC1123)))
+
+	// Summary
+	sb.WriteString("## Summary\n\n")
+	sb.WriteString("| Metric | Value |\n|--------|-------|\n")
+	sb.WriteString(fmt.Sprintf("| Epic status | %s |\n", r.EpicStatus))
+	sb.WriteString(fmt.Sprintf("| Issues closed | %d / %d |\n", r.Closed, r.Total))
+	sb.WriteString(fmt.Sprintf("| Planned up front | %d |\n", r.Planned))
+	sb.WriteString(fmt.Sprintf("| Added mid-flight | %d |\n", len(r.AddedMidFlight)))
+	if !r.FirstClosure.IsZero() {
+		sb.WriteString(fmt.Sprintf("| First closure | %s |\n", r.FirstClosure.Format("2006-01-02")))
+		sb.WriteString(fmt.Sprintf("| Last closure | %s |\n", r.LastClosure.Format("2006-01-02")))
+		sb.WriteString(fmt.Sprintf("| First → last closure | %s |\n", retroDuration(r.Span)))
+	}
+	if r.Closed > 0 {
+		sb.WriteString(fmt.Sprintf("| Median cycle time | %s |\n", retroDuration(r.MedianCycle)))
+		sb.WriteString(fmt.Sprintf("| P90 cycle time | %s |\n", retroDuration(r.P90Cycle)))
+	}
+	sb.WriteString("\n")
+
+	// Scope changes
+	sb.WriteString("## Added Mid-Flight\n\n")
+	if len(r.AddedMidFlight) == 0 {
+		sb.WriteString("*No issues were added after work started.*\n\n")
+	} else {
+		sb.WriteString("| ID | Title | Created |\n|----|-------|---------|\n")
+		for _, iss := range r.AddedMidFlight {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s |\n", iss.ID, escapeRetroCell(iss.Title), iss.CreatedAt.Format("2006-01-02")))
+		}
+		sb.WriteString("\n")
+	}
+
+	// Blocked time
+	sb.WriteString("## Longest Blocked\n\n")
+	if len(r.LongestBlocked) == 0 {
+		sb.WriteString("*Nothing waited on a blocker.*\n\n")
+	} else {
+		sb.WriteString("| ID | Title | Waited | Blocked by |\n|----|-------|--------|------------|\n")
+		for _, b := range r.LongestBlocked {
+			waited := retroDuration(b.Waited)
+			if b.StillBlocked {
+				waited += " (still blocked)"
+			}
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n", b.ID, escapeRetroCell(b.Title), waited, b.BlockedBy))
+		}
+		sb.WriteString("\n")
+	}
+
+	// Cycle time distribution
+	sb.WriteString("## Cycle Time Distribution\n\n")
+	if r.Closed == 0 {
+		sb.WriteString("*No closed issues yet.*\n")
+	} else {
+		sb.WriteString("| Cycle time | Issues |\n|------------|--------|\n")
+		for _, b := range r.CycleTimes {
+			sb.WriteString(fmt.Sprintf("| %s | %d |\n", b.Label, b.Count))
+		}
+	}
+
+	return sb.String()
+}
+
+// SaveRetroMarkdown writes the retrospective Markdown to a file
+func SaveRetroMarkdown(r *analysis.EpicRetro, filename string) error {
+	if err := os.WriteFile(filename, []byte(GenerateRetroMarkdown(r)), 0644); err != nil {
+		return fmt.Errorf("writing retro: %w", err)
+	}
+	return nil
+}
+
+// retroDuration formats a duration in days (or hours when under a day)
+func retroDuration(d time.Duration) string {
+	if d < 24*time.Hour {
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+	return fmt.Sprintf("%.1fd", d.Hours()/24)
+}
+
+// escapeRetroCell keeps titles from breaking Markdown tables
+func escapeRetroCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
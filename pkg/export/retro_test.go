@@ -0,0 +1,54 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+func TestGenerateRetroMarkdown(t *testing.T) {
+	first := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	r := &analysis.EpicRetro{
+		EpicID:         "bv-1",
+		EpicTitle:      "Search rewrite",
+		EpicStatus:     "closed",
+		GeneratedAt:    first,
+		Total:          3,
+		Closed:         3,
+		Planned:        2,
+		AddedMidFlight: []analysis.RetroIssue{{ID: "bv-4", Title: "Fix a|b", CreatedAt: first}},
+		FirstClosure:   first,
+		LastClosure:    first.Add(36 * time.Hour),
+		Span:           36 * time.Hour,
+		LongestBlocked: []analysis.RetroBlocked{{ID: "bv-3", Title: "Index", BlockedBy: "bv-2", Waited: 5 * time.Hour}},
+		CycleTimes:     []analysis.CycleTimeBucket{{Label: "<1d", Count: 3}},
+		MedianCycle:    2 * time.Hour,
+		P90Cycle:       20 * time.Hour,
+	}
+
+	md := GenerateRetroMarkdown(r)
+	for _, want := range []string{
+		"# Retro: bv-1 Search rewrite",
+		"| Issues closed | 3 / 3 |",
+		"| First → last closure | 1.5d |",
+		"| bv-4 | Fix a\\|b | 2025-03-01 |",
+		"| bv-3 | Index | 5h | bv-2 |",
+		"| <1d | 3 |",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("missing %q in:\n%s", want, md)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "retro.md")
+	if err := SaveRetroMarkdown(r, path); err != nil {
+		t.Fatalf("SaveRetroMarkdown: %v", err)
+	}
+	if data, err := os.ReadFile(path); err != nil || string(data) != md {
+		t.Errorf("saved file mismatch (err=%v)", err)
+	}
+}
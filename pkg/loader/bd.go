@@ -0,0 +1,14 @@
+package loader
+
+import "os/exec"
+
+// BdCommand is the beads CLI binary name.
+const BdCommand = "bd"
+
+// BdAvailable reports whether the bd CLI is on PATH. bv reads .beads JSONL
+// directly, so bd is only needed for writes (e.g. saving review comments);
+// without it bv runs read-only.
+func BdAvailable() bool {
+	_, err := exec.LookPath(BdCommand)
+	return err == nil
+}
@@ -0,0 +1,188 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DefaultBdBatchIDs caps the issue IDs passed to one combined bd invocation
+const DefaultBdBatchIDs = 50
+
+// DefaultBdInterval is the minimum gap between bd invocations, so a bulk
+// write doesn't contend with itself for the beads database lock
+const DefaultBdInterval = 25 * time.Millisecond
+
+// bdMultiIDCommands take several issue IDs before their arguments
+// (bd label add ID... LABEL), so writes that differ only in the issue
+// combine into one invocation
+var bdMultiIDCommands = [][]string{
+	{"label", "add"},
+	{"label", "remove"},
+}
+
+// BdWrite is one change to one issue: bd COMMAND... ISSUE-ID ARGS...
+type BdWrite struct {
+	IssueID string
+	Command []string // e.g. {"label", "add"}
+	Args    []string // After the issue ID, e.g. the label
+}
+
+func (w BdWrite) String() string {
+	return "bd " + strings.Join(w.Command, " ")
+}
+
+// BdWriteError is the failure of one write in a batch
+type BdWriteError struct {
+	Write BdWrite
+	Err   error
+}
+
+func (e *BdWriteError) Error() string {
+	return fmt.Sprintf("%s: %s failed: %v", e.Write.IssueID, e.Write, e.Err)
+}
+
+func (e *BdWriteError) Unwrap() error {
+	return e.Err
+}
+
+// BdBatcher writes many changes through the bd CLI in as few invocations as
+// it can. Writes sharing a multi-ID command and arguments go out together;
+// the rest run one at a time, no faster than Interval.
+type BdBatcher struct {
+	Dir      string
+	MaxIDs   int                   // IDs per combined invocation (0 = DefaultBdBatchIDs)
+	Interval time.Duration         // Minimum gap between invocations
+	Progress func(done, total int) // Called as writes finish; may be nil
+	DryRun   *DryRun               // Records the writes instead of running them; nil runs them
+	lastRun  time.Time
+}
+
+// NewBdBatcher returns a batcher for the beads workspace in dir
+func NewBdBatcher(dir string) *BdBatcher {
+	return &BdBatcher{Dir: dir, MaxIDs: DefaultBdBatchIDs, Interval: DefaultBdInterval}
+}
+
+// Run applies writes in order of their first appearance and returns one
+// *BdWriteError per write that failed. When a combined invocation fails,
+// its writes are retried one by one so the error names the issue at fault
+// and the others still go through. In a dry run (see DryRun) nothing is
+// invoked: every write is recorded as a pending change and succeeds.
+func (b *BdBatcher) Run(writes []BdWrite) []error {
+	if b.DryRun != nil {
+		for i, w := range writes {
+			b.DryRun.RecordBd(w)
+			if b.Progress != nil {
+				b.Progress(i+1, len(writes))
+			}
+		}
+		return nil
+	}
+	var errs []error
+	done := 0
+	for _, group := range b.group(writes) {
+		var failed []*BdWriteError
+		if len(group) > 1 {
+			if _, err := b.run(combinedArgs(group)); err != nil {
+				for _, w := range group {
+					failed = append(failed, b.runOne(w)...)
+				}
+			}
+		} else {
+			failed = b.runOne(group[0])
+		}
+		for _, e := range failed {
+			errs = append(errs, e)
+		}
+		done += len(group)
+		if b.Progress != nil {
+			b.Progress(done, len(writes))
+		}
+	}
+	return errs
+}
+
+// runOne runs a single write, returning its error if any
+func (b *BdBatcher) runOne(w BdWrite) []*BdWriteError {
+	args := append(slices.Clone(w.Command), w.IssueID)
+	args = append(args, w.Args...)
+	out, err := b.run(args)
+	if err == nil {
+		return nil
+	}
+	if msg := strings.TrimSpace(string(out)); msg != "" {
+		err = fmt.Errorf("%v: %s", err, msg)
+	}
+	return []*BdWriteError{{Write: w, Err: err}}
+}
+
+// run invokes bd, waiting out the rest of Interval since the last invocation
+func (b *BdBatcher) run(args []string) ([]byte, error) {
+	if wait := b.Interval - time.Since(b.lastRun); !b.lastRun.IsZero() && wait > 0 {
+		time.Sleep(wait)
+	}
+	defer func() { b.lastRun = time.Now() }()
+	return runBd(b.Dir, args...)
+}
+
+// group splits writes into invocations: combinable writes with the same
+// command and arguments share one (up to MaxIDs), the rest go alone
+func (b *BdBatcher) group(writes []BdWrite) [][]BdWrite {
+	maxIDs := b.MaxIDs
+	if maxIDs <= 0 {
+		maxIDs = DefaultBdBatchIDs
+	}
+	var groups [][]BdWrite
+	open := make(map[string]int) // Batch key -> index of the group still filling
+	for _, w := range writes {
+		if !combinable(w) {
+			groups = append(groups, []BdWrite{w})
+			continue
+		}
+		key := strings.Join(w.Command, "\x00") + "\x01" + strings.Join(w.Args, "\x00")
+		if i, ok := open[key]; ok && len(groups[i]) < maxIDs && !containsIssue(groups[i], w.IssueID) {
+			groups[i] = append(groups[i], w)
+			continue
+		}
+		open[key] = len(groups)
+		groups = append(groups, []BdWrite{w})
+	}
+	return groups
+}
+
+func combinable(w BdWrite) bool {
+	for _, cmd := range bdMultiIDCommands {
+		if slices.Equal(w.Command, cmd) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsIssue(group []BdWrite, id string) bool {
+	return slices.ContainsFunc(group, func(w BdWrite) bool { return w.IssueID == id })
+}
+
+// combinedArgs builds bd COMMAND... ID... ARGS... for a group of writes
+// sharing command and arguments
+func combinedArgs(group []BdWrite) []string {
+	args := slices.Clone(group[0].Command)
+	for _, w := range group {
+		args = append(args, w.IssueID)
+	}
+	return append(args, group[0].Args...)
+}
+
+// FailedIssues returns the IDs of the issues with a *BdWriteError in errs
+func FailedIssues(errs []error) map[string]bool {
+	failed := make(map[string]bool, len(errs))
+	for _, err := range errs {
+		var writeErr *BdWriteError
+		if errors.As(err, &writeErr) {
+			failed[writeErr.Write.IssueID] = true
+		}
+	}
+	return failed
+}
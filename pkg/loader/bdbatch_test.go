@@ -0,0 +1,97 @@
+package loader
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBdBatcherRun(t *testing.T) {
+	var calls []string
+	orig := runBd
+	defer func() { runBd = orig }()
+	runBd = func(dir string, args ...string) ([]byte, error) {
+		call := strings.Join(args, " ")
+		calls = append(calls, call)
+		if strings.HasPrefix(call, "comment z") {
+			return []byte("no such issue"), errors.New("exit status 1")
+		}
+		return nil, nil
+	}
+
+	add := func(id, label string) BdWrite {
+		return BdWrite{IssueID: id, Command: []string{"label", "add"}, Args: []string{label}}
+	}
+	writes := []BdWrite{
+		add("a", "api"), add("b", "api"), add("c", "ui"), add("d", "api"),
+		{IssueID: "z", Command: []string{"comment"}, Args: []string{"hi"}},
+		{IssueID: "y", Command: []string{"comment"}, Args: []string{"hi"}},
+	}
+	b := &BdBatcher{Dir: "/work", MaxIDs: 2}
+	var progress []int
+	b.Progress = func(done, total int) {
+		if total != len(writes) {
+			t.Errorf("total = %d, want %d", total, len(writes))
+		}
+		progress = append(progress, done)
+	}
+
+	errs := b.Run(writes)
+	want := []string{
+		"label add a b api", // Capped at MaxIDs
+		"label add c ui",
+		"label add d api",
+		"comment z hi", // Comments take one issue each
+		"comment y hi",
+	}
+	if !slices.Equal(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+	if !slices.Equal(progress, []int{2, 3, 4, 5, 6}) {
+		t.Errorf("progress = %v", progress)
+	}
+	if len(errs) != 1 || !FailedIssues(errs)["z"] || !strings.Contains(errs[0].Error(), "z: bd comment failed: exit status 1: no such issue") {
+		t.Errorf("errs = %v, want z's comment only", errs)
+	}
+}
+
+func TestBdBatcherDryRun(t *testing.T) {
+	orig := runBd
+	defer func() { runBd = orig }()
+	runBd = func(dir string, args ...string) ([]byte, error) {
+		t.Errorf("bd ran in a dry run: %v", args)
+		return nil, nil
+	}
+	d := NewDryRun()
+	batcher := NewBdBatcher("/work")
+	batcher.DryRun = d
+
+	errs := batcher.Run([]BdWrite{
+		{IssueID: "a", Command: []string{"label", "add"}, Args: []string{"api"}},
+		{IssueID: "b", Command: []string{"comment"}, Args: []string{"looks good"}},
+	})
+	if len(errs) != 0 {
+		t.Fatalf("errs = %v", errs)
+	}
+	changes := d.Changes()
+	if len(changes) != 2 || changes[0].Summary != "bd label add a api" || changes[1].IssueID != "b" {
+		t.Fatalf("changes = %+v", changes)
+	}
+
+	path := filepath.Join(t.TempDir(), DryRunFile)
+	if err := d.Export(path); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	var export struct {
+		DryRun  bool            `json:"dry_run"`
+		Changes []PendingChange `json:"changes"`
+	}
+	if err := json.Unmarshal(data, &export); err != nil || !export.DryRun || len(export.Changes) != 2 {
+		t.Errorf("export = %s (%v)", data, err)
+	}
+}
@@ -0,0 +1,100 @@
+package loader
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DryRunFile is where the pending changes of a dry run are exported, in the
+// working directory
+const DryRunFile = "bv-dry-run.json"
+
+// PendingChange is a write that dry-run mode held back
+type PendingChange struct {
+	At      time.Time `json:"at"`
+	Kind    string    `json:"kind"`               // "bd" for tracker writes, "file" for bv's own files
+	IssueID string    `json:"issue_id,omitempty"` // For "bd" changes
+	Path    string    `json:"path,omitempty"`     // For "file" changes: the file that would be written
+	Summary string    `json:"summary"`            // e.g. "bd label add bv-1 api"
+	Command []string  `json:"command,omitempty"`  // For "bd" changes: the bd arguments
+}
+
+// DryRun collects the changes of a session that must not persist anything
+type DryRun struct {
+	mu      sync.Mutex
+	changes []PendingChange
+}
+
+// NewDryRun returns an empty change set
+func NewDryRun() *DryRun {
+	return &DryRun{}
+}
+
+// Record adds c to the change set, stamping it if it has no time yet
+func (d *DryRun) Record(c PendingChange) {
+	if c.At.IsZero() {
+		c.At = time.Now()
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.changes = append(d.changes, c)
+}
+
+// RecordFile records that bv would have written path; summary says what
+func (d *DryRun) RecordFile(path, summary string) {
+	d.Record(PendingChange{Kind: "file", Path: path, Summary: summary})
+}
+
+// RecordBd records w as the bd invocation it would have been
+func (d *DryRun) RecordBd(w BdWrite) {
+	args := append(slices.Clone(w.Command), w.IssueID)
+	args = append(args, w.Args...)
+	d.Record(PendingChange{
+		Kind:    "bd",
+		IssueID: w.IssueID,
+		Summary: BdCommand + " " + strings.Join(args, " "),
+		Command: args,
+	})
+}
+
+// Changes returns the changes recorded so far, oldest first
+func (d *DryRun) Changes() []PendingChange {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return slices.Clone(d.changes)
+}
+
+// Len returns how many changes are pending
+func (d *DryRun) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.changes)
+}
+
+// dryRunExport is the DryRunFile format
+type dryRunExport struct {
+	DryRun      bool            `json:"dry_run"`
+	GeneratedAt time.Time       `json:"generated_at"`
+	Changes     []PendingChange `json:"changes"`
+}
+
+// Export writes the change set to path as JSON
+func (d *DryRun) Export(path string) error {
+	changes := d.Changes()
+	if changes == nil {
+		changes = []PendingChange{}
+	}
+	data, err := json.MarshalIndent(dryRunExport{DryRun: true, GeneratedAt: time.Now(), Changes: changes}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding pending changes: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing pending changes: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package loader
+
+// MarkDuplicate records through the bd CLI that dupID duplicates ofID: a
+// related dependency from dupID to ofID (bd dep add DUP OF --type=related),
+// then label on dupID. Both writes are attempted; the errors of those that
+// failed come back. In a dry run (dry not nil) they are recorded instead.
+func MarkDuplicate(workDir, dupID, ofID, label string, dry *DryRun) []error {
+	batcher := NewBdBatcher(workDir)
+	batcher.DryRun = dry
+	return batcher.Run([]BdWrite{
+		{IssueID: dupID, Command: []string{"dep", "add"}, Args: []string{ofID, "--type=related"}},
+		{IssueID: dupID, Command: []string{"label", "add"}, Args: []string{label}},
+	})
+}
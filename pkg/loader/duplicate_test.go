@@ -0,0 +1,35 @@
+package loader
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMarkDuplicate(t *testing.T) {
+	var calls []string
+	orig := runBd
+	defer func() { runBd = orig }()
+	runBd = func(dir string, args ...string) ([]byte, error) {
+		calls = append(calls, strings.Join(args, " "))
+		if args[0] == "dep" && args[3] == "bv-gone" {
+			return []byte("issue not found"), errors.New("exit status 1")
+		}
+		return nil, nil
+	}
+
+	if errs := MarkDuplicate("/work", "bv-2", "bv-1", "duplicate", nil); len(errs) != 0 {
+		t.Fatalf("MarkDuplicate: %v", errs)
+	}
+	want := []string{"dep add bv-2 bv-1 --type=related", "label add bv-2 duplicate"}
+	if strings.Join(calls, "|") != strings.Join(want, "|") {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+
+	// A failed link still labels the issue, and says which write failed
+	calls = nil
+	errs := MarkDuplicate("/work", "bv-3", "bv-gone", "duplicate", nil)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "bd dep add failed") || len(calls) != 2 {
+		t.Errorf("errs = %v, calls = %q", errs, calls)
+	}
+}
@@ -0,0 +1,45 @@
+package loader_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+)
+
+// FuzzParseIssues feeds arbitrary bytes to the JSONL parser. Bad lines must
+// be skipped with a warning, never panic, and whatever is kept must be a
+// valid issue that survives being written back out and parsed again.
+func FuzzParseIssues(f *testing.F) {
+	f.Add([]byte(`{"id":"bv-1","title":"Good","status":"open","priority":1,"issue_type":"task"}`), 0)
+	f.Add([]byte("\xef\xbb\xbf{\"id\":\"bv-2\",\"title\":\"BOM\",\"status\":\"closed\"}\n{INVALID JSON}\n\n"), 0)
+	f.Add([]byte(`{"id":"bv-3","title":"Deps","dependencies":[{"issue_id":"bv-3","depends_on_id":"bv-1","type":"blocks"},null]}`), 16)
+	f.Add([]byte(`{"id":"","title":"No ID"}`+"\n"+`{"id":"x","title":"","labels":null,"comments":[null]}`), 0)
+	f.Add([]byte(`{"id":"old","title":"Schema v0","state":"open","type":"bug","deps":["bv-1"]}`), 0)
+	f.Add([]byte(testutil.ToJSONL(testutil.QuickDiamond(3))), 64)
+
+	f.Fuzz(func(t *testing.T, data []byte, bufferSize int) {
+		opts := loader.ParseOptions{WarningHandler: func(string) {}, BufferSize: bufferSize % 4096}
+		issues, err := loader.ParseIssuesWithOptions(bytes.NewReader(data), opts)
+		if err != nil {
+			t.Fatalf("in-memory parse failed: %v", err)
+		}
+		for i := range issues {
+			if err := issues[i].Validate(); err != nil {
+				t.Fatalf("kept invalid issue %q: %v", issues[i].ID, err)
+			}
+		}
+
+		again, err := loader.ParseIssuesWithOptions(strings.NewReader(testutil.ToJSONL(issues)), loader.ParseOptions{WarningHandler: func(string) {}})
+		if err != nil || len(again) != len(issues) {
+			t.Fatalf("re-parse kept %d of %d issues (err %v)", len(again), len(issues), err)
+		}
+		for i := range issues {
+			if again[i].ID != issues[i].ID {
+				t.Fatalf("re-parse issue %d = %q, want %q", i, again[i].ID, issues[i].ID)
+			}
+		}
+	})
+}
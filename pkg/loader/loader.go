@@ -3,6 +3,8 @@ package loader
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -180,6 +182,23 @@ func LoadIssuesFromFile(path string) ([]model.Issue, error) {
 	return LoadIssuesFromFileWithOptions(path, ParseOptions{})
 }
 
+// FileChecksum returns a SHA-256 checksum of the file's contents. Callers use
+// it to skip re-parsing when a watched file was rewritten without changes
+// (bd rewrites its JSONL export on every sync, even when nothing moved).
+func FileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open issues file: %w", err)
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("failed to read issues file: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // ParseIssues parses JSONL content from a reader into issues.
 // Handles UTF-8 BOM stripping, large lines, and validation.
 func ParseIssues(r io.Reader) ([]model.Issue, error) {
@@ -189,7 +208,19 @@ func ParseIssues(r io.Reader) ([]model.Issue, error) {
 // ParseIssuesWithOptions parses JSONL content with custom options.
 func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, error) {
 	var issues []model.Issue
+	err := parseIssues(r, opts, func(issue model.Issue) error {
+		issues = append(issues, issue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
 
+// parseIssues reads JSONL from r and hands each valid issue to emit, in file
+// order. Parsing stops at the first error emit returns.
+func parseIssues(r io.Reader, opts ParseOptions, emit func(model.Issue) error) error {
 	// Determine buffer size
 	maxCapacity := opts.BufferSize
 	if maxCapacity <= 0 {
@@ -198,18 +229,12 @@ func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, erro
 
 	reader := bufio.NewReaderSize(r, maxCapacity)
 
-	// Default warning handler prints to stderr (suppressed in robot mode).
 	warn := opts.WarningHandler
 	if warn == nil {
-		if os.Getenv("BV_ROBOT") == "1" {
-			warn = func(string) {}
-		} else {
-			warn = func(msg string) {
-				fmt.Fprintf(os.Stderr, "Warning: %s\n", msg)
-			}
-		}
+		warn = defaultWarningHandler()
 	}
 
+	schema := newSchemaCheck()
 	lineNum := 0
 	for {
 		lineNum++
@@ -221,7 +246,7 @@ func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, erro
 			if err == io.EOF {
 				break
 			}
-			return nil, fmt.Errorf("error reading issues stream at line %d: %w", lineNum, err)
+			return fmt.Errorf("error reading issues stream at line %d: %w", lineNum, err)
 		}
 
 		if isPrefix {
@@ -230,7 +255,7 @@ func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, erro
 			for isPrefix {
 				_, isPrefix, err = reader.ReadLine()
 				if err != nil && err != io.EOF {
-					return nil, fmt.Errorf("error skipping long line at line %d: %w", lineNum, err)
+					return fmt.Errorf("error skipping long line at line %d: %w", lineNum, err)
 				}
 				if err == io.EOF {
 					break
@@ -255,17 +280,43 @@ func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, erro
 			continue
 		}
 
+		// Check leading records (and any that fail validation) against the
+		// schema, upgrading records from older bd versions in place
+		validErr := issue.Validate()
+		if lineNum <= schemaSampleLines || validErr != nil {
+			if adapted, changed := schema.inspect(line); changed {
+				var upgraded model.Issue
+				if err := json.Unmarshal(adapted, &upgraded); err == nil {
+					issue = upgraded
+					validErr = issue.Validate()
+				}
+			}
+		}
+
 		// Validate issue
-		if err := issue.Validate(); err != nil {
+		if err := validErr; err != nil {
 			// Skip invalid issues
 			warn(fmt.Sprintf("skipping invalid issue on line %d: %v", lineNum, err))
 			continue
 		}
 
-		issues = append(issues, issue)
+		if err := emit(issue); err != nil {
+			return err
+		}
 	}
 
-	return issues, nil
+	schema.report(warn)
+	return nil
+}
+
+// defaultWarningHandler prints warnings to stderr (suppressed in robot mode)
+func defaultWarningHandler() func(string) {
+	if os.Getenv("BV_ROBOT") == "1" {
+		return func(string) {}
+	}
+	return func(msg string) {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", msg)
+	}
 }
 
 // stripBOM removes the UTF-8 Byte Order Mark if present
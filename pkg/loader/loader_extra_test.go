@@ -51,3 +51,34 @@ func TestParseIssuesWithOptions_LineTooLong(t *testing.T) {
 		t.Errorf("Expected warning containing %q, got: %v", expectedWarning, warnings)
 	}
 }
+
+func TestFileChecksum(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "issues.jsonl")
+	if err := os.WriteFile(path, []byte(`{"id":"A","title":"A"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := loader.FileChecksum(path)
+	if err != nil {
+		t.Fatalf("FileChecksum: %v", err)
+	}
+	// Rewriting identical bytes keeps the checksum
+	if err := os.WriteFile(path, []byte(`{"id":"A","title":"A"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := loader.FileChecksum(path); again != first {
+		t.Errorf("identical content changed checksum: %s vs %s", first, again)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"id":"A","title":"B"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if changed, _ := loader.FileChecksum(path); changed == first {
+		t.Error("different content should change the checksum")
+	}
+
+	if _, err := loader.FileChecksum(filepath.Join(dir, "missing.jsonl")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
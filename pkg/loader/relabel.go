@@ -0,0 +1,102 @@
+package loader
+
+import (
+	"fmt"
+	"os/exec"
+	"slices"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Relabel moves every issue labeled From over to To. When To is already in
+// use the two labels merge; otherwise From is simply renamed.
+type Relabel struct {
+	From      string
+	To        string
+	Merge     bool     // To is already in use
+	IssueIDs  []string // Issues labeled From, in load order
+	HasTarget []string // Of those, the ones already carrying To
+}
+
+// PlanRelabel lists the issues a rename or merge of from into to touches
+func PlanRelabel(issues []model.Issue, from, to string) Relabel {
+	plan := Relabel{From: from, To: to}
+	for _, issue := range issues {
+		hasTo := slices.Contains(issue.Labels, to)
+		plan.Merge = plan.Merge || hasTo
+		if !slices.Contains(issue.Labels, from) {
+			continue
+		}
+		plan.IssueIDs = append(plan.IssueIDs, issue.ID)
+		if hasTo {
+			plan.HasTarget = append(plan.HasTarget, issue.ID)
+		}
+	}
+	return plan
+}
+
+// runBd runs a bd command in dir; swapped out in tests
+var runBd = func(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command(BdCommand, args...)
+	cmd.Dir = dir
+	return cmd.CombinedOutput()
+}
+
+// ApplyRelabel writes plan back through the bd CLI: issues without To gain
+// it, then every issue that has To loses From. Both steps go out in batches
+// (see BdBatcher), so an issue whose add failed keeps From. It keeps going
+// past failures, reporting progress in writes, and returns how many issues
+// were fully relabeled. In a dry run (dry not nil) the writes are recorded.
+func ApplyRelabel(workDir string, plan Relabel, dry *DryRun, progress func(done, total int)) (int, []error) {
+	if plan.From == "" || plan.To == "" || plan.From == plan.To {
+		return 0, []error{fmt.Errorf("relabel needs two different labels")}
+	}
+
+	var adds []BdWrite
+	for _, id := range plan.IssueIDs {
+		if !slices.Contains(plan.HasTarget, id) {
+			adds = append(adds, BdWrite{IssueID: id, Command: []string{"label", "add"}, Args: []string{plan.To}})
+		}
+	}
+	total := len(adds) + len(plan.IssueIDs)
+
+	batcher := NewBdBatcher(workDir)
+	batcher.DryRun = dry
+	if progress != nil {
+		batcher.Progress = func(done, _ int) { progress(done, total) }
+	}
+	errs := batcher.Run(adds)
+	failedAdd := FailedIssues(errs)
+
+	var removes []BdWrite
+	for _, id := range plan.IssueIDs {
+		if !failedAdd[id] {
+			removes = append(removes, BdWrite{IssueID: id, Command: []string{"label", "remove"}, Args: []string{plan.From}})
+		}
+	}
+	if progress != nil {
+		skipped := len(plan.IssueIDs) - len(removes)
+		batcher.Progress = func(done, _ int) { progress(len(adds)+skipped+done, total) }
+	}
+	removeErrs := batcher.Run(removes)
+	errs = append(errs, removeErrs...)
+	return len(removes) - len(removeErrs), errs
+}
+
+// EditLabel adds label to every issue in ids, or with remove takes it off
+// them, through the bd CLI in batches (see BdBatcher). It returns one error
+// per issue that could not be written; in a dry run (dry not nil) the
+// writes are recorded.
+func EditLabel(workDir string, ids []string, label string, remove bool, dry *DryRun) []error {
+	command := []string{"label", "add"}
+	if remove {
+		command = []string{"label", "remove"}
+	}
+	writes := make([]BdWrite, 0, len(ids))
+	for _, id := range ids {
+		writes = append(writes, BdWrite{IssueID: id, Command: command, Args: []string{label}})
+	}
+	batcher := NewBdBatcher(workDir)
+	batcher.DryRun = dry
+	return batcher.Run(writes)
+}
@@ -0,0 +1,94 @@
+package loader
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestPlanRelabel(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Labels: []string{"ui"}},
+		{ID: "b", Labels: []string{"ui", "frontend"}},
+		{ID: "c", Labels: []string{"frontend"}},
+		{ID: "d"},
+	}
+
+	merge := PlanRelabel(issues, "ui", "frontend")
+	if !merge.Merge {
+		t.Error("frontend is in use, want a merge")
+	}
+	if !slices.Equal(merge.IssueIDs, []string{"a", "b"}) || !slices.Equal(merge.HasTarget, []string{"b"}) {
+		t.Errorf("merge plan = %+v", merge)
+	}
+
+	rename := PlanRelabel(issues, "ui", "web")
+	if rename.Merge || len(rename.HasTarget) != 0 || !slices.Equal(rename.IssueIDs, []string{"a", "b"}) {
+		t.Errorf("rename plan = %+v", rename)
+	}
+}
+
+func TestApplyRelabel(t *testing.T) {
+	var calls []string
+	orig := runBd
+	defer func() { runBd = orig }()
+	runBd = func(dir string, args ...string) ([]byte, error) {
+		call := strings.Join(args, " ")
+		calls = append(calls, call)
+		if strings.HasPrefix(call, "label add") && strings.Contains(call, " c ") {
+			return []byte("issue not found"), errors.New("exit status 1")
+		}
+		return nil, nil
+	}
+
+	plan := Relabel{From: "ui", To: "frontend", IssueIDs: []string{"a", "b", "c"}, HasTarget: []string{"b"}}
+	var progress [][2]int
+	done, errs := ApplyRelabel("/work", plan, nil, func(done, total int) {
+		progress = append(progress, [2]int{done, total})
+	})
+	if done != 2 {
+		t.Errorf("done = %d, want 2", done)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "c: bd label add failed") || !strings.Contains(errs[0].Error(), "issue not found") {
+		t.Errorf("errs = %v", errs)
+	}
+	// One combined add; its failure is retried per issue, and c keeps ui
+	want := []string{
+		"label add a c frontend",
+		"label add a frontend", "label add c frontend",
+		"label remove a b ui",
+	}
+	if !slices.Equal(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+	if !slices.Equal(progress, [][2]int{{2, 5}, {5, 5}}) {
+		t.Errorf("progress = %v", progress)
+	}
+
+	if _, errs := ApplyRelabel("/work", Relabel{From: "ui", To: "ui"}, nil, nil); len(errs) != 1 {
+		t.Errorf("same-label relabel errs = %v, want one", errs)
+	}
+}
+
+func TestEditLabel(t *testing.T) {
+	var calls []string
+	orig := runBd
+	defer func() { runBd = orig }()
+	runBd = func(dir string, args ...string) ([]byte, error) {
+		calls = append(calls, strings.Join(args, " "))
+		return nil, nil
+	}
+
+	if errs := EditLabel("/work", []string{"a", "b"}, "api", false, nil); len(errs) != 0 {
+		t.Errorf("add errs = %v", errs)
+	}
+	if errs := EditLabel("/work", []string{"a"}, "api", true, nil); len(errs) != 0 {
+		t.Errorf("remove errs = %v", errs)
+	}
+	if want := []string{"label add a b api", "label remove a api"}; !slices.Equal(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+}
@@ -0,0 +1,189 @@
+package loader
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// CurrentSchemaVersion is the newest beads record schema bv understands.
+// Version 1 is the shape bd has exported since issue types were introduced:
+// issue_type on every record, dependencies as objects with depends_on_id.
+const CurrentSchemaVersion = 1
+
+// SchemaVersionField is the optional per-record field declaring the schema
+// version. Records without it are versioned by shape.
+const SchemaVersionField = "schema_version"
+
+// schemaSampleLines is how many leading records are always inspected for
+// unknown or missing fields. Later records are only inspected when they fail
+// to validate, keeping the fast path a single json.Unmarshal per line.
+const schemaSampleLines = 50
+
+// bdExtraFields are fields bd exports that bv deliberately does not model.
+// They are known, so they never trigger unknown-field warnings.
+var bdExtraFields = []string{
+	SchemaVersionField,
+	"content_hash",
+	"close_reason",
+	"created_by",
+	"deleted_at",
+	"deleted_by",
+	"delete_reason",
+	"original_type",
+}
+
+// expectedFields are fields every current record must carry. Reporting their
+// absence next to unknown fields points at a rename in bd's export.
+var expectedFields = []string{"id", "title", "status", "issue_type"}
+
+// schemaAdapters upgrade a record from the keyed version to the next one.
+// Keep at least the previous major version here so an older bd keeps working.
+var schemaAdapters = map[int]func(rec map[string]json.RawMessage){
+	0: upgradeSchemaV0,
+}
+
+// upgradeSchemaV0 handles records from before issue types: the type lived in
+// "type" if anywhere, and status could be omitted for open issues.
+func upgradeSchemaV0(rec map[string]json.RawMessage) {
+	if _, ok := rec["issue_type"]; !ok {
+		var legacy string
+		if raw, ok := rec["type"]; ok && json.Unmarshal(raw, &legacy) == nil && model.IssueType(legacy).IsValid() {
+			rec["issue_type"] = raw
+			delete(rec, "type")
+		} else {
+			rec["issue_type"] = json.RawMessage(`"task"`)
+		}
+	}
+	if _, ok := rec["status"]; !ok {
+		rec["status"] = json.RawMessage(`"open"`)
+	}
+}
+
+// knownIssueFields holds the JSON field names model.Issue decodes, plus bdExtraFields.
+var knownIssueFields = func() map[string]bool {
+	known := make(map[string]bool)
+	t := reflect.TypeOf(model.Issue{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			known[name] = true
+		}
+	}
+	for _, name := range bdExtraFields {
+		known[name] = true
+	}
+	return known
+}()
+
+// schemaCheck accumulates schema drift observed during one parse.
+type schemaCheck struct {
+	newest   int            // Highest declared schema_version
+	upgraded map[int]int    // Records adapted, by source version
+	unknown  map[string]int // Unrecognized top-level fields after adaptation -> record count
+	missing  map[string]int // Expected fields absent after adaptation -> record count
+}
+
+func newSchemaCheck() *schemaCheck {
+	return &schemaCheck{
+		upgraded: make(map[int]int),
+		unknown:  make(map[string]int),
+		missing:  make(map[string]int),
+	}
+}
+
+// recordVersion returns the declared schema version, or infers it from shape.
+func recordVersion(rec map[string]json.RawMessage) int {
+	if raw, ok := rec[SchemaVersionField]; ok {
+		var v int
+		if json.Unmarshal(raw, &v) == nil {
+			return v
+		}
+	}
+	if _, ok := rec["issue_type"]; !ok {
+		return 0
+	}
+	return CurrentSchemaVersion
+}
+
+// inspect records drift for one line and, when the record is from an older
+// schema, returns it upgraded to the current one (changed=true).
+func (s *schemaCheck) inspect(line []byte) (adapted []byte, changed bool) {
+	var rec map[string]json.RawMessage
+	if err := json.Unmarshal(line, &rec); err != nil || rec == nil {
+		return nil, false // Not an object; a JSON null decodes to a nil map
+	}
+
+	version := recordVersion(rec)
+	if version > s.newest {
+		s.newest = version
+	}
+	for v := version; v < CurrentSchemaVersion; v++ {
+		if adapt, ok := schemaAdapters[v]; ok {
+			adapt(rec)
+			changed = true
+		}
+	}
+	if changed {
+		s.upgraded[version]++
+	}
+
+	for key := range rec {
+		if !knownIssueFields[key] {
+			s.unknown[key]++
+		}
+	}
+	for _, field := range expectedFields {
+		if _, ok := rec[field]; !ok {
+			s.missing[field]++
+		}
+	}
+
+	if !changed {
+		return nil, false
+	}
+	out, err := json.Marshal(rec)
+	if err != nil {
+		return nil, false
+	}
+	return out, true
+}
+
+// report emits one warning per kind of drift, in a stable order.
+func (s *schemaCheck) report(warn func(string)) {
+	if s.newest > CurrentSchemaVersion {
+		warn(fmt.Sprintf("beads data declares schema v%d but bv supports up to v%d; loading best-effort (upgrade bv)", s.newest, CurrentSchemaVersion))
+	}
+	versions := make([]int, 0, len(s.upgraded))
+	for v := range s.upgraded {
+		versions = append(versions, v)
+	}
+	sort.Ints(versions)
+	for _, v := range versions {
+		warn(fmt.Sprintf("upgraded %d record(s) from beads schema v%d to v%d", s.upgraded[v], v, CurrentSchemaVersion))
+	}
+	if len(s.unknown) > 0 {
+		warn(fmt.Sprintf("ignoring unknown field(s) %s (bd may be newer than bv)", sortedFieldCounts(s.unknown)))
+	}
+	if len(s.missing) > 0 {
+		warn(fmt.Sprintf("records missing expected field(s) %s", sortedFieldCounts(s.missing)))
+	}
+}
+
+// sortedFieldCounts renders "a (3), b (1)" sorted by field name.
+func sortedFieldCounts(counts map[string]int) string {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s (%d)", name, counts[name])
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,71 @@
+package loader_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func parseWithWarnings(t *testing.T, data string) ([]model.Issue, []string) {
+	t.Helper()
+	var warnings []string
+	issues, err := loader.ParseIssuesWithOptions(strings.NewReader(data), loader.ParseOptions{
+		WarningHandler: func(msg string) { warnings = append(warnings, msg) },
+	})
+	if err != nil {
+		t.Fatalf("ParseIssuesWithOptions: %v", err)
+	}
+	return issues, warnings
+}
+
+func TestSchema_CurrentRecordsAreQuiet(t *testing.T) {
+	// Includes fields bd exports that bv intentionally does not model
+	data := `{"id":"A","title":"A","status":"open","issue_type":"task","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","created_by":"me","close_reason":""}`
+	issues, warnings := parseWithWarnings(t, data)
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+	if len(warnings) != 0 {
+		t.Errorf("expected no warnings, got %v", warnings)
+	}
+}
+
+func TestSchema_UpgradesV0Records(t *testing.T) {
+	data := `{"id":"OLD-1","title":"Legacy bug","type":"bug","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}
+{"id":"OLD-2","title":"Legacy untyped","status":"closed","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`
+	issues, warnings := parseWithWarnings(t, data)
+	if len(issues) != 2 {
+		t.Fatalf("expected both legacy records to load, got %d (warnings: %v)", len(issues), warnings)
+	}
+	if issues[0].IssueType != model.TypeBug || issues[0].Status != model.StatusOpen {
+		t.Errorf("OLD-1 upgraded to %s/%s, want bug/open", issues[0].IssueType, issues[0].Status)
+	}
+	if issues[1].IssueType != model.TypeTask || issues[1].Status != model.StatusClosed {
+		t.Errorf("OLD-2 upgraded to %s/%s, want task/closed", issues[1].IssueType, issues[1].Status)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "upgraded 2 record(s) from beads schema v0") {
+		t.Errorf("expected one upgrade warning, got %v", warnings)
+	}
+}
+
+func TestSchema_NewerSchemaAndUnknownFields(t *testing.T) {
+	data := `{"id":"N-1","title":"Future","status":"open","issue_type":"task","schema_version":2,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","sprint_points":3}
+{"id":"N-2","title":"Future","status":"open","issue_type":"task","schema_version":2,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","sprint_points":5}
+{"id":"N-3","summary":"Renamed title","status":"open","issue_type":"task","schema_version":2}`
+	issues, warnings := parseWithWarnings(t, data)
+	if len(issues) != 2 {
+		t.Fatalf("newer records should still load, got %d", len(issues))
+	}
+	joined := strings.Join(warnings, "\n")
+	for _, want := range []string{
+		"declares schema v2 but bv supports up to v1",
+		"ignoring unknown field(s) sprint_points (2), summary (1)",
+		"missing expected field(s) title (1)",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("warnings missing %q:\n%s", want, joined)
+		}
+	}
+}
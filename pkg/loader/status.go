@@ -0,0 +1,15 @@
+package loader
+
+import "github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+// SetStatus writes issueID's new status through the bd CLI
+// (bd update ID --status STATUS), or records it in dry when that is not nil
+func SetStatus(workDir, issueID string, status model.Status, dry *DryRun) error {
+	write := BdWrite{IssueID: issueID, Command: []string{"update"}, Args: []string{"--status", string(status)}}
+	batcher := NewBdBatcher(workDir)
+	batcher.DryRun = dry
+	if errs := batcher.Run([]BdWrite{write}); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
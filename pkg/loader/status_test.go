@@ -0,0 +1,43 @@
+package loader
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestSetStatus(t *testing.T) {
+	var calls []string
+	orig := runBd
+	defer func() { runBd = orig }()
+	runBd = func(dir string, args ...string) ([]byte, error) {
+		calls = append(calls, dir+": "+strings.Join(args, " "))
+		if args[1] == "bv-gone" {
+			return []byte("issue not found"), errors.New("exit status 1")
+		}
+		return nil, nil
+	}
+
+	if err := SetStatus("/work", "bv-1", model.StatusInProgress, nil); err != nil {
+		t.Fatalf("SetStatus: %v", err)
+	}
+	if len(calls) != 1 || calls[0] != "/work: update bv-1 --status in_progress" {
+		t.Errorf("calls = %q", calls)
+	}
+
+	err := SetStatus("/work", "bv-gone", model.StatusClosed, nil)
+	if err == nil || !strings.Contains(err.Error(), "issue not found") {
+		t.Errorf("err = %v, want bd's message", err)
+	}
+
+	// A dry run records the write instead
+	d := NewDryRun()
+	if err := SetStatus("/work", "bv-2", model.StatusBlocked, d); err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if changes := d.Changes(); len(changes) != 1 || changes[0].Summary != "bd update bv-2 --status blocked" || len(calls) != 2 {
+		t.Errorf("dry run changes = %+v, calls = %q", changes, calls)
+	}
+}
@@ -0,0 +1,134 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// StreamThreshold is the file size above which the TUI streams issues in
+// instead of parsing the whole file before the first frame.
+const StreamThreshold = 16 * 1024 * 1024
+
+// DefaultStreamBatchSize is the size of the first streamed batch. Each later
+// batch is twice the previous one, so consumers that rebuild their state per
+// batch do it O(log n) times.
+const DefaultStreamBatchSize = 2000
+
+// StreamOptions configures StreamIssuesFromFile.
+type StreamOptions struct {
+	ParseOptions
+
+	// BatchSize is the number of issues in the first batch.
+	// If 0, uses DefaultStreamBatchSize.
+	BatchSize int
+}
+
+// StreamBatch is one delivery from StreamIssuesFromFile.
+type StreamBatch struct {
+	Issues    []model.Issue // Issues parsed since the previous batch
+	BytesRead int64         // Bytes of the file consumed so far
+	Size      int64         // Size of the file when the stream started
+	Warnings  int           // Warnings reported so far
+	Done      bool          // Last batch; the channel closes after it
+	Err       error         // Set on the last batch when reading failed
+}
+
+// Progress returns the fraction of the file read, between 0 and 1.
+func (b StreamBatch) Progress() float64 {
+	if b.Done || b.Size <= 0 {
+		return 1
+	}
+	return min(float64(b.BytesRead)/float64(b.Size), 1)
+}
+
+// StreamIssuesFromFile parses a JSONL file in the background and delivers
+// issues in batches of growing size. The last batch has Done set, after which
+// the channel is closed. Cancelling ctx stops the reader; the channel is then
+// closed without a Done batch. Warnings go to opts.WarningHandler, called from
+// the reading goroutine, and are counted in each batch.
+func StreamIssuesFromFile(ctx context.Context, path string, opts StreamOptions) (<-chan StreamBatch, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no beads issues found at %s", path)
+		}
+		return nil, fmt.Errorf("failed to open issues file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to stat issues file: %w", err)
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultStreamBatchSize
+	}
+
+	out := make(chan StreamBatch, 1)
+	go func() {
+		defer close(out)
+		defer file.Close()
+
+		counter := &countingReader{r: file}
+		parseOpts := opts.ParseOptions
+		warn := parseOpts.WarningHandler
+		if warn == nil {
+			warn = defaultWarningHandler()
+		}
+		warnings := 0
+		parseOpts.WarningHandler = func(msg string) {
+			warnings++
+			warn(msg)
+		}
+		send := func(b StreamBatch) bool {
+			if ctx.Err() != nil {
+				return false
+			}
+			b.BytesRead = counter.n
+			b.Size = info.Size()
+			b.Warnings = warnings
+			select {
+			case out <- b:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		var pending []model.Issue
+		err := parseIssues(counter, parseOpts, func(issue model.Issue) error {
+			pending = append(pending, issue)
+			if len(pending) < batchSize {
+				return nil
+			}
+			if !send(StreamBatch{Issues: pending}) {
+				return ctx.Err()
+			}
+			pending = nil
+			batchSize *= 2
+			return nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		send(StreamBatch{Issues: pending, Done: true, Err: err})
+	}()
+	return out, nil
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,94 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeIssuesFile(t *testing.T, n int) string {
+	t.Helper()
+	var sb strings.Builder
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&sb, `{"id":"s-%d","title":"Issue %d","status":"open","issue_type":"task"}`+"\n", i, i)
+	}
+	sb.WriteString("{bad json}\n")
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	if err := os.WriteFile(path, []byte(sb.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestStreamIssuesFromFile(t *testing.T) {
+	path := writeIssuesFile(t, 25)
+	var warnings []string
+	ch, err := StreamIssuesFromFile(context.Background(), path, StreamOptions{
+		ParseOptions: ParseOptions{WarningHandler: func(msg string) { warnings = append(warnings, msg) }},
+		BatchSize:    4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sizes []int
+	var ids []string
+	var last StreamBatch
+	for b := range ch {
+		sizes = append(sizes, len(b.Issues))
+		for _, issue := range b.Issues {
+			ids = append(ids, issue.ID)
+		}
+		if !b.Done && (b.Progress() <= 0 || b.Progress() > 1) {
+			t.Errorf("progress %v out of range", b.Progress())
+		}
+		last = b
+	}
+
+	// Batches double: 4, 8, then the remaining 13 with Done
+	if fmt.Sprint(sizes) != "[4 8 13]" {
+		t.Errorf("batch sizes = %v, want [4 8 13]", sizes)
+	}
+	if len(ids) != 25 || ids[0] != "s-1" || ids[24] != "s-25" {
+		t.Errorf("issues out of order or missing: %d issues", len(ids))
+	}
+	if !last.Done || last.Err != nil || last.Progress() != 1 || last.BytesRead != last.Size {
+		t.Errorf("last batch = %+v", last)
+	}
+	if len(warnings) != 1 || last.Warnings != 1 {
+		t.Errorf("expected one malformed-line warning, got %v", warnings)
+	}
+}
+
+func TestStreamIssuesFromFileCancel(t *testing.T) {
+	path := writeIssuesFile(t, 50)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := StreamIssuesFromFile(ctx, path, StreamOptions{
+		ParseOptions: ParseOptions{WarningHandler: func(string) {}},
+		BatchSize:    5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first := <-ch; len(first.Issues) != 5 || first.Done {
+		t.Fatalf("first batch = %d issues, done %v", len(first.Issues), first.Done)
+	}
+	// The reader is at most one batch ahead (5, 10, 20, then 15 with Done),
+	// so it cannot reach the final batch before the cancel
+	cancel()
+	for b := range ch {
+		if b.Done {
+			t.Errorf("cancelled stream delivered a final batch")
+		}
+	}
+}
+
+func TestStreamIssuesFromFileMissing(t *testing.T) {
+	_, err := StreamIssuesFromFile(context.Background(), filepath.Join(t.TempDir(), "nope.jsonl"), StreamOptions{})
+	if err == nil || !strings.Contains(err.Error(), "no beads issues found") {
+		t.Errorf("expected a missing-file error, got %v", err)
+	}
+}
@@ -12,6 +12,16 @@ type ReviewTree struct {
 	Descendants []*model.Issue          // All children recursively via parent-child deps
 	Blockers    []*model.Issue          // External issues that block items in the tree
 	IssueMap    map[string]*model.Issue // All issues by ID for O(1) lookup
+
+	// Label is set for trees built by LoadLabelReviewTree and
+	// LoadScopedReviewTree. Root is then a placeholder, not an issue, and
+	// must not be reviewed.
+	Label string
+
+	// Scope holds the IDs of a tree built by LoadScopedReviewTree
+	Scope []string
+
+	children map[string][]*model.Issue // Parent ID -> children, in display order
 }
 
 // LoadReviewTree loads an issue tree starting from rootID
@@ -63,11 +73,176 @@ func LoadReviewTree(rootID string, issues []model.Issue) (*ReviewTree, error) {
 		}
 	}
 
-	// Find external blockers - issues that block items in the tree but aren't descendants
+	return &ReviewTree{
+		Root:        root,
+		Descendants: descendants,
+		Blockers:    externalBlockers(descendantIDs, issueMap),
+		IssueMap:    issueMap,
+	}, nil
+}
+
+// LoadLabelReviewTree builds a pseudo-tree of the issues carrying label: a
+// placeholder root over the nearest epic of each labeled issue, with the
+// labeled issues beneath. Labeled issues outside any epic hang off the root.
+func LoadLabelReviewTree(label string, issues []model.Issue) (*ReviewTree, error) {
+	issueMap := make(map[string]*model.Issue)
+	parentOf := make(map[string]string)
+	var labeled []*model.Issue
+	for i := range issues {
+		issue := &issues[i]
+		issueMap[issue.ID] = issue
+		for _, dep := range issue.Dependencies {
+			if dep.Type == model.DepParentChild && parentOf[issue.ID] == "" {
+				parentOf[issue.ID] = dep.DependsOnID
+			}
+		}
+		if hasLabel(issue, label) {
+			labeled = append(labeled, issue)
+		}
+	}
+	if len(labeled) == 0 {
+		return nil, fmt.Errorf("no issues labeled %q", label)
+	}
+
+	// nearestEpic walks up parent-child links, stopping on cycles
+	nearestEpic := func(id string) *model.Issue {
+		seen := map[string]bool{id: true}
+		for parentID := parentOf[id]; parentID != "" && !seen[parentID]; parentID = parentOf[parentID] {
+			seen[parentID] = true
+			if parent, ok := issueMap[parentID]; ok && parent.IssueType == model.TypeEpic {
+				return parent
+			}
+		}
+		return nil
+	}
+
+	root := &model.Issue{ID: "label:" + label, Title: "Label: " + label}
+	inTree := map[string]bool{root.ID: true}
+	children := make(map[string][]*model.Issue)
+	descendants := make([]*model.Issue, 0, len(labeled))
+	add := func(issue *model.Issue, parentID string) {
+		if inTree[issue.ID] {
+			return
+		}
+		inTree[issue.ID] = true
+		descendants = append(descendants, issue)
+		children[parentID] = append(children[parentID], issue)
+	}
+	for _, issue := range labeled {
+		parentID := root.ID
+		if epic := nearestEpic(issue.ID); epic != nil {
+			// Unlabeled epics are grouping rows; labeled ones place themselves
+			if !hasLabel(epic, label) {
+				add(epic, root.ID)
+			}
+			parentID = epic.ID
+		}
+		add(issue, parentID)
+	}
+
+	return &ReviewTree{
+		Root:        root,
+		Descendants: descendants,
+		Blockers:    externalBlockers(inTree, issueMap),
+		IssueMap:    issueMap,
+		Label:       label,
+		children:    children,
+	}, nil
+}
+
+// LoadScopedReviewTree builds a pseudo-tree of exactly the issues in ids,
+// such as the ones a lens dashboard shows, under a placeholder root named
+// name. Each issue hangs off its nearest parent-child ancestor in the set, or
+// off the root. IDs that no longer exist are skipped.
+func LoadScopedReviewTree(name string, ids []string, issues []model.Issue) (*ReviewTree, error) {
+	issueMap := make(map[string]*model.Issue)
+	parentOf := make(map[string]string)
+	for i := range issues {
+		issue := &issues[i]
+		issueMap[issue.ID] = issue
+		for _, dep := range issue.Dependencies {
+			if dep.Type == model.DepParentChild && parentOf[issue.ID] == "" {
+				parentOf[issue.ID] = dep.DependsOnID
+			}
+		}
+	}
+
+	inScope := make(map[string]bool, len(ids))
+	var scope []string
+	for _, id := range ids {
+		if _, ok := issueMap[id]; ok && !inScope[id] {
+			inScope[id] = true
+			scope = append(scope, id)
+		}
+	}
+	if len(scope) == 0 {
+		return nil, fmt.Errorf("no issues to review in %s", name)
+	}
+
+	root := &model.Issue{ID: "scope:" + name, Title: "Lens: " + name}
+	placedUnder := make(map[string]string, len(scope))
+	for _, id := range scope {
+		placedUnder[id] = root.ID
+		seen := map[string]bool{id: true}
+		for p := parentOf[id]; p != "" && !seen[p]; p = parentOf[p] {
+			seen[p] = true
+			if inScope[p] {
+				placedUnder[id] = p
+				break
+			}
+		}
+	}
+	// A parent-child cycle inside the set would be unreachable from the root;
+	// its first issue hangs off the root instead
+	for _, id := range scope {
+		seen := map[string]bool{}
+		for p := placedUnder[id]; p != root.ID && !seen[p]; p = placedUnder[p] {
+			seen[p] = true
+			if p == id {
+				placedUnder[id] = root.ID
+				break
+			}
+		}
+	}
+
+	children := make(map[string][]*model.Issue)
+	descendants := make([]*model.Issue, 0, len(scope))
+	for _, id := range scope {
+		issue := issueMap[id]
+		descendants = append(descendants, issue)
+		children[placedUnder[id]] = append(children[placedUnder[id]], issue)
+	}
+
+	inTree := map[string]bool{root.ID: true}
+	for _, id := range scope {
+		inTree[id] = true
+	}
+	return &ReviewTree{
+		Root:        root,
+		Descendants: descendants,
+		Blockers:    externalBlockers(inTree, issueMap),
+		IssueMap:    issueMap,
+		Label:       name,
+		Scope:       scope,
+		children:    children,
+	}, nil
+}
+
+func hasLabel(issue *model.Issue, label string) bool {
+	for _, l := range issue.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// externalBlockers returns the issues outside the tree that block an issue in it
+func externalBlockers(inTree map[string]bool, issueMap map[string]*model.Issue) []*model.Issue {
 	blockers := make([]*model.Issue, 0)
 	blockerIDs := make(map[string]bool)
 
-	for id := range descendantIDs {
+	for id := range inTree {
 		issue := issueMap[id]
 		if issue == nil {
 			continue
@@ -76,7 +251,7 @@ func LoadReviewTree(rootID string, issues []model.Issue) (*ReviewTree, error) {
 			if dep.Type == model.DepBlocks {
 				// This issue is blocked by dep.DependsOnID
 				blockerID := dep.DependsOnID
-				if !descendantIDs[blockerID] && !blockerIDs[blockerID] {
+				if !inTree[blockerID] && !blockerIDs[blockerID] {
 					if blocker, ok := issueMap[blockerID]; ok {
 						blockers = append(blockers, blocker)
 						blockerIDs[blockerID] = true
@@ -86,12 +261,22 @@ func LoadReviewTree(rootID string, issues []model.Issue) (*ReviewTree, error) {
 		}
 	}
 
-	return &ReviewTree{
-		Root:        root,
-		Descendants: descendants,
-		Blockers:    blockers,
-		IssueMap:    issueMap,
-	}, nil
+	return blockers
+}
+
+// Children returns the children of id in display order
+func (t *ReviewTree) Children(id string) []*model.Issue {
+	if t.children == nil {
+		t.children = make(map[string][]*model.Issue)
+		for _, desc := range t.Descendants {
+			for _, dep := range desc.Dependencies {
+				if dep.Type == model.DepParentChild {
+					t.children[dep.DependsOnID] = append(t.children[dep.DependsOnID], desc)
+				}
+			}
+		}
+	}
+	return t.children[id]
 }
 
 // AllIssues returns root + all descendants as a flat slice
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"sort"
+	"sync"
+)
+
+// CustomStatus is a team workflow status beyond the built-in four, e.g.
+// "review" or "done". It behaves like the built-in status in Column (board
+// column, open/closed semantics) but keeps its own name, color and place in
+// status sections.
+type CustomStatus struct {
+	Name   Status
+	Column Status // Built-in status it maps onto (open when empty)
+	Color  string // Hex color for headers and badges ("" uses the column's)
+	Order  int    // Sort position on the StatusOrder scale (0 sorts just after Column)
+}
+
+var (
+	customStatusMu sync.RWMutex
+	customStatuses map[Status]CustomStatus
+)
+
+// RegisterCustomStatuses replaces the custom statuses known to IsValid,
+// Column and StatusOrder. Call it before loading issues that use them.
+func RegisterCustomStatuses(defs []CustomStatus) {
+	registry := make(map[Status]CustomStatus, len(defs))
+	for _, def := range defs {
+		if def.Column == "" {
+			def.Column = StatusOpen
+		}
+		registry[def.Name] = def
+	}
+	customStatusMu.Lock()
+	customStatuses = registry
+	customStatusMu.Unlock()
+}
+
+// LookupCustomStatus returns the definition of a registered custom status
+func LookupCustomStatus(s Status) (CustomStatus, bool) {
+	customStatusMu.RLock()
+	defer customStatusMu.RUnlock()
+	def, ok := customStatuses[s]
+	return def, ok
+}
+
+// CustomStatuses returns the registered custom statuses in sort order
+func CustomStatuses() []CustomStatus {
+	customStatusMu.RLock()
+	defs := make([]CustomStatus, 0, len(customStatuses))
+	for _, def := range customStatuses {
+		defs = append(defs, def)
+	}
+	customStatusMu.RUnlock()
+	sort.Slice(defs, func(i, j int) bool {
+		oi, oj := defs[i].Name.StatusOrder(), defs[j].Name.StatusOrder()
+		if oi != oj {
+			return oi < oj
+		}
+		return defs[i].Name < defs[j].Name
+	})
+	return defs
+}
+
+// Column returns the built-in status s maps onto: itself for built-ins, the
+// configured column for custom statuses, and s unchanged when unknown.
+func (s Status) Column() Status {
+	switch s {
+	case StatusOpen, StatusInProgress, StatusBlocked, StatusClosed:
+		return s
+	}
+	if def, ok := LookupCustomStatus(s); ok {
+		return def.Column
+	}
+	return s
+}
+
+// IsCustom reports whether s is a registered custom status
+func (s Status) IsCustom() bool {
+	_, ok := LookupCustomStatus(s)
+	return ok
+}
+
+// StatusOrder places s among status sections: open 10, in_progress 20,
+// blocked 30, closed 40. Custom statuses use their Order, or sit just after
+// their column without one.
+func (s Status) StatusOrder() int {
+	switch s {
+	case StatusOpen:
+		return 10
+	case StatusInProgress:
+		return 20
+	case StatusBlocked:
+		return 30
+	case StatusClosed:
+		return 40
+	}
+	if def, ok := LookupCustomStatus(s); ok {
+		if def.Order != 0 {
+			return def.Order
+		}
+		return def.Column.StatusOrder() + 5
+	}
+	return 50
+}
@@ -0,0 +1,54 @@
+package model
+
+import "testing"
+
+func TestCustomStatuses(t *testing.T) {
+	RegisterCustomStatuses([]CustomStatus{
+		{Name: "review", Column: StatusInProgress, Color: "#FFB86C"},
+		{Name: "done", Column: StatusClosed, Order: 45},
+		{Name: "triage"},
+	})
+	defer RegisterCustomStatuses(nil)
+
+	tests := []struct {
+		status Status
+		valid  bool
+		open   bool
+		closed bool
+		column Status
+		order  int
+	}{
+		{"review", true, true, false, StatusInProgress, 25},
+		{"done", true, false, true, StatusClosed, 45},
+		{"triage", true, true, false, StatusOpen, 15},
+		{StatusBlocked, true, false, false, StatusBlocked, 30},
+		{"unknown", false, false, false, "unknown", 50},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			if got := tt.status.IsValid(); got != tt.valid {
+				t.Errorf("IsValid() = %v, want %v", got, tt.valid)
+			}
+			if got := tt.status.IsOpen(); got != tt.open {
+				t.Errorf("IsOpen() = %v, want %v", got, tt.open)
+			}
+			if got := tt.status.IsClosed(); got != tt.closed {
+				t.Errorf("IsClosed() = %v, want %v", got, tt.closed)
+			}
+			if got := tt.status.Column(); got != tt.column {
+				t.Errorf("Column() = %q, want %q", got, tt.column)
+			}
+			if got := tt.status.StatusOrder(); got != tt.order {
+				t.Errorf("StatusOrder() = %d, want %d", got, tt.order)
+			}
+		})
+	}
+
+	var names []Status
+	for _, def := range CustomStatuses() {
+		names = append(names, def.Name)
+	}
+	if len(names) != 3 || names[0] != "triage" || names[1] != "review" || names[2] != "done" {
+		t.Errorf("CustomStatuses() order = %v, want [triage review done]", names)
+	}
+}
@@ -125,23 +125,25 @@ const (
 	StatusClosed     Status = "closed"
 )
 
-// IsValid returns true if the status is a recognized value
+// IsValid returns true if the status is a recognized value (built-in or a
+// registered custom status)
 func (s Status) IsValid() bool {
 	switch s {
 	case StatusOpen, StatusInProgress, StatusBlocked, StatusClosed:
 		return true
 	}
-	return false
+	return s.IsCustom()
 }
 
 // IsClosed returns true if the status represents a closed state
 func (s Status) IsClosed() bool {
-	return s == StatusClosed
+	return s.Column() == StatusClosed
 }
 
 // IsOpen returns true if the status represents an active (open or in_progress) state
 func (s Status) IsOpen() bool {
-	return s == StatusOpen || s == StatusInProgress
+	col := s.Column()
+	return col == StatusOpen || col == StatusInProgress
 }
 
 // IssueType categorizes the kind of work
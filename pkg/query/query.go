@@ -0,0 +1,342 @@
+// Package query implements bv's issue query language: a compact filter and
+// sort syntax for ad-hoc questions about the backlog.
+//
+//	status:open label:api p<=1 -assignee:alice
+//	(type:bug or type:feature) blocked sort:-updated limit:10
+//	title~"auth flow" created>14d
+//
+// Terms next to each other must all match; "or" binds looser, "not" or a
+// leading "-" negates a term or group, and parentheses group. A bare word
+// matches issue IDs and titles. sort:FIELD (sort:-FIELD for descending) and
+// limit:N may appear anywhere.
+package query
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Fields lists the filterable fields and what they compare, for help text
+var Fields = []struct{ Name, Help string }{
+	{"id", "issue ID (= exact, ~ contains)"},
+	{"title", "title (= exact, ~ contains; case-insensitive)"},
+	{"status", "open, in_progress, blocked, closed"},
+	{"type", "bug, feature, task, epic, chore"},
+	{"assignee", "assignee; assignee= matches unassigned"},
+	{"label", "has the label (~ any label contains)"},
+	{"priority, p", "0-4, compared numerically: p<=1"},
+	{"created, updated, closed", "dates: 14d/2w/1m/1y ago or YYYY-MM-DD; created>14d"},
+	{"blockers", "number of open blockers"},
+	{"blocks", "number of open issues it blocks"},
+	{"comments", "number of comments"},
+	{"ready", "bare word: open with no open blockers"},
+	{"blocked", "bare word: has open blockers or is marked blocked"},
+}
+
+// sortFields are the fields sort: accepts
+var sortFields = []string{"id", "title", "status", "type", "priority", "assignee", "created", "updated", "blockers", "blocks"}
+
+// Query is a parsed query, ready to run against any set of issues
+type Query struct {
+	where    node // nil matches everything
+	sortBy   string
+	sortDesc bool
+	limit    int // 0 = no limit
+}
+
+// Parse parses a query expression. The empty query matches every issue.
+func Parse(s string) (*Query, error) {
+	tokens, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{}
+	var rest []token
+	for _, tok := range tokens {
+		switch {
+		case !tok.quoted && strings.HasPrefix(tok.text, "sort:"):
+			field := strings.TrimPrefix(tok.text, "sort:")
+			q.sortDesc = strings.HasPrefix(field, "-")
+			q.sortBy = canonicalField(strings.TrimPrefix(field, "-"))
+			if !slices.Contains(sortFields, q.sortBy) {
+				return nil, fmt.Errorf("cannot sort by %q (one of %s)", field, strings.Join(sortFields, ", "))
+			}
+		case !tok.quoted && strings.HasPrefix(tok.text, "limit:"):
+			n, err := strconv.Atoi(strings.TrimPrefix(tok.text, "limit:"))
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("limit needs a positive number, got %q", tok.text)
+			}
+			q.limit = n
+		default:
+			rest = append(rest, tok)
+		}
+	}
+
+	p := &parser{tokens: rest}
+	if len(rest) > 0 {
+		if q.where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+		if p.pos < len(rest) {
+			return nil, fmt.Errorf("unexpected %q", rest[p.pos].text)
+		}
+	}
+	return q, nil
+}
+
+// Run returns the issues matching q, sorted (by priority then ID unless q
+// sorts) and limited. Relative dates are measured back from now, and
+// blocking is judged against the whole of issues.
+func (q *Query) Run(issues []model.Issue, now time.Time) []model.Issue {
+	env := newEnv(issues, now)
+
+	var out []model.Issue
+	for i := range issues {
+		if q.where == nil || q.where.match(env, &issues[i]) {
+			out = append(out, issues[i])
+		}
+	}
+
+	less := func(a, b *model.Issue) int {
+		switch q.sortBy {
+		case "id":
+			return strings.Compare(a.ID, b.ID)
+		case "title":
+			return strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		case "status":
+			return strings.Compare(string(a.Status), string(b.Status))
+		case "type":
+			return strings.Compare(string(a.IssueType), string(b.IssueType))
+		case "assignee":
+			return strings.Compare(a.Assignee, b.Assignee)
+		case "created":
+			return a.CreatedAt.Compare(b.CreatedAt)
+		case "updated":
+			return a.UpdatedAt.Compare(b.UpdatedAt)
+		case "blockers":
+			return len(env.blockedBy[a.ID]) - len(env.blockedBy[b.ID])
+		case "blocks":
+			return env.blocks[a.ID] - env.blocks[b.ID]
+		}
+		return a.Priority - b.Priority
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		c := less(&out[i], &out[j])
+		if q.sortDesc {
+			c = -c
+		}
+		if c != 0 {
+			return c < 0
+		}
+		if out[i].Priority != out[j].Priority {
+			return out[i].Priority < out[j].Priority
+		}
+		return out[i].ID < out[j].ID
+	})
+
+	if q.limit > 0 && len(out) > q.limit {
+		out = out[:q.limit]
+	}
+	return out
+}
+
+// env is what terms need beyond the issue itself
+type env struct {
+	now       time.Time
+	blockedBy map[string][]string // issue -> open blockers
+	blocks    map[string]int      // issue -> open issues it blocks
+}
+
+func newEnv(issues []model.Issue, now time.Time) *env {
+	e := &env{now: now, blockedBy: analysis.BlockedByMap(issues), blocks: make(map[string]int)}
+	for _, blockers := range e.blockedBy {
+		for _, b := range blockers {
+			e.blocks[b]++
+		}
+	}
+	return e
+}
+
+// ============================================================================
+// Lexer and parser
+// ============================================================================
+
+type token struct {
+	text   string
+	quoted bool // The whole token was a quoted string
+}
+
+// lex splits s into words and parentheses. Double quotes group spaces into
+// a word, whether they wrap it ("fix auth") or a value (title~"fix auth").
+func lex(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, token{text: string(c)})
+			i++
+		default:
+			var word strings.Builder
+			quoted := c == '"'
+			for i < len(s) && s[i] != ' ' && s[i] != '\t' && s[i] != '(' && s[i] != ')' {
+				if s[i] != '"' {
+					word.WriteByte(s[i])
+					i++
+					continue
+				}
+				end := strings.IndexByte(s[i+1:], '"')
+				if end < 0 {
+					return nil, fmt.Errorf("unterminated quote")
+				}
+				word.WriteString(s[i+1 : i+1+end])
+				i += end + 2
+			}
+			tokens = append(tokens, token{text: word.String(), quoted: quoted})
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// peekKeyword reports whether the next token is the unquoted keyword kw
+func (p *parser) peekKeyword(kw string) bool {
+	return p.pos < len(p.tokens) && !p.tokens[p.pos].quoted && strings.EqualFold(p.tokens[p.pos].text, kw)
+}
+
+func (p *parser) parseOr() (node, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	nodes := orNode{first}
+	for p.peekKeyword("or") {
+		p.pos++
+		next, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, next)
+	}
+	if len(nodes) == 1 {
+		return first, nil
+	}
+	return nodes, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	var nodes andNode
+	for p.pos < len(p.tokens) && !p.peekKeyword("or") && !p.peekKeyword(")") {
+		if p.peekKeyword("and") {
+			p.pos++
+			continue
+		}
+		n, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	switch len(nodes) {
+	case 0:
+		if p.pos < len(p.tokens) {
+			return nil, fmt.Errorf("expected a term before %q", p.tokens[p.pos].text)
+		}
+		return nil, fmt.Errorf("expected a term at the end")
+	case 1:
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	tok := p.tokens[p.pos]
+	switch {
+	case p.peekKeyword("not") || p.peekKeyword("-"):
+		p.pos++
+		if p.pos == len(p.tokens) {
+			return nil, fmt.Errorf("expected a term after not")
+		}
+		n, err := p.parseUnary()
+		return notNode{n}, err
+	case p.peekKeyword("("):
+		p.pos++
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peekKeyword(")") {
+			return nil, fmt.Errorf("missing )")
+		}
+		p.pos++
+		return n, nil
+	case !tok.quoted && len(tok.text) > 1 && tok.text[0] == '-':
+		p.pos++
+		n, err := parseTerm(token{text: tok.text[1:]})
+		return notNode{n}, err
+	}
+	p.pos++
+	return parseTerm(tok)
+}
+
+// ops are the comparison operators, longest first so <= wins over <
+var ops = []string{"!=", "<=", ">=", ":", "=", "<", ">", "~"}
+
+// parseTerm parses field-op-value, a bare keyword, or a bare word
+func parseTerm(tok token) (node, error) {
+	if !tok.quoted {
+		switch strings.ToLower(tok.text) {
+		case "ready":
+			return readyTerm{}, nil
+		case "blocked":
+			return blockedTerm{}, nil
+		}
+		if name, op, value, ok := splitTerm(tok.text); ok {
+			return newFieldTerm(canonicalField(name), op, value)
+		}
+	}
+	return wordTerm(strings.ToLower(tok.text)), nil
+}
+
+// splitTerm splits "field<op>value" where field is a run of letters
+func splitTerm(s string) (field, op, value string, ok bool) {
+	end := 0
+	for end < len(s) && (s[end] >= 'a' && s[end] <= 'z' || s[end] >= 'A' && s[end] <= 'Z' || s[end] == '_') {
+		end++
+	}
+	if end == 0 {
+		return "", "", "", false
+	}
+	for _, op := range ops {
+		if strings.HasPrefix(s[end:], op) {
+			return strings.ToLower(s[:end]), op, s[end+len(op):], true
+		}
+	}
+	return "", "", "", false
+}
+
+// canonicalField resolves field aliases
+func canonicalField(name string) string {
+	switch name {
+	case "p", "prio":
+		return "priority"
+	case "label", "labels":
+		return "label"
+	case "issue_type":
+		return "type"
+	}
+	return name
+}
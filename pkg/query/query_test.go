@@ -0,0 +1,105 @@
+package query_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/query"
+)
+
+func queryIssues(now time.Time) []model.Issue {
+	day := 24 * time.Hour
+	closedAt := now.Add(-2 * day)
+	return []model.Issue{
+		{ID: "bv-1", Title: "Auth flow", Status: model.StatusOpen, IssueType: model.TypeFeature, Priority: 1,
+			Assignee: "alice", Labels: []string{"api", "security"}, CreatedAt: now.Add(-30 * day), UpdatedAt: now.Add(-1 * day)},
+		{ID: "bv-2", Title: "Login page", Status: model.StatusOpen, IssueType: model.TypeFeature, Priority: 2,
+			Labels: []string{"ui"}, CreatedAt: now.Add(-3 * day), UpdatedAt: now.Add(-3 * day),
+			Dependencies: []*model.Dependency{{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+		{ID: "bv-3", Title: "Crash on logout", Status: model.StatusInProgress, IssueType: model.TypeBug, Priority: 0,
+			Assignee: "bob", Labels: []string{"ui"}, CreatedAt: now.Add(-10 * day), UpdatedAt: now,
+			Comments: []*model.Comment{{Text: "repro"}, {Text: "fixed?"}}},
+		{ID: "bv-4", Title: "Old auth spike", Status: model.StatusClosed, IssueType: model.TypeTask, Priority: 3,
+			Labels: []string{"api"}, CreatedAt: now.Add(-60 * day), UpdatedAt: closedAt, ClosedAt: &closedAt},
+	}
+}
+
+func TestQueryRun(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	issues := queryIssues(now)
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"", "bv-3 bv-1 bv-2 bv-4"},
+		{"status:open", "bv-1 bv-2"},
+		{"STATUS=Open label:ui", "bv-2"},
+		{"label:api -status:closed", "bv-1"},
+		{"not label:api", "bv-3 bv-2"},
+		{"label~sec", "bv-1"},
+		{"p<=1", "bv-3 bv-1"},
+		{"priority>P1", "bv-2 bv-4"},
+		{"type:bug or assignee:alice", "bv-3 bv-1"},
+		{"(type:bug or type:task) and -closed>7d", "bv-3"},
+		{"assignee=", "bv-2 bv-4"},
+		{"assignee!=", "bv-3 bv-1"},
+		{"ready", "bv-3 bv-1"},
+		{"blocked", "bv-2"},
+		{"blocks>=1", "bv-1"},
+		{"blockers=0 -status:closed", "bv-3 bv-1"},
+		{"comments>1", "bv-3"},
+		{"auth", "bv-1 bv-4"},
+		{`"old auth"`, "bv-4"},
+		{`title~"on log"`, "bv-3"},
+		{"created>14d", "bv-3 bv-2"},
+		{"created<2025-05-01", "bv-4"},
+		{"updated=2025-06-15", "bv-3"},
+		{"closed>7d", "bv-4"},
+		{"sort:-updated", "bv-3 bv-1 bv-4 bv-2"},
+		{"sort:id limit:2", "bv-1 bv-2"},
+		{"label:ui sort:title", "bv-3 bv-2"},
+		{"id~-1", "bv-1"},
+	}
+	for _, tt := range tests {
+		q, err := query.Parse(tt.query)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.query, err)
+			continue
+		}
+		var got []string
+		for _, issue := range q.Run(issues, now) {
+			got = append(got, issue.ID)
+		}
+		if strings.Join(got, " ") != tt.want {
+			t.Errorf("%q = %s, want %s", tt.query, strings.Join(got, " "), tt.want)
+		}
+	}
+}
+
+func TestQueryParseErrors(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"color:red", `unknown field "color"`},
+		{"p~1", "priority does not support ~"},
+		{"p<high", "priority needs a number"},
+		{"created>soon", "created needs a date"},
+		{"sort:size", `cannot sort by "size"`},
+		{"limit:0", "limit needs a positive number"},
+		{`title~"open`, "unterminated quote"},
+		{"(status:open", "missing )"},
+		{"status:open )", `unexpected ")"`},
+		{"status:open or", "expected a term at the end"},
+		{"not", "expected a term after not"},
+	}
+	for _, tt := range tests {
+		_, err := query.Parse(tt.query)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Parse(%q) error = %v, want %q", tt.query, err, tt.want)
+		}
+	}
+}
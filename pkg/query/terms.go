@@ -0,0 +1,213 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+)
+
+// node is one parsed piece of a query
+type node interface {
+	match(e *env, issue *model.Issue) bool
+}
+
+type andNode []node
+
+func (n andNode) match(e *env, issue *model.Issue) bool {
+	for _, c := range n {
+		if !c.match(e, issue) {
+			return false
+		}
+	}
+	return true
+}
+
+type orNode []node
+
+func (n orNode) match(e *env, issue *model.Issue) bool {
+	for _, c := range n {
+		if c.match(e, issue) {
+			return true
+		}
+	}
+	return false
+}
+
+type notNode struct{ node }
+
+func (n notNode) match(e *env, issue *model.Issue) bool {
+	return !n.node.match(e, issue)
+}
+
+// wordTerm is a bare word: it matches a substring of the ID or title
+type wordTerm string
+
+func (w wordTerm) match(_ *env, issue *model.Issue) bool {
+	return strings.Contains(strings.ToLower(issue.ID), string(w)) ||
+		strings.Contains(strings.ToLower(issue.Title), string(w))
+}
+
+// readyTerm matches issues that can be worked on now
+type readyTerm struct{}
+
+func (readyTerm) match(e *env, issue *model.Issue) bool {
+	return !issue.Status.IsClosed() && issue.Status.Column() != model.StatusBlocked && len(e.blockedBy[issue.ID]) == 0
+}
+
+// blockedTerm matches issues waiting on something
+type blockedTerm struct{}
+
+func (blockedTerm) match(e *env, issue *model.Issue) bool {
+	return issue.Status.Column() == model.StatusBlocked || len(e.blockedBy[issue.ID]) > 0
+}
+
+// fieldTerm compares one field against a value
+type fieldTerm struct {
+	field string
+	op    string // ":" is folded into "="
+	value string // Lowercased for text fields
+	num   int    // For numeric fields
+}
+
+// fieldOps lists the operators each field accepts
+var fieldOps = map[string]string{
+	"id":       "= != ~",
+	"title":    "= != ~",
+	"status":   "= != ~",
+	"type":     "= != ~",
+	"assignee": "= != ~",
+	"label":    "= != ~",
+	"priority": "= != < <= > >=",
+	"blockers": "= != < <= > >=",
+	"blocks":   "= != < <= > >=",
+	"comments": "= != < <= > >=",
+	"created":  "= != < <= > >=",
+	"updated":  "= != < <= > >=",
+	"closed":   "= != < <= > >=",
+}
+
+// newFieldTerm validates field, op and value
+func newFieldTerm(field, op, value string) (node, error) {
+	allowed, ok := fieldOps[field]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q", field)
+	}
+	if op == ":" {
+		op = "="
+	}
+	if !strings.Contains(" "+allowed+" ", " "+op+" ") {
+		return nil, fmt.Errorf("%s does not support %s (use %s)", field, op, allowed)
+	}
+
+	t := fieldTerm{field: field, op: op, value: strings.ToLower(value)}
+	switch field {
+	case "priority", "blockers", "blocks", "comments":
+		digits := value
+		if field == "priority" {
+			digits = strings.TrimPrefix(strings.ToLower(value), "p")
+		}
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			return nil, fmt.Errorf("%s needs a number, got %q", field, value)
+		}
+		t.num = n
+	case "created", "updated", "closed":
+		if _, err := recipe.ParseRelativeTime(value, time.Now()); err != nil || value == "" {
+			return nil, fmt.Errorf("%s needs a date like 14d or 2025-01-31, got %q", field, value)
+		}
+		t.value = value
+	}
+	return t, nil
+}
+
+func (t fieldTerm) match(e *env, issue *model.Issue) bool {
+	switch t.field {
+	case "id":
+		return t.text(issue.ID)
+	case "title":
+		return t.text(issue.Title)
+	case "status":
+		return t.text(string(issue.Status))
+	case "type":
+		return t.text(string(issue.IssueType))
+	case "assignee":
+		return t.text(issue.Assignee)
+	case "label":
+		has := false
+		for _, l := range issue.Labels {
+			l = strings.ToLower(l)
+			if l == t.value || t.op == "~" && strings.Contains(l, t.value) {
+				has = true
+				break
+			}
+		}
+		return has != (t.op == "!=")
+	case "priority":
+		return t.compare(issue.Priority - t.num)
+	case "blockers":
+		return t.compare(len(e.blockedBy[issue.ID]) - t.num)
+	case "blocks":
+		return t.compare(e.blocks[issue.ID] - t.num)
+	case "comments":
+		return t.compare(len(issue.Comments) - t.num)
+	case "created":
+		return t.date(issue.CreatedAt, e.now)
+	case "updated":
+		return t.date(issue.UpdatedAt, e.now)
+	case "closed":
+		if issue.ClosedAt == nil {
+			return false
+		}
+		return t.date(*issue.ClosedAt, e.now)
+	}
+	return false
+}
+
+// text compares a text field case-insensitively
+func (t fieldTerm) text(s string) bool {
+	s = strings.ToLower(s)
+	switch t.op {
+	case "~":
+		return strings.Contains(s, t.value)
+	case "!=":
+		return s != t.value
+	}
+	return s == t.value
+}
+
+// compare applies the operator to the sign of a difference
+func (t fieldTerm) compare(diff int) bool {
+	switch t.op {
+	case "!=":
+		return diff != 0
+	case "<":
+		return diff < 0
+	case "<=":
+		return diff <= 0
+	case ">":
+		return diff > 0
+	case ">=":
+		return diff >= 0
+	}
+	return diff == 0
+}
+
+// date compares at against the value, resolved relative to now. = and !=
+// compare calendar days; the others compare instants, so created>14d is
+// "created in the last 14 days".
+func (t fieldTerm) date(at, now time.Time) bool {
+	ref, err := recipe.ParseRelativeTime(t.value, now)
+	if err != nil || at.IsZero() {
+		return false
+	}
+	if t.op == "=" || t.op == "!=" {
+		y1, m1, d1 := at.In(now.Location()).Date()
+		y2, m2, d2 := ref.Date()
+		return (y1 == y2 && m1 == m2 && d1 == d2) != (t.op == "!=")
+	}
+	return t.compare(at.Compare(ref))
+}
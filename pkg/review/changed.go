@@ -0,0 +1,64 @@
+package review
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// reviewedFields are the parts of an issue an approval vouches for, in
+// fingerprint order
+var reviewedFields = []struct {
+	name  string
+	value func(*model.Issue) string
+}{
+	{"description", func(i *model.Issue) string { return i.Description }},
+	{"design", func(i *model.Issue) string { return i.Design }},
+	{"acceptance criteria", func(i *model.Issue) string { return i.AcceptanceCriteria }},
+}
+
+// ContentFingerprint hashes the description, design and acceptance criteria
+// of issue, 8 hex digits each, space-separated. Approvals record it so a
+// later edit to any of them can be spotted.
+func ContentFingerprint(issue *model.Issue) string {
+	parts := make([]string, len(reviewedFields))
+	for i, f := range reviewedFields {
+		parts[i] = fieldHash(f.value(issue))
+	}
+	return strings.Join(parts, " ")
+}
+
+// UnknownFields is what ChangedFields reports for a review saved without a
+// fingerprint when the issue was updated after it
+const UnknownFields = "unknown fields"
+
+// reviewWriteGrace allows for the review comment itself bumping updated_at
+const reviewWriteGrace = time.Minute
+
+// ChangedFields names the reviewed fields of issue that no longer match
+// fingerprint. Reviews saved without one can only be judged by date: they
+// report UnknownFields when the issue was updated after issue.ReviewedAt.
+func ChangedFields(fingerprint string, issue *model.Issue) []string {
+	hashes := strings.Fields(fingerprint)
+	if len(hashes) != len(reviewedFields) {
+		if !issue.ReviewedAt.IsZero() && issue.UpdatedAt.After(issue.ReviewedAt.Add(reviewWriteGrace)) {
+			return []string{UnknownFields}
+		}
+		return nil
+	}
+	var changed []string
+	for i, f := range reviewedFields {
+		if hashes[i] != fieldHash(f.value(issue)) {
+			changed = append(changed, f.name)
+		}
+	}
+	return changed
+}
+
+func fieldHash(s string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(s)))
+	return hex.EncodeToString(sum[:4])
+}
@@ -0,0 +1,56 @@
+package review
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestChangedFields(t *testing.T) {
+	issue := &model.Issue{ID: "bv-1", Description: "Add login", Design: "JWT", AcceptanceCriteria: "Tokens expire"}
+	fingerprint := ContentFingerprint(issue)
+
+	// The fingerprint survives the review comment
+	saver := NewCommentReviewSaver("")
+	event, ok := ParseReviewEvent(saver.formatReviewComment(ReviewAction{
+		Status: model.ReviewStatusApproved, Reviewer: "alice", Timestamp: time.Now(), Content: fingerprint,
+	}))
+	if !ok || event.Content != fingerprint {
+		t.Fatalf("content = %q, want %q", event.Content, fingerprint)
+	}
+
+	if got := ChangedFields(fingerprint, issue); got != nil {
+		t.Errorf("unchanged issue reports %v", got)
+	}
+	edited := *issue
+	edited.Description = "Add login and logout"
+	edited.AcceptanceCriteria = "Tokens expire after an hour"
+	edited.Notes = "Not reviewed, so not tracked"
+	if got := ChangedFields(fingerprint, &edited); !reflect.DeepEqual(got, []string{"description", "acceptance criteria"}) {
+		t.Errorf("changed = %v", got)
+	}
+
+	// Whitespace-only edits and fingerprint-less reviews don't count
+	edited = *issue
+	edited.Design = "JWT\n"
+	if got := ChangedFields(fingerprint, &edited); got != nil {
+		t.Errorf("trailing newline reported %v", got)
+	}
+	if got := ChangedFields("", &edited); got != nil {
+		t.Errorf("review without fingerprint or dates reported %v", got)
+	}
+
+	// Without a fingerprint, an update after the review is all there is to go on
+	reviewed := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	edited.ReviewedAt = reviewed
+	edited.UpdatedAt = reviewed.Add(30 * time.Second)
+	if got := ChangedFields("", &edited); got != nil {
+		t.Errorf("update from the review comment itself reported %v", got)
+	}
+	edited.UpdatedAt = reviewed.Add(time.Hour)
+	if got := ChangedFields("", &edited); !reflect.DeepEqual(got, []string{UnknownFields}) {
+		t.Errorf("update after a fingerprint-less review = %v", got)
+	}
+}
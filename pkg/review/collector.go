@@ -49,6 +49,60 @@ func (c *ReviewActionCollector) Record(issueID, status, notes string) {
 	}
 }
 
+// SetContent attaches a ContentFingerprint to the recorded action for an
+// issue, if any
+func (c *ReviewActionCollector) SetContent(issueID, content string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if idx, exists := c.issueSet[issueID]; exists {
+		c.actions[idx].Content = content
+	}
+}
+
+// Lookup returns the recorded action for an issue, if any
+func (c *ReviewActionCollector) Lookup(issueID string) (ReviewAction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if idx, exists := c.issueSet[issueID]; exists {
+		return c.actions[idx], true
+	}
+	return ReviewAction{}, false
+}
+
+// Restore puts back a previously recorded action verbatim (keeping its
+// timestamp), replacing any current action for the same issue. Used by undo.
+func (c *ReviewActionCollector) Restore(action ReviewAction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if idx, exists := c.issueSet[action.IssueID]; exists {
+		c.actions[idx] = action
+	} else {
+		c.issueSet[action.IssueID] = len(c.actions)
+		c.actions = append(c.actions, action)
+	}
+}
+
+// Remove drops the recorded action for an issue, if any
+func (c *ReviewActionCollector) Remove(issueID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	idx, exists := c.issueSet[issueID]
+	if !exists {
+		return
+	}
+	c.actions = append(c.actions[:idx], c.actions[idx+1:]...)
+	delete(c.issueSet, issueID)
+	for id, i := range c.issueSet {
+		if i > idx {
+			c.issueSet[id] = i - 1
+		}
+	}
+}
+
 // Actions returns all collected actions
 func (c *ReviewActionCollector) Actions() []ReviewAction {
 	c.mu.Lock()
@@ -0,0 +1,80 @@
+package review
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// FuzzReviewCommentRoundTrip checks that every review written by the comment
+// saver parses back to the same fields, whatever the reviewer typed
+func FuzzReviewCommentRoundTrip(f *testing.F) {
+	f.Add("approved", "alice", "plan", "", int64(1767225600))
+	f.Add("needs_revision", "bob", "implementation", "split the migration\ninto two steps", int64(0))
+	f.Add("deferred", "", "", "status: approved\nreviewer: mallory", int64(-1))
+	f.Add(" ", "carol\r\n", "security", "\n", int64(253402300799))
+
+	saver := NewCommentReviewSaver("")
+	f.Fuzz(func(t *testing.T, status, reviewer, reviewType, notes string, unix int64) {
+		at := time.Unix(unix%253402300800, 0).UTC() // Keep within RFC 3339 years
+		if at.Year() < 1 {
+			at = time.Time{}
+		}
+		action := ReviewAction{Status: status, Reviewer: reviewer, ReviewType: reviewType, Notes: notes, Timestamp: at}
+
+		event, ok := ParseReviewEvent(saver.formatReviewComment(action))
+		if ok != (oneLine(status) != "") {
+			t.Fatalf("parsed = %v for status %q", ok, status)
+		}
+		if !ok {
+			return
+		}
+		want := ReviewEvent{
+			Status:     oneLine(status),
+			Reviewer:   oneLine(reviewer),
+			ReviewType: oneLine(reviewType),
+			Notes:      oneLine(notes),
+			At:         at,
+		}
+		if !event.At.Equal(want.At) {
+			t.Errorf("date %v, want %v", event.At, want.At)
+		}
+		event.At = want.At
+		if event != want {
+			t.Errorf("round trip = %+v, want %+v", event, want)
+		}
+	})
+}
+
+// FuzzReviewCommentParsing feeds arbitrary comment text to the review parsers,
+// which must never panic and only report reviews that carry a status
+func FuzzReviewCommentParsing(f *testing.F) {
+	f.Add("[REVIEW]\nstatus: approved\nreviewer: alice\ndate: 2026-03-01T09:00:00Z\n[/REVIEW]")
+	f.Add("---REVIEW---\nStatus: needs_revision\nReviewer: Bob\nNotes: fix it\n---REVIEW---")
+	f.Add("[REVIEW]\nstatus:\ndate: not-a-date\n")
+	f.Add("just a comment\x00[REVIEW]\nSTATUS:\xff\xfe")
+
+	f.Fuzz(func(t *testing.T, text string) {
+		comments := strings.Split(text, "\x1e") // Record separator: several comments per input
+		if status, _, _, found := GetLatestReviewFromComments(comments); found && status == "" {
+			t.Error("latest review found without a status")
+		}
+
+		modelComments := make([]*model.Comment, 0, len(comments)+1)
+		for i, c := range comments {
+			modelComments = append(modelComments, &model.Comment{Author: "fuzz", Text: c, CreatedAt: time.Unix(int64(i), 0)})
+		}
+		modelComments = append(modelComments, nil)
+		events := ReviewHistory(modelComments)
+		for i, e := range events {
+			if e.Status == "" {
+				t.Errorf("history event %d has no status", i)
+			}
+			if i > 0 && e.At.Before(events[i-1].At) {
+				t.Errorf("history out of order at %d", i)
+			}
+		}
+	})
+}
@@ -6,11 +6,16 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
 )
 
 // CommentReviewSaver persists reviews as structured comments via bd comment
 type CommentReviewSaver struct {
 	workspaceRoot string
+
+	// DryRun records each comment instead of posting it; nil posts them
+	DryRun *loader.DryRun
 }
 
 // NewCommentReviewSaver creates a saver that uses bd comment
@@ -21,11 +26,19 @@ func NewCommentReviewSaver(workspaceRoot string) *CommentReviewSaver {
 }
 
 // Save implements ReviewSaver using bd comment command with structured format.
-// Runs saves in parallel for better performance.
+// Runs saves in parallel for better performance; comments never combine into
+// one bd invocation, so there is nothing for a loader.BdBatcher to batch. In
+// a dry run (see DryRun) each comment is recorded instead.
 func (s *CommentReviewSaver) Save(actions []ReviewAction) (int, []error) {
 	if len(actions) == 0 {
 		return 0, nil
 	}
+	if s.DryRun != nil {
+		for _, action := range actions {
+			s.DryRun.RecordBd(s.commentWrite(action))
+		}
+		return len(actions), nil
+	}
 
 	// Run saves in parallel with error collection
 	var wg sync.WaitGroup
@@ -41,7 +54,7 @@ func (s *CommentReviewSaver) Save(actions []ReviewAction) (int, []error) {
 			mu.Lock()
 			defer mu.Unlock()
 			if err != nil {
-				errors = append(errors, fmt.Errorf("%s: %w", a.IssueID, err))
+				errors = append(errors, &SaveError{IssueID: a.IssueID, Err: err})
 			} else {
 				saved++
 			}
@@ -52,17 +65,21 @@ func (s *CommentReviewSaver) Save(actions []ReviewAction) (int, []error) {
 	return saved, errors
 }
 
-func (s *CommentReviewSaver) saveOne(action ReviewAction) error {
-	// Build structured review comment
-	commentText := s.formatReviewComment(action)
-
-	// bd comment <id> "<text>" [--author <reviewer>]
-	args := []string{"comment", action.IssueID, commentText}
+// commentWrite is the bd comment invocation that saves action:
+// bd comment <id> "<text>" [--author <reviewer>]
+func (s *CommentReviewSaver) commentWrite(action ReviewAction) loader.BdWrite {
+	args := []string{s.formatReviewComment(action)}
 	if action.Reviewer != "" {
 		args = append(args, "--author", action.Reviewer)
 	}
+	return loader.BdWrite{IssueID: action.IssueID, Command: []string{"comment"}, Args: args}
+}
 
-	cmd := exec.Command("bd", args...)
+func (s *CommentReviewSaver) saveOne(action ReviewAction) error {
+	w := s.commentWrite(action)
+	args := append(append(w.Command, w.IssueID), w.Args...)
+
+	cmd := exec.Command(loader.BdCommand, args...)
 	cmd.Dir = s.workspaceRoot
 
 	output, err := cmd.CombinedOutput()
@@ -78,20 +95,29 @@ func (s *CommentReviewSaver) formatReviewComment(action ReviewAction) string {
 	var sb strings.Builder
 
 	sb.WriteString("[REVIEW]\n")
-	sb.WriteString(fmt.Sprintf("status: %s\n", action.Status))
-	sb.WriteString(fmt.Sprintf("reviewer: %s\n", action.Reviewer))
+	sb.WriteString(fmt.Sprintf("status: %s\n", oneLine(action.Status)))
+	sb.WriteString(fmt.Sprintf("reviewer: %s\n", oneLine(action.Reviewer)))
 	sb.WriteString(fmt.Sprintf("date: %s\n", action.Timestamp.Format(time.RFC3339)))
 	if action.ReviewType != "" {
-		sb.WriteString(fmt.Sprintf("type: %s\n", action.ReviewType))
+		sb.WriteString(fmt.Sprintf("type: %s\n", oneLine(action.ReviewType)))
 	}
 	if action.Notes != "" {
-		sb.WriteString(fmt.Sprintf("notes: %s\n", action.Notes))
+		sb.WriteString(fmt.Sprintf("notes: %s\n", oneLine(action.Notes)))
+	}
+	if action.Content != "" {
+		sb.WriteString(fmt.Sprintf("content: %s\n", oneLine(action.Content)))
 	}
 	sb.WriteString("[/REVIEW]")
 
 	return sb.String()
 }
 
+// oneLine folds line breaks into spaces. Fields are one per line, so a
+// multi-line note would otherwise lose its tail or forge other fields.
+func oneLine(s string) string {
+	return strings.TrimSpace(strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(s))
+}
+
 // Close implements ReviewSaver
 func (s *CommentReviewSaver) Close() error {
 	return nil
@@ -106,33 +132,8 @@ const LegacyReviewCommentMarker = "---REVIEW---"
 // ParseReviewFromComment extracts review status from a review comment
 // Supports both new [REVIEW] format and legacy ---REVIEW--- format
 func ParseReviewFromComment(commentText string) (status, reviewer string, reviewedAt time.Time, notes string, ok bool) {
-	// Check for either marker format
-	if !strings.Contains(commentText, ReviewCommentMarker) && !strings.Contains(commentText, LegacyReviewCommentMarker) {
-		return "", "", time.Time{}, "", false
-	}
-
-	lines := strings.Split(commentText, "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-
-		// Handle both lowercase (new) and titlecase (legacy) field names
-		lineLower := strings.ToLower(line)
-		if strings.HasPrefix(lineLower, "status:") {
-			status = strings.TrimSpace(line[7:])
-		} else if strings.HasPrefix(lineLower, "reviewer:") {
-			reviewer = strings.TrimSpace(line[9:])
-		} else if strings.HasPrefix(lineLower, "date:") {
-			dateStr := strings.TrimSpace(line[5:])
-			if t, err := time.Parse(time.RFC3339, dateStr); err == nil {
-				reviewedAt = t
-			}
-		} else if strings.HasPrefix(lineLower, "notes:") {
-			notes = strings.TrimSpace(line[6:])
-		}
-	}
-
-	ok = status != ""
-	return
+	event, ok := ParseReviewEvent(commentText)
+	return event.Status, event.Reviewer, event.At, event.Notes, ok
 }
 
 // GetLatestReviewFromComments scans comments and returns the latest review status
@@ -0,0 +1,70 @@
+package review
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// CoverageTypes are the review types an epic's coverage is reported for
+var CoverageTypes = []string{model.ReviewTypePlan, model.ReviewTypeImplementation, model.ReviewTypeSecurity}
+
+// EpicCoverage is how far each review type has got through an epic's
+// descendants (`bv review coverage`)
+type EpicCoverage struct {
+	EpicID      string                  `json:"epic_id"`
+	Title       string                  `json:"title"`
+	Status      string                  `json:"status"`
+	Descendants int                     `json:"descendants"`
+	ByType      map[string]TreeCoverage `json:"by_type"` // Keyed by review type
+}
+
+// ComputeEpicCoverage counts the epic's descendants by their latest review of
+// each type. The epic itself is left out: the report is about the plan under it.
+func ComputeEpicCoverage(epicID string, issues []model.Issue) (EpicCoverage, error) {
+	tree, err := loader.LoadReviewTree(epicID, issues)
+	if err != nil {
+		return EpicCoverage{}, err
+	}
+	return epicCoverage(tree), nil
+}
+
+func epicCoverage(tree *loader.ReviewTree) EpicCoverage {
+	c := EpicCoverage{
+		EpicID:      tree.Root.ID,
+		Title:       tree.Root.Title,
+		Status:      string(tree.Root.Status),
+		Descendants: len(tree.Descendants),
+		ByType:      make(map[string]TreeCoverage, len(CoverageTypes)),
+	}
+	for _, reviewType := range CoverageTypes {
+		nodes := ExportTree(tree, reviewType).Nodes
+		c.ByType[reviewType] = summarize(nodes[1:]) // Nodes[0] is the epic
+	}
+	return c
+}
+
+// ComputeAllEpicCoverage reports every epic with descendants, closed ones only
+// when includeClosed is set. The least plan-reviewed come first.
+func ComputeAllEpicCoverage(issues []model.Issue, includeClosed bool) []EpicCoverage {
+	var out []EpicCoverage
+	for _, issue := range issues {
+		if issue.IssueType != model.TypeEpic || (!includeClosed && issue.Status.IsClosed()) {
+			continue
+		}
+		tree, err := loader.LoadReviewTree(issue.ID, issues)
+		if err != nil || len(tree.Descendants) == 0 {
+			continue
+		}
+		out = append(out, epicCoverage(tree))
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		pi, pj := out[i].ByType[model.ReviewTypePlan].Coverage, out[j].ByType[model.ReviewTypePlan].Coverage
+		if pi != pj {
+			return pi < pj
+		}
+		return out[i].EpicID < out[j].EpicID
+	})
+	return out
+}
@@ -0,0 +1,56 @@
+package review
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeAllEpicCoverage(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	comment := func(status, reviewType string) *model.Comment {
+		saver := NewCommentReviewSaver("")
+		return &model.Comment{Text: saver.formatReviewComment(ReviewAction{
+			Status: status, Reviewer: "alice", ReviewType: reviewType, Timestamp: at,
+		})}
+	}
+	child := func(id, parent string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: id, DependsOnID: parent, Type: model.DepParentChild}}
+	}
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Reviewed plan", Status: model.StatusOpen, IssueType: model.TypeEpic,
+			Comments: []*model.Comment{comment("approved", "plan")}},
+		{ID: "bv-2", Title: "Task", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: child("bv-2", "bv-1"),
+			Comments: []*model.Comment{comment("approved", "plan"), comment("needs_revision", "security")}},
+		{ID: "bv-3", Title: "Sub", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: child("bv-3", "bv-2"),
+			Comments: []*model.Comment{comment("needs_revision", "plan")}},
+		{ID: "bv-4", Title: "Unreviewed plan", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "bv-5", Title: "Task", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: child("bv-5", "bv-4")},
+		{ID: "bv-6", Title: "Empty epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "bv-7", Title: "Done", Status: model.StatusClosed, IssueType: model.TypeEpic},
+		{ID: "bv-8", Title: "Task", Status: model.StatusClosed, IssueType: model.TypeTask, Dependencies: child("bv-8", "bv-7")},
+	}
+
+	got := ComputeAllEpicCoverage(issues, false)
+	if len(got) != 2 || got[0].EpicID != "bv-4" || got[1].EpicID != "bv-1" {
+		t.Fatalf("epics = %+v, want bv-4 then bv-1", got)
+	}
+	plan := got[1].ByType[model.ReviewTypePlan]
+	if want := (TreeCoverage{Total: 2, Reviewed: 2, Approved: 1, NeedsRevision: 1, Coverage: 1}); plan != want {
+		t.Errorf("plan coverage = %+v, want %+v (the epic's own review left out)", plan, want)
+	}
+	if sec := got[1].ByType[model.ReviewTypeSecurity]; sec.NeedsRevision != 1 || sec.Unreviewed != 1 {
+		t.Errorf("security coverage = %+v", sec)
+	}
+	if impl := got[1].ByType[model.ReviewTypeImplementation]; impl.Reviewed != 0 || impl.Total != 2 {
+		t.Errorf("implementation coverage = %+v", impl)
+	}
+
+	if got := ComputeAllEpicCoverage(issues, true); len(got) != 3 {
+		t.Errorf("with closed epics: %d epics, want 3", len(got))
+	}
+	if _, err := ComputeEpicCoverage("bv-missing", issues); err == nil {
+		t.Error("expected an error for an unknown epic")
+	}
+}
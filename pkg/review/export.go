@@ -0,0 +1,133 @@
+package review
+
+import (
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// TreeExport is a review tree with each node's latest review, for
+// dashboards built outside bv (`bv review export --json`)
+type TreeExport struct {
+	Root    string       `json:"root"` // Issue ID, or "label:NAME" for a label tree
+	Title   string       `json:"title"`
+	Label   string       `json:"label,omitempty"`
+	Summary TreeCoverage `json:"summary"`
+	Nodes   []NodeExport `json:"nodes"` // Depth-first, parents before children
+}
+
+// TreeCoverage counts the nodes of a tree by latest review status
+type TreeCoverage struct {
+	Total         int     `json:"total"`
+	Reviewed      int     `json:"reviewed"` // Approved, needs revision or deferred
+	Approved      int     `json:"approved"`
+	NeedsRevision int     `json:"needs_revision"`
+	Deferred      int     `json:"deferred"`
+	Unreviewed    int     `json:"unreviewed"`
+	Coverage      float64 `json:"coverage"` // Reviewed / Total, 0..1
+}
+
+// NodeExport is one issue of the tree and where its review stands
+type NodeExport struct {
+	ID              string     `json:"id"`
+	Title           string     `json:"title"`
+	Status          string     `json:"status"`
+	IssueType       string     `json:"issue_type"`
+	ParentID        string     `json:"parent_id,omitempty"`
+	Depth           int        `json:"depth"`
+	ReviewStatus    string     `json:"review_status"` // "unreviewed" when never reviewed
+	ReviewType      string     `json:"review_type,omitempty"`
+	Reviewer        string     `json:"reviewer,omitempty"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty"`
+	FirstReviewedAt *time.Time `json:"first_reviewed_at,omitempty"`
+	ReviewCount     int        `json:"review_count"`
+	Notes           string     `json:"notes,omitempty"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// ExportTree walks tree depth-first and reads each node's reviews from its
+// comments. A non-empty reviewType only counts reviews of that type. The
+// placeholder root of a label tree is left out; its children are the top.
+func ExportTree(tree *loader.ReviewTree, reviewType string) TreeExport {
+	out := TreeExport{Root: tree.Root.ID, Title: tree.Root.Title, Label: tree.Label, Nodes: []NodeExport{}}
+
+	seen := make(map[string]bool)
+	var walk func(issue *model.Issue, parentID string, depth int)
+	walk = func(issue *model.Issue, parentID string, depth int) {
+		if seen[issue.ID] {
+			return
+		}
+		seen[issue.ID] = true
+		out.Nodes = append(out.Nodes, exportNode(issue, parentID, depth, reviewType))
+		for _, child := range tree.Children(issue.ID) {
+			walk(child, issue.ID, depth+1)
+		}
+	}
+	if tree.Label != "" {
+		for _, child := range tree.Children(tree.Root.ID) {
+			walk(child, "", 0)
+		}
+	} else {
+		walk(tree.Root, "", 0)
+	}
+
+	out.Summary = summarize(out.Nodes)
+	return out
+}
+
+// summarize counts nodes by their latest review status
+func summarize(nodes []NodeExport) TreeCoverage {
+	var s TreeCoverage
+	for _, node := range nodes {
+		switch node.ReviewStatus {
+		case model.ReviewStatusApproved:
+			s.Approved++
+		case model.ReviewStatusNeedsRevision:
+			s.NeedsRevision++
+		case model.ReviewStatusDeferred:
+			s.Deferred++
+		default:
+			s.Unreviewed++
+		}
+	}
+	s.Total = len(nodes)
+	s.Reviewed = s.Total - s.Unreviewed
+	if s.Total > 0 {
+		s.Coverage = float64(s.Reviewed) / float64(s.Total)
+	}
+	return s
+}
+
+// exportNode fills in issue's latest review of reviewType (any type when empty)
+func exportNode(issue *model.Issue, parentID string, depth int, reviewType string) NodeExport {
+	node := NodeExport{
+		ID:           issue.ID,
+		Title:        issue.Title,
+		Status:       string(issue.Status),
+		IssueType:    string(issue.IssueType),
+		ParentID:     parentID,
+		Depth:        depth,
+		ReviewStatus: model.ReviewStatusUnreviewed,
+		UpdatedAt:    issue.UpdatedAt,
+	}
+	for _, event := range ReviewHistory(issue.Comments) {
+		if reviewType != "" && event.ReviewType != "" && event.ReviewType != reviewType {
+			continue
+		}
+		if !model.IsValidReviewStatus(event.Status) {
+			continue // A note, not a verdict
+		}
+		at := event.At
+		if node.FirstReviewedAt == nil {
+			node.FirstReviewedAt = &at
+		}
+		node.ReviewCount++
+		node.ReviewStatus = event.Status
+		node.ReviewType = event.ReviewType
+		node.Reviewer = event.Reviewer
+		node.ReviewedAt = &at
+		node.Notes = event.Notes
+	}
+	return node
+}
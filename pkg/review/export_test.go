@@ -0,0 +1,70 @@
+package review
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestExportTree(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.UTC) }
+	comment := func(status, reviewer, reviewType string, at time.Time) *model.Comment {
+		saver := NewCommentReviewSaver("")
+		return &model.Comment{Text: saver.formatReviewComment(ReviewAction{
+			Status: status, Reviewer: reviewer, ReviewType: reviewType, Timestamp: at,
+		})}
+	}
+	child := func(id, parent string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: id, DependsOnID: parent, Type: model.DepParentChild}}
+	}
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic, Labels: []string{"api"},
+			Comments: []*model.Comment{comment("approved", "alice", "plan", day(2))}},
+		{ID: "bv-2", Title: "Task", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: child("bv-2", "bv-1"),
+			Comments: []*model.Comment{
+				comment("needs_revision", "bob", "plan", day(1)),
+				comment("approved", "carol", "security", day(4)),
+				{Text: "Looks fine to me"},
+				comment("approved", "bob", "plan", day(3)),
+			}},
+		{ID: "bv-3", Title: "Sub", Status: model.StatusOpen, IssueType: model.TypeTask, Labels: []string{"api"}, Dependencies: child("bv-3", "bv-2")},
+	}
+
+	tree, err := loader.LoadReviewTree("bv-1", issues)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := ExportTree(tree, model.ReviewTypePlan)
+	if len(got.Nodes) != 3 || got.Nodes[1].ParentID != "bv-1" || got.Nodes[2].Depth != 2 {
+		t.Fatalf("nodes = %+v", got.Nodes)
+	}
+	task := got.Nodes[1]
+	if task.ReviewStatus != "approved" || task.Reviewer != "bob" || task.ReviewCount != 2 ||
+		!task.ReviewedAt.Equal(day(3)) || !task.FirstReviewedAt.Equal(day(1)) {
+		t.Errorf("plan review of bv-2 = %+v", task)
+	}
+	if got.Nodes[2].ReviewStatus != model.ReviewStatusUnreviewed || got.Nodes[2].ReviewedAt != nil {
+		t.Errorf("bv-3 = %+v, want unreviewed", got.Nodes[2])
+	}
+	want := TreeCoverage{Total: 3, Reviewed: 2, Approved: 2, Unreviewed: 1, Coverage: 2.0 / 3}
+	if got.Summary != want {
+		t.Errorf("summary = %+v, want %+v", got.Summary, want)
+	}
+
+	// Any type: carol's later security review is the latest
+	if node := ExportTree(tree, "").Nodes[1]; node.Reviewer != "carol" || node.ReviewCount != 3 {
+		t.Errorf("any-type latest = %+v", node)
+	}
+
+	// A label tree leaves its placeholder root out
+	labelTree, err := loader.LoadLabelReviewTree("api", issues)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = ExportTree(labelTree, "")
+	if got.Root != "label:api" || len(got.Nodes) != 2 || got.Nodes[0].ID != "bv-1" || got.Nodes[0].ParentID != "" {
+		t.Errorf("label export = %+v", got)
+	}
+}
@@ -0,0 +1,78 @@
+package review
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ReviewEvent is one review recorded as an issue comment
+type ReviewEvent struct {
+	Status     string // "approved", "needs_revision", "deferred", "unreviewed"
+	Reviewer   string
+	ReviewType string // "plan", "implementation", "security"
+	Notes      string
+	Content    string // ContentFingerprint of the approved issue, if recorded
+	At         time.Time
+}
+
+// ParseReviewEvent parses a review comment in the [REVIEW] or legacy
+// ---REVIEW--- format. ok is false for other comments.
+func ParseReviewEvent(commentText string) (event ReviewEvent, ok bool) {
+	// Check for either marker format
+	if !strings.Contains(commentText, ReviewCommentMarker) && !strings.Contains(commentText, LegacyReviewCommentMarker) {
+		return ReviewEvent{}, false
+	}
+
+	lines := strings.Split(commentText, "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+
+		// Handle both lowercase (new) and titlecase (legacy) field names
+		lineLower := strings.ToLower(line)
+		if strings.HasPrefix(lineLower, "status:") {
+			event.Status = strings.TrimSpace(line[7:])
+		} else if strings.HasPrefix(lineLower, "reviewer:") {
+			event.Reviewer = strings.TrimSpace(line[9:])
+		} else if strings.HasPrefix(lineLower, "date:") {
+			dateStr := strings.TrimSpace(line[5:])
+			if t, err := time.Parse(time.RFC3339, dateStr); err == nil {
+				event.At = t
+			}
+		} else if strings.HasPrefix(lineLower, "type:") {
+			event.ReviewType = strings.TrimSpace(line[5:])
+		} else if strings.HasPrefix(lineLower, "notes:") {
+			event.Notes = strings.TrimSpace(line[6:])
+		} else if strings.HasPrefix(lineLower, "content:") {
+			event.Content = strings.TrimSpace(line[8:])
+		}
+	}
+
+	return event, event.Status != ""
+}
+
+// ReviewHistory returns every review recorded in comments, oldest first.
+// Reviews without a reviewer or date take the comment's author and time.
+func ReviewHistory(comments []*model.Comment) []ReviewEvent {
+	var events []ReviewEvent
+	for _, c := range comments {
+		if c == nil {
+			continue
+		}
+		event, ok := ParseReviewEvent(c.Text)
+		if !ok {
+			continue
+		}
+		if event.Reviewer == "" {
+			event.Reviewer = c.Author
+		}
+		if event.At.IsZero() {
+			event.At = c.CreatedAt
+		}
+		events = append(events, event)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].At.Before(events[j].At) })
+	return events
+}
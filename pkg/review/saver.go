@@ -1,27 +1,47 @@
 package review
 
-import "time"
+import (
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+)
 
 // ReviewAction represents a single review action to be persisted
 type ReviewAction struct {
-	IssueID    string
-	Status     string // "approved", "needs_revision", "deferred"
-	Reviewer   string
-	Notes      string
-	ReviewType string // "plan", "implementation", "security"
-	Timestamp  time.Time
+	IssueID    string    `json:"issue_id"`
+	Status     string    `json:"status"` // "approved", "needs_revision", "deferred"
+	Reviewer   string    `json:"reviewer,omitempty"`
+	Notes      string    `json:"notes,omitempty"`
+	ReviewType string    `json:"review_type"` // "plan", "implementation", "security"
+	Timestamp  time.Time `json:"timestamp"`
+	Content    string    `json:"content,omitempty"` // ContentFingerprint when approved
 }
 
 // ReviewSaver defines the interface for persisting review actions
 type ReviewSaver interface {
 	// Save persists all review actions from a session
-	// Returns the number of successfully saved actions and any errors
+	// Returns the number of successfully saved actions and any errors,
+	// one *SaveError per failed action
 	Save(actions []ReviewAction) (saved int, errors []error)
 
 	// Close releases any resources
 	Close() error
 }
 
+// SaveError is a failure to persist the review of one issue
+type SaveError struct {
+	IssueID string
+	Err     error
+}
+
+func (e *SaveError) Error() string {
+	return e.IssueID + ": " + e.Err.Error()
+}
+
+func (e *SaveError) Unwrap() error {
+	return e.Err
+}
+
 // ReviewSaveResult contains the outcome of a save operation
 type ReviewSaveResult struct {
 	Saved  int
@@ -29,7 +49,10 @@ type ReviewSaveResult struct {
 	Errors []error
 }
 
-// NewReviewSaver creates a saver that persists reviews as comments
-func NewReviewSaver(workspaceRoot string) ReviewSaver {
-	return NewCommentReviewSaver(workspaceRoot)
+// NewReviewSaver creates a saver that persists reviews as comments, or
+// records them in dry when that is not nil
+func NewReviewSaver(workspaceRoot string, dry *loader.DryRun) ReviewSaver {
+	saver := NewCommentReviewSaver(workspaceRoot)
+	saver.DryRun = dry
+	return saver
 }
@@ -0,0 +1,99 @@
+package review
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ReviewerProgress is one reviewer's share of a review pass. Statuses come
+// from the reviewer's own latest review of each issue.
+type ReviewerProgress struct {
+	Reviewer      string `json:"reviewer"`
+	Reviewed      int    `json:"reviewed"`
+	Approved      int    `json:"approved"`
+	NeedsRevision int    `json:"needs_revision"`
+	Deferred      int    `json:"deferred"`
+	Shared        int    `json:"shared"` // Reviewed issues someone else reviewed too
+}
+
+// TeamSummary splits a review pass across the people doing it
+type TeamSummary struct {
+	Total      int                `json:"total"`
+	Reviewers  []ReviewerProgress `json:"reviewers"`  // Most reviewed first
+	Overlap    int                `json:"overlap"`    // Issues with two or more reviewers
+	Unreviewed []string           `json:"unreviewed"` // Issues nobody has reviewed, in input order
+}
+
+// ComputeTeamSummary attributes the reviews of issueIDs to their reviewers.
+// history holds each issue's reviews oldest first, as ReviewHistory returns
+// them; reviewType narrows it to one type (any type when empty). A reviewer
+// whose latest review of an issue reset it no longer counts for that issue.
+func ComputeTeamSummary(issueIDs []string, history map[string][]ReviewEvent, reviewType string) TeamSummary {
+	summary := TeamSummary{Total: len(issueIDs)}
+	progress := make(map[string]*ReviewerProgress)
+
+	for _, id := range issueIDs {
+		// Each reviewer's latest verdict on this issue
+		latest := make(map[string]string)
+		var order []string
+		for _, e := range history[id] {
+			if reviewType != "" && e.ReviewType != "" && e.ReviewType != reviewType {
+				continue
+			}
+			if e.Reviewer == "" || !model.IsValidReviewStatus(e.Status) {
+				continue
+			}
+			if _, seen := latest[e.Reviewer]; !seen {
+				order = append(order, e.Reviewer)
+			}
+			latest[e.Reviewer] = e.Status
+		}
+
+		var reviewers []string
+		for _, r := range order {
+			if latest[r] != model.ReviewStatusUnreviewed {
+				reviewers = append(reviewers, r)
+			}
+		}
+		if len(reviewers) == 0 {
+			summary.Unreviewed = append(summary.Unreviewed, id)
+			continue
+		}
+		if len(reviewers) > 1 {
+			summary.Overlap++
+		}
+
+		for _, r := range reviewers {
+			p := progress[r]
+			if p == nil {
+				p = &ReviewerProgress{Reviewer: r}
+				progress[r] = p
+			}
+			p.Reviewed++
+			switch latest[r] {
+			case model.ReviewStatusApproved:
+				p.Approved++
+			case model.ReviewStatusNeedsRevision:
+				p.NeedsRevision++
+			case model.ReviewStatusDeferred:
+				p.Deferred++
+			}
+			if len(reviewers) > 1 {
+				p.Shared++
+			}
+		}
+	}
+
+	for _, p := range progress {
+		summary.Reviewers = append(summary.Reviewers, *p)
+	}
+	sort.Slice(summary.Reviewers, func(i, j int) bool {
+		a, b := summary.Reviewers[i], summary.Reviewers[j]
+		if a.Reviewed != b.Reviewed {
+			return a.Reviewed > b.Reviewed
+		}
+		return a.Reviewer < b.Reviewer
+	})
+	return summary
+}
@@ -0,0 +1,40 @@
+package review
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeTeamSummary(t *testing.T) {
+	event := func(reviewer, status, reviewType string) ReviewEvent {
+		return ReviewEvent{Reviewer: reviewer, Status: status, ReviewType: reviewType}
+	}
+	history := map[string][]ReviewEvent{
+		"bv-1": {event("alice", model.ReviewStatusNeedsRevision, "plan"), event("alice", model.ReviewStatusApproved, "plan")},
+		"bv-2": {event("alice", model.ReviewStatusApproved, "plan"), event("bob", model.ReviewStatusNeedsRevision, "plan")},
+		"bv-3": {event("bob", model.ReviewStatusDeferred, "")},
+		"bv-4": {event("carol", model.ReviewStatusApproved, "plan"), event("carol", model.ReviewStatusUnreviewed, "plan")},
+		"bv-5": {event("dave", model.ReviewStatusApproved, "security")},
+	}
+	ids := []string{"bv-1", "bv-2", "bv-3", "bv-4", "bv-5", "bv-6"}
+
+	got := ComputeTeamSummary(ids, history, "plan")
+	want := TeamSummary{
+		Total: 6,
+		Reviewers: []ReviewerProgress{
+			{Reviewer: "alice", Reviewed: 2, Approved: 2, Shared: 1},
+			{Reviewer: "bob", Reviewed: 2, NeedsRevision: 1, Deferred: 1, Shared: 1},
+		},
+		Overlap:    1,
+		Unreviewed: []string{"bv-4", "bv-5", "bv-6"}, // Reset, other type, never reviewed
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v\nwant %+v", got, want)
+	}
+
+	if all := ComputeTeamSummary(ids, history, ""); len(all.Reviewers) != 3 || len(all.Unreviewed) != 2 {
+		t.Errorf("any type: %+v", all)
+	}
+}
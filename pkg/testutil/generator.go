@@ -601,6 +601,17 @@ func QuickRandom(size int, density float64) []model.Issue {
 	return gen.ToIssues(gen.RandomDAG(size, density))
 }
 
+// QuickProject creates a project-sized random DAG: size labeled issues with a
+// mix of statuses and types and about size blocking edges.
+func QuickProject(size int) []model.Issue {
+	cfg := DefaultConfig()
+	cfg.IncludeLabels = true
+	cfg.StatusMix = []model.Status{model.StatusOpen, model.StatusOpen, model.StatusInProgress, model.StatusBlocked, model.StatusClosed}
+	cfg.TypeMix = []model.IssueType{model.TypeTask, model.TypeTask, model.TypeBug, model.TypeFeature}
+	gen := New(cfg)
+	return gen.ToIssues(gen.RandomDAG(size, 2/float64(size)))
+}
+
 // Empty returns an empty issue slice for edge case testing.
 func Empty() []model.Issue {
 	return []model.Issue{}
@@ -0,0 +1,204 @@
+// Package themes defines the color palettes of the TUI: a built-in gallery
+// plus user palettes read from YAML files in ~/.config/bv/themes/.
+package themes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Default is the name of the built-in Dracula palette, which adapts to
+// light terminals. The other palettes use the same colors on any background.
+const Default = "default"
+
+// Palette is a named set of colors. Empty fields and missing keys keep the
+// default palette's color.
+type Palette struct {
+	Name string `yaml:"name,omitempty"` // Defaults to the file name
+
+	Primary   string `yaml:"primary,omitempty"`   // Titles, accents, focused borders
+	Secondary string `yaml:"secondary,omitempty"` // Hints and secondary text
+	Subtext   string `yaml:"subtext,omitempty"`
+	Text      string `yaml:"text,omitempty"`
+	Muted     string `yaml:"muted,omitempty"`
+	Border    string `yaml:"border,omitempty"`
+	Highlight string `yaml:"highlight,omitempty"` // Selected row background
+
+	Status   map[string]string `yaml:"status,omitempty"`   // open, in_progress, blocked, closed
+	Priority map[string]string `yaml:"priority,omitempty"` // p0 … p4
+	Type     map[string]string `yaml:"type,omitempty"`     // bug, feature, task, epic, chore
+
+	Source string `yaml:"-"` // "builtin" or the file the palette was read from
+}
+
+// Keys accepted in each color map
+var (
+	StatusKeys   = []string{"open", "in_progress", "blocked", "closed"}
+	PriorityKeys = []string{"p0", "p1", "p2", "p3", "p4"}
+	TypeKeys     = []string{"bug", "feature", "task", "epic", "chore"}
+)
+
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Validate checks that the palette has a name and every color is #RGB or
+// #RRGGBB under a known key
+func (p Palette) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errors.New("theme has no name")
+	}
+	for _, c := range []struct{ key, value string }{
+		{"primary", p.Primary}, {"secondary", p.Secondary}, {"subtext", p.Subtext}, {"text", p.Text},
+		{"muted", p.Muted}, {"border", p.Border}, {"highlight", p.Highlight},
+	} {
+		if c.value != "" && !hexColor.MatchString(c.value) {
+			return fmt.Errorf("%s must be #RGB or #RRGGBB, got %q", c.key, c.value)
+		}
+	}
+	for _, m := range []struct {
+		name   string
+		colors map[string]string
+		keys   []string
+	}{
+		{"status", p.Status, StatusKeys},
+		{"priority", p.Priority, PriorityKeys},
+		{"type", p.Type, TypeKeys},
+	} {
+		for key, value := range m.colors {
+			if !slices.Contains(m.keys, key) {
+				return fmt.Errorf("%s: unknown key %q (want %s)", m.name, key, strings.Join(m.keys, ", "))
+			}
+			if !hexColor.MatchString(value) {
+				return fmt.Errorf("%s.%s must be #RGB or #RRGGBB, got %q", m.name, key, value)
+			}
+		}
+	}
+	return nil
+}
+
+// Builtin returns the built-in palettes, default first
+func Builtin() []Palette {
+	return []Palette{
+		{Name: Default, Source: "builtin"},
+		{
+			Name: "nord", Source: "builtin",
+			Primary: "#88C0D0", Secondary: "#4C566A", Subtext: "#D8DEE9", Text: "#ECEFF4",
+			Muted: "#616E88", Border: "#3B4252", Highlight: "#434C5E",
+			Status:   map[string]string{"open": "#A3BE8C", "in_progress": "#81A1C1", "blocked": "#BF616A", "closed": "#4C566A"},
+			Priority: map[string]string{"p0": "#BF616A", "p1": "#D08770", "p2": "#EBCB8B", "p3": "#A3BE8C", "p4": "#616E88"},
+			Type:     map[string]string{"bug": "#BF616A", "feature": "#D08770", "task": "#EBCB8B", "epic": "#B48EAD", "chore": "#88C0D0"},
+		},
+		{
+			Name: "gruvbox", Source: "builtin",
+			Primary: "#FE8019", Secondary: "#928374", Subtext: "#D5C4A1", Text: "#EBDBB2",
+			Muted: "#928374", Border: "#504945", Highlight: "#3C3836",
+			Status:   map[string]string{"open": "#B8BB26", "in_progress": "#83A598", "blocked": "#FB4934", "closed": "#928374"},
+			Priority: map[string]string{"p0": "#FB4934", "p1": "#FE8019", "p2": "#FABD2F", "p3": "#B8BB26", "p4": "#928374"},
+			Type:     map[string]string{"bug": "#FB4934", "feature": "#FE8019", "task": "#FABD2F", "epic": "#D3869B", "chore": "#8EC07C"},
+		},
+		{
+			Name: "solarized", Source: "builtin",
+			Primary: "#268BD2", Secondary: "#586E75", Subtext: "#93A1A1", Text: "#839496",
+			Muted: "#586E75", Border: "#073642", Highlight: "#073642",
+			Status:   map[string]string{"open": "#859900", "in_progress": "#2AA198", "blocked": "#DC322F", "closed": "#586E75"},
+			Priority: map[string]string{"p0": "#DC322F", "p1": "#CB4B16", "p2": "#B58900", "p3": "#859900", "p4": "#586E75"},
+			Type:     map[string]string{"bug": "#DC322F", "feature": "#CB4B16", "task": "#B58900", "epic": "#6C71C4", "chore": "#2AA198"},
+		},
+		{
+			Name: "high-contrast", Source: "builtin",
+			Primary: "#FFFF00", Secondary: "#FFFFFF", Subtext: "#FFFFFF", Text: "#FFFFFF",
+			Muted: "#C0C0C0", Border: "#FFFFFF", Highlight: "#0000AA",
+			Status:   map[string]string{"open": "#00FF00", "in_progress": "#00FFFF", "blocked": "#FF0000", "closed": "#C0C0C0"},
+			Priority: map[string]string{"p0": "#FF0000", "p1": "#FF8800", "p2": "#FFFF00", "p3": "#00FF00", "p4": "#C0C0C0"},
+			Type:     map[string]string{"bug": "#FF0000", "feature": "#FF8800", "task": "#FFFF00", "epic": "#FF00FF", "chore": "#00FFFF"},
+		},
+	}
+}
+
+// DefaultDir returns the user themes directory (~/.config/bv/themes), or ""
+// if the home directory cannot be determined
+func DefaultDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "bv", "themes")
+}
+
+// LoadDir reads every .yaml and .yml file in dir as one palette, sorted by
+// name. A missing directory yields none; files that fail to parse or
+// validate are skipped with a warning naming the file.
+func LoadDir(dir string) (palettes []Palette, warnings []string) {
+	if dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			warnings = append(warnings, fmt.Sprintf("reading themes directory: %v", err))
+		}
+		return nil, warnings
+	}
+
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %v", entry.Name(), err))
+			continue
+		}
+		var p Palette
+		if err := yaml.Unmarshal(data, &p); err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %v", entry.Name(), err))
+			continue
+		}
+		if strings.TrimSpace(p.Name) == "" {
+			p.Name = strings.TrimSuffix(entry.Name(), ext)
+		}
+		if err := p.Validate(); err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %v", entry.Name(), err))
+			continue
+		}
+		p.Source = path
+		palettes = append(palettes, p)
+	}
+	sort.Slice(palettes, func(i, j int) bool { return palettes[i].Name < palettes[j].Name })
+	return palettes, warnings
+}
+
+// All returns the built-in palettes followed by the user palettes in dir.
+// A user palette named like a built-in one replaces it in place.
+func All(dir string) ([]Palette, []string) {
+	all := Builtin()
+	user, warnings := LoadDir(dir)
+	for _, p := range user {
+		if i := Index(all, p.Name); i >= 0 {
+			all[i] = p
+		} else {
+			all = append(all, p)
+		}
+	}
+	return all, warnings
+}
+
+// Index returns the position of the palette named name (case-insensitive),
+// or -1
+func Index(palettes []Palette, name string) int {
+	for i, p := range palettes {
+		if strings.EqualFold(p.Name, strings.TrimSpace(name)) {
+			return i
+		}
+	}
+	return -1
+}
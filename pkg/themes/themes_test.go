@@ -0,0 +1,65 @@
+package themes
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuiltinPalettesAreValid(t *testing.T) {
+	builtin := Builtin()
+	if builtin[0].Name != Default {
+		t.Errorf("first palette = %q, want %q", builtin[0].Name, Default)
+	}
+	for _, p := range builtin {
+		if err := p.Validate(); err != nil {
+			t.Errorf("%s: %v", p.Name, err)
+		}
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("mine.yaml", `
+primary: "#FF00AA"
+status:
+  blocked: "#f00"
+priority:
+  p0: "#AA0000"
+`)
+	write("nord.yml", "name: nord\nprimary: \"#123456\"\n")
+	write("bad-color.yaml", "primary: red\n")
+	write("bad-key.yaml", "type:\n  story: \"#FFFFFF\"\n")
+	write("notes.txt", "not a theme")
+
+	if got, warnings := LoadDir(filepath.Join(dir, "missing")); got != nil || warnings != nil {
+		t.Errorf("missing dir: %v, %v", got, warnings)
+	}
+
+	all, warnings := All(dir)
+	if len(warnings) != 2 || !strings.Contains(strings.Join(warnings, "\n"), "bad-color.yaml: primary must be") ||
+		!strings.Contains(strings.Join(warnings, "\n"), `unknown key "story"`) {
+		t.Errorf("warnings = %v", warnings)
+	}
+
+	i := Index(all, "MINE")
+	if i < 0 {
+		t.Fatalf("mine.yaml should be named after its file: %+v", all)
+	}
+	mine := all[i]
+	if mine.Primary != "#FF00AA" || mine.Status["blocked"] != "#f00" || mine.Source != filepath.Join(dir, "mine.yaml") {
+		t.Errorf("mine = %+v", mine)
+	}
+	if len(all) != len(Builtin())+1 {
+		t.Errorf("got %d palettes, want the built-ins plus mine", len(all))
+	}
+	if nord := all[Index(all, "nord")]; nord.Primary != "#123456" || nord.Source == "builtin" {
+		t.Errorf("a user nord should replace the built-in one, got %+v", nord)
+	}
+}
@@ -269,7 +269,7 @@ func (m *ActionableModel) Render() string {
 			if isSelected {
 				idStyle = idStyle.Bold(true)
 			}
-			itemLine.WriteString(idStyle.Render(item.ID))
+			itemLine.WriteString(idStyle.Render(m.theme.ID(item.ID)))
 			itemLine.WriteString(" ")
 
 			// Title with selection highlighting
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// asciiReplacements maps the glyphs the UI draws to ASCII. asciiGlyphs pads or
+// cuts each replacement to the glyph's cell width, so layouts keep their shape.
+var asciiReplacements = map[rune]string{
+	// Lines, borders and trees
+	'─': "-", '━': "-", '═': "=", '┄': "-",
+	'│': "|", '┃': "|", '║': "|",
+	'┌': "+", '┐': "+", '└': "+", '┘': "+", '├': "+", '┤': "+", '┬': "+", '┴': "+", '┼': "+",
+	'╭': "+", '╮': "+", '╰': "+", '╯': "+",
+	'┏': "+", '┓': "+", '┗': "+", '┛': "+",
+	'╔': "+", '╗': "+", '╚': "+", '╝': "+", '╠': "+", '╣': "+",
+
+	// Arrows and pointers
+	'→': ">", '←': "<", '↑': "^", '↓': "v", '↕': "|", '↔': "-", '⇄': "=", '⇅': "=", '⇠': "<", '⇢': ">",
+	'↳': ">", '↪': ">", '↩': "<", '↺': "@", '↻': "@", '⬆': "^", '⬇': "v",
+	'▸': ">", '▶': ">", '►': ">", '›': ">", '◂': "<", '◀': "<", '◄': "<",
+	'▲': "^", '▼': "v", '⏎': "<",
+
+	// Markers and shapes
+	'•': "*", '·': ".", '…': ".", '⋯': ".", '●': "*", '○': "o", '◉': "@",
+	'◆': "*", '◈': "#", '◇': "o", '⬡': "o", '■': "#", '▮': "#", '▦': "#",
+	'✓': "v", '✕': "x", '✂': "x", '×': "x", '★': "*", '⚑': "F",
+	'①': "1", '②': "2", '③': "3", '④': "4",
+
+	// Bars and sparklines
+	'█': "#", '░': ".", '▏': "|",
+	'▁': "_", '▂': "_", '▃': ".", '▄': "-", '▅': "=", '▆': "+", '▇': "#",
+
+	// Math
+	'—': "-", '−': "-", '≠': "#", '≥': ">", '∩': "n", '∪': "u", '⊕': "+", '⌀': "o",
+	'Σ': "S", 'σ': "s", 'Δ': "D", '≈': "~", 'λ': "L", 'ℹ': "i",
+
+	// Issue types
+	'🐛': "B", '✨': "F", '📋': "T", '🚀': "E", '🧹': "C",
+
+	// Status and alerts
+	'✅': "OK", '❌': "X", '⚠': "!", '⛔': "X", '🚫': "X", '❓': "?",
+	'🔴': "*", '🟠': "*", '🟡': "*", '🟢': "*", '🔵': "*", '⚫': "*", '⚪': "o",
+	'⚡': "!", '🔥': "!", '⭐': "*", '🎯': "@", '💡': "i", '🔔': "!",
+	'⏱': "T", '⏳': "..", '⏸': "=", '💤': "z", '🆕': "N", '🚧': "!",
+
+	// Everything else decorative
+	'📊': "#", '📝': "N", '🔍': "?", '🔎': "?", '🔬': "?", '🔭': "?",
+	'🏷': "L", '📦': "P", '📁': "D", '📂': "D", '🗂': "D", '🔗': "&", '📎': "&",
+	'🔄': "@", '🔀': "%", '🔧': "W", '🔓': "U", '🔒': "L", '🧪': "T",
+	'🎨': "C", '💬': "\"", '🌐': "W", '📚': "D", '👁': "o", '📈': "^",
+	'✎': "e", '📍': "@", '📌': "@", '☕': "~", '🩺': "+", '🏛': "A",
+	'🛰': "S", '🧠': "M", '🪢': "&", '📅': "D", '⌨': "K", '🛤': "=",
+	'📑': "D", '📜': "D", '📄': "D", '⌫': "<", '🔮': "?", '♻': "@",
+	'💄': "S", '👤': "@", '🔹': "*", '⛏': "W",
+}
+
+// Glyph returns s, a string the UI draws (an icon, a tree connector, a
+// format string), with its glyphs in ASCII when the theme is in ASCII mode
+// (--ascii or ascii: true). Issue text must not go through it: wrap the
+// format string, not the title filled into it.
+func (t Theme) Glyph(s string) string {
+	if !t.ASCII {
+		return s
+	}
+	return asciiGlyphs(s)
+}
+
+// BorderOf returns b drawn in ASCII when the theme is in ASCII mode
+func (t Theme) BorderOf(b lipgloss.Border) lipgloss.Border {
+	if !t.ASCII {
+		return b
+	}
+	for _, side := range []*string{
+		&b.Top, &b.Bottom, &b.Left, &b.Right,
+		&b.TopLeft, &b.TopRight, &b.BottomLeft, &b.BottomRight,
+		&b.MiddleLeft, &b.MiddleRight, &b.Middle, &b.MiddleTop, &b.MiddleBottom,
+	} {
+		*side = asciiGlyphs(*side)
+	}
+	return b
+}
+
+// asciiGlyphs rewrites the glyphs in s as ASCII; ANSI styling and
+// everything else pass through untouched
+func asciiGlyphs(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		replacement, ok := asciiReplacements[r]
+		if !ok {
+			b.WriteString(s[i : i+size])
+			i += size
+			continue
+		}
+		glyph := s[i : i+size]
+		if strings.HasPrefix(s[i+size:], "\uFE0F") {
+			glyph += "\uFE0F" // Emoji presentation, often two cells wide
+		}
+		b.WriteString(fitCells(replacement, lipgloss.Width(glyph)))
+		i += len(glyph)
+	}
+	return b.String()
+}
+
+// fitCells pads or cuts ASCII text s to width cells
+func fitCells(s string, width int) string {
+	if len(s) >= width {
+		return s[:width]
+	}
+	return s + strings.Repeat(" ", width-len(s))
+}
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestGlyphKeepsWidths(t *testing.T) {
+	theme := Theme{ASCII: true}
+	for glyph := range asciiReplacements {
+		for _, s := range []string{string(glyph), string(glyph) + "\uFE0F"} {
+			got := theme.Glyph(s)
+			if lipgloss.Width(got) != lipgloss.Width(s) {
+				t.Errorf("%q -> %q changes the width from %d to %d", s, got, lipgloss.Width(s), lipgloss.Width(got))
+			}
+			for _, r := range got {
+				if r > 127 {
+					t.Errorf("%q -> %q is not ASCII", s, got)
+					break
+				}
+			}
+		}
+	}
+
+	styled := lipgloss.NewStyle().Bold(true).Render("└─► café")
+	if got := stripAnsi(theme.Glyph(styled)); got != "+-> café" {
+		t.Errorf("got %q, want the tree drawn in ASCII and the text kept", got)
+	}
+	if got := (Theme{}).Glyph("└─►"); got != "└─►" {
+		t.Errorf("without ASCII mode got %q, want the glyphs kept", got)
+	}
+}
+
+func TestASCIIModeLensDashboard(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Epic", IssueType: model.TypeEpic, Status: model.StatusOpen, Labels: []string{"api"}},
+		{ID: "bv-2", Title: "Move → café ✓", IssueType: model.TypeBug, Status: model.StatusBlocked, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepParentChild}}},
+	}
+	render := func(ascii bool) string {
+		m := NewModel(issues, nil, "", WithProjectConfig(&config.Config{ASCII: ascii}))
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 30})
+		m = updated.(Model)
+		m.openLensDashboard(lensRef{Type: "epic", Value: "bv-1", Title: "Epic"}, nil, ScopeModeUnion)
+		return stripAnsi(m.lensDashboard.View())
+	}
+
+	unicode, ascii := render(false), render(true)
+	if !strings.ContainsAny(unicode, "╭│└") {
+		t.Fatalf("expected the lens drawn with box and tree characters:\n%s", unicode)
+	}
+	if !strings.Contains(ascii, "Move → café ✓") {
+		t.Errorf("ASCII mode must keep the issue's own text:\n%s", ascii)
+	}
+	for _, r := range strings.ReplaceAll(ascii, "Move → café ✓", "") {
+		if _, ok := asciiReplacements[r]; ok {
+			t.Fatalf("%q left in the ASCII view:\n%s", r, ascii)
+		}
+	}
+	unicodeLines, asciiLines := strings.Split(unicode, "\n"), strings.Split(ascii, "\n")
+	if len(unicodeLines) != len(asciiLines) {
+		t.Fatalf("ASCII mode renders %d lines, want %d", len(asciiLines), len(unicodeLines))
+	}
+	for i := range unicodeLines {
+		if lipgloss.Width(unicodeLines[i]) != lipgloss.Width(asciiLines[i]) {
+			t.Errorf("line %d is %d cells wide in ASCII mode, want %d", i, lipgloss.Width(asciiLines[i]), lipgloss.Width(unicodeLines[i]))
+		}
+	}
+}
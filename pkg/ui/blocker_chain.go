@@ -0,0 +1,235 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// blockerChainRow is one issue in the blocker tree of a BlockerChainModal
+type blockerChainRow struct {
+	issue  *model.Issue
+	prefix string // Tree prefix (├─, └─, │ )
+	root   bool   // Open with no open blockers: this is what holds the chain up
+	seen   bool   // Already listed under another branch; its blockers are not repeated
+	cycle  bool   // Blocks one of its own blockers
+}
+
+// BlockerChainModal answers "why is this blocked?": it walks every open
+// blocker of an issue transitively and lists the tree, with the open issues
+// at the bottom of the chain (the ones actually holding things up) flagged.
+type BlockerChainModal struct {
+	target   *model.Issue
+	rows     []blockerChainRow // rows[0] is the target
+	roots    []*model.Issue    // Root blockers, in tree order
+	selected int
+	scroll   int
+	theme    Theme
+	width    int
+	height   int
+}
+
+// NewBlockerChainModal builds the blocker tree of target from issueMap
+func NewBlockerChainModal(target *model.Issue, issueMap map[string]*model.Issue, theme Theme) BlockerChainModal {
+	m := BlockerChainModal{target: target, theme: theme, width: 80, height: 25}
+	m.rows = append(m.rows, blockerChainRow{issue: target})
+
+	listed := map[string]bool{target.ID: true}
+	onPath := map[string]bool{target.ID: true}
+	var walk func(issue *model.Issue, indent string)
+	walk = func(issue *model.Issue, indent string) {
+		blockers := openBlockers(issue, issueMap)
+		for i, b := range blockers {
+			branch, next := "├─ ", "│  "
+			if i == len(blockers)-1 {
+				branch, next = "└─ ", "   "
+			}
+			row := blockerChainRow{issue: b, prefix: indent + branch}
+			switch {
+			case onPath[b.ID]:
+				row.cycle = true
+			case listed[b.ID]:
+				row.seen = true
+			}
+			if row.cycle || row.seen {
+				m.rows = append(m.rows, row)
+				continue
+			}
+			listed[b.ID] = true
+			bBlockers := openBlockers(b, issueMap)
+			row.root = len(bBlockers) == 0
+			m.rows = append(m.rows, row)
+			if row.root {
+				m.roots = append(m.roots, b)
+				continue
+			}
+			onPath[b.ID] = true
+			walk(b, indent+next)
+			delete(onPath, b.ID)
+		}
+	}
+	walk(target, "")
+	return m
+}
+
+// openBlockers returns the open issues blocking issue, in dependency order
+func openBlockers(issue *model.Issue, issueMap map[string]*model.Issue) []*model.Issue {
+	var blockers []*model.Issue
+	for _, dep := range issue.Dependencies {
+		if dep == nil || !dep.Type.IsBlocking() {
+			continue
+		}
+		if blocker, ok := issueMap[dep.DependsOnID]; ok && !blocker.Status.IsClosed() {
+			blockers = append(blockers, blocker)
+		}
+	}
+	return blockers
+}
+
+// Update moves the selection; the owner handles closing and jumping
+func (m BlockerChainModal) Update(msg tea.Msg) (BlockerChainModal, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "j", "down":
+			if m.selected < len(m.rows)-1 {
+				m.selected++
+			}
+		case "k", "up":
+			if m.selected > 0 {
+				m.selected--
+			}
+		case "g", "home":
+			m.selected = 0
+		case "G", "end":
+			m.selected = len(m.rows) - 1
+		}
+	}
+	visible := m.visibleRows()
+	if m.selected < m.scroll {
+		m.scroll = m.selected
+	} else if m.selected >= m.scroll+visible {
+		m.scroll = m.selected - visible + 1
+	}
+	return m, nil
+}
+
+// SelectedIssue returns the issue under the cursor
+func (m BlockerChainModal) SelectedIssue() *model.Issue {
+	return m.rows[m.selected].issue
+}
+
+// Roots returns the open issues at the bottom of the chain
+func (m BlockerChainModal) Roots() []*model.Issue {
+	return m.roots
+}
+
+// SetSize sets the terminal size the modal is fitted to
+func (m *BlockerChainModal) SetSize(width, height int) {
+	m.width = min(max(width-10, 50), 100)
+	m.height = height
+}
+
+// visibleRows is how many tree rows fit beside the header and footer
+func (m BlockerChainModal) visibleRows() int {
+	return max(m.height-14, 3)
+}
+
+// View renders the modal
+func (m BlockerChainModal) View() string {
+	t := m.theme
+	inner := m.width - 6 // Border and padding
+
+	headerStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	rootStyle := t.Renderer.NewStyle().Foreground(ColorWarning).Bold(true)
+
+	var lines []string
+	lines = append(lines, headerStyle.Render(truncate("Why is "+m.target.ID+" blocked?", inner)), "")
+
+	if len(m.rows) == 1 {
+		lines = append(lines, m.renderRow(m.rows[0], true, inner), "",
+			mutedStyle.Render("No open blockers: this issue is ready to work on."))
+	} else {
+		end := min(m.scroll+m.visibleRows(), len(m.rows))
+		if m.scroll > 0 {
+			lines = append(lines, mutedStyle.Render(fmt.Sprintf("  ↑ %d more", m.scroll)))
+		}
+		for i := m.scroll; i < end; i++ {
+			lines = append(lines, m.renderRow(m.rows[i], i == m.selected, inner))
+		}
+		if end < len(m.rows) {
+			lines = append(lines, mutedStyle.Render(fmt.Sprintf("  ↓ %d more", len(m.rows)-end)))
+		}
+
+		lines = append(lines, "", rootStyle.Render(fmt.Sprintf("Holding things up (%d):", len(m.roots))))
+		for _, r := range m.roots {
+			lines = append(lines, truncate(fmt.Sprintf("  ◆ %s %s · %s", m.theme.ID(r.ID), r.Title, assigneeText(r)), inner))
+		}
+	}
+
+	lines = append(lines, "", mutedStyle.Render("j/k move · enter jump to issue · esc close"))
+
+	return t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(m.width).
+		Render(strings.Join(lines, "\n"))
+}
+
+// renderRow renders one tree row: prefix, status, ID, title and assignee
+func (m BlockerChainModal) renderRow(row blockerChainRow, selected bool, width int) string {
+	t := m.theme
+	issue := row.issue
+
+	cursor := "  "
+	if selected {
+		cursor = "▸ "
+	}
+	status := t.Renderer.NewStyle().Foreground(t.GetStatusColor(string(issue.Status))).
+		Render(GetStatusIcon(string(issue.Status)) + " " + string(issue.Status))
+
+	var suffix string
+	switch {
+	case row.cycle:
+		suffix = " ↻ cycle"
+	case row.seen:
+		suffix = " (listed above)"
+	case row.root:
+		suffix = " ◆ root"
+	}
+	tail := " · " + assigneeText(issue) + suffix
+
+	// The title gets what the fixed parts leave
+	fixed := lipgloss.Width(cursor+row.prefix+issue.ID+" ") + lipgloss.Width(status) + 1 + lipgloss.Width(tail)
+	title := truncate(issue.Title, max(width-fixed, 5))
+
+	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	titleStyle := t.Renderer.NewStyle()
+	tailStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	if selected {
+		idStyle = idStyle.Bold(true)
+		titleStyle = titleStyle.Foreground(t.Primary).Bold(true)
+	}
+	if row.root {
+		tailStyle = tailStyle.Foreground(ColorWarning)
+	}
+	return cursor + row.prefix + idStyle.Render(m.theme.ID(issue.ID)) + " " + status + " " + titleStyle.Render(title) + tailStyle.Render(tail)
+}
+
+// assigneeText is "@name", or "unassigned"
+func assigneeText(issue *model.Issue) string {
+	if issue.Assignee == "" {
+		return "unassigned"
+	}
+	return "@" + issue.Assignee
+}
+
+// CenterModal renders the modal centered in the terminal
+func (m BlockerChainModal) CenterModal(termWidth, termHeight int) string {
+	return lipgloss.Place(termWidth, termHeight, lipgloss.Center, lipgloss.Center, m.View())
+}
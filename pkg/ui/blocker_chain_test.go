@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestBlockerChainWalksToRoots(t *testing.T) {
+	blocks := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	// top <- (mid-a, mid-b) <- base; mid-a also waits on a closed issue
+	issues := []model.Issue{
+		{ID: "top", Title: "Top", Status: model.StatusBlocked, Dependencies: blocks("mid-a", "mid-b")},
+		{ID: "mid-a", Title: "Mid A", Status: model.StatusInProgress, Assignee: "bob", Dependencies: blocks("base", "done")},
+		{ID: "mid-b", Title: "Mid B", Status: model.StatusOpen, Dependencies: blocks("base")},
+		{ID: "base", Title: "Base", Status: model.StatusOpen, Assignee: "carol"},
+		{ID: "done", Title: "Done", Status: model.StatusClosed},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	if !m.selectIssueInList("top") {
+		t.Fatal("top should be listed")
+	}
+
+	updated, _ = m.Update(keyMsg("B"))
+	m = updated.(Model)
+	if !m.showBlockerChain {
+		t.Fatal("B should open the blocker chain")
+	}
+	var ids []string
+	for _, row := range m.blockerChain.rows {
+		ids = append(ids, row.prefix+row.issue.ID)
+	}
+	if got := strings.Join(ids, ","); got != "top,├─ mid-a,│  └─ base,└─ mid-b,   └─ base" {
+		t.Errorf("tree = %s", got)
+	}
+	if roots := m.blockerChain.Roots(); len(roots) != 1 || roots[0].ID != "base" {
+		t.Errorf("roots = %v, want only base", roots)
+	}
+	view := m.View()
+	for _, want := range []string{"Why is top blocked?", "Holding things up (1):", "◆ base Base · @carol", "@bob", "(listed above)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q", want)
+		}
+	}
+
+	// Enter jumps to the selected blocker and closes the explorer
+	for _, k := range []string{"j", "j", "enter"} {
+		updated, _ = m.Update(keyMsg(k))
+		m = updated.(Model)
+	}
+	if m.showBlockerChain {
+		t.Error("enter should close the explorer")
+	}
+	if item, _ := m.list.SelectedItem().(IssueItem); item.Issue.ID != "base" {
+		t.Errorf("selected %s, want base", item.Issue.ID)
+	}
+}
+
+func TestBlockerChainCycle(t *testing.T) {
+	a := &model.Issue{ID: "a", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "b", Type: model.DepBlocks}}}
+	b := &model.Issue{ID: "b", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "a", Type: model.DepBlocks}}}
+	chain := NewBlockerChainModal(a, map[string]*model.Issue{"a": a, "b": b}, Theme{})
+	if len(chain.rows) != 3 || !chain.rows[2].cycle || len(chain.Roots()) != 0 {
+		t.Errorf("cycle should end the walk without roots, rows = %+v", chain.rows)
+	}
+}
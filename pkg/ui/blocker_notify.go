@@ -0,0 +1,135 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// lensSnapshot is what a reload is compared against to tell which of the
+// open lens's blockers it closed
+type lensSnapshot struct {
+	issueIDs map[string]bool         // Issues the lens shows
+	statuses map[string]model.Status // Every issue's status
+}
+
+// snapshotLens records the open lens's issues and every issue's status
+// before a reload; it returns nil when no lens is open
+func (m Model) snapshotLens() *lensSnapshot {
+	if !m.showLensDashboard {
+		return nil
+	}
+	s := &lensSnapshot{
+		issueIDs: make(map[string]bool),
+		statuses: make(map[string]model.Status, len(m.issues)),
+	}
+	for _, issue := range m.lensDashboard.GetAllDisplayIssues() {
+		s.issueIDs[issue.ID] = true
+	}
+	for _, issue := range m.issues {
+		s.statuses[issue.ID] = issue.Status
+	}
+	return s
+}
+
+// reloadLens rebuilds the open lens from reloaded issues, keeping the cursor
+// on its issue, and describes the blockers of its issues the reload closed
+func (m *Model) reloadLens(before *lensSnapshot) []string {
+	if !m.showLensDashboard {
+		return nil
+	}
+	id := m.lensDashboard.SelectedIssueID()
+	m.refreshLensDashboard()
+	if id != "" {
+		m.lensDashboard.SelectIssue(id)
+	}
+	if before == nil {
+		return nil
+	}
+	return closedBlockerNotices(before, m.issueMap, m.theme)
+}
+
+// closedBlockerNotices describes, one line per closed blocker, the issues of
+// the lens that an issue closed since before was blocking, e.g. "bv-42
+// closed — 3 issues now ready". An issue is ready once nothing that blocks
+// it is open. IDs are shown as t displays them.
+func closedBlockerNotices(before *lensSnapshot, after map[string]*model.Issue, t Theme) []string {
+	var closed []string
+	freed := make(map[string][]string) // Closed blocker -> lens issues it blocked
+	for id := range before.issueIDs {
+		issue, ok := after[id]
+		if !ok || issue.Status.IsClosed() {
+			continue
+		}
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			blocker, ok := after[dep.DependsOnID]
+			was, known := before.statuses[dep.DependsOnID]
+			if !ok || !known || was.IsClosed() || !blocker.Status.IsClosed() {
+				continue
+			}
+			if _, seen := freed[blocker.ID]; !seen {
+				closed = append(closed, blocker.ID)
+			}
+			freed[blocker.ID] = append(freed[blocker.ID], id)
+		}
+	}
+
+	sort.Strings(closed)
+	var notices []string
+	for _, blockerID := range closed {
+		ready := 0
+		for _, id := range freed[blockerID] {
+			if !hasOpenBlocker(after[id], after) {
+				ready++
+			}
+		}
+		waiting := len(freed[blockerID]) - ready
+		var notice string
+		switch {
+		case waiting == 0:
+			notice = fmt.Sprintf("%s closed — %s now ready", t.ID(blockerID), pluralIssues(ready))
+		case ready == 0:
+			notice = fmt.Sprintf("%s closed — %s still blocked by others", t.ID(blockerID), pluralIssues(waiting))
+		default:
+			notice = fmt.Sprintf("%s closed — %s now ready, %d still blocked", t.ID(blockerID), pluralIssues(ready), waiting)
+		}
+		notices = append(notices, notice)
+	}
+	return notices
+}
+
+// hasOpenBlocker reports whether anything still open blocks issue
+func hasOpenBlocker(issue *model.Issue, issues map[string]*model.Issue) bool {
+	for _, dep := range issue.Dependencies {
+		if dep == nil || !dep.Type.IsBlocking() {
+			continue
+		}
+		if blocker, ok := issues[dep.DependsOnID]; ok && !blocker.Status.IsClosed() {
+			return true
+		}
+	}
+	return false
+}
+
+// blockerToast is the toast for a reload's closed blockers: the first
+// notice, and how many more there are
+func blockerToast(notices []string) string {
+	switch len(notices) {
+	case 0:
+		return ""
+	case 1:
+		return notices[0]
+	}
+	return fmt.Sprintf("%s (+%d more)", notices[0], len(notices)-1)
+}
+
+func pluralIssues(n int) string {
+	if n == 1 {
+		return "1 issue"
+	}
+	return fmt.Sprintf("%d issues", n)
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestReloadToastsClosedLensBlockers(t *testing.T) {
+	var bell bytes.Buffer
+	oldBell := bellOut
+	bellOut = &bell
+	defer func() { bellOut = oldBell }()
+
+	beads := filepath.Join(t.TempDir(), "beads.jsonl")
+	write := func(gateStatus string) {
+		t.Helper()
+		data := strings.Join([]string{
+			`{"id":"bv-1","title":"Gate","status":"` + gateStatus + `","issue_type":"task","labels":["api"]}`,
+			`{"id":"bv-2","title":"Freed","status":"open","issue_type":"task","labels":["api"],"dependencies":[{"issue_id":"bv-2","depends_on_id":"bv-1","type":"blocks"}]}`,
+			`{"id":"bv-3","title":"Still waiting","status":"open","issue_type":"task","labels":["api"],"dependencies":[{"issue_id":"bv-3","depends_on_id":"bv-1","type":"blocks"},{"issue_id":"bv-3","depends_on_id":"bv-4","type":"blocks"}]}`,
+			`{"id":"bv-4","title":"Other gate","status":"open","issue_type":"task"}`,
+		}, "\n")
+		if err := os.WriteFile(beads, []byte(data), 0644); err != nil {
+			t.Fatalf("write beads: %v", err)
+		}
+	}
+	write("open")
+	issues, err := loader.LoadIssuesFromFile(beads)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	m := NewModel(issues, nil, beads)
+	m.projectConfig = &config.Config{NotifyDesktop: true}
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updated.(Model)
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	m.lensDashboard.SelectIssue("bv-3")
+
+	// Someone closes the gate: the toast names it and what it freed
+	write("closed")
+	updated, _ = m.Update(FileChangedMsg{})
+	m = updated.(Model)
+	want := "bv-1 closed — 1 issue now ready, 1 still blocked"
+	if m.toast == nil || m.toast.text != want {
+		t.Fatalf("toast = %+v, want %q", m.toast, want)
+	}
+	if got := bell.String(); got != "\x1b]9;bv: "+want+"\a" {
+		t.Errorf("desktop notification = %q", got)
+	}
+	// The lens shows the reloaded issues, cursor where it was
+	if len(m.lensDashboard.blockedByMap["bv-2"]) != 0 || m.lensDashboard.SelectedIssueID() != "bv-3" {
+		t.Errorf("lens not reloaded: bv-2 blockers %v, cursor on %q", m.lensDashboard.blockedByMap["bv-2"], m.lensDashboard.SelectedIssueID())
+	}
+
+	// A reload that closes nothing the lens waits on stays quiet
+	m.toast = nil
+	write("open")
+	updated, _ = m.Update(FileChangedMsg{})
+	m = updated.(Model)
+	if m.toast != nil {
+		t.Errorf("reopening toasted %q", m.toast.text)
+	}
+}
@@ -263,8 +263,9 @@ func groupIssuesByMode(issues []model.Issue, mode SwimLaneMode) [4][]model.Issue
 		var colIdx int
 		switch mode {
 		case SwimByStatus:
-			// Default: Open | In Progress | Blocked | Closed
-			switch issue.Status {
+			// Default: Open | In Progress | Blocked | Closed; custom
+			// statuses land in the column they map onto
+			switch issue.Status.Column() {
 			case model.StatusOpen:
 				colIdx = 0
 			case model.StatusInProgress:
@@ -755,6 +756,22 @@ func (b *BoardModel) SelectedIssue() *model.Issue {
 	return nil
 }
 
+// SelectByID focuses the column holding id and selects its card, reporting
+// whether the board shows it
+func (b *BoardModel) SelectByID(id string) bool {
+	for i, activeCol := range b.activeColIdx {
+		for row, issue := range b.columns[activeCol] {
+			if issue.ID == id {
+				b.CollapseExpanded()
+				b.focusedCol = i
+				b.selectedRow[activeCol] = row
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // ColumnCount returns the number of issues in a column
 func (b *BoardModel) ColumnCount(col int) int {
 	if col >= 0 && col < 4 {
@@ -1167,7 +1184,7 @@ func (b BoardModel) renderCard(issue model.Issue, width int, selected bool, colI
 		borderColor = lipgloss.AdaptiveColor{Light: "#c62828", Dark: "#ef5350"} // Red - blocked
 	} else if blocksOthers {
 		borderColor = lipgloss.AdaptiveColor{Light: "#f57c00", Dark: "#ffb74d"} // Yellow/orange - high impact
-	} else if issue.Status == model.StatusOpen {
+	} else if issue.Status.Column() == model.StatusOpen {
 		borderColor = lipgloss.AdaptiveColor{Light: "#2e7d32", Dark: "#81c784"} // Green - ready
 	} else {
 		borderColor = t.Border // Default border
@@ -1209,7 +1226,7 @@ func (b BoardModel) renderCard(issue model.Issue, width int, selected bool, colI
 	if maxIDLen < 6 {
 		maxIDLen = 6
 	}
-	displayID := truncateRunesHelper(issue.ID, maxIDLen, "…")
+	displayID := truncateRunesHelper(b.theme.ID(issue.ID), maxIDLen, "…")
 
 	// Age indicator with color coding: green(<7d), yellow(7-30d), red(>30d)
 	ageText := FormatTimeRel(issue.UpdatedAt)
@@ -1328,7 +1345,7 @@ func (b BoardModel) renderExpandedCard(issue model.Issue, width int, _, _ int) s
 		borderColor = lipgloss.AdaptiveColor{Light: "#c62828", Dark: "#ef5350"} // Red - blocked
 	} else if blocksOthers {
 		borderColor = lipgloss.AdaptiveColor{Light: "#f57c00", Dark: "#ffb74d"} // Yellow - high impact
-	} else if issue.Status == model.StatusOpen {
+	} else if issue.Status.Column() == model.StatusOpen {
 		borderColor = lipgloss.AdaptiveColor{Light: "#2e7d32", Dark: "#81c784"} // Green - ready
 	} else {
 		borderColor = t.Primary // Selected uses primary
@@ -1354,7 +1371,7 @@ func (b BoardModel) renderExpandedCard(issue model.Issue, width int, _, _ int) s
 	header := fmt.Sprintf("%s %s %s ▼",
 		t.Renderer.NewStyle().Foreground(iconColor).Render(icon),
 		prioStyle.Render(prioText),
-		t.Renderer.NewStyle().Bold(true).Foreground(t.Primary).Render(issue.ID),
+		t.Renderer.NewStyle().Bold(true).Foreground(t.Primary).Render(b.theme.ID(issue.ID)),
 	)
 
 	// ══════════════════════════════════════════════════════════════════════════
@@ -1504,7 +1521,7 @@ func (b *BoardModel) renderDetailPanel(width, height int) string {
 
 			// Header with ID and type
 			icon, _ := t.GetTypeIcon(string(issue.IssueType))
-			content.WriteString(fmt.Sprintf("## %s %s\n\n", icon, issue.ID))
+			content.WriteString(fmt.Sprintf("## %s %s\n\n", icon, b.theme.ID(issue.ID)))
 
 			// Title
 			content.WriteString(fmt.Sprintf("**%s**\n\n", issue.Title))
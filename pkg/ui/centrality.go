@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// centralityRank is an issue's place in the PageRank and betweenness
+// orderings. Ranks are 1-indexed; ties share a rank.
+type centralityRank struct {
+	PageRank    int
+	PRScore     float64
+	Betweenness int
+	BTScore     float64
+}
+
+// centralityRanks holds the centrality ranks of every issue for one analysis.
+// Ranking sorts both score maps, so it runs once per analysis in the
+// background rather than on every frame of the lens selector stats panel.
+type centralityRanks struct {
+	stats *analysis.GraphStats // The analysis ranked, to detect stale results
+	ranks map[string]centralityRank
+	total int // Issue count, the denominator of the rank badges
+}
+
+// CentralityReadyMsg is sent when background centrality ranking completes
+type CentralityReadyMsg struct {
+	ranks *centralityRanks
+}
+
+// ComputeCentralityCmd waits for Phase 2 of stats, then ranks its PageRank
+// and betweenness scores
+func ComputeCentralityCmd(stats *analysis.GraphStats, total int) tea.Cmd {
+	return func() tea.Msg {
+		stats.WaitForPhase2()
+		return CentralityReadyMsg{ranks: rankCentrality(stats, total)}
+	}
+}
+
+func rankCentrality(stats *analysis.GraphStats, total int) *centralityRanks {
+	c := &centralityRanks{stats: stats, ranks: make(map[string]centralityRank), total: total}
+	rankScores(stats.PageRank(), func(id string, rank int, score float64) {
+		r := c.ranks[id]
+		r.PageRank, r.PRScore = rank, score
+		c.ranks[id] = r
+	})
+	rankScores(stats.Betweenness(), func(id string, rank int, score float64) {
+		r := c.ranks[id]
+		r.Betweenness, r.BTScore = rank, score
+		c.ranks[id] = r
+	})
+	return c
+}
+
+// rankScores calls set with each ID's rank: one more than the number of
+// strictly higher scores
+func rankScores(scores map[string]float64, set func(id string, rank int, score float64)) {
+	ids := make([]string, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return scores[ids[i]] > scores[ids[j]] })
+	rank := 0
+	for i, id := range ids {
+		if i == 0 || scores[id] < scores[ids[i-1]] {
+			rank = i + 1
+		}
+		set(id, rank, scores[id])
+	}
+}
+
+// centralityCmd hands cached centrality ranks to the lens selector, or starts
+// ranking the current analysis with the selector's spinner running meanwhile
+func (m *Model) centralityCmd() tea.Cmd {
+	if m.analysis == nil {
+		return nil
+	}
+	if m.centrality != nil && m.centrality.stats == m.analysis {
+		m.lensSelector.SetCentrality(m.centrality)
+		return nil
+	}
+	if m.centralityPending == m.analysis {
+		return m.lensSelector.spinner.Tick
+	}
+	m.centralityPending = m.analysis
+	return tea.Batch(m.lensSelector.spinner.Tick, ComputeCentralityCmd(m.analysis, len(m.issues)))
+}
+
+// handleCentralityReady passes finished ranks to an open lens selector built
+// on the same analysis and caches them, unless a reload replaced the analysis.
+func (m Model) handleCentralityReady(msg CentralityReadyMsg) (Model, tea.Cmd) {
+	if m.showLensSelector {
+		m.lensSelector.SetCentrality(msg.ranks)
+	}
+	if msg.ranks.stats != m.analysis {
+		return m, nil
+	}
+	m.centrality = msg.ranks
+	m.centralityPending = nil
+	return m, nil
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestRankScoresSharesTies(t *testing.T) {
+	ranks := map[string]int{}
+	rankScores(map[string]float64{"a": 0.5, "b": 0.9, "c": 0.5, "d": 0.1}, func(id string, rank int, _ float64) {
+		ranks[id] = rank
+	})
+	if got := fmt.Sprint(ranks); got != "map[a:2 b:1 c:2 d:4]" {
+		t.Errorf("ranks = %s", got)
+	}
+}
+
+func TestLensSelectorCentralityInBackground(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Base", Status: model.StatusOpen},
+		{ID: "bv-2", Title: "Top", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepBlocks},
+		}},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	cmd := m.openLensSelector()
+	if cmd == nil || !m.lensSelector.CentralityPending() {
+		t.Fatal("opening the selector should start ranking centrality")
+	}
+
+	// Run the ranking as the program would
+	updated, _ = m.Update(ComputeCentralityCmd(m.analysis, len(m.issues))())
+	m = updated.(Model)
+	if m.lensSelector.CentralityPending() || m.centrality == nil {
+		t.Fatal("ranks should reach the selector and the cache")
+	}
+	if rank, _, _, _, total := m.lensSelector.getCentralityRank("bv-1"); rank != 1 || total != 2 {
+		t.Errorf("bv-1 PageRank rank = %d of %d, want 1 of 2", rank, total)
+	}
+
+	// A reopened selector uses the cache; a reload invalidates it
+	if cmd := m.openLensSelector(); cmd != nil || m.lensSelector.CentralityPending() {
+		t.Error("reopening should reuse cached ranks")
+	}
+	m.replaceIssues(issues)
+	if m.centrality != nil {
+		t.Error("reload should drop cached ranks")
+	}
+	if cmd := m.openLensSelector(); cmd == nil {
+		t.Error("selector after reload should rank again")
+	}
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/claims"
+)
+
+// claimOwnerWidth caps the owner name shown in a claim badge
+const claimOwnerWidth = 10
+
+// refreshClaims rereads .beads/claims.json and works out which issues the
+// claims cover, for the badges in the list, lens views and details.
+// claimWarning is set when some issues are claimed by more than one owner.
+func (m *Model) refreshClaims() {
+	m.claimCoverage, m.labelClaims, m.claimWarning = nil, nil, ""
+	defer m.updateListDelegate()
+	if m.beadsPath == "" {
+		return
+	}
+	store, err := claims.Load(filepath.Dir(m.beadsPath))
+	if err != nil {
+		m.claimWarning = fmt.Sprintf("Ignoring claims: %v", err)
+		return
+	}
+	m.claimCoverage = store.Coverage(m.issues)
+	for _, c := range store.Claims {
+		if label, ok := c.Label(); ok {
+			if m.labelClaims == nil {
+				m.labelClaims = make(map[string][]claims.Claim)
+			}
+			m.labelClaims[label] = append(m.labelClaims[label], c)
+		}
+	}
+	if overlaps := claims.Overlaps(m.claimCoverage); len(overlaps) > 0 {
+		m.claimWarning = fmt.Sprintf("⚑ %d issue(s) claimed by more than one owner, e.g. %s: %s (see bv claims)",
+			len(overlaps), overlaps[0].IssueID, strings.Join(overlaps[0].Owners, ", "))
+	}
+}
+
+// claimBadge renders who holds an issue: "⚑alice", or "⚑alice+1" in the
+// warning color when several owners overlap. It is "" for unclaimed issues.
+func claimBadge(t Theme, cs []claims.Claim) string {
+	owners := claims.Owners(cs)
+	if len(owners) == 0 {
+		return ""
+	}
+	text := "⚑" + truncateRunesHelper(owners[0], claimOwnerWidth, "…")
+	style := t.Renderer.NewStyle().Foreground(t.Feature)
+	if len(owners) > 1 {
+		text += fmt.Sprintf("+%d", len(owners)-1)
+		style = t.Renderer.NewStyle().Foreground(ColorWarning).Bold(true)
+	}
+	return style.Render(text)
+}
+
+// describeClaims lists claims for the detail panes: "alice (bv-1), bob (label:api)"
+func describeClaims(cs []claims.Claim) string {
+	parts := make([]string, 0, len(cs))
+	for _, c := range cs {
+		part := fmt.Sprintf("%s (%s)", c.Owner, c.Target)
+		if c.Note != "" {
+			part += " — " + c.Note
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
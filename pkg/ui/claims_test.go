@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/claims"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestClaimsBadgeAndOverlapWarning(t *testing.T) {
+	tmp := t.TempDir()
+	beads := filepath.Join(tmp, "beads.jsonl")
+	if err := os.WriteFile(beads, nil, 0644); err != nil {
+		t.Fatalf("write beads: %v", err)
+	}
+	since := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	store := &claims.Store{}
+	store.Add(claims.Claim{Target: "epic", Owner: "alice", ClaimedAt: since})
+	store.Add(claims.Claim{Target: claims.LabelTarget("api"), Owner: "bob", ClaimedAt: since.Add(time.Hour)})
+	if err := store.Save(tmp); err != nil {
+		t.Fatalf("save claims: %v", err)
+	}
+
+	issues := []model.Issue{
+		{ID: "epic", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "task", Title: "Task", Status: model.StatusOpen, IssueType: model.TypeTask, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "task", DependsOnID: "epic", Type: model.DepParentChild}}},
+		{ID: "free", Title: "Free", Status: model.StatusOpen, IssueType: model.TypeTask},
+	}
+	m := NewModel(issues, nil, beads)
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "task: alice, bob") {
+		t.Fatalf("expected overlap warning for task, got %q", m.statusMsg)
+	}
+
+	delegate := IssueDelegate{Theme: DefaultTheme(lipgloss.NewRenderer(os.Stdout)), Claims: m.claimCoverage}
+	render := func(issue model.Issue) string {
+		item := IssueItem{Issue: issue}
+		l := list.New([]list.Item{item}, delegate, 0, 0)
+		l.SetWidth(100)
+		var buf bytes.Buffer
+		delegate.Render(&buf, l, 0, item)
+		return buf.String()
+	}
+	if out := render(issues[0]); !strings.Contains(out, "⚑alice") || strings.Contains(out, "+1") {
+		t.Errorf("epic row should show alice's claim alone: %q", out)
+	}
+	if out := render(issues[1]); !strings.Contains(out, "⚑alice+1") {
+		t.Errorf("task row should show the overlap: %q", out)
+	}
+	if out := render(issues[2]); strings.Contains(out, "⚑") {
+		t.Errorf("unclaimed row should have no badge: %q", out)
+	}
+}
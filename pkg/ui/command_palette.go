@@ -0,0 +1,269 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// paletteAction identifies how a palette command is executed by the main model
+type paletteAction int
+
+const (
+	paletteActionKey       paletteAction = iota // Replay a global or list action's key from the list view
+	paletteActionLensKey                        // Replay a lens action's key inside the lens dashboard
+	paletteActionLensDepth                      // Set lens dashboard dependency depth
+	paletteActionLensDump                       // Write lens dashboard dump to file
+	paletteActionFilter                         // Apply a list status filter
+	paletteActionGotoIssue                      // Jump to an issue by ID
+)
+
+// PaletteCommand is a single entry in the command palette
+type PaletteCommand struct {
+	Category string // Grouping shown before the title (e.g. "View", "Lens", "Go to")
+	Title    string
+	Key      string // Equivalent keybinding, shown as a hint (may be empty)
+
+	action paletteAction
+	arg    string // Keymap action to replay, depth value or issue ID depending on action
+}
+
+// searchText returns the text matched against the palette query
+func (c PaletteCommand) searchText() string {
+	return c.Category + " " + c.Title
+}
+
+// CommandPaletteModel provides a fuzzy-searchable list of every available action
+type CommandPaletteModel struct {
+	commands      []PaletteCommand
+	filtered      []PaletteCommand
+	input         textinput.Model
+	selectedIndex int
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewCommandPaletteModel creates an empty command palette
+func NewCommandPaletteModel(theme Theme) CommandPaletteModel {
+	ti := textinput.New()
+	ti.Placeholder = "type a command or issue ID..."
+	ti.CharLimit = 80
+	ti.Width = 50
+	ti.Focus()
+
+	return CommandPaletteModel{
+		input: ti,
+		theme: theme,
+	}
+}
+
+// SetCommands replaces the available commands and resets the query
+func (m *CommandPaletteModel) SetCommands(commands []PaletteCommand) {
+	m.commands = commands
+	m.input.SetValue("")
+	m.filterCommands()
+}
+
+// SetSize updates the palette dimensions
+func (m *CommandPaletteModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// MoveUp moves selection up
+func (m *CommandPaletteModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves selection down
+func (m *CommandPaletteModel) MoveDown() {
+	if m.selectedIndex < len(m.filtered)-1 {
+		m.selectedIndex++
+	}
+}
+
+// Selected returns the currently selected command, or nil if nothing matches
+func (m *CommandPaletteModel) Selected() *PaletteCommand {
+	if m.selectedIndex < 0 || m.selectedIndex >= len(m.filtered) {
+		return nil
+	}
+	return &m.filtered[m.selectedIndex]
+}
+
+// UpdateInput processes a key message for the text input
+func (m *CommandPaletteModel) UpdateInput(msg interface{}) {
+	m.input, _ = m.input.Update(msg)
+	m.filterCommands()
+}
+
+// FilteredCount returns the number of commands matching the query
+func (m *CommandPaletteModel) FilteredCount() int {
+	return len(m.filtered)
+}
+
+// filterCommands ranks commands against the query using the label picker's fuzzy scoring.
+// With an empty query, commands keep their registration order.
+func (m *CommandPaletteModel) filterCommands() {
+	m.selectedIndex = 0
+	query := strings.ToLower(strings.TrimSpace(m.input.Value()))
+	if query == "" {
+		m.filtered = m.commands
+		return
+	}
+
+	type scored struct {
+		cmd   PaletteCommand
+		score int
+	}
+
+	var matches []scored
+	for _, cmd := range m.commands {
+		// Score title and category+title separately so "board" ranks "Kanban board" highly
+		score := fuzzyScore(cmd.Title, query)
+		if s := fuzzyScore(cmd.searchText(), query); s > score {
+			score = s
+		}
+		if score > 0 {
+			matches = append(matches, scored{cmd, score})
+		}
+	}
+
+	// Stable sort keeps registration order for ties (views before issues)
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	m.filtered = make([]PaletteCommand, len(matches))
+	for i, match := range matches {
+		m.filtered[i] = match.cmd
+	}
+}
+
+// View renders the command palette overlay
+func (m *CommandPaletteModel) View() string {
+	if m.width == 0 {
+		m.width = 80
+	}
+	if m.height == 0 {
+		m.height = 24
+	}
+
+	t := m.theme
+
+	boxWidth := 64
+	if m.width < boxWidth+6 {
+		boxWidth = m.width - 6
+	}
+	if boxWidth < 30 {
+		boxWidth = 30
+	}
+
+	maxVisible := 12
+	if m.height < 20 {
+		maxVisible = m.height - 9
+	}
+	if maxVisible < 3 {
+		maxVisible = 3
+	}
+
+	var lines []string
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true)
+	lines = append(lines, titleStyle.Render("Command Palette"))
+	lines = append(lines, "")
+
+	inputStyle := t.Renderer.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(t.Secondary).
+		Padding(0, 1).
+		Width(boxWidth - 6)
+	lines = append(lines, inputStyle.Render(m.input.View()))
+	lines = append(lines, "")
+
+	if len(m.filtered) == 0 {
+		dimStyle := t.Renderer.NewStyle().
+			Foreground(t.Secondary).
+			Italic(true)
+		lines = append(lines, dimStyle.Render("  No matching commands"))
+	} else {
+		start := 0
+		if m.selectedIndex >= maxVisible {
+			start = m.selectedIndex - maxVisible + 1
+		}
+		end := start + maxVisible
+		if end > len(m.filtered) {
+			end = len(m.filtered)
+		}
+
+		categoryWidth := 0
+		for _, cmd := range m.filtered[start:end] {
+			if w := lipgloss.Width(cmd.Category); w > categoryWidth {
+				categoryWidth = w
+			}
+		}
+
+		for i := start; i < end; i++ {
+			cmd := m.filtered[i]
+			isSelected := i == m.selectedIndex
+
+			itemStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+			categoryStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+			keyStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+			prefix := "  "
+			if isSelected {
+				itemStyle = itemStyle.Foreground(t.Primary).Bold(true)
+				categoryStyle = categoryStyle.Foreground(t.Primary)
+				prefix = "> "
+			}
+
+			keyHint := ""
+			if cmd.Key != "" {
+				keyHint = " [" + cmd.Key + "]"
+			}
+			category := cmd.Category + strings.Repeat(" ", categoryWidth-lipgloss.Width(cmd.Category))
+			maxTitleLen := boxWidth - 10 - categoryWidth - len(keyHint)
+			if maxTitleLen < 10 {
+				maxTitleLen = 10
+			}
+			title := truncateRunesHelper(cmd.Title, maxTitleLen, "...")
+			lines = append(lines, prefix+categoryStyle.Render(category)+"  "+itemStyle.Render(title)+keyStyle.Render(keyHint))
+		}
+
+		if len(m.filtered) > maxVisible {
+			countStyle := t.Renderer.NewStyle().
+				Foreground(t.Secondary).
+				Italic(true)
+			lines = append(lines, "")
+			lines = append(lines, countStyle.Render(
+				"  ("+itoa(m.selectedIndex+1)+"/"+itoa(len(m.filtered))+")",
+			))
+		}
+	}
+
+	lines = append(lines, "")
+	footerStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+	lines = append(lines, footerStyle.Render("↑/↓: navigate | enter: run | esc: cancel"))
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(strings.Join(lines, "\n")),
+	)
+}
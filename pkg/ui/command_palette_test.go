@@ -0,0 +1,108 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func typePalette(m Model, text string) Model {
+	for _, r := range text {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = updated.(Model)
+	}
+	return m
+}
+
+func TestCommandPaletteFiltering(t *testing.T) {
+	theme := Theme{Renderer: lipgloss.DefaultRenderer()}
+	p := NewCommandPaletteModel(theme)
+	p.SetCommands([]PaletteCommand{
+		{Category: "View", Title: "Kanban board", Key: "b"},
+		{Category: "View", Title: "Graph view", Key: "g"},
+		{Category: "Go to", Title: "bv-42  Fix the board layout"},
+	})
+
+	if p.FilteredCount() != 3 {
+		t.Fatalf("empty query should list all commands, got %d", p.FilteredCount())
+	}
+
+	p.input.SetValue("board")
+	p.filterCommands()
+	if p.FilteredCount() != 2 {
+		t.Fatalf("expected 2 matches for 'board', got %d", p.FilteredCount())
+	}
+	if sel := p.Selected(); sel == nil || sel.Title != "Kanban board" {
+		t.Errorf("expected view command first, got %+v", sel)
+	}
+
+	p.input.SetValue("zzz")
+	p.filterCommands()
+	if p.Selected() != nil {
+		t.Error("expected no selection when nothing matches")
+	}
+	if !strings.Contains(p.View(), "No matching commands") {
+		t.Error("expected empty-state message")
+	}
+}
+
+func TestCommandPaletteRunsViewCommand(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "One", Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	m = updated.(Model)
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = updated.(Model)
+	if !m.showCommandPalette {
+		t.Fatal("expected ctrl+p to open the command palette")
+	}
+
+	// Letters go to the palette input, not to global keybindings
+	m = typePalette(m, "kanban")
+	if m.isBoardView {
+		t.Fatal("typing in the palette must not trigger global keys")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.showCommandPalette {
+		t.Error("expected palette to close after running a command")
+	}
+	if !m.isBoardView || m.focused != focusBoard {
+		t.Errorf("expected board view, got focus %v", m.focused)
+	}
+}
+
+func TestCommandPaletteGotoIssueClearsFilter(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Open one", Status: model.StatusOpen},
+		{ID: "bv-2", Title: "Closed one", Status: model.StatusClosed},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	m = updated.(Model)
+	m.currentFilter = "open"
+	m.applyFilter()
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = updated.(Model)
+	m = typePalette(m, "bv-2")
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	if m.currentFilter != "all" {
+		t.Errorf("expected filter cleared to reveal hidden issue, got %q", m.currentFilter)
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != "bv-2" {
+		t.Errorf("expected bv-2 selected, got %+v", m.list.SelectedItem())
+	}
+	if !m.showDetails {
+		t.Error("expected detail view after jumping to issue")
+	}
+}
@@ -93,7 +93,9 @@ const contextHelpList = `## List View
 **Navigation**
   j/k       Move up/down
   Enter     View issue details
+  K         Peek (hovercard)
   g/G       Jump to top/bottom
+  y         Copy agent context
 
 **Filtering**
   o         Open issues only
@@ -118,6 +120,9 @@ const contextHelpGraph = `## Graph View
   h/l       Navigate siblings
   Enter     View selected issue
   f         Focus on subgraph
+  c         Layered canvas (arrows select,
+            hjkl pan, +/- zoom)
+  y         Copy agent context
   Esc       Exit to list
 
 **Understanding the Graph**
@@ -155,7 +160,7 @@ const contextHelpBoard = `## Board View
   Tab       Toggle detail panel
   Ctrl+j/k  Scroll detail panel
   V         Preview cass sessions
-  y         Copy issue ID
+  y/Y       Copy agent context / issue ID
   Enter     View issue details
   Esc       Return to List view`
 
@@ -175,6 +180,7 @@ const contextHelpInsights = `## Insights Panel
 **Details**
   e         Toggle explanations
   x         Toggle calculations
+  y         Copy agent context
 
 **Attention Indicators**
 • Stale: Open too long
@@ -205,6 +211,7 @@ const contextHelpHistory = `## History View
 
 **Actions**
   y         Copy commit SHA
+  Y         Copy bead's agent context
   o         Open commit in browser
   Esc       Return to list`
 
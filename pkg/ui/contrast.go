@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Minimum contrast ratios (WCAG 2.1): text needs 4.5:1, glyphs such as bars,
+// icons and tree lines 3:1
+const (
+	minTextContrast  = 4.5
+	minGlyphContrast = 3.0
+)
+
+// The backgrounds adaptive colors are checked against: Dracula's for dark
+// terminals, white for light ones
+const (
+	darkTerminalBg  = "#282A36"
+	lightTerminalBg = "#FFFFFF"
+)
+
+// parseHex reads #RGB or #RRGGBB into 0-255 channels
+func parseHex(hex string) (r, g, b float64, ok bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return 0, 0, 0, false
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return float64(v >> 16 & 0xFF), float64(v >> 8 & 0xFF), float64(v & 0xFF), true
+}
+
+// relativeLuminance is the WCAG relative luminance of a color, 0 (black) to 1 (white)
+func relativeLuminance(r, g, b float64) float64 {
+	channel := func(c float64) float64 {
+		c /= 255
+		if c <= 0.03928 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(r) + 0.7152*channel(g) + 0.0722*channel(b)
+}
+
+// contrastRatio returns the WCAG contrast ratio of two hex colors (1 to 21),
+// or 0 if either is not a hex color
+func contrastRatio(a, b string) float64 {
+	ar, ag, ab, ok1 := parseHex(a)
+	br, bg, bb, ok2 := parseHex(b)
+	if !ok1 || !ok2 {
+		return 0
+	}
+	la, lb := relativeLuminance(ar, ag, ab), relativeLuminance(br, bg, bb)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// readableOn returns fg, or fg blended toward black or white (away from bg)
+// just far enough to reach min contrast against bg. Colors that are not hex,
+// such as ANSI indexes, are returned as they are.
+func readableOn(fg, bg string, min float64) string {
+	ratio := contrastRatio(fg, bg)
+	if ratio == 0 || ratio >= min {
+		return fg
+	}
+	fr, fgc, fb, _ := parseHex(fg)
+	br, bgc, bb, _ := parseHex(bg)
+	target := 255.0
+	if relativeLuminance(br, bgc, bb) > 0.5 {
+		target = 0
+	}
+	blended := fg
+	for step := 1; step <= 10; step++ {
+		f := float64(step) / 10
+		mix := func(c float64) int { return int(math.Round(c + (target-c)*f)) }
+		blended = fmt.Sprintf("#%02X%02X%02X", mix(fr), mix(fgc), mix(fb))
+		if contrastRatio(blended, bg) >= min {
+			break
+		}
+	}
+	return blended
+}
+
+// readableColor is readableOn for lipgloss colors
+func readableColor(fg, bg lipgloss.Color, min float64) lipgloss.Color {
+	return lipgloss.Color(readableOn(string(fg), string(bg), min))
+}
+
+// tuneContrast raises every foreground color of the theme to at least glyph
+// contrast, the light variant against a light terminal and the dark variant
+// against a dark one, so progress bars, badges and tree prefixes stay
+// readable with any palette on any background. Border and Highlight are
+// backgrounds and keep their colors.
+func (t *Theme) tuneContrast() {
+	for _, c := range []*lipgloss.AdaptiveColor{
+		&t.Primary, &t.Secondary, &t.Subtext,
+		&t.Open, &t.InProgress, &t.Blocked, &t.Closed,
+		&t.Bug, &t.Feature, &t.Task, &t.Epic, &t.Chore,
+		&t.Muted,
+	} {
+		c.Light = readableOn(c.Light, lightTerminalBg, minGlyphContrast)
+		c.Dark = readableOn(c.Dark, darkTerminalBg, minGlyphContrast)
+	}
+}
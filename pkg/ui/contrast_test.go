@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/themes"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestContrastRatio(t *testing.T) {
+	if got := contrastRatio("#000", "#FFFFFF"); got < 20.9 || got > 21.1 {
+		t.Errorf("black on white = %.2f, want 21", got)
+	}
+	if got := contrastRatio("#777777", "#777777"); got != 1 {
+		t.Errorf("same color = %.2f, want 1", got)
+	}
+	if got := contrastRatio("12", "#FFFFFF"); got != 0 {
+		t.Errorf("ANSI index = %.2f, want 0", got)
+	}
+}
+
+func TestReadableOn(t *testing.T) {
+	tests := []struct {
+		fg, bg string
+		min    float64
+	}{
+		{"#A3BE8C", lightTerminalBg, minGlyphContrast}, // Nord green on white
+		{"#44475A", darkTerminalBg, minGlyphContrast},  // Dracula border as text
+		{"#6272A4", "#2A2A3D", minTextContrast},        // Closed badge
+		{"#FFFF00", lightTerminalBg, minTextContrast},
+	}
+	for _, tt := range tests {
+		got := readableOn(tt.fg, tt.bg, tt.min)
+		if ratio := contrastRatio(got, tt.bg); ratio < tt.min {
+			t.Errorf("readableOn(%s, %s) = %s at %.2f:1, want %.1f:1", tt.fg, tt.bg, got, ratio, tt.min)
+		}
+	}
+	if got := readableOn("#000000", lightTerminalBg, minTextContrast); got != "#000000" {
+		t.Errorf("a readable color should be kept, got %s", got)
+	}
+}
+
+func TestPaletteThemeTunesBothBackgrounds(t *testing.T) {
+	nord := themes.Builtin()[themes.Index(themes.Builtin(), "nord")]
+	theme := paletteTheme(nord, lipgloss.DefaultRenderer())
+
+	if theme.Open.Dark != nord.Status["open"] {
+		t.Errorf("nord green is readable on dark, got %s", theme.Open.Dark)
+	}
+	for name, c := range map[string]lipgloss.AdaptiveColor{"open": theme.Open, "closed": theme.Closed, "subtext": theme.Subtext, "task": theme.Task} {
+		if ratio := contrastRatio(c.Light, lightTerminalBg); ratio < minGlyphContrast {
+			t.Errorf("%s on light = %s at %.2f:1", name, c.Light, ratio)
+		}
+		if ratio := contrastRatio(c.Dark, darkTerminalBg); ratio < minGlyphContrast {
+			t.Errorf("%s on dark = %s at %.2f:1", name, c.Dark, ratio)
+		}
+	}
+}
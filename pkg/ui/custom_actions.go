@@ -0,0 +1,320 @@
+package ui
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui/keymap"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// bgAction tracks the captured custom action that is running
+const bgAction = "action"
+
+// defaultActionTimeout stops a captured action that hangs
+const defaultActionTimeout = 30 * time.Second
+
+// customAction is a configured action and the key it is bound to, as
+// tea.KeyMsg.String() prints it
+type customAction struct {
+	config.ActionConfig
+	key string
+}
+
+// CustomActionDoneMsg reports a finished custom action. Output is only
+// captured for actions that don't take the terminal.
+type CustomActionDoneMsg struct {
+	Name        string
+	IssueID     string
+	Interactive bool
+	Stdout      string
+	Stderr      string
+	Duration    time.Duration
+	Err         error
+}
+
+// buildCustomActions parses the keys of the configured actions. Actions
+// naming a key bubbletea does not know are returned as bad, and actions on a
+// key the keymap already binds as taken, e.g. "O (list.edit)": a checked-in
+// config must not be able to turn j or enter into a shell command.
+func buildCustomActions(configs []config.ActionConfig, km *keymap.Keymap) (actions []customAction, bad, taken []string) {
+	for _, c := range configs {
+		key, ok := parseKey(c.Key)
+		if !ok {
+			bad = append(bad, fmt.Sprintf("%s (%s)", c.Name, c.Key))
+			continue
+		}
+		if bound := keymapActionsOn(km, key.String()); len(bound) > 0 {
+			taken = append(taken, fmt.Sprintf("%s (%s)", key.String(), strings.Join(bound, ", ")))
+			continue
+		}
+		actions = append(actions, customAction{ActionConfig: c, key: key.String()})
+	}
+	return actions, bad, taken
+}
+
+// keymapActionsOn lists the keymap actions bound to key in any context
+func keymapActionsOn(km *keymap.Keymap, key string) []string {
+	var bound []string
+	for _, b := range km.Bindings() {
+		if slices.Contains(b.Keys, key) {
+			bound = append(bound, b.Action)
+		}
+	}
+	return bound
+}
+
+// actionHelpSection lists the custom actions in the help overlay
+func (m Model) actionHelpSection() keymap.HelpSection {
+	section := keymap.HelpSection{Title: "Actions (selected issue)", Icon: "▶"}
+	for _, a := range m.customActions {
+		section.Rows = append(section.Rows, keymap.HelpRow{Key: keymap.FormatKey(a.key), Desc: a.Name})
+	}
+	return section
+}
+
+// customActionFor returns the action bound to key
+func (m Model) customActionFor(key string) (customAction, bool) {
+	for _, a := range m.customActions {
+		if a.key == key {
+			return a, true
+		}
+	}
+	return customAction{}, false
+}
+
+// actionEnv is the selected issue's fields, in the BV_ISSUE_* variables an
+// action's command reads
+func actionEnv(issue *model.Issue) []string {
+	externalRef := ""
+	if issue.ExternalRef != nil {
+		externalRef = *issue.ExternalRef
+	}
+	return []string{
+		"BV_ISSUE_ID=" + issue.ID,
+		"BV_ISSUE_TITLE=" + issue.Title,
+		"BV_ISSUE_STATUS=" + string(issue.Status),
+		"BV_ISSUE_PRIORITY=" + strconv.Itoa(issue.Priority),
+		"BV_ISSUE_TYPE=" + string(issue.IssueType),
+		"BV_ISSUE_ASSIGNEE=" + issue.Assignee,
+		"BV_ISSUE_LABELS=" + strings.Join(issue.Labels, ","),
+		"BV_ISSUE_DESCRIPTION=" + issue.Description,
+		"BV_ISSUE_EXTERNAL_REF=" + externalRef,
+	}
+}
+
+// actionCommand builds the shell command for action on issue, run in workDir
+func actionCommand(ctx context.Context, action customAction, issue *model.Issue, workDir string) *exec.Cmd {
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	cmd := exec.CommandContext(ctx, shell, flag, action.Command)
+	cmd.Dir = workDir
+	cmd.Env = append(os.Environ(), actionEnv(issue)...)
+	return cmd
+}
+
+// RunCustomActionCmd runs action on issue in the background, capturing what
+// it prints
+func RunCustomActionCmd(action customAction, issue *model.Issue, workDir string) tea.Cmd {
+	timeout := defaultActionTimeout
+	if action.TimeoutSeconds > 0 {
+		timeout = time.Duration(action.TimeoutSeconds) * time.Second
+	}
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		cmd := actionCommand(ctx, action, issue, workDir)
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout, cmd.Stderr = &stdout, &stderr
+		start := time.Now()
+		err := cmd.Run()
+		if ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("timed out after %v", timeout)
+		}
+		return CustomActionDoneMsg{
+			Name:     action.Name,
+			IssueID:  issue.ID,
+			Stdout:   strings.TrimRight(stdout.String(), "\n"),
+			Stderr:   strings.TrimRight(stderr.String(), "\n"),
+			Duration: time.Since(start),
+			Err:      err,
+		}
+	}
+}
+
+// startCustomAction runs the action bound to key on the selected issue. It
+// returns false when no action is bound to key or the view has no selected
+// issue, leaving the key to the view.
+func (m *Model) startCustomAction(key string) (tea.Cmd, bool) {
+	action, ok := m.customActionFor(key)
+	if !ok {
+		return nil, false
+	}
+	issue, ok := m.issueMap[m.statusTargetID()]
+	if !ok {
+		return nil, false
+	}
+
+	if m.dryRun != nil {
+		m.statusMsg = fmt.Sprintf("Dry run: %s not run (actions are off in a dry run)", action.Name)
+		m.statusIsError = true
+		return nil, true
+	}
+
+	m.statusIsError = false
+	if action.Interactive {
+		m.statusMsg = fmt.Sprintf("▶ %s on %s", action.Name, issue.ID)
+		cmd := actionCommand(context.Background(), action, issue, m.workDir)
+		start := time.Now()
+		return tea.ExecProcess(cmd, func(err error) tea.Msg {
+			return CustomActionDoneMsg{Name: action.Name, IssueID: issue.ID, Interactive: true, Duration: time.Since(start), Err: err}
+		}), true
+	}
+	if op, running := m.backgroundOps[bgAction]; running {
+		m.statusMsg = fmt.Sprintf("⏳ %s is still running", strings.TrimSuffix(op.label, " finished"))
+		return nil, true
+	}
+	m.statusMsg = fmt.Sprintf("⏳ Running %s on %s…", action.Name, issue.ID)
+	m.startBackgroundOp(bgAction, action.Name+" finished")
+	return RunCustomActionCmd(action, issue, m.workDir), true
+}
+
+// handleCustomActionDone reports a finished action: interactive ones on the
+// status line, captured ones in the result pane
+func (m Model) handleCustomActionDone(msg CustomActionDoneMsg) (Model, tea.Cmd) {
+	outcome := fmt.Sprintf("✓ %s finished on %s", msg.Name, msg.IssueID)
+	if msg.Err != nil {
+		outcome = fmt.Sprintf("❌ %s failed on %s: %v", msg.Name, msg.IssueID, msg.Err)
+	}
+	m.statusMsg, m.statusIsError = outcome, msg.Err != nil
+	if msg.Interactive {
+		return m, nil
+	}
+	cmd := m.finishBackgroundOp(bgAction, outcome, msg.Err != nil)
+	m.actionResult = &msg
+	m.actionResultScroll = 0
+	m.showActionResult = true
+	return m, cmd
+}
+
+// actionResultLines is the output the result pane scrolls through: stdout,
+// then stderr under a heading
+func (m Model) actionResultLines() []string {
+	r := m.actionResult
+	var lines []string
+	if r.Stdout != "" {
+		lines = strings.Split(r.Stdout, "\n")
+	}
+	if r.Stderr != "" {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "── stderr ──")
+		lines = append(lines, strings.Split(r.Stderr, "\n")...)
+	}
+	return lines
+}
+
+// actionResultRows is how many output lines the result pane shows at once
+func (m Model) actionResultRows() int {
+	return max(m.height-12, 3)
+}
+
+// handleActionResultKeys scrolls or closes the result pane
+func (m Model) handleActionResultKeys(msg tea.KeyMsg) Model {
+	last := max(len(m.actionResultLines())-m.actionResultRows(), 0)
+	switch msg.String() {
+	case "j", "down":
+		m.actionResultScroll = min(m.actionResultScroll+1, last)
+	case "k", "up":
+		m.actionResultScroll = max(m.actionResultScroll-1, 0)
+	case "ctrl+d", "pgdown", " ":
+		m.actionResultScroll = min(m.actionResultScroll+m.actionResultRows(), last)
+	case "ctrl+u", "pgup":
+		m.actionResultScroll = max(m.actionResultScroll-m.actionResultRows(), 0)
+	case "g", "home":
+		m.actionResultScroll = 0
+	case "G", "end":
+		m.actionResultScroll = last
+	case "esc", "q", "enter":
+		m.showActionResult = false
+	}
+	return m
+}
+
+// renderActionResult renders the result pane of the last captured action
+func (m Model) renderActionResult() string {
+	t := m.theme
+	r := m.actionResult
+	width := min(110, m.width-4)
+
+	borderColor := t.Primary
+	if r.Err != nil {
+		borderColor = t.Blocked
+	}
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(1, 2).
+		Width(width).
+		MaxHeight(m.height - 2)
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(borderColor)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("▶ %s · %s", r.Name, r.IssueID)))
+	sb.WriteString("\n")
+	status := fmt.Sprintf("✓ finished in %s", r.Duration.Round(time.Millisecond))
+	if r.Err != nil {
+		status = "❌ " + r.Err.Error()
+		var exitErr *exec.ExitError
+		if errors.As(r.Err, &exitErr) {
+			status = fmt.Sprintf("❌ exit status %d after %s", exitErr.ExitCode(), r.Duration.Round(time.Millisecond))
+		}
+	}
+	sb.WriteString(mutedStyle.Render(status))
+	sb.WriteString("\n\n")
+
+	lines := m.actionResultLines()
+	if len(lines) == 0 {
+		sb.WriteString(mutedStyle.Render("(no output)"))
+		sb.WriteString("\n")
+	}
+	start := min(m.actionResultScroll, max(len(lines)-m.actionResultRows(), 0))
+	end := min(start+m.actionResultRows(), len(lines))
+	for _, line := range lines[start:end] {
+		line = strings.ReplaceAll(stripAnsi(line), "\t", "    ")
+		sb.WriteString(truncate(line, width-4))
+		sb.WriteString("\n")
+	}
+	if len(lines) > end-start {
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("lines %d-%d of %d", start+1, end, len(lines))))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k, ctrl+d/u: scroll • g/G: top/bottom • Esc: close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCustomActionShowsOutput(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the command uses sh")
+	}
+	m := newProjectConfigModel(t, &config.Config{Actions: []config.ActionConfig{
+		{Name: "Echo", Key: "ctrl+e", Command: `printf '%s|%s\n' "$BV_ISSUE_ID" "$BV_ISSUE_TITLE"; echo oops >&2`},
+	}})
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	m = updated.(Model)
+	if cmd == nil || !strings.Contains(m.statusMsg, "Running Echo on bv-1") {
+		t.Fatalf("ctrl+e should start the action on the selected issue, status %q", m.statusMsg)
+	}
+	if _, again := m.Update(tea.KeyMsg{Type: tea.KeyCtrlE}); again != nil {
+		t.Error("a second run should wait for the first")
+	}
+
+	done, ok := cmd().(CustomActionDoneMsg)
+	if !ok || done.Err != nil {
+		t.Fatalf("action failed: %+v", done)
+	}
+	updated, _ = m.Update(done)
+	m = updated.(Model)
+	if !m.showActionResult {
+		t.Fatal("the result pane should open")
+	}
+	view := stripAnsi(m.View())
+	for _, want := range []string{"Echo · bv-1", "bv-1|API one", "── stderr ──", "oops"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("result pane missing %q:\n%s", want, view)
+		}
+	}
+
+	updated, _ = m.Update(keyMsg("esc"))
+	if m = updated.(Model); m.showActionResult {
+		t.Error("esc should close the result pane")
+	}
+}
+
+func TestCustomActionKeys(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{Path: ".bv.yaml", Actions: []config.ActionConfig{
+		{Name: "Edit", Key: "O", Command: "true"},
+		{Name: "Bogus", Key: "hyper+q", Command: "true"},
+		{Name: "Free", Key: "alt+p", Command: "true"},
+	}})
+	if len(m.customActions) != 1 || m.customActions[0].Name != "Free" || !strings.Contains(m.statusMsg, "Bogus (hyper+q)") {
+		t.Errorf("unknown keys should be reported, got %+v, status %q", m.customActions, m.statusMsg)
+	}
+	_, _, taken := buildCustomActions([]config.ActionConfig{{Name: "Edit", Key: "O", Command: "true"}}, m.keymap)
+	if len(taken) != 1 || taken[0] != "O (list.edit, lens.order_direction)" {
+		t.Errorf("taken = %v, want [O (list.edit, lens.order_direction)]", taken)
+	}
+
+	m.dryRun = loader.NewDryRun()
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p"), Alt: true})
+	if m = updated.(Model); cmd != nil || !strings.Contains(m.statusMsg, "Free not run") {
+		t.Errorf("actions should not run in a dry run, status %q", m.statusMsg)
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/claims"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
@@ -19,6 +21,13 @@ type IssueDelegate struct {
 	PriorityHints     map[string]*analysis.PriorityRecommendation
 	WorkspaceMode     bool // When true, shows repo prefix badges
 	ShowSearchScores  bool // Show semantic/hybrid score badge when search is active
+	StaleDays         int  // Inactivity before an open issue is aging (0 = default)
+
+	// Workstream claims by issue ID; claimed rows get a ⚑owner badge
+	Claims map[string][]claims.Claim
+
+	// Priorities inherited from blocked work; non-nil shows the "→P0" column
+	EffectivePriorities map[string]analysis.EffectivePriority
 }
 
 func (d IssueDelegate) Height() int {
@@ -56,10 +65,11 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 
 	// Get all the data
 	icon, iconColor := t.GetTypeIcon(string(i.Issue.IssueType))
-	idStr := i.Issue.ID
+	idStr := i.shownID()
 	title := i.Issue.Title
 	ageStr := FormatTimeRel(i.Issue.CreatedAt)
 	commentCount := len(i.Issue.Comments)
+	staleness := analysis.ComputeIssueAge(i.Issue, time.Now(), d.StaleDays).Staleness
 
 	// Measure actual icon display width (emojis vary: 1-2 cells)
 	iconDisplayWidth := lipgloss.Width(icon)
@@ -68,6 +78,17 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	rightWidth := 0
 	var rightParts []string
 
+	// Transitive dependents ("↑N") - how much open work this issue gates
+	if width > 80 {
+		depStr := ""
+		if i.DependentsCount > 0 {
+			depStr = fmt.Sprintf("↑%d", i.DependentsCount)
+		}
+		depStyle := t.Renderer.NewStyle().Foreground(ColorWarning)
+		rightParts = append(rightParts, depStyle.Render(fmt.Sprintf("%5s", depStr)))
+		rightWidth += 6
+	}
+
 	// Show Age and Comments only if we have reasonable width
 	if width > 60 {
 		// Age - with subtle styling
@@ -133,6 +154,16 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	prioBadgeWidth := lipgloss.Width(prioBadge)
 	leftFixedWidth += prioBadgeWidth + 1
 
+	// Effective priority column: the priority inherited from blocked work
+	var effectiveBadge string
+	if d.EffectivePriorities != nil {
+		effectiveBadge = "   "
+		if ep, ok := d.EffectivePriorities[i.Issue.ID]; ok {
+			effectiveBadge = t.Renderer.NewStyle().Foreground(ColorWarning).Render("→") + RenderPriorityBadge(ep.Priority)
+		}
+		leftFixedWidth += lipgloss.Width(effectiveBadge) + 1
+	}
+
 	// Priority hint indicator
 	if d.ShowPriorityHints {
 		leftFixedWidth += 2
@@ -174,6 +205,17 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 		leftFixedWidth += lipgloss.Width(badge) + 1
 	}
 
+	// Stale badge width
+	if staleness == analysis.Stale {
+		leftFixedWidth += lipgloss.Width("⚠") + 1
+	}
+
+	// Claim badge width
+	claimStr := claimBadge(t, d.Claims[i.Issue.ID])
+	if claimStr != "" {
+		leftFixedWidth += lipgloss.Width(claimStr) + 1
+	}
+
 	// Title gets everything in between
 	titleWidth := width - leftFixedWidth - rightWidth - 2
 	if titleWidth < 5 {
@@ -214,6 +256,10 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	// Priority badge (polished)
 	leftSide.WriteString(prioBadge)
 	leftSide.WriteString(" ")
+	if effectiveBadge != "" {
+		leftSide.WriteString(effectiveBadge)
+		leftSide.WriteString(" ")
+	}
 
 	// Priority hint indicator (↑/↓)
 	if d.ShowPriorityHints && d.PriorityHints != nil {
@@ -267,12 +313,27 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 		leftSide.WriteString(" ")
 	}
 
-	// Title with emphasis when selected
+	// Stale badge: open and untouched for twice the stale threshold
+	if staleness == analysis.Stale {
+		leftSide.WriteString(t.Renderer.NewStyle().Foreground(ColorWarning).Render("⚠"))
+		leftSide.WriteString(" ")
+	}
+
+	// Claim badge: who has this issue's workstream
+	if claimStr != "" {
+		leftSide.WriteString(claimStr)
+		leftSide.WriteString(" ")
+	}
+
+	// Title with emphasis when selected, dimmed while aging
 	titleStyle := t.Renderer.NewStyle()
 	if isSelected {
 		titleStyle = titleStyle.Foreground(t.Primary).Bold(true)
 	} else {
 		titleStyle = titleStyle.Foreground(lipgloss.AdaptiveColor{Light: "#333333", Dark: "#E8E8E8"})
+		if staleness != analysis.Fresh {
+			titleStyle = titleStyle.Faint(true)
+		}
 	}
 	leftSide.WriteString(titleStyle.Render(title))
 
@@ -0,0 +1,154 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WithDryRun makes the session a rehearsal: relabels, review saves and bv's
+// own file writes are collected in d as pending changes (W to review and
+// export them) instead of being persisted. The analysis disk cache is read
+// but not written.
+func WithDryRun(d *loader.DryRun) ModelOption {
+	return func(o *modelOptions) {
+		o.dryRun = d
+	}
+}
+
+// IsDryRun returns whether writes are being held back
+func (m Model) IsDryRun() bool {
+	return m.dryRun != nil
+}
+
+// dryRunStatus prefixes a write's outcome with the reminder that nothing
+// was saved
+func (m Model) dryRunStatus(msg string) string {
+	if m.dryRun == nil || msg == "" {
+		return msg
+	}
+	return fmt.Sprintf("Dry run: %s (%d pending, W to review)", msg, m.dryRun.Len())
+}
+
+// pendingChangesPath is where e in the pending changes panel exports to
+func (m Model) pendingChangesPath() string {
+	return filepath.Join(m.workDir, loader.DryRunFile)
+}
+
+// togglePendingChanges opens or closes the pending changes panel
+func (m *Model) togglePendingChanges() {
+	if m.dryRun == nil {
+		m.statusMsg = "Not a dry run: changes are saved as you make them (start bv with --dry-run)"
+		m.statusIsError = false
+		return
+	}
+	m.showPendingChanges = !m.showPendingChanges
+	m.pendingScroll = 0
+}
+
+// handlePendingChangesKeys scrolls, exports or closes the pending changes panel
+func (m Model) handlePendingChangesKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		if m.pendingScroll < m.dryRun.Len()-m.pendingRows() {
+			m.pendingScroll++
+		}
+	case "k", "up":
+		if m.pendingScroll > 0 {
+			m.pendingScroll--
+		}
+	case "e":
+		path := m.pendingChangesPath()
+		if err := m.dryRun.Export(path); err != nil {
+			m.statusMsg = fmt.Sprintf("Export failed: %v", err)
+			m.statusIsError = true
+			return m
+		}
+		m.statusMsg = fmt.Sprintf("Exported %d pending changes to %s", m.dryRun.Len(), path)
+		m.statusIsError = false
+	case "esc", "q", "W":
+		m.showPendingChanges = false
+	}
+	return m
+}
+
+// pendingRows is how many changes the panel lists at once
+func (m Model) pendingRows() int {
+	return max(m.height-14, 3)
+}
+
+// renderPendingChanges renders the pending changes overlay
+func (m Model) renderPendingChanges() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorWarning).
+		Padding(1, 2).
+		Width(min(90, m.width-4)).
+		MaxHeight(m.height - 4)
+
+	titleStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(ColorWarning).
+		MarginBottom(1)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	changes := m.dryRun.Changes()
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("🧪 Pending changes (dry run)"))
+	sb.WriteString("\n\n")
+
+	if len(changes) == 0 {
+		sb.WriteString(mutedStyle.Render("Nothing yet: relabels, review saves and pins land here instead of being saved"))
+		sb.WriteString("\n\n")
+	} else {
+		issues := make(map[string]bool)
+		files := 0
+		for _, c := range changes {
+			if c.IssueID != "" {
+				issues[c.IssueID] = true
+			}
+			if c.Kind == "file" {
+				files++
+			}
+		}
+		summary := fmt.Sprintf("%d changes • %d issues", len(changes), len(issues))
+		if files > 0 {
+			summary += fmt.Sprintf(" • %d file writes", files)
+		}
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(summary + " • nothing has been written"))
+		sb.WriteString("\n\n")
+
+		start := min(m.pendingScroll, max(len(changes)-m.pendingRows(), 0))
+		end := min(start+m.pendingRows(), len(changes))
+		for _, c := range changes[start:end] {
+			line := c.Summary
+			if c.Kind == "file" {
+				line = fmt.Sprintf("%s → %s", c.Summary, c.Path)
+			}
+			sb.WriteString(mutedStyle.Render(c.At.Format("15:04:05")) + " " + line)
+			sb.WriteString("\n")
+		}
+		if hidden := len(changes) - (end - start); hidden > 0 {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("%d more (j/k to scroll)", hidden)))
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render(
+		fmt.Sprintf("j/k: scroll • e: export to %s • Esc: close", loader.DryRunFile)))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestDryRunCollectsPendingChanges(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Task", Status: model.StatusOpen, Labels: []string{"api"}}}
+	m := NewModel(issues, nil, "", WithDryRun(loader.NewDryRun()))
+	m.width, m.height = 120, 40
+	m.workDir = t.TempDir()
+
+	m.savePinnedLenses([]string{"label:api"})
+	if _, err := os.Stat(filepath.Join(m.workDir, ".bv.yaml")); !os.IsNotExist(err) {
+		t.Errorf("pins were written in a dry run (%v)", err)
+	}
+	if !strings.HasPrefix(m.statusMsg, "Dry run:") {
+		t.Errorf("status = %q, want a dry-run reminder", m.statusMsg)
+	}
+	batcher := loader.NewBdBatcher(m.workDir)
+	batcher.DryRun = m.dryRun
+	batcher.Run([]loader.BdWrite{
+		{IssueID: "bv-1", Command: []string{"label", "add"}, Args: []string{"ui"}},
+	})
+
+	newM, _ := m.Update(keyMsg("W"))
+	m = newM.(Model)
+	if !m.showPendingChanges {
+		t.Fatal("W should open the pending changes panel")
+	}
+	view := m.renderPendingChanges()
+	for _, want := range []string{"2 changes", "bd label add bv-1 ui", "pin 1 lens(es): label:api"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("panel missing %q", want)
+		}
+	}
+
+	newM, _ = m.Update(keyMsg("e"))
+	m = newM.(Model)
+	data, err := os.ReadFile(filepath.Join(m.workDir, loader.DryRunFile))
+	if err != nil {
+		t.Fatalf("export: %v (%s)", err, m.statusMsg)
+	}
+	var export struct {
+		Changes []loader.PendingChange `json:"changes"`
+	}
+	if err := json.Unmarshal(data, &export); err != nil || len(export.Changes) != 2 || export.Changes[1].IssueID != "bv-1" {
+		t.Errorf("export = %s (%v)", data, err)
+	}
+}
+
+func TestDryRunWritesNoFiles(t *testing.T) {
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+	if err := os.MkdirAll(beadsDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	beadsPath := filepath.Join(beadsDir, "issues.jsonl")
+	if err := os.WriteFile(beadsPath, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	d := loader.NewDryRun()
+	m := NewModel(testReviewIssues(), nil, beadsPath, WithDryRun(d))
+	defer m.Stop()
+
+	m.recordLensVisit(lensRef{Type: "epic", Value: "EPIC", Title: "Epic"})
+	m.reviewDashboard = newTestReviewDashboard(t)
+	m.trackReviewSession()
+	m.reviewDashboard = pressReview(m.reviewDashboard, "a", "j", "a")
+
+	entries, _ := os.ReadDir(beadsDir)
+	if len(entries) != 1 {
+		t.Errorf("a dry run wrote into .beads: %v", entries)
+	}
+	var summaries []string
+	for _, c := range d.Changes() {
+		summaries = append(summaries, c.Summary)
+	}
+	want := []string{"epic membership snapshot", "recent lens: Epic", "review session for bv review --resume"}
+	if strings.Join(summaries, "|") != strings.Join(want, "|") {
+		t.Errorf("pending changes = %q, want %q", summaries, want)
+	}
+}
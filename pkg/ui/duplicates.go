@@ -0,0 +1,227 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DuplicateMarkedMsg reports the outcome of marking DupID a duplicate of OfID
+type DuplicateMarkedMsg struct {
+	DupID string
+	OfID  string
+	Errs  []error
+}
+
+// MarkDuplicateCmd writes the duplicate link and label through the bd CLI,
+// or records them in dry when that is not nil
+func MarkDuplicateCmd(workDir, dupID, ofID string, dry *loader.DryRun) tea.Cmd {
+	return func() tea.Msg {
+		errs := loader.MarkDuplicate(workDir, dupID, ofID, analysis.DuplicateLabel, dry)
+		return DuplicateMarkedMsg{DupID: dupID, OfID: ofID, Errs: errs}
+	}
+}
+
+// toggleDuplicates opens the duplicates panel with a fresh similarity pass,
+// or closes it
+func (m *Model) toggleDuplicates() {
+	m.showDuplicates = !m.showDuplicates
+	if !m.showDuplicates {
+		return
+	}
+	m.duplicatesCursor = 0
+	m.refreshDuplicates()
+}
+
+// refreshDuplicates reruns the similarity pass, keeping the cursor in range
+func (m *Model) refreshDuplicates() {
+	m.duplicates = analysis.FindNearDuplicates(m.issues, analysis.DefaultNearDuplicateConfig())
+	m.duplicatesCursor = min(m.duplicatesCursor, max(len(m.duplicates)-1, 0))
+}
+
+// handleDuplicatesKeys moves through the pairs; d marks the newer issue of
+// the pair a duplicate of the older one, D the other way round, and enter
+// jumps to the older one
+func (m Model) handleDuplicatesKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	pairs := m.duplicates
+	switch msg.String() {
+	case "j", "down":
+		if m.duplicatesCursor < len(pairs)-1 {
+			m.duplicatesCursor++
+		}
+	case "k", "up":
+		if m.duplicatesCursor > 0 {
+			m.duplicatesCursor--
+		}
+	case "d", "D":
+		if m.duplicatesCursor >= len(pairs) {
+			return m, nil
+		}
+		p := pairs[m.duplicatesCursor]
+		if msg.String() == "D" {
+			return m.markDuplicate(p.Issue1, p.Issue2)
+		}
+		return m.markDuplicate(p.Issue2, p.Issue1)
+	case "enter":
+		if m.duplicatesCursor >= len(pairs) {
+			return m, nil
+		}
+		m.showDuplicates = false
+		id := pairs[m.duplicatesCursor].Issue1
+		m.exitToListView()
+		if !m.selectIssueInList(id) {
+			m.statusMsg = id + " is hidden by the current filter"
+			m.statusIsError = true
+			return m, nil
+		}
+		m.updateViewportContent()
+	case "esc", "q", "=":
+		m.showDuplicates = false
+	}
+	return m, nil
+}
+
+// markDuplicate links dupID to ofID and labels it at once, writing both
+// through bd in the background; DuplicateMarkedMsg undoes what failed
+func (m Model) markDuplicate(dupID, ofID string) (Model, tea.Cmd) {
+	dup, ok := m.issueMap[dupID]
+	if !ok {
+		return m, nil
+	}
+	if reason := m.editRefusal("Duplicate marks"); reason != "" {
+		m.statusMsg = reason
+		m.statusIsError = true
+		return m, nil
+	}
+	dup.Dependencies = append(dup.Dependencies, &model.Dependency{IssueID: dupID, DependsOnID: ofID, Type: model.DepRelated})
+	if !slices.Contains(dup.Labels, analysis.DuplicateLabel) {
+		dup.Labels = append(dup.Labels, analysis.DuplicateLabel)
+	}
+	m.refreshAfterEdit(dupID)
+	m.refreshDuplicates()
+	m.statusMsg = fmt.Sprintf("Marking %s a duplicate of %s…", m.theme.ID(dupID), m.theme.ID(ofID))
+	m.statusIsError = false
+	return m, MarkDuplicateCmd(m.workDir, dupID, ofID, m.dryRun)
+}
+
+// handleDuplicateMarked reports a duplicate mark, undoing the writes that
+// failed
+func (m Model) handleDuplicateMarked(msg DuplicateMarkedMsg) Model {
+	if len(msg.Errs) == 0 {
+		m.statusMsg = m.dryRunStatus(fmt.Sprintf("%s marked a duplicate of %s", m.theme.ID(msg.DupID), m.theme.ID(msg.OfID)))
+		m.statusIsError = false
+		return m
+	}
+	if dup, ok := m.issueMap[msg.DupID]; ok {
+		for _, err := range msg.Errs {
+			var writeErr *loader.BdWriteError
+			if !errors.As(err, &writeErr) {
+				continue
+			}
+			switch writeErr.Write.Command[0] {
+			case "dep":
+				dup.Dependencies = slices.DeleteFunc(dup.Dependencies, func(d *model.Dependency) bool {
+					return d != nil && d.DependsOnID == msg.OfID && d.Type == model.DepRelated
+				})
+			case "label":
+				dup.Labels = slices.DeleteFunc(dup.Labels, func(l string) bool { return l == analysis.DuplicateLabel })
+			}
+		}
+		m.refreshAfterEdit(msg.DupID)
+		if m.showDuplicates {
+			m.refreshDuplicates()
+		}
+	}
+	m.statusMsg = fmt.Sprintf("Could not mark %s a duplicate: %v", m.theme.ID(msg.DupID), msg.Errs[0])
+	m.statusIsError = true
+	return m
+}
+
+// duplicatesRows is how many pairs the panel lists at once
+func (m Model) duplicatesRows() int {
+	return max((m.height-14)/3, 2)
+}
+
+// renderDuplicates renders the duplicates overlay: pairs of issues that
+// read alike, most similar first
+func (m Model) renderDuplicates() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(min(90, m.width-4)).
+		MaxHeight(m.height - 4)
+
+	titleStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Primary).
+		MarginBottom(1)
+	scoreStyle := t.Renderer.NewStyle().Bold(true).Foreground(ColorWarning)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("≈ Possible duplicates"))
+	sb.WriteString("\n\n")
+
+	pairs := m.duplicates
+	if len(pairs) == 0 {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(ColorSuccess).Render("✓ No open issues read alike"))
+		sb.WriteString("\n\n")
+	} else {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(
+			fmt.Sprintf("%d pairs by title and description similarity", len(pairs))))
+		sb.WriteString("\n\n")
+
+		// Scroll so the cursor stays in view
+		start := max(0, min(m.duplicatesCursor-m.duplicatesRows()/2, len(pairs)-m.duplicatesRows()))
+		end := min(start+m.duplicatesRows(), len(pairs))
+		for i := start; i < end; i++ {
+			p := pairs[i]
+			cursor := "  "
+			if i == m.duplicatesCursor {
+				cursor = "▸ "
+			}
+			first, second := m.duplicateLine(p.Issue1), m.duplicateLine(p.Issue2)
+			if i == m.duplicatesCursor {
+				bold := t.Renderer.NewStyle().Bold(true)
+				first, second = bold.Render(first), bold.Render(second)
+			}
+			sb.WriteString(cursor + scoreStyle.Render(fmt.Sprintf("%3.0f%%", p.Similarity*100)) + "  " + first + "\n")
+			sb.WriteString("        " + second + "\n")
+			sb.WriteString("        " + mutedStyle.Render("shared: "+strings.Join(p.Keywords, ", ")) + "\n")
+		}
+		if hidden := len(pairs) - (end - start); hidden > 0 {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("%d more (j/k to scroll)", hidden)))
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k: navigate • d: newer is a duplicate of older • D: older of newer • Enter: jump • Esc: close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
+
+// duplicateLine is one issue of a pair: ID, status and title
+func (m Model) duplicateLine(id string) string {
+	issue, ok := m.issueMap[id]
+	if !ok {
+		return id
+	}
+	return fmt.Sprintf("%s [%s] %s", m.theme.ID(id), issue.Status, truncateRunesHelper(issue.Title, 50, "…"))
+}
@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestDuplicatesPanel(t *testing.T) {
+	day := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Safari login crashes", Description: "Submitting credentials leaves a blank screen", Status: model.StatusOpen, CreatedAt: day},
+		{ID: "bv-2", Title: "Login page crashes on Safari", Description: "Blank screen after submitting credentials", Status: model.StatusOpen, CreatedAt: day.Add(time.Hour)},
+		{ID: "bv-3", Title: "Export burndown chart", Description: "Add a download button", Status: model.StatusOpen, CreatedAt: day},
+	}
+	m := NewModel(issues, nil, "", WithDryRun(loader.NewDryRun()))
+	m.width, m.height = 120, 40
+
+	newM, _ := m.Update(keyMsg("="))
+	m = newM.(Model)
+	if !m.showDuplicates || len(m.duplicates) != 1 {
+		t.Fatalf("= should open the panel with one pair, got %+v", m.duplicates)
+	}
+	view := stripAnsi(m.View())
+	for _, want := range []string{"≈ Possible duplicates", "bv-1 [open] Safari login crashes", "bv-2 [open] Login page crashes on Safari", "shared:"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("panel missing %q:\n%s", want, view)
+		}
+	}
+
+	// d marks the newer issue a duplicate of the older one
+	newM, cmd := m.Update(keyMsg("d"))
+	m = newM.(Model)
+	dup := m.issueMap["bv-2"]
+	if cmd == nil || !slices.Contains(dup.Labels, analysis.DuplicateLabel) || len(dup.Dependencies) != 1 || dup.Dependencies[0].DependsOnID != "bv-1" {
+		t.Fatalf("bv-2 not marked: labels %v, deps %v", dup.Labels, dup.Dependencies)
+	}
+	if len(m.duplicates) != 0 {
+		t.Errorf("marked pair still listed: %+v", m.duplicates)
+	}
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	var summaries []string
+	for _, c := range m.dryRun.Changes() {
+		summaries = append(summaries, c.Summary)
+	}
+	if want := "bd dep add bv-2 bv-1 --type=related|bd label add bv-2 duplicate"; strings.Join(summaries, "|") != want {
+		t.Errorf("pending changes = %q, want %q", summaries, want)
+	}
+	if !strings.Contains(m.statusMsg, "bv-2 marked a duplicate of bv-1") {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+
+	// A failed label write takes the label back off, keeping the link
+	failed := &loader.BdWriteError{Write: loader.BdWrite{IssueID: "bv-2", Command: []string{"label", "add"}}, Err: errors.New("locked")}
+	newM, _ = m.Update(DuplicateMarkedMsg{DupID: "bv-2", OfID: "bv-1", Errs: []error{failed}})
+	m = newM.(Model)
+	if slices.Contains(dup.Labels, analysis.DuplicateLabel) || len(dup.Dependencies) != 1 || !m.statusIsError {
+		t.Errorf("rollback: labels %v, deps %v, status %q", dup.Labels, dup.Dependencies, m.statusMsg)
+	}
+}
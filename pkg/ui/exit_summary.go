@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// exitSummaryTopReady is how many ready issues the exit summary lists
+const exitSummaryTopReady = 5
+
+// ExitSummary is a compact plain-text record of what was on screen at quit:
+// the last lens viewed (or the list and its filter when no lens was opened),
+// its status counts and the most urgent ready issues. bv prints it to stdout
+// after the TUI exits when --print-on-exit or print_on_exit is set.
+func (m Model) ExitSummary() string {
+	var title string
+	var issues []model.Issue
+	var ready []model.Issue
+	counts := make(map[string]int)
+
+	if m.lensDashboard.viewMode != "" {
+		d := &m.lensDashboard
+		switch d.viewMode {
+		case "epic":
+			title = fmt.Sprintf("epic %s %s", d.epicID, d.labelName)
+		case "bead":
+			title = "bead " + d.epicID
+		default:
+			title = "label #" + d.labelName
+		}
+		if scope := d.GetScopeLabels(); len(scope) > 0 {
+			title += fmt.Sprintf(" (scope %s: %s)", d.GetScopeMode().ShortString(), strings.Join(scope, ", "))
+		}
+		issues = d.GetAllDisplayIssues()
+		for _, issue := range issues {
+			kind := lensStatusKind(d.getIssueStatus(issue))
+			counts[kind]++
+			if kind == "ready" {
+				ready = append(ready, issue)
+			}
+		}
+	} else {
+		title = "list"
+		if m.currentFilter != "" && m.currentFilter != "all" {
+			title += " [" + m.currentFilter + "]"
+		}
+		if q := m.list.FilterValue(); q != "" {
+			title += fmt.Sprintf(" search %q", q)
+		}
+		for _, item := range m.list.VisibleItems() {
+			if it, ok := item.(IssueItem); ok {
+				issues = append(issues, it.Issue)
+			}
+		}
+		for _, issue := range issues {
+			kind := m.exitStatusKind(issue)
+			counts[kind]++
+			if kind == "ready" {
+				ready = append(ready, issue)
+			}
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "bv · %s · %d issues: %d ready, %d in progress, %d blocked, %d closed",
+		title, len(issues), counts["ready"], counts["in_progress"], counts["blocked"], counts["closed"])
+	if len(issues) > 0 {
+		fmt.Fprintf(&b, " (%d%% done)", counts["closed"]*100/len(issues))
+	}
+	b.WriteString("\n")
+
+	sort.SliceStable(ready, func(i, j int) bool {
+		if ready[i].Priority != ready[j].Priority {
+			return ready[i].Priority < ready[j].Priority
+		}
+		return ready[i].ID < ready[j].ID
+	})
+	if len(ready) > exitSummaryTopReady {
+		ready = ready[:exitSummaryTopReady]
+	}
+	if len(ready) > 0 {
+		b.WriteString("Top ready:\n")
+		for _, issue := range ready {
+			fmt.Fprintf(&b, "  %-12s P%d  %s\n", issue.ID, issue.Priority, truncate(issue.Title, 70))
+		}
+	}
+	return b.String()
+}
+
+// exitStatusKind buckets a list issue as ready, in_progress, blocked or closed,
+// treating open issues with an open blocker as blocked
+func (m Model) exitStatusKind(issue model.Issue) string {
+	switch issue.Status.Column() {
+	case model.StatusClosed:
+		return "closed"
+	case model.StatusInProgress:
+		return "in_progress"
+	case model.StatusBlocked:
+		return "blocked"
+	}
+	for _, dep := range issue.Dependencies {
+		if dep == nil || dep.Type != model.DepBlocks {
+			continue
+		}
+		if blocker, ok := m.issueMap[dep.DependsOnID]; ok && !blocker.Status.IsClosed() {
+			return "blocked"
+		}
+	}
+	return "ready"
+}
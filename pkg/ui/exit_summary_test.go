@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestExitSummary(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Title: "Low priority", Status: model.StatusOpen, Priority: 3, Labels: []string{"api"}},
+		{ID: "b", Title: "Urgent", Status: model.StatusOpen, Priority: 0, Labels: []string{"api"}},
+		{ID: "c", Title: "Working", Status: model.StatusInProgress, Priority: 1, Labels: []string{"api"}},
+		{ID: "d", Title: "Waiting", Status: model.StatusOpen, Priority: 1, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "d", DependsOnID: "c", Type: model.DepBlocks}}},
+		{ID: "e", Title: "Shipped", Status: model.StatusClosed, Priority: 2},
+	}
+
+	// Without a lens the summary covers the list
+	m := NewModel(issues, nil, "")
+	got := m.ExitSummary()
+	if !strings.HasPrefix(got, "bv · list · 5 issues: 2 ready, 1 in progress, 1 blocked, 1 closed (20% done)\n") {
+		t.Errorf("list summary header:\n%s", got)
+	}
+	if !strings.Contains(got, "Top ready:\n  b") || strings.Index(got, "  b ") > strings.Index(got, "  a ") {
+		t.Errorf("ready issues should be listed by priority:\n%s", got)
+	}
+
+	// The last lens viewed wins
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	m.lensDashboard = NewLensDashboardModel("api", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	got = m.ExitSummary()
+	if !strings.HasPrefix(got, "bv · label #api · ") {
+		t.Errorf("lens summary header:\n%s", got)
+	}
+	if strings.Contains(got, "Shipped") {
+		t.Errorf("issue outside the lens listed:\n%s", got)
+	}
+}
@@ -0,0 +1,221 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// diffContext is how many unchanged lines surround each change
+const diffContext = 2
+
+// maxDiffCells caps the LCS table; past it the changed span is shown as
+// removed wholesale and added wholesale
+const maxDiffCells = 1 << 20
+
+// FieldChangesLoadedMsg carries an issue's edits to its text fields, read
+// from the git history of the beads file
+type FieldChangesLoadedMsg struct {
+	IssueID string
+	Events  []correlation.BeadEvent
+	Err     error
+}
+
+// fieldHistory is the loaded (or loading) change history of one issue
+type fieldHistory struct {
+	loading bool
+	events  []correlation.BeadEvent // Only events that edited a text field
+	err     error
+}
+
+// LoadFieldChangesCmd reads the commits that edited issueID's title,
+// description, design, acceptance criteria or notes, oldest first
+func LoadFieldChangesCmd(repoPath, issueID string) tea.Cmd {
+	return func() tea.Msg {
+		events, err := correlation.NewExtractor(repoPath).ExtractForBead(issueID, correlation.ExtractOptions{FieldChanges: true})
+		var edits []correlation.BeadEvent
+		for _, e := range events {
+			if len(e.FieldChanges) > 0 {
+				edits = append(edits, e)
+			}
+		}
+		return FieldChangesLoadedMsg{IssueID: issueID, Events: edits, Err: err}
+	}
+}
+
+// diffLine is one line of a unified diff: ' ' kept, '-' removed, '+' added,
+// or '~' for the gap between two hunks
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// unifiedDiff diffs two texts line by line, keeping diffContext unchanged
+// lines around each change and eliding the rest
+func unifiedDiff(oldText, newText string) []diffLine {
+	a, b := splitDiffLines(oldText), splitDiffLines(newText)
+
+	// Shared head and tail lines need no table
+	var all []diffLine
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		all = append(all, diffLine{' ', a[0]})
+		a, b = a[1:], b[1:]
+	}
+	var tail []diffLine
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		tail = append([]diffLine{{' ', a[len(a)-1]}}, tail...)
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+	all = append(all, middleDiff(a, b)...)
+	all = append(all, tail...)
+
+	// Removals read better ahead of the additions that replace them
+	for k := 1; k < len(all); k++ {
+		for n := k; n > 0 && all[n].op == '-' && all[n-1].op == '+'; n-- {
+			all[n], all[n-1] = all[n-1], all[n]
+		}
+	}
+
+	keep := make([]bool, len(all))
+	for k, l := range all {
+		if l.op == ' ' {
+			continue
+		}
+		for n := max(0, k-diffContext); n <= min(len(all)-1, k+diffContext); n++ {
+			keep[n] = true
+		}
+	}
+	var out []diffLine
+	for k, l := range all {
+		if !keep[k] {
+			continue
+		}
+		if len(out) > 0 && !keep[k-1] {
+			out = append(out, diffLine{op: '~'})
+		}
+		out = append(out, l)
+	}
+	return out
+}
+
+// middleDiff aligns a and b by their longest common subsequence, or
+// replaces a with b outright when the table would exceed maxDiffCells
+func middleDiff(a, b []string) []diffLine {
+	var all []diffLine
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, l := range a {
+			all = append(all, diffLine{'-', l})
+		}
+		for _, l := range b {
+			all = append(all, diffLine{'+', l})
+		}
+		return all
+	}
+
+	// Longest common subsequence, suffix table
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			all = append(all, diffLine{' ', a[i]})
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			all = append(all, diffLine{'+', b[j]})
+			j++
+		default:
+			all = append(all, diffLine{'-', a[i]})
+			i++
+		}
+	}
+	return all
+}
+
+func splitDiffLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// fieldChangeLines renders the edits to an issue's text fields, oldest
+// first, each field as a colored unified diff
+func (m *ReviewDashboardModel) fieldChangeLines(issue *model.Issue, width int) []string {
+	sectionStyle := m.theme.Renderer.NewStyle().Bold(true)
+	metaStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
+
+	h := m.fieldChanges[issue.ID]
+	switch {
+	case h == nil || h.loading:
+		return []string{sectionStyle.Render("Changes:"), metaStyle.Render("Reading git history…")}
+	case h.err != nil:
+		return []string{sectionStyle.Render("Changes:"), metaStyle.Render("Unavailable: " + h.err.Error())}
+	case len(h.events) == 0:
+		return []string{sectionStyle.Render("Changes:"), metaStyle.Render("No edits in git history")}
+	}
+
+	removed := m.theme.Renderer.NewStyle().Foreground(ColorDanger)
+	added := m.theme.Renderer.NewStyle().Foreground(ColorSuccess)
+	kept := metaStyle.Faint(true)
+	lines := []string{sectionStyle.Render(fmt.Sprintf("Changes (%d):", len(h.events)))}
+	for _, e := range h.events {
+		sha := e.CommitSHA
+		if len(sha) > 7 {
+			sha = sha[:7]
+		}
+		lines = append(lines, metaStyle.Render(fmt.Sprintf("● %s %s · %s", e.Timestamp.Format("2006-01-02 15:04"), e.Author, sha)))
+		for _, fc := range e.FieldChanges {
+			lines = append(lines, metaStyle.Bold(true).Render("  "+strings.ReplaceAll(fc.Field, "_", " ")))
+			for _, dl := range unifiedDiff(fc.Old, fc.New) {
+				if dl.op == '~' {
+					lines = append(lines, kept.Render("  ⋯"))
+					continue
+				}
+				style := kept
+				switch dl.op {
+				case '-':
+					style = removed
+				case '+':
+					style = added
+				}
+				for _, wl := range wrapTextLines(dl.text, width-6) {
+					lines = append(lines, style.Render("  "+string(dl.op)+" "+wl))
+				}
+			}
+		}
+	}
+	return lines
+}
+
+// fieldChangesCmd starts loading the selected issue's change history when
+// the changes section is open and it has not been read yet
+func (m *ReviewDashboardModel) fieldChangesCmd() tea.Cmd {
+	issue := m.SelectedIssue()
+	if !m.showChanges || issue == nil || m.fieldChanges[issue.ID] != nil {
+		return nil
+	}
+	m.fieldChanges[issue.ID] = &fieldHistory{loading: true}
+	return LoadFieldChangesCmd(m.workspaceRoot, issue.ID)
+}
+
+// SetFieldChanges stores a loaded change history
+func (m *ReviewDashboardModel) SetFieldChanges(msg FieldChangesLoadedMsg) {
+	m.fieldChanges[msg.IssueID] = &fieldHistory{events: msg.Events, err: msg.Err}
+}
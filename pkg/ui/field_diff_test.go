@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	oldText := "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight"
+	newText := "one\n2\nthree\nfour\nfive\nsix\nseven\neight\nnine"
+
+	var got []string
+	for _, l := range unifiedDiff(oldText, newText) {
+		got = append(got, string(l.op)+l.text)
+	}
+	want := []string{" one", "-two", "+2", " three", " four", "~", " seven", " eight", "+nine"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("diff = %q, want %q", got, want)
+	}
+
+	if d := unifiedDiff("", "new"); len(d) != 1 || d[0].op != '+' {
+		t.Errorf("added field = %+v", d)
+	}
+
+	// Past the LCS cap the changed span is replaced outright, keeping the
+	// shared head and tail as context
+	var huge, other []string
+	for i := 0; i < 1100; i++ {
+		huge = append(huge, fmt.Sprintf("a%d", i))
+		other = append(other, fmt.Sprintf("b%d", i))
+	}
+	d := unifiedDiff("head\n"+strings.Join(huge, "\n")+"\ntail", "head\n"+strings.Join(other, "\n")+"\ntail")
+	if len(d) != 2+2*1100 || d[0].text != "head" || d[1].text != "a0" || d[1100].text != "a1099" || d[1101].text != "b0" || d[len(d)-1].text != "tail" {
+		t.Errorf("capped diff: %d lines, starting %+v", len(d), d[:3])
+	}
+}
+
+func TestReviewDashboardFieldChanges(t *testing.T) {
+	m := newTestReviewDashboard(t)
+
+	m, cmd := m.Update(keyMsg("c"))
+	if cmd == nil {
+		t.Fatal("c should start reading the selected issue's history")
+	}
+	if panel := stripAnsi(m.renderDetailPanelFixed(60, 40)); !strings.Contains(panel, "Reading git history…") {
+		t.Errorf("expected loading line:\n%s", panel)
+	}
+	// Already loading: moving back to it doesn't read again
+	m = pressReview(m, "j")
+	if _, cmd = m.Update(keyMsg("k")); cmd != nil {
+		t.Error("history is read once per issue")
+	}
+
+	m, _ = m.Update(FieldChangesLoadedMsg{IssueID: "EPIC", Events: []correlation.BeadEvent{{
+		Timestamp: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC),
+		Author:    "agent",
+		CommitSHA: "0123456789abcdef",
+		FieldChanges: []correlation.FieldChange{
+			{Field: "acceptance_criteria", Old: "Tokens expire", New: "Tokens expire after an hour"},
+		},
+	}}})
+	panel := stripAnsi(m.renderDetailPanelFixed(60, 40))
+	for _, want := range []string{
+		"Changes (1):",
+		"● 2026-03-01 09:00 agent · 0123456",
+		"acceptance criteria",
+		"- Tokens expire",
+		"+ Tokens expire after an hour",
+	} {
+		if !strings.Contains(panel, want) {
+			t.Errorf("changes missing %q:\n%s", want, panel)
+		}
+	}
+
+	// c again hides the section
+	m = pressReview(m, "c")
+	if panel := stripAnsi(m.renderDetailPanelFixed(60, 40)); strings.Contains(panel, "Changes") {
+		t.Errorf("changes still shown:\n%s", panel)
+	}
+}
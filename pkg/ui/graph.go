@@ -29,6 +29,9 @@ type GraphModel struct {
 	// Flat list for navigation
 	sortedIDs []string
 
+	// Archaeology mode: dim closed nodes and show their closure dates
+	archaeology bool
+
 	// Precomputed rankings for all metrics (id -> rank, 1-indexed)
 	rankPageRank     map[string]int
 	rankBetweenness  map[string]int
@@ -85,6 +88,16 @@ func (g *GraphModel) SetIssues(issues []model.Issue, insights *analysis.Insights
 	}
 }
 
+// SetArchaeologyMode toggles dimmed rendering of closed nodes with closure dates
+func (g *GraphModel) SetArchaeologyMode(on bool) {
+	g.archaeology = on
+}
+
+// isArchaeologyClosed reports whether an issue should get archaeology styling
+func (g *GraphModel) isArchaeologyClosed(issue *model.Issue) bool {
+	return g.archaeology && issue != nil && issue.Status.IsClosed()
+}
+
 func (g *GraphModel) rebuildGraph() {
 	size := len(g.issues)
 	g.issueMap = make(map[string]*model.Issue, size)
@@ -359,7 +372,7 @@ func (g *GraphModel) renderNodeList(width, height int, t Theme) string {
 		isSelected := i == g.selectedIdx
 		statusIcon := getStatusIcon(issue.Status)
 		maxIDLen := width - 4
-		displayID := smartTruncateID(id, maxIDLen)
+		displayID := smartTruncateID(t.ID(id), maxIDLen)
 		line := fmt.Sprintf("%s %s", statusIcon, displayID)
 
 		var style lipgloss.Style
@@ -373,6 +386,9 @@ func (g *GraphModel) renderNodeList(width, height int, t Theme) string {
 			style = t.Renderer.NewStyle().
 				Foreground(getStatusColor(issue.Status, t)).
 				Width(width)
+			if g.isArchaeologyClosed(issue) {
+				style = style.Faint(true)
+			}
 		}
 		lines = append(lines, style.Render(line))
 	}
@@ -550,14 +566,14 @@ func (g *GraphModel) renderNodeBox(id string, boxWidth int, t Theme, isEgo bool)
 	if issue != nil {
 		statusIcon = getStatusIcon(issue.Status)
 		statusColor = getStatusColor(issue.Status, t)
-		displayID = smartTruncateID(id, boxWidth-4)
+		displayID = smartTruncateID(t.ID(id), boxWidth-4)
 		if issue.Title != "" {
 			title = truncateRunesHelper(issue.Title, boxWidth-4, "…")
 		}
 	} else {
 		statusIcon = "❓"
 		statusColor = t.Secondary
-		displayID = smartTruncateID(id, boxWidth-4)
+		displayID = smartTruncateID(t.ID(id), boxWidth-4)
 		title = "(not in filter)"
 	}
 
@@ -589,6 +605,14 @@ func (g *GraphModel) renderNodeBox(id string, boxWidth int, t Theme, isEgo bool)
 	if title != "" && boxWidth > 14 {
 		content = line1 + "\n" + title
 	}
+	if g.isArchaeologyClosed(issue) {
+		if date := FormatClosureDate(*issue); date != "" {
+			content += "\n✓ " + date
+		}
+		if !isEgo {
+			boxStyle = boxStyle.Faint(true)
+		}
+	}
 
 	return boxStyle.Render(content)
 }
@@ -615,7 +639,7 @@ func (g *GraphModel) renderEgoNode(id string, issue *model.Issue, width int, t T
 	}
 
 	icons := fmt.Sprintf("%s %s %s", statusIcon, prioIcon, typeIcon)
-	displayID := smartTruncateID(id, egoWidth-4)
+	displayID := smartTruncateID(t.ID(id), egoWidth-4)
 	title := ""
 	if issue.Title != "" {
 		title = truncateRunesHelper(issue.Title, egoWidth-4, "…")
@@ -630,6 +654,11 @@ func (g *GraphModel) renderEgoNode(id string, issue *model.Issue, width int, t T
 	blockerCount := len(g.blockers[id])
 	dependentCount := len(g.dependents[id])
 	content += fmt.Sprintf("\n⬆%d  ⬇%d", blockerCount, dependentCount)
+	if g.isArchaeologyClosed(issue) {
+		if date := FormatClosureDate(*issue); date != "" {
+			content += "  ✓ " + date
+		}
+	}
 
 	egoStyle := t.Renderer.NewStyle().
 		Border(lipgloss.DoubleBorder()).
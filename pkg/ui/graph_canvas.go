@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui/graphview"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// graphCanvasPalette maps the theme onto the canvas colors.
+func (m *Model) graphCanvasPalette() graphview.Palette {
+	t := m.theme
+	return graphview.Palette{
+		Renderer:   t.Renderer,
+		Primary:    t.Primary,
+		Edge:       t.Secondary,
+		Text:       t.Base.GetForeground(),
+		Muted:      t.Muted,
+		Highlight:  t.Highlight,
+		Open:       t.Open,
+		InProgress: t.InProgress,
+		Blocked:    t.Blocked,
+		Closed:     t.Closed,
+	}
+}
+
+// openGraphCanvas shows the layered canvas for the issues currently in the
+// graph view, starting on the graph view's selection.
+func (m *Model) openGraphCanvas() {
+	m.graphCanvas = graphview.New(m.graphView.issues, m.graphCanvasPalette())
+	if selected := m.graphView.SelectedIssue(); selected != nil {
+		m.graphCanvas.Select(selected.ID)
+	}
+	m.graphCanvas.SetSize(m.width, m.height-1)
+	m.showGraphCanvas = true
+	m.focused = focusGraphCanvas
+}
+
+// closeGraphCanvas returns to the graph view, keeping the canvas selection.
+func (m *Model) closeGraphCanvas() {
+	m.showGraphCanvas = false
+	m.focused = focusGraph
+	if id := m.graphCanvas.SelectedID(); id != "" {
+		m.graphView.SelectByID(id)
+	}
+}
+
+// syncGraphCanvas rebuilds an open canvas after the graph view's issues change.
+func (m *Model) syncGraphCanvas() {
+	if m.showGraphCanvas {
+		m.graphCanvas.SetIssues(m.graphView.issues)
+	}
+}
+
+// handleGraphCanvasKeys handles keyboard input when the graph canvas is focused
+func (m Model) handleGraphCanvasKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "esc", "q", "c":
+		m.closeGraphCanvas()
+		return m
+	}
+	if id := m.graphCanvas.HandleKey(msg); id != "" {
+		// Jump to the issue's detail the same way the graph view does
+		m.closeGraphCanvas()
+		m = m.handleGraphKeys(msg)
+	}
+	return m
+}
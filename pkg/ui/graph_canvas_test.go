@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestGraphCanvasOpenPanAndJump(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "B", DependsOnID: "A", Type: model.DepBlocks}}},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 30})
+	m = updated.(Model)
+
+	for _, k := range []string{"g", "c"} {
+		updated, _ = m.Update(keyMsg(k))
+		m = updated.(Model)
+	}
+	if !m.showGraphCanvas || m.focused != focusGraphCanvas {
+		t.Fatalf("c in the graph view should open the canvas (focus=%v)", m.focused)
+	}
+	if view := m.View(); !strings.Contains(view, "Dependency graph") || !strings.Contains(view, "▼") {
+		t.Errorf("canvas view not rendered:\n%s", view)
+	}
+
+	// h and l pan the canvas instead of opening history or the label picker
+	for _, k := range []string{"h", "l", "j", "k"} {
+		updated, _ = m.Update(keyMsg(k))
+		m = updated.(Model)
+	}
+	if m.focused != focusGraphCanvas || m.isHistoryView || m.showLabelPicker {
+		t.Fatalf("hjkl should stay in the canvas (focus=%v)", m.focused)
+	}
+
+	m.graphCanvas.Select("B")
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.showGraphCanvas || m.isGraphView {
+		t.Fatal("enter should leave the graph views")
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != "B" {
+		t.Errorf("enter should select B in the list, got %+v", m.list.SelectedItem())
+	}
+
+	// esc from the canvas returns to the graph view
+	for _, k := range []string{"g", "c"} {
+		updated, _ = m.Update(keyMsg(k))
+		m = updated.(Model)
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.showGraphCanvas || !m.isGraphView || m.focused != focusGraph {
+		t.Errorf("esc should return to the graph view (focus=%v)", m.focused)
+	}
+}
@@ -2,7 +2,9 @@ package ui_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
@@ -885,3 +887,22 @@ func TestGraphModelReferenceToSelf(t *testing.T) {
 		t.Error("Expected non-empty view")
 	}
 }
+
+// TestGraphModelArchaeologyShowsClosureDate verifies closed nodes carry their closure date
+func TestGraphModelArchaeologyShowsClosureDate(t *testing.T) {
+	theme := createTheme()
+	closedAt := time.Date(2025, 3, 14, 12, 0, 0, 0, time.Local)
+	issues := []model.Issue{
+		{ID: "A", Title: "Done", Status: model.StatusClosed, ClosedAt: &closedAt},
+	}
+
+	g := ui.NewGraphModel(issues, nil, theme)
+	if strings.Contains(g.View(120, 30), "2025-03-14") {
+		t.Error("closure date should only appear in archaeology mode")
+	}
+
+	g.SetArchaeologyMode(true)
+	if !strings.Contains(g.View(120, 30), "✓ 2025-03-14") {
+		t.Error("expected closure date in archaeology mode")
+	}
+}
@@ -0,0 +1,166 @@
+package graphview
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Line directions, OR-ed per cell so crossing and merging edges pick the
+// right box-drawing junction.
+const (
+	lineUp uint8 = 1 << iota
+	lineDown
+	lineLeft
+	lineRight
+)
+
+var lineRunes = map[uint8]rune{
+	lineUp:                                   '│',
+	lineDown:                                 '│',
+	lineUp | lineDown:                        '│',
+	lineLeft:                                 '─',
+	lineRight:                                '─',
+	lineLeft | lineRight:                     '─',
+	lineDown | lineRight:                     '┌',
+	lineDown | lineLeft:                      '┐',
+	lineUp | lineRight:                       '└',
+	lineUp | lineLeft:                        '┘',
+	lineUp | lineDown | lineRight:            '├',
+	lineUp | lineDown | lineLeft:             '┤',
+	lineLeft | lineRight | lineDown:          '┬',
+	lineLeft | lineRight | lineUp:            '┴',
+	lineUp | lineDown | lineLeft | lineRight: '┼',
+}
+
+// cellStyle indexes the styles a canvas cell can be drawn with.
+type cellStyle uint8
+
+const (
+	styleNone cellStyle = iota
+	styleEdge
+	styleEdgeHighlight
+	styleText
+	styleTextSelected
+	styleMuted
+	styleOpen
+	styleInProgress
+	styleBlocked
+	styleClosed
+	styleSelected
+	styleCount
+)
+
+type cell struct {
+	r     rune  // Explicit glyph; wins over lines
+	lines uint8 // Line directions when r is zero
+	style cellStyle
+}
+
+// canvas is a clipped character grid. Callers draw in canvas coordinates;
+// only cells inside the window [x0, x0+w) × [y0, y0+h) are kept.
+type canvas struct {
+	x0, y0 int
+	w, h   int
+	cells  []cell
+}
+
+func newCanvas(x0, y0, w, h int) *canvas {
+	if w < 0 {
+		w = 0
+	}
+	if h < 0 {
+		h = 0
+	}
+	return &canvas{x0: x0, y0: y0, w: w, h: h, cells: make([]cell, w*h)}
+}
+
+func (c *canvas) at(x, y int) *cell {
+	x -= c.x0
+	y -= c.y0
+	if x < 0 || y < 0 || x >= c.w || y >= c.h {
+		return nil
+	}
+	return &c.cells[y*c.w+x]
+}
+
+// line adds line directions to a cell. Highlighted edges keep their style
+// where they cross plain ones.
+func (c *canvas) line(x, y int, dirs uint8, style cellStyle) {
+	if cl := c.at(x, y); cl != nil && cl.r == 0 {
+		cl.lines |= dirs
+		if cl.style != styleEdgeHighlight {
+			cl.style = style
+		}
+	}
+}
+
+// hline draws a horizontal run between x1 and x2 (inclusive) on row y,
+// without the end caps; callers add the corners.
+func (c *canvas) hline(x1, x2, y int, style cellStyle) {
+	if x1 > x2 {
+		x1, x2 = x2, x1
+	}
+	for x := x1 + 1; x < x2; x++ {
+		c.line(x, y, lineLeft|lineRight, style)
+	}
+}
+
+func (c *canvas) put(x, y int, r rune, style cellStyle) {
+	if cl := c.at(x, y); cl != nil {
+		cl.r = r
+		cl.lines = 0
+		cl.style = style
+	}
+}
+
+func (c *canvas) text(x, y int, s string, style cellStyle) {
+	for _, r := range s {
+		c.put(x, y, r, style)
+		x++
+	}
+}
+
+// render turns the grid into styled lines, batching runs of equal style.
+func (c *canvas) render(styles [styleCount]lipgloss.Style) string {
+	var b strings.Builder
+	var run strings.Builder
+	for y := 0; y < c.h; y++ {
+		if y > 0 {
+			b.WriteByte('\n')
+		}
+		current := styleNone
+		flush := func() {
+			if run.Len() == 0 {
+				return
+			}
+			if current == styleNone {
+				b.WriteString(run.String())
+			} else {
+				b.WriteString(styles[current].Render(run.String()))
+			}
+			run.Reset()
+		}
+		for x := 0; x < c.w; x++ {
+			cl := c.cells[y*c.w+x]
+			r := cl.r
+			if r == 0 {
+				r = lineRunes[cl.lines]
+			}
+			if r == 0 {
+				r = ' '
+			}
+			style := cl.style
+			if r == ' ' {
+				style = styleNone
+			}
+			if style != current {
+				flush()
+				current = style
+			}
+			run.WriteRune(r)
+		}
+		flush()
+	}
+	return b.String()
+}
@@ -0,0 +1,584 @@
+// Package graphview renders the blocking-dependency graph as a 2D canvas of
+// nodes and edges, laid out in layers by analysis.LayeredLayout. It supports
+// panning, three zoom levels, and spatial navigation between nodes.
+package graphview
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// edgeGap is the number of rows between layers reserved for edge routing.
+const edgeGap = 3
+
+// Zoom selects how much of each node is drawn.
+type Zoom int
+
+const (
+	ZoomDots     Zoom = iota // One glyph per node, for the shape of large graphs
+	ZoomCompact              // Boxed IDs
+	ZoomDetailed             // Boxed IDs with titles
+)
+
+func (z Zoom) String() string {
+	switch z {
+	case ZoomDots:
+		return "dots"
+	case ZoomDetailed:
+		return "detailed"
+	default:
+		return "compact"
+	}
+}
+
+// Palette holds the colors the canvas draws with. The ui package fills it
+// from its theme, which keeps this package free of a dependency on ui.
+type Palette struct {
+	Renderer   *lipgloss.Renderer
+	Primary    lipgloss.AdaptiveColor // Selected node and its edges
+	Edge       lipgloss.AdaptiveColor
+	Text       lipgloss.TerminalColor // Body text; nil uses the terminal default
+	Muted      lipgloss.AdaptiveColor
+	Highlight  lipgloss.AdaptiveColor // Background of the selected node's label
+	Open       lipgloss.AdaptiveColor
+	InProgress lipgloss.AdaptiveColor
+	Blocked    lipgloss.AdaptiveColor
+	Closed     lipgloss.AdaptiveColor
+}
+
+// Model is the graph canvas state.
+type Model struct {
+	issues   map[string]model.Issue
+	layout   *analysis.GraphLayout
+	order    []int // Real node indexes in reading order (layer, then position)
+	blocks   map[string]int
+	blockers map[string]int
+	widest   int // Nodes in the widest layer
+	maxIDLen int
+
+	palette Palette
+	styles  [styleCount]lipgloss.Style
+
+	zoom     Zoom
+	selected int // Node index into layout.Nodes, -1 when empty
+	offsetX  int
+	offsetY  int
+	width    int
+	height   int
+}
+
+// New builds a canvas for issues. The first node in reading order starts selected.
+func New(issues []model.Issue, palette Palette) Model {
+	m := Model{palette: palette, zoom: ZoomCompact, selected: -1}
+	m.buildStyles()
+	m.SetIssues(issues)
+	return m
+}
+
+func (m *Model) buildStyles() {
+	r := m.palette.Renderer
+	if r == nil {
+		r = lipgloss.DefaultRenderer()
+		m.palette.Renderer = r
+	}
+	p := m.palette
+	m.styles[styleEdge] = r.NewStyle().Foreground(p.Edge)
+	m.styles[styleEdgeHighlight] = r.NewStyle().Foreground(p.Primary).Bold(true)
+	m.styles[styleText] = r.NewStyle()
+	m.styles[styleTextSelected] = r.NewStyle().Background(p.Highlight).Bold(true)
+	if p.Text != nil {
+		m.styles[styleText] = m.styles[styleText].Foreground(p.Text)
+		m.styles[styleTextSelected] = m.styles[styleTextSelected].Foreground(p.Text)
+	}
+	m.styles[styleMuted] = r.NewStyle().Foreground(p.Muted)
+	m.styles[styleOpen] = r.NewStyle().Foreground(p.Open)
+	m.styles[styleInProgress] = r.NewStyle().Foreground(p.InProgress)
+	m.styles[styleBlocked] = r.NewStyle().Foreground(p.Blocked)
+	m.styles[styleClosed] = r.NewStyle().Foreground(p.Closed)
+	m.styles[styleSelected] = r.NewStyle().Foreground(p.Primary).Bold(true)
+}
+
+// SetIssues replaces the graph, keeping the selection when the issue survives.
+func (m *Model) SetIssues(issues []model.Issue) {
+	prev := m.SelectedID()
+
+	m.issues = make(map[string]model.Issue, len(issues))
+	for _, issue := range issues {
+		m.issues[issue.ID] = issue
+	}
+	m.layout = analysis.LayeredLayout(issues)
+
+	m.blocks = make(map[string]int)
+	m.blockers = make(map[string]int)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if _, ok := m.issues[dep.DependsOnID]; ok && dep.DependsOnID != issue.ID {
+				m.blockers[issue.ID]++
+				m.blocks[dep.DependsOnID]++
+			}
+		}
+	}
+
+	m.widest, m.maxIDLen = 0, 0
+	for _, layer := range m.layout.Layers {
+		if len(layer) > m.widest {
+			m.widest = len(layer)
+		}
+	}
+	m.order = make([]int, 0, len(m.layout.Nodes))
+	for i, n := range m.layout.Nodes {
+		if n.Virtual {
+			continue
+		}
+		m.order = append(m.order, i)
+		if l := len([]rune(n.ID)); l > m.maxIDLen {
+			m.maxIDLen = l
+		}
+	}
+	sort.Slice(m.order, func(i, j int) bool {
+		a, b := m.layout.Nodes[m.order[i]], m.layout.Nodes[m.order[j]]
+		if a.Layer != b.Layer {
+			return a.Layer < b.Layer
+		}
+		return a.Pos < b.Pos
+	})
+
+	m.selected = -1
+	if !m.Select(prev) && len(m.order) > 0 {
+		m.selected = m.order[0]
+		m.centerOnSelection()
+	}
+}
+
+// SetSize sets the space available to View, including header and status lines.
+func (m *Model) SetSize(width, height int) {
+	m.width, m.height = width, height
+	m.clampOffsets()
+}
+
+// SelectedID returns the ID of the selected issue, or "" when the graph is empty.
+func (m Model) SelectedID() string {
+	if m.layout == nil || m.selected < 0 || m.selected >= len(m.layout.Nodes) {
+		return ""
+	}
+	return m.layout.Nodes[m.selected].ID
+}
+
+// Select moves the selection to id and scrolls it into view.
+func (m *Model) Select(id string) bool {
+	if id == "" {
+		return false
+	}
+	idx, ok := m.layout.NodeIndex(id)
+	if !ok {
+		return false
+	}
+	m.selected = idx
+	m.centerOnSelection()
+	return true
+}
+
+// Zoom returns the current zoom level.
+func (m Model) Zoom() Zoom { return m.zoom }
+
+// HandleKey applies a key press. It returns the selected issue ID when the
+// user asks to open it (enter), and "" otherwise.
+func (m *Model) HandleKey(msg tea.KeyMsg) string {
+	g := m.geometry()
+	vw, vh := m.viewSize()
+	switch msg.String() {
+	case "h":
+		m.offsetX -= max(g.slotW, vw/4)
+	case "l":
+		m.offsetX += max(g.slotW, vw/4)
+	case "k":
+		m.offsetY -= max(g.rowH, vh/4)
+	case "j":
+		m.offsetY += max(g.rowH, vh/4)
+	case "left":
+		m.moveWithinLayer(-1)
+	case "right":
+		m.moveWithinLayer(1)
+	case "up":
+		m.moveLayer(-1)
+	case "down":
+		m.moveLayer(1)
+	case "tab":
+		m.cycle(1)
+	case "shift+tab":
+		m.cycle(-1)
+	case "+", "=":
+		if m.zoom < ZoomDetailed {
+			m.zoom++
+			m.centerOnSelection()
+		}
+	case "-", "_":
+		if m.zoom > ZoomDots {
+			m.zoom--
+			m.centerOnSelection()
+		}
+	case ".":
+		m.centerOnSelection()
+	case "enter":
+		return m.SelectedID()
+	}
+	m.clampOffsets()
+	return ""
+}
+
+// === Geometry ===
+
+type geometry struct {
+	boxW, boxH int
+	slotW      int // Horizontal distance between node origins
+	rowH       int // Vertical distance between layer origins
+}
+
+func (m Model) geometry() geometry {
+	var g geometry
+	switch m.zoom {
+	case ZoomDots:
+		g = geometry{boxW: 1, boxH: 1, slotW: 3}
+	case ZoomDetailed:
+		g = geometry{boxW: 26, boxH: 4, slotW: 28}
+	default:
+		w := min(max(m.maxIDLen+6, 10), 20)
+		g = geometry{boxW: w, boxH: 3, slotW: w + 2}
+	}
+	g.rowH = g.boxH + edgeGap
+	return g
+}
+
+// origin returns the top-left canvas cell of node idx. Layers are centered
+// against the widest one.
+func (m Model) origin(idx int, g geometry) (int, int) {
+	n := m.layout.Nodes[idx]
+	indent := (m.widest - len(m.layout.Layers[n.Layer])) * g.slotW / 2
+	return indent + n.Pos*g.slotW, n.Layer * g.rowH
+}
+
+func (m Model) center(idx int, g geometry) int {
+	x, _ := m.origin(idx, g)
+	return x + g.boxW/2
+}
+
+func (m Model) canvasSize(g geometry) (int, int) {
+	if len(m.layout.Layers) == 0 {
+		return 0, 0
+	}
+	return m.widest*g.slotW - (g.slotW - g.boxW), len(m.layout.Layers)*g.rowH - edgeGap
+}
+
+// viewSize is the canvas area: everything but the header and status lines.
+func (m Model) viewSize() (int, int) {
+	return max(m.width, 0), max(m.height-2, 0)
+}
+
+func (m *Model) clampOffsets() {
+	g := m.geometry()
+	cw, ch := m.canvasSize(g)
+	vw, vh := m.viewSize()
+	if cw <= vw {
+		m.offsetX = -(vw - cw) / 2
+	} else {
+		m.offsetX = min(max(m.offsetX, 0), cw-vw)
+	}
+	if ch <= vh {
+		m.offsetY = 0
+	} else {
+		m.offsetY = min(max(m.offsetY, 0), ch-vh)
+	}
+}
+
+func (m *Model) centerOnSelection() {
+	if m.selected < 0 {
+		return
+	}
+	g := m.geometry()
+	x, y := m.origin(m.selected, g)
+	vw, vh := m.viewSize()
+	m.offsetX = x + g.boxW/2 - vw/2
+	m.offsetY = y + g.boxH/2 - vh/2
+	m.clampOffsets()
+}
+
+// ensureVisible scrolls the minimum needed to show the selected node.
+func (m *Model) ensureVisible() {
+	if m.selected < 0 {
+		return
+	}
+	g := m.geometry()
+	x, y := m.origin(m.selected, g)
+	vw, vh := m.viewSize()
+	if x < m.offsetX {
+		m.offsetX = x - 1
+	} else if x+g.boxW > m.offsetX+vw {
+		m.offsetX = x + g.boxW - vw + 1
+	}
+	if y < m.offsetY {
+		m.offsetY = y
+	} else if y+g.boxH > m.offsetY+vh {
+		m.offsetY = y + g.boxH - vh
+	}
+	m.clampOffsets()
+}
+
+// === Navigation ===
+
+func (m *Model) moveWithinLayer(dir int) {
+	if m.selected < 0 {
+		return
+	}
+	n := m.layout.Nodes[m.selected]
+	layer := m.layout.Layers[n.Layer]
+	for p := n.Pos + dir; p >= 0 && p < len(layer); p += dir {
+		if !m.layout.Nodes[layer[p]].Virtual {
+			m.selected = layer[p]
+			m.ensureVisible()
+			return
+		}
+	}
+}
+
+// moveLayer selects the horizontally closest node in the next layer up or
+// down that has any real nodes.
+func (m *Model) moveLayer(dir int) {
+	if m.selected < 0 {
+		return
+	}
+	g := m.geometry()
+	cx := m.center(m.selected, g)
+	for l := m.layout.Nodes[m.selected].Layer + dir; l >= 0 && l < len(m.layout.Layers); l += dir {
+		best, bestDist := -1, 0
+		for _, idx := range m.layout.Layers[l] {
+			if m.layout.Nodes[idx].Virtual {
+				continue
+			}
+			d := m.center(idx, g) - cx
+			if d < 0 {
+				d = -d
+			}
+			if best < 0 || d < bestDist {
+				best, bestDist = idx, d
+			}
+		}
+		if best >= 0 {
+			m.selected = best
+			m.ensureVisible()
+			return
+		}
+	}
+}
+
+func (m *Model) cycle(dir int) {
+	if len(m.order) == 0 {
+		return
+	}
+	i := 0
+	for k, idx := range m.order {
+		if idx == m.selected {
+			i = k
+			break
+		}
+	}
+	i = (i + dir + len(m.order)) % len(m.order)
+	m.selected = m.order[i]
+	m.ensureVisible()
+}
+
+// === Rendering ===
+
+// View renders the header, the visible part of the canvas, and a status line
+// describing the selected issue.
+func (m Model) View() string {
+	r := m.palette.Renderer
+	vw, vh := m.viewSize()
+	header := r.NewStyle().Foreground(m.palette.Primary).Bold(true).Render("Dependency graph") +
+		m.styles[styleMuted].Render(fmt.Sprintf("  %d issues · %d layers · zoom: %s   ←↑↓→ select · hjkl pan · +/- zoom · . center · ⏎ open · esc back",
+			len(m.order), len(m.layout.Layers), m.zoom))
+
+	if len(m.order) == 0 {
+		empty := r.NewStyle().Width(vw).Height(vh).Align(lipgloss.Center, lipgloss.Center).
+			Foreground(m.palette.Muted).Render("No issues to display")
+		return lipgloss.JoinVertical(lipgloss.Left, r.NewStyle().MaxWidth(m.width).Render(header), empty, "")
+	}
+
+	c := newCanvas(m.offsetX, m.offsetY, vw, vh)
+	m.draw(c)
+	return lipgloss.JoinVertical(lipgloss.Left,
+		r.NewStyle().MaxWidth(m.width).Render(header),
+		c.render(m.styles),
+		r.NewStyle().MaxWidth(m.width).Render(m.statusLine()))
+}
+
+func (m Model) statusLine() string {
+	issue, ok := m.issues[m.SelectedID()]
+	if !ok {
+		return ""
+	}
+	return m.styles[styleSelected].Render(issue.ID) + m.styles[styleMuted].Render(fmt.Sprintf(
+		"  %s · P%d · blocked by %d · blocks %d  ", issue.Status, issue.Priority,
+		m.blockers[issue.ID], m.blocks[issue.ID])) + m.styles[styleText].Render(issue.Title)
+}
+
+func (m Model) draw(c *canvas) {
+	g := m.geometry()
+	selID := m.SelectedID()
+
+	// Virtual nodes touched by a highlighted edge keep the highlight
+	hiVirtual := make(map[int]bool)
+	for _, e := range m.layout.Edges {
+		if e.Source == selID || e.Target == selID {
+			hiVirtual[e.From] = true
+			hiVirtual[e.To] = true
+		}
+	}
+
+	for _, e := range m.layout.Edges {
+		style := styleEdge
+		if e.Source == selID || e.Target == selID {
+			style = styleEdgeHighlight
+		}
+		x1, x2 := m.center(e.From, g), m.center(e.To, g)
+		_, y := m.origin(e.From, g)
+		y += g.boxH
+
+		c.line(x1, y, lineUp|lineDown, style)
+		switch {
+		case x1 == x2:
+			c.line(x1, y+1, lineUp|lineDown, style)
+		case x1 < x2:
+			c.line(x1, y+1, lineUp|lineRight, style)
+			c.hline(x1, x2, y+1, style)
+			c.line(x2, y+1, lineDown|lineLeft, style)
+		default:
+			c.line(x1, y+1, lineUp|lineLeft, style)
+			c.hline(x1, x2, y+1, style)
+			c.line(x2, y+1, lineDown|lineRight, style)
+		}
+		c.line(x2, y+2, lineUp|lineDown, style)
+
+		// Arrows point from blocker to blocked
+		if e.Reversed {
+			if !m.layout.Nodes[e.From].Virtual {
+				c.put(x1, y, '▲', style)
+			}
+		} else if !m.layout.Nodes[e.To].Virtual {
+			c.put(x2, y+2, '▼', style)
+		}
+	}
+
+	for idx, n := range m.layout.Nodes {
+		x, y := m.origin(idx, g)
+		if n.Virtual {
+			style := styleEdge
+			if hiVirtual[idx] {
+				style = styleEdgeHighlight
+			}
+			for dy := 0; dy < g.boxH; dy++ {
+				c.line(x+g.boxW/2, y+dy, lineUp|lineDown, style)
+			}
+			continue
+		}
+		m.drawNode(c, idx, x, y, g, n.ID == selID)
+	}
+}
+
+func (m Model) drawNode(c *canvas, idx, x, y int, g geometry, selected bool) {
+	issue := m.issues[m.layout.Nodes[idx].ID]
+	status := statusStyle(issue.Status)
+
+	if m.zoom == ZoomDots {
+		glyph, style := '●', status
+		if selected {
+			glyph, style = '◉', styleSelected
+		}
+		c.put(x, y, glyph, style)
+		return
+	}
+
+	border, text := status, styleText
+	tl, tr, bl, br, hz, vt := '┌', '┐', '└', '┘', '─', '│'
+	if selected {
+		border, text = styleSelected, styleTextSelected
+		tl, tr, bl, br, hz, vt = '╔', '╗', '╚', '╝', '═', '║'
+	}
+	right, bottom := x+g.boxW-1, y+g.boxH-1
+	c.put(x, y, tl, border)
+	c.put(right, y, tr, border)
+	c.put(x, bottom, bl, border)
+	c.put(right, bottom, br, border)
+	for cx := x + 1; cx < right; cx++ {
+		c.put(cx, y, hz, border)
+		c.put(cx, bottom, hz, border)
+	}
+	for cy := y + 1; cy < bottom; cy++ {
+		c.put(x, cy, vt, border)
+		c.put(right, cy, vt, border)
+		for cx := x + 1; cx < right; cx++ {
+			c.put(cx, cy, ' ', styleNone)
+		}
+	}
+
+	inner := g.boxW - 4
+	c.put(x+2, y+1, statusGlyph(issue.Status), status)
+	c.text(x+4, y+1, truncate(issue.ID, inner-2), text)
+	if m.zoom == ZoomDetailed {
+		c.text(x+2, y+2, truncate(issue.Title, inner), styleText)
+	}
+}
+
+func statusStyle(s model.Status) cellStyle {
+	switch s {
+	case model.StatusOpen:
+		return styleOpen
+	case model.StatusInProgress:
+		return styleInProgress
+	case model.StatusBlocked:
+		return styleBlocked
+	case model.StatusClosed:
+		return styleClosed
+	default:
+		return styleMuted
+	}
+}
+
+func statusGlyph(s model.Status) rune {
+	switch s {
+	case model.StatusOpen:
+		return '○'
+	case model.StatusInProgress:
+		return '◐'
+	case model.StatusBlocked:
+		return '◈'
+	case model.StatusClosed:
+		return '✓'
+	default:
+		return '·'
+	}
+}
+
+// truncate shortens s to at most width runes, marking the cut with "…".
+func truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	if width == 1 {
+		return "…"
+	}
+	return strings.TrimRight(string(runes[:width-1]), " ") + "…"
+}
@@ -0,0 +1,161 @@
+package graphview
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func key(k string) tea.KeyMsg {
+	switch k {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	case "left":
+		return tea.KeyMsg{Type: tea.KeyLeft}
+	case "right":
+		return tea.KeyMsg{Type: tea.KeyRight}
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+}
+
+func issue(id string, blockers ...string) model.Issue {
+	is := model.Issue{ID: id, Title: "Title of " + id, Status: model.StatusOpen, IssueType: model.TypeTask}
+	for _, b := range blockers {
+		is.Dependencies = append(is.Dependencies, &model.Dependency{IssueID: id, DependsOnID: b, Type: model.DepBlocks})
+	}
+	return is
+}
+
+func newTestModel(issues ...model.Issue) Model {
+	m := New(issues, Palette{Renderer: lipgloss.DefaultRenderer()})
+	m.SetSize(80, 20)
+	return m
+}
+
+func TestViewDrawsBoxesAndArrows(t *testing.T) {
+	m := newTestModel(issue("A"), issue("B", "A"), issue("C", "A"))
+	out := m.View()
+
+	for _, want := range []string{"Dependency graph", "3 issues · 2 layers", "A", "B", "C", "▼", "╔", "┌", "┴"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+	lines := strings.Split(out, "\n")
+	if len(lines) != 20 {
+		t.Errorf("view should fill its height, got %d lines", len(lines))
+	}
+	if !strings.Contains(lines[len(lines)-1], "blocks 2") {
+		t.Errorf("status line should describe the selected blocker A, got %q", lines[len(lines)-1])
+	}
+}
+
+func TestNavigationAndOpen(t *testing.T) {
+	m := newTestModel(issue("A"), issue("B", "A"), issue("C", "A"), issue("D", "B"))
+	if m.SelectedID() != "A" {
+		t.Fatalf("first node in reading order should start selected, got %s", m.SelectedID())
+	}
+
+	m.HandleKey(key("down"))
+	below := m.SelectedID()
+	if below != "B" && below != "C" {
+		t.Fatalf("down should move to layer 1, got %s", below)
+	}
+	m.HandleKey(key("right"))
+	m.HandleKey(key("left"))
+	if m.SelectedID() != below {
+		t.Errorf("right then left should return to %s, got %s", below, m.SelectedID())
+	}
+	m.HandleKey(key("up"))
+	if m.SelectedID() != "A" {
+		t.Errorf("up should return to A, got %s", m.SelectedID())
+	}
+
+	for i := 0; i < 4; i++ {
+		m.HandleKey(key("tab"))
+	}
+	if m.SelectedID() != "A" {
+		t.Errorf("tab should cycle through all 4 nodes, got %s", m.SelectedID())
+	}
+	if got := m.HandleKey(key("enter")); got != "A" {
+		t.Errorf("enter should return the selected ID, got %q", got)
+	}
+	if got := m.HandleKey(key("j")); got != "" {
+		t.Errorf("panning should not open anything, got %q", got)
+	}
+}
+
+func TestZoomAndPan(t *testing.T) {
+	var issues []model.Issue
+	for _, id := range []string{"W-1", "W-2", "W-3", "W-4", "W-5", "W-6", "W-7", "W-8"} {
+		issues = append(issues, issue(id))
+	}
+	m := newTestModel(issues...)
+
+	m.HandleKey(key("+"))
+	if m.Zoom() != ZoomDetailed {
+		t.Fatalf("zoom in should reach detailed, got %s", m.Zoom())
+	}
+	if out := m.View(); !strings.Contains(out, "Title of W-1") {
+		t.Errorf("detailed zoom should show titles:\n%s", out)
+	}
+
+	// Eight 28-wide slots overflow 80 columns, so panning right moves the window
+	start := m.offsetX
+	m.HandleKey(key("l"))
+	if m.offsetX <= start {
+		t.Errorf("l should pan right: offset %d -> %d", start, m.offsetX)
+	}
+	for i := 0; i < 20; i++ {
+		m.HandleKey(key("l"))
+	}
+	cw, _ := m.canvasSize(m.geometry())
+	if m.offsetX != cw-80 {
+		t.Errorf("panning should clamp at the right edge: offset %d, want %d", m.offsetX, cw-80)
+	}
+
+	m.HandleKey(key("-"))
+	m.HandleKey(key("-"))
+	if m.Zoom() != ZoomDots {
+		t.Fatalf("zoom out should reach dots, got %s", m.Zoom())
+	}
+	if out := m.View(); !strings.Contains(out, "◉") || !strings.Contains(out, "●") {
+		t.Errorf("dots zoom should draw glyphs:\n%s", out)
+	}
+}
+
+func TestSetIssuesKeepsSelectionAndHandlesEmpty(t *testing.T) {
+	m := newTestModel(issue("A"), issue("B", "A"))
+	m.Select("B")
+	m.SetIssues([]model.Issue{issue("A"), issue("B", "A"), issue("C")})
+	if m.SelectedID() != "B" {
+		t.Errorf("selection should survive a reload, got %s", m.SelectedID())
+	}
+
+	m.SetIssues(nil)
+	if m.SelectedID() != "" {
+		t.Errorf("empty graph should have no selection, got %s", m.SelectedID())
+	}
+	if out := m.View(); !strings.Contains(out, "No issues to display") {
+		t.Errorf("empty view: %q", out)
+	}
+	m.HandleKey(key("down"))
+	m.HandleKey(key("tab"))
+}
+
+func TestCycleIsDrawnWithUpArrow(t *testing.T) {
+	m := newTestModel(issue("X", "Y"), issue("Y", "X"))
+	if out := m.View(); !strings.Contains(out, "▲") {
+		t.Errorf("reversed cycle edge should be drawn with an up arrow:\n%s", out)
+	}
+}
@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// toggleHealth opens the health panel with a fresh integrity check, or closes it
+func (m *Model) toggleHealth() {
+	m.showHealth = !m.showHealth
+	if !m.showHealth {
+		return
+	}
+	m.health = analysis.CheckIntegrity(m.issues)
+	m.healthCursor = 0
+}
+
+// handleHealthKeys moves through the health panel's problems; enter jumps
+// to the selected issue
+func (m Model) handleHealthKeys(msg tea.KeyMsg) Model {
+	problems := m.health.Problems
+	switch msg.String() {
+	case "j", "down":
+		if m.healthCursor < len(problems)-1 {
+			m.healthCursor++
+		}
+	case "k", "up":
+		if m.healthCursor > 0 {
+			m.healthCursor--
+		}
+	case "enter":
+		if m.healthCursor >= len(problems) {
+			return m
+		}
+		m.showHealth = false
+		id := problems[m.healthCursor].IssueID
+		m.exitToListView()
+		if !m.selectIssueInList(id) {
+			m.statusMsg = id + " is hidden by the current filter"
+			m.statusIsError = true
+			return m
+		}
+		m.updateViewportContent()
+	case "esc", "q", "E":
+		m.showHealth = false
+	}
+	return m
+}
+
+// healthRows is how many problems the panel lists at once
+func (m Model) healthRows() int {
+	return max(m.height-16, 3)
+}
+
+// renderHealth renders the health overlay: integrity problems grouped by kind
+func (m Model) renderHealth() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(min(90, m.width-4)).
+		MaxHeight(m.height - 4)
+
+	titleStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Primary).
+		MarginBottom(1)
+	groupStyle := t.Renderer.NewStyle().Bold(true).Foreground(ColorWarning)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("🩺 Health check"))
+	sb.WriteString("\n\n")
+
+	problems := m.health.Problems
+	if len(problems) == 0 {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(ColorSuccess).Render("✓ No integrity problems"))
+		sb.WriteString("\n\n")
+	} else {
+		var counts []string
+		for _, kind := range analysis.IntegrityKinds {
+			if n := m.health.Counts[kind]; n > 0 {
+				counts = append(counts, fmt.Sprintf("%d %s", n, strings.ToLower(kind.Title())))
+			}
+		}
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(strings.Join(counts, " • ")))
+		sb.WriteString("\n\n")
+
+		// Scroll so the cursor stays in view
+		start := max(0, min(m.healthCursor-m.healthRows()/2, len(problems)-m.healthRows()))
+		end := min(start+m.healthRows(), len(problems))
+		for i := start; i < end; i++ {
+			p := problems[i]
+			if i == start || problems[i-1].Kind != p.Kind {
+				sb.WriteString(groupStyle.Render(p.Kind.Title()))
+				sb.WriteString("\n")
+			}
+			cursor := "  "
+			if i == m.healthCursor {
+				cursor = "▸ "
+			}
+			line := fmt.Sprintf("%s%s %s", cursor, p.IssueID, truncateRunesHelper(p.Title, 30, "…"))
+			if i == m.healthCursor {
+				line = t.Renderer.NewStyle().Bold(true).Render(line)
+			}
+			sb.WriteString(line + "  " + mutedStyle.Render(p.Message))
+			sb.WriteString("\n")
+		}
+		if hidden := len(problems) - (end - start); hidden > 0 {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("%d more (j/k to scroll)", hidden)))
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k: navigate • Enter: jump to issue • Esc: close • bv doctor prints this report"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
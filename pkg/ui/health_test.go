@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestHealthPanel(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Task", Status: model.StatusOpen},
+		{ID: "bv-2", Title: "Broken link", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "bv-2", DependsOnID: "bv-404", Type: model.DepBlocks}}},
+		{ID: "bv-3", Title: "Hollow epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+	}
+	m := NewModel(issues, nil, "")
+	m.width, m.height = 120, 40
+
+	newM, _ := m.Update(keyMsg("E"))
+	m = newM.(Model)
+	if !m.showHealth || len(m.health.Problems) != 2 {
+		t.Fatalf("E should open the health panel with 2 problems, got %+v", m.health)
+	}
+	view := stripAnsi(m.View())
+	for _, want := range []string{"🩺 Health check", "Dangling dependencies", "blocks dependency on missing issue bv-404", "Epics without children"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("health panel missing %q:\n%s", want, view)
+		}
+	}
+
+	// Enter on the epic closes the panel and selects it in the list
+	newM, _ = m.Update(keyMsg("j"))
+	m = newM.(Model)
+	newM, _ = m.Update(keyMsg("enter"))
+	m = newM.(Model)
+	if m.showHealth {
+		t.Error("enter should close the health panel")
+	}
+	if item, ok := m.list.SelectedItem().(IssueItem); !ok || item.Issue.ID != "bv-3" {
+		t.Errorf("selected %v, want bv-3", m.list.SelectedItem())
+	}
+}
@@ -0,0 +1,310 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui/keymap"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/version"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ══════════════════════════════════════════════════════════════════════════════
+// HELP OVERLAY - every key of the current view and the global ones (?), from
+// the keymap, with a fuzzy filter (/)
+// ══════════════════════════════════════════════════════════════════════════════
+
+// openHelp shows the help overlay for the screen that has the keyboard
+func (m *Model) openHelp() {
+	m.helpContext = m.keyContext()
+	if m.helpContext == "" {
+		m.helpContext = keymap.Global
+	}
+	m.helpReturnFocus = m.focused
+	m.showHelp = true
+	m.focused = focusHelp
+	m.helpScroll = 0
+	m.helpQuery = ""
+	m.helpSearching = false
+}
+
+// closeHelp hides the help overlay and gives the keyboard back
+func (m *Model) closeHelp() {
+	m.showHelp = false
+	m.helpScroll = 0
+	m.helpQuery = ""
+	m.helpSearching = false
+	m.focused = m.helpReturnFocus
+	if m.focused == focusHelp {
+		m.focused = focusList
+	}
+}
+
+// handleHelpKeys handles keyboard input when the help overlay is focused
+func (m Model) handleHelpKeys(msg tea.KeyMsg) Model {
+	if m.helpSearching {
+		switch msg.String() {
+		case "esc":
+			m.helpQuery = ""
+			m.helpSearching = false
+		case "enter":
+			m.helpSearching = false
+		case "backspace":
+			if m.helpQuery != "" {
+				_, size := utf8.DecodeLastRuneInString(m.helpQuery)
+				m.helpQuery = m.helpQuery[:len(m.helpQuery)-size]
+			}
+		case "ctrl+u":
+			m.helpQuery = ""
+		default:
+			if msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace {
+				m.helpQuery += string(msg.Runes)
+			}
+		}
+		m.helpScroll = 0
+		return m
+	}
+
+	switch msg.String() {
+	case "j", "down":
+		m.helpScroll++
+	case "k", "up":
+		if m.helpScroll > 0 {
+			m.helpScroll--
+		}
+	case "ctrl+d":
+		m.helpScroll += 10
+	case "ctrl+u":
+		m.helpScroll -= 10
+		if m.helpScroll < 0 {
+			m.helpScroll = 0
+		}
+	case "home", "g":
+		m.helpScroll = 0
+	case "G", "end":
+		// Will be clamped in render
+		m.helpScroll = 999
+	case "/":
+		m.helpSearching = true
+	case "esc":
+		// The first esc drops the filter
+		if m.helpQuery != "" {
+			m.helpQuery = ""
+			m.helpScroll = 0
+			break
+		}
+		m.closeHelp()
+	case "q", "?", "f1":
+		m.closeHelp()
+	case " ": // Space opens interactive tutorial (bv-0trk, bv-8y31)
+		m.closeHelp()
+		m.showTutorial = true
+		m.tutorialModel.SetSize(m.width, m.height)
+		m.focused = focusTutorial
+	default:
+		// Any other key dismisses help
+		m.closeHelp()
+	}
+	return m
+}
+
+// filterHelpSections keeps the rows that fuzzily match query on their key or
+// description, best match first within each section; sections left empty
+// are dropped
+func filterHelpSections(sections []keymap.HelpSection, query string) []keymap.HelpSection {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return sections
+	}
+	var out []keymap.HelpSection
+	for _, s := range sections {
+		type scored struct {
+			row   keymap.HelpRow
+			score int
+		}
+		var matches []scored
+		for _, r := range s.Rows {
+			score := max(fuzzyScore(r.Desc, query), fuzzyScore(r.Key, query))
+			if score > 0 {
+				matches = append(matches, scored{r, score})
+			}
+		}
+		if len(matches) == 0 {
+			continue
+		}
+		sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+		filtered := keymap.HelpSection{Title: s.Title, Icon: s.Icon}
+		for _, match := range matches {
+			filtered.Rows = append(filtered.Rows, match.row)
+		}
+		out = append(out, filtered)
+	}
+	return out
+}
+
+// helpOverlayChrome is the lines around the key columns: border and
+// padding, the title and filter lines, and the build line under a blank one
+const helpOverlayChrome = 8
+
+func (m *Model) renderHelpOverlay() string {
+	t := m.theme
+
+	// Determine layout based on terminal width
+	// 3 columns for wide (≥120), 2 columns for medium (≥80), 1 column for narrow
+	numCols := 3
+	if m.width < 120 {
+		numCols = 2
+	}
+	if m.width < 80 {
+		numCols = 1
+	}
+
+	// Calculate column width (accounting for gaps and outer padding)
+	totalPadding := 8 // outer padding
+	gapWidth := 2     // gap between columns
+	availableWidth := m.width - totalPadding - (gapWidth * (numCols - 1))
+	colWidth := availableWidth / numCols
+	if colWidth < 28 {
+		colWidth = 28
+	}
+
+	// Define color palette (Dracula-inspired gradient)
+	colors := []lipgloss.AdaptiveColor{
+		{Light: "#7D56F4", Dark: "#BD93F9"}, // Purple
+		{Light: "#FF79C6", Dark: "#FF79C6"}, // Pink
+		{Light: "#8BE9FD", Dark: "#8BE9FD"}, // Cyan
+		{Light: "#50FA7B", Dark: "#50FA7B"}, // Green
+		{Light: "#FFB86C", Dark: "#FFB86C"}, // Orange
+		{Light: "#F1FA8C", Dark: "#F1FA8C"}, // Yellow
+	}
+
+	// Sections come from the keymap so rebound keys show up here
+	km := m.activeKeymap()
+	ctx := m.helpContext
+	if ctx == "" {
+		ctx = keymap.List
+	}
+	sections := km.HelpSections(ctx)
+	if len(m.customActions) > 0 {
+		sections = append(sections, m.actionHelpSection())
+	}
+	sections = filterHelpSections(sections, m.helpQuery)
+
+	// One line per section header and row, flowed down the columns
+	type helpLine struct {
+		text   string
+		header bool
+	}
+	var lines []helpLine
+	for i, section := range sections {
+		color := colors[i%len(colors)]
+		headerStyle := t.Renderer.NewStyle().Foreground(color).Bold(true)
+		keyStyle := t.Renderer.NewStyle().Foreground(color).Bold(true).Width(11)
+		descStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground()).MaxWidth(colWidth - 11)
+		if i > 0 {
+			lines = append(lines, helpLine{})
+		}
+		lines = append(lines, helpLine{text: headerStyle.Render(section.Icon + " " + section.Title), header: true})
+		for _, r := range section.Rows {
+			lines = append(lines, helpLine{text: keyStyle.Render(r.Key) + descStyle.Render(r.Desc)})
+		}
+	}
+
+	perCol := (len(lines) + numCols - 1) / numCols
+	var columns [][]string
+	for start := 0; start < len(lines); {
+		end := min(start+perCol, len(lines))
+		// Don't leave a header (or the gap before one) at the foot of a column
+		for end < len(lines) && end-start > 2 && (lines[end-1].header || lines[end-1].text == "") {
+			end--
+		}
+		if len(columns) == numCols-1 {
+			end = len(lines)
+		}
+		var col []string
+		for _, l := range lines[start:end] {
+			col = append(col, l.text)
+		}
+		if len(col) > 0 && col[0] == "" {
+			col = col[1:]
+		}
+		columns = append(columns, col)
+		start = end
+	}
+
+	// Scroll the columns together when the tallest doesn't fit
+	tallest := 0
+	for _, col := range columns {
+		tallest = max(tallest, len(col))
+	}
+	visible := max(m.height-1-helpOverlayChrome, 5)
+	m.helpScroll = max(min(m.helpScroll, tallest-visible), 0)
+	colStyle := t.Renderer.NewStyle().Width(colWidth)
+	var rendered []string
+	for i, col := range columns {
+		from := min(m.helpScroll, len(col))
+		to := min(from+visible, len(col))
+		if i > 0 {
+			rendered = append(rendered, strings.Repeat(" ", gapWidth))
+		}
+		rendered = append(rendered, colStyle.Render(strings.Join(col[from:to], "\n")))
+	}
+	body := lipgloss.JoinHorizontal(lipgloss.Top, rendered...)
+	if len(sections) == 0 {
+		body = t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).Render(fmt.Sprintf("No keys match %q", m.helpQuery))
+	}
+
+	// Title bar
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true).
+		Padding(0, 2)
+
+	subtitleStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+
+	title := titleStyle.Render("⌨️  Keyboard Shortcuts · " + ctx.Title())
+	subtitle := subtitleStyle.Render("Space: Tutorial │ / search │ ? or Esc to close")
+	titleBar := lipgloss.JoinHorizontal(lipgloss.Center, title, "  ", subtitle)
+
+	// Filter line: the query being typed, or where the list is scrolled
+	hintStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	var filterLine string
+	switch {
+	case m.helpSearching:
+		filterLine = t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("/"+m.helpQuery+"█") + hintStyle.Render("  enter keep · esc clear")
+	case m.helpQuery != "":
+		filterLine = hintStyle.Render(fmt.Sprintf("/%s · esc clears", m.helpQuery))
+	case tallest > visible:
+		filterLine = hintStyle.Render(fmt.Sprintf("lines %d-%d of %d · j/k scroll", m.helpScroll+1, min(m.helpScroll+visible, tallest), tallest))
+	}
+
+	// Build line so screenshots in bug reports identify the exact binary
+	buildLine := t.Renderer.NewStyle().
+		Foreground(t.Subtext).
+		Render("bv " + version.Info().String())
+
+	// Combine title, body and build line
+	content := lipgloss.JoinVertical(lipgloss.Center, titleBar, filterLine, body, "", buildLine)
+
+	// Outer container
+	containerStyle := t.Renderer.NewStyle().
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2)
+
+	helpBox := containerStyle.Render(content)
+
+	// Center in viewport
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		helpBox,
+	)
+}
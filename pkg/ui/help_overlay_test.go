@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHelpOverlayInLens(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{
+		Keymap: map[string][]string{"lens.rename": {"N"}},
+	})
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 130, Height: 40})
+	m = updated.(Model)
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	updated, _ = m.Update(keyMsg("?"))
+	m = updated.(Model)
+
+	view := stripAnsi(m.View())
+	if !m.showHelp || !strings.Contains(view, "Keyboard Shortcuts · Lens dashboard") {
+		t.Fatalf("? in a lens should show the lens keys:\n%s", view)
+	}
+	if !strings.Contains(view, "Only stalled workstreams") || strings.Contains(view, "Kanban board") {
+		t.Error("the lens help lists the lens's keys, not the global ones it doesn't see")
+	}
+	if !strings.Contains(view, "N          Rename workstream") {
+		t.Error("a rebound key should show as bound")
+	}
+
+	// / filters the rows as you type; esc drops the filter, then closes
+	for _, k := range []string{"/", "s", "t", "a", "l", "l"} {
+		updated, _ = m.Update(keyMsg(k))
+		m = updated.(Model)
+	}
+	view = stripAnsi(m.View())
+	if !strings.Contains(view, "Only stalled workstreams") || strings.Contains(view, "Rename workstream") {
+		t.Errorf("filter %q should keep only matching rows:\n%s", m.helpQuery, view)
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.helpQuery != "" || !m.showHelp {
+		t.Fatalf("esc should clear the filter first, query %q", m.helpQuery)
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.showHelp || m.focused != focusLensDashboard || !m.showLensDashboard {
+		t.Error("closing help should return to the lens")
+	}
+}
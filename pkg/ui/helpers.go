@@ -220,7 +220,7 @@ func getDepTypeIcon(depType string) string {
 
 // GetStatusIcon returns a colored icon for a status
 func GetStatusIcon(s string) string {
-	switch s {
+	switch string(model.Status(s).Column()) {
 	case "open":
 		return "🟢"
 	case "in_progress":
@@ -299,3 +299,20 @@ func FormatAgeBadge(t time.Time) string {
 		return fmt.Sprintf("%dmo", days/30)
 	}
 }
+
+// FormatClosureDate returns the date an issue was closed (e.g., "2025-03-14"),
+// falling back to its last update when no closure time was recorded.
+// Returns "" for issues that are not closed.
+func FormatClosureDate(issue model.Issue) string {
+	if !issue.Status.IsClosed() {
+		return ""
+	}
+	closedAt := issue.UpdatedAt
+	if issue.ClosedAt != nil && !issue.ClosedAt.IsZero() {
+		closedAt = *issue.ClosedAt
+	}
+	if closedAt.IsZero() {
+		return ""
+	}
+	return closedAt.Local().Format("2006-01-02")
+}
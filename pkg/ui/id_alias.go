@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// idAliases maps issue IDs to the shorter form shown on screen (id_display
+// in the project config) and back. The zero value shows IDs unchanged.
+type idAliases struct {
+	alias map[string]string // ID → alias
+	ids   map[string]string // Alias or real ID → ID, to keep aliases unique
+}
+
+// newIDAliases builds the aliases for mode: short strips prefix (or the
+// prefix every ID shares when empty), number numbers issues by creation
+// order. An alias that would collide with another issue keeps the full ID.
+func newIDAliases(mode, prefix string, issues []model.Issue) idAliases {
+	var a idAliases
+	switch strings.ToLower(mode) {
+	case "short":
+		if prefix == "" {
+			prefix = commonIDPrefix(issues)
+		}
+		if prefix == "" {
+			return a
+		}
+		a.init(issues)
+		for _, issue := range issues {
+			a.add(issue.ID, strings.TrimPrefix(issue.ID, prefix))
+		}
+	case "number":
+		ordered := make([]model.Issue, len(issues))
+		copy(ordered, issues)
+		sort.SliceStable(ordered, func(i, j int) bool {
+			if !ordered[i].CreatedAt.Equal(ordered[j].CreatedAt) {
+				return ordered[i].CreatedAt.Before(ordered[j].CreatedAt)
+			}
+			return ordered[i].ID < ordered[j].ID
+		})
+		a.init(issues)
+		for i, issue := range ordered {
+			a.add(issue.ID, fmt.Sprintf("#%d", i+1))
+		}
+	}
+	return a
+}
+
+// init reserves every real ID, so no alias can shadow one
+func (a *idAliases) init(issues []model.Issue) {
+	a.alias = make(map[string]string, len(issues))
+	a.ids = make(map[string]string, 2*len(issues))
+	for _, issue := range issues {
+		a.ids[issue.ID] = issue.ID
+	}
+}
+
+// add records alias for id unless it is empty or already taken
+func (a *idAliases) add(id, alias string) {
+	if alias == "" || alias == id {
+		return
+	}
+	if _, taken := a.ids[alias]; taken {
+		return
+	}
+	a.alias[id] = alias
+	a.ids[alias] = id
+}
+
+// commonIDPrefix returns the "name-" prefix all IDs share, or "" when they
+// don't share one (e.g. several repos in workspace mode)
+func commonIDPrefix(issues []model.Issue) string {
+	if len(issues) == 0 {
+		return ""
+	}
+	dash := strings.Index(issues[0].ID, "-")
+	if dash <= 0 {
+		return ""
+	}
+	prefix := issues[0].ID[:dash+1]
+	for _, issue := range issues[1:] {
+		if !strings.HasPrefix(issue.ID, prefix) {
+			return ""
+		}
+	}
+	return prefix
+}
+
+// ID is how id appears on screen under the configured id_display. Copies
+// and exports use the real ID instead.
+func (t Theme) ID(id string) string {
+	if t.ids == nil {
+		return id
+	}
+	return t.ids.display(id)
+}
+
+// display returns the alias of id, or id when it has none
+func (a idAliases) display(id string) string {
+	if alias, ok := a.alias[id]; ok {
+		return alias
+	}
+	return id
+}
+
+// setDisplayAliases rebuilds the theme's aliases for issues from the
+// config. Every copy of the theme handed to a view shares them, so the
+// views pick up the new ones without being told.
+func (m *Model) setDisplayAliases(issues []model.Issue) {
+	*m.theme.ids = newIDAliases(m.projectConfig.IDDisplay, m.projectConfig.IDPrefix, issues)
+}
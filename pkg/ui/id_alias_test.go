@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestIDAliases(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	issues := []model.Issue{
+		{ID: "bv-x9k2", CreatedAt: day(3)},
+		{ID: "bv-a1b2", CreatedAt: day(1)},
+		{ID: "bv-77aa", CreatedAt: day(1)},
+	}
+
+	short := newIDAliases("short", "", issues)
+	if got := short.alias["bv-x9k2"]; got != "x9k2" {
+		t.Errorf("short alias = %q, want the shared bv- prefix stripped", got)
+	}
+	number := newIDAliases("number", "", issues)
+	for id, want := range map[string]string{"bv-77aa": "#1", "bv-a1b2": "#2", "bv-x9k2": "#3"} {
+		if got := number.alias[id]; got != want {
+			t.Errorf("number alias of %s = %q, want %q", id, got, want)
+		}
+	}
+
+	// No shared prefix (workspace mode) leaves IDs alone; a collision keeps the full ID
+	mixed := append(issues, model.Issue{ID: "api-1"})
+	if a := newIDAliases("short", "", mixed); a.alias != nil {
+		t.Errorf("mixed prefixes should not be stripped: %v", a.alias)
+	}
+	clash := newIDAliases("short", "bv-", append(issues, model.Issue{ID: "x9k2"}))
+	if clash.alias["bv-x9k2"] != "" || clash.alias["bv-a1b2"] != "a1b2" {
+		t.Errorf("aliases with a clash = %v", clash.alias)
+	}
+}
+
+func TestIDDisplayInViews(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "proj-alpha", Title: "First", Status: model.StatusOpen},
+		{ID: "proj-beta", Title: "Second", Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "", WithProjectConfig(&config.Config{IDDisplay: "short"}))
+	m.width, m.height = 120, 30
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m = newM.(Model)
+
+	view := stripAnsi(m.View())
+	if !strings.Contains(view, "alpha") || strings.Contains(view, "proj-alpha") {
+		t.Errorf("list should show the short ID:\n%s", view)
+	}
+	// The aliases belong to the model: another one shows full IDs
+	if other := NewModel(issues, nil, ""); other.theme.ID("proj-alpha") != "proj-alpha" || m.theme.ID("proj-alpha") != "alpha" {
+		t.Error("id_display leaked between models")
+	}
+
+	// Copies keep the real ID
+	if ctx := m.issueContext(m.issueMap["proj-alpha"], nil); !strings.HasPrefix(ctx, "# proj-alpha: First") {
+		t.Errorf("context block should use the real ID:\n%s", ctx)
+	}
+}
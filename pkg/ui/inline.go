@@ -0,0 +1,22 @@
+package ui
+
+// DefaultInlineHeight is how many rows --inline renders unless told otherwise
+const DefaultInlineHeight = 24
+
+// WithInlineHeight caps the rendered height at rows for inline mode, where
+// the TUI runs without the alternate screen. It then takes a band of the
+// terminal instead of all of it, and the last frame stays readable in the
+// scrollback after quitting. 0 or less keeps the full terminal height.
+func WithInlineHeight(rows int) ModelOption {
+	return func(o *modelOptions) {
+		o.inlineHeight = rows
+	}
+}
+
+// fitInlineHeight returns the terminal height capped for inline mode
+func (m Model) fitInlineHeight(height int) int {
+	if m.inlineHeight > 0 && height > m.inlineHeight {
+		return m.inlineHeight
+	}
+	return height
+}
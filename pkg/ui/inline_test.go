@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestInlineHeightCapsTheFrame(t *testing.T) {
+	issues := []model.Issue{{ID: "a", Title: "A", Status: model.StatusOpen}}
+
+	m := NewModel(issues, nil, "", WithInlineHeight(12))
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 50})
+	m = updated.(Model)
+	if m.height != 12 {
+		t.Errorf("height = %d, want the inline cap of 12", m.height)
+	}
+	if lines := strings.Count(m.View(), "\n") + 1; lines > 12 {
+		t.Errorf("inline frame is %d rows, want at most 12", lines)
+	}
+
+	// A terminal shorter than the cap keeps its own height
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: 100, Height: 8})
+	if got := updated.(Model).height; got != 8 {
+		t.Errorf("height = %d, want 8", got)
+	}
+
+	full := NewModel(issues, nil, "")
+	updated, _ = full.Update(tea.WindowSizeMsg{Width: 100, Height: 50})
+	if got := updated.(Model).height; got != 50 {
+		t.Errorf("height without inline = %d, want 50", got)
+	}
+}
@@ -1725,7 +1725,7 @@ func (m *InsightsModel) buildDetailMarkdown(selectedID string) string {
 
 	// === Meta Table ===
 	sb.WriteString("| Field | Value |\n|---|---|\n")
-	sb.WriteString(fmt.Sprintf("| **ID** | `%s` |\n", issue.ID))
+	sb.WriteString(fmt.Sprintf("| **ID** | `%s` |\n", m.theme.ID(issue.ID)))
 	sb.WriteString(fmt.Sprintf("| **Status** | **%s** |\n", strings.ToUpper(string(issue.Status))))
 	sb.WriteString(fmt.Sprintf("| **Priority** | %s P%d |\n", GetPriorityIcon(issue.Priority), issue.Priority))
 	if issue.Assignee != "" {
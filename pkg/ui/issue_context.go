@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/atotto/clipboard"
+)
+
+// maxContextRelated caps the workstream issues listed in a context block
+const maxContextRelated = 10
+
+// issueContext builds the Markdown block y copies for an agent: the issue,
+// what it depends on and blocks, and the work around it. ws is the lens
+// workstream holding the issue; without one the parent epic stands in.
+func (m *Model) issueContext(issue *model.Issue, ws *analysis.Workstream) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s: %s\n\n", issue.ID, issue.Title)
+	fmt.Fprintf(&sb, "- **Type:** %s | **Status:** %s | **Priority:** P%d\n", issue.IssueType, issue.Status, issue.Priority)
+	if issue.Assignee != "" {
+		fmt.Fprintf(&sb, "- **Assignee:** @%s\n", issue.Assignee)
+	}
+	if len(issue.Labels) > 0 {
+		fmt.Fprintf(&sb, "- **Labels:** %s\n", strings.Join(issue.Labels, ", "))
+	}
+	if cs := m.claimCoverage[issue.ID]; len(cs) > 0 {
+		fmt.Fprintf(&sb, "- **Claimed:** %s\n", describeClaims(cs))
+	}
+
+	if issue.Description != "" {
+		fmt.Fprintf(&sb, "\n## Description\n\n%s\n", strings.TrimSpace(issue.Description))
+	}
+	if issue.AcceptanceCriteria != "" {
+		fmt.Fprintf(&sb, "\n## Acceptance Criteria\n\n%s\n", strings.TrimSpace(issue.AcceptanceCriteria))
+	}
+
+	// Dependencies, with the open blockers called out
+	var deps []string
+	var parent *model.Issue
+	for _, dep := range issue.Dependencies {
+		if dep == nil {
+			continue
+		}
+		target := m.issueMap[dep.DependsOnID]
+		if dep.Type == model.DepParentChild && target != nil {
+			parent = target
+		}
+		deps = append(deps, fmt.Sprintf("- %s (%s)", m.contextRef(dep.DependsOnID), dep.Type))
+	}
+	if len(deps) > 0 {
+		fmt.Fprintf(&sb, "\n## Depends On\n\n%s\n", strings.Join(deps, "\n"))
+	}
+	if blockers := openBlockers(issue, m.issueMap); len(blockers) > 0 {
+		sb.WriteString("\n## Blocked By (open)\n\n")
+		for _, b := range blockers {
+			fmt.Fprintf(&sb, "- %s\n", m.contextRef(b.ID))
+		}
+	}
+	if blocks := m.openDependents(issue.ID); len(blocks) > 0 {
+		sb.WriteString("\n## Blocks\n\n")
+		for _, id := range blocks {
+			fmt.Fprintf(&sb, "- %s\n", m.contextRef(id))
+		}
+	}
+
+	// The work around it
+	var heading string
+	var related []string
+	switch {
+	case ws != nil:
+		heading = "Workstream: " + ws.Name
+		for _, other := range ws.Issues {
+			related = append(related, other.ID)
+		}
+	case parent != nil:
+		heading = "Epic: " + m.contextRef(parent.ID)
+		for _, other := range m.issues {
+			for _, dep := range other.Dependencies {
+				if dep != nil && dep.Type == model.DepParentChild && dep.DependsOnID == parent.ID {
+					related = append(related, other.ID)
+					break
+				}
+			}
+		}
+	}
+	related = slices.DeleteFunc(related, func(id string) bool { return id == issue.ID })
+	if heading != "" && len(related) > 0 {
+		fmt.Fprintf(&sb, "\n## %s\n\n", heading)
+		for i, id := range related {
+			if i == maxContextRelated {
+				fmt.Fprintf(&sb, "- …and %d more\n", len(related)-maxContextRelated)
+				break
+			}
+			fmt.Fprintf(&sb, "- %s\n", m.contextRef(id))
+		}
+	}
+	return sb.String()
+}
+
+// contextRef is how an issue is referenced in a context block: ID, title
+// and status, or the bare ID when it is not loaded
+func (m *Model) contextRef(id string) string {
+	issue := m.issueMap[id]
+	if issue == nil {
+		return id
+	}
+	return fmt.Sprintf("%s %s [%s]", id, issue.Title, issue.Status)
+}
+
+// openDependents returns the open issues id blocks, sorted by ID
+func (m *Model) openDependents(id string) []string {
+	var ids []string
+	for _, other := range m.issues {
+		if other.Status.IsClosed() {
+			continue
+		}
+		for _, dep := range other.Dependencies {
+			if dep != nil && dep.Type.IsBlocking() && dep.DependsOnID == id {
+				ids = append(ids, other.ID)
+				break
+			}
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// copyIssueContext copies the context block of issue id, as y does in
+// every view that has a selected issue
+func (m Model) copyIssueContext(id string) Model {
+	issue := m.issueMap[id]
+	if issue == nil {
+		m.statusMsg = "❌ No issue selected"
+		m.statusIsError = true
+		return m
+	}
+	var ws *analysis.Workstream
+	if m.showLensDashboard {
+		ws = m.lensDashboard.WorkstreamOf(id)
+	}
+	if err := clipboard.WriteAll(m.issueContext(issue, ws)); err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Clipboard error: %v", err)
+		m.statusIsError = true
+		return m
+	}
+	m.statusMsg = fmt.Sprintf("📋 Copied agent context for %s", id)
+	m.statusIsError = false
+	return m
+}
@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestIssueContextBlock(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Auth epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "bv-2", Title: "Token refresh", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 1,
+			Labels: []string{"api"}, Description: "Refresh tokens before expiry.", AcceptanceCriteria: "- [ ] Retries once",
+			Dependencies: []*model.Dependency{
+				{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepParentChild},
+				{IssueID: "bv-2", DependsOnID: "bv-3", Type: model.DepBlocks},
+			}},
+		{ID: "bv-3", Title: "Session store", Status: model.StatusInProgress, IssueType: model.TypeTask},
+		{ID: "bv-4", Title: "Login page", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{
+				{IssueID: "bv-4", DependsOnID: "bv-1", Type: model.DepParentChild},
+				{IssueID: "bv-4", DependsOnID: "bv-2", Type: model.DepBlocks},
+			}},
+	}
+	m := NewModel(issues, nil, "")
+
+	got := m.issueContext(m.issueMap["bv-2"], nil)
+	for _, want := range []string{
+		"# bv-2: Token refresh",
+		"**Priority:** P1",
+		"**Labels:** api",
+		"## Description\n\nRefresh tokens before expiry.",
+		"## Acceptance Criteria\n\n- [ ] Retries once",
+		"- bv-3 Session store [in_progress] (blocks)",
+		"## Blocked By (open)\n\n- bv-3 Session store [in_progress]",
+		"## Blocks\n\n- bv-4 Login page [open]",
+		"## Epic: bv-1 Auth epic [open]\n\n- bv-4 Login page [open]",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("context block missing %q:\n%s", want, got)
+		}
+	}
+
+	// A lens workstream replaces the epic as the surrounding work
+	ws := &analysis.Workstream{Name: "Sessions", Issues: []model.Issue{issues[1], issues[2]}}
+	got = m.issueContext(m.issueMap["bv-2"], ws)
+	if !strings.Contains(got, "## Workstream: Sessions\n\n- bv-3 Session store") || strings.Contains(got, "## Epic") {
+		t.Errorf("workstream section:\n%s", got)
+	}
+}
@@ -0,0 +1,260 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// issueLink is a URL or an existing file named in an issue's text
+type issueLink struct {
+	Target string // As written, e.g. "docs/design.md:42"
+	URL    string // Set for web links
+	Path   string // Set for files: resolved against the project directory
+	Line   int    // Line named after the path, 0 = none
+}
+
+// LinkEditorClosedMsg reports that the editor a file link opened in exited
+type LinkEditorClosedMsg struct {
+	Path string
+	Err  error
+}
+
+var (
+	linkURLPattern  = regexp.MustCompile("https?://[^\\s<>()\\[\\]{}\"'`]+")
+	linkPathPattern = regexp.MustCompile(`(?:~/|/)?[\w.-]+(?:/[\w.-]+)*(?::\d+)?`)
+)
+
+// issueLinks returns the URLs and existing files named in the issue's
+// description, design, acceptance criteria, notes and comments, in order of
+// appearance and without repeats. Paths that don't exist are left out, so a
+// version number or an abbreviation never shows up as a file.
+func issueLinks(issue *model.Issue, workDir string) []issueLink {
+	texts := []string{issue.Description, issue.Design, issue.AcceptanceCriteria, issue.Notes}
+	for _, c := range issue.Comments {
+		if c != nil {
+			texts = append(texts, c.Text)
+		}
+	}
+
+	var links []issueLink
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		for _, raw := range linkURLPattern.FindAllString(text, -1) {
+			url := strings.TrimRight(raw, ".,;:!?")
+			if !seen[url] {
+				seen[url] = true
+				links = append(links, issueLink{Target: url, URL: url})
+			}
+		}
+		text = linkURLPattern.ReplaceAllString(text, " ")
+		for _, raw := range linkPathPattern.FindAllString(text, -1) {
+			target := strings.TrimRight(raw, ".,;:!?")
+			if seen[target] || !looksLikePath(target) {
+				continue
+			}
+			if link, ok := resolveFileLink(target, workDir); ok {
+				seen[target] = true
+				links = append(links, link)
+			}
+		}
+	}
+	return links
+}
+
+// looksLikePath keeps the candidates worth a stat: a directory separator or
+// a file extension
+func looksLikePath(s string) bool {
+	s, _, _ = strings.Cut(s, ":")
+	return strings.Contains(s, "/") || strings.Contains(strings.TrimLeft(s, "."), ".")
+}
+
+// resolveFileLink turns "path" or "path:line" into a link when the file
+// exists, relative to workDir unless absolute or under ~
+func resolveFileLink(target, workDir string) (issueLink, bool) {
+	path, lineStr, _ := strings.Cut(target, ":")
+	line, _ := strconv.Atoi(lineStr)
+	switch {
+	case strings.HasPrefix(path, "~/"):
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return issueLink{}, false
+		}
+		path = filepath.Join(home, path[2:])
+	case !filepath.IsAbs(path):
+		path = filepath.Join(workDir, path)
+	}
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return issueLink{}, false
+	}
+	return issueLink{Target: target, Path: path, Line: line}, true
+}
+
+// refreshDetailLinks collects the links of the issue in the detail panel
+// once per issue, keeping the selected one while the issue stays the same.
+// A reload clears detailLinksValid, since the issue's text may have changed.
+func (m *Model) refreshDetailLinks(issue *model.Issue) {
+	if issue.ID == m.detailLinksID && m.detailLinksValid {
+		return
+	}
+	if issue.ID != m.detailLinksID {
+		m.detailLinksID = issue.ID
+		m.detailLinkCursor = 0
+	}
+	m.detailLinks = issueLinks(issue, m.workDir)
+	m.detailLinksValid = true
+	m.detailLinkCursor = min(m.detailLinkCursor, max(len(m.detailLinks)-1, 0))
+}
+
+// detailLinksMD is the detail panel's Links section, the selected link
+// marked
+func (m Model) detailLinksMD() string {
+	if len(m.detailLinks) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("### 🔗 Links\n")
+	for i, link := range m.detailLinks {
+		icon := "🌐"
+		if link.URL == "" {
+			icon = "📄"
+		}
+		if i == m.detailLinkCursor {
+			sb.WriteString(fmt.Sprintf("- ▶ **%d.** %s `%s`\n", i+1, icon, link.Target))
+		} else {
+			sb.WriteString(fmt.Sprintf("- %d. %s `%s`\n", i+1, icon, link.Target))
+		}
+	}
+	sb.WriteString("\n*n/N: select • v: open*\n\n")
+	return sb.String()
+}
+
+// handleLinkKeys selects (n, N) and opens (v) the links of the issue in the
+// detail panel. It returns false for other keys, or when the issue has no
+// links, leaving the key to the view.
+func (m Model) handleLinkKeys(msg tea.KeyMsg) (Model, tea.Cmd, bool) {
+	n := len(m.detailLinks)
+	if n == 0 {
+		return m, nil, false
+	}
+	switch msg.String() {
+	case "n":
+		m.detailLinkCursor = (m.detailLinkCursor + 1) % n
+	case "N":
+		m.detailLinkCursor = (m.detailLinkCursor + n - 1) % n
+	case "v":
+		next, cmd := m.openLink(m.detailLinks[m.detailLinkCursor])
+		return next, cmd, true
+	default:
+		return m, nil, false
+	}
+	m.statusMsg = fmt.Sprintf("🔗 Link %d/%d: %s • v to open", m.detailLinkCursor+1, n, m.detailLinks[m.detailLinkCursor].Target)
+	m.statusIsError = false
+	m.updateViewportContent()
+	return m, nil, true
+}
+
+// openCodeRef opens a file the selected issue names (path or path:line) in
+// $EDITOR at its line, from any view with a selected issue (ctrl+]).
+// Pressing it again on the same issue opens the next file. It returns false
+// when the view has no selected issue, leaving the key to the view.
+func (m Model) openCodeRef() (Model, tea.Cmd, bool) {
+	issue, ok := m.issueMap[m.statusTargetID()]
+	if !ok {
+		return m, nil, false
+	}
+	var refs []issueLink
+	for _, link := range issueLinks(issue, m.workDir) {
+		if link.Path != "" {
+			refs = append(refs, link)
+		}
+	}
+	if len(refs) == 0 {
+		m.statusMsg = fmt.Sprintf("No files named in %s (write them as path/file.go:123)", issue.ID)
+		m.statusIsError = false
+		return m, nil, true
+	}
+	if issue.ID != m.codeRefID {
+		m.codeRefID, m.codeRefNext = issue.ID, 0
+	}
+	i := m.codeRefNext % len(refs)
+	m.codeRefNext = i + 1
+	next, cmd := m.openLink(refs[i])
+	if len(refs) > 1 && !next.statusIsError {
+		next.statusMsg += fmt.Sprintf(" (%d/%d, ctrl+] for the next)", i+1, len(refs))
+	}
+	return next, cmd, true
+}
+
+// openLink opens a URL in the browser, and a file in $EDITOR (at its line,
+// for editors known to take one). Files need $EDITOR: the browser opener is
+// for web links only.
+func (m Model) openLink(link issueLink) (Model, tea.Cmd) {
+	m.statusIsError = false
+	if link.URL != "" {
+		if err := openBrowserURL(link.URL); err != nil {
+			m.statusMsg = fmt.Sprintf("❌ Opening %s failed: %v", link.Target, err)
+			m.statusIsError = true
+			return m, nil
+		}
+		m.statusMsg = "🔗 Opened " + link.Target
+		return m, nil
+	}
+	editor := strings.TrimSpace(os.Getenv("EDITOR"))
+	if editor == "" {
+		m.statusMsg = fmt.Sprintf("Set $EDITOR to open %s", link.Target)
+		m.statusIsError = true
+		return m, nil
+	}
+
+	cmd := editorCommand(editor, link.Path, link.Line)
+	cmd.Dir = m.workDir
+	m.statusMsg = fmt.Sprintf("📝 Opened %s in %s", link.Target, filepath.Base(cmd.Path))
+	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return LinkEditorClosedMsg{Path: link.Path, Err: err}
+	})
+}
+
+// editorCommand opens path in editor ($EDITOR, which may carry flags such
+// as "code --wait"), at line when it is set and the editor has a way to
+// take it
+func editorCommand(editor, path string, line int) *exec.Cmd {
+	fields := strings.Fields(editor)
+	args := fields[1:]
+	target := []string{path}
+	if line > 0 {
+		at := path + ":" + strconv.Itoa(line)
+		switch editorLineStyle[strings.TrimSuffix(filepath.Base(fields[0]), ".exe")] {
+		case lineArgPlus:
+			target = []string{"+" + strconv.Itoa(line), path}
+		case lineArgGoto:
+			target = []string{"--goto", at}
+		case lineArgSuffix:
+			target = []string{at}
+		}
+	}
+	return exec.Command(fields[0], append(args, target...)...)
+}
+
+// How an editor takes the line to open a file at
+const (
+	lineArgPlus   = iota + 1 // +N before the file
+	lineArgGoto              // --goto file:N
+	lineArgSuffix            // file:N
+)
+
+// editorLineStyle maps editors to how they take a line; others just get the file
+var editorLineStyle = map[string]int{
+	"vi": lineArgPlus, "vim": lineArgPlus, "nvim": lineArgPlus, "nano": lineArgPlus, "emacs": lineArgPlus,
+	"emacsclient": lineArgPlus, "micro": lineArgPlus, "kak": lineArgPlus, "joe": lineArgPlus, "ne": lineArgPlus,
+	"code": lineArgGoto, "codium": lineArgGoto, "cursor": lineArgGoto, "windsurf": lineArgGoto,
+	"subl": lineArgSuffix, "zed": lineArgSuffix, "hx": lineArgSuffix,
+}
@@ -0,0 +1,138 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestIssueLinks(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "docs"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "docs", "design.md"), []byte("# Design\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	issue := &model.Issue{
+		ID:          "bv-1",
+		Description: "Mockups at https://example.com/figma?id=7. Spec in docs/design.md:12, shipped in v1.2 (not docs/missing.md).",
+		Notes:       "Again: https://example.com/figma?id=7 and [spec](docs/design.md:12)",
+		Comments:    []*model.Comment{{Text: "e.g. see http://localhost:8080/debug"}},
+	}
+	got := issueLinks(issue, dir)
+	want := []issueLink{
+		{Target: "https://example.com/figma?id=7", URL: "https://example.com/figma?id=7"},
+		{Target: "docs/design.md:12", Path: filepath.Join(dir, "docs", "design.md"), Line: 12},
+		{Target: "http://localhost:8080/debug", URL: "http://localhost:8080/debug"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("issueLinks = %+v\nwant %+v", got, want)
+	}
+}
+
+func TestDetailLinkKeys(t *testing.T) {
+	t.Setenv("BV_NO_BROWSER", "1")
+	t.Setenv("EDITOR", "")
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Links", Status: model.StatusOpen, Description: "See https://a.example and https://b.example"},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	if !m.isSplitView || len(m.detailLinks) != 2 {
+		t.Fatalf("expected the split view with 2 links, got split=%v links=%v", m.isSplitView, m.detailLinks)
+	}
+	if !strings.Contains(stripAnsi(m.viewport.View()), "Links") {
+		t.Error("the detail panel should list the links")
+	}
+
+	updated, _ = m.Update(keyMsg("n"))
+	m = updated.(Model)
+	if m.detailLinkCursor != 1 || !strings.Contains(m.statusMsg, "Link 2/2: https://b.example") {
+		t.Errorf("n should select the next link, cursor %d status %q", m.detailLinkCursor, m.statusMsg)
+	}
+	updated, _ = m.Update(keyMsg("N"))
+	if m = updated.(Model); m.detailLinkCursor != 0 {
+		t.Errorf("N should go back to the first link, cursor %d", m.detailLinkCursor)
+	}
+
+	updated, _ = m.Update(keyMsg("v"))
+	m = updated.(Model)
+	if m.statusMsg != "🔗 Opened https://a.example" {
+		t.Errorf("v should open the selected link, status %q", m.statusMsg)
+	}
+
+	// Files need an editor; the browser opener only takes URLs
+	m, cmd := m.openLink(issueLink{Target: "a.go", Path: "/work/a.go"})
+	if cmd != nil || !m.statusIsError || !strings.Contains(m.statusMsg, "Set $EDITOR to open a.go") {
+		t.Errorf("a file without $EDITOR should be refused, status %q", m.statusMsg)
+	}
+
+	// The links are collected once per issue, until a reload
+	m.detailLinks = nil
+	m.updateViewportContent()
+	if m.detailLinks != nil {
+		t.Error("links were collected again for the same issue")
+	}
+	m.detailLinksValid = false
+	m.updateViewportContent()
+	if len(m.detailLinks) != 2 {
+		t.Errorf("links after a reload = %v", m.detailLinks)
+	}
+}
+
+func TestEditorCommand(t *testing.T) {
+	cases := []struct {
+		editor string
+		line   int
+		want   []string
+	}{
+		{"vim", 12, []string{"vim", "+12", "a.go"}},
+		{"code --wait", 12, []string{"code", "--wait", "--goto", "a.go:12"}},
+		{"/usr/local/bin/hx", 12, []string{"/usr/local/bin/hx", "a.go:12"}},
+		{"gedit", 12, []string{"gedit", "a.go"}},
+		{"nvim", 0, []string{"nvim", "a.go"}},
+	}
+	for _, tc := range cases {
+		if got := editorCommand(tc.editor, "a.go", tc.line).Args; !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("editorCommand(%q, %d) = %q, want %q", tc.editor, tc.line, got, tc.want)
+		}
+	}
+}
+
+func TestOpenCodeRef(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.go", "b.go"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("package x\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("EDITOR", "vim")
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Refs", Status: model.StatusOpen, Description: "Crash at a.go:3, called from b.go:10"},
+		{ID: "bv-2", Title: "None", Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "")
+	m.workDir = dir
+
+	for _, want := range []string{"a.go:3 in vim (1/2", "b.go:10 in vim (2/2", "a.go:3 in vim (1/2"} {
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlCloseBracket})
+		m = updated.(Model)
+		if cmd == nil || !strings.Contains(m.statusMsg, want) {
+			t.Errorf("ctrl+] should open %q, status %q", want, m.statusMsg)
+		}
+	}
+
+	m.list.Select(1)
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlCloseBracket})
+	if m = updated.(Model); cmd != nil || !strings.Contains(m.statusMsg, "No files named in bv-2") {
+		t.Errorf("an issue without file references should say so, status %q", m.statusMsg)
+	}
+}
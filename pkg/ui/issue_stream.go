@@ -0,0 +1,181 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// issueStream tracks a streaming load that is still delivering issues
+type issueStream struct {
+	batches  <-chan loader.StreamBatch
+	progress float64   // Fraction of the file read
+	started  time.Time // For the "loaded in" message
+	changed  bool      // The file changed on disk mid-load; reload when done
+}
+
+// IssueBatchMsg carries the next batch of a streaming load. closed is set
+// when the channel closed without a final batch (the load was cancelled).
+type IssueBatchMsg struct {
+	Batch  loader.StreamBatch
+	closed bool
+}
+
+// WithIssueStream opens the TUI on the issues of the first batch of a
+// streaming load (loader.StreamIssuesFromFile) and adds the remaining
+// batches as they arrive. first is the batch already passed to NewModel.
+func WithIssueStream(batches <-chan loader.StreamBatch, first loader.StreamBatch) ModelOption {
+	return func(o *modelOptions) {
+		o.stream = &issueStream{
+			batches:  batches,
+			progress: first.Progress(),
+			started:  time.Now(),
+		}
+	}
+}
+
+// WaitForIssueBatchCmd waits for the next batch of a streaming load
+func WaitForIssueBatchCmd(batches <-chan loader.StreamBatch) tea.Cmd {
+	return func() tea.Msg {
+		b, ok := <-batches
+		return IssueBatchMsg{Batch: b, closed: !ok}
+	}
+}
+
+// IsLoading reports whether a streaming load is still adding issues
+func (m Model) IsLoading() bool {
+	return m.stream != nil
+}
+
+// handleIssueBatch folds a streamed batch, plus any others already waiting,
+// into the issue set. Every view is rebuilt from the partial set, so the
+// dashboards work while loading; the open lens dashboard is rebuilt once
+// the load completes.
+func (m Model) handleIssueBatch(msg IssueBatchMsg) (Model, tea.Cmd) {
+	if m.stream == nil {
+		return m, nil
+	}
+
+	issues := make([]model.Issue, len(m.issues), len(m.issues)+len(msg.Batch.Issues))
+	copy(issues, m.issues)
+	last, done := msg.Batch, msg.closed || msg.Batch.Done
+	issues = append(issues, last.Issues...)
+drain:
+	for !done {
+		select {
+		case b, ok := <-m.stream.batches:
+			if !ok {
+				done = true
+				break
+			}
+			issues = append(issues, b.Issues...)
+			last, done = b, b.Done
+		default:
+			break drain
+		}
+	}
+
+	stream := m.stream
+	stream.progress = last.Progress()
+	if done {
+		m.stream = nil
+	}
+
+	cacheHit, cmds := m.replaceIssues(issues)
+	m.updateViewportContent()
+	m.startBackgroundOp(bgMetrics, fmt.Sprintf("Loaded %d issues: graph metrics ready", len(m.issues)))
+	cmds = append(cmds, WaitForPhase2Cmd(m.analysis))
+
+	if !done {
+		cmds = append(cmds, WaitForIssueBatchCmd(stream.batches))
+		return m, tea.Batch(cmds...)
+	}
+
+	// Skip identical rewrites from here on. A change seen mid-load may be
+	// only partly in what was read, so it always reloads.
+	if stream.changed {
+		m.beadsSum = ""
+		cmds = append(cmds, func() tea.Msg { return FileChangedMsg{} })
+	} else if m.beadsPath != "" {
+		m.beadsSum, _ = loader.FileChecksum(m.beadsPath)
+	}
+	if m.showLensDashboard {
+		m.refreshLensDashboard()
+	}
+
+	switch {
+	case last.Err != nil:
+		m.statusMsg = fmt.Sprintf("Loading stopped after %d issues: %v", len(m.issues), last.Err)
+		m.statusIsError = true
+	case msg.closed && !last.Done:
+		m.statusMsg = fmt.Sprintf("Loading cancelled after %d issues", len(m.issues))
+		m.statusIsError = true
+	default:
+		m.statusMsg = fmt.Sprintf("Loaded %d issues in %s", len(m.issues), time.Since(stream.started).Round(100*time.Millisecond))
+		if cacheHit {
+			m.statusMsg += " (cached)"
+		}
+		if last.Warnings > 0 {
+			m.statusMsg += fmt.Sprintf(" (%d warnings)", last.Warnings)
+		}
+		m.statusIsError = false
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// refreshLensDashboard rebuilds the open lens dashboard from the current
+// issues, keeping its scope, depth, layout, grouping and order within status.
+// The cursor returns to the top.
+// Dashboards drilled out of are rebuilt when esc returns to them.
+func (m *Model) refreshLensDashboard() {
+	for i := range m.lensStack {
+		m.lensStack[i].stale = true
+	}
+	old := m.lensDashboard
+	switch old.viewMode {
+	case "epic":
+		m.lensDashboard = NewEpicLensModel(old.epicID, old.labelName, m.issues, m.issueMap, m.theme)
+	case "bead":
+		if old.egoNode == nil {
+			return
+		}
+		m.lensDashboard = NewBeadLensModel(old.egoNode.Node.Issue.ID, m.issues, m.issueMap, m.theme)
+	default:
+		m.lensDashboard = NewLensDashboardModel(old.labelName, m.issues, m.issueMap, m.theme)
+	}
+
+	for _, label := range old.GetScopeLabels() {
+		m.lensDashboard.AddScopeLabel(label)
+	}
+	m.lensDashboard.SetScopeMode(old.GetScopeMode())
+	m.lensDashboard.SetArchaeologyMode(old.IsArchaeologyMode())
+	m.lensDashboard.SetStaleDays(old.staleDays)
+	m.lensDashboard.SetClaims(m.claimCoverage)
+	if m.projectConfig != nil && m.projectConfig.EpicRollup {
+		m.lensDashboard.SetEpicRollups(analysis.ComputeEpicRollups(m.issues))
+	}
+	m.lensDashboard.SetWorkstreamOverrides(old.workstreamOverrides)
+	m.lensDashboard.SetWorkstreamNames(old.workstreamNames)
+	m.lensDashboard.SetStalledOnly(old.IsStalledOnly())
+	m.lensDashboard.SetBreadcrumbs(old.breadcrumbs)
+	m.lensDashboard.SetDetailMode(old.DetailMode())
+	m.lensDashboard.SetGroupByMode(old.GetGroupByMode())
+	m.applyLensLayout(depthToView(old.GetDepth()), viewTypeToView(old.GetViewType()))
+	m.lensDashboard.SetSortSources(m.dependentsCount, m.analysis)
+	if s, desc := old.SectionOrder(); s != m.lensDashboard.sectionSort || desc != m.lensDashboard.sectionDesc {
+		m.lensDashboard.SetSectionOrder(s, desc)
+	}
+	m.lensDashboard.SetSize(m.width, m.height-1)
+}
+
+// loadingLabel describes the progress of a streaming load for the footer
+func (m Model) loadingLabel() string {
+	if m.stream == nil {
+		return ""
+	}
+	return fmt.Sprintf("⏳ loading %d%% · %d issues", int(m.stream.progress*100), len(m.issues))
+}
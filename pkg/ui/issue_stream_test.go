@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func streamIssues(from, to int) []model.Issue {
+	var issues []model.Issue
+	for i := from; i <= to; i++ {
+		issues = append(issues, model.Issue{ID: fmt.Sprintf("s-%d", i), Title: "Streamed", Status: model.StatusOpen, IssueType: model.TypeTask})
+	}
+	return issues
+}
+
+func TestIssueStreamFillsModel(t *testing.T) {
+	ch := make(chan loader.StreamBatch, 3)
+	first := loader.StreamBatch{Issues: streamIssues(1, 2), BytesRead: 20, Size: 100}
+	m := NewModel(first.Issues, nil, "", WithIssueStream(ch, first))
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m = updated.(Model)
+	defer m.Stop()
+
+	if !m.IsLoading() || !strings.Contains(m.View(), "loading 20%") {
+		t.Fatal("expected the footer to show load progress")
+	}
+
+	// Batches already waiting are folded in with the delivered one
+	ch <- loader.StreamBatch{Issues: streamIssues(5, 6), BytesRead: 80, Size: 100}
+	updated, _ = m.Update(IssueBatchMsg{Batch: loader.StreamBatch{Issues: streamIssues(3, 4), BytesRead: 40, Size: 100}})
+	m = updated.(Model)
+	if len(m.issues) != 6 || !m.IsLoading() || m.stream.progress != 0.8 {
+		t.Fatalf("after two batches: %d issues, loading %v", len(m.issues), m.IsLoading())
+	}
+	if _, ok := m.issueMap["s-6"]; !ok {
+		t.Error("issue map should include streamed issues")
+	}
+
+	updated, _ = m.Update(IssueBatchMsg{Batch: loader.StreamBatch{Issues: streamIssues(7, 7), Done: true, Warnings: 2}})
+	m = updated.(Model)
+	if len(m.issues) != 7 || m.IsLoading() {
+		t.Fatalf("after the last batch: %d issues, loading %v", len(m.issues), m.IsLoading())
+	}
+	if !strings.HasPrefix(m.statusMsg, "Loaded 7 issues") || !strings.Contains(m.statusMsg, "2 warnings") {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+	if strings.Contains(m.View(), "loading") {
+		t.Error("loading badge should clear once the stream is done")
+	}
+}
+
+func TestIssueStreamCancelled(t *testing.T) {
+	ch := make(chan loader.StreamBatch)
+	first := loader.StreamBatch{Issues: streamIssues(1, 2), BytesRead: 20, Size: 100}
+	m := NewModel(first.Issues, nil, "", WithIssueStream(ch, first))
+	defer m.Stop()
+
+	updated, _ := m.Update(IssueBatchMsg{closed: true})
+	m = updated.(Model)
+	if m.IsLoading() || !m.statusIsError || !strings.Contains(m.statusMsg, "cancelled after 2 issues") {
+		t.Errorf("loading %v, status %q", m.IsLoading(), m.statusMsg)
+	}
+}
+
+func TestRefreshLensDashboardKeepsOrderAndGrouping(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "One", Status: model.StatusOpen, Priority: 2, Labels: []string{"api"}},
+		{ID: "bv-2", Title: "Two", Status: model.StatusOpen, Priority: 1, Labels: []string{"api"}},
+	}
+	m := NewModel(issues, nil, "")
+	m.width, m.height = 100, 40
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	m.lensDashboard.EnterGroupedView()
+	m.lensDashboard.SetGroupByMode(GroupByPriority)
+	m.lensDashboard.SetSectionOrder(SectionSortImpact, true)
+
+	m.refreshLensDashboard()
+	if s, desc := m.lensDashboard.SectionOrder(); s != SectionSortImpact || !desc {
+		t.Errorf("section order = %v desc=%v, want impact descending", s, desc)
+	}
+	if !m.lensDashboard.IsGroupedView() || m.lensDashboard.GetGroupByMode() != GroupByPriority {
+		t.Errorf("grouping = %v grouped=%v, want grouped by priority", m.lensDashboard.GetGroupByMode(), m.lensDashboard.IsGroupedView())
+	}
+	if m.lensDashboard.impact == nil {
+		t.Error("the impact sort lost its dependent counts")
+	}
+}
@@ -15,6 +15,7 @@ const (
 	DiffStatusNew                        // Issue was added since comparison point
 	DiffStatusClosed                     // Issue was closed since comparison point
 	DiffStatusModified                   // Issue was modified since comparison point
+	DiffStatusAffected                   // Issue is downstream of one that changed
 )
 
 // DiffBadge returns the badge string for a diff status
@@ -26,6 +27,8 @@ func (s DiffStatus) Badge() string {
 		return "✅"
 	case DiffStatusModified:
 		return "~"
+	case DiffStatusAffected:
+		return "↳"
 	default:
 		return ""
 	}
@@ -38,6 +41,7 @@ type IssueItem struct {
 	Impact     float64
 	DiffStatus DiffStatus // Diff state for time-travel mode
 	RepoPrefix string     // Repository prefix for workspace mode (e.g., "api", "web")
+	DisplayID  string     // ID as id_display shows it; empty shows the real ID
 
 	// Semantic/hybrid search scores (set when search is active)
 	SearchScore      float64
@@ -52,6 +56,8 @@ type IssueItem struct {
 	IsQuickWin    bool     // True if identified as a quick win
 	IsBlocker     bool     // True if this item blocks significant downstream work
 	UnblocksCount int      // Number of items this unblocks
+
+	DependentsCount int // Open issues transitively blocked by this one
 }
 
 func (i IssueItem) Title() string {
@@ -59,7 +65,15 @@ func (i IssueItem) Title() string {
 }
 
 func (i IssueItem) Description() string {
-	return fmt.Sprintf("%s %s • %s", i.Issue.ID, i.Issue.Status, i.Issue.Assignee)
+	return fmt.Sprintf("%s %s • %s", i.shownID(), i.Issue.Status, i.Issue.Assignee)
+}
+
+// shownID is the ID to display: DisplayID, or the real ID without one
+func (i IssueItem) shownID() string {
+	if i.DisplayID != "" {
+		return i.DisplayID
+	}
+	return i.Issue.ID
 }
 
 func (i IssueItem) FilterValue() string {
@@ -68,6 +82,10 @@ func (i IssueItem) FilterValue() string {
 	sb.WriteString(i.Issue.Title)
 	sb.WriteString(" ")
 	sb.WriteString(i.Issue.ID)
+	if alias := i.shownID(); alias != i.Issue.ID {
+		sb.WriteString(" ")
+		sb.WriteString(alias)
+	}
 	sb.WriteString(" ")
 	sb.WriteString(string(i.Issue.Status))
 	sb.WriteString(" ")
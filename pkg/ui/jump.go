@@ -0,0 +1,248 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// jumpCandidate is one issue the jump overlay can land on
+type jumpCandidate struct {
+	id    string
+	alias string // How the ID is shown under id_display
+	title string
+}
+
+// jumpMaxResults caps the matches listed; the overlay is for landing on a
+// known issue, not browsing
+const jumpMaxResults = 8
+
+// JumpModel is the lightweight `:` / ctrl+g overlay that fuzzy-matches issue
+// IDs and titles and moves the current view's cursor to the chosen issue
+type JumpModel struct {
+	candidates    []jumpCandidate
+	filtered      []jumpCandidate
+	input         textinput.Model
+	selectedIndex int
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewJumpModel creates an empty jump overlay
+func NewJumpModel(theme Theme) JumpModel {
+	ti := textinput.New()
+	ti.Placeholder = "issue ID or title..."
+	ti.CharLimit = 80
+	ti.Width = 40
+	ti.Focus()
+
+	return JumpModel{
+		input: ti,
+		theme: theme,
+	}
+}
+
+// SetIssues replaces the candidates and resets the query
+func (m *JumpModel) SetIssues(issues []model.Issue) {
+	m.candidates = make([]jumpCandidate, len(issues))
+	for i, issue := range issues {
+		m.candidates[i] = jumpCandidate{id: issue.ID, alias: m.theme.ID(issue.ID), title: issue.Title}
+	}
+	m.input.SetValue("")
+	m.filter()
+}
+
+// SetSize updates the overlay dimensions
+func (m *JumpModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// MoveUp moves selection up
+func (m *JumpModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves selection down
+func (m *JumpModel) MoveDown() {
+	if m.selectedIndex < len(m.filtered)-1 {
+		m.selectedIndex++
+	}
+}
+
+// SelectedID returns the ID of the selected match, or "" if nothing matches
+func (m *JumpModel) SelectedID() string {
+	if m.selectedIndex < 0 || m.selectedIndex >= len(m.filtered) {
+		return ""
+	}
+	return m.filtered[m.selectedIndex].id
+}
+
+// UpdateInput processes a key message for the text input
+func (m *JumpModel) UpdateInput(msg tea.Msg) {
+	m.input, _ = m.input.Update(msg)
+	m.filter()
+}
+
+// filter ranks candidates by their best score over alias, ID and title.
+// ID matches count double, so "42" puts bv-42 ahead of "Cache 42 results".
+// An empty query lists nothing.
+func (m *JumpModel) filter() {
+	m.selectedIndex = 0
+	m.filtered = nil
+	query := strings.ToLower(strings.TrimSpace(m.input.Value()))
+	if query == "" {
+		return
+	}
+
+	type scored struct {
+		c     jumpCandidate
+		score int
+	}
+	var matches []scored
+	for _, c := range m.candidates {
+		score := max(2*fuzzyScore(c.alias, query), 2*fuzzyScore(c.id, query), fuzzyScore(c.title, query))
+		if score > 0 {
+			matches = append(matches, scored{c, score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	for i, match := range matches {
+		if i == jumpMaxResults {
+			break
+		}
+		m.filtered = append(m.filtered, match.c)
+	}
+}
+
+// View renders the jump overlay near the top of the screen
+func (m *JumpModel) View() string {
+	t := m.theme
+	boxWidth := max(30, min(64, m.width-6))
+
+	var lines []string
+	lines = append(lines, t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("Jump to issue"))
+	lines = append(lines, m.input.View())
+
+	dimStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true)
+	switch {
+	case strings.TrimSpace(m.input.Value()) == "":
+	case len(m.filtered) == 0:
+		lines = append(lines, "", dimStyle.Render("  No matching issues"))
+	default:
+		lines = append(lines, "")
+		idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+		for i, c := range m.filtered {
+			prefix := "  "
+			titleStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+			if i == m.selectedIndex {
+				prefix = "> "
+				titleStyle = titleStyle.Foreground(t.Primary).Bold(true)
+			}
+			title := truncateRunesHelper(c.title, max(10, boxWidth-8-lipgloss.Width(c.alias)), "...")
+			lines = append(lines, prefix+idStyle.Render(c.alias)+"  "+titleStyle.Render(title))
+		}
+	}
+
+	lines = append(lines, "", dimStyle.Render("↑/↓: navigate | enter: jump | esc: cancel"))
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(0, 2).
+		Width(boxWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Top,
+		"\n\n"+boxStyle.Render(strings.Join(lines, "\n")),
+	)
+}
+
+// jumpAvailable reports whether : or ctrl+g may open the jump overlay: from
+// any view, but not while a text input has the keyboard or a modal panel
+// is open
+func (m Model) jumpAvailable() bool {
+	switch {
+	case m.textInputActive():
+		return false
+	case m.showQuitConfirm, m.showActionResult, m.showAgentPrompt, m.showCassModal, m.showHealth, m.showDuplicates, m.showWorkstreamCompare, m.showPendingChanges, m.focused == focusTutorial:
+		return false
+	case m.showReviewDashboard || m.focused == focusReviewDashboard:
+		return m.reviewDashboard != nil && !m.reviewDashboard.HasActiveModal()
+	}
+	return true
+}
+
+// openJump opens the jump overlay over every loaded issue
+func (m *Model) openJump() {
+	m.jump.SetIssues(m.issues)
+	m.jump.SetSize(m.width, m.height-1)
+	m.showJump = true
+}
+
+// handleJumpKeys handles keyboard input while the jump overlay is open
+func (m Model) handleJumpKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "esc", "ctrl+g":
+		m.showJump = false
+	case "down", "ctrl+n", "tab":
+		m.jump.MoveDown()
+	case "up", "ctrl+p", "shift+tab":
+		m.jump.MoveUp()
+	case "enter":
+		if id := m.jump.SelectedID(); id != "" {
+			m.showJump = false
+			return m.jumpToIssue(id)
+		}
+	default:
+		m.jump.UpdateInput(msg)
+	}
+	return m
+}
+
+// jumpToIssue moves the cursor of the view on screen to id, expanding the
+// lens dashboard's groups as needed. Views without an issue cursor of their
+// own jump in the list, clearing filters that hide the issue.
+func (m Model) jumpToIssue(id string) Model {
+	var found bool
+	switch {
+	case m.showReviewDashboard && m.reviewDashboard != nil:
+		found = m.reviewDashboard.SelectIssue(id)
+	case m.showLensDashboard:
+		found = m.lensDashboard.SelectIssue(id)
+	case m.isBoardView:
+		found = m.board.SelectByID(id)
+	case m.isGraphView:
+		found = m.graphView.SelectByID(id)
+	default:
+		m.exitToListView()
+		if found = m.selectIssueInList(id); !found {
+			m.clearAllFilters()
+			found = m.selectIssueInList(id)
+		}
+		m.updateViewportContent()
+	}
+
+	if !found {
+		m.statusMsg = fmt.Sprintf("%s is not shown in this view", m.theme.ID(id))
+		m.statusIsError = true
+		return m
+	}
+	m.statusMsg = "Jumped to " + m.theme.ID(id)
+	m.statusIsError = false
+	return m
+}
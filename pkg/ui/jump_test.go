@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestJumpFiltering(t *testing.T) {
+	j := NewJumpModel(Theme{Renderer: lipgloss.DefaultRenderer()})
+	j.SetIssues([]model.Issue{
+		{ID: "bv-7", Title: "Cache 42 results"},
+		{ID: "bv-42", Title: "Fix login"},
+		{ID: "bv-9", Title: "Refresh login token"},
+	})
+	if j.SelectedID() != "" {
+		t.Fatal("an empty query should match nothing")
+	}
+
+	j.input.SetValue("42")
+	j.filter()
+	if got := j.SelectedID(); got != "bv-42" {
+		t.Errorf("42 selected %q, want the ID match bv-42", got)
+	}
+
+	j.input.SetValue("login")
+	j.filter()
+	if len(j.filtered) != 2 {
+		t.Errorf("login matched %d issues, want 2", len(j.filtered))
+	}
+
+	j.input.SetValue("zzz")
+	j.filter()
+	if !strings.Contains(j.View(), "No matching issues") {
+		t.Error("expected the empty-state message")
+	}
+}
+
+func TestJumpMovesCurrentViewCursor(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "One", Status: model.StatusOpen},
+		{ID: "bv-2", Title: "Two", Status: model.StatusInProgress},
+		{ID: "bv-3", Title: "Three", Status: model.StatusClosed},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	m = updated.(Model)
+
+	jump := func(m Model, opener tea.KeyMsg, query string) Model {
+		updated, _ := m.Update(opener)
+		m = updated.(Model)
+		if !m.showJump {
+			t.Fatalf("%s should open the jump overlay", opener)
+		}
+		m = typePalette(m, query)
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		return updated.(Model)
+	}
+
+	// The list clears the filter hiding a closed issue
+	m = jump(m, keyMsg(":"), "three")
+	if m.showJump {
+		t.Fatal("enter should close the overlay")
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != "bv-3" {
+		t.Errorf("list selection = %v, want bv-3", m.list.SelectedItem())
+	}
+
+	// The board stays open and focuses the card's column
+	updated, _ = m.Update(keyMsg("b"))
+	m = updated.(Model)
+	m = jump(m, tea.KeyMsg{Type: tea.KeyCtrlG}, "bv-2")
+	if !m.isBoardView {
+		t.Fatal("jumping should not leave the board")
+	}
+	if sel := m.board.SelectedIssue(); sel == nil || sel.ID != "bv-2" {
+		t.Errorf("board selection = %v, want bv-2", sel)
+	}
+}
+
+func TestLensDashboardSelectIssueExpands(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A1", Status: model.StatusOpen, Labels: []string{"l"}},
+		{ID: "A2", Status: model.StatusOpen, Labels: []string{"l"}},
+		{ID: "B1", Status: model.StatusOpen, Labels: []string{"l"}},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	dashboard := NewLensDashboardModel("l", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	dashboard.SetSize(100, 60)
+
+	dashboard.EnterGroupedView()
+	dashboard.groupedSections = []analysis.Workstream{
+		{ID: "alpha", Name: "alpha", Issues: issues[:2], SubWorkstreams: []*analysis.Workstream{
+			{ID: "a1", Name: "a1", Issues: issues[:1]},
+			{ID: "a2", Name: "a2", Issues: issues[1:2]},
+		}},
+		{ID: "beta", Name: "beta", Issues: issues[2:]},
+	}
+	dashboard.groupedExpanded = map[int]bool{}
+	dashboard.groupedSubExpanded = map[int]map[int]bool{}
+
+	if !dashboard.SelectIssue("A2") {
+		t.Fatal("A2 is in sub-group a2")
+	}
+	if dashboard.CursorRow() != LensRowIssue || dashboard.CursorRowName() != "a2" || dashboard.SelectedIssueID() != "A2" {
+		t.Errorf("on row %d of %q at %q, want A2 in a2", dashboard.CursorRow(), dashboard.CursorRowName(), dashboard.SelectedIssueID())
+	}
+	if !dashboard.IsGroupExpanded(0) || !dashboard.groupedSubExpanded[0][1] {
+		t.Error("the group and sub-group holding A2 should be expanded")
+	}
+	if dashboard.SelectIssue("nope") {
+		t.Error("an unknown issue cannot be selected")
+	}
+}
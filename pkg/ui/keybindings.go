@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui/keymap"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// buildKeymap applies keymap overrides (action -> keys) and then
+// keybindings (key -> key it acts as) from the project config to the
+// built-in table, so both show up in help and in keymap conflicts. Entries
+// naming a key bubbletea does not know are skipped; every problem is
+// reported in the returned error.
+func buildKeymap(overrides map[string][]string, aliases map[string]string) (*keymap.Keymap, error) {
+	km := keymap.Default()
+	valid := make(map[string][]string, len(overrides))
+	var problems []string
+	for action, names := range overrides {
+		keys := make([]string, 0, len(names))
+		for _, name := range names {
+			key, ok := parseKey(name)
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s: unknown key %q", action, name))
+				keys = nil
+				break
+			}
+			keys = append(keys, key.String())
+		}
+		if keys != nil {
+			valid[action] = keys
+		}
+	}
+	sort.Strings(problems)
+
+	if err := km.Apply(valid); err != nil {
+		problems = append(problems, strings.TrimPrefix(err.Error(), "keymap: "))
+	}
+
+	validAliases := make(map[string]string, len(aliases))
+	var badAliases []string
+	for from, to := range aliases {
+		fromKey, okFrom := parseKey(from)
+		toKey, okTo := parseKey(to)
+		if !okFrom || !okTo {
+			badAliases = append(badAliases, fmt.Sprintf("keybinding %s: unknown key", from))
+			continue
+		}
+		validAliases[fromKey.String()] = toKey.String()
+	}
+	sort.Strings(badAliases)
+	problems = append(problems, badAliases...)
+	if err := km.ApplyAliases(validAliases); err != nil {
+		problems = append(problems, "keybinding "+strings.TrimPrefix(err.Error(), "keymap: "))
+	}
+	if len(problems) > 0 {
+		return km, errors.New(strings.Join(problems, "; "))
+	}
+	return km, nil
+}
+
+// joinConflicts lists keymap conflicts for the status bar
+func joinConflicts(conflicts []keymap.Conflict) string {
+	parts := make([]string, len(conflicts))
+	for i, c := range conflicts {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, "; ")
+}
+
+// keyContext names the keymap context for the screen that receives the next
+// key. Overlays with their own small key sets return "" and are not remapped.
+func (m Model) keyContext() keymap.Context {
+	switch {
+	case m.showAgentPrompt, m.showCassModal, m.showHelp, m.showLabelHealthDetail, m.showLabelDrilldown,
+		m.showLabelGraphAnalysis, m.showAttentionView, m.showAlertsPanel, m.showPendingChanges, m.showActionResult, m.showHealth, m.showDuplicates, m.showWorkstreamCompare, m.showThemeGallery, m.showQuitConfirm:
+		return ""
+	case m.showLensSelector || m.focused == focusLensSelector:
+		return keymap.LensSelector
+	case m.showLensDashboard || m.focused == focusLensDashboard:
+		return keymap.Lens
+	case m.showReviewDashboard || m.focused == focusReviewDashboard:
+		switch {
+		case m.reviewDashboard == nil, m.reviewDashboard.HasActiveModal():
+			return ""
+		case m.reviewDashboard.IsShowingSummary():
+			return keymap.ReviewSummary
+		}
+		return keymap.Review
+	}
+
+	switch m.focused {
+	case focusList:
+		return keymap.List
+	case focusBoard:
+		return keymap.Board
+	case focusGraph:
+		return keymap.Graph
+	case focusInsights:
+		return keymap.Insights
+	case focusHistory:
+		return keymap.History
+	case focusActionable:
+		return keymap.Actionable
+	case focusLabelDashboard:
+		return keymap.Labels
+	case focusDetail, focusSprint, focusFlowMatrix, focusStatsDashboard:
+		return keymap.Global
+	}
+	return ""
+}
+
+// builtinKeyMsg is a built-in key sent by bv itself (the command palette
+// replaying an action). Update handles it as a key press without translating
+// it, so rebinding the action's keys doesn't change what it does.
+type builtinKeyMsg tea.KeyMsg
+
+// activeKeymap returns the keymap, or the built-in one when none was built
+func (m Model) activeKeymap() *keymap.Keymap {
+	if m.keymap == nil {
+		return keymap.Default()
+	}
+	return m.keymap
+}
+
+// keyHint returns the first key bound to action, or "" when it has none
+func (m Model) keyHint(action string) string {
+	if keys := m.activeKeymap().Keys(action); len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+// translateKey rewrites a key press into the built-in key its handler
+// matches, through the keymap. ok is false when the press was rebound away
+// and should be dropped. Nothing is rewritten while a text input has the
+// keyboard.
+func (m Model) translateKey(key tea.KeyMsg) (tea.KeyMsg, bool) {
+	if m.textInputActive() {
+		return key, true
+	}
+	if _, ok := m.customActionFor(key.String()); ok && m.statusTargetID() != "" {
+		return key, true // Actions take their key before any rewriting
+	}
+	ctx := m.keyContext()
+	if m.keymap == nil || ctx == "" {
+		return key, true
+	}
+	to, ok := m.keymap.Translate(ctx, key.String())
+	if !ok {
+		return key, false
+	}
+	if to == key.String() {
+		return key, true
+	}
+	if translated, ok := parseKey(to); ok {
+		return translated, true
+	}
+	return key, true
+}
@@ -0,0 +1,285 @@
+package keymap
+
+// defaults is the built-in binding table. The first key of each action is
+// the one its view handler matches. Keys a global binding shadows (h and l
+// in the board, graph and insights views) are left out.
+var defaults = []struct {
+	action string
+	keys   []string
+	help   string
+}{
+	// Global: checked before the focused view's own keys
+	{"global.help", []string{"?", "f1"}, "This help"},
+	{"global.tutorial", []string{"`"}, "Tutorial"},
+	{"global.shortcuts", []string{";", "f2"}, "Shortcuts bar"},
+	{"global.shortcuts_down", []string{"ctrl+j"}, "Scroll shortcuts bar down"},
+	{"global.shortcuts_up", []string{"ctrl+k"}, "Scroll shortcuts bar up"},
+	{"global.palette", []string{"ctrl+p"}, "Command palette"},
+	{"global.jump", []string{":", "ctrl+g"}, "Jump to issue"},
+	{"global.status", []string{"ctrl+x"}, "Set status of selected issue"},
+	{"global.code_ref", []string{"ctrl+]"}, "Open file the issue names in $EDITOR"},
+	{"global.quit", []string{"q"}, "Back / Quit"},
+	{"global.back", []string{"esc"}, "Back / close"},
+	{"global.focus", []string{"tab"}, "Switch focus"},
+	{"global.board", []string{"b"}, "Kanban board"},
+	{"global.graph", []string{"g"}, "Graph view"},
+	{"global.actionable", []string{"a"}, "Actionable"},
+	{"global.insights", []string{"i"}, "Insights"},
+	{"global.priority_hints", []string{"p"}, "Priority hints"},
+	{"global.history", []string{"h"}, "History view"},
+	{"global.label_dashboard", []string{"[", "f3"}, "Label dashboard"},
+	{"global.attention", []string{"]", "f4"}, "Attention view"},
+	{"global.flow_matrix", []string{"f"}, "Flow matrix"},
+	{"global.stats", []string{"D"}, "Stats dashboard"},
+	{"global.table", []string{"R"}, "Table view"},
+	{"global.alerts", []string{"!"}, "Alerts panel"},
+	{"global.pending_changes", []string{"W"}, "Pending changes (dry run)"},
+	{"global.health", []string{"E"}, "Health check"},
+	{"global.duplicates", []string{"="}, "Possible duplicates"},
+	{"global.themes", []string{"ctrl+t"}, "Theme gallery"},
+	{"global.recipes", []string{"'", "f5"}, "Recipes"},
+	{"global.repo_picker", []string{"w"}, "Repo picker"},
+	{"global.export", []string{"x"}, "Export markdown"},
+	{"global.label_picker", []string{"l"}, "Filter by label"},
+	{"global.lens", []string{"L"}, "Lens selector"},
+
+	// Issue list
+	{"list.down", []string{"j", "down"}, "Move down"},
+	{"list.up", []string{"k", "up"}, "Move up"},
+	{"list.search", []string{"/"}, "Fuzzy search"},
+	{"list.semantic_search", []string{"ctrl+s"}, "Semantic search"},
+	{"list.hybrid", []string{"H"}, "Hybrid ranking"},
+	{"list.hybrid_preset", []string{"alt+h", "alt+H"}, "Hybrid preset"},
+	{"list.open", []string{"enter"}, "View details"},
+	{"list.top", []string{"home"}, "Go to first"},
+	{"list.bottom", []string{"G", "end"}, "Go to last"},
+	{"list.page_down", []string{"ctrl+d"}, "Page down"},
+	{"list.page_up", []string{"ctrl+u"}, "Page up"},
+	{"list.filter_open", []string{"o"}, "Open issues"},
+	{"list.filter_closed", []string{"c"}, "Closed issues"},
+	{"list.filter_ready", []string{"r"}, "Ready (unblocked)"},
+	{"list.filter_affected", []string{"A"}, "Affected by time-travel changes"},
+	{"list.time_travel", []string{"t"}, "Time-travel"},
+	{"list.time_travel_quick", []string{"T"}, "Quick time-travel"},
+	{"list.copy", []string{"C"}, "Copy to clipboard"},
+	{"list.context", []string{"y"}, "Copy agent context"},
+	{"list.edit", []string{"O"}, "Open in editor"},
+	{"list.triage_sort", []string{"S"}, "Triage sort"},
+	{"list.sort", []string{"s"}, "Cycle sort"},
+	{"list.sessions", []string{"V"}, "Agent sessions"},
+	{"list.peek", []string{"K"}, "Peek at issue"},
+	{"list.blocker_chain", []string{"B"}, "Why is this blocked?"},
+	{"list.next_link", []string{"n"}, "Next link in details"},
+	{"list.prev_link", []string{"N"}, "Previous link in details"},
+	{"list.open_link", []string{"v"}, "Open selected link"},
+
+	// Kanban board
+	{"board.left", []string{"left"}, "Previous column"},
+	{"board.right", []string{"right"}, "Next column"},
+	{"board.down", []string{"j", "down"}, "Move down"},
+	{"board.up", []string{"k", "up"}, "Move up"},
+	{"board.top", []string{"home"}, "First card"},
+	{"board.bottom", []string{"G", "end"}, "Last card"},
+	{"board.page_down", []string{"ctrl+d"}, "Page down"},
+	{"board.page_up", []string{"ctrl+u"}, "Page up"},
+	{"board.column_1", []string{"1"}, "Jump to column 1"},
+	{"board.column_2", []string{"2"}, "Jump to column 2"},
+	{"board.column_3", []string{"3"}, "Jump to column 3"},
+	{"board.column_4", []string{"4"}, "Jump to column 4"},
+	{"board.first_column", []string{"H"}, "First column"},
+	{"board.last_column", []string{"L"}, "Last column"},
+	{"board.column_top", []string{"0"}, "Top of column"},
+	{"board.column_bottom", []string{"$"}, "Bottom of column"},
+	{"board.search", []string{"/"}, "Search cards"},
+	{"board.next_match", []string{"n"}, "Next match"},
+	{"board.prev_match", []string{"N"}, "Previous match"},
+	{"board.context", []string{"y"}, "Copy agent context"},
+	{"board.copy_id", []string{"Y"}, "Copy ID"},
+	{"board.filter_open", []string{"o"}, "Open issues"},
+	{"board.filter_closed", []string{"c"}, "Closed issues"},
+	{"board.filter_ready", []string{"r"}, "Ready (unblocked)"},
+	{"board.swimlanes", []string{"s"}, "Cycle swimlanes"},
+	{"board.empty_columns", []string{"e"}, "Empty columns"},
+	{"board.expand", []string{"d"}, "Expand card"},
+	{"board.detail", []string{"tab"}, "Detail panel"},
+	{"board.detail_down", []string{"ctrl+j"}, "Scroll detail down"},
+	{"board.detail_up", []string{"ctrl+k"}, "Scroll detail up"},
+	{"board.open", []string{"enter"}, "Jump to issue"},
+
+	// Dependency graph
+	{"graph.left", []string{"left"}, "Node left"},
+	{"graph.down", []string{"j", "down"}, "Node down"},
+	{"graph.up", []string{"k", "up"}, "Node up"},
+	{"graph.right", []string{"right"}, "Node right"},
+	{"graph.page_up", []string{"pgup", "ctrl+u"}, "Scroll up"},
+	{"graph.page_down", []string{"pgdown", "ctrl+d"}, "Scroll down"},
+	{"graph.scroll_left", []string{"H"}, "Scroll left"},
+	{"graph.scroll_right", []string{"L"}, "Scroll right"},
+	{"graph.archaeology", []string{"A"}, "Archaeology (closed)"},
+	{"graph.canvas", []string{"c"}, "Layered canvas"},
+	{"graph.context", []string{"y"}, "Copy agent context"},
+	{"graph.open", []string{"enter"}, "Jump to issue"},
+
+	// Insights
+	{"insights.down", []string{"j", "down"}, "Next item"},
+	{"insights.up", []string{"k", "up"}, "Previous item"},
+	{"insights.detail_down", []string{"ctrl+j"}, "Scroll detail down"},
+	{"insights.detail_up", []string{"ctrl+k"}, "Scroll detail up"},
+	{"insights.prev_panel", []string{"left"}, "Previous panel"},
+	{"insights.next_panel", []string{"right", "tab"}, "Next panel"},
+	{"insights.explain", []string{"e"}, "Explanations"},
+	{"insights.calc", []string{"x"}, "Calc details"},
+	{"insights.heatmap", []string{"m"}, "Toggle heatmap"},
+	{"insights.context", []string{"y"}, "Copy agent context"},
+	{"insights.open", []string{"enter"}, "Jump to issue"},
+
+	// History
+	{"history.down", []string{"j", "down"}, "Next bead"},
+	{"history.up", []string{"k", "up"}, "Previous bead"},
+	{"history.next_commit", []string{"J"}, "Next commit"},
+	{"history.prev_commit", []string{"K"}, "Previous commit"},
+	{"history.focus", []string{"tab"}, "Toggle focus"},
+	{"history.search", []string{"/"}, "Search"},
+	{"history.mode", []string{"v"}, "Bead / git mode"},
+	{"history.open", []string{"enter"}, "Jump to issue"},
+	{"history.copy_sha", []string{"y"}, "Copy SHA"},
+	{"history.context", []string{"Y"}, "Copy bead's agent context"},
+	{"history.confidence", []string{"c"}, "Confidence filter"},
+	{"history.files", []string{"f", "F"}, "File tree"},
+	{"history.browse", []string{"o"}, "Open commit"},
+
+	// Actionable plan
+	{"actionable.down", []string{"j", "down"}, "Move down"},
+	{"actionable.up", []string{"k", "up"}, "Move up"},
+	{"actionable.context", []string{"y"}, "Copy agent context"},
+	{"actionable.open", []string{"enter"}, "Jump to issue"},
+
+	// Label dashboard
+	{"labels.down", []string{"j", "down"}, "Move down"},
+	{"labels.up", []string{"k", "up"}, "Move up"},
+	{"labels.top", []string{"home"}, "First label"},
+	{"labels.bottom", []string{"G", "end"}, "Last label"},
+	{"labels.drilldown", []string{"d"}, "Drill down"},
+	{"labels.open", []string{"enter"}, "Filter by label"},
+
+	// Lens dashboard
+	{"lens.view", []string{"w"}, "Flat / workstream"},
+	{"lens.down", []string{"j", "down"}, "Move down"},
+	{"lens.up", []string{"k", "up"}, "Move up"},
+	{"lens.grouped", []string{"g"}, "Grouped view"},
+	{"lens.group_by", []string{"G"}, "Group by / scoped graph"},
+	{"lens.top", []string{"u"}, "Go to top"},
+	{"lens.bottom", []string{"d"}, "Go to bottom"},
+	{"lens.page_down", []string{"ctrl+d"}, "Page down"},
+	{"lens.page_up", []string{"ctrl+u"}, "Page up"},
+	{"lens.next_section", []string{"]"}, "Next section"},
+	{"lens.prev_section", []string{"["}, "Previous section"},
+	{"lens.stream_1", []string{"1"}, "Jump to workstream 1"},
+	{"lens.stream_2", []string{"2"}, "Jump to workstream 2"},
+	{"lens.stream_3", []string{"3"}, "Jump to workstream 3"},
+	{"lens.stream_4", []string{"4"}, "Jump to workstream 4"},
+	{"lens.stream_5", []string{"5"}, "Jump to workstream 5"},
+	{"lens.stream_6", []string{"6"}, "Jump to workstream 6"},
+	{"lens.stream_7", []string{"7"}, "Jump to workstream 7"},
+	{"lens.stream_8", []string{"8"}, "Jump to workstream 8"},
+	{"lens.stream_9", []string{"9"}, "Jump to workstream 9"},
+	{"lens.next_page", []string{">"}, "Next workstream page"},
+	{"lens.prev_page", []string{"<"}, "Previous workstream page"},
+	{"lens.depth", []string{"t"}, "Cycle depth"},
+	{"lens.order", []string{"o"}, "Order within status"},
+	{"lens.order_direction", []string{"O"}, "Reverse order within status"},
+	{"lens.tree", []string{"T"}, "Toggle tree"},
+	{"lens.focus", []string{"tab"}, "Tree / detail focus"},
+	{"lens.detail", []string{"v"}, "Show / hide detail panel"},
+	{"lens.expand_all", []string{"z"}, "Expand all"},
+	{"lens.collapse_all", []string{"Z"}, "Collapse all"},
+	{"lens.archaeology", []string{"A"}, "Archaeology (closed)"},
+	{"lens.insights", []string{"I"}, "Scoped insights"},
+	{"lens.board", []string{"B"}, "Scoped board"},
+	{"lens.drill", []string{"L"}, "Open issue as its own lens"},
+	{"lens.history_back", []string{"ctrl+o"}, "Previous lens"},
+	{"lens.history_forward", []string{"ctrl+n"}, "Next lens"},
+	{"lens.copy", []string{"C"}, "Copy ID and title"},
+	{"lens.prompt", []string{"P"}, "Copy work prompt"},
+	{"lens.context", []string{"y"}, "Copy agent context"},
+	{"lens.scope", []string{"s"}, "Add scope label"},
+	{"lens.scope_mode", []string{"S"}, "Scope ANY / ALL"},
+	{"lens.scope_pop", []string{"backspace", "ctrl+h"}, "Remove scope label"},
+	{"lens.search", []string{"/"}, "Search (tab keeps it as a filter)"},
+	{"lens.status_filter", []string{"f"}, "Cycle status filter"},
+	{"lens.type_filter", []string{"F"}, "Cycle type filter"},
+	{"lens.filter_pill", []string{"x"}, "Select filter pill (enter removes)"},
+	{"lens.review", []string{"r"}, "Review"},
+	{"lens.review_scope", []string{"R"}, "Review visible issues"},
+	{"lens.compare", []string{"c"}, "Compare two workstreams"},
+	{"lens.rename", []string{"n"}, "Rename workstream"},
+	{"lens.cut", []string{"X"}, "Cut issue to move to another workstream"},
+	{"lens.paste", []string{"p"}, "Move cut issue here (pins it)"},
+	{"lens.stalled", []string{"W"}, "Only stalled workstreams"},
+	{"lens.help", []string{"?", "f1"}, "Help"},
+	{"lens.back", []string{"esc", "q"}, "Back"},
+	{"lens.open", []string{"enter"}, "Toggle header / open issue"},
+	{"lens.expand", []string{"l", "right"}, "Expand / step in"},
+	{"lens.collapse", []string{"h", "left"}, "Collapse / step out"},
+
+	// Lens selector
+	{"lens_selector.up", []string{"up", "k"}, "Move up"},
+	{"lens_selector.down", []string{"down", "j"}, "Move down"},
+	{"lens_selector.jump_up", []string{"u"}, "Up 5"},
+	{"lens_selector.jump_down", []string{"d"}, "Down 5"},
+	{"lens_selector.search", []string{"i", "/"}, "Search"},
+	{"lens_selector.scope", []string{"s"}, "Add scope label"},
+	{"lens_selector.scope_mode", []string{"S"}, "Scope ANY / ALL"},
+	{"lens_selector.mode", []string{"m"}, "Cycle search mode"},
+	{"lens_selector.save_view", []string{"w"}, "Save view"},
+	{"lens_selector.views", []string{"v"}, "Saved views"},
+	{"lens_selector.review", []string{"r"}, "Review"},
+	{"lens_selector.compare", []string{"c"}, "Compare two lenses"},
+	{"lens_selector.manage_label", []string{"e"}, "Rename / merge label"},
+	{"lens_selector.pin", []string{"p"}, "Pin / unpin lens"},
+	{"lens_selector.open", []string{"enter"}, "Open lens"},
+	{"lens_selector.back", []string{"esc", "q"}, "Cancel"},
+	{"lens_selector.clear", []string{"backspace"}, "Clear search / scope"},
+
+	// Review dashboard
+	{"review.down", []string{"j", "down"}, "Move down"},
+	{"review.up", []string{"k", "up"}, "Move up"},
+	{"review.top", []string{"g", "home"}, "Go to first"},
+	{"review.bottom", []string{"G", "end"}, "Go to last"},
+	{"review.page_up", []string{"ctrl+u"}, "Page up"},
+	{"review.page_down", []string{"ctrl+d"}, "Page down"},
+	{"review.filter", []string{"f"}, "Cycle filter"},
+	{"review.focus", []string{"tab"}, "Tree / detail focus"},
+	{"review.next_unreviewed", []string{"]"}, "Next unreviewed"},
+	{"review.prev_unreviewed", []string{"["}, "Previous unreviewed"},
+	{"review.note", []string{"n"}, "Add note"},
+	{"review.approve", []string{"a"}, "Approve"},
+	{"review.revise", []string{"r"}, "Request revision"},
+	{"review.defer", []string{"d"}, "Defer"},
+	{"review.undo", []string{"u"}, "Undo"},
+	{"review.redo", []string{"ctrl+r"}, "Redo"},
+	{"review.reset", []string{"U"}, "Unapprove"},
+	{"review.mark", []string{" "}, "Mark for bulk action"},
+	{"review.visual", []string{"V"}, "Visual range"},
+	{"review.mark_subtree", []string{"+"}, "Mark subtree"},
+	{"review.mark_all", []string{"*"}, "Mark everything shown"},
+	{"review.label_add", []string{"l"}, "Add label"},
+	{"review.label_remove", []string{"L"}, "Remove label"},
+	{"review.help", []string{"?"}, "Help"},
+	{"review.search", []string{"/"}, "Search"},
+	{"review.scope", []string{"s"}, "Add scope label"},
+	{"review.clear_scope", []string{"S"}, "Clear scope"},
+	{"review.assign", []string{"A"}, "Assign"},
+	{"review.context", []string{"y"}, "Copy agent context"},
+	{"review.changes", []string{"c"}, "Field changes from git"},
+	{"review.team", []string{"T"}, "Progress per reviewer"},
+	{"review.save", []string{"w"}, "Save reviews"},
+	{"review.quit", []string{"q", "esc"}, "Finish review"},
+
+	// Review summary screen
+	{"review_summary.save", []string{"q"}, "Save and quit"},
+	{"review_summary.discard", []string{"Q"}, "Discard and quit"},
+	{"review_summary.back", []string{"esc"}, "Back to review"},
+	{"review_summary.copy", []string{"p"}, "Copy summary"},
+	{"review_summary.copy_full", []string{"P"}, "Copy review prompt"},
+}
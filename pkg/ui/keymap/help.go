@@ -0,0 +1,188 @@
+package keymap
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// HelpSection is one panel of the help overlay
+type HelpSection struct {
+	Title string
+	Icon  string
+	Rows  []HelpRow
+}
+
+// HelpRow is a key column and its description
+type HelpRow struct {
+	Key  string
+	Desc string
+}
+
+// contextTitles names each context's help section
+var contextTitles = map[Context]struct{ title, icon string }{
+	Global:        {"Global", "🌐"},
+	List:          {"Issue list", "📋"},
+	Board:         {"Kanban board", "🗂"},
+	Graph:         {"Graph view", "📊"},
+	Insights:      {"Insights", "💡"},
+	History:       {"History", "📜"},
+	Actionable:    {"Actionable", "🎯"},
+	Labels:        {"Label dashboard", "🏷"},
+	Lens:          {"Lens dashboard", "🔭"},
+	LensSelector:  {"Lens selector", "🔍"},
+	Review:        {"Review", "📝"},
+	ReviewSummary: {"Review summary", "📑"},
+}
+
+// fixedRows are keys no view handler lets the keymap rebind
+var fixedRows = map[Context][]HelpRow{
+	Global: {{Key: "Ctrl+c", Desc: "Force quit"}},
+}
+
+// keyColumnWidth is the widest key label the help overlay fits on one line
+const keyColumnWidth = 9
+
+// Title returns the name the help overlay gives the context
+func (c Context) Title() string {
+	if t, ok := contextTitles[c]; ok {
+		return t.title
+	}
+	return string(c)
+}
+
+// HelpSections lays out the help overlay for ctx from the current bindings:
+// a Conflicts section when some binding shadows another, the context's
+// actions in table order, then the global ones when they are live there
+func (km *Keymap) HelpSections(ctx Context) []HelpSection {
+	var sections []HelpSection
+
+	// Keys the config made unreachable come first, so they aren't missed
+	if conflicts := km.Conflicts(); len(conflicts) > 0 {
+		s := HelpSection{Title: "Conflicts", Icon: "⚠"}
+		for _, c := range conflicts {
+			s.Rows = append(s.Rows, HelpRow{Key: FormatKey(c.Key), Desc: c.Shadowed + " (lost to " + c.Winner + ")"})
+		}
+		sections = append(sections, s)
+	}
+
+	contexts := []Context{ctx}
+	if ctx.inheritsGlobal() {
+		contexts = append(contexts, Global)
+	}
+	for _, c := range contexts {
+		s := HelpSection{Title: contextTitles[c].title, Icon: contextTitles[c].icon}
+		s.Rows = append(km.contextRows(c), fixedRows[c]...)
+		if len(s.Rows) > 0 {
+			sections = append(sections, s)
+		}
+	}
+	return sections
+}
+
+// contextRows is one help row per action of ctx. A run of numbered actions
+// on single keys ("Jump to column 1" on 1 through 4) shares one row ("1-4").
+func (km *Keymap) contextRows(ctx Context) []HelpRow {
+	var rows []HelpRow
+	var run []Binding
+	flush := func() {
+		if len(run) > 2 {
+			first, last := run[0].Keys[0], run[len(run)-1].Keys[0]
+			rows = append(rows, HelpRow{Key: FormatKey(first) + "-" + FormatKey(last), Desc: numberedStem(run[0].Help)})
+		} else {
+			for _, b := range run {
+				rows = append(rows, HelpRow{Key: joinKeys(b.Keys, true), Desc: b.Help})
+			}
+		}
+		run = nil
+	}
+	for _, b := range km.bindings {
+		if b.Context != ctx {
+			continue
+		}
+		if len(run) > 0 && !continuesRun(run[len(run)-1], b) {
+			flush()
+		}
+		run = append(run, b)
+	}
+	flush()
+	return rows
+}
+
+// continuesRun reports whether b follows prev in a numbered run: the same
+// help text with the next number, each on the key of its number
+func continuesRun(prev, b Binding) bool {
+	if len(prev.Keys) != 1 || len(b.Keys) != 1 || numberedStem(prev.Help) != numberedStem(b.Help) {
+		return false
+	}
+	n, ok := helpNumber(prev.Help)
+	next, ok2 := helpNumber(b.Help)
+	return ok && ok2 && next == n+1 && prev.Keys[0] == strconv.Itoa(n) && b.Keys[0] == strconv.Itoa(next)
+}
+
+// numberedStem drops a trailing number from a help text: "Jump to column 2"
+// becomes "Jump to column"
+func numberedStem(help string) string {
+	if _, ok := helpNumber(help); !ok {
+		return help
+	}
+	return strings.TrimRight(help, "0123456789 ")
+}
+
+// helpNumber returns the number a help text ends with
+func helpNumber(help string) (int, bool) {
+	i := strings.LastIndexByte(help, ' ')
+	n, err := strconv.Atoi(help[i+1:])
+	return n, err == nil
+}
+
+// joinKeys formats keys as "a/b". Alternatives for a single action are
+// dropped once the label would overflow the key column.
+func joinKeys(keys []string, alternatives bool) string {
+	label := ""
+	for i, k := range keys {
+		next := FormatKey(k)
+		if i > 0 {
+			next = label + "/" + next
+		}
+		if i > 0 && alternatives && utf8.RuneCountInString(next) > keyColumnWidth {
+			break
+		}
+		label = next
+	}
+	return label
+}
+
+var keyNames = map[string]string{
+	"up":        "↑",
+	"down":      "↓",
+	"left":      "←",
+	"right":     "→",
+	"enter":     "Enter",
+	"esc":       "Esc",
+	"tab":       "Tab",
+	"backspace": "Bksp",
+	"pgup":      "PgUp",
+	"pgdown":    "PgDn",
+	"home":      "Home",
+	"end":       "End",
+	" ":         "Space",
+	"space":     "Space",
+}
+
+// FormatKey renders a key name for display: "ctrl+d" → "Ctrl+d", "down" → "↓"
+func FormatKey(key string) string {
+	var prefix strings.Builder
+	for _, mod := range []string{"ctrl+", "alt+", "shift+"} {
+		if rest, ok := strings.CutPrefix(key, mod); ok && rest != "" {
+			prefix.WriteString(strings.ToUpper(mod[:1]) + mod[1:])
+			key = rest
+		}
+	}
+	if name, ok := keyNames[key]; ok {
+		key = name
+	} else if len(key) > 1 && key[0] == 'f' && strings.Trim(key[1:], "0123456789") == "" {
+		key = "F" + key[1:]
+	}
+	return prefix.String() + key
+}
@@ -0,0 +1,308 @@
+// Package keymap holds the TUI's key binding table. Every view handler keeps
+// matching on its built-in key names; the table translates what the user
+// pressed into those names, so rebinding an action never touches a handler.
+package keymap
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Context is the part of the UI a binding applies in
+type Context string
+
+const (
+	Global        Context = "global"
+	List          Context = "list"
+	Board         Context = "board"
+	Graph         Context = "graph"
+	Insights      Context = "insights"
+	History       Context = "history"
+	Actionable    Context = "actionable"
+	Labels        Context = "labels"
+	Lens          Context = "lens"
+	LensSelector  Context = "lens_selector"
+	Review        Context = "review"
+	ReviewSummary Context = "review_summary"
+)
+
+// inheritsGlobal reports whether global keys are live in the context. The
+// lens and review screens take the keyboard before the global keys run.
+func (c Context) inheritsGlobal() bool {
+	switch c {
+	case List, Board, Graph, Insights, History, Actionable, Labels:
+		return true
+	}
+	return false
+}
+
+// Binding is one action and the keys that trigger it
+type Binding struct {
+	Action  string // "<context>.<name>", e.g. "list.page_down"
+	Context Context
+	Keys    []string // Key names as printed by tea.KeyMsg.String()
+	Help    string
+
+	defaults []string // Built-in keys; Keys[0] of these is what handlers match
+}
+
+// Canonical returns the built-in key the view handler matches for this action
+func (b Binding) Canonical() string {
+	return b.defaults[0]
+}
+
+// Default returns the built-in keys for the action
+func (b Binding) Default() []string {
+	return slices.Clone(b.defaults)
+}
+
+// Keymap is the binding table plus the per-context lookup built from it
+type Keymap struct {
+	bindings []Binding
+	index    map[string]int
+	lookup   map[Context]map[string]string // Pressed key -> canonical key, "" = unbound
+}
+
+// Default returns the built-in bindings
+func Default() *Keymap {
+	km := &Keymap{index: make(map[string]int, len(defaults))}
+	for _, d := range defaults {
+		b := Binding{
+			Action:   d.action,
+			Context:  Context(d.action[:strings.IndexByte(d.action, '.')]),
+			Keys:     slices.Clone(d.keys),
+			Help:     d.help,
+			defaults: d.keys,
+		}
+		km.index[b.Action] = len(km.bindings)
+		km.bindings = append(km.bindings, b)
+	}
+	km.rebuild()
+	return km
+}
+
+// Bindings returns the table in display order
+func (km *Keymap) Bindings() []Binding {
+	out := make([]Binding, len(km.bindings))
+	for i, b := range km.bindings {
+		b.Keys = slices.Clone(b.Keys)
+		out[i] = b
+	}
+	return out
+}
+
+// Keys returns the keys currently bound to action
+func (km *Keymap) Keys(action string) []string {
+	i, ok := km.index[action]
+	if !ok {
+		return nil
+	}
+	return slices.Clone(km.bindings[i].Keys)
+}
+
+// Builtin returns the built-in key the view handler matches for action, or ""
+// for an unknown action
+func (km *Keymap) Builtin(action string) string {
+	i, ok := km.index[action]
+	if !ok {
+		return ""
+	}
+	return km.bindings[i].Canonical()
+}
+
+// Bind replaces the keys of action. The built-in keys stop working unless
+// they are listed again.
+func (km *Keymap) Bind(action string, keys ...string) error {
+	i, ok := km.index[action]
+	if !ok {
+		return fmt.Errorf("unknown action %q", action)
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("%s: no keys given", action)
+	}
+	for _, k := range keys {
+		if k == "" {
+			return fmt.Errorf("%s: empty key", action)
+		}
+	}
+	km.bindings[i].Keys = slices.Clone(keys)
+	km.rebuild()
+	return nil
+}
+
+// Apply binds every action in overrides, in sorted order so errors are
+// reported deterministically. Valid entries are applied even when others fail.
+func (km *Keymap) Apply(overrides map[string][]string) error {
+	actions := make([]string, 0, len(overrides))
+	for action := range overrides {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	var errs []string
+	for _, action := range actions {
+		if err := km.Bind(action, overrides[action]...); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("keymap: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Alias makes key act as target: key joins the keys of every action target
+// is built in for, so it shows up in help and in Conflicts like any other
+// binding. Call it after Apply, which replaces keys wholesale.
+func (km *Keymap) Alias(key, target string) error {
+	if key == "" || target == "" {
+		return fmt.Errorf("alias %q = %q: empty key", key, target)
+	}
+	found := false
+	for i := range km.bindings {
+		b := &km.bindings[i]
+		if !slices.Contains(b.defaults, target) {
+			continue
+		}
+		found = true
+		if !slices.Contains(b.Keys, key) {
+			b.Keys = append(b.Keys, key)
+		}
+	}
+	if !found {
+		return fmt.Errorf("%s: no action uses %s", key, target)
+	}
+	km.rebuild()
+	return nil
+}
+
+// ApplyAliases adds every alias in aliases (key -> key it acts as), in
+// sorted order. Valid entries are applied even when others fail.
+func (km *Keymap) ApplyAliases(aliases map[string]string) error {
+	keys := make([]string, 0, len(aliases))
+	for key := range aliases {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []string
+	for _, key := range keys {
+		if err := km.Alias(key, aliases[key]); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("keymap: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Translate maps a pressed key to the key the context's handler matches.
+// Built-in keys come back unchanged, keys bound by config come back as the
+// action's built-in key, and ok is false for a built-in key that was rebound
+// away (the press should be dropped). Keys the table does not know pass
+// through. Global bindings win over the context's, as in the handlers.
+func (km *Keymap) Translate(ctx Context, key string) (string, bool) {
+	global := km.lookup[Global]
+	withGlobal := ctx == Global || ctx.inheritsGlobal()
+	if withGlobal {
+		if to, ok := global[key]; ok && to != "" {
+			return to, true
+		}
+	}
+	if to, ok := km.lookup[ctx][key]; ok {
+		return to, to != ""
+	}
+	if _, ok := global[key]; ok && withGlobal {
+		return "", false
+	}
+	return key, true
+}
+
+// Conflict is a key bound to two actions where only Winner ever sees it
+type Conflict struct {
+	Key      string
+	Winner   string // Action the key triggers
+	Shadowed string // Action that never sees the key
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s: %s shadows %s", FormatKey(c.Key), c.Winner, c.Shadowed)
+}
+
+// Conflicts returns every key one action silently takes from another: two
+// actions of a context sharing a key (the earlier in the table wins), or a
+// global key reused in a view that inherits the global keys (the global
+// action wins). A key that is built in for both actions is not a conflict;
+// the view handlers already arbitrate between those.
+func (km *Keymap) Conflicts() []Conflict {
+	var conflicts []Conflict
+	owner := make(map[Context]map[string]Binding)
+	for _, b := range km.bindings {
+		claimed := owner[b.Context]
+		if claimed == nil {
+			claimed = make(map[string]Binding)
+			owner[b.Context] = claimed
+		}
+		for _, k := range b.Keys {
+			if first, taken := claimed[k]; taken {
+				if first.Action != b.Action && !builtInForBoth(k, first, b) {
+					conflicts = append(conflicts, Conflict{Key: k, Winner: first.Action, Shadowed: b.Action})
+				}
+				continue
+			}
+			claimed[k] = b
+		}
+	}
+	for _, b := range km.bindings {
+		if !b.Context.inheritsGlobal() {
+			continue
+		}
+		for _, k := range b.Keys {
+			if g, taken := owner[Global][k]; taken && owner[b.Context][k].Action == b.Action && !builtInForBoth(k, g, b) {
+				conflicts = append(conflicts, Conflict{Key: k, Winner: g.Action, Shadowed: b.Action})
+			}
+		}
+	}
+	return conflicts
+}
+
+// builtInForBoth reports whether key is a built-in key of both bindings
+func builtInForBoth(key string, a, b Binding) bool {
+	return slices.Contains(a.defaults, key) && slices.Contains(b.defaults, key)
+}
+
+// rebuild recomputes the lookup tables after a binding change
+func (km *Keymap) rebuild() {
+	km.lookup = make(map[Context]map[string]string)
+	for _, b := range km.bindings {
+		m := km.lookup[b.Context]
+		if m == nil {
+			m = make(map[string]string)
+			km.lookup[b.Context] = m
+		}
+		for _, k := range b.Keys {
+			if _, taken := m[k]; taken {
+				continue
+			}
+			// Built-in keys stay themselves: handlers may tell them apart
+			// (esc clears scope in the lens selector, q does not)
+			if slices.Contains(b.defaults, k) {
+				m[k] = k
+			} else {
+				m[k] = b.Canonical()
+			}
+		}
+	}
+	// Built-in keys no action claims any more are dropped
+	for _, b := range km.bindings {
+		m := km.lookup[b.Context]
+		for _, k := range b.defaults {
+			if _, taken := m[k]; !taken {
+				m[k] = ""
+			}
+		}
+	}
+}
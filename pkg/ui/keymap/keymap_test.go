@@ -0,0 +1,219 @@
+package keymap
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDefaultTranslateIsIdentity(t *testing.T) {
+	km := Default()
+	for _, b := range km.Bindings() {
+		if !strings.HasPrefix(b.Action, string(b.Context)+".") {
+			t.Errorf("%s: context %q does not match the action prefix", b.Action, b.Context)
+		}
+		for _, k := range b.Keys {
+			got, ok := km.Translate(b.Context, k)
+			if !ok || got != k {
+				t.Errorf("%s: Translate(%s, %q) = %q, %v; want the key unchanged", b.Action, b.Context, k, got, ok)
+			}
+		}
+	}
+	if got, ok := km.Translate(List, "ctrl+z"); !ok || got != "ctrl+z" {
+		t.Errorf("unknown keys should pass through, got %q, %v", got, ok)
+	}
+}
+
+func TestBindRebindsAction(t *testing.T) {
+	km := Default()
+	if err := km.Bind("list.page_down", "ctrl+f", "pgdown"); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		ctx    Context
+		key    string
+		want   string
+		wantOK bool
+	}{
+		{List, "ctrl+f", "ctrl+d", true},
+		{List, "pgdown", "ctrl+d", true},
+		{List, "ctrl+d", "", false}, // Rebound away
+		{Board, "ctrl+d", "ctrl+d", true},
+		{Lens, "ctrl+d", "ctrl+d", true},
+	}
+	for _, tc := range cases {
+		got, ok := km.Translate(tc.ctx, tc.key)
+		if got != tc.want || ok != tc.wantOK {
+			t.Errorf("Translate(%s, %q) = %q, %v; want %q, %v", tc.ctx, tc.key, got, ok, tc.want, tc.wantOK)
+		}
+	}
+}
+
+func TestGlobalBindingsApplyToViews(t *testing.T) {
+	km := Default()
+	if err := km.Bind("global.board", "B"); err != nil {
+		t.Fatal(err)
+	}
+	for _, ctx := range []Context{Global, List, Graph} {
+		if got, ok := km.Translate(ctx, "B"); !ok || got != "b" {
+			t.Errorf("Translate(%s, B) = %q, %v; want b", ctx, got, ok)
+		}
+		if _, ok := km.Translate(ctx, "b"); ok {
+			t.Errorf("Translate(%s, b) should drop the old board key", ctx)
+		}
+	}
+	// The lens dashboard's own B (scoped board) is unaffected
+	if got, ok := km.Translate(Lens, "B"); !ok || got != "B" {
+		t.Errorf("Translate(lens, B) = %q, %v", got, ok)
+	}
+}
+
+func TestApplyReportsUnknownActions(t *testing.T) {
+	km := Default()
+	err := km.Apply(map[string][]string{
+		"list.sort":  {"z"},
+		"list.nope":  {"x"},
+		"board.down": nil,
+	})
+	if err == nil || !strings.Contains(err.Error(), `"list.nope"`) || !strings.Contains(err.Error(), "board.down: no keys") {
+		t.Errorf("expected unknown action and empty key errors, got %v", err)
+	}
+	if keys := km.Keys("list.sort"); len(keys) != 1 || keys[0] != "z" {
+		t.Errorf("valid overrides should still apply, got %v", keys)
+	}
+}
+
+func TestHelpSectionsReflectBindings(t *testing.T) {
+	km := Default()
+	if err := km.Bind("list.page_down", "ctrl+f"); err != nil {
+		t.Fatal(err)
+	}
+
+	rows := func(ctx Context) (map[string]string, []string) {
+		byDesc := map[string]string{}
+		var titles []string
+		for _, s := range km.HelpSections(ctx) {
+			titles = append(titles, s.Title)
+			for _, r := range s.Rows {
+				if r.Key == "" || r.Desc == "" {
+					t.Errorf("%s: empty help row %+v", s.Title, r)
+				}
+				byDesc[r.Desc] = r.Key
+			}
+		}
+		return byDesc, titles
+	}
+
+	list, titles := rows(List)
+	if strings.Join(titles, ",") != "Issue list,Global" {
+		t.Errorf("list sections = %v, want the list's then the global keys", titles)
+	}
+	want := map[string]string{
+		"Page down":     "Ctrl+f",
+		"Move down":     "j/↓",
+		"Go to last":    "G/End",
+		"Hybrid preset": "Alt+h",
+		"Kanban board":  "b",
+		"Force quit":    "Ctrl+c",
+	}
+	for desc, key := range want {
+		if list[desc] != key {
+			t.Errorf("help row %q = %q, want %q", desc, list[desc], key)
+		}
+	}
+
+	// Numbered actions share a row; the lens doesn't see the global keys
+	if board, _ := rows(Board); board["Jump to column"] != "1-4" {
+		t.Errorf("board columns row = %q, want 1-4", board["Jump to column"])
+	}
+	lens, titles := rows(Lens)
+	if strings.Join(titles, ",") != "Lens dashboard" || lens["Jump to workstream"] != "1-9" || lens["Kanban board"] != "" {
+		t.Errorf("lens sections = %v, rows %v", titles, lens)
+	}
+}
+
+func TestEveryContextHasHelpTitle(t *testing.T) {
+	for _, b := range Default().Bindings() {
+		if _, ok := contextTitles[b.Context]; !ok {
+			t.Errorf("%s: context %q has no help title", b.Action, b.Context)
+		}
+	}
+}
+
+func TestFormatKey(t *testing.T) {
+	cases := map[string]string{
+		"ctrl+d": "Ctrl+d",
+		"alt+H":  "Alt+H",
+		"down":   "↓",
+		"enter":  "Enter",
+		"f12":    "F12",
+		"f":      "f",
+		" ":      "Space",
+	}
+	for in, want := range cases {
+		if got := FormatKey(in); got != want {
+			t.Errorf("FormatKey(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	if c := Default().Conflicts(); len(c) != 0 {
+		t.Errorf("built-in bindings should not conflict, got %v", c)
+	}
+
+	km := Default()
+	err := km.Apply(map[string][]string{
+		"list.peek":          {"P"}, // Free key: fine
+		"list.blocker_chain": {"K"}, // list.peek's old key, now free again: fine
+		"list.copy":          {"r"}, // Taken by list.filter_ready, earlier in the table
+		"list.edit":          {"L"}, // The global lens key
+		"history.copy_sha":   {"i"}, // The global insights key
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, c := range km.Conflicts() {
+		got = append(got, c.String())
+	}
+	want := []string{
+		"r: list.filter_ready shadows list.copy",
+		"L: global.lens shadows list.edit",
+		"i: global.insights shadows history.copy_sha",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("conflicts:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestAliasJoinsTheTable(t *testing.T) {
+	km := Default()
+	if err := km.ApplyAliases(map[string]string{"ctrl+y": "j", "ctrl+x": "nope"}); err == nil || !strings.Contains(err.Error(), "ctrl+x: no action uses nope") {
+		t.Errorf("err = %v, want ctrl+x reported", err)
+	}
+	if got, ok := km.Translate(List, "ctrl+y"); !ok || got != "j" {
+		t.Errorf("ctrl+y in the list = %q, %v; want j", got, ok)
+	}
+	if !slices.Contains(km.Keys("review.down"), "ctrl+y") {
+		t.Errorf("review.down keys = %v, want ctrl+y added", km.Keys("review.down"))
+	}
+
+	// An alias onto a key another action of the context already has is a
+	// conflict like any keymap entry
+	km = Default()
+	if err := km.Alias("x", "esc"); err != nil {
+		t.Fatal(err)
+	}
+	var lens []string
+	for _, c := range km.Conflicts() {
+		if strings.HasPrefix(c.Winner, "lens.") {
+			lens = append(lens, c.String())
+		}
+	}
+	if want := []string{"x: lens.filter_pill shadows lens.back"}; !slices.Equal(lens, want) {
+		t.Errorf("lens conflicts = %q, want %q", lens, want)
+	}
+}
@@ -109,6 +109,32 @@ func (m *LabelDashboardModel) Update(msg tea.KeyMsg) (string, tea.Cmd) {
 	return "", nil
 }
 
+// HandleMouse scrolls with the wheel and selects the clicked row. It returns
+// true when the already selected row is clicked again, which the caller
+// treats like enter.
+func (m *LabelDashboardModel) HandleMouse(msg tea.MouseMsg) bool {
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	case tea.MouseButtonWheelDown:
+		m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	case tea.MouseButtonLeft:
+		if msg.Action != tea.MouseActionPress {
+			return false
+		}
+		// Rows start below the header line
+		idx := m.scrollOffset + msg.Y - 1
+		if msg.Y < 1 || msg.Y > m.height-1 || idx >= len(m.labels) {
+			return false
+		}
+		if idx == m.cursor {
+			return true
+		}
+		m.cursor = idx
+	}
+	return false
+}
+
 func (m LabelDashboardModel) View() string {
 	if len(m.labels) == 0 {
 		return "No labels found"
@@ -213,7 +239,7 @@ func (m LabelDashboardModel) renderLabelCell(lh analysis.LabelHealth) string {
 	if lh.HealthLevel == analysis.HealthLevelCritical {
 		indicator = " !"
 	} else if lh.Blocked > 0 {
-		indicator = " ⛔"
+		indicator = m.theme.Glyph(" ⛔")
 	}
 	return lh.Label + indicator
 }
@@ -227,8 +253,8 @@ func (m LabelDashboardModel) renderHealthCell(lh analysis.LabelHealth) string {
 	if filled > barWidth {
 		filled = barWidth
 	}
-	filledStr := strings.Repeat("█", filled)
-	blankStr := strings.Repeat("░", barWidth-filled)
+	filledStr := strings.Repeat(m.theme.Glyph("█"), filled)
+	blankStr := strings.Repeat(m.theme.Glyph("░"), barWidth-filled)
 	bar := filledStr + blankStr
 
 	style := m.theme.Base
@@ -0,0 +1,320 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Stages of a LabelManagerModal
+const (
+	labelManageChoose  = iota // r rename or m merge
+	labelManageRename         // Typing the new name
+	labelManageMerge          // Picking the label to merge into
+	labelManageConfirm        // Previewing the affected issues
+)
+
+// LabelManagerModal renames a label or merges it into another across every
+// issue. It previews how many issues change before anything is written; the
+// owner applies the confirmed plan through bd.
+type LabelManagerModal struct {
+	label   string
+	issues  []model.Issue
+	counts  map[string]int
+	stage   int
+	input   textinput.Model
+	targets LabelPickerModel // Merge targets: every other label
+	plan    loader.Relabel
+	back    int // Stage esc returns to from the preview
+	err     string
+	apply   bool // Confirmed; consumed by TakeApplyRequest
+	done    bool // Closed without applying
+	theme   Theme
+	width   int
+}
+
+// NewLabelManagerModal opens the manager on label
+func NewLabelManagerModal(label string, issues []model.Issue, theme Theme) LabelManagerModal {
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		for _, l := range issue.Labels {
+			counts[l]++
+		}
+	}
+	var others []string
+	for l := range counts {
+		if l != label {
+			others = append(others, l)
+		}
+	}
+
+	ti := textinput.New()
+	ti.Placeholder = "new label name"
+	ti.CharLimit = 64
+	ti.Width = 40
+	ti.SetValue(label)
+
+	return LabelManagerModal{
+		label:   label,
+		issues:  issues,
+		counts:  counts,
+		input:   ti,
+		targets: NewLabelPickerModel(others, counts, theme),
+		theme:   theme,
+		width:   64,
+	}
+}
+
+// Update handles a key in the current stage
+func (m LabelManagerModal) Update(msg tea.KeyMsg) (LabelManagerModal, tea.Cmd) {
+	key := msg.String()
+	switch m.stage {
+	case labelManageChoose:
+		switch key {
+		case "r":
+			m.stage = labelManageRename
+			m.input.Focus()
+			m.input.CursorEnd()
+		case "m":
+			m.stage = labelManageMerge
+			m.targets.Reset()
+		case "esc", "q":
+			m.done = true
+		}
+		return m, nil
+
+	case labelManageRename:
+		switch key {
+		case "esc":
+			m.stage, m.err = labelManageChoose, ""
+			m.input.Blur()
+			return m, nil
+		case "enter":
+			m.preview(strings.TrimSpace(m.input.Value()), labelManageRename)
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		m.err = ""
+		return m, cmd
+
+	case labelManageMerge:
+		switch key {
+		case "esc":
+			m.stage, m.err = labelManageChoose, ""
+		case "up", "ctrl+k":
+			m.targets.MoveUp()
+		case "down", "ctrl+j":
+			m.targets.MoveDown()
+		case "enter":
+			if target := m.targets.SelectedLabel(); target != "" {
+				m.preview(target, labelManageMerge)
+			}
+		default:
+			m.targets.UpdateInput(msg)
+		}
+		return m, nil
+
+	default: // labelManageConfirm
+		switch key {
+		case "y", "enter":
+			m.apply = true
+		case "n", "esc":
+			m.stage = m.back
+			if m.back == labelManageRename {
+				m.input.Focus()
+			}
+		}
+		return m, nil
+	}
+}
+
+// preview plans moving the label to target and shows the confirmation, or
+// explains why it can't
+func (m *LabelManagerModal) preview(target string, from int) {
+	switch {
+	case target == "":
+		m.err = "Enter a label name"
+		return
+	case target == m.label:
+		m.err = "That is the current name"
+		return
+	case strings.ContainsAny(target, " \t,"):
+		m.err = "Labels can't contain spaces or commas"
+		return
+	}
+	m.err = ""
+	m.plan = loader.PlanRelabel(m.issues, m.label, target)
+	m.back = from
+	m.stage = labelManageConfirm
+	m.input.Blur()
+}
+
+// TakeApplyRequest returns the confirmed plan once; the owner writes it
+func (m *LabelManagerModal) TakeApplyRequest() (loader.Relabel, bool) {
+	if !m.apply {
+		return loader.Relabel{}, false
+	}
+	m.apply = false
+	return m.plan, true
+}
+
+// Done reports whether the manager was closed without applying
+func (m LabelManagerModal) Done() bool {
+	return m.done
+}
+
+// InTextInput reports whether keys are going to a text field
+func (m LabelManagerModal) InTextInput() bool {
+	return m.stage == labelManageRename || m.stage == labelManageMerge
+}
+
+// SetSize sizes the modal to the terminal
+func (m *LabelManagerModal) SetSize(width, height int) {
+	m.width = max(min(width-4, 72), 40)
+	m.targets.SetSize(m.width, height)
+}
+
+// View renders the modal
+func (m LabelManagerModal) View() string {
+	t := m.theme
+	inner := m.width - 6
+	headerStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	keyStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
+	errStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+
+	lines := []string{
+		headerStyle.Render(truncate(fmt.Sprintf("Manage #%s (%d issues)", m.label, m.counts[m.label]), inner)),
+		"",
+	}
+
+	switch m.stage {
+	case labelManageChoose:
+		lines = append(lines,
+			keyStyle.Render("r")+"  rename it on every issue",
+			keyStyle.Render("m")+"  merge it into another label",
+			"", mutedStyle.Render("Changes are written through bd"),
+			"", mutedStyle.Render("r rename · m merge · esc close"))
+
+	case labelManageRename:
+		lines = append(lines, "Rename to:", m.input.View())
+		if m.err != "" {
+			lines = append(lines, errStyle.Render(m.err))
+		}
+		lines = append(lines, "", mutedStyle.Render("enter preview · esc back"))
+
+	case labelManageMerge:
+		lines = append(lines, "Merge into: "+m.targets.input.View(), "")
+		visible := min(len(m.targets.filtered), 10)
+		start := max(0, min(m.targets.selectedIndex-visible+1, len(m.targets.filtered)-visible))
+		for i := start; i < start+visible; i++ {
+			label := m.targets.filtered[i]
+			row := fmt.Sprintf("#%s %s", label, mutedStyle.Render(fmt.Sprintf("(%d)", m.counts[label])))
+			if i == m.targets.selectedIndex {
+				lines = append(lines, headerStyle.Render("▸ ")+row)
+			} else {
+				lines = append(lines, "  "+row)
+			}
+		}
+		if len(m.targets.filtered) == 0 {
+			lines = append(lines, mutedStyle.Render("  No matching labels"))
+		}
+		lines = append(lines, "", mutedStyle.Render("type to filter · ↑/↓ move · enter preview · esc back"))
+
+	default:
+		verb := "Rename"
+		if m.plan.Merge {
+			verb = "Merge"
+		}
+		lines = append(lines, fmt.Sprintf("%s #%s → #%s", verb, m.plan.From, m.plan.To), "",
+			fmt.Sprintf("%d issue(s) will change", len(m.plan.IssueIDs)))
+		if n := len(m.plan.HasTarget); n > 0 {
+			lines = append(lines, mutedStyle.Render(fmt.Sprintf("%d already have #%s and just lose #%s", n, m.plan.To, m.plan.From)))
+		}
+		if m.plan.Merge {
+			lines = append(lines, mutedStyle.Render(fmt.Sprintf("#%s ends up on %d issues", m.plan.To, m.counts[m.plan.To]+len(m.plan.IssueIDs)-len(m.plan.HasTarget))))
+		}
+		lines = append(lines, "", mutedStyle.Render("y/enter apply via bd · n/esc back"))
+	}
+
+	return t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(m.width).
+		Render(strings.Join(lines, "\n"))
+}
+
+// CenterModal renders the modal centered in the terminal
+func (m LabelManagerModal) CenterModal(termWidth, termHeight int) string {
+	return lipgloss.Place(termWidth, termHeight, lipgloss.Center, lipgloss.Center, m.View())
+}
+
+// RelabelDoneMsg reports how writing a rename or merge through bd went
+type RelabelDoneMsg struct {
+	Plan loader.Relabel
+	Done int
+	Errs []error
+}
+
+// Status summarizes the result for the status bar
+func (msg RelabelDoneMsg) Status() (string, bool) {
+	verb := "Renamed"
+	if msg.Plan.Merge {
+		verb = "Merged"
+	}
+	if len(msg.Errs) == 0 {
+		return fmt.Sprintf("%s #%s → #%s on %d issues", verb, msg.Plan.From, msg.Plan.To, msg.Done), false
+	}
+	status := fmt.Sprintf("Relabeled %d of %d issues: %v", msg.Done, len(msg.Plan.IssueIDs), msg.Errs[0])
+	if len(msg.Errs) > 1 {
+		status += fmt.Sprintf(" (+%d more)", len(msg.Errs)-1)
+	}
+	return status, true
+}
+
+// RelabelProgressMsg reports how many of a relabel's bd writes are done
+type RelabelProgressMsg struct {
+	Plan    loader.Relabel
+	Done    int
+	Total   int
+	updates <-chan tea.Msg
+}
+
+// Status describes the progress for the status bar
+func (msg RelabelProgressMsg) Status() string {
+	return fmt.Sprintf("Relabeling #%s → #%s: %d/%d writes…", msg.Plan.From, msg.Plan.To, msg.Done, msg.Total)
+}
+
+// ApplyRelabelCmd writes plan through bd in the background. Progress
+// arrives as RelabelProgressMsg (each followed up with WaitForRelabelCmd)
+// and the outcome as RelabelDoneMsg. A dry run (dry not nil) records it.
+func ApplyRelabelCmd(workDir string, plan loader.Relabel, dry *loader.DryRun) tea.Cmd {
+	return func() tea.Msg {
+		updates := make(chan tea.Msg, 1)
+		go func() {
+			done, errs := loader.ApplyRelabel(workDir, plan, dry, func(done, total int) {
+				select {
+				case updates <- RelabelProgressMsg{Plan: plan, Done: done, Total: total, updates: updates}:
+				default: // The last update hasn't been shown yet; skip this one
+				}
+			})
+			updates <- RelabelDoneMsg{Plan: plan, Done: done, Errs: errs}
+		}()
+		return <-updates
+	}
+}
+
+// WaitForRelabelCmd waits for the next update of a running relabel
+func WaitForRelabelCmd(msg RelabelProgressMsg) tea.Cmd {
+	return func() tea.Msg {
+		return <-msg.updates
+	}
+}
@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestLabelManagerRenamePreview(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Labels: []string{"ui"}},
+		{ID: "b", Labels: []string{"ui", "api"}},
+		{ID: "c", Labels: []string{"api"}},
+	}
+	m := NewLabelManagerModal("ui", issues, DefaultTheme(lipgloss.DefaultRenderer()))
+
+	m, _ = m.Update(keyMsg("r"))
+	if !m.InTextInput() {
+		t.Fatal("r should open the rename input")
+	}
+	// Replace the prefilled name
+	for range "ui" {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	for _, r := range "web" {
+		m, _ = m.Update(keyMsg(string(r)))
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	view := m.View()
+	for _, want := range []string{"Rename #ui → #web", "2 issue(s) will change"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("preview missing %q:\n%s", want, view)
+		}
+	}
+	if _, ok := m.TakeApplyRequest(); ok {
+		t.Fatal("nothing should apply before confirming")
+	}
+
+	m, _ = m.Update(keyMsg("y"))
+	plan, ok := m.TakeApplyRequest()
+	if !ok || plan.From != "ui" || plan.To != "web" || plan.Merge || len(plan.IssueIDs) != 2 {
+		t.Errorf("plan = %+v, ok = %v", plan, ok)
+	}
+	if _, ok := m.TakeApplyRequest(); ok {
+		t.Error("the request should clear once taken")
+	}
+}
+
+func TestLabelManagerMergePreview(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Labels: []string{"ui"}},
+		{ID: "b", Labels: []string{"ui", "api"}},
+		{ID: "c", Labels: []string{"api"}},
+		{ID: "d", Labels: []string{"docs"}},
+	}
+	m := NewLabelManagerModal("ui", issues, DefaultTheme(lipgloss.DefaultRenderer()))
+
+	m, _ = m.Update(keyMsg("m"))
+	for _, r := range "api" {
+		m, _ = m.Update(keyMsg(string(r)))
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	view := m.View()
+	for _, want := range []string{"Merge #ui → #api", "2 issue(s) will change", "1 already have #api", "#api ends up on 3 issues"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("preview missing %q:\n%s", want, view)
+		}
+	}
+
+	// Backing out returns to the picker; esc twice closes
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !m.InTextInput() {
+		t.Error("esc from the preview should return to the merge picker")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !m.Done() {
+		t.Error("esc from the first stage should close the manager")
+	}
+}
@@ -0,0 +1,276 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Panes of a LensCompareModel
+const (
+	comparePaneLeft   = iota // Only in the left lens
+	comparePaneRight         // Only in the right lens
+	comparePaneShared        // In both
+	comparePaneCount
+)
+
+// LensCompareModel compares two lenses (labels, epics or beads): the issues
+// only one of them includes, the ones they share, and their status mix side
+// by side. It answers "what does milestone-2 include that milestone-1
+// didn't?".
+type LensCompareModel struct {
+	left, right LensItem
+	panes       [comparePaneCount][]*model.Issue
+	statuses    []model.Status          // Every status either side has, in workflow order
+	counts      [2]map[model.Status]int // Issues per status: left, right
+	totals      [2]int
+	pane        int
+	selected    [comparePaneCount]int
+	scroll      [comparePaneCount]int
+	theme       Theme
+	width       int
+	height      int
+}
+
+// NewLensCompareModel splits the members of the two lenses into what only
+// left has, what only right has, and what both have
+func NewLensCompareModel(left, right LensItem, issues []model.Issue, theme Theme) LensCompareModel {
+	m := LensCompareModel{left: left, right: right, theme: theme, width: 100, height: 30}
+	inLeft, inRight := lensMembers(left, issues), lensMembers(right, issues)
+	m.counts = [2]map[model.Status]int{{}, {}}
+
+	seen := map[model.Status]bool{}
+	for i := range issues {
+		issue := &issues[i]
+		l, r := inLeft[issue.ID], inRight[issue.ID]
+		switch {
+		case l && r:
+			m.panes[comparePaneShared] = append(m.panes[comparePaneShared], issue)
+		case l:
+			m.panes[comparePaneLeft] = append(m.panes[comparePaneLeft], issue)
+		case r:
+			m.panes[comparePaneRight] = append(m.panes[comparePaneRight], issue)
+		default:
+			continue
+		}
+		for side, in := range []bool{l, r} {
+			if in {
+				m.counts[side][issue.Status]++
+				m.totals[side]++
+			}
+		}
+		if !seen[issue.Status] {
+			seen[issue.Status] = true
+			m.statuses = append(m.statuses, issue.Status)
+		}
+	}
+
+	order := []model.Status{model.StatusOpen, model.StatusInProgress, model.StatusBlocked, model.StatusClosed}
+	rank := func(s model.Status) int {
+		if i := slices.Index(order, s); i >= 0 {
+			return i
+		}
+		return len(order)
+	}
+	sort.Slice(m.statuses, func(i, j int) bool {
+		if ri, rj := rank(m.statuses[i]), rank(m.statuses[j]); ri != rj {
+			return ri < rj
+		}
+		return m.statuses[i] < m.statuses[j]
+	})
+	for _, pane := range m.panes {
+		sort.SliceStable(pane, func(i, j int) bool {
+			if pane[i].Priority != pane[j].Priority {
+				return pane[i].Priority < pane[j].Priority
+			}
+			return pane[i].ID < pane[j].ID
+		})
+	}
+
+	// Start where there is something to see
+	for m.pane < comparePaneShared && len(m.panes[m.pane]) == 0 {
+		m.pane++
+	}
+	return m
+}
+
+// lensMembers returns the issues the lens dashboard for item shows: labeled
+// issues and their descendants, an epic and its descendants, or a bead with
+// its descendants and everything it blocks
+func lensMembers(item LensItem, issues []model.Issue) map[string]bool {
+	seed := map[string]bool{}
+	switch item.Type {
+	case "label":
+		for _, issue := range issues {
+			if slices.Contains(issue.Labels, item.Value) {
+				seed[issue.ID] = true
+			}
+		}
+		return expandToDescendants(seed, issues)
+	case "bead":
+		seed[item.Value] = true
+		return expandToDescendantsAndBlocked(seed, issues)
+	default: // "epic"
+		seed[item.Value] = true
+		return expandToDescendants(seed, issues)
+	}
+}
+
+// Update moves between and within the panes; the owner handles closing
+// and jumping
+func (m LensCompareModel) Update(msg tea.Msg) (LensCompareModel, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	n := len(m.panes[m.pane])
+	switch key.String() {
+	case "tab", "l", "right":
+		m.pane = (m.pane + 1) % comparePaneCount
+	case "shift+tab", "h", "left":
+		m.pane = (m.pane + comparePaneCount - 1) % comparePaneCount
+	case "j", "down":
+		if m.selected[m.pane] < n-1 {
+			m.selected[m.pane]++
+		}
+	case "k", "up":
+		if m.selected[m.pane] > 0 {
+			m.selected[m.pane]--
+		}
+	case "g", "home":
+		m.selected[m.pane] = 0
+	case "G", "end":
+		m.selected[m.pane] = max(n-1, 0)
+	}
+	visible := m.paneRows(m.pane)
+	if m.selected[m.pane] < m.scroll[m.pane] {
+		m.scroll[m.pane] = m.selected[m.pane]
+	} else if m.selected[m.pane] >= m.scroll[m.pane]+visible {
+		m.scroll[m.pane] = m.selected[m.pane] - visible + 1
+	}
+	return m, nil
+}
+
+// SelectedIssue returns the issue under the cursor in the focused pane, or
+// nil when that pane is empty
+func (m LensCompareModel) SelectedIssue() *model.Issue {
+	pane := m.panes[m.pane]
+	if len(pane) == 0 {
+		return nil
+	}
+	return pane[m.selected[m.pane]]
+}
+
+// SetSize sets the area the comparison fills
+func (m *LensCompareModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// paneRows is how many issue rows fit in a pane: the side-by-side panes get
+// three fifths of what the header and status table leave, shared the rest
+func (m LensCompareModel) paneRows(pane int) int {
+	// Title, blank, table header, a row per status, total, blank, two pane
+	// headers, blank between panes, footer
+	avail := max(m.height-len(m.statuses)-10, 4)
+	top := avail * 3 / 5
+	if pane == comparePaneShared {
+		return max(avail-top, 2)
+	}
+	return max(top, 2)
+}
+
+// View renders the comparison
+func (m LensCompareModel) View() string {
+	t := m.theme
+	headerStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+
+	leftName, rightName := lensTitle(m.left), lensTitle(m.right)
+	lines := []string{
+		headerStyle.Render(truncate("Compare "+leftName+m.theme.Glyph(" ⇄ ")+rightName, max(m.width-2, 10))),
+		"",
+	}
+
+	// Status mix side by side
+	colW := max(min((m.width-20)/2, 24), 8)
+	cell := func(s string) string { return fmt.Sprintf("%*s", colW, truncate(s, colW)) }
+	lines = append(lines, mutedStyle.Render(fmt.Sprintf("%-14s", "status")+cell(leftName)+cell(rightName)))
+	for _, status := range m.statuses {
+		l, r := m.counts[0][status], m.counts[1][status]
+		row := fmt.Sprintf("%-14s", status) + cell(fmt.Sprint(l)) + cell(fmt.Sprint(r))
+		if l != r {
+			row += mutedStyle.Render(fmt.Sprintf("  %+d", r-l))
+		}
+		lines = append(lines, row)
+	}
+	lines = append(lines, t.Renderer.NewStyle().Bold(true).Render(
+		fmt.Sprintf("%-14s", "total")+cell(fmt.Sprint(m.totals[0]))+cell(fmt.Sprint(m.totals[1]))), "")
+
+	// Only-in panes next to each other, shared below at full width
+	half := max((m.width-3)/2, 20)
+	leftPane := m.renderPane(comparePaneLeft, "Only in "+leftName, half)
+	rightPane := m.renderPane(comparePaneRight, "Only in "+rightName, half)
+	sep := mutedStyle.Render(strings.TrimSuffix(strings.Repeat(m.theme.Glyph(" │ \n"), len(leftPane)), "\n"))
+	lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+		strings.Join(leftPane, "\n"), sep, strings.Join(rightPane, "\n")), "")
+	lines = append(lines, m.renderPane(comparePaneShared, "In both", m.width)...)
+
+	lines = append(lines, "", mutedStyle.Render(m.theme.Glyph("tab/h/l switch pane · j/k move · enter jump to issue · esc back")))
+	return strings.Join(lines, "\n")
+}
+
+// renderPane renders a pane's header and visible rows, padded to the pane
+// height so the side-by-side panes line up
+func (m LensCompareModel) renderPane(pane int, title string, width int) []string {
+	t := m.theme
+	headerStyle := t.Renderer.NewStyle().Bold(true)
+	if pane == m.pane {
+		headerStyle = headerStyle.Foreground(t.Primary)
+	}
+	issues := m.panes[pane]
+	lines := []string{headerStyle.Render(truncate(fmt.Sprintf("%s (%d)", title, len(issues)), width))}
+
+	rows := m.paneRows(pane)
+	if len(issues) == 0 {
+		lines = append(lines, t.Renderer.NewStyle().Foreground(t.Subtext).Render("  (none)"))
+	}
+	end := min(m.scroll[pane]+rows, len(issues))
+	for i := m.scroll[pane]; i < end; i++ {
+		issue := issues[i]
+		cursor := "  "
+		idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+		titleStyle := t.Renderer.NewStyle()
+		if pane == m.pane && i == m.selected[pane] {
+			cursor = m.theme.Glyph("▸ ")
+			idStyle = idStyle.Bold(true)
+			titleStyle = titleStyle.Foreground(t.Primary).Bold(true)
+		}
+		status := t.Renderer.NewStyle().Foreground(t.GetStatusColor(string(issue.Status))).
+			Render(GetStatusIcon(string(issue.Status)))
+		fixed := lipgloss.Width(cursor+issue.ID+" ") + lipgloss.Width(status) + 1
+		lines = append(lines, cursor+status+" "+idStyle.Render(issue.ID)+" "+
+			titleStyle.Render(truncate(issue.Title, max(width-fixed, 5))))
+	}
+	for len(lines) < rows+1 {
+		lines = append(lines, "")
+	}
+	for i, line := range lines {
+		lines[i] = line + strings.Repeat(" ", max(width-lipgloss.Width(line), 0))
+	}
+	return lines
+}
+
+// lensTitle names a lens for headers: "#label", or the epic/bead ID
+func lensTitle(item LensItem) string {
+	if item.Type == "label" {
+		return "#" + item.Value
+	}
+	return item.Value
+}
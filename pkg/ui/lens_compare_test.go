@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestLensCompareSplitsMembers(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Title: "Only m1", Status: model.StatusClosed, Labels: []string{"m1"}},
+		{ID: "b", Title: "Both", Status: model.StatusOpen, Labels: []string{"m1", "m2"}},
+		{ID: "c", Title: "Only m2", Status: model.StatusOpen, Priority: 2, Labels: []string{"m2"}},
+		{ID: "d", Title: "Only m2 urgent", Status: model.StatusInProgress, Priority: 0, Labels: []string{"m2"}},
+		{ID: "e", Title: "Neither", Status: model.StatusOpen},
+	}
+	left := LensItem{Type: "label", Value: "m1", Title: "m1"}
+	right := LensItem{Type: "label", Value: "m2", Title: "m2"}
+	m := NewLensCompareModel(left, right, issues, DefaultTheme(lipgloss.DefaultRenderer()))
+
+	ids := func(pane int) string {
+		var out []string
+		for _, issue := range m.panes[pane] {
+			out = append(out, issue.ID)
+		}
+		return strings.Join(out, ",")
+	}
+	if got := ids(comparePaneLeft); got != "a" {
+		t.Errorf("only left = %q, want a", got)
+	}
+	if got := ids(comparePaneRight); got != "d,c" {
+		t.Errorf("only right = %q, want d,c (priority order)", got)
+	}
+	if got := ids(comparePaneShared); got != "b" {
+		t.Errorf("shared = %q, want b", got)
+	}
+	if m.totals != [2]int{2, 3} {
+		t.Errorf("totals = %v, want [2 3]", m.totals)
+	}
+	if m.counts[0][model.StatusOpen] != 1 || m.counts[1][model.StatusOpen] != 2 {
+		t.Errorf("open counts = %d/%d, want 1/2", m.counts[0][model.StatusOpen], m.counts[1][model.StatusOpen])
+	}
+
+	// Moving right focuses the m2-only pane
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(keyMsg("j"))
+	if issue := m.SelectedIssue(); issue == nil || issue.ID != "c" {
+		t.Errorf("selected = %v, want c", issue)
+	}
+
+	view := m.View()
+	for _, want := range []string{"Compare #m1 ⇄ #m2", "Only in #m1 (1)", "Only in #m2 (2)", "In both (1)", "+1"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q:\n%s", want, view)
+		}
+	}
+}
+
+func TestLensSelectorComparePicksTwoLenses(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, Labels: []string{"m1"}},
+		{ID: "b", Status: model.StatusOpen, Labels: []string{"m2"}},
+	}
+	selector := NewLensSelectorModel(issues, DefaultTheme(lipgloss.DefaultRenderer()), nil)
+	selector.insertMode = false
+	selector.filteredItems = []LensItem{
+		{Type: "label", Value: "m1", Title: "m1"},
+		{Type: "label", Value: "m2", Title: "m2"},
+	}
+	selector.selectedIndex = 0
+
+	selector.Update("c")
+	if selector.IsConfirmed() {
+		t.Fatal("first c should only pick the left lens")
+	}
+	selector.Update("c")
+	if selector.compareLeft != nil {
+		t.Fatal("c on the same lens should drop the pick")
+	}
+
+	selector.Update("c")
+	selector.selectedIndex = 1
+	selector.Update("c")
+	left, right, ok := selector.CompareRequest()
+	if !ok || !selector.IsConfirmed() {
+		t.Fatal("c on a second lens should request a comparison")
+	}
+	if left.Value != "m1" || right.Value != "m2" {
+		t.Errorf("compare %s vs %s, want m1 vs m2", left.Value, right.Value)
+	}
+
+	selector.Reset()
+	if _, _, ok := selector.CompareRequest(); ok {
+		t.Error("Reset should clear the comparison")
+	}
+}
@@ -0,0 +1,232 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// RecentLensesFile keeps the most recently opened lenses, in the beads
+// directory, for the lens selector's Recent section
+const RecentLensesFile = "bv-recent-lenses.json"
+
+// maxRecentLenses caps the lenses remembered in RecentLensesFile
+const maxRecentLenses = 20
+
+// lensRef names a lens dashboard: a label, an epic or a bead
+type lensRef struct {
+	Type     string    `json:"type"` // "label", "epic" or "bead"
+	Value    string    `json:"value"`
+	Title    string    `json:"title,omitempty"`
+	OpenedAt time.Time `json:"opened_at,omitempty"`
+}
+
+func (r lensRef) same(o lensRef) bool {
+	return r.Type == o.Type && r.Value == o.Value
+}
+
+// recentLenses is the RecentLensesFile format, most recent first
+type recentLenses struct {
+	Lenses []lensRef `json:"lenses"`
+}
+
+// loadRecentLenses reads the recent lenses at path; a missing or broken
+// file is an empty history
+func loadRecentLenses(path string) []lensRef {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var recent recentLenses
+	if json.Unmarshal(data, &recent) != nil {
+		return nil
+	}
+	return recent.Lenses
+}
+
+// addRecentLens moves ref to the front of refs, dropping the oldest past
+// maxRecentLenses
+func addRecentLens(refs []lensRef, ref lensRef) []lensRef {
+	refs = slices.DeleteFunc(slices.Clone(refs), ref.same)
+	refs = append([]lensRef{ref}, refs...)
+	if len(refs) > maxRecentLenses {
+		refs = refs[:maxRecentLenses]
+	}
+	return refs
+}
+
+// saveRecentLenses writes refs to path
+func saveRecentLenses(path string, refs []lensRef) error {
+	data, err := json.MarshalIndent(recentLenses{Lenses: refs}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding recent lenses: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing recent lenses: %w", err)
+	}
+	return nil
+}
+
+// lensHistory is the trail of dashboards opened this session, for
+// ctrl+o (back) and ctrl+n (forward)
+type lensHistory struct {
+	entries []lensRef
+	pos     int // Index of the dashboard on screen
+}
+
+// visit records ref as the dashboard now on screen, dropping anything
+// forward of the current position as a browser does
+func (h *lensHistory) visit(ref lensRef) {
+	if len(h.entries) > 0 && h.entries[h.pos].same(ref) {
+		return
+	}
+	if len(h.entries) > 0 {
+		h.entries = h.entries[:h.pos+1]
+	}
+	h.entries = append(h.entries, ref)
+	h.pos = len(h.entries) - 1
+}
+
+// step moves delta entries back (-1) or forward (+1), skipping lenses
+// that no longer exist, and returns the lens to open
+func (h *lensHistory) step(delta int, exists func(lensRef) bool) (lensRef, bool) {
+	for i := h.pos + delta; i >= 0 && i < len(h.entries); i += delta {
+		if exists(h.entries[i]) {
+			h.pos = i
+			return h.entries[i], true
+		}
+	}
+	return lensRef{}, false
+}
+
+// recentLensesPath returns where this project's recent lenses are kept,
+// or "" when there is nowhere to keep them
+func (m *Model) recentLensesPath() string {
+	if m.beadsPath == "" || m.workspaceMode {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(m.beadsPath), RecentLensesFile)
+}
+
+// recordLensVisit adds ref to the back/forward trail and the persisted
+// recent lenses
+func (m *Model) recordLensVisit(ref lensRef) {
+	m.lensHistory.visit(ref)
+	path := m.recentLensesPath()
+	if path == "" {
+		return
+	}
+	if m.dryRun != nil {
+		m.dryRun.RecordFile(path, "recent lens: "+ref.Title)
+		return
+	}
+	ref.OpenedAt = time.Now()
+	_ = saveRecentLenses(path, addRecentLens(loadRecentLenses(path), ref)) // Best effort: a read-only repo just forgets
+}
+
+// lensExists reports whether ref still names something to show
+func (m *Model) lensExists(ref lensRef) bool {
+	if ref.Type != "label" {
+		_, ok := m.issueMap[ref.Value]
+		return ok
+	}
+	return slices.ContainsFunc(m.issues, func(issue model.Issue) bool {
+		return slices.Contains(issue.Labels, ref.Value)
+	})
+}
+
+// stepLensHistory opens the previous (delta -1) or next (+1) dashboard
+// from this session's trail
+func (m *Model) stepLensHistory(delta int) {
+	ref, ok := m.lensHistory.step(delta, m.lensExists)
+	if !ok {
+		if delta < 0 {
+			m.statusMsg = "No earlier lens"
+		} else {
+			m.statusMsg = "No later lens"
+		}
+		m.statusIsError = false
+		return
+	}
+	m.lensStack = nil
+	m.openLensDashboard(ref, nil, ScopeModeUnion)
+	m.statusMsg = fmt.Sprintf("Lens: %s (%d/%d) • ctrl+o back • ctrl+n forward", ref.Title, m.lensHistory.pos+1, len(m.lensHistory.entries))
+	m.statusIsError = false
+}
+
+// lensCrumb is a dashboard drilled out of, kept as it was for esc to return to
+type lensCrumb struct {
+	ref       lensRef
+	dashboard LensDashboardModel
+	stale     bool // The issues were reloaded since: rebuild on return
+}
+
+// crumbLabel is how ref reads in the breadcrumb bar
+func (r lensRef) crumbLabel() string {
+	switch {
+	case r.Type == "label":
+		return "#" + r.Value
+	case r.Title != "" && r.Title != r.Value:
+		return r.Value + " " + r.Title
+	}
+	return r.Value
+}
+
+// lensBreadcrumbs is the path from the outermost lens to the one on screen
+func (m *Model) lensBreadcrumbs() []string {
+	crumbs := make([]string, 0, len(m.lensStack)+1)
+	for _, c := range m.lensStack {
+		crumbs = append(crumbs, c.ref.crumbLabel())
+	}
+	return append(crumbs, m.lensCurrent.crumbLabel())
+}
+
+// drillIntoLens opens the selected issue as a lens of its own (an epic lens
+// for epics, a bead lens otherwise), keeping the current one for esc
+func (m *Model) drillIntoLens() {
+	issue := m.issueMap[m.lensDashboard.SelectedIssueID()]
+	if issue == nil {
+		return
+	}
+	ref := lensRef{Type: "bead", Value: issue.ID, Title: issue.Title}
+	if issue.IssueType == model.TypeEpic {
+		ref.Type = "epic"
+	}
+	if ref.same(m.lensCurrent) {
+		m.statusMsg = fmt.Sprintf("Already viewing %s", issue.ID)
+		m.statusIsError = false
+		return
+	}
+	m.lensStack = append(m.lensStack, lensCrumb{ref: m.lensCurrent, dashboard: m.lensDashboard})
+	m.openLensDashboard(ref, nil, ScopeModeUnion)
+	m.recordLensVisit(ref)
+	m.statusMsg = fmt.Sprintf("Lens: %s • esc back to %s", ref.crumbLabel(), m.lensStack[len(m.lensStack)-1].ref.crumbLabel())
+	m.statusIsError = false
+}
+
+// popLens returns to the dashboard drilled out of, as it was left. One
+// kept across a reload is rebuilt from the current issues, its cursor on the
+// same issue.
+func (m *Model) popLens() {
+	top := m.lensStack[len(m.lensStack)-1]
+	m.lensStack = m.lensStack[:len(m.lensStack)-1]
+	m.lensDashboard = top.dashboard
+	m.lensCurrent = top.ref
+	m.lensHistory.visit(top.ref)
+	m.lensDashboard.SetDetailMode(m.lensDetailMode) // v may have been pressed since
+	m.lensDashboard.SetSize(m.width, m.height-1)    // The terminal may have been resized since
+	if top.stale {
+		id := m.lensDashboard.SelectedIssueID()
+		m.refreshLensDashboard()
+		if id != "" {
+			m.lensDashboard.SelectIssue(id)
+		}
+	}
+	m.statusMsg = fmt.Sprintf("Lens: %s", top.ref.crumbLabel())
+	m.statusIsError = false
+}
@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestLensHistoryBackForwardAndRecent(t *testing.T) {
+	tmp := t.TempDir()
+	beads := filepath.Join(tmp, "beads.jsonl")
+	if err := os.WriteFile(beads, nil, 0644); err != nil {
+		t.Fatalf("write beads: %v", err)
+	}
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "bv-2", Title: "Task", Status: model.StatusOpen, IssueType: model.TypeTask, Labels: []string{"api", "ui"}},
+	}
+	m := NewModel(issues, nil, beads)
+	m.width, m.height = 120, 40
+
+	open := func(ref lensRef) {
+		m.openLensDashboard(ref, nil, ScopeModeUnion)
+		m.recordLensVisit(ref)
+	}
+	open(lensRef{Type: "label", Value: "api", Title: "api"})
+	open(lensRef{Type: "epic", Value: "bv-1", Title: "Epic"})
+	open(lensRef{Type: "label", Value: "ui", Title: "ui"})
+
+	m = m.handleLensDashboardKeys(keyMsg("ctrl+o"))
+	m = m.handleLensDashboardKeys(keyMsg("ctrl+o"))
+	if m.lensDashboard.labelName != "api" {
+		t.Fatalf("two steps back = %q, want api", m.lensDashboard.labelName)
+	}
+	m = m.handleLensDashboardKeys(keyMsg("ctrl+o"))
+	if m.statusMsg != "No earlier lens" {
+		t.Errorf("status at the start = %q", m.statusMsg)
+	}
+	m = m.handleLensDashboardKeys(keyMsg("ctrl+n"))
+	if m.lensDashboard.epicID != "bv-1" || !strings.Contains(m.statusMsg, "(2/3)") {
+		t.Errorf("forward = %q (%q), want the epic at 2/3", m.lensDashboard.epicID, m.statusMsg)
+	}
+
+	// The selector lists them under Recent, most recent first
+	m.openLensSelector()
+	var got []string
+	for _, item := range m.lensSelector.filteredItems[:m.lensSelector.recentSectionLen()] {
+		got = append(got, item.Value)
+	}
+	if strings.Join(got, ",") != "ui,bv-1,api" {
+		t.Errorf("recent section = %v, want ui,bv-1,api", got)
+	}
+	if !strings.Contains(m.lensSelector.View(), "Recent") {
+		t.Error("recent section heading missing")
+	}
+}
+
+func TestLensDrillBreadcrumbs(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic, Labels: []string{"api"}},
+		{ID: "bv-2", Title: "Task", Status: model.StatusOpen, IssueType: model.TypeTask, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepParentChild}}},
+	}
+	m := NewModel(issues, nil, "")
+	m.width, m.height = 100, 40
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+
+	drill := func(id string) {
+		t.Helper()
+		m.lensDashboard.selectedIssueID = id
+		m = m.handleLensDashboardKeys(keyMsg("L"))
+	}
+	drill("bv-1")
+	if m.lensDashboard.viewMode != "epic" || m.lensCurrent.Value != "bv-1" {
+		t.Fatalf("L on an epic opened %q %q, want its epic lens", m.lensDashboard.viewMode, m.lensCurrent.Value)
+	}
+	drill("bv-2")
+	if got := strings.Join(m.lensBreadcrumbs(), " › "); got != "#api › bv-1 Epic › bv-2 Task" {
+		t.Errorf("breadcrumbs = %q", got)
+	}
+	if !strings.Contains(m.lensDashboard.View(), "bv-1 Epic") {
+		t.Error("breadcrumb bar missing from the dashboard")
+	}
+
+	// esc pops one level at a time, then leaves for the selector
+	m = m.handleLensDashboardKeys(keyMsg("esc"))
+	if m.lensCurrent.Value != "bv-1" || !m.showLensDashboard {
+		t.Fatalf("esc = %q, want back on the epic", m.lensCurrent.Value)
+	}
+	m = m.handleLensDashboardKeys(keyMsg("esc"))
+	if m.lensCurrent.Value != "api" || m.lensDashboard.labelName != "api" {
+		t.Fatalf("esc = %q, want back on the label", m.lensCurrent.Value)
+	}
+	m = m.handleLensDashboardKeys(keyMsg("esc"))
+	if m.showLensDashboard || !m.showLensSelector {
+		t.Error("esc at the outermost lens should return to the selector")
+	}
+}
+
+func TestLensPopAfterReload(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic, Labels: []string{"api"}},
+	}
+	m := NewModel(issues, nil, "")
+	m.width, m.height = 100, 40
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	m.lensDashboard.selectedIssueID = "bv-1"
+	m = m.handleLensDashboardKeys(keyMsg("L"))
+
+	m.issues = append(m.issues, model.Issue{ID: "bv-9", Title: "Arrived later", Status: model.StatusOpen, IssueType: model.TypeTask, Labels: []string{"api"}})
+	m.issueMap = make(map[string]*model.Issue)
+	for i := range m.issues {
+		m.issueMap[m.issues[i].ID] = &m.issues[i]
+	}
+	m.refreshLensDashboard()
+
+	m = m.handleLensDashboardKeys(keyMsg("esc"))
+	if m.lensCurrent.Value != "api" || !strings.Contains(m.lensDashboard.View(), "Arrived later") {
+		t.Errorf("esc after a reload should show the label lens with the reloaded issues:\n%s", m.lensDashboard.View())
+	}
+	if m.lensDashboard.SelectedIssueID() != "bv-1" {
+		t.Errorf("cursor = %q, want it kept on bv-1", m.lensDashboard.SelectedIssueID())
+	}
+}
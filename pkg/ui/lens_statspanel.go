@@ -18,13 +18,21 @@ type StatusCounts struct {
 	Total      int
 }
 
-// NewStatusCounts creates StatusCounts from a status count map.
+// NewStatusCounts creates StatusCounts from a status count map. Custom
+// statuses count toward the column they map onto.
 func NewStatusCounts(counts map[model.Status]int) StatusCounts {
-	sc := StatusCounts{
-		Open:       counts[model.StatusOpen],
-		InProgress: counts[model.StatusInProgress],
-		Blocked:    counts[model.StatusBlocked],
-		Closed:     counts[model.StatusClosed],
+	var sc StatusCounts
+	for status, n := range counts {
+		switch status.Column() {
+		case model.StatusOpen:
+			sc.Open += n
+		case model.StatusInProgress:
+			sc.InProgress += n
+		case model.StatusBlocked:
+			sc.Blocked += n
+		case model.StatusClosed:
+			sc.Closed += n
+		}
 	}
 	sc.Total = sc.Open + sc.InProgress + sc.Blocked + sc.Closed
 	return sc
@@ -47,19 +55,19 @@ func RenderStatsHeaderBox(title, typeLabel string, width int, theme Theme, color
 		maxTitleLen = 5
 	}
 	if len(title) > maxTitleLen {
-		title = title[:maxTitleLen-1] + "…"
+		title = title[:maxTitleLen-1] + theme.Glyph("…")
 	}
 
-	topBorder := "╔" + strings.Repeat("═", boxWidth-2) + "╗"
-	bottomBorder := "╚" + strings.Repeat("═", boxWidth-2) + "╝"
+	topBorder := theme.Glyph("╔") + strings.Repeat(theme.Glyph("═"), boxWidth-2) + theme.Glyph("╗")
+	bottomBorder := theme.Glyph("╚") + strings.Repeat(theme.Glyph("═"), boxWidth-2) + theme.Glyph("╝")
 
 	// Format title line to fit box width
-	contentWidth := boxWidth - 4 // Account for "║ " and " ║"
+	contentWidth := boxWidth - 4 // Account for theme.Glyph("║ ") and theme.Glyph(" ║")
 	titleContent := fmt.Sprintf("%s %s", typeLabel, title)
 	if len(titleContent) > contentWidth {
 		titleContent = titleContent[:contentWidth]
 	}
-	titleLine := fmt.Sprintf("║ %-*s║", boxWidth-3, titleContent)
+	titleLine := fmt.Sprintf(theme.Glyph("║ %-*s║"), boxWidth-3, titleContent)
 
 	return []string{
 		headerStyle.Render(topBorder),
@@ -87,9 +95,9 @@ func RenderStatusBars(counts StatusCounts, theme Theme) []string {
 	closedStyle := t.Renderer.NewStyle().Foreground(t.Closed)
 
 	return []string{
-		fmt.Sprintf("   %s %-12s %2d %s", openStyle.Render("●"), "Open:", counts.Open, openBar),
-		fmt.Sprintf("   %s %-12s %2d %s", inProgStyle.Render("●"), "In Progress:", counts.InProgress, inProgBar),
-		fmt.Sprintf("   %s %-12s %2d %s", blockedStyle.Render("●"), "Blocked:", counts.Blocked, blockedBar),
-		fmt.Sprintf("   %s %-12s %2d %s", closedStyle.Render("●"), "Closed:", counts.Closed, closedBar),
+		fmt.Sprintf("   %s %-12s %2d %s", openStyle.Render(theme.Glyph("●")), "Open:", counts.Open, openBar),
+		fmt.Sprintf("   %s %-12s %2d %s", inProgStyle.Render(theme.Glyph("●")), "In Progress:", counts.InProgress, inProgBar),
+		fmt.Sprintf("   %s %-12s %2d %s", blockedStyle.Render(theme.Glyph("●")), "Blocked:", counts.Blocked, blockedBar),
+		fmt.Sprintf("   %s %-12s %2d %s", closedStyle.Render(theme.Glyph("●")), "Closed:", counts.Closed, closedBar),
 	}
 }
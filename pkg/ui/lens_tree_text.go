@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// RenderIssueTree renders the tree the epic or bead dashboard builds for
+// issueID as text for stdout (bv tree): the issue's blockers, the issue, then
+// what sits under it to depth, with the dashboard's connectors. Colors come
+// from theme's renderer, and theme.ASCII draws the glyphs in ASCII.
+func RenderIssueTree(issueID string, issues []model.Issue, depth DepthOption, theme Theme) (string, error) {
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	issue, ok := issueMap[issueID]
+	if !ok {
+		return "", fmt.Errorf("issue %s not found", issueID)
+	}
+
+	// Epics open as an epic lens in the TUI, everything else as a bead lens
+	var m LensDashboardModel
+	if issue.IssueType == model.TypeEpic {
+		m = NewEpicLensModel(issueID, issue.Title, issues, issueMap, theme)
+	} else {
+		m = NewBeadLensModel(issueID, issues, issueMap, theme)
+	}
+	m.SetDepth(depth)
+
+	t := theme
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	var lines []string
+	if len(m.upstreamNodes) > 0 {
+		lines = append(lines, t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true).Render(t.Glyph("◇ Blockers")))
+		for _, fn := range m.upstreamNodes {
+			lines = append(lines, "  "+m.treeTextLine(fn))
+		}
+		lines = append(lines, "")
+	}
+	if m.egoNode != nil {
+		ego := *m.egoNode
+		ego.BlockerInTree = len(m.upstreamNodes) > 0 // Listed just above
+		lines = append(lines, m.treeTextLine(ego))
+	}
+	for _, fn := range m.flatNodes {
+		lines = append(lines, "  "+m.treeTextLine(fn))
+	}
+	if len(m.flatNodes) == 0 {
+		lines = append(lines, mutedStyle.Render("  (nothing under it)"))
+	}
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// treeTextLine is one node of RenderIssueTree: connectors, status icon, ID,
+// title and the blockers the tree doesn't show
+func (m *LensDashboardModel) treeTextLine(fn LensFlatNode) string {
+	t := m.theme
+	node := fn.Node
+
+	prefix := ""
+	if fn.TreePrefix != "" {
+		prefix = t.Renderer.NewStyle().Foreground(t.Subtext).Render(fn.TreePrefix) + " "
+	}
+	icon, color := "•", t.GetStatusColor(fn.Status)
+	switch fn.Status {
+	case "ready":
+		icon, color = "○", t.Open
+	case "in_progress":
+		icon, color = "●", t.InProgress
+	case "blocked":
+		icon, color = "◈", t.Blocked
+	case "closed":
+		icon, color = "✓", t.Closed
+	}
+	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	titleStyle := t.Renderer.NewStyle()
+	if node.IsEntryEpic {
+		idStyle = idStyle.Foreground(t.Primary).Bold(true)
+		titleStyle = titleStyle.Foreground(t.Primary).Bold(true)
+	} else if !node.IsPrimary {
+		titleStyle = titleStyle.Foreground(t.Subtext)
+	}
+
+	line := prefix + t.Renderer.NewStyle().Foreground(color).Render(t.Glyph(icon)) + " " +
+		idStyle.Render(m.theme.ID(node.Issue.ID)) + " " + titleStyle.Render(node.Issue.Title)
+	if fn.Status == "blocked" && len(fn.BlockedBy) > 0 && !fn.BlockerInTree {
+		blockers := strings.Join(fn.BlockedBy, ", ")
+		line += t.Renderer.NewStyle().Foreground(t.Blocked).Render(t.Glyph(" ◄ ") + blockers)
+	}
+	return line
+}
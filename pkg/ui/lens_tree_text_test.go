@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"io"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestRenderIssueTree(t *testing.T) {
+	child := func(id, title string, status model.Status, deps ...*model.Dependency) model.Issue {
+		deps = append(deps, &model.Dependency{IssueID: id, DependsOnID: "bv-1", Type: model.DepParentChild})
+		return model.Issue{ID: id, Title: title, Status: status, IssueType: model.TypeTask, Dependencies: deps}
+	}
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Auth epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		child("bv-2", "Login form", model.StatusInProgress),
+		child("bv-3", "Sessions", model.StatusOpen, &model.Dependency{IssueID: "bv-3", DependsOnID: "bv-2", Type: model.DepBlocks}),
+		child("bv-4", "Logout", model.StatusClosed),
+		{ID: "bv-5", Title: "Pick IdP", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "bv-6", Title: "Token refresh", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: []*model.Dependency{
+			{IssueID: "bv-6", DependsOnID: "bv-3", Type: model.DepBlocks},
+			{IssueID: "bv-6", DependsOnID: "bv-5", Type: model.DepBlocks}}},
+	}
+	theme := DefaultTheme(lipgloss.NewRenderer(io.Discard))
+
+	tests := []struct {
+		id    string
+		depth DepthOption
+		ascii bool
+		want  string
+	}{
+		{"bv-1", DepthAll, false,
+			"○ bv-1 Auth epic\n" +
+				"  ├─ ● bv-2 Login form\n" +
+				"  │ └▸ ◈ bv-3 Sessions\n" +
+				"  │   └▸ ◈ bv-6 Token refresh\n" +
+				"  └─ ✓ bv-4 Logout\n"},
+		{"bv-1", Depth1, true,
+			"o bv-1 Auth epic\n" +
+				"  +- * bv-2 Login form\n" +
+				"  +- # bv-3 Sessions < bv-2\n" +
+				"  +- v bv-4 Logout\n"},
+		{"bv-6", Depth2, false,
+			"◇ Blockers\n" +
+				"  ○ bv-5 Pick IdP\n" +
+				"  ◈ bv-3 Sessions ◄ bv-2\n" +
+				"\n" +
+				"◈ bv-6 Token refresh\n" +
+				"  (nothing under it)\n"},
+	}
+	for _, tt := range tests {
+		theme.ASCII = tt.ascii
+		got, err := RenderIssueTree(tt.id, issues, tt.depth, theme)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s depth %s:\n%s\nwant:\n%s", tt.id, tt.depth, got, tt.want)
+		}
+	}
+
+	if _, err := RenderIssueTree("bv-9", issues, Depth2, theme); err == nil {
+		t.Error("missing issue should fail")
+	}
+}
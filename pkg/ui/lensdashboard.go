@@ -3,8 +3,11 @@ package ui
 import (
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/claims"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"github.com/charmbracelet/bubbles/viewport"
@@ -36,6 +39,7 @@ const (
 	GroupByLabel    GroupByMode = iota // Group by most popular labels
 	GroupByPriority                    // Group by priority (P0, P1, P2, P3+)
 	GroupByStatus                      // Group by status (Open, In Progress, Blocked, Closed)
+	GroupByWave                        // Build order: waves of issues that can run in parallel
 )
 
 // String returns display name for the group-by mode
@@ -47,11 +51,90 @@ func (g GroupByMode) String() string {
 		return "Priority"
 	case GroupByStatus:
 		return "Status"
+	case GroupByWave:
+		return "Build order"
 	default:
 		return "Label"
 	}
 }
 
+// SectionSort is the key issues are ordered by within each status section.
+// Each key sorts ascending or descending; ties fall back to blockers first.
+type SectionSort int
+
+const (
+	SectionSortTopo     SectionSort = iota // Blockers first, then priority (default)
+	SectionSortPriority                    // Priority (P0 first ascending)
+	SectionSortCreated                     // Creation date
+	SectionSortUpdated                     // Last update
+	SectionSortID                          // Hierarchical ID
+	SectionSortImpact                      // Open issues transitively blocked (↑N)
+	SectionSortPageRank                    // PageRank
+	numSectionSorts
+)
+
+// String returns display name for the section sort, also its config value
+func (s SectionSort) String() string {
+	switch s {
+	case SectionSortPriority:
+		return "priority"
+	case SectionSortCreated:
+		return "created"
+	case SectionSortUpdated:
+		return "updated"
+	case SectionSortID:
+		return "id"
+	case SectionSortImpact:
+		return "impact"
+	case SectionSortPageRank:
+		return "pagerank"
+	default:
+		return "blockers"
+	}
+}
+
+// defaultDesc reports whether the key starts out descending when cycled to:
+// the latest update and the most impact or PageRank come first
+func (s SectionSort) defaultDesc() bool {
+	return s == SectionSortUpdated || s == SectionSortImpact || s == SectionSortPageRank
+}
+
+// FormatSectionOrder returns the config value of a section order, such as
+// "priority" or "updated desc". The direction is left out when it is the
+// key's default.
+func FormatSectionOrder(s SectionSort, desc bool) string {
+	if desc == s.defaultDesc() {
+		return s.String()
+	}
+	if desc {
+		return s.String() + " desc"
+	}
+	return s.String() + " asc"
+}
+
+// ParseSectionOrder reads a section order as written by FormatSectionOrder
+func ParseSectionOrder(value string) (SectionSort, bool, bool) {
+	fields := strings.Fields(strings.ToLower(value))
+	if len(fields) == 0 || len(fields) > 2 {
+		return SectionSortTopo, false, false
+	}
+	for s := SectionSortTopo; s < numSectionSorts; s++ {
+		if s.String() != fields[0] {
+			continue
+		}
+		if len(fields) == 1 {
+			return s, s.defaultDesc(), true
+		}
+		switch fields[1] {
+		case "asc":
+			return s, false, true
+		case "desc":
+			return s, true, true
+		}
+	}
+	return SectionSortTopo, false, false
+}
+
 // ScopeMode represents how multiple scope labels are combined
 type ScopeMode int
 
@@ -79,7 +162,7 @@ func (s ScopeMode) ShortString() string {
 // Viewport constants for consistent layout calculations
 const (
 	lensHeaderMinLines   = 4 // title + stats + blank + blank
-	lensKeybindBarLines  = 2 // keybind info bar (2 lines: global + mode-specific)
+	lensKeybindBarLines  = 1 // keybind info bar (view mode, its toggles and ? for the rest)
 	lensMinContentHeight = 5
 )
 
@@ -93,8 +176,11 @@ type ViewportConfig struct {
 // calculateViewport returns the current viewport configuration
 func (m *LensDashboardModel) calculateViewport() ViewportConfig {
 	headerLines := lensHeaderMinLines
-	if len(m.scopeLabels) > 0 {
-		headerLines++
+	if len(m.breadcrumbs) > 1 {
+		headerLines++ // Breadcrumb bar
+	}
+	if len(m.FilterPills()) > 0 {
+		headerLines++ // Filter pills
 	}
 	if m.showScopeInput {
 		headerLines += 2
@@ -214,6 +300,27 @@ type LensDashboardModel struct {
 	// Dependency expansion
 	dependencyDepth DepthOption
 
+	// Order within each status section
+	sectionSort SectionSort
+	sectionDesc bool
+	impact      map[string]int        // Transitive open dependents (SectionSortImpact)
+	graphStats  *analysis.GraphStats // PageRank (SectionSortPageRank)
+
+	// Archaeology mode: closed blockers still shape the tree (dimmed, with closure dates)
+	archaeologyMode bool
+
+	// Inactivity in days before an open issue is aging (0 = default)
+	staleDays int
+
+	// Lenses drilled through to reach this one, shown as a breadcrumb bar
+	breadcrumbs []string
+
+	// Workstream claims by issue ID, shown as ⚑owner on rows
+	claims map[string][]claims.Claim
+
+	// Epic statuses derived from their children (epic_rollup), nil when off
+	epicRollups map[string]analysis.EpicRollup
+
 	// View type (flat vs workstream)
 	viewType        ViewType
 	workstreamCount int
@@ -226,7 +333,26 @@ type LensDashboardModel struct {
 	// Workstream expansion state
 	wsExpanded map[int]bool // Which workstreams are expanded
 	wsScroll   int          // Scroll offset for workstream view
+
+	compareMarkID string // Workstream marked with c for a side by side comparison
+
+	// Workstream names chosen with n (see lensdashboard_names.go)
+	workstreamNames map[string]string // Config key -> chosen name
+	detectedWSNames map[string]string // Workstream ID -> detected name
+	renamingWS      bool              // True while the cursor stream's name is edited
+	renameInput     string            // Name being typed
+
+	// Issues moved between workstreams with X and p (see lensdashboard_overrides.go)
+	workstreamOverrides map[string]string // Config key -> workstream ID
+	detectedWSOf        map[string]string // Issue ID -> workstream detection chose
+	pinnedWSIssues      map[string]bool   // Issues moved into their stream by hand
+	cutIssueID          string            // Issue cut with X, waiting for p
+
+	// Only stalled workstreams shown (W, see lensdashboard_stalled.go)
+	stalledOnly bool
+
 	wsTreeView bool         // Show dependency tree within workstreams
+	wsPage     map[int]int  // Page shown for each expanded workstream (wsPageSize issues each)
 
 	// Sub-workstream support
 	workstreamPtrs []*analysis.Workstream // Pointers for mutation during subdivision
@@ -235,7 +361,7 @@ type LensDashboardModel struct {
 	subWsCursor    map[int]int            // wsIndex -> subWsCursor
 
 	// Grouped view state
-	groupByMode        GroupByMode           // Current grouping mode (Label, Priority, Status)
+	groupByMode        GroupByMode           // Current grouping mode (Label, Priority, Status, Build order)
 	groupedSections    []analysis.Workstream // Grouped sections (reusing Workstream struct)
 	groupedExpanded    map[int]bool          // Expansion state per group
 	groupedSubExpanded map[int]map[int]bool  // groupIndex -> subIndex -> expanded
@@ -257,6 +383,7 @@ type LensDashboardModel struct {
 	readyCount   int
 	blockedCount int
 	closedCount  int
+	forecast     analysis.CompletionForecast // When the lens's open issues close
 
 	// Dimensions
 	width  int
@@ -267,6 +394,14 @@ type LensDashboardModel struct {
 	scopeLabels []string  // Currently selected scope labels (empty = no scope)
 	scopeMode   ScopeMode // Union (ANY) or Intersection (ALL) mode
 
+	// Quick filters: f cycles the status, F the type, tab in a search keeps its query
+	statusFilter model.Status    // "" = every status
+	typeFilter   model.IssueType // "" = every type
+	searchFilter string          // Fuzzy query issues must match, "" = none
+
+	// Filter pill highlighted with x (0 = none, i = i-th pill)
+	pillFocus int
+
 	// Scope input modal
 	showScopeInput bool   // True when scope input modal is visible
 	scopeInput     string // Current text in scope input
@@ -281,9 +416,11 @@ type LensDashboardModel struct {
 	preFuzzySelectedID  string         // Original selected issue ID
 
 	// Split view (bead detail panel)
-	detailViewport viewport.Model // Viewport for bead details on the right
-	detailFocus    bool           // True when detail panel has focus
-	splitViewMode  bool           // True when in split view mode (wide terminal)
+	detailViewport viewport.Model    // Viewport for bead details on the right
+	detailFocus    bool              // True when detail panel has focus
+	splitViewMode  bool              // True when the detail panel is shown
+	detailMode     lensDetailMode    // Whether v showed or hid the detail panel
+	markdown       *MarkdownRenderer // Renders issue text in the detail panel
 }
 
 // NewLensDashboardModel creates a new label dashboard for the given label
@@ -454,16 +591,25 @@ func (m *LensDashboardModel) getTotalCenteredNodeCount() int {
 }
 
 
-// getIssueStatus returns the effective status of an issue
+// getIssueStatus returns the effective status of an issue. Custom statuses
+// keep their own name (and section); those mapped onto open still yield to
+// blocking dependencies like open issues do.
 func (m *LensDashboardModel) getIssueStatus(issue model.Issue) string {
-	if issue.Status == model.StatusClosed {
+	if issue.Status.IsCustom() {
+		if issue.Status.Column() == model.StatusOpen && len(m.blockedByMap[issue.ID]) > 0 {
+			return "blocked"
+		}
+		return string(issue.Status)
+	}
+	if issue.Status.IsClosed() {
 		return "closed"
 	}
+	// Custom statuses returned above, so only the built-in one is left here
 	if issue.Status == model.StatusInProgress {
 		return "in_progress"
 	}
 	// Check explicit blocked status first, then blocking dependencies
-	if issue.Status == model.StatusBlocked {
+	if issue.Status.Column() == model.StatusBlocked {
 		return "blocked"
 	}
 	if len(m.blockedByMap[issue.ID]) > 0 {
@@ -472,21 +618,70 @@ func (m *LensDashboardModel) getIssueStatus(issue model.Issue) string {
 	return "ready"
 }
 
-// getStatusOrder returns sort order for status (ready first)
+// blockerGates reports whether a blocker still counts as a nesting/blocking edge.
+// Normally closed blockers are ignored; archaeology mode keeps them so finished
+// chains render in the order they actually unfolded.
+func (m *LensDashboardModel) blockerGates(blocker *model.Issue) bool {
+	return m.archaeologyMode || !blocker.Status.IsClosed()
+}
+
+// getStatusOrder returns sort order for status (ready first, custom statuses
+// at their configured place)
 func (m *LensDashboardModel) getStatusOrder(issue model.Issue) int {
 	status := m.getIssueStatus(issue)
-	switch status {
-	case "ready":
-		return 0
-	case "in_progress":
-		return 1
-	case "blocked":
-		return 2
-	case "closed":
-		return 3
-	default:
-		return 4
+	if status == "ready" {
+		return model.StatusOpen.StatusOrder()
+	}
+	return model.Status(status).StatusOrder()
+}
+
+// lensStatusKind maps a section status onto the built-in one it counts as in
+// the header stats and progress: custom statuses count as their column
+func lensStatusKind(status string) string {
+	s := model.Status(status)
+	if !s.IsCustom() {
+		return status
+	}
+	if s.Column() == model.StatusOpen {
+		return "ready"
+	}
+	return string(s.Column())
+}
+
+// IsArchaeologyMode returns whether closed blockers are kept in the tree structure
+func (m *LensDashboardModel) IsArchaeologyMode() bool {
+	return m.archaeologyMode
+}
+
+// SetStaleDays sets the inactivity, in days, that marks open issues as aging
+func (m *LensDashboardModel) SetStaleDays(days int) {
+	m.staleDays = days
+}
+
+// SetClaims sets the workstream claims covering each issue
+func (m *LensDashboardModel) SetClaims(coverage map[string][]claims.Claim) {
+	m.claims = coverage
+}
+
+// SetEpicRollups sets the derived epic statuses shown beside the declared
+// ones; nil hides them
+func (m *LensDashboardModel) SetEpicRollups(rollups map[string]analysis.EpicRollup) {
+	m.epicRollups = rollups
+}
+
+// SetArchaeologyMode toggles closed-issue archaeology and rebuilds the tree
+func (m *LensDashboardModel) SetArchaeologyMode(on bool) {
+	if m.archaeologyMode == on {
+		return
 	}
+	m.archaeologyMode = on
+	m.buildTree()
+	m.recomputeWorkstreams()
+	if m.viewType == ViewTypeGrouped {
+		m.buildGroupedSections()
+	}
+	// The node list changes shape, so restart from the top
+	m.GoToTop()
 }
 
 // CycleDepth cycles through depth options
@@ -522,8 +717,8 @@ func (m *LensDashboardModel) IsCenteredMode() bool {
 // At Depth2+, returns expanded set including descendants.
 // When scope filtering is active, returns the scope-filtered primaryIDs.
 func (m *LensDashboardModel) GetPrimaryIDsForDepth() map[string]bool {
-	// When scope is active, applyScopeFilter has already filtered the IDs
-	if len(m.scopeLabels) > 0 {
+	// When a filter is active, applyScopeFilter has already filtered the IDs
+	if m.isFiltered() {
 		// For epic/bead modes with scope: use scope-filtered primaryIDs
 		if m.viewMode == "epic" || m.viewMode == "bead" {
 			return m.primaryIDs
@@ -564,6 +759,7 @@ func (m *LensDashboardModel) recomputeWorkstreams() {
 	displayIssues := m.getDisplayIssues()
 
 	workstreams := analysis.DetectWorkstreams(displayIssues, primaryIDs, selectedLabel)
+	workstreams = m.applyWorkstreamOverrides(workstreams, primaryIDs)
 	m.SetWorkstreams(workstreams)
 }
 
@@ -640,15 +836,73 @@ func (m *LensDashboardModel) SetDepth(depth DepthOption) {
 	m.recomputeWorkstreams()
 }
 
+// GetSectionSort returns the order used within status sections
+func (m *LensDashboardModel) GetSectionSort() SectionSort {
+	return m.sectionSort
+}
+
+// SectionOrder returns the section sort key and whether it is descending
+func (m *LensDashboardModel) SectionOrder() (SectionSort, bool) {
+	return m.sectionSort, m.sectionDesc
+}
+
+// SetSortSources gives the section sort the transitive dependent counts and
+// graph metrics it orders by impact and PageRank with
+func (m *LensDashboardModel) SetSortSources(impact map[string]int, stats *analysis.GraphStats) {
+	m.impact = impact
+	m.graphStats = stats
+	if m.sectionSort == SectionSortImpact || m.sectionSort == SectionSortPageRank {
+		m.SetSectionOrder(m.sectionSort, m.sectionDesc)
+	}
+}
+
+// CycleSectionSort cycles the key within status sections (blockers ->
+// priority -> created -> updated -> id -> impact -> pagerank), each in its
+// default direction, and keeps the selected issue
+func (m *LensDashboardModel) CycleSectionSort() {
+	next := (m.sectionSort + 1) % numSectionSorts
+	m.SetSectionOrder(next, next.defaultDesc())
+}
+
+// ToggleSectionSortDirection flips the section sort between ascending and
+// descending and keeps the selected issue
+func (m *LensDashboardModel) ToggleSectionSortDirection() {
+	m.SetSectionOrder(m.sectionSort, !m.sectionDesc)
+}
+
+// SetSectionOrder sets the section sort and rebuilds the tree, keeping the
+// selected issue
+func (m *LensDashboardModel) SetSectionOrder(s SectionSort, desc bool) {
+	m.sectionSort, m.sectionDesc = s, desc
+
+	selectedID := m.selectedIssueID
+	m.buildTree()
+	for i, node := range m.flatNodes {
+		if node.Node.Issue.ID == selectedID {
+			m.cursor = i
+			m.selectedIssueID = selectedID
+			m.ensureVisible()
+			break
+		}
+	}
+}
+
 // ══════════════════════════════════════════════════════════════════════════════
 // SCOPE MANAGEMENT - Multi-label filtering with union/intersection
 
 // SetSize updates the dashboard dimensions
 func (m *LensDashboardModel) SetSize(width, height int) {
+	rewrap := width != m.width && m.markdown != nil
 	m.width = width
 	m.height = height
-	// Enable split view mode for wide terminals
-	m.splitViewMode = width >= LensSplitViewThreshold
+	// Show the detail panel on wide terminals, or where v asked for it
+	m.updateSplitView()
+	if rewrap {
+		// The details were rendered for the old panel width
+		offset := m.detailViewport.YOffset
+		m.updateDetailContent()
+		m.detailViewport.SetYOffset(offset)
+	}
 }
 
 
@@ -721,7 +975,7 @@ func (m *LensDashboardModel) ExitGroupedView() {
 	m.viewType = ViewTypeFlat
 }
 
-// CycleGroupByMode cycles through grouping modes: Label -> Priority -> Status -> Label
+// CycleGroupByMode cycles through grouping modes: Label -> Priority -> Status -> Build order -> Label
 func (m *LensDashboardModel) CycleGroupByMode() {
 	switch m.groupByMode {
 	case GroupByLabel:
@@ -729,6 +983,8 @@ func (m *LensDashboardModel) CycleGroupByMode() {
 	case GroupByPriority:
 		m.groupByMode = GroupByStatus
 	case GroupByStatus:
+		m.groupByMode = GroupByWave
+	case GroupByWave:
 		m.groupByMode = GroupByLabel
 	default:
 		m.groupByMode = GroupByLabel
@@ -747,35 +1003,67 @@ func (m *LensDashboardModel) GetGroupByMode() GroupByMode {
 	return m.groupByMode
 }
 
-// updateSelectedIssueFromGrouped updates the selected issue ID based on grouped view cursor
-func (m *LensDashboardModel) updateSelectedIssueFromGrouped() {
-	if m.groupedIssueCursor < 0 {
-		// On group or sub-group header, no specific issue selected
-		m.selectedIssueID = ""
+// SetGroupByMode sets the grouping mode, rebuilding the groups when the
+// grouped view is showing
+func (m *LensDashboardModel) SetGroupByMode(mode GroupByMode) {
+	if m.groupByMode == mode {
 		return
 	}
+	m.groupByMode = mode
+	if m.viewType == ViewTypeGrouped {
+		m.buildGroupedSections()
+		m.updateSelectedIssueFromGrouped()
+	}
+}
+
+// groupedRows returns the issues of a group (subIdx < 0) or one of its
+// sub-groups in the order they are drawn. In tree view that is dependency
+// order, and issues past the depth limit are left out.
+func (m *LensDashboardModel) groupedRows(gIdx, subIdx int) []model.Issue {
+	if gIdx < 0 || gIdx >= len(m.groupedSections) {
+		return nil
+	}
+	ws := &m.groupedSections[gIdx]
+	if subIdx >= 0 {
+		if subIdx >= len(ws.SubWorkstreams) || ws.SubWorkstreams[subIdx] == nil {
+			return nil
+		}
+		ws = ws.SubWorkstreams[subIdx]
+	}
+	if !m.groupedTreeView {
+		return ws.Issues
+	}
 
+	wsCopy := *ws
+	flatNodes := m.flattenWSTree(m.buildWorkstreamTree(&wsCopy))
+	issues := make([]model.Issue, len(flatNodes))
+	for i, fn := range flatNodes {
+		issues[i] = fn.Node.Issue
+	}
+	return issues
+}
+
+// updateSelectedIssueFromGrouped updates the selected issue ID based on grouped view cursor.
+// Group and sub-group headers select their first issue, as workstream headers
+// do, so the detail panel and the copy/review keys work from any row.
+func (m *LensDashboardModel) updateSelectedIssueFromGrouped() {
 	if m.groupedCursor < 0 || m.groupedCursor >= len(m.groupedSections) {
 		m.selectedIssueID = ""
 		return
 	}
 
-	group := m.groupedSections[m.groupedCursor]
-
-	// Check if we're in a sub-group
-	if m.groupedSubCursor >= 0 && m.groupedSubCursor < len(group.SubWorkstreams) {
-		subGroup := group.SubWorkstreams[m.groupedSubCursor]
-		if subGroup != nil && m.groupedIssueCursor < len(subGroup.Issues) {
-			m.selectedIssueID = subGroup.Issues[m.groupedIssueCursor].ID
-		} else {
-			m.selectedIssueID = ""
-		}
-		return
+	subIdx := -1
+	if m.groupedSubCursor >= 0 && m.groupedSubCursor < len(m.groupedSections[m.groupedCursor].SubWorkstreams) {
+		subIdx = m.groupedSubCursor
 	}
+	rows := m.groupedRows(m.groupedCursor, subIdx)
 
-	// Not in sub-group, check group issues
-	if m.groupedIssueCursor < len(group.Issues) {
-		m.selectedIssueID = group.Issues[m.groupedIssueCursor].ID
+	idx := m.groupedIssueCursor
+	if idx < 0 {
+		idx = 0
+	}
+	if idx < len(rows) {
+		m.selectedIssueID = rows[idx].ID
 	} else {
 		m.selectedIssueID = ""
 	}
@@ -1079,9 +1367,17 @@ func (m *LensDashboardModel) SetWorkstreams(ws []analysis.Workstream) {
 		})
 	}
 
+	analysis.ForecastWorkstreams(ws, m.allIssues, time.Now())
+	m.applyWorkstreamNames(ws)
+	ws = m.filterStalledWorkstreams(ws)
 	m.workstreams = ws
 	m.workstreamCount = len(ws)
+	if m.wsCursor >= len(ws) {
+		// A filter or a move can leave fewer streams
+		m.wsCursor, m.wsIssueCursor = max(len(ws)-1, 0), -1
+	}
 	m.wsExpanded = make(map[int]bool)   // Reset expansion state
+	m.wsPage = make(map[int]int)        // Reset pagination
 	m.subWSExpanded = make(map[int]map[int]bool) // Reset sub-workstream expansion
 	m.subWsCursor = make(map[int]int)   // Reset sub-workstream cursors
 	m.wsSubdivided = false              // Reset subdivision state
@@ -1320,18 +1616,17 @@ func (m *LensDashboardModel) GoToBottom() {
 				m.groupedSubCursor = len(group.SubWorkstreams) - 1
 				// Navigate to last issue in last subgroup if expanded
 				if m.groupedSubExpanded[m.groupedCursor] != nil && m.groupedSubExpanded[m.groupedCursor][m.groupedSubCursor] {
-					subGroup := group.SubWorkstreams[m.groupedSubCursor]
-					if subGroup != nil && len(subGroup.Issues) > 0 {
-						m.groupedIssueCursor = len(subGroup.Issues) - 1
+					if n := len(m.groupedRows(m.groupedCursor, m.groupedSubCursor)); n > 0 {
+						m.groupedIssueCursor = n - 1
 					} else {
 						m.groupedIssueCursor = -1
 					}
 				} else {
 					m.groupedIssueCursor = -1
 				}
-			} else if len(group.Issues) > 0 {
+			} else if n := len(m.groupedRows(m.groupedCursor, -1)); n > 0 {
 				m.groupedSubCursor = -1
-				m.groupedIssueCursor = len(group.Issues) - 1
+				m.groupedIssueCursor = n - 1
 			} else {
 				m.groupedSubCursor = -1
 				m.groupedIssueCursor = -1
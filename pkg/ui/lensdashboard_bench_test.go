@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func BenchmarkLensBuildTree_Large1000(b *testing.B) {
+	benchLensBuildTree(b, testutil.QuickProject(1000))
+}
+
+func BenchmarkLensBuildTree_Large5000(b *testing.B) {
+	benchLensBuildTree(b, testutil.QuickProject(5000))
+}
+
+// benchLensBuildTree measures rebuilding the label lens tree, as a depth
+// change or reload does
+func benchLensBuildTree(b *testing.B, issues []model.Issue) {
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	m := NewLensDashboardModel("backend", issues, issueMap, DefaultTheme(lipgloss.NewRenderer(nil)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.buildTree()
+	}
+}
+
+// TestLensBuildTreeBudget fails when rebuilding a 5000-issue lens tree takes
+// several times longer than on a laptop. It only runs when asked:
+//
+//	BV_BENCH_BUDGETS=1 go test -run TestLensBuildTreeBudget ./pkg/ui/
+func TestLensBuildTreeBudget(t *testing.T) {
+	if os.Getenv("BV_BENCH_BUDGETS") != "1" {
+		t.Skip("Skipping benchmark budgets (set BV_BENCH_BUDGETS=1 to run)")
+	}
+	const budget = 200 * time.Millisecond
+	got := time.Duration(testing.Benchmark(BenchmarkLensBuildTree_Large5000).NsPerOp())
+	t.Logf("LensBuildTree_Large5000: %v/op (budget %v)", got, budget)
+	if got > budget {
+		t.Errorf("lens buildTree regressed: %v/op exceeds the %v budget", got, budget)
+	}
+}
@@ -0,0 +1,41 @@
+package ui
+
+import "strings"
+
+// ══════════════════════════════════════════════════════════════════════════════
+// BREADCRUMBS - the path of lenses drilled through (label › epic › bead)
+// ══════════════════════════════════════════════════════════════════════════════
+
+// lensCrumbWidth caps each breadcrumb so a deep path still fits
+const lensCrumbWidth = 28
+
+// SetBreadcrumbs sets the path of lenses leading here, outermost first and
+// ending with this one. A single crumb (nothing drilled into) shows no bar.
+func (m *LensDashboardModel) SetBreadcrumbs(crumbs []string) {
+	m.breadcrumbs = crumbs
+}
+
+// renderBreadcrumbs renders the breadcrumb bar, or "" when this lens was
+// opened directly
+func (m *LensDashboardModel) renderBreadcrumbs(contentWidth int) string {
+	if len(m.breadcrumbs) < 2 {
+		return ""
+	}
+	t := m.theme
+	pathStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	hereStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	sepStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+	hintStyle := t.Renderer.NewStyle().Faint(true)
+
+	parts := make([]string, 0, len(m.breadcrumbs))
+	for i, crumb := range m.breadcrumbs {
+		crumb = truncateRunesHelper(crumb, lensCrumbWidth, m.theme.Glyph("…"))
+		if i == len(m.breadcrumbs)-1 {
+			parts = append(parts, hereStyle.Render(crumb))
+		} else {
+			parts = append(parts, pathStyle.Render(crumb))
+		}
+	}
+	bar := strings.Join(parts, sepStyle.Render(m.theme.Glyph(" › "))) + " " + hintStyle.Render("esc up")
+	return t.Renderer.NewStyle().MaxWidth(contentWidth).Render(bar)
+}
@@ -1,7 +1,10 @@
 package ui
 
 import (
+	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
@@ -23,9 +26,9 @@ func (m *LensDashboardModel) buildWorkstreamFromIssues(name string, issues []mod
 		ws.IssueIDs[i] = issue.ID
 	}
 
-	// Compute stats
+	// Compute stats (custom statuses count as their column)
 	for _, issue := range issues {
-		switch issue.Status {
+		switch issue.Status.Column() {
 		case model.StatusClosed:
 			ws.ClosedCount++
 		case model.StatusBlocked:
@@ -49,6 +52,7 @@ func (m *LensDashboardModel) buildWorkstreamFromIssues(name string, issues []mod
 	}
 
 	ws.PrimaryCount = len(issues)
+	analysis.ScheduleWorkstream(&ws)
 	return ws
 }
 
@@ -233,16 +237,18 @@ func (m *LensDashboardModel) buildGroupedByPriority() []analysis.Workstream {
 	return result
 }
 
-// buildGroupedByStatus groups issues by computed status (considers implicit blocking)
+// buildGroupedByStatus groups issues by computed status (considers implicit
+// blocking), custom statuses in their configured place
 func (m *LensDashboardModel) buildGroupedByStatus() []analysis.Workstream {
 	statusNames := map[string]string{
-		"open":        "Open",
+		"ready":       "Open",
 		"in_progress": "In Progress",
 		"blocked":     "Blocked",
 		"closed":      "Closed",
 	}
-	statusOrder := []string{"open", "in_progress", "blocked", "closed"}
 	groups := make(map[string][]model.Issue)
+	var statuses []string
+	order := make(map[string]int)
 
 	for _, issue := range m.allIssues {
 		if !m.primaryIDs[issue.ID] {
@@ -250,15 +256,55 @@ func (m *LensDashboardModel) buildGroupedByStatus() []analysis.Workstream {
 		}
 		// Use computed status which checks blockedByMap for implicit blocking
 		computedStatus := m.getIssueStatus(issue)
+		if _, seen := groups[computedStatus]; !seen {
+			statuses = append(statuses, computedStatus)
+			order[computedStatus] = m.getStatusOrder(issue)
+		}
 		groups[computedStatus] = append(groups[computedStatus], issue)
 	}
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if order[statuses[i]] != order[statuses[j]] {
+			return order[statuses[i]] < order[statuses[j]]
+		}
+		return statuses[i] < statuses[j]
+	})
 
 	var result []analysis.Workstream
-	for _, status := range statusOrder {
-		if issues, ok := groups[status]; ok && len(issues) > 0 {
-			ws := m.buildWorkstreamFromIssues(statusNames[status], issues)
-			result = append(result, ws)
+	for _, status := range statuses {
+		name, ok := statusNames[status]
+		if !ok {
+			name = strings.ReplaceAll(status, "_", " ")
+		}
+		result = append(result, m.buildWorkstreamFromIssues(name, groups[status]))
+	}
+	return result
+}
+
+// buildGroupedByWave lays the lens out in build order: one section per wave
+// of issues that can be worked in parallel once the earlier waves close,
+// then the open issues no wave reaches and the closed ones
+func (m *LensDashboardModel) buildGroupedByWave() []analysis.Workstream {
+	var issues []model.Issue
+	for _, issue := range m.allIssues {
+		if m.primaryIDs[issue.ID] {
+			issues = append(issues, issue)
+		}
+	}
+	order := analysis.ComputeBuildOrder(issues, m.allIssues)
+
+	var result []analysis.Workstream
+	for i, wave := range order.Waves {
+		name := fmt.Sprintf("Wave %d", i+1)
+		if i == 0 {
+			name += m.theme.Glyph(" · ready now")
 		}
+		result = append(result, m.buildWorkstreamFromIssues(name, wave))
+	}
+	if len(order.Waiting) > 0 {
+		result = append(result, m.buildWorkstreamFromIssues("Waiting (blocked outside the lens or cyclic)", order.Waiting))
+	}
+	if len(order.Done) > 0 {
+		result = append(result, m.buildWorkstreamFromIssues("Closed", order.Done))
 	}
 	return result
 }
@@ -272,9 +318,12 @@ func (m *LensDashboardModel) buildGroupedSections() {
 		m.groupedSections = m.buildGroupedByPriority()
 	case GroupByStatus:
 		m.groupedSections = m.buildGroupedByStatus()
+	case GroupByWave:
+		m.groupedSections = m.buildGroupedByWave()
 	default:
 		m.groupedSections = m.buildGroupedByLabel()
 	}
+	analysis.ForecastWorkstreams(m.groupedSections, m.allIssues, time.Now())
 
 	// Initialize expansion state - expand first group by default
 	if m.groupedExpanded == nil {
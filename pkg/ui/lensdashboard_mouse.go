@@ -0,0 +1,121 @@
+package ui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// ══════════════════════════════════════════════════════════════════════════════
+// MOUSE - Click-to-select and wheel scrolling
+// ══════════════════════════════════════════════════════════════════════════════
+
+// cursorLine returns the content line of the cursor and the first visible
+// content line for the active view, matching the dispatch in View()
+func (m *LensDashboardModel) cursorLine() (line, scroll int) {
+	switch {
+	case m.viewType == ViewTypeGrouped && len(m.groupedSections) > 0:
+		return m.getGroupedCursorLine(), m.groupedScroll
+	case m.viewType == ViewTypeWorkstream && len(m.workstreams) > 1:
+		return m.getWSCursorLine(), m.wsScroll
+	case (m.viewMode == "epic" || m.viewMode == "bead") && m.egoNode != nil:
+		return m.getCenteredCursorLine(), m.scroll
+	default:
+		return m.getFlatLinePosition(m.cursor), m.scroll
+	}
+}
+
+// contentArea returns the screen row of the first content line and the
+// number of content lines shown, matching View() and renderTreeContent()
+func (m *LensDashboardModel) contentArea() (top, height int) {
+	if m.splitViewMode {
+		// Panel border and panel header sit above the tree header
+		leftWidth, _ := m.splitPanelWidths()
+		return 2 + len(m.renderTreeContentHeader(leftWidth-4)), max(m.height-10, 5)
+	}
+	vp := m.calculateViewport()
+	return vp.HeaderLines, vp.ContentHeight
+}
+
+// selectLine moves the cursor to the given content line by stepping through
+// the normal navigation, so every view keeps its own cursor bookkeeping.
+// Lines that hold no selectable row leave the cursor on the nearest row past
+// them; it reports whether the target line itself was reached.
+func (m *LensDashboardModel) selectLine(target int) bool {
+	line, _ := m.cursorLine()
+	for line != target {
+		if line < target {
+			m.MoveDown()
+		} else {
+			m.MoveUp()
+		}
+		next, _ := m.cursorLine()
+		if next == line || (line < target) != (next < target) {
+			return next == target
+		}
+		line = next
+	}
+	return true
+}
+
+// onHeader reports whether the cursor sits on a workstream or group header
+func (m *LensDashboardModel) onHeader() bool {
+	switch {
+	case m.viewType == ViewTypeGrouped && len(m.groupedSections) > 0:
+		return m.groupedIssueCursor < 0
+	case m.viewType == ViewTypeWorkstream && len(m.workstreams) > 1:
+		return m.wsIssueCursor < 0
+	}
+	return false
+}
+
+// HandleMouse handles wheel scrolling and clicks. It returns true when a
+// workstream or group header was clicked, which the caller treats like
+// enter (toggle expand).
+func (m *LensDashboardModel) HandleMouse(msg tea.MouseMsg) bool {
+	if m.ShowFuzzySearch() || m.ShowScopeInput() || m.IsRenamingWorkstream() {
+		return false
+	}
+
+	inDetail := false
+	if m.splitViewMode {
+		leftWidth, _ := m.splitPanelWidths()
+		inDetail = msg.X >= leftWidth
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		if inDetail {
+			m.ScrollDetailUp()
+		} else {
+			m.MoveUp()
+		}
+		return false
+	case tea.MouseButtonWheelDown:
+		if inDetail {
+			m.ScrollDetailDown()
+		} else {
+			m.MoveDown()
+		}
+		return false
+	case tea.MouseButtonLeft:
+		if msg.Action != tea.MouseActionPress {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if m.splitViewMode {
+		m.SetDetailFocus(inDetail)
+		if inDetail {
+			return false
+		}
+	}
+
+	top, height := m.contentArea()
+	if msg.Y < top || msg.Y >= top+height {
+		return false
+	}
+	_, scroll := m.cursorLine()
+	if !m.selectLine(scroll + msg.Y - top) {
+		return false
+	}
+	return m.onHeader()
+}
@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+)
+
+// ══════════════════════════════════════════════════════════════════════════════
+// WORKSTREAM NAMES - Renaming streams with n, kept in the project config
+// ══════════════════════════════════════════════════════════════════════════════
+
+// workstreamRename is a finished rename for the owner to save: Name under
+// Key in the config's workstream_names ("" goes back to the detected name)
+type workstreamRename struct {
+	Key  string
+	Name string
+}
+
+// SetWorkstreamNames sets the chosen workstream names, keyed by
+// config.WorkstreamNameKey, and applies them to the current streams
+func (m *LensDashboardModel) SetWorkstreamNames(names map[string]string) {
+	m.workstreamNames = names
+	for i := range m.workstreams {
+		m.renameWorkstream(i, m.chosenWorkstreamName(m.workstreams[i].ID))
+	}
+}
+
+// workstreamNameKey is the config key of the stream wsID in this lens
+func (m *LensDashboardModel) workstreamNameKey(wsID string) string {
+	return config.WorkstreamNameKey(m.lensConfigKey(), wsID)
+}
+
+// chosenWorkstreamName is the name chosen for wsID, or the detected one
+func (m *LensDashboardModel) chosenWorkstreamName(wsID string) string {
+	if name := m.workstreamNames[m.workstreamNameKey(wsID)]; name != "" {
+		return name
+	}
+	return m.detectedWSNames[wsID]
+}
+
+// applyWorkstreamNames records the detected names of freshly detected
+// streams, then renames the ones a name was chosen for
+func (m *LensDashboardModel) applyWorkstreamNames(ws []analysis.Workstream) {
+	m.detectedWSNames = make(map[string]string, len(ws))
+	for _, stream := range ws {
+		m.detectedWSNames[stream.ID] = stream.Name
+	}
+	for i := range ws {
+		renameWorkstreamIn(ws, i, m.chosenWorkstreamName(ws[i].ID))
+	}
+}
+
+// renameWorkstream renames the i'th stream in place
+func (m *LensDashboardModel) renameWorkstream(i int, name string) {
+	renameWorkstreamIn(m.workstreams, i, name)
+}
+
+// renameWorkstreamIn renames ws[i], along with the cross-stream
+// dependencies of every stream that mention it by name
+func renameWorkstreamIn(ws []analysis.Workstream, i int, name string) {
+	old := ws[i].Name
+	if name == "" || name == old {
+		return
+	}
+	ws[i].Name = name
+	for j := range ws {
+		for _, deps := range [][]analysis.CrossWorkstreamBlocker{ws[j].CrossBlockedBy, ws[j].CrossBlocks} {
+			for k := range deps {
+				if deps[k].BlockerWorkstream == old {
+					deps[k].BlockerWorkstream = name
+				}
+				if deps[k].BlockedWorkstream == old {
+					deps[k].BlockedWorkstream = name
+				}
+			}
+		}
+	}
+}
+
+// StartWorkstreamRename opens the name of the stream under the cursor for
+// editing; it returns the status line to show
+func (m *LensDashboardModel) StartWorkstreamRename() string {
+	if !m.IsWorkstreamView() || m.wsCursor >= len(m.workstreams) {
+		return "Switch to the workstream view (w) to rename a workstream"
+	}
+	m.renamingWS = true
+	m.renameInput = m.workstreams[m.wsCursor].Name
+	return "Rename workstream (Enter: save, empty: detected name, Esc: cancel)"
+}
+
+// IsRenamingWorkstream reports whether a stream's name is being edited
+func (m *LensDashboardModel) IsRenamingWorkstream() bool {
+	return m.renamingWS
+}
+
+// HandleWorkstreamRenameKey edits the name being typed. Enter renames the
+// stream and returns the rename to save; an empty name restores the
+// detected one.
+func (m *LensDashboardModel) HandleWorkstreamRenameKey(key string) (*workstreamRename, string) {
+	switch key {
+	case "esc":
+		m.renamingWS = false
+		return nil, "Rename cancelled"
+	case "enter":
+		m.renamingWS = false
+		if m.wsCursor >= len(m.workstreams) {
+			return nil, ""
+		}
+		id := m.workstreams[m.wsCursor].ID
+		rename := &workstreamRename{Key: m.workstreamNameKey(id), Name: m.renameInput}
+		if m.renameInput == m.detectedWSNames[id] {
+			rename.Name = ""
+		}
+		if m.workstreamNames == nil {
+			m.workstreamNames = make(map[string]string)
+		}
+		if rename.Name == "" {
+			delete(m.workstreamNames, rename.Key)
+		} else {
+			m.workstreamNames[rename.Key] = rename.Name
+		}
+		m.renameWorkstream(m.wsCursor, m.chosenWorkstreamName(id))
+		return rename, ""
+	case "backspace", "ctrl+h":
+		if _, size := utf8.DecodeLastRuneInString(m.renameInput); size > 0 {
+			m.renameInput = m.renameInput[:len(m.renameInput)-size]
+		}
+	case "ctrl+u":
+		m.renameInput = ""
+	default:
+		if r, size := utf8.DecodeRuneInString(key); size == len(key) && unicode.IsPrint(r) {
+			m.renameInput += key
+		}
+	}
+	return nil, ""
+}
@@ -1,5 +1,12 @@
 package ui
 
+import (
+	"slices"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
 // ══════════════════════════════════════════════════════════════════════════════
 // NAVIGATION - Cursor movement and scroll management
 // ══════════════════════════════════════════════════════════════════════════════
@@ -122,6 +129,86 @@ func (m *LensDashboardModel) getVisibleIssueCount(wsIdx int) int {
 	return issueCount // Expanded: show all
 }
 
+// wsPageSize is how many issues an expanded workstream shows at a time
+const wsPageSize = 10
+
+// wsPageCount returns the number of pages of an expanded workstream (1 when
+// it fits on one page or is collapsed)
+func (m *LensDashboardModel) wsPageCount(wsIdx int) int {
+	if !m.wsExpanded[wsIdx] {
+		return 1
+	}
+	return max(1, (m.getVisibleIssueCount(wsIdx)+wsPageSize-1)/wsPageSize)
+}
+
+// wsPageRange returns the issue indexes [start, end) rendered for a workstream
+func (m *LensDashboardModel) wsPageRange(wsIdx int) (start, end int) {
+	count := m.getVisibleIssueCount(wsIdx)
+	pages := m.wsPageCount(wsIdx)
+	if pages == 1 {
+		return 0, count
+	}
+	page := min(m.wsPage[wsIdx], pages-1)
+	start = page * wsPageSize
+	return start, min(start+wsPageSize, count)
+}
+
+// WorkstreamPage returns the 1-based page and page count of the current workstream
+func (m *LensDashboardModel) WorkstreamPage() (page, pages int) {
+	if len(m.workstreams) == 0 {
+		return 1, 1
+	}
+	start, _ := m.wsPageRange(m.wsCursor)
+	return start/wsPageSize + 1, m.wsPageCount(m.wsCursor)
+}
+
+// JumpToWorkstream selects the header of workstream n (0-based), collapsing
+// the current one and expanding the target like [ and ] do. It reports
+// whether n is a valid workstream.
+func (m *LensDashboardModel) JumpToWorkstream(n int) bool {
+	if m.viewType != ViewTypeWorkstream || n < 0 || n >= len(m.workstreams) {
+		return false
+	}
+	if n != m.wsCursor {
+		m.wsExpanded[m.wsCursor] = false
+		m.wsCursor = n
+	}
+	m.wsExpanded[n] = true
+	m.wsIssueCursor = -1
+	m.updateSelectedIssueFromWS()
+	return true
+}
+
+// NextWorkstreamPage moves to the first issue of the current workstream's
+// next page, expanding it first if needed
+func (m *LensDashboardModel) NextWorkstreamPage() {
+	m.stepWorkstreamPage(1)
+}
+
+// PrevWorkstreamPage moves to the first issue of the current workstream's
+// previous page
+func (m *LensDashboardModel) PrevWorkstreamPage() {
+	m.stepWorkstreamPage(-1)
+}
+
+func (m *LensDashboardModel) stepWorkstreamPage(delta int) {
+	if m.viewType != ViewTypeWorkstream || len(m.workstreams) == 0 {
+		return
+	}
+	m.wsExpanded[m.wsCursor] = true
+	start, _ := m.wsPageRange(m.wsCursor)
+	page := start/wsPageSize + delta
+	if m.wsIssueCursor < 0 && delta > 0 {
+		// From the header, the first step lands on the page already shown
+		page = start / wsPageSize
+	}
+	if page < 0 || page >= m.wsPageCount(m.wsCursor) || m.getVisibleIssueCount(m.wsCursor) == 0 {
+		return
+	}
+	m.wsIssueCursor = page * wsPageSize
+	m.updateSelectedIssueFromWS()
+}
+
 // moveDownWS moves cursor down in workstream view
 func (m *LensDashboardModel) moveDownWS() {
 	if len(m.workstreams) == 0 {
@@ -171,12 +258,8 @@ func (m *LensDashboardModel) moveUpGrouped() {
 		if m.groupedSubCursor > 0 && m.groupedSubCursor <= len(group.SubWorkstreams) {
 			// Go to previous sub-group's last issue or header
 			m.groupedSubCursor--
-			subGroup := group.SubWorkstreams[m.groupedSubCursor]
-			if m.groupedSubExpanded[m.groupedCursor] != nil && m.groupedSubExpanded[m.groupedCursor][m.groupedSubCursor] && subGroup != nil {
-				m.groupedIssueCursor = len(subGroup.Issues) - 1
-				if m.groupedIssueCursor < 0 {
-					m.groupedIssueCursor = -1
-				}
+			if m.groupedSubExpanded[m.groupedCursor] != nil && m.groupedSubExpanded[m.groupedCursor][m.groupedSubCursor] {
+				m.groupedIssueCursor = len(m.groupedRows(m.groupedCursor, m.groupedSubCursor)) - 1
 			} else {
 				m.groupedIssueCursor = -1
 			}
@@ -198,19 +281,15 @@ func (m *LensDashboardModel) moveUpGrouped() {
 		if prevHasSubGroups {
 			// Go to last sub-group
 			m.groupedSubCursor = len(prevGroup.SubWorkstreams) - 1
-			subGroup := prevGroup.SubWorkstreams[m.groupedSubCursor]
-			if m.groupedSubExpanded[m.groupedCursor] != nil && m.groupedSubExpanded[m.groupedCursor][m.groupedSubCursor] && subGroup != nil {
-				m.groupedIssueCursor = len(subGroup.Issues) - 1
-				if m.groupedIssueCursor < 0 {
-					m.groupedIssueCursor = -1
-				}
+			if m.groupedSubExpanded[m.groupedCursor] != nil && m.groupedSubExpanded[m.groupedCursor][m.groupedSubCursor] {
+				m.groupedIssueCursor = len(m.groupedRows(m.groupedCursor, m.groupedSubCursor)) - 1
 			} else {
 				m.groupedIssueCursor = -1
 			}
-		} else if m.groupedExpanded[m.groupedCursor] && len(prevGroup.Issues) > 0 {
+		} else if n := len(m.groupedRows(m.groupedCursor, -1)); m.groupedExpanded[m.groupedCursor] && n > 0 {
 			// Go to last issue in previous group
 			m.groupedSubCursor = -1
-			m.groupedIssueCursor = len(prevGroup.Issues) - 1
+			m.groupedIssueCursor = n - 1
 		} else {
 			// Go to previous group header
 			m.groupedSubCursor = -1
@@ -234,12 +313,8 @@ func (m *LensDashboardModel) moveDownGrouped() {
 
 	if m.groupedSubCursor >= 0 && len(group.SubWorkstreams) > m.groupedSubCursor {
 		// We're in a sub-group
-		subGroup := group.SubWorkstreams[m.groupedSubCursor]
 		isSubExpanded := m.groupedSubExpanded[m.groupedCursor] != nil && m.groupedSubExpanded[m.groupedCursor][m.groupedSubCursor]
-		subIssueCount := 0
-		if subGroup != nil {
-			subIssueCount = len(subGroup.Issues)
-		}
+		subIssueCount := len(m.groupedRows(m.groupedCursor, m.groupedSubCursor))
 
 		if m.groupedIssueCursor < 0 {
 			// At sub-group header
@@ -277,7 +352,7 @@ func (m *LensDashboardModel) moveDownGrouped() {
 		}
 	} else if m.groupedIssueCursor >= 0 {
 		// We're in group issues (no sub-groups)
-		if m.groupedIssueCursor < len(group.Issues)-1 {
+		if m.groupedIssueCursor < len(m.groupedRows(m.groupedCursor, -1))-1 {
 			m.groupedIssueCursor++
 		} else if m.groupedCursor < len(m.groupedSections)-1 {
 			// Go to next group
@@ -291,7 +366,7 @@ func (m *LensDashboardModel) moveDownGrouped() {
 			// Go to first sub-group
 			m.groupedSubCursor = 0
 			m.groupedIssueCursor = -1
-		} else if isGroupExpanded && len(group.Issues) > 0 {
+		} else if isGroupExpanded && len(m.groupedRows(m.groupedCursor, -1)) > 0 {
 			// Go to first issue
 			m.groupedIssueCursor = 0
 		} else if m.groupedCursor < len(m.groupedSections)-1 {
@@ -323,7 +398,7 @@ func (m *LensDashboardModel) getVisibleGroupedIssueCount(gIdx int) int {
 		return 0 // Issues in sub-groups, not directly navigable at group level
 	}
 
-	return len(group.Issues)
+	return len(m.groupedRows(gIdx, -1))
 }
 
 // getTotalGroupedLines calculates total lines in grouped view
@@ -333,7 +408,7 @@ func (m *LensDashboardModel) getTotalGroupedLines() int {
 		totalLines++ // Header line
 		if m.groupedExpanded[i] {
 			if len(group.SubWorkstreams) == 0 {
-				totalLines += len(group.Issues)
+				totalLines += len(m.groupedRows(i, -1))
 			} else {
 				for j, sub := range group.SubWorkstreams {
 					if sub == nil {
@@ -341,7 +416,7 @@ func (m *LensDashboardModel) getTotalGroupedLines() int {
 					}
 					totalLines++ // Sub-group header
 					if m.groupedSubExpanded[i] != nil && m.groupedSubExpanded[i][j] {
-						totalLines += len(sub.Issues)
+						totalLines += len(m.groupedRows(i, j))
 					}
 				}
 			}
@@ -353,7 +428,38 @@ func (m *LensDashboardModel) getTotalGroupedLines() int {
 
 // ensureGroupedVisible ensures the current cursor position is visible
 func (m *LensDashboardModel) ensureGroupedVisible() {
-	// Calculate line position for current cursor
+	linePos := m.getGroupedCursorLine()
+
+	// Calculate visible lines using viewport config
+	vp := m.calculateViewport()
+	contentLines := vp.ContentHeight
+	if contentLines < 5 {
+		contentLines = 5
+	}
+
+	// Center cursor in viewport with reduced scrolloff (1/4 viewport instead of 1/2)
+	scrolloff := contentLines / 4
+	targetScroll := linePos - scrolloff
+	if targetScroll < 0 {
+		targetScroll = 0
+	}
+
+	// Allow scroll to center last items (with empty padding below)
+	totalLines := m.getTotalGroupedLines()
+	scrolloffForBottom := contentLines / 4
+	maxScroll := totalLines - contentLines + scrolloffForBottom
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	if targetScroll > maxScroll {
+		targetScroll = maxScroll
+	}
+
+	m.groupedScroll = targetScroll
+}
+
+// getGroupedCursorLine calculates the line number of the current cursor in grouped view
+func (m *LensDashboardModel) getGroupedCursorLine() int {
 	// This must match the rendering logic in renderGroupedView()
 	linePos := 0
 
@@ -363,7 +469,7 @@ func (m *LensDashboardModel) ensureGroupedVisible() {
 			group := m.groupedSections[i]
 			if len(group.SubWorkstreams) == 0 {
 				// No sub-groups: just add issue count
-				linePos += len(group.Issues)
+				linePos += len(m.groupedRows(i, -1))
 			} else {
 				// Has sub-groups: add each sub-group header + expanded issues
 				for j, sub := range group.SubWorkstreams {
@@ -372,7 +478,7 @@ func (m *LensDashboardModel) ensureGroupedVisible() {
 					}
 					linePos++ // Sub-group header
 					if m.groupedSubExpanded[i] != nil && m.groupedSubExpanded[i][j] {
-						linePos += len(sub.Issues)
+						linePos += len(m.groupedRows(i, j))
 					}
 				}
 			}
@@ -380,8 +486,7 @@ func (m *LensDashboardModel) ensureGroupedVisible() {
 		linePos++ // Empty line between groups
 	}
 
-	// Add current group header
-	linePos++
+	// linePos is now the current group header line
 
 	// Handle position within current group
 	if m.groupedCursor >= 0 && m.groupedCursor < len(m.groupedSections) {
@@ -404,10 +509,10 @@ func (m *LensDashboardModel) ensureGroupedVisible() {
 						}
 						linePos++ // Sub-group header
 						if m.groupedSubExpanded[m.groupedCursor] != nil && m.groupedSubExpanded[m.groupedCursor][j] {
-							linePos += len(sub.Issues)
+							linePos += len(m.groupedRows(m.groupedCursor, j))
 						}
 					}
-					// Add current sub-group header
+					// Step past the group header to the current sub-group header
 					linePos++
 					// Add issue position within sub-group
 					if m.groupedIssueCursor >= 0 {
@@ -418,32 +523,7 @@ func (m *LensDashboardModel) ensureGroupedVisible() {
 		}
 	}
 
-	// Calculate visible lines using viewport config
-	vp := m.calculateViewport()
-	contentLines := vp.ContentHeight
-	if contentLines < 5 {
-		contentLines = 5
-	}
-
-	// Center cursor in viewport with reduced scrolloff (1/4 viewport instead of 1/2)
-	scrolloff := contentLines / 4
-	targetScroll := linePos - scrolloff
-	if targetScroll < 0 {
-		targetScroll = 0
-	}
-
-	// Allow scroll to center last items (with empty padding below)
-	totalLines := m.getTotalGroupedLines()
-	scrolloffForBottom := contentLines / 4
-	maxScroll := totalLines - contentLines + scrolloffForBottom
-	if maxScroll < 0 {
-		maxScroll = 0
-	}
-	if targetScroll > maxScroll {
-		targetScroll = maxScroll
-	}
-
-	m.groupedScroll = targetScroll
+	return linePos
 }
 
 // updateSelectedIssueFromWS updates selectedIssueID based on workstream cursor
@@ -488,6 +568,14 @@ func (m *LensDashboardModel) updateSelectedIssueFromWS() {
 		m.selectedIssueID = ""
 	}
 
+	// Keep the page holding the cursor on screen
+	if m.wsIssueCursor >= 0 && isExpanded {
+		if m.wsPage == nil {
+			m.wsPage = make(map[int]int)
+		}
+		m.wsPage[m.wsCursor] = m.wsIssueCursor / wsPageSize
+	}
+
 	// Ensure current position is visible
 	m.ensureVisibleWS()
 }
@@ -551,17 +639,26 @@ func (m *LensDashboardModel) getWSCursorLine() int {
 			issueLineCount = m.getVisibleIssueCount(wsIdx)
 		}
 
+		// Only the current page of an expanded workstream is drawn
+		start, end := m.wsPageRange(wsIdx)
+		if end-start < issueLineCount {
+			issueLineCount = end - start
+		}
+
 		if wsIdx == m.wsCursor && m.wsIssueCursor >= 0 {
 			// Clamp cursor to valid range
-			if m.wsIssueCursor >= issueLineCount {
+			if m.wsIssueCursor-start >= issueLineCount {
 				return line + issueLineCount - 1
 			}
-			return line + m.wsIssueCursor
+			return line + max(m.wsIssueCursor-start, 0)
 		}
 		line += issueLineCount
+		if m.wsPageCount(wsIdx) > 1 {
+			line++ // Page indicator
+		}
 
-		// "+N more" line if collapsed with hidden issues (only in flat view)
-		if !isExpanded && !m.wsTreeView && len(ws.Issues) > 3 {
+		// "+N more" line if collapsed with hidden issues
+		if !isExpanded && len(ws.Issues) > 3 {
 			line++
 		}
 
@@ -580,16 +677,13 @@ func (m *LensDashboardModel) getTotalWSLines() int {
 
 		line++ // header
 
-		if m.wsTreeView && isExpanded {
-			wsCopy := ws
-			treeRoots := m.buildWorkstreamTree(&wsCopy)
-			flatNodes := m.flattenWSTree(treeRoots)
-			line += len(flatNodes)
-		} else {
-			line += m.getVisibleIssueCount(wsIdx)
-			if !isExpanded && len(ws.Issues) > 3 {
-				line++ // "+N more" line
-			}
+		start, end := m.wsPageRange(wsIdx)
+		line += end - start
+		if m.wsPageCount(wsIdx) > 1 {
+			line++ // Page indicator
+		}
+		if !isExpanded && len(ws.Issues) > 3 {
+			line++ // "+N more" line
 		}
 
 		line++ // empty line
@@ -866,6 +960,122 @@ func (m *LensDashboardModel) SelectedIssueID() string {
 	return m.selectedIssueID
 }
 
+// WorkstreamOf returns the detected workstream holding id, or nil
+func (m *LensDashboardModel) WorkstreamOf(id string) *analysis.Workstream {
+	for i := range m.workstreams {
+		if slices.ContainsFunc(m.workstreams[i].Issues, func(issue model.Issue) bool { return issue.ID == id }) {
+			return &m.workstreams[i]
+		}
+	}
+	return nil
+}
+
+// SelectIssue moves the cursor to id in the current view, expanding the
+// workstream, group or sub-group holding it. It reports whether the
+// dashboard shows id at all.
+func (m *LensDashboardModel) SelectIssue(id string) bool {
+	m.CloseFuzzySearch()
+
+	var found bool
+	switch {
+	case m.viewType == ViewTypeGrouped && len(m.groupedSections) > 0:
+		found = m.selectGroupedIssue(id)
+	case m.viewType == ViewTypeWorkstream && len(m.workstreams) > 1:
+		found = m.selectWSIssue(id)
+	case m.IsCenteredMode() && m.egoNode != nil:
+		cursor := m.cursor
+		for i := 0; i < m.getTotalCenteredNodeCount() && !found; i++ {
+			m.cursor = i
+			found = m.getSelectedIDForCenteredMode() == id
+		}
+		if !found {
+			m.cursor = cursor
+			break
+		}
+		m.selectedIssueID = id
+		m.ensureCenteredVisible()
+	default:
+		for i, fn := range m.flatNodes {
+			if fn.Node.Issue.ID == id {
+				m.cursor = i
+				m.selectedIssueID = id
+				m.ensureVisible()
+				found = true
+				break
+			}
+		}
+	}
+
+	if found {
+		m.updateDetailContent()
+	}
+	return found
+}
+
+// selectWSIssue expands the workstream holding id and puts the cursor on it
+func (m *LensDashboardModel) selectWSIssue(id string) bool {
+	for wsIdx := range m.workstreams {
+		ws := m.workstreams[wsIdx]
+		if !slices.ContainsFunc(ws.Issues, func(issue model.Issue) bool { return issue.ID == id }) {
+			continue
+		}
+		m.wsExpanded[wsIdx] = true
+		row := -1
+		if m.wsTreeView {
+			for i, fn := range m.flattenWSTree(m.buildWorkstreamTree(&ws)) {
+				if fn.Node.Issue.ID == id {
+					row = i
+					break
+				}
+			}
+		} else {
+			row = slices.IndexFunc(ws.Issues, func(issue model.Issue) bool { return issue.ID == id })
+		}
+		if row < 0 {
+			continue // Past the tree's depth limit
+		}
+		m.wsCursor = wsIdx
+		m.wsIssueCursor = row
+		m.updateSelectedIssueFromWS()
+		return true
+	}
+	return false
+}
+
+// selectGroupedIssue expands the group and sub-group holding id and puts
+// the cursor on it
+func (m *LensDashboardModel) selectGroupedIssue(id string) bool {
+	for gIdx, group := range m.groupedSections {
+		subs := []int{-1}
+		if len(group.SubWorkstreams) > 0 {
+			subs = subs[:0]
+			for j := range group.SubWorkstreams {
+				subs = append(subs, j)
+			}
+		}
+		for _, subIdx := range subs {
+			row := slices.IndexFunc(m.groupedRows(gIdx, subIdx), func(issue model.Issue) bool { return issue.ID == id })
+			if row < 0 {
+				continue
+			}
+			m.groupedExpanded[gIdx] = true
+			if subIdx >= 0 {
+				if m.groupedSubExpanded[gIdx] == nil {
+					m.groupedSubExpanded[gIdx] = make(map[int]bool)
+				}
+				m.groupedSubExpanded[gIdx][subIdx] = true
+			}
+			m.groupedCursor = gIdx
+			m.groupedSubCursor = subIdx
+			m.groupedIssueCursor = row
+			m.updateSelectedIssueFromGrouped()
+			m.ensureGroupedVisible()
+			return true
+		}
+	}
+	return false
+}
+
 // LabelName returns the current label name
 func (m *LensDashboardModel) LabelName() string {
 	return m.labelName
@@ -876,3 +1086,168 @@ func (m *LensDashboardModel) IssueCount() int {
 	return m.totalCount
 }
 
+// ══════════════════════════════════════════════════════════════════════════════
+// ROW ACTIONS - Expand, collapse and step between headers and issues
+// ══════════════════════════════════════════════════════════════════════════════
+
+// LensRow is the kind of row under the cursor
+type LensRow int
+
+const (
+	LensRowIssue     LensRow = iota // An issue (every row of the flat and centered views)
+	LensRowHeader                   // A workstream or group header
+	LensRowSubHeader                // A sub-group header in grouped view
+)
+
+// CursorRow returns the kind of row under the cursor. Workstream and grouped
+// views share one model: enter toggles headers and opens issues, l/→ expands
+// or steps in, h/← collapses or steps out.
+func (m *LensDashboardModel) CursorRow() LensRow {
+	switch {
+	case m.viewType == ViewTypeGrouped && len(m.groupedSections) > 0:
+		if m.groupedIssueCursor >= 0 {
+			return LensRowIssue
+		}
+		if m.groupedSubCursor >= 0 {
+			return LensRowSubHeader
+		}
+		return LensRowHeader
+	case m.viewType == ViewTypeWorkstream && len(m.workstreams) > 1:
+		if m.wsIssueCursor >= 0 {
+			return LensRowIssue
+		}
+		return LensRowHeader
+	}
+	return LensRowIssue
+}
+
+// groupedSubAt returns the sub-group under the grouped cursor, or nil
+func (m *LensDashboardModel) groupedSubAt() *analysis.Workstream {
+	if m.groupedCursor < 0 || m.groupedCursor >= len(m.groupedSections) {
+		return nil
+	}
+	subs := m.groupedSections[m.groupedCursor].SubWorkstreams
+	if m.groupedSubCursor < 0 || m.groupedSubCursor >= len(subs) {
+		return nil
+	}
+	return subs[m.groupedSubCursor]
+}
+
+// CursorRowName returns the name of the header under the cursor, or of the
+// header holding the issue under it
+func (m *LensDashboardModel) CursorRowName() string {
+	switch {
+	case m.viewType == ViewTypeGrouped && len(m.groupedSections) > 0:
+		if sub := m.groupedSubAt(); sub != nil {
+			return sub.Name
+		}
+		return m.CurrentGroupName()
+	case m.viewType == ViewTypeWorkstream && len(m.workstreams) > 1:
+		return m.CurrentWorkstreamName()
+	}
+	return ""
+}
+
+// IsCursorRowExpanded reports whether the header under the cursor is expanded
+func (m *LensDashboardModel) IsCursorRowExpanded() bool {
+	switch m.CursorRow() {
+	case LensRowHeader:
+		if m.viewType == ViewTypeGrouped {
+			return m.groupedExpanded[m.groupedCursor]
+		}
+		return m.wsExpanded[m.wsCursor]
+	case LensRowSubHeader:
+		return m.groupedSubExpanded[m.groupedCursor] != nil && m.groupedSubExpanded[m.groupedCursor][m.groupedSubCursor]
+	}
+	return false
+}
+
+// ToggleRowExpand expands or collapses the header under the cursor. Issue
+// rows are left alone; they open the detail instead.
+func (m *LensDashboardModel) ToggleRowExpand() {
+	if m.CursorRow() == LensRowIssue {
+		return
+	}
+	if m.viewType == ViewTypeGrouped {
+		m.ToggleGroupedExpand()
+	} else {
+		m.ToggleWorkstreamExpand()
+	}
+	m.syncRowCursor()
+}
+
+// ExpandRow expands the header under the cursor, or steps onto its first row
+// when it is already expanded. It reports whether the cursor row changed
+// state; issue rows have nothing to expand.
+func (m *LensDashboardModel) ExpandRow() bool {
+	row := m.CursorRow()
+	if row == LensRowIssue {
+		return false
+	}
+	if !m.IsCursorRowExpanded() {
+		m.ToggleRowExpand()
+		return true
+	}
+
+	switch {
+	case row == LensRowHeader && m.viewType == ViewTypeWorkstream:
+		start, end := m.wsPageRange(m.wsCursor)
+		if start == end {
+			return false
+		}
+		m.wsIssueCursor = start
+	case row == LensRowHeader && len(m.groupedSections[m.groupedCursor].SubWorkstreams) > 0:
+		m.groupedSubCursor = 0
+	default:
+		if len(m.groupedRows(m.groupedCursor, m.groupedSubCursor)) == 0 {
+			return false
+		}
+		m.groupedIssueCursor = 0
+	}
+	m.syncRowCursor()
+	return true
+}
+
+// CollapseRow steps from an issue to its header, collapses an expanded
+// header, and steps from a collapsed sub-group to its group. It reports
+// whether anything changed.
+func (m *LensDashboardModel) CollapseRow() bool {
+	switch {
+	case m.viewType == ViewTypeGrouped && len(m.groupedSections) > 0:
+		switch {
+		case m.groupedIssueCursor >= 0:
+			m.groupedIssueCursor = -1
+		case m.IsCursorRowExpanded():
+			m.ToggleGroupedExpand()
+		case m.groupedSubCursor >= 0:
+			m.groupedSubCursor = -1
+		default:
+			return false
+		}
+	case m.viewType == ViewTypeWorkstream && len(m.workstreams) > 1:
+		switch {
+		case m.wsIssueCursor >= 0:
+			m.wsIssueCursor = -1
+		case m.wsExpanded[m.wsCursor]:
+			m.ToggleWorkstreamExpand()
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+	m.syncRowCursor()
+	return true
+}
+
+// syncRowCursor refreshes the selection, scroll and detail panel after the
+// cursor or expansion state changed outside MoveUp/MoveDown
+func (m *LensDashboardModel) syncRowCursor() {
+	if m.viewType == ViewTypeGrouped {
+		m.updateSelectedIssueFromGrouped()
+		m.ensureGroupedVisible()
+	} else {
+		m.updateSelectedIssueFromWS()
+	}
+	m.updateDetailContent()
+}
@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+)
+
+// ══════════════════════════════════════════════════════════════════════════════
+// WORKSTREAM OVERRIDES - Moving issues between streams with X and p
+// ══════════════════════════════════════════════════════════════════════════════
+
+// workstreamOverride is a finished move for the owner to save: the issue's
+// stream under Key in the config's workstream_overrides ("" unpins it)
+type workstreamOverride struct {
+	Key  string
+	WSID string
+}
+
+// SetWorkstreamOverrides sets the issues pinned to workstreams, keyed by
+// config.WorkstreamOverrideKey, and regroups the streams if any belong to
+// this lens
+func (m *LensDashboardModel) SetWorkstreamOverrides(overrides map[string]string) {
+	m.workstreamOverrides = overrides
+	if len(m.workstreamMoves()) > 0 {
+		m.recomputeWorkstreams()
+	}
+}
+
+// lensConfigKey names this lens in the config's workstream maps: its label,
+// or its epic or issue ID
+func (m *LensDashboardModel) lensConfigKey() string {
+	if m.viewMode != "label" {
+		return m.epicID
+	}
+	return m.labelName
+}
+
+// workstreamMoves returns this lens's pinned issues: issue ID -> stream ID
+func (m *LensDashboardModel) workstreamMoves() map[string]string {
+	prefix := config.WorkstreamOverrideKey(m.lensConfigKey(), "")
+	moves := make(map[string]string)
+	for key, wsID := range m.workstreamOverrides {
+		if id, ok := strings.CutPrefix(key, prefix); ok && wsID != "" {
+			moves[id] = wsID
+		}
+	}
+	return moves
+}
+
+// applyWorkstreamOverrides moves pinned issues into their streams, noting
+// where detection put each issue first
+func (m *LensDashboardModel) applyWorkstreamOverrides(ws []analysis.Workstream, primaryIDs map[string]bool) []analysis.Workstream {
+	m.detectedWSOf = make(map[string]string)
+	for _, stream := range ws {
+		for _, issue := range stream.Issues {
+			m.detectedWSOf[issue.ID] = stream.ID
+		}
+	}
+	ws, m.pinnedWSIssues = analysis.ApplyWorkstreamOverrides(ws, m.workstreamMoves(), primaryIDs)
+	return ws
+}
+
+// CutWorkstreamIssue picks up the issue under the cursor in the workstream
+// view, to be moved with PasteWorkstreamIssue; it returns the status line
+func (m *LensDashboardModel) CutWorkstreamIssue() string {
+	if !m.IsWorkstreamView() || len(m.workstreams) < 2 {
+		return "Switch to a workstream view with two or more streams (w) to move issues"
+	}
+	if m.wsIssueCursor < 0 || m.selectedIssueID == "" {
+		return "Move the cursor onto an issue to cut it"
+	}
+	m.cutIssueID = m.selectedIssueID
+	return fmt.Sprintf("Cut %s • p on another workstream moves it there, esc cancels", m.theme.ID(m.cutIssueID))
+}
+
+// CutIssueID returns the issue cut with X, or "" when none is
+func (m *LensDashboardModel) CutIssueID() string {
+	return m.cutIssueID
+}
+
+// CancelCut drops the issue cut with X
+func (m *LensDashboardModel) CancelCut() {
+	m.cutIssueID = ""
+}
+
+// PasteWorkstreamIssue moves the cut issue into the stream under the cursor
+// and pins it there. Moving it back to the stream detection chose unpins it.
+// It returns the change to save, or nil, and the status line.
+func (m *LensDashboardModel) PasteWorkstreamIssue() (*workstreamOverride, string) {
+	id := m.cutIssueID
+	if id == "" {
+		return nil, "Nothing cut: X on an issue first"
+	}
+	if m.wsCursor >= len(m.workstreams) {
+		return nil, ""
+	}
+	target := m.workstreams[m.wsCursor]
+	if current := m.WorkstreamOf(id); current != nil && current.ID == target.ID {
+		return nil, fmt.Sprintf("%s is already in %s", m.theme.ID(id), target.Name)
+	}
+
+	m.cutIssueID = ""
+	change := &workstreamOverride{Key: config.WorkstreamOverrideKey(m.lensConfigKey(), id), WSID: target.ID}
+	if m.detectedWSOf[id] == target.ID {
+		change.WSID = ""
+	}
+	if m.workstreamOverrides == nil {
+		m.workstreamOverrides = make(map[string]string)
+	}
+	if change.WSID == "" {
+		delete(m.workstreamOverrides, change.Key)
+	} else {
+		m.workstreamOverrides[change.Key] = change.WSID
+	}
+	m.recomputeWorkstreams()
+	m.SelectIssue(id)
+
+	if change.WSID == "" {
+		return change, fmt.Sprintf("Moved %s back to %s, unpinned", m.theme.ID(id), target.Name)
+	}
+	return change, fmt.Sprintf("Moved %s to %s, pinned", m.theme.ID(id), target.Name)
+}
+
+// workstreamIssueBadge marks issues pinned to their stream and the one cut
+func (m *LensDashboardModel) workstreamIssueBadge(id string) string {
+	style := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
+	switch {
+	case id == m.cutIssueID:
+		return style.Render(m.theme.Glyph(" ✂"))
+	case m.pinnedWSIssues[id]:
+		return style.Render(m.theme.Glyph(" 📌"))
+	}
+	return ""
+}
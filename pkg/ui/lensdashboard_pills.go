@@ -0,0 +1,135 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ══════════════════════════════════════════════════════════════════════════════
+// FILTER PILLS - the active filter stack shown in the header (x cycles, enter removes)
+// ══════════════════════════════════════════════════════════════════════════════
+
+// Kinds of filter pill
+const (
+	pillStatus      = "status"      // The status quick filter
+	pillType        = "type"        // The type filter
+	pillScope       = "scope"       // A scope label
+	pillSearch      = "search"      // A search query kept as a filter
+	pillArchaeology = "archaeology" // Closed blockers kept in the tree
+	pillStalled     = "stalled"     // Only stalled workstreams
+)
+
+// filterPill is one dismissible entry in the active filter stack
+type filterPill struct {
+	Kind  string
+	Value string // Status, type, scope label or search query
+	Text  string
+}
+
+// FilterPills returns the active filters in header order
+func (m *LensDashboardModel) FilterPills() []filterPill {
+	var pills []filterPill
+	if m.statusFilter != "" {
+		pills = append(pills, filterPill{Kind: pillStatus, Value: string(m.statusFilter), Text: "status:" + string(m.statusFilter)})
+	}
+	if m.typeFilter != "" {
+		pills = append(pills, filterPill{Kind: pillType, Value: string(m.typeFilter), Text: "type:" + string(m.typeFilter)})
+	}
+	for _, label := range m.scopeLabels {
+		pills = append(pills, filterPill{Kind: pillScope, Value: label, Text: "#" + label})
+	}
+	if m.searchFilter != "" {
+		pills = append(pills, filterPill{Kind: pillSearch, Value: m.searchFilter, Text: "/" + m.searchFilter})
+	}
+	if m.archaeologyMode {
+		pills = append(pills, filterPill{Kind: pillArchaeology, Text: m.theme.Glyph("⛏ closed")})
+	}
+	if m.stalledOnly && m.IsWorkstreamView() {
+		pills = append(pills, filterPill{Kind: pillStalled, Text: m.theme.Glyph("⏸ stalled")})
+	}
+	return pills
+}
+
+// CyclePillFocus moves the highlight to the next pill, wrapping back to none
+// after the last. It returns the focused pill, or false when none is focused.
+func (m *LensDashboardModel) CyclePillFocus() (filterPill, bool) {
+	pills := m.FilterPills()
+	if m.pillFocus >= len(pills) {
+		m.pillFocus = 0
+		return filterPill{}, false
+	}
+	m.pillFocus++
+	return pills[m.pillFocus-1], true
+}
+
+// HasPillFocus reports whether a pill is highlighted (enter removes it)
+func (m *LensDashboardModel) HasPillFocus() bool {
+	return m.pillFocus > 0 && m.pillFocus <= len(m.FilterPills())
+}
+
+// ClearPillFocus drops the pill highlight
+func (m *LensDashboardModel) ClearPillFocus() {
+	m.pillFocus = 0
+}
+
+// RemoveFocusedPill clears the highlighted filter. Focus stays on the pill
+// that slides into its place, or drops when none is left.
+func (m *LensDashboardModel) RemoveFocusedPill() (filterPill, bool) {
+	if !m.HasPillFocus() {
+		return filterPill{}, false
+	}
+	pill := m.FilterPills()[m.pillFocus-1]
+	switch pill.Kind {
+	case pillStatus:
+		m.SetStatusFilter("")
+	case pillType:
+		m.SetTypeFilter("")
+	case pillScope:
+		m.RemoveScopeLabel(pill.Value)
+	case pillSearch:
+		m.SetSearchFilter("")
+	case pillArchaeology:
+		m.SetArchaeologyMode(false)
+	case pillStalled:
+		m.SetStalledOnly(false)
+	}
+	if m.pillFocus > len(m.FilterPills()) {
+		m.pillFocus = 0
+	}
+	return pill, true
+}
+
+// renderFilterPills renders the filter stack as one header line, or "" when
+// no filter is active
+func (m *LensDashboardModel) renderFilterPills(contentWidth int) string {
+	pills := m.FilterPills()
+	if len(pills) == 0 {
+		return ""
+	}
+	t := m.theme
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	pillStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Background(t.Highlight).Padding(0, 1)
+	focusStyle := t.Renderer.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#282A36"}).Background(t.Primary).Bold(true).Padding(0, 1)
+	modeStyle := t.Renderer.NewStyle().Foreground(t.InProgress)
+	hintStyle := t.Renderer.NewStyle().Faint(true)
+
+	parts := []string{labelStyle.Render("Filters:")}
+	for i, pill := range pills {
+		if i+1 == m.pillFocus {
+			parts = append(parts, focusStyle.Render(pill.Text+m.theme.Glyph(" ✕")))
+		} else {
+			parts = append(parts, pillStyle.Render(pill.Text))
+		}
+		// The ANY/ALL mode reads after the last scope label
+		if pill.Kind == pillScope && (i+1 == len(pills) || pills[i+1].Kind != pillScope) {
+			parts = append(parts, modeStyle.Render(m.theme.Glyph(m.scopeMode.ShortString())))
+		}
+	}
+	if m.HasPillFocus() {
+		parts = append(parts, hintStyle.Render(m.theme.Glyph("enter remove · x next")))
+	} else {
+		parts = append(parts, hintStyle.Render("x select"))
+	}
+	return t.Renderer.NewStyle().MaxWidth(contentWidth).Render(strings.Join(parts, " "))
+}
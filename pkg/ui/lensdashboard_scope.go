@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -87,10 +88,20 @@ func (m *LensDashboardModel) SetScopeMode(mode ScopeMode) {
 	}
 }
 
+// RefreshIssues rebuilds the graphs and tree after issues changed in place,
+// such as a status set from the dashboard, keeping the cursor on its issue
+func (m *LensDashboardModel) RefreshIssues() {
+	id := m.SelectedIssueID()
+	m.rebuildWithScope()
+	if id != "" {
+		m.SelectIssue(id)
+	}
+}
+
 // rebuildWithScope rebuilds primaryIDs based on scope and rebuilds tree
 func (m *LensDashboardModel) rebuildWithScope() {
-	// If no scope, reset to original behavior
-	if len(m.scopeLabels) == 0 {
+	// If no filter, reset to original behavior
+	if !m.isFiltered() {
 		// Reset primaryIDs based on view mode
 		if m.viewMode == "label" {
 			// Rebuild directPrimaryIDs from labelName
@@ -123,18 +134,23 @@ func (m *LensDashboardModel) rebuildWithScope() {
 	m.recomputeWorkstreams()
 }
 
-// applyScopeFilter filters primaryIDs to only include issues matching scope criteria
+// applyScopeFilter filters primaryIDs to only include issues matching the
+// scope labels and quick filters
 func (m *LensDashboardModel) applyScopeFilter() {
-	if len(m.scopeLabels) == 0 {
+	if !m.isFiltered() {
 		return
 	}
 
 	// Build set of issues that match scope criteria
 	scopeMatchingIDs := make(map[string]bool)
+	quickMatchingIDs := make(map[string]bool)
 
 	for _, issue := range m.allIssues {
-		matches := m.issueMatchesScope(issue)
-		if matches {
+		if !m.issueMatchesQuickFilters(issue) {
+			continue
+		}
+		quickMatchingIDs[issue.ID] = true
+		if m.issueMatchesScope(issue) {
 			scopeMatchingIDs[issue.ID] = true
 		}
 	}
@@ -159,7 +175,13 @@ func (m *LensDashboardModel) applyScopeFilter() {
 				m.directPrimaryIDs[id] = true
 			}
 		}
-		m.primaryIDs = expandToDescendants(m.directPrimaryIDs, m.allIssues)
+		// Descendants inherit the scope but not the quick filters
+		m.primaryIDs = make(map[string]bool)
+		for id := range expandToDescendants(m.directPrimaryIDs, m.allIssues) {
+			if quickMatchingIDs[id] {
+				m.primaryIDs[id] = true
+			}
+		}
 	} else {
 		// For epic/bead modes, filter from the ORIGINAL set (not the already-filtered m.primaryIDs)
 		// Use epicDescendantsByDepth[DepthAll] as the source of truth
@@ -371,6 +393,83 @@ func (m *LensDashboardModel) HandleScopeInputKey(key string) (handled bool, stat
 	return false, ""
 }
 
+// ══════════════════════════════════════════════════════════════════════════════
+// QUICK FILTERS - status (f), type (F) and a search query kept with tab
+// ══════════════════════════════════════════════════════════════════════════════
+
+// lensStatusFilters is the order f steps through before every status again
+var lensStatusFilters = []model.Status{model.StatusOpen, model.StatusInProgress, model.StatusBlocked, model.StatusClosed}
+
+// isFiltered returns true if scope labels or a quick filter narrow the lens
+func (m *LensDashboardModel) isFiltered() bool {
+	return len(m.scopeLabels) > 0 || m.statusFilter != "" || m.typeFilter != "" || m.searchFilter != ""
+}
+
+// issueMatchesQuickFilters checks an issue against the status (custom
+// statuses by their column), type and search filters
+func (m *LensDashboardModel) issueMatchesQuickFilters(issue model.Issue) bool {
+	switch {
+	case m.statusFilter != "" && issue.Status.Column() != m.statusFilter:
+		return false
+	case m.typeFilter != "" && issue.IssueType != m.typeFilter:
+		return false
+	case m.searchFilter != "" && len(fuzzy.Find(m.searchFilter, []string{issue.ID + " " + issue.Title})) == 0:
+		return false
+	}
+	return true
+}
+
+// CycleStatusFilter steps the status filter through open, in progress,
+// blocked and closed, then back to every status, and returns the new one
+func (m *LensDashboardModel) CycleStatusFilter() model.Status {
+	next := lensStatusFilters[0]
+	if i := slices.Index(lensStatusFilters, m.statusFilter); i == len(lensStatusFilters)-1 {
+		next = ""
+	} else if i >= 0 {
+		next = lensStatusFilters[i+1]
+	}
+	m.SetStatusFilter(next)
+	return next
+}
+
+// SetStatusFilter shows only issues with status ("" shows every status)
+func (m *LensDashboardModel) SetStatusFilter(status model.Status) {
+	m.statusFilter = status
+	m.RefreshIssues()
+}
+
+// CycleTypeFilter steps the type filter through the issue types in use,
+// alphabetically, then back to every type, and returns the new one
+func (m *LensDashboardModel) CycleTypeFilter() model.IssueType {
+	var types []model.IssueType
+	for _, issue := range m.allIssues {
+		if issue.IssueType != "" && !slices.Contains(types, issue.IssueType) {
+			types = append(types, issue.IssueType)
+		}
+	}
+	slices.Sort(types)
+
+	var next model.IssueType
+	if i := slices.Index(types, m.typeFilter); i+1 < len(types) {
+		next = types[i+1]
+	}
+	m.SetTypeFilter(next)
+	return next
+}
+
+// SetTypeFilter shows only issues of type issueType ("" shows every type)
+func (m *LensDashboardModel) SetTypeFilter(issueType model.IssueType) {
+	m.typeFilter = issueType
+	m.RefreshIssues()
+}
+
+// SetSearchFilter shows only issues whose ID and title fuzzy-match query
+// ("" shows every issue)
+func (m *LensDashboardModel) SetSearchFilter(query string) {
+	m.searchFilter = query
+	m.RefreshIssues()
+}
+
 // ══════════════════════════════════════════════════════════════════════════════
 // FUZZY SEARCH - Quick navigation via "/" keybinding
 // Filters the main list in-place and updates detail panel as you navigate
@@ -502,6 +601,16 @@ func (m *LensDashboardModel) HandleFuzzySearchKey(key string) (handled bool, sta
 		m.CloseFuzzySearch()
 		return true, "No matches"
 
+	case "tab":
+		// Keep the query as a filter pill
+		query := strings.TrimSpace(m.fuzzyInput)
+		m.CloseFuzzySearch()
+		if query == "" {
+			return true, "Search cancelled"
+		}
+		m.SetSearchFilter(query)
+		return true, fmt.Sprintf("Filtering by /%s (x, enter removes it)", query)
+
 	case "up", "k", "ctrl+p":
 		m.MoveUp()
 		m.updateDetailContent()
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ══════════════════════════════════════════════════════════════════════════════
+// STALLED WORKSTREAMS - streams with no update or closure in stale_days (W)
+// ══════════════════════════════════════════════════════════════════════════════
+
+// workstreamStall grades a stream by its days without progress
+func (m *LensDashboardModel) workstreamStall(ws analysis.Workstream) analysis.WorkstreamStall {
+	return analysis.ComputeWorkstreamStall(ws, time.Now(), m.staleDays)
+}
+
+// filterStalledWorkstreams keeps only the stalled streams while the filter is on
+func (m *LensDashboardModel) filterStalledWorkstreams(ws []analysis.Workstream) []analysis.Workstream {
+	if !m.stalledOnly {
+		return ws
+	}
+	stalled := ws[:0:0]
+	for _, stream := range ws {
+		if m.workstreamStall(stream).Stalled() {
+			stalled = append(stalled, stream)
+		}
+	}
+	return stalled
+}
+
+// IsStalledOnly reports whether only stalled workstreams are shown
+func (m *LensDashboardModel) IsStalledOnly() bool {
+	return m.stalledOnly
+}
+
+// SetStalledOnly shows only stalled workstreams, or all of them again
+func (m *LensDashboardModel) SetStalledOnly(on bool) {
+	if m.stalledOnly == on {
+		return
+	}
+	m.stalledOnly = on
+	m.recomputeWorkstreams()
+	m.wsCursor, m.wsIssueCursor, m.wsScroll = 0, -1, 0
+	m.updateSelectedIssueFromWS()
+}
+
+// ToggleStalledOnly switches the stalled filter in the workstream view and
+// returns the status line. It isn't turned on when no stream has stalled.
+func (m *LensDashboardModel) ToggleStalledOnly() string {
+	if !m.IsWorkstreamView() {
+		return "Switch to the workstream view (w) to filter stalled streams"
+	}
+	if m.stalledOnly {
+		m.SetStalledOnly(false)
+		return "Showing all workstreams"
+	}
+	stalled := 0
+	for _, ws := range m.workstreams {
+		if m.workstreamStall(ws).Stalled() {
+			stalled++
+		}
+	}
+	days := m.staleDays
+	if days <= 0 {
+		days = analysis.DefaultStaleThresholdDays
+	}
+	if stalled == 0 {
+		return fmt.Sprintf("No workstream has gone %d days without an update or closure", days)
+	}
+	m.SetStalledOnly(true)
+	return fmt.Sprintf("Showing %d stalled workstream(s), idle %d+ days • W shows all", stalled, days)
+}
+
+// renderStallBadge marks a stalled stream with its idle days, e.g. " ⏸ 23d":
+// dimmed past the stale threshold, in the warning color past twice it
+func (m *LensDashboardModel) renderStallBadge(ws analysis.Workstream) string {
+	stall := m.workstreamStall(ws)
+	if !stall.Stalled() {
+		return ""
+	}
+	var color lipgloss.TerminalColor = m.theme.Subtext
+	if stall.Level == analysis.Stale {
+		color = ColorWarning
+	}
+	return " " + m.theme.Renderer.NewStyle().Foreground(color).Render(fmt.Sprintf(m.theme.Glyph("⏸ %dd"), stall.IdleDays))
+}
@@ -1,9 +1,12 @@
 package ui
 
 import (
+	"cmp"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
@@ -33,7 +36,7 @@ func (m *LensDashboardModel) computeBlockingTopoRanks(issueIDs map[string]bool)
 		for _, blockerID := range m.upstream[id] {
 			// Only count blockers that are in our set and open
 			if issueIDs[blockerID] {
-				if blocker, ok := m.issueMap[blockerID]; ok && blocker.Status != model.StatusClosed {
+				if blocker, ok := m.issueMap[blockerID]; ok && m.blockerGates(blocker) {
 					inDegree[id]++
 				}
 			}
@@ -84,6 +87,90 @@ func (m *LensDashboardModel) computeBlockingTopoRanks(issueIDs map[string]bool)
 	return ranks
 }
 
+// issueLess orders issues by status section, then by the section sort: the
+// chosen key first (in its direction), then topological rank (blockers
+// first), priority and hierarchical ID.
+func (m *LensDashboardModel) issueLess(a, b model.Issue) bool {
+	if sa, sb := m.getStatusOrder(a), m.getStatusOrder(b); sa != sb {
+		return sa < sb
+	}
+
+	if c := m.sectionCompare(a, b); c != 0 {
+		if m.sectionDesc {
+			return c > 0
+		}
+		return c < 0
+	}
+
+	// Within same status, use topological rank (blockers first)
+	if ra, rb := m.topoRanks[a.ID], m.topoRanks[b.ID]; ra != rb {
+		return ra < rb
+	}
+	if a.Priority != b.Priority {
+		return a.Priority < b.Priority
+	}
+	return CompareHierarchicalIDs(a.ID, b.ID) < 0
+}
+
+// sectionCompare compares a and b ascending by the section sort key (-1, 0
+// or 1). Blockers order is the fallback every key ends with, so it compares
+// as equal here unless descending reverses it.
+func (m *LensDashboardModel) sectionCompare(a, b model.Issue) int {
+	switch m.sectionSort {
+	case SectionSortTopo:
+		if m.sectionDesc {
+			return cmp.Compare(m.topoRanks[a.ID], m.topoRanks[b.ID])
+		}
+	case SectionSortPriority:
+		return cmp.Compare(a.Priority, b.Priority)
+	case SectionSortCreated:
+		return compareDates(a.CreatedAt, b.CreatedAt, m.sectionDesc)
+	case SectionSortUpdated:
+		return compareDates(a.UpdatedAt, b.UpdatedAt, m.sectionDesc)
+	case SectionSortID:
+		return CompareHierarchicalIDs(a.ID, b.ID)
+	case SectionSortImpact:
+		return cmp.Compare(m.impactOf(a.ID), m.impactOf(b.ID))
+	case SectionSortPageRank:
+		if m.graphStats != nil {
+			return cmp.Compare(m.graphStats.GetPageRankScore(a.ID), m.graphStats.GetPageRankScore(b.ID))
+		}
+	}
+	return 0
+}
+
+// compareDates compares two dates ascending; a missing date sorts last in
+// either direction
+func compareDates(a, b time.Time, desc bool) int {
+	if a.IsZero() != b.IsZero() {
+		if a.IsZero() == desc {
+			return -1
+		}
+		return 1
+	}
+	return a.Compare(b)
+}
+
+// impactOf returns how many open issues the issue blocks transitively, or
+// directly when the lens was given no counts
+func (m *LensDashboardModel) impactOf(id string) int {
+	if m.impact != nil {
+		return m.impact[id]
+	}
+	return m.dependentCount(id)
+}
+
+// dependentCount returns how many issues the given issue directly blocks
+func (m *LensDashboardModel) dependentCount(id string) int {
+	n := 0
+	for _, d := range m.downstream[id] {
+		if m.edgeTypes[id+":"+d] == EdgeBlocking {
+			n++
+		}
+	}
+	return n
+}
+
 // sortByStatusThenTopoThenPriority sorts issues by: status order, then topological rank, then priority, then hierarchical ID.
 // This ensures blockers appear before blocked issues within the same status level.
 func (m *LensDashboardModel) sortByStatusThenTopoThenPriority(issues []model.Issue, topoRanks map[string]int) {
@@ -127,7 +214,7 @@ func (m *LensDashboardModel) findContextBlockers(primaryIDs map[string]bool) map
 			if !primaryIDs[blockerID] {
 				// This is a context blocker
 				if blocker, ok := m.issueMap[blockerID]; ok {
-					if blocker.Status != model.StatusClosed {
+					if m.blockerGates(blocker) {
 						contextBlockers[blockerID] = true
 					}
 				}
@@ -148,7 +235,7 @@ func (m *LensDashboardModel) findContextBlockers(primaryIDs map[string]bool) map
 		for _, blockerID := range m.upstream[current] {
 			if !primaryIDs[blockerID] && !contextBlockers[blockerID] {
 				if blocker, ok := m.issueMap[blockerID]; ok {
-					if blocker.Status != model.StatusClosed {
+					if m.blockerGates(blocker) {
 						contextBlockers[blockerID] = true
 						toVisit = append(toVisit, blockerID)
 					}
@@ -456,17 +543,9 @@ func buildBeadDescendantsByDepth(beadID string, issues []model.Issue) map[DepthO
 func (m *LensDashboardModel) buildGraphs() {
 	m.downstream = make(map[string][]string)
 	m.upstream = make(map[string][]string)
-	m.blockedByMap = make(map[string][]string)
+	m.blockedByMap = analysis.BlockedByMap(m.allIssues)
 	m.edgeTypes = make(map[string]EdgeType)
 
-	// Build set of open issues
-	openIssues := make(map[string]bool)
-	for _, issue := range m.allIssues {
-		if issue.Status != model.StatusClosed {
-			openIssues[issue.ID] = true
-		}
-	}
-
 	// Build graphs from dependencies
 	for _, issue := range m.allIssues {
 		for _, dep := range issue.Dependencies {
@@ -479,11 +558,6 @@ func (m *LensDashboardModel) buildGraphs() {
 				m.upstream[issue.ID] = append(m.upstream[issue.ID], dep.DependsOnID)
 				m.edgeTypes[dep.DependsOnID+":"+issue.ID] = EdgeBlocking
 
-				// Track all open blockers
-				if openIssues[dep.DependsOnID] {
-					m.blockedByMap[issue.ID] = append(m.blockedByMap[issue.ID], dep.DependsOnID)
-				}
-
 			case model.DepParentChild:
 				// issue is a child of dep.DependsOnID (parent -> child relationship)
 				// So: dep.DependsOnID -> issue (downstream/children)
@@ -497,6 +571,18 @@ func (m *LensDashboardModel) buildGraphs() {
 	}
 }
 
+// lensForecast forecasts the lens's primary issues at the current depth
+func (m *LensDashboardModel) lensForecast() analysis.CompletionForecast {
+	primaryIDs := m.GetPrimaryIDsForDepth()
+	var issues []model.Issue
+	for _, issue := range m.allIssues {
+		if primaryIDs[issue.ID] {
+			issues = append(issues, issue)
+		}
+	}
+	return analysis.ForecastCompletion(m.allIssues, issues, time.Now())
+}
+
 // buildTree builds the tree structure based on current depth
 func (m *LensDashboardModel) buildTree() {
 	m.roots = nil
@@ -509,6 +595,7 @@ func (m *LensDashboardModel) buildTree() {
 	m.readyCount = 0
 	m.blockedCount = 0
 	m.closedCount = 0
+	m.forecast = m.lensForecast()
 
 	// For epic/bead modes, use ego-centered tree building
 	if (m.viewMode == "epic" || m.viewMode == "bead") && m.epicID != "" {
@@ -547,14 +634,16 @@ func (m *LensDashboardModel) buildTree() {
 		// Roots are primary issues with no open blockers within the visible set
 		// (considering both primary AND context blockers that will be shown)
 
-		// First, identify context blockers that block primary issues
-		contextBlockers := m.findContextBlockers(depthPrimaryIDs)
+		// First, identify context blockers that block primary issues.
+		// A filter hides context blockers, so they don't count as visible then.
 		visibleIssues := make(map[string]bool)
 		for id := range depthPrimaryIDs {
 			visibleIssues[id] = true
 		}
-		for id := range contextBlockers {
-			visibleIssues[id] = true
+		if !m.isFiltered() {
+			for id := range m.findContextBlockers(depthPrimaryIDs) {
+				visibleIssues[id] = true
+			}
 		}
 
 		for _, issue := range m.allIssues {
@@ -566,7 +655,7 @@ func (m *LensDashboardModel) buildTree() {
 			isBlockedByVisible := false
 			for _, blockerID := range m.upstream[issue.ID] {
 				if blocker, ok := m.issueMap[blockerID]; ok {
-					if blocker.Status != model.StatusClosed && visibleIssues[blockerID] {
+					if m.blockerGates(blocker) && visibleIssues[blockerID] {
 						isBlockedByVisible = true
 						break
 					}
@@ -588,7 +677,7 @@ func (m *LensDashboardModel) buildTree() {
 		}
 	}
 
-	// Sort roots: entry point first (when in epic or bead mode), then by status, topo rank, priority, hierarchical ID
+	// Sort roots: entry point first (when in epic or bead mode), then by status and section sort order
 	sort.Slice(rootIssues, func(i, j int) bool {
 		// Entry point (epic or bead) always comes first
 		if (m.viewMode == "epic" || m.viewMode == "bead") && m.epicID != "" {
@@ -599,24 +688,7 @@ func (m *LensDashboardModel) buildTree() {
 				return false
 			}
 		}
-		// Sort by status, then topological rank, then priority, then hierarchical ID
-		si := m.getStatusOrder(rootIssues[i])
-		sj := m.getStatusOrder(rootIssues[j])
-		if si != sj {
-			return si < sj
-		}
-		// Within same status, use topological rank (blockers first)
-		ri := m.topoRanks[rootIssues[i].ID]
-		rj := m.topoRanks[rootIssues[j].ID]
-		if ri != rj {
-			return ri < rj
-		}
-		// Within same topo rank, sort by priority
-		if rootIssues[i].Priority != rootIssues[j].Priority {
-			return rootIssues[i].Priority < rootIssues[j].Priority
-		}
-		// Within same priority, sort by hierarchical ID (ascending)
-		return CompareHierarchicalIDs(rootIssues[i].ID, rootIssues[j].ID) < 0
+		return m.issueLess(rootIssues[i], rootIssues[j])
 	})
 
 	// Build tree from each root
@@ -696,7 +768,7 @@ func (m *LensDashboardModel) buildTreeNode(issue model.Issue, depth, maxDepth in
 	}
 
 	status := m.getIssueStatus(issue)
-	switch status {
+	switch lensStatusKind(status) {
 	case "ready":
 		m.readyCount++
 	case "blocked":
@@ -712,7 +784,7 @@ func (m *LensDashboardModel) buildTreeNode(issue model.Issue, depth, maxDepth in
 			if child, ok := m.issueMap[childID]; ok {
 				if !seen[childID] {
 					// When scope is active, only include scope-matching issues
-					if m.HasScope() && !depthPrimaryIDs[childID] {
+					if m.isFiltered() && !depthPrimaryIDs[childID] {
 						continue
 					}
 					childIssues = append(childIssues, *child)
@@ -720,25 +792,9 @@ func (m *LensDashboardModel) buildTreeNode(issue model.Issue, depth, maxDepth in
 			}
 		}
 
-		// Sort children by status, then topological rank, then priority, then hierarchical ID
+		// Sort children by status, then by the section sort order
 		sort.Slice(childIssues, func(i, j int) bool {
-			si := m.getStatusOrder(childIssues[i])
-			sj := m.getStatusOrder(childIssues[j])
-			if si != sj {
-				return si < sj
-			}
-			// Within same status, use topological rank (blockers first)
-			ri := m.topoRanks[childIssues[i].ID]
-			rj := m.topoRanks[childIssues[j].ID]
-			if ri != rj {
-				return ri < rj
-			}
-			// Within same topo rank, sort by priority
-			if childIssues[i].Priority != childIssues[j].Priority {
-				return childIssues[i].Priority < childIssues[j].Priority
-			}
-			// Within same priority, sort by hierarchical ID (ascending)
-			return CompareHierarchicalIDs(childIssues[i].ID, childIssues[j].ID) < 0
+			return m.issueLess(childIssues[i], childIssues[j])
 		})
 
 		newParentPath := append(parentPath, isLast)
@@ -760,7 +816,7 @@ func (m *LensDashboardModel) buildTreeNode(issue model.Issue, depth, maxDepth in
 // When scope is active, skip adding context blockers to keep view focused on scope.
 func (m *LensDashboardModel) addUpstreamContextBlockers(seen map[string]bool, maxDepth int) {
 	// When scope is active, don't add context blockers - keep view focused on scope-matching issues
-	if m.HasScope() {
+	if m.isFiltered() {
 		return
 	}
 
@@ -821,25 +877,9 @@ func (m *LensDashboardModel) addUpstreamContextBlockers(seen map[string]bool, ma
 		return
 	}
 
-	// Sort by status, then topological rank, then priority, then hierarchical ID
+	// Sort by status, then by the section sort order
 	sort.Slice(contextBlockers, func(i, j int) bool {
-		si := m.getStatusOrder(contextBlockers[i])
-		sj := m.getStatusOrder(contextBlockers[j])
-		if si != sj {
-			return si < sj
-		}
-		// Within same status, use topological rank (blockers first)
-		ri := m.topoRanks[contextBlockers[i].ID]
-		rj := m.topoRanks[contextBlockers[j].ID]
-		if ri != rj {
-			return ri < rj
-		}
-		// Within same topo rank, sort by priority
-		if contextBlockers[i].Priority != contextBlockers[j].Priority {
-			return contextBlockers[i].Priority < contextBlockers[j].Priority
-		}
-		// Within same priority, sort by hierarchical ID (ascending)
-		return CompareHierarchicalIDs(contextBlockers[i].ID, contextBlockers[j].ID) < 0
+		return m.issueLess(contextBlockers[i], contextBlockers[j])
 	})
 
 	// Find context blockers that are "roots" (not blocked by other unseen context blockers)
@@ -929,7 +969,7 @@ func (m *LensDashboardModel) buildContextBlockerNode(issue model.Issue, depth, m
 	}
 
 	status := m.getIssueStatus(issue)
-	switch status {
+	switch lensStatusKind(status) {
 	case "ready":
 		m.readyCount++
 	case "blocked":
@@ -938,43 +978,34 @@ func (m *LensDashboardModel) buildContextBlockerNode(issue model.Issue, depth, m
 		m.closedCount++
 	}
 
-	// Add children (downstream issues within context blocker set) if within depth
+	// Add children (downstream issues within context blocker set) if within depth.
+	// Primaries blocked only by context issues are reached from no primary root,
+	// so they hang off their context blocker instead.
 	if depth < maxDepth-1 {
 		var childIssues []model.Issue
 		for _, childID := range m.downstream[issue.ID] {
 			if child, ok := m.issueMap[childID]; ok {
-				// Only include if it's a context blocker and not yet seen
-				if contextBlockerSet[childID] && !seen[childID] {
+				// Only include if it's a context blocker or primary and not yet seen
+				if (contextBlockerSet[childID] || depthPrimaryIDs[childID]) && !seen[childID] {
 					childIssues = append(childIssues, *child)
 				}
 			}
 		}
 
-		// Sort children by status, then topological rank, then priority, then hierarchical ID
+		// Sort children by status, then by the section sort order
 		sort.Slice(childIssues, func(i, j int) bool {
-			si := m.getStatusOrder(childIssues[i])
-			sj := m.getStatusOrder(childIssues[j])
-			if si != sj {
-				return si < sj
-			}
-			// Within same status, use topological rank (blockers first)
-			ri := m.topoRanks[childIssues[i].ID]
-			rj := m.topoRanks[childIssues[j].ID]
-			if ri != rj {
-				return ri < rj
-			}
-			// Within same topo rank, sort by priority
-			if childIssues[i].Priority != childIssues[j].Priority {
-				return childIssues[i].Priority < childIssues[j].Priority
-			}
-			// Within same priority, sort by hierarchical ID (ascending)
-			return CompareHierarchicalIDs(childIssues[i].ID, childIssues[j].ID) < 0
+			return m.issueLess(childIssues[i], childIssues[j])
 		})
 
 		newParentPath := append(parentPath, isLast)
 		for i, child := range childIssues {
 			childIsLast := i == len(childIssues)-1
-			childNode := m.buildContextBlockerNode(child, depth+1, maxDepth, seen, childIsLast, newParentPath, contextBlockerSet, issue.ID)
+			var childNode *LensTreeNode
+			if depthPrimaryIDs[child.ID] {
+				childNode = m.buildTreeNode(child, depth+1, maxDepth, seen, childIsLast, newParentPath, issue.ID)
+			} else {
+				childNode = m.buildContextBlockerNode(child, depth+1, maxDepth, seen, childIsLast, newParentPath, contextBlockerSet, issue.ID)
+			}
 			if childNode != nil {
 				node.Children = append(node.Children, childNode)
 			}
@@ -1103,11 +1134,11 @@ func (m *LensDashboardModel) buildEgoCenteredTree() {
 	seen[m.epicID] = true
 	m.totalCount++
 	m.primaryCount++
-	if m.egoNode.Status == "ready" {
+	if kind := lensStatusKind(m.egoNode.Status); kind == "ready" {
 		m.readyCount++
-	} else if m.egoNode.Status == "blocked" {
+	} else if kind == "blocked" {
 		m.blockedCount++
-	} else if m.egoNode.Status == "closed" {
+	} else if kind == "closed" {
 		m.closedCount++
 	}
 
@@ -1119,9 +1150,9 @@ func (m *LensDashboardModel) buildEgoCenteredTree() {
 	var blockerIssues []model.Issue
 	for _, blockerID := range blockerIDs {
 		if blocker, ok := m.issueMap[blockerID]; ok {
-			if blocker.Status != model.StatusClosed { // Only show open blockers
+			if m.blockerGates(blocker) { // Only show open blockers (closed too in archaeology mode)
 				// When scope is active, only include scope-matching blockers
-				if m.HasScope() && !depthPrimaryIDs[blockerID] {
+				if m.isFiltered() && !depthPrimaryIDs[blockerID] {
 					continue
 				}
 				blockerIssues = append(blockerIssues, *blocker)
@@ -1129,25 +1160,9 @@ func (m *LensDashboardModel) buildEgoCenteredTree() {
 		}
 	}
 
-	// Sort blockers by status, then topological rank, then priority, then hierarchical ID
+	// Sort blockers by status, then by the section sort order
 	sort.Slice(blockerIssues, func(i, j int) bool {
-		si := m.getStatusOrder(blockerIssues[i])
-		sj := m.getStatusOrder(blockerIssues[j])
-		if si != sj {
-			return si < sj
-		}
-		// Within same status, use topological rank (blockers first)
-		ri := m.topoRanks[blockerIssues[i].ID]
-		rj := m.topoRanks[blockerIssues[j].ID]
-		if ri != rj {
-			return ri < rj
-		}
-		// Within same topo rank, sort by priority
-		if blockerIssues[i].Priority != blockerIssues[j].Priority {
-			return blockerIssues[i].Priority < blockerIssues[j].Priority
-		}
-		// Within same priority, sort by hierarchical ID (ascending)
-		return CompareHierarchicalIDs(blockerIssues[i].ID, blockerIssues[j].ID) < 0
+		return m.issueLess(blockerIssues[i], blockerIssues[j])
 	})
 
 	for i, blocker := range blockerIssues {
@@ -1180,11 +1195,11 @@ func (m *LensDashboardModel) buildEgoCenteredTree() {
 		} else {
 			m.contextCount++
 		}
-		if fn.Status == "ready" {
+		if kind := lensStatusKind(fn.Status); kind == "ready" {
 			m.readyCount++
-		} else if fn.Status == "blocked" {
+		} else if kind == "blocked" {
 			m.blockedCount++
-		} else if fn.Status == "closed" {
+		} else if kind == "closed" {
 			m.closedCount++
 		}
 	}
@@ -1202,7 +1217,7 @@ func (m *LensDashboardModel) buildEgoCenteredTree() {
 		if child, ok := m.issueMap[childID]; ok {
 			if !seen[childID] {
 				// When scope is active, only include scope-matching issues
-				if m.HasScope() && !depthPrimaryIDs[childID] {
+				if m.isFiltered() && !depthPrimaryIDs[childID] {
 					continue
 				}
 				downstreamIssues = append(downstreamIssues, *child)
@@ -1210,25 +1225,9 @@ func (m *LensDashboardModel) buildEgoCenteredTree() {
 		}
 	}
 
-	// Sort by status, then topological rank, then priority, then hierarchical ID
+	// Sort by status, then by the section sort order
 	sort.Slice(downstreamIssues, func(i, j int) bool {
-		si := m.getStatusOrder(downstreamIssues[i])
-		sj := m.getStatusOrder(downstreamIssues[j])
-		if si != sj {
-			return si < sj
-		}
-		// Within same status, use topological rank (blockers first)
-		ri := m.topoRanks[downstreamIssues[i].ID]
-		rj := m.topoRanks[downstreamIssues[j].ID]
-		if ri != rj {
-			return ri < rj
-		}
-		// Within same topo rank, sort by priority
-		if downstreamIssues[i].Priority != downstreamIssues[j].Priority {
-			return downstreamIssues[i].Priority < downstreamIssues[j].Priority
-		}
-		// Within same priority, sort by hierarchical ID (ascending)
-		return CompareHierarchicalIDs(downstreamIssues[i].ID, downstreamIssues[j].ID) < 0
+		return m.issueLess(downstreamIssues[i], downstreamIssues[j])
 	})
 
 	// Build tree from each downstream issue
@@ -1319,7 +1318,7 @@ func (m *LensDashboardModel) buildCenteredTreeNode(issue model.Issue, relDepth,
 	}
 
 	status := m.getIssueStatus(issue)
-	switch status {
+	switch lensStatusKind(status) {
 	case "ready":
 		m.readyCount++
 	case "blocked":
@@ -1335,7 +1334,7 @@ func (m *LensDashboardModel) buildCenteredTreeNode(issue model.Issue, relDepth,
 			if child, ok := m.issueMap[childID]; ok {
 				if !seen[childID] {
 					// When scope is active, only include scope-matching issues
-					if m.HasScope() && !depthPrimaryIDs[childID] {
+					if m.isFiltered() && !depthPrimaryIDs[childID] {
 						continue
 					}
 					childIssues = append(childIssues, *child)
@@ -1343,25 +1342,9 @@ func (m *LensDashboardModel) buildCenteredTreeNode(issue model.Issue, relDepth,
 			}
 		}
 
-		// Sort children by status, then topological rank, then priority, then hierarchical ID
+		// Sort children by status, then by the section sort order
 		sort.Slice(childIssues, func(i, j int) bool {
-			si := m.getStatusOrder(childIssues[i])
-			sj := m.getStatusOrder(childIssues[j])
-			if si != sj {
-				return si < sj
-			}
-			// Within same status, use topological rank (blockers first)
-			ri := m.topoRanks[childIssues[i].ID]
-			rj := m.topoRanks[childIssues[j].ID]
-			if ri != rj {
-				return ri < rj
-			}
-			// Within same topo rank, sort by priority
-			if childIssues[i].Priority != childIssues[j].Priority {
-				return childIssues[i].Priority < childIssues[j].Priority
-			}
-			// Within same priority, sort by hierarchical ID (ascending)
-			return CompareHierarchicalIDs(childIssues[i].ID, childIssues[j].ID) < 0
+			return m.issueLess(childIssues[i], childIssues[j])
 		})
 
 		newParentPath := append(parentPath, isLast)
@@ -1397,7 +1380,7 @@ func (m *LensDashboardModel) buildTreePrefix(node *LensTreeNode) string {
 		if node.ParentPath[i] {
 			prefix.WriteString("  ") // parent was last child, no line
 		} else {
-			prefix.WriteString("│ ") // parent has siblings, continue line
+			prefix.WriteString(m.theme.Glyph("│ ")) // parent has siblings, continue line
 		}
 	}
 
@@ -1406,16 +1389,16 @@ func (m *LensDashboardModel) buildTreePrefix(node *LensTreeNode) string {
 	// ├─ / └─ = parent-child relationship (line shows hierarchy)
 	if node.EdgeToParent == EdgeParentChild {
 		if node.IsLastChild {
-			prefix.WriteString("└─")
+			prefix.WriteString(m.theme.Glyph("└─"))
 		} else {
-			prefix.WriteString("├─")
+			prefix.WriteString(m.theme.Glyph("├─"))
 		}
 	} else {
 		// Blocking relationship (default)
 		if node.IsLastChild {
-			prefix.WriteString("└▸")
+			prefix.WriteString(m.theme.Glyph("└▸"))
 		} else {
-			prefix.WriteString("├▸")
+			prefix.WriteString(m.theme.Glyph("├▸"))
 		}
 	}
 
@@ -0,0 +1,279 @@
+package ui
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ============================================================================
+// Lens Tree Property Tests
+//
+// buildTree/flattenTree bugs (a primary drawn twice, a missing tree line, a
+// header count off by one) used to surface only visually. These tests build
+// lens trees over many random graphs and check the invariants every tree
+// must hold, whatever its shape.
+// ============================================================================
+
+// propertyLabel is the lens label of the random graphs
+const propertyLabel = "lens"
+
+// propertyScopeLabel is the label the scoped variants narrow the lens to
+const propertyScopeLabel = "scoped"
+
+// propertyDepths are the depths every random graph is built at
+var propertyDepths = []DepthOption{Depth1, Depth2, Depth3, DepthAll}
+
+// randomLensIssues returns a random acyclic graph of 2-40 issues mixing
+// blocking and parent-child edges, statuses, and whether the lens label is
+// on an issue
+func randomLensIssues(rng *rand.Rand) []model.Issue {
+	n := 2 + rng.Intn(39)
+	density := 0.02 + rng.Float64()*0.2
+	statuses := []model.Status{model.StatusOpen, model.StatusOpen, model.StatusInProgress, model.StatusBlocked, model.StatusClosed}
+
+	issues := make([]model.Issue, n)
+	for i := range issues {
+		id := fmt.Sprintf("p-%d", i)
+		issues[i] = model.Issue{
+			ID:       id,
+			Title:    "Issue " + id,
+			Status:   statuses[rng.Intn(len(statuses))],
+			Priority: rng.Intn(5),
+		}
+		if rng.Intn(3) == 0 {
+			issues[i].Labels = []string{propertyLabel}
+		}
+		if rng.Intn(2) == 0 {
+			issues[i].Labels = append(issues[i].Labels, propertyScopeLabel)
+		}
+		// Edges only point at lower indexes, so the graph stays acyclic
+		for j := 0; j < i; j++ {
+			if rng.Float64() >= density {
+				continue
+			}
+			depType := model.DepBlocks
+			if rng.Intn(3) == 0 {
+				depType = model.DepParentChild
+			}
+			issues[i].Dependencies = append(issues[i].Dependencies, &model.Dependency{
+				IssueID: id, DependsOnID: fmt.Sprintf("p-%d", j), Type: depType,
+			})
+		}
+	}
+	return issues
+}
+
+// lensVariant is one way of viewing a random graph
+type lensVariant struct {
+	name        string
+	archaeology bool // Keep closed blockers in the tree
+	scoped      bool // Narrow the lens to propertyScopeLabel
+}
+
+var lensVariants = []lensVariant{
+	{name: "plain"},
+	{name: "archaeology", archaeology: true},
+	{name: "scoped", scoped: true},
+}
+
+// newPropertyLens builds a label lens over issues at depth
+func newPropertyLens(issues []model.Issue, depth DepthOption, v lensVariant) LensDashboardModel {
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	m := NewLensDashboardModel(propertyLabel, issues, issueMap, DefaultTheme(lipgloss.NewRenderer(nil)))
+	m.SetArchaeologyMode(v.archaeology)
+	if v.scoped {
+		m.AddScopeLabel(propertyScopeLabel)
+	}
+	m.SetDepth(depth)
+	return m
+}
+
+// checkLensTreeInvariants reports every invariant the built tree of m breaks
+func checkLensTreeInvariants(m *LensDashboardModel) []string {
+	var problems []string
+	fail := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	depthPrimaryIDs := m.GetPrimaryIDsForDepth()
+	maxDepth := int(m.dependencyDepth)
+	if m.dependencyDepth == DepthAll {
+		maxDepth = 100
+	}
+
+	// Every issue is drawn at most once, and every depth-appropriate primary
+	// exactly once unless the depth limit cut its branch off
+	count := make(map[string]int)
+	drawnDepth := make(map[string]int)
+	for _, fn := range m.flatNodes {
+		count[fn.Node.Issue.ID]++
+		drawnDepth[fn.Node.Issue.ID] = fn.Node.Depth
+	}
+	for id, c := range count {
+		if c > 1 {
+			fail("%s drawn %d times", id, c)
+		}
+	}
+	for id := range depthPrimaryIDs {
+		if count[id] == 0 && !cutOffByDepth(m, id, drawnDepth, maxDepth, map[string]bool{}) {
+			fail("primary %s missing from the tree", id)
+		}
+	}
+
+	var ready, blocked, closed, primary int
+	for i, fn := range m.flatNodes {
+		node := fn.Node
+		id := node.Issue.ID
+
+		if node.Depth >= maxDepth {
+			fail("%s at depth %d, beyond max depth %d", id, node.Depth, maxDepth)
+		}
+		if node.IsPrimary != depthPrimaryIDs[id] {
+			fail("%s IsPrimary = %v, want %v", id, node.IsPrimary, depthPrimaryIDs[id])
+		}
+
+		// Pre-order: a node is at most one level below the previous one
+		if i == 0 && node.Depth != 0 {
+			fail("first node %s at depth %d, want a root", id, node.Depth)
+		}
+		if i > 0 && node.Depth > m.flatNodes[i-1].Node.Depth+1 {
+			fail("%s at depth %d follows depth %d", id, node.Depth, m.flatNodes[i-1].Node.Depth)
+		}
+
+		// The prefix draws one column per ancestor plus the connector
+		if node.Depth == 0 {
+			if fn.TreePrefix != "" {
+				fail("root %s has prefix %q", id, fn.TreePrefix)
+			}
+		} else {
+			if len(node.ParentPath) != node.Depth {
+				fail("%s at depth %d has a parent path of %d", id, node.Depth, len(node.ParentPath))
+			}
+			if got, want := utf8.RuneCountInString(fn.TreePrefix), 2*(node.Depth+1); got != want {
+				fail("%s at depth %d has prefix %q (%d runes, want %d)", id, node.Depth, fn.TreePrefix, got, want)
+			}
+			connector := "├"
+			if node.IsLastChild {
+				connector = "└"
+			}
+			if !strings.HasPrefix(fn.TreePrefix[len(fn.TreePrefix)-len("└▸"):], connector) {
+				fail("%s (last child %v) has connector %q", id, node.IsLastChild, fn.TreePrefix)
+			}
+		}
+
+		// Children are downstream of their parent and one level deeper
+		for j, child := range node.Children {
+			if child.Depth != node.Depth+1 {
+				fail("%s child %s at depth %d", id, child.Issue.ID, child.Depth)
+			}
+			if child.IsLastChild != (j == len(node.Children)-1) {
+				fail("%s child %s IsLastChild = %v", id, child.Issue.ID, child.IsLastChild)
+			}
+			if _, ok := m.edgeTypes[id+":"+child.Issue.ID]; !ok {
+				fail("%s child %s is not downstream of it", id, child.Issue.ID)
+			}
+		}
+
+		if node.IsPrimary {
+			primary++
+		}
+		switch m.getIssueStatus(node.Issue) {
+		case "ready":
+			ready++
+		case "blocked":
+			blocked++
+		case "closed":
+			closed++
+		}
+	}
+
+	// Header counts agree with the drawn tree
+	if m.totalCount != len(m.flatNodes) {
+		fail("totalCount = %d, %d nodes drawn", m.totalCount, len(m.flatNodes))
+	}
+	if m.primaryCount+m.contextCount != m.totalCount {
+		fail("primary %d + context %d != total %d", m.primaryCount, m.contextCount, m.totalCount)
+	}
+	if m.primaryCount != primary {
+		fail("primaryCount = %d, %d primaries drawn", m.primaryCount, primary)
+	}
+	if m.readyCount != ready || m.blockedCount != blocked || m.closedCount != closed {
+		fail("ready/blocked/closed = %d/%d/%d, drawn %d/%d/%d",
+			m.readyCount, m.blockedCount, m.closedCount, ready, blocked, closed)
+	}
+	return problems
+}
+
+// cutOffByDepth reports whether an undrawn issue sits below the depth limit:
+// something upstream of it is drawn on the last level, or is undrawn and cut
+// off itself
+func cutOffByDepth(m *LensDashboardModel, id string, drawnDepth map[string]int, maxDepth int, visited map[string]bool) bool {
+	if visited[id] {
+		return false
+	}
+	visited[id] = true
+	for _, upID := range m.upstream[id] {
+		depth, drawn := drawnDepth[upID]
+		if drawn && depth == maxDepth-1 {
+			return true
+		}
+		if !drawn && cutOffByDepth(m, upID, drawnDepth, maxDepth, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestLensTreeInvariants(t *testing.T) {
+	for seed := int64(1); seed <= 300; seed++ {
+		issues := randomLensIssues(rand.New(rand.NewSource(seed)))
+		for _, v := range lensVariants {
+			for _, depth := range propertyDepths {
+				m := newPropertyLens(issues, depth, v)
+				for _, p := range checkLensTreeInvariants(&m) {
+					t.Errorf("seed %d, %s, depth %s: %s", seed, v.name, depth, p)
+				}
+			}
+		}
+	}
+}
+
+// TestLensTreeKeepsPrimaryBlockedByContext pins the smallest graphs the
+// invariants caught: a primary blocked only by a context issue was dropped,
+// since context blocker branches only followed other context blockers, and
+// under a scope, which hides context blockers, it was never made a root.
+func TestLensTreeKeepsPrimaryBlockedByContext(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "ctx", Title: "Context", Status: model.StatusOpen},
+		{ID: "blocked", Title: "Blocked", Status: model.StatusOpen, Labels: []string{propertyLabel, propertyScopeLabel}, Dependencies: []*model.Dependency{
+			{IssueID: "blocked", DependsOnID: "ctx", Type: model.DepBlocks},
+		}},
+		{ID: "free", Title: "Free", Status: model.StatusOpen, Labels: []string{propertyLabel, propertyScopeLabel}},
+	}
+	tests := []struct {
+		variant lensVariant
+		want    string
+	}{
+		{lensVariant{name: "plain"}, "ctx │ └▸blocked free"},
+		{lensVariant{name: "scoped", scoped: true}, "free blocked"},
+	}
+	for _, tt := range tests {
+		m := newPropertyLens(issues, Depth2, tt.variant)
+		var drawn []string
+		for _, fn := range m.flatNodes {
+			drawn = append(drawn, fn.TreePrefix+fn.Node.Issue.ID)
+		}
+		if got := strings.Join(drawn, " "); got != tt.want {
+			t.Errorf("%s tree = %q, want %q", tt.variant.name, got, tt.want)
+		}
+	}
+}
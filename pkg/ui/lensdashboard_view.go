@@ -40,23 +40,14 @@ func (m *LensDashboardModel) View() string {
 	// statsStyle needed for view renders below
 	statsStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
 
-	// Scope indicator (if scope is active)
-	if len(m.scopeLabels) > 0 {
-		scopeStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
-		modeStyle := t.Renderer.NewStyle().Foreground(t.InProgress)
-		tagStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
-
-		// Build scope tags
-		var tags []string
-		for _, label := range m.scopeLabels {
-			tags = append(tags, tagStyle.Render("["+label+"]"))
-		}
-
-		// Mode indicator
-		modeIndicator := m.scopeMode.ShortString()
+	// Path of lenses drilled through
+	if crumbs := m.renderBreadcrumbs(contentWidth); crumbs != "" {
+		lines = append(lines, crumbs)
+	}
 
-		scopeLine := scopeStyle.Render("Scope: ") + strings.Join(tags, " ") + "  " + modeStyle.Render(modeIndicator)
-		lines = append(lines, scopeLine)
+	// Active filters as dismissible pills
+	if pills := m.renderFilterPills(contentWidth); pills != "" {
+		lines = append(lines, pills)
 	}
 
 	// Scope input field (inline, appears when adding scope)
@@ -66,7 +57,7 @@ func (m *LensDashboardModel) View() string {
 		promptStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
 		hintStyle := t.Renderer.NewStyle().Faint(true)
 
-		inputLine := promptStyle.Render("+ Scope: ") + inputStyle.Render(m.scopeInput) + inputStyle.Render("█")
+		inputLine := promptStyle.Render("+ Scope: ") + inputStyle.Render(m.scopeInput) + inputStyle.Render(m.theme.Glyph("█"))
 		lines = append(lines, inputLine)
 
 		// Show matching labels on second line, or empty line if no matches
@@ -90,7 +81,7 @@ func (m *LensDashboardModel) View() string {
 				if maxLen > 0 && len(matchText) > maxLen {
 					matchText = matchText[:maxLen-3] + "..."
 				}
-				matchLine = hintStyle.Render("  → " + matchText)
+				matchLine = hintStyle.Render(m.theme.Glyph("  → ") + matchText)
 			}
 		}
 		lines = append(lines, matchLine) // Always add second line (empty if no matches)
@@ -112,7 +103,7 @@ func (m *LensDashboardModel) View() string {
 		}
 
 		countText := countStyle.Render(fmt.Sprintf(" (%d matches)", visibleCount))
-		searchLine := promptStyle.Render("/") + inputStyle.Render(m.fuzzyInput) + inputStyle.Render("█") + countText
+		searchLine := promptStyle.Render("/") + inputStyle.Render(m.fuzzyInput) + inputStyle.Render(m.theme.Glyph("█")) + countText
 		lines = append(lines, searchLine)
 	}
 
@@ -233,7 +224,7 @@ func (m *LensDashboardModel) renderCenteredView(contentWidth, visibleLines int,
 		if lineLen < 5 {
 			lineLen = 5
 		}
-		line := strings.Repeat("─", lineLen)
+		line := strings.Repeat(m.theme.Glyph("─"), lineLen)
 		return iconStyled + " " + sectionLabelStyle.Render(label) + " " + separatorStyle.Render(line)
 	}
 
@@ -243,7 +234,7 @@ func (m *LensDashboardModel) renderCenteredView(contentWidth, visibleLines int,
 
 	// === UPSTREAM SECTION (blockers) ===
 	if len(m.upstreamNodes) > 0 {
-		header := renderSectionHeader("◇", upstreamIconStyle.Render("◇"), "BLOCKERS", min(contentWidth, 50))
+		header := renderSectionHeader(m.theme.Glyph("◇"), upstreamIconStyle.Render(m.theme.Glyph("◇")), "BLOCKERS", min(contentWidth, 50))
 		allLines = append(allLines, header)
 
 		for _, fn := range m.upstreamNodes {
@@ -258,8 +249,8 @@ func (m *LensDashboardModel) renderCenteredView(contentWidth, visibleLines int,
 	// === CENTER SECTION (entry point/ego) with elegant top/bottom lines ===
 	if m.egoNode != nil {
 		lineWidth := min(contentWidth-4, 50)
-		topLine := boxStyle.Render("═" + strings.Repeat("═", lineWidth) + "═")
-		bottomLine := boxStyle.Render("─" + strings.Repeat("─", lineWidth) + "─")
+		topLine := boxStyle.Render(m.theme.Glyph("═") + strings.Repeat(m.theme.Glyph("═"), lineWidth) + m.theme.Glyph("═"))
+		bottomLine := boxStyle.Render(m.theme.Glyph("─") + strings.Repeat(m.theme.Glyph("─"), lineWidth) + m.theme.Glyph("─"))
 
 		allLines = append(allLines, topLine)
 
@@ -275,7 +266,7 @@ func (m *LensDashboardModel) renderCenteredView(contentWidth, visibleLines int,
 
 	// === DOWNSTREAM SECTION (children/dependents) ===
 	if len(m.flatNodes) > 0 {
-		header := renderSectionHeader("◆", downstreamIconStyle.Render("◆"), "DESCENDANTS", min(contentWidth, 50))
+		header := renderSectionHeader(m.theme.Glyph("◆"), downstreamIconStyle.Render(m.theme.Glyph("◆")), "DESCENDANTS", min(contentWidth, 50))
 		allLines = append(allLines, header)
 
 		lastStatus := ""
@@ -338,7 +329,7 @@ func (m *LensDashboardModel) renderEgoNodeLine(fn LensFlatNode, isSelected bool,
 	// Selection indicator
 	selectPrefix := "  "
 	if isSelected {
-		selectPrefix = "▸ "
+		selectPrefix = m.theme.Glyph("▸ ")
 	}
 
 	// Issue ID and title with prominent styling
@@ -346,12 +337,12 @@ func (m *LensDashboardModel) renderEgoNodeLine(fn LensFlatNode, isSelected bool,
 	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
 
 	// Calculate max title length
-	prefixLen := len(selectPrefix) + len(node.Issue.ID) + 2
+	prefixLen := len(selectPrefix) + len(m.theme.ID(node.Issue.ID)) + 2
 	maxTitleLen := maxWidth - prefixLen
 	if maxTitleLen < 15 {
 		maxTitleLen = 15
 	}
-	title := truncateRunesHelper(node.Issue.Title, maxTitleLen, "…")
+	title := truncateRunesHelper(node.Issue.Title, maxTitleLen, m.theme.Glyph("…"))
 
 	// Status indicator (only show if blocker not already visible in tree)
 	statusSuffix := ""
@@ -361,12 +352,16 @@ func (m *LensDashboardModel) renderEgoNodeLine(fn LensFlatNode, isSelected bool,
 		if len(fn.BlockedBy) > 1 {
 			blockerText += fmt.Sprintf(" +%d", len(fn.BlockedBy)-1)
 		}
-		statusSuffix = blockerStyle.Render(" ◄ " + blockerText)
+		statusSuffix = blockerStyle.Render(m.theme.Glyph(" ◄ ") + blockerText)
 	}
+	statusSuffix += m.archaeologySuffix(node.Issue)
+	statusSuffix += m.stalenessSuffix(node.Issue)
+	statusSuffix += m.claimSuffix(node.Issue)
+	statusSuffix += m.rollupSuffix(node.Issue)
 
 	return fmt.Sprintf("%s%s %s%s",
 		selectPrefix,
-		idStyle.Render(node.Issue.ID),
+		idStyle.Render(m.theme.ID(node.Issue.ID)),
 		titleStyle.Render(title),
 		statusSuffix)
 }
@@ -379,7 +374,7 @@ func (m *LensDashboardModel) renderCenteredNode(fn LensFlatNode, isSelected bool
 	// Selection indicator
 	selectPrefix := "  "
 	if isSelected {
-		selectPrefix = "▸ "
+		selectPrefix = m.theme.Glyph("▸ ")
 	}
 
 	// Tree prefix (styled dimmer)
@@ -426,14 +421,18 @@ func (m *LensDashboardModel) renderCenteredNode(fn LensFlatNode, isSelected bool
 		idStyle = idStyle.Foreground(t.Base.GetForeground())
 		titleStyle = titleStyle.Foreground(t.Base.GetForeground())
 	}
+	if m.archaeologyMode && fn.Status == "closed" {
+		idStyle = idStyle.Faint(true)
+		titleStyle = titleStyle.Faint(true)
+	}
 
 	// Calculate max title length
-	prefixLen := len(selectPrefix) + len(fn.TreePrefix) + len(node.Issue.ID) + 2
+	prefixLen := len(selectPrefix) + len(fn.TreePrefix) + len(m.theme.ID(node.Issue.ID)) + 2
 	maxTitleLen := maxWidth - prefixLen
 	if maxTitleLen < 15 {
 		maxTitleLen = 15
 	}
-	title := truncateRunesHelper(node.Issue.Title, maxTitleLen, "…")
+	title := truncateRunesHelper(node.Issue.Title, maxTitleLen, m.theme.Glyph("…"))
 
 	// Status indicator for blocked items (only show if blocker not already visible in tree)
 	statusSuffix := ""
@@ -443,13 +442,17 @@ func (m *LensDashboardModel) renderCenteredNode(fn LensFlatNode, isSelected bool
 		if len(fn.BlockedBy) > 1 {
 			blockerText += fmt.Sprintf(" +%d", len(fn.BlockedBy)-1)
 		}
-		statusSuffix = blockerStyle.Render(" ◄ " + blockerText)
+		statusSuffix = blockerStyle.Render(m.theme.Glyph(" ◄ ") + blockerText)
 	}
+	statusSuffix += m.archaeologySuffix(node.Issue)
+	statusSuffix += m.stalenessSuffix(node.Issue)
+	statusSuffix += m.claimSuffix(node.Issue)
+	statusSuffix += m.rollupSuffix(node.Issue)
 
 	return fmt.Sprintf("%s%s%s %s%s",
 		selectPrefix,
 		treePrefix,
-		idStyle.Render(node.Issue.ID),
+		idStyle.Render(m.theme.ID(node.Issue.ID)),
 		titleStyle.Render(title),
 		statusSuffix)
 }
@@ -475,12 +478,13 @@ func (m *LensDashboardModel) renderWorkstreamView(contentWidth, visibleLines int
 		isHeaderSelected := wsIdx == m.wsCursor && m.wsIssueCursor < 0
 		isExpanded := m.wsExpanded[wsIdx]
 
-		// Workstream header with progress
+		// Workstream header with progress, in the stream's accent color
+		accent := WorkstreamColor(ws.ID)
 		progressPct := int(ws.Progress * 100)
-		progressBar := m.renderMiniProgressBar(ws.Progress, 8)
+		progressBar := m.renderAccentProgressBar(ws.Progress, 8, accent)
 
 		// Status counts
-		statusCounts := fmt.Sprintf("○%d ●%d ◈%d ✓%d",
+		statusCounts := fmt.Sprintf(m.theme.Glyph("○%d ●%d ◈%d ✓%d"),
 			ws.ReadyCount, ws.InProgressCount, ws.BlockedCount, ws.ClosedCount)
 
 		// Expand/collapse indicator
@@ -491,10 +495,10 @@ func (m *LensDashboardModel) renderWorkstreamView(contentWidth, visibleLines int
 
 		// Selection indicator
 		selectPrefix := "  "
-		headerStyle := wsHeaderStyle
+		headerStyle := wsHeaderStyle.Foreground(accent)
 		if isHeaderSelected {
-			selectPrefix = "▸ "
-			headerStyle = wsHeaderSelectedStyle
+			selectPrefix = m.theme.Glyph("▸ ")
+			headerStyle = wsHeaderSelectedStyle.Foreground(accent)
 		}
 
 		// Show sub-workstream indicator if present
@@ -506,13 +510,33 @@ func (m *LensDashboardModel) renderWorkstreamView(contentWidth, visibleLines int
 			}
 		}
 
-		wsLine := fmt.Sprintf("%s%s %s %s %d%% %s%s",
+		if effort := workstreamEffortLabel(ws); effort != "" {
+			statusCounts += " " + m.theme.Glyph(effort)
+		}
+
+		// The first nine streams are numbered for jumping with 1-9
+		number := " "
+		if wsIdx < 9 {
+			number = fmt.Sprintf("%d", wsIdx+1)
+		}
+
+		name := headerStyle.Render(ws.Name)
+		if m.renamingWS && wsIdx == m.wsCursor {
+			name = headerStyle.Render(m.theme.Glyph("✎ ")+m.renameInput) + headerStyle.Render(m.theme.Glyph("█"))
+		}
+		if ws.ID == m.compareMarkID {
+			name += wsSubStyle.Render(m.theme.Glyph(" ⇄"))
+		}
+
+		wsLine := fmt.Sprintf("%s%s %s %s %s %d%% %s%s%s",
 			selectPrefix,
-			expandIcon,
-			headerStyle.Render(ws.Name),
+			wsSubStyle.Render(number),
+			m.theme.Glyph(expandIcon),
+			name,
 			progressBar,
 			progressPct,
 			wsSubStyle.Render(statusCounts),
+			m.renderAgeBar(ws)+m.renderStallBadge(ws)+m.renderForecast(ws.Forecast),
 			wsSubStyle.Render(subWsIndicator))
 		allLines = append(allLines, wsLine)
 
@@ -523,10 +547,11 @@ func (m *LensDashboardModel) renderWorkstreamView(contentWidth, visibleLines int
 					continue
 				}
 				subProgress := int(subWs.Progress * 100)
-				subStatusCounts := fmt.Sprintf("○%d ●%d ◈%d ✓%d",
+				subStatusCounts := fmt.Sprintf(m.theme.Glyph("○%d ●%d ◈%d ✓%d"),
 					subWs.ReadyCount, subWs.InProgressCount, subWs.BlockedCount, subWs.ClosedCount)
-				subLine := fmt.Sprintf("     %s (%d%%) %s",
-					wsSubStyle.Render("├─ "+subWs.Name),
+				subLine := fmt.Sprintf("     %s%s (%d%%) %s",
+					wsSubStyle.Render(m.theme.Glyph("├─ ")),
+					wsSubStyle.Foreground(WorkstreamColor(subWs.ID)).Render(subWs.Name),
 					subProgress,
 					wsSubStyle.Render(subStatusCounts))
 				_ = subIdx // Will be used for sub-workstream selection in future
@@ -540,8 +565,13 @@ func (m *LensDashboardModel) renderWorkstreamView(contentWidth, visibleLines int
 			wsCopy := ws
 			treeRoots := m.buildWorkstreamTree(&wsCopy)
 			flatNodes := m.flattenWSTree(treeRoots)
+			pageStart, pageEnd := m.wsPageRange(wsIdx)
 
 			for i, fn := range flatNodes {
+				if i < pageStart || i >= pageEnd {
+					continue
+				}
+
 				// Check if this issue is selected
 				isIssueSelected := wsIdx == m.wsCursor && i == m.wsIssueCursor
 				isEpicEntry := m.isEntryEpic(fn.Node.Issue.ID)
@@ -580,7 +610,7 @@ func (m *LensDashboardModel) renderWorkstreamView(contentWidth, visibleLines int
 					titleStyle = issueSelectedStyle.Foreground(t.Primary)
 				}
 				if isIssueSelected {
-					issuePrefix = "  ▸ "
+					issuePrefix = m.theme.Glyph("  ▸ ")
 					idStyle = issueSelectedStyle.Foreground(t.Primary)
 					titleStyle = issueSelectedStyle
 				}
@@ -591,26 +621,30 @@ func (m *LensDashboardModel) renderWorkstreamView(contentWidth, visibleLines int
 					treePrefix = wsSubStyle.Render(fn.TreePrefix) + " "
 				}
 
-				title := truncateRunesHelper(fn.Node.Issue.Title, contentWidth-25-len(fn.TreePrefix), "…")
+				title := truncateRunesHelper(fn.Node.Issue.Title, contentWidth-25-len(fn.TreePrefix), m.theme.Glyph("…"))
 				epicBadge := ""
 				if isEpicEntry {
 					epicBadge = wsSubStyle.Render(" [EPIC]")
 				}
+				epicBadge += m.workstreamIssueBadge(fn.Node.Issue.ID)
 				issueLine := fmt.Sprintf("%s%s %s%s %s%s",
 					issuePrefix,
-					style.Render(statusIcon),
+					style.Render(m.theme.Glyph(statusIcon)),
 					treePrefix,
-					idStyle.Render(fn.Node.Issue.ID),
+					idStyle.Render(m.theme.ID(fn.Node.Issue.ID)),
 					titleStyle.Render(title),
 					epicBadge)
 				allLines = append(allLines, issueLine)
 			}
 		} else {
 			// Flat list view
-			maxIssues := m.getVisibleIssueCount(wsIdx)
+			pageStart, pageEnd := m.wsPageRange(wsIdx)
 
 			for i, issue := range ws.Issues {
-				if i >= maxIssues {
+				if i < pageStart {
+					continue
+				}
+				if i >= pageEnd {
 					break
 				}
 
@@ -652,20 +686,21 @@ func (m *LensDashboardModel) renderWorkstreamView(contentWidth, visibleLines int
 					titleStyle = issueSelectedStyle.Foreground(t.Primary)
 				}
 				if isIssueSelected {
-					issuePrefix = "  ▸ "
+					issuePrefix = m.theme.Glyph("  ▸ ")
 					idStyle = issueSelectedStyle.Foreground(t.Primary)
 					titleStyle = issueSelectedStyle
 				}
 
-				title := truncateRunesHelper(issue.Title, contentWidth-20, "…")
+				title := truncateRunesHelper(issue.Title, contentWidth-20, m.theme.Glyph("…"))
 				epicBadge := ""
 				if isEpicEntry {
 					epicBadge = wsSubStyle.Render(" [EPIC]")
 				}
+				epicBadge += m.workstreamIssueBadge(issue.ID)
 				issueLine := fmt.Sprintf("%s%s %s %s%s",
 					issuePrefix,
-					style.Render(statusIcon),
-					idStyle.Render(issue.ID),
+					style.Render(m.theme.Glyph(statusIcon)),
+					idStyle.Render(m.theme.ID(issue.ID)),
 					titleStyle.Render(title),
 					epicBadge)
 				allLines = append(allLines, issueLine)
@@ -678,6 +713,10 @@ func (m *LensDashboardModel) renderWorkstreamView(contentWidth, visibleLines int
 			}
 		}
 
+		if pages := m.wsPageCount(wsIdx); pages > 1 {
+			allLines = append(allLines, wsSubStyle.Render(m.workstreamPageIndicator(wsIdx, pages)))
+		}
+
 		allLines = append(allLines, "") // Empty line between workstreams
 	}
 
@@ -713,26 +752,189 @@ func (m *LensDashboardModel) renderWorkstreamView(contentWidth, visibleLines int
 	return lines
 }
 
-// renderMiniProgressBar renders a small progress bar
-func (m *LensDashboardModel) renderMiniProgressBar(progress float64, width int) string {
-	t := m.theme
+// workstreamPageIndicator describes which slice of a paginated workstream is
+// shown, e.g. "◂ page 2/5 · 11-20 of 48 ▸"
+func (m *LensDashboardModel) workstreamPageIndicator(wsIdx, pages int) string {
+	start, end := m.wsPageRange(wsIdx)
+	page := start/wsPageSize + 1
+	prev, next := " ", " "
+	if page > 1 {
+		prev = m.theme.Glyph("◂")
+	}
+	if page < pages {
+		next = m.theme.Glyph("▸")
+	}
+	hint := ""
+	if wsIdx == m.wsCursor {
+		hint = "  (</> page)"
+	}
+	return fmt.Sprintf(m.theme.Glyph("        %s page %d/%d · %d-%d of %d %s%s"),
+		prev, page, pages, start+1, end, m.getVisibleIssueCount(wsIdx), next, hint)
+}
 
+// renderAccentProgressBar renders a small progress bar in the given color,
+// normally the workstream's accent so the bar matches its header.
+func (m *LensDashboardModel) renderAccentProgressBar(progress float64, width int, color lipgloss.AdaptiveColor) string {
 	filled := int(progress * float64(width))
 	if filled > width {
 		filled = width
 	}
+	if filled < 0 {
+		filled = 0
+	}
+	bar := strings.Repeat(m.theme.Glyph("█"), filled) + strings.Repeat(m.theme.Glyph("░"), width-filled)
+	return m.theme.Renderer.NewStyle().Foreground(color).Render("[" + bar + "]")
+}
 
-	var barColor lipgloss.AdaptiveColor
-	if progress >= 1.0 {
-		barColor = t.Closed
-	} else if progress >= 0.5 {
-		barColor = t.InProgress
-	} else {
-		barColor = t.Open
+// workstreamEffortLabel summarizes remaining estimated effort and the
+// dependency-bound earliest finish, e.g. "⏱12h (6h path)". A leading "~"
+// marks totals that include defaulted estimates. Empty when nothing is open.
+func workstreamEffortLabel(ws analysis.Workstream) string {
+	if ws.RemainingMinutes <= 0 {
+		return ""
+	}
+	approx := ""
+	if ws.UnestimatedCount > 0 {
+		approx = "~"
+	}
+	label := "⏱" + approx + formatEffortMinutes(ws.RemainingMinutes)
+	if ws.FinishMinutes > 0 && ws.FinishMinutes < ws.RemainingMinutes {
+		label += " (" + formatEffortMinutes(ws.FinishMinutes) + " path)"
 	}
+	return label
+}
 
-	bar := strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
-	return t.Renderer.NewStyle().Foreground(barColor).Render("[" + bar + "]")
+// workstreamAgeBarWidth is the cells in a workstream header's age bar
+const workstreamAgeBarWidth = 6
+
+// workstreamAgeMix counts a stream's open issues by staleness, indexed by
+// analysis.StaleLevel (fresh, aging, stale)
+func workstreamAgeMix(ws analysis.Workstream, now time.Time, staleDays int) [3]int {
+	var mix [3]int
+	for _, issue := range ws.Issues {
+		if issue.Status.IsClosed() {
+			continue
+		}
+		mix[analysis.ComputeIssueAge(issue, now, staleDays).Staleness]++
+	}
+	return mix
+}
+
+// apportionCells splits width cells across counts in proportion (largest
+// remainder first), giving every non-zero count at least one cell
+func apportionCells(counts []int, width int) []int {
+	cells := make([]int, len(counts))
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+	if total == 0 || width <= 0 {
+		return cells
+	}
+	used := 0
+	for i, c := range counts {
+		cells[i] = c * width / total
+		used += cells[i]
+	}
+	for ; used < width; used++ {
+		best := -1
+		for i, c := range counts {
+			if c == 0 {
+				continue
+			}
+			// Remainder of count i, compared as c*width - cells*total
+			if best < 0 || c*width-cells[i]*total > counts[best]*width-cells[best]*total {
+				best = i
+			}
+		}
+		cells[best]++
+	}
+	for i, c := range counts {
+		if c == 0 || cells[i] > 0 {
+			continue
+		}
+		widest := 0
+		for j := range cells {
+			if cells[j] > cells[widest] {
+				widest = j
+			}
+		}
+		if cells[widest] > 1 {
+			cells[widest]--
+			cells[i]++
+		}
+	}
+	return cells
+}
+
+// renderAgeBar renders the mix of a stream's open issues by staleness as a
+// compact stacked bar (fresh, aging, then stale), or "" when none are open
+func (m *LensDashboardModel) renderAgeBar(ws analysis.Workstream) string {
+	mix := workstreamAgeMix(ws, time.Now(), m.staleDays)
+	cells := apportionCells(mix[:], workstreamAgeBarWidth)
+	colors := [3]lipgloss.TerminalColor{m.theme.Open, m.theme.Subtext, ColorWarning}
+	var bar strings.Builder
+	for level, n := range cells {
+		if n > 0 {
+			bar.WriteString(m.theme.Renderer.NewStyle().Foreground(colors[level]).Render(strings.Repeat(m.theme.Glyph("▮"), n)))
+		}
+	}
+	if bar.Len() == 0 {
+		return ""
+	}
+	return " " + bar.String()
+}
+
+// renderForecast renders a completion forecast as its optimistic, expected
+// and pessimistic dates, e.g. " 📅 Mar 3·Mar 5·Mar 9 B": the expected date in
+// its confidence grade's color, the outer two dimmed. "" when nothing is open.
+func (m *LensDashboardModel) renderForecast(f analysis.CompletionForecast) string {
+	if f.OpenCount == 0 {
+		return ""
+	}
+	t := m.theme
+	gradeColor := t.Open
+	switch f.Grade {
+	case "B":
+		gradeColor = t.InProgress
+	case "C":
+		gradeColor = t.Feature
+	case "D":
+		gradeColor = t.Blocked
+	}
+	dimStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+	expectedStyle := t.Renderer.NewStyle().Foreground(gradeColor).Bold(true)
+
+	now := time.Now()
+	optimistic, expected, pessimistic := forecastDate(f.Optimistic, now), forecastDate(f.Expected, now), forecastDate(f.Pessimistic, now)
+	if optimistic == pessimistic {
+		return m.theme.Glyph(" 📅 ") + expectedStyle.Render(expected) + dimStyle.Render(" "+f.Grade)
+	}
+	return m.theme.Glyph(" 📅 ") + dimStyle.Render(optimistic+m.theme.Glyph("·")) + expectedStyle.Render(expected) + dimStyle.Render(m.theme.Glyph("·")+pessimistic+" "+f.Grade)
+}
+
+// forecastDate formats a forecast date as "today", "Mar 5", or "Mar 5 2027"
+// outside the current year
+func forecastDate(d, now time.Time) string {
+	switch {
+	case d.Year() == now.Year() && d.YearDay() == now.YearDay():
+		return "today"
+	case d.Year() != now.Year():
+		return d.Format("Jan 2 2006")
+	}
+	return d.Format("Jan 2")
+}
+
+// formatEffortMinutes renders an effort in minutes as "45m", "2.5h" or "12h".
+func formatEffortMinutes(minutes int) string {
+	switch {
+	case minutes < 60:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes < 600 && minutes%60 != 0:
+		return fmt.Sprintf("%.1fh", float64(minutes)/60)
+	default:
+		return fmt.Sprintf("%dh", (minutes+30)/60)
+	}
 }
 
 // renderGroupedView renders the grouped view with workstream-like styling
@@ -757,13 +959,17 @@ func (m *LensDashboardModel) renderGroupedView(contentWidth, visibleLines int, s
 		isHeaderSelected := gIdx == m.groupedCursor && m.groupedSubCursor < 0 && m.groupedIssueCursor < 0
 		isExpanded := m.groupedExpanded[gIdx]
 
-		// Group header with progress
+		// Group header with progress, in the group's accent color
+		accent := WorkstreamColor(group.ID)
 		progressPct := int(group.Progress * 100)
-		progressBar := m.renderMiniProgressBar(group.Progress, 8)
+		progressBar := m.renderAccentProgressBar(group.Progress, 8, accent)
 
 		// Status counts
-		statusCounts := fmt.Sprintf("○%d ●%d ◈%d ✓%d",
+		statusCounts := fmt.Sprintf(m.theme.Glyph("○%d ●%d ◈%d ✓%d"),
 			group.ReadyCount, group.InProgressCount, group.BlockedCount, group.ClosedCount)
+		if effort := workstreamEffortLabel(group); effort != "" {
+			statusCounts += " " + m.theme.Glyph(effort)
+		}
 
 		// Expand/collapse indicator
 		expandIcon := "▶"
@@ -773,10 +979,10 @@ func (m *LensDashboardModel) renderGroupedView(contentWidth, visibleLines int, s
 
 		// Selection indicator
 		selectPrefix := "  "
-		headerStyle := groupHeaderStyle
+		headerStyle := groupHeaderStyle.Foreground(accent)
 		if isHeaderSelected {
-			selectPrefix = "▸ "
-			headerStyle = groupHeaderSelectedStyle
+			selectPrefix = m.theme.Glyph("▸ ")
+			headerStyle = groupHeaderSelectedStyle.Foreground(accent)
 		}
 
 		// Sub-group indicator
@@ -785,14 +991,15 @@ func (m *LensDashboardModel) renderGroupedView(contentWidth, visibleLines int, s
 			subGroupIndicator = fmt.Sprintf(" [%d sub]", len(group.SubWorkstreams))
 		}
 
-		groupLine := fmt.Sprintf("%s%s %s %s %d%% %s (%d)%s",
+		groupLine := fmt.Sprintf("%s%s %s %s %d%% %s (%d)%s%s",
 			selectPrefix,
-			expandIcon,
+			m.theme.Glyph(expandIcon),
 			headerStyle.Render(group.Name),
 			progressBar,
 			progressPct,
 			subStyle.Render(statusCounts),
 			len(group.Issues),
+			m.renderAgeBar(group)+m.renderForecast(group.Forecast),
 			subStyle.Render(subGroupIndicator))
 		allLines = append(allLines, groupLine)
 
@@ -803,7 +1010,7 @@ func (m *LensDashboardModel) renderGroupedView(contentWidth, visibleLines int, s
 					continue
 				}
 				subProgress := int(subGroup.Progress * 100)
-				subStatusCounts := fmt.Sprintf("○%d ●%d ◈%d ✓%d",
+				subStatusCounts := fmt.Sprintf(m.theme.Glyph("○%d ●%d ◈%d ✓%d"),
 					subGroup.ReadyCount, subGroup.InProgressCount, subGroup.BlockedCount, subGroup.ClosedCount)
 
 				// Check sub-group expansion
@@ -816,15 +1023,16 @@ func (m *LensDashboardModel) renderGroupedView(contentWidth, visibleLines int, s
 				// Check if this sub-group header is selected
 				isSubHeaderSelected := gIdx == m.groupedCursor && subIdx == m.groupedSubCursor && m.groupedIssueCursor < 0
 				subSelectPrefix := "     "
-				subHeaderStyle := subStyle
+				subAccent := WorkstreamColor(subGroup.ID)
+				subHeaderStyle := subStyle.Foreground(subAccent)
 				if isSubHeaderSelected {
-					subSelectPrefix = "   ▸ "
-					subHeaderStyle = groupHeaderSelectedStyle
+					subSelectPrefix = m.theme.Glyph("   ▸ ")
+					subHeaderStyle = groupHeaderSelectedStyle.Foreground(subAccent)
 				}
 
 				subLine := fmt.Sprintf("%s%s %s (%d%%) %s (%d)",
 					subSelectPrefix,
-					subExpandIcon,
+					m.theme.Glyph(subExpandIcon),
 					subHeaderStyle.Render(subGroup.Name),
 					subProgress,
 					subStyle.Render(subStatusCounts),
@@ -941,16 +1149,16 @@ func (m *LensDashboardModel) renderGroupedIssue(issue model.Issue, isSelected bo
 	idStyle := issueStyle
 	titleStyle := issueStyle
 	if isSelected {
-		issuePrefix = indent[:len(indent)-2] + "▸ "
+		issuePrefix = indent[:len(indent)-2] + m.theme.Glyph("▸ ")
 		idStyle = issueSelectedStyle.Foreground(t.Primary)
 		titleStyle = issueSelectedStyle
 	}
 
-	title := truncateRunesHelper(issue.Title, contentWidth-20-len(indent), "…")
+	title := truncateRunesHelper(issue.Title, contentWidth-20-len(indent), m.theme.Glyph("…"))
 	return fmt.Sprintf("%s%s %s %s",
 		issuePrefix,
-		style.Render(statusIcon),
-		idStyle.Render(issue.ID),
+		style.Render(m.theme.Glyph(statusIcon)),
+		idStyle.Render(m.theme.ID(issue.ID)),
 		titleStyle.Render(title))
 }
 
@@ -1001,7 +1209,7 @@ func (m *LensDashboardModel) renderGroupedTreeIssue(fn LensFlatNode, isSelected
 		titleStyle = issueSelectedStyle.Foreground(t.Primary)
 	}
 	if isSelected {
-		issuePrefix = indent[:len(indent)-2] + "▸ "
+		issuePrefix = indent[:len(indent)-2] + m.theme.Glyph("▸ ")
 		idStyle = issueSelectedStyle.Foreground(t.Primary)
 		titleStyle = issueSelectedStyle
 	}
@@ -1018,13 +1226,13 @@ func (m *LensDashboardModel) renderGroupedTreeIssue(fn LensFlatNode, isSelected
 		epicBadge = subStyle.Render(" [EPIC]")
 	}
 
-	title := truncateRunesHelper(issue.Title, contentWidth-25-len(indent)-len(fn.TreePrefix), "…")
+	title := truncateRunesHelper(issue.Title, contentWidth-25-len(indent)-len(fn.TreePrefix), m.theme.Glyph("…"))
 	// Order matches workstream: prefix → status icon → tree prefix → ID → title → badge
 	return fmt.Sprintf("%s%s %s%s %s%s",
 		issuePrefix,
-		style.Render(statusIcon),
+		style.Render(m.theme.Glyph(statusIcon)),
 		treePrefix,
-		idStyle.Render(issue.ID),
+		idStyle.Render(m.theme.ID(issue.ID)),
 		titleStyle.Render(title),
 		epicBadge)
 }
@@ -1050,8 +1258,9 @@ func (m *LensDashboardModel) renderStatusHeader(status string) string {
 		color = t.Closed
 		label = "CLOSED"
 	default:
-		color = t.Subtext
-		label = strings.ToUpper(status)
+		// Custom statuses carry their configured color
+		color = t.GetStatusColor(status)
+		label = strings.ToUpper(strings.ReplaceAll(status, "_", " "))
 	}
 
 	// Elegant dotted divider: ┄ LABEL ┄┄┄┄┄┄
@@ -1063,7 +1272,58 @@ func (m *LensDashboardModel) renderStatusHeader(status string) string {
 		dotCount = 3
 	}
 
-	return dividerStyle.Render("┄ ") + labelStyle.Render(label) + " " + dividerStyle.Render(strings.Repeat("┄", dotCount))
+	return dividerStyle.Render(m.theme.Glyph("┄ ")) + labelStyle.Render(label) + " " + dividerStyle.Render(strings.Repeat(m.theme.Glyph("┄"), dotCount))
+}
+
+// archaeologySuffix returns the dimmed closure date shown after closed issues in
+// archaeology mode, or "" when the mode is off or the issue is still open.
+func (m *LensDashboardModel) archaeologySuffix(issue model.Issue) string {
+	if !m.archaeologyMode {
+		return ""
+	}
+	date := FormatClosureDate(issue)
+	if date == "" {
+		return ""
+	}
+	return m.theme.Renderer.NewStyle().Foreground(m.theme.Closed).Faint(true).Render(m.theme.Glyph(" ✓ ") + date)
+}
+
+// stalenessSuffix returns the days since an aging or stale open issue was last
+// updated: dimmed while aging, flagged in the warning color once stale.
+func (m *LensDashboardModel) stalenessSuffix(issue model.Issue) string {
+	age := analysis.ComputeIssueAge(issue, time.Now(), m.staleDays)
+	switch age.Staleness {
+	case analysis.Stale:
+		return m.theme.Renderer.NewStyle().Foreground(ColorWarning).Render(fmt.Sprintf(m.theme.Glyph(" ⚠ %dd"), age.DaysSinceUpdate))
+	case analysis.Aging:
+		return m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext).Faint(true).Render(fmt.Sprintf(" %dd", age.DaysSinceUpdate))
+	}
+	return ""
+}
+
+// claimSuffix returns the claim badge for a claimed issue
+func (m *LensDashboardModel) claimSuffix(issue model.Issue) string {
+	if badge := claimBadge(m.theme, m.claims[issue.ID]); badge != "" {
+		return " " + badge
+	}
+	return ""
+}
+
+// rollupSuffix shows the status an epic's children imply: dimmed when it
+// agrees with the declared status, flagged in the warning color when not
+func (m *LensDashboardModel) rollupSuffix(issue model.Issue) string {
+	r, ok := m.epicRollups[issue.ID]
+	if !ok {
+		return ""
+	}
+	derived := strings.ReplaceAll(string(r.Derived), "_", " ")
+	if r.Mismatch() {
+		declared := strings.ReplaceAll(string(r.Declared), "_", " ")
+		return m.theme.Renderer.NewStyle().Foreground(ColorWarning).Render(
+			fmt.Sprintf(m.theme.Glyph(" ⚠ %s, children %s %d/%d"), declared, derived, r.Closed, r.Children))
+	}
+	return m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext).Faint(true).Render(
+		fmt.Sprintf(m.theme.Glyph(" ⇅ %s %d/%d"), derived, r.Closed, r.Children))
 }
 
 // renderTreeNode renders a single tree node
@@ -1074,7 +1334,7 @@ func (m *LensDashboardModel) renderTreeNode(fn LensFlatNode, isSelected bool, ma
 	// Selection indicator
 	selectPrefix := "  "
 	if isSelected {
-		selectPrefix = "▸ "
+		selectPrefix = m.theme.Glyph("▸ ")
 	}
 
 	// Tree prefix (styled dimmer)
@@ -1101,14 +1361,18 @@ func (m *LensDashboardModel) renderTreeNode(fn LensFlatNode, isSelected bool, ma
 		idStyle = idStyle.Foreground(t.Base.GetForeground())
 		titleStyle = titleStyle.Foreground(t.Base.GetForeground())
 	}
+	if m.archaeologyMode && fn.Status == "closed" {
+		idStyle = idStyle.Faint(true)
+		titleStyle = titleStyle.Faint(true)
+	}
 
 	// Calculate max title length (removed bullet indicator, so less prefix)
-	prefixLen := len(selectPrefix) + len(fn.TreePrefix) + len(node.Issue.ID) + 2
+	prefixLen := len(selectPrefix) + len(fn.TreePrefix) + len(m.theme.ID(node.Issue.ID)) + 2
 	maxTitleLen := maxWidth - prefixLen
 	if maxTitleLen < 15 {
 		maxTitleLen = 15
 	}
-	title := truncateRunesHelper(node.Issue.Title, maxTitleLen, "…")
+	title := truncateRunesHelper(node.Issue.Title, maxTitleLen, m.theme.Glyph("…"))
 
 	// Entry epic badge
 	epicBadge := ""
@@ -1124,13 +1388,17 @@ func (m *LensDashboardModel) renderTreeNode(fn LensFlatNode, isSelected bool, ma
 		if len(fn.BlockedBy) > 1 {
 			blockerText += fmt.Sprintf(" +%d", len(fn.BlockedBy)-1)
 		}
-		statusSuffix = blockerStyle.Render(" ◄ " + blockerText)
+		statusSuffix = blockerStyle.Render(m.theme.Glyph(" ◄ ") + blockerText)
 	}
+	statusSuffix += m.archaeologySuffix(node.Issue)
+	statusSuffix += m.stalenessSuffix(node.Issue)
+	statusSuffix += m.claimSuffix(node.Issue)
+	statusSuffix += m.rollupSuffix(node.Issue)
 
 	return fmt.Sprintf("%s%s%s %s%s%s",
 		selectPrefix,
 		treePrefix,
-		idStyle.Render(node.Issue.ID),
+		idStyle.Render(m.theme.ID(node.Issue.ID)),
 		titleStyle.Render(title),
 		epicBadge,
 		statusSuffix)
@@ -1153,7 +1421,7 @@ func (m *LensDashboardModel) renderProgressBar(progress float64, width int) stri
 		barColor = t.Open
 	}
 
-	bar := strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+	bar := strings.Repeat(m.theme.Glyph("█"), filled) + strings.Repeat(m.theme.Glyph("░"), width-filled)
 	return t.Renderer.NewStyle().Foreground(barColor).Render("[" + bar + "]")
 }
 
@@ -1197,7 +1465,7 @@ func (m *LensDashboardModel) renderStatsHeader(contentWidth int) []string {
 
 	// === LINE 1: Title with wide progress bar ===
 	// Calculate available width for progress bar
-	titleText := modeIcon + " " + m.labelName
+	titleText := m.theme.Glyph(modeIcon) + " " + m.labelName
 	pctText := fmt.Sprintf(" %d%%", progressPct)
 	doneText := fmt.Sprintf(" %d/%d", m.closedCount, m.totalCount)
 
@@ -1228,24 +1496,30 @@ func (m *LensDashboardModel) renderStatsHeader(contentWidth int) []string {
 
 	// === LINE 2: Compact status pills with metadata ===
 	// Status counts with icons: ○12 ready  ●3 active  ◈5 blocked  ✓8 done
-	statusPills := readyStyle.Render(fmt.Sprintf("○%d", m.readyCount)) +
+	statusPills := readyStyle.Render(fmt.Sprintf(m.theme.Glyph("○%d"), m.readyCount)) +
 		statsStyle.Render(" ready  ") +
-		activeStyle.Render(fmt.Sprintf("●%d", inProgressCount)) +
+		activeStyle.Render(fmt.Sprintf(m.theme.Glyph("●%d"), inProgressCount)) +
 		statsStyle.Render(" active  ") +
-		blockedStyle.Render(fmt.Sprintf("◈%d", m.blockedCount)) +
+		blockedStyle.Render(fmt.Sprintf(m.theme.Glyph("◈%d"), m.blockedCount)) +
 		statsStyle.Render(" blocked  ") +
-		closedStyle.Render(fmt.Sprintf("✓%d", m.closedCount)) +
+		closedStyle.Render(fmt.Sprintf(m.theme.Glyph("✓%d"), m.closedCount)) +
 		statsStyle.Render(" done")
 
 	// Metadata: lens count, context count, depth
-	sep := sepStyle.Render(" │ ")
+	sep := sepStyle.Render(m.theme.Glyph(" │ "))
 	metaInfo := fmt.Sprintf("%d lens", m.primaryCount)
 	if m.contextCount > 0 {
-		metaInfo += fmt.Sprintf(" · %d ctx", m.contextCount)
+		metaInfo += fmt.Sprintf(m.theme.Glyph(" · %d ctx"), m.contextCount)
+	}
+	metaInfo += m.theme.Glyph(" · d:") + m.dependencyDepth.String()
+	if m.sectionSort != SectionSortTopo || m.sectionDesc {
+		metaInfo += m.theme.Glyph(" · by ") + FormatSectionOrder(m.sectionSort, m.sectionDesc)
 	}
-	metaInfo += " · d:" + m.dependencyDepth.String()
 
 	line2 := statusPills + sep + depthStyle.Render(metaInfo)
+	if forecast := m.renderForecast(m.forecast); forecast != "" && lipgloss.Width(line2+sep+forecast) <= contentWidth {
+		line2 += sep + forecast[1:]
+	}
 	lines = append(lines, line2)
 
 	// === LINE 3: Empty line for spacing ===
@@ -1288,15 +1562,15 @@ func (m *LensDashboardModel) renderThinProgressBar(progress float64, width int)
 	// Use thin bar characters: ━ for filled, ─ for empty, ● for bullet
 	if filled == 0 {
 		// No progress: bullet at start
-		return bulletStyle.Render("●") + emptyStyle.Render(strings.Repeat("─", width-1))
+		return bulletStyle.Render(m.theme.Glyph("●")) + emptyStyle.Render(strings.Repeat(m.theme.Glyph("─"), width-1))
 	} else if filled >= width {
 		// Complete: all filled with bullet at end
-		return filledStyle.Render(strings.Repeat("━", width-1)) + bulletStyle.Render("●")
+		return filledStyle.Render(strings.Repeat(m.theme.Glyph("━"), width-1)) + bulletStyle.Render(m.theme.Glyph("●"))
 	} else {
 		// Partial: filled portion, bullet, then empty
-		filledBar := strings.Repeat("━", filled-1)
-		emptyBar := strings.Repeat("─", width-filled)
-		return filledStyle.Render(filledBar) + bulletStyle.Render("●") + emptyStyle.Render(emptyBar)
+		filledBar := strings.Repeat(m.theme.Glyph("━"), filled-1)
+		emptyBar := strings.Repeat(m.theme.Glyph("─"), width-filled)
+		return filledStyle.Render(filledBar) + bulletStyle.Render(m.theme.Glyph("●")) + emptyStyle.Render(emptyBar)
 	}
 }
 
@@ -1342,10 +1616,10 @@ func (m *LensDashboardModel) renderCompactStatsHeader(contentWidth int) []string
 	lines = append(lines, line1)
 
 	// === LINE 2: Compact status pills ===
-	statusPills := readyStyle.Render(fmt.Sprintf("○%d", m.readyCount)) + " " +
-		activeStyle.Render(fmt.Sprintf("●%d", inProgressCount)) + " " +
-		blockedStyle.Render(fmt.Sprintf("◈%d", m.blockedCount)) + " " +
-		closedStyle.Render(fmt.Sprintf("✓%d", m.closedCount)) + "  " +
+	statusPills := readyStyle.Render(fmt.Sprintf(m.theme.Glyph("○%d"), m.readyCount)) + " " +
+		activeStyle.Render(fmt.Sprintf(m.theme.Glyph("●%d"), inProgressCount)) + " " +
+		blockedStyle.Render(fmt.Sprintf(m.theme.Glyph("◈%d"), m.blockedCount)) + " " +
+		closedStyle.Render(fmt.Sprintf(m.theme.Glyph("✓%d"), m.closedCount)) + "  " +
 		statsStyle.Render(fmt.Sprintf("%d lens", m.primaryCount)) + " " +
 		depthStyle.Render("d:"+m.dependencyDepth.String())
 
@@ -1354,9 +1628,8 @@ func (m *LensDashboardModel) renderCompactStatsHeader(contentWidth int) []string
 	return lines
 }
 
-// renderKeybindBar renders a two-line keybind info bar:
-// Line 1: Global keybinds (navigation, scope) with view mode label
-// Line 2: Mode-specific keybinds (view toggles, mode nav, external views)
+// renderKeybindBar renders the one-line footer: the view mode, the keys that
+// switch it, and ? for every other key (the help overlay, from the keymap)
 func (m *LensDashboardModel) renderKeybindBar() string {
 	t := m.theme
 
@@ -1373,7 +1646,7 @@ func (m *LensDashboardModel) renderKeybindBar() string {
 		return keyStyle.Render(key) + descStyle.Render(":"+desc)
 	}
 
-	// View mode indicator (used in both lines)
+	// View mode indicator
 	var viewMode string
 	switch {
 	case m.viewType == ViewTypeWorkstream && len(m.workstreams) > 1:
@@ -1383,28 +1656,10 @@ func (m *LensDashboardModel) renderKeybindBar() string {
 	default:
 		viewMode = "flat"
 	}
-
-	// ══════════════════════════════════════════════════════════════════════
-	// LINE 1: Global keybinds (always the same regardless of view mode)
-	// ══════════════════════════════════════════════════════════════════════
-
-	// Navigation
-	nav := k("j/k", "nav") + " " + k("u/d", "top/bottom") + " " + k("ctrl+d/u", "page")
-
-	// Core options
-	var core string
-	if len(m.scopeLabels) > 0 {
-		core = k("/", "search") + " " + k("t", "depth") + " " + k("s", "scope") + " " + k("S", "mode")
-	} else {
-		core = k("/", "search") + " " + k("t", "depth") + " " + k("s", "scope")
+	if m.archaeologyMode {
+		viewMode += m.theme.Glyph(" ⛏")
 	}
 
-	line1 := modeStyle.Render(viewMode) + sep + nav + sep + core
-
-	// ══════════════════════════════════════════════════════════════════════
-	// LINE 2: Mode-specific keybinds
-	// ══════════════════════════════════════════════════════════════════════
-
 	// View toggles (mode-dependent)
 	var viewToggles string
 	switch {
@@ -1416,35 +1671,13 @@ func (m *LensDashboardModel) renderKeybindBar() string {
 		viewToggles = k("w", "streams") + " " + k("g", "group")
 	}
 
-	// Mode-specific navigation
-	var modeNav string
-	switch {
-	case m.viewType == ViewTypeWorkstream && len(m.workstreams) > 1:
-		modeNav = k("[/]", "stream") + " " + k("T", "tree") + " " + k("z/Z", "expand/collapse")
-	case m.viewType == ViewTypeGrouped && len(m.groupedSections) > 0:
-		modeNav = k("[/]", "group") + " " + k("T", "tree") + " " + k("z/Z", "expand/collapse")
-	case m.viewMode == "epic" || m.viewMode == "bead":
-		modeNav = "" // Centered mode has no extra nav
-	default:
-		modeNav = k("[/]", "section")
-	}
-
-	// External views (only in flat view)
-	var extViews string
-	if m.viewType == ViewTypeFlat {
-		extViews = k("G", "graph") + " " + k("I", "insights") + " " + k("B", "board")
+	keys := k("/", "search")
+	if len(m.FilterPills()) > 0 {
+		keys += " " + k("x", "filters")
 	}
+	keys += " " + k("?", "all keys") + " " + k("esc", "back")
 
-	// Build line 2
-	line2 := viewToggles
-	if modeNav != "" {
-		line2 += sep + modeNav
-	}
-	if extViews != "" {
-		line2 += sep + extViews
-	}
-
-	return line1 + "\n" + line2
+	return modeStyle.Render(viewMode) + sep + viewToggles + sep + keys
 }
 
 // DumpToFile writes workstream information to a text file
@@ -1503,6 +1736,10 @@ func (m *LensDashboardModel) dumpWorkstreamTree(ws *analysis.Workstream, indent
 		prefix, ws.ID, ws.Name, len(ws.Issues), int(ws.Progress*100)))
 	buf.WriteString(fmt.Sprintf("%s  Ready: %d, Blocked: %d, In Progress: %d, Closed: %d\n",
 		prefix, ws.ReadyCount, ws.BlockedCount, ws.InProgressCount, ws.ClosedCount))
+	if ws.RemainingMinutes > 0 {
+		buf.WriteString(fmt.Sprintf("%s  Remaining: %s, earliest finish: %s (%d unestimated)\n",
+			prefix, formatEffortMinutes(ws.RemainingMinutes), formatEffortMinutes(ws.FinishMinutes), ws.UnestimatedCount))
+	}
 
 	if ws.GroupedBy != "" {
 		buf.WriteString(fmt.Sprintf("%s  Grouped by: %s\n", prefix, ws.GroupedBy))
@@ -1572,6 +1809,56 @@ func (m *LensDashboardModel) dumpFlatByDepth() string {
 
 const LensSplitViewThreshold = 120 // Minimum width for split view
 
+// LensSplitViewMinWidth is the narrowest terminal v can open the detail panel on
+const LensSplitViewMinWidth = 80
+
+// lensDetailMode is the user's choice for the detail panel, toggled with v
+type lensDetailMode int
+
+const (
+	lensDetailAuto   lensDetailMode = iota // Shown from LensSplitViewThreshold columns
+	lensDetailShown                        // Shown from LensSplitViewMinWidth columns
+	lensDetailHidden                       // Never shown
+)
+
+// updateSplitView decides whether the detail panel is wanted and fits
+func (m *LensDashboardModel) updateSplitView() {
+	switch m.detailMode {
+	case lensDetailHidden:
+		m.splitViewMode = false
+	case lensDetailShown:
+		m.splitViewMode = m.width >= LensSplitViewMinWidth
+	default:
+		m.splitViewMode = m.width >= LensSplitViewThreshold
+	}
+	if !m.splitViewMode {
+		m.detailFocus = false
+	}
+}
+
+// ToggleDetailPanel shows or hides the detail panel and reports whether it
+// is now shown (it stays hidden below LensSplitViewMinWidth columns)
+func (m *LensDashboardModel) ToggleDetailPanel() bool {
+	if m.splitViewMode {
+		m.detailMode = lensDetailHidden
+	} else {
+		m.detailMode = lensDetailShown
+	}
+	m.updateSplitView()
+	return m.splitViewMode
+}
+
+// DetailMode returns the user's choice for the detail panel
+func (m *LensDashboardModel) DetailMode() lensDetailMode {
+	return m.detailMode
+}
+
+// SetDetailMode carries a detail panel choice over from another dashboard
+func (m *LensDashboardModel) SetDetailMode(mode lensDetailMode) {
+	m.detailMode = mode
+	m.updateSplitView()
+}
+
 // initDetailViewport initializes the detail viewport for split view
 func (m *LensDashboardModel) initDetailViewport() {
 	m.detailViewport = viewport.New(40, 20)
@@ -1666,13 +1953,24 @@ func (m *LensDashboardModel) renderIssueDetail(issue *model.Issue) string {
 	valueStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
 
 	sb.WriteString(labelStyle.Render("ID:       "))
-	sb.WriteString(valueStyle.Render(issue.ID))
+	sb.WriteString(valueStyle.Render(m.theme.ID(issue.ID)))
 	sb.WriteString("\n")
 
 	sb.WriteString(labelStyle.Render("Status:   "))
 	sb.WriteString(RenderStatusBadge(string(issue.Status)))
 	sb.WriteString("\n")
 
+	if r, ok := m.epicRollups[issue.ID]; ok {
+		sb.WriteString(labelStyle.Render("Rollup:   "))
+		sb.WriteString(RenderStatusBadge(string(r.Derived)))
+		sb.WriteString(valueStyle.Render(fmt.Sprintf(" from %d children: %d closed, %d in progress, %d blocked",
+			r.Children, r.Closed, r.InProgress, r.Blocked)))
+		if r.Mismatch() {
+			sb.WriteString(t.Renderer.NewStyle().Foreground(ColorWarning).Render(m.theme.Glyph(" ⚠ differs from status")))
+		}
+		sb.WriteString("\n")
+	}
+
 	sb.WriteString(labelStyle.Render("Priority: "))
 	sb.WriteString(RenderPriorityBadge(issue.Priority))
 	sb.WriteString("\n")
@@ -1687,6 +1985,12 @@ func (m *LensDashboardModel) renderIssueDetail(issue *model.Issue) string {
 		sb.WriteString("\n")
 	}
 
+	if cs := m.claims[issue.ID]; len(cs) > 0 {
+		sb.WriteString(labelStyle.Render("Claimed:  "))
+		sb.WriteString(valueStyle.Render(describeClaims(cs)))
+		sb.WriteString("\n")
+	}
+
 	sb.WriteString(labelStyle.Render("Created:  "))
 	sb.WriteString(valueStyle.Render(issue.CreatedAt.Format("2006-01-02 15:04")))
 	sb.WriteString("\n")
@@ -1701,7 +2005,7 @@ func (m *LensDashboardModel) renderIssueDetail(issue *model.Issue) string {
 	if len(issue.Labels) > 0 {
 		sb.WriteString("\n")
 		sectionStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
-		sb.WriteString(sectionStyle.Render("🏷 Labels"))
+		sb.WriteString(sectionStyle.Render(m.theme.Glyph("🏷 Labels")))
 		sb.WriteString("\n")
 
 		chipStyle := t.Renderer.NewStyle().Foreground(t.Primary)
@@ -1719,12 +2023,12 @@ func (m *LensDashboardModel) renderIssueDetail(issue *model.Issue) string {
 	if len(blockers) > 0 || len(dependents) > 0 {
 		sb.WriteString("\n")
 		sectionStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
-		sb.WriteString(sectionStyle.Render("🔗 Dependencies"))
+		sb.WriteString(sectionStyle.Render(m.theme.Glyph("🔗 Dependencies")))
 		sb.WriteString("\n")
 
 		if len(blockers) > 0 {
 			blockerStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
-			sb.WriteString(blockerStyle.Render(fmt.Sprintf("  ↓ Blocked by (%d):", len(blockers))))
+			sb.WriteString(blockerStyle.Render(fmt.Sprintf(m.theme.Glyph("  ↓ Blocked by (%d):"), len(blockers))))
 			sb.WriteString("\n")
 			for _, blockerID := range blockers {
 				if blocker, ok := m.issueMap[blockerID]; ok {
@@ -1739,7 +2043,7 @@ func (m *LensDashboardModel) renderIssueDetail(issue *model.Issue) string {
 
 		if len(dependents) > 0 {
 			dependentStyle := t.Renderer.NewStyle().Foreground(t.Open)
-			sb.WriteString(dependentStyle.Render(fmt.Sprintf("  ↑ Blocks (%d):", len(dependents))))
+			sb.WriteString(dependentStyle.Render(fmt.Sprintf(m.theme.Glyph("  ↑ Blocks (%d):"), len(dependents))))
 			sb.WriteString("\n")
 			for _, depID := range dependents {
 				if dep, ok := m.issueMap[depID]; ok {
@@ -1757,9 +2061,9 @@ func (m *LensDashboardModel) renderIssueDetail(issue *model.Issue) string {
 	if issue.Description != "" {
 		sb.WriteString("\n")
 		sectionStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
-		sb.WriteString(sectionStyle.Render("📝 Description"))
+		sb.WriteString(sectionStyle.Render(m.theme.Glyph("📝 Description")))
 		sb.WriteString("\n\n")
-		sb.WriteString(issue.Description)
+		sb.WriteString(m.detailMarkdown(issue.Description))
 		sb.WriteString("\n")
 	}
 
@@ -1767,9 +2071,9 @@ func (m *LensDashboardModel) renderIssueDetail(issue *model.Issue) string {
 	if issue.Design != "" {
 		sb.WriteString("\n")
 		sectionStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
-		sb.WriteString(sectionStyle.Render("🎨 Design"))
+		sb.WriteString(sectionStyle.Render(m.theme.Glyph("🎨 Design")))
 		sb.WriteString("\n\n")
-		sb.WriteString(issue.Design)
+		sb.WriteString(m.detailMarkdown(issue.Design))
 		sb.WriteString("\n")
 	}
 
@@ -1777,9 +2081,9 @@ func (m *LensDashboardModel) renderIssueDetail(issue *model.Issue) string {
 	if issue.AcceptanceCriteria != "" {
 		sb.WriteString("\n")
 		sectionStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
-		sb.WriteString(sectionStyle.Render("✅ Acceptance Criteria"))
+		sb.WriteString(sectionStyle.Render(m.theme.Glyph("✅ Acceptance Criteria")))
 		sb.WriteString("\n\n")
-		sb.WriteString(issue.AcceptanceCriteria)
+		sb.WriteString(m.detailMarkdown(issue.AcceptanceCriteria))
 		sb.WriteString("\n")
 	}
 
@@ -1787,29 +2091,43 @@ func (m *LensDashboardModel) renderIssueDetail(issue *model.Issue) string {
 	if issue.Notes != "" {
 		sb.WriteString("\n")
 		sectionStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
-		sb.WriteString(sectionStyle.Render("📋 Notes"))
+		sb.WriteString(sectionStyle.Render(m.theme.Glyph("📋 Notes")))
 		sb.WriteString("\n\n")
-		sb.WriteString(issue.Notes)
+		sb.WriteString(m.detailMarkdown(issue.Notes))
 		sb.WriteString("\n")
 	}
 
+	// Comments
+	if len(issue.Comments) > 0 {
+		sb.WriteString("\n")
+		sectionStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
+		sb.WriteString(sectionStyle.Render(fmt.Sprintf(m.theme.Glyph("💬 Comments (%d)"), len(issue.Comments))))
+		sb.WriteString("\n")
+		for _, comment := range issue.Comments {
+			sb.WriteString("\n")
+			sb.WriteString(labelStyle.Render(fmt.Sprintf(m.theme.Glyph("%s · %s"), comment.Author, FormatTimeRel(comment.CreatedAt))))
+			sb.WriteString("\n")
+			sb.WriteString(m.detailMarkdown(comment.Text))
+			sb.WriteString("\n")
+		}
+	}
+
 	return sb.String()
 }
 
+// detailMarkdown renders issue text as markdown at the detail panel's width
+func (m *LensDashboardModel) detailMarkdown(text string) string {
+	_, right := m.splitPanelWidths()
+	if m.markdown == nil {
+		m.markdown = NewMarkdownRendererWithTheme(right-4, m.theme)
+	}
+	return m.markdown.RenderPanel(text, right-4)
+}
+
 // renderSplitView renders the split layout with tree on left and detail on right
 func (m *LensDashboardModel) renderSplitView() string {
 	t := m.theme
-
-	// Calculate panel widths (45% tree, 55% detail)
-	leftWidth := (m.width * 45) / 100
-	rightWidth := m.width - leftWidth - 1 // 1 for separator
-
-	if leftWidth < 40 {
-		leftWidth = 40
-	}
-	if rightWidth < 30 {
-		rightWidth = 30
-	}
+	leftWidth, rightWidth := m.splitPanelWidths()
 
 	// Panel styles based on focus
 	var leftStyle, rightStyle lipgloss.Style
@@ -1820,26 +2138,26 @@ func (m *LensDashboardModel) renderSplitView() string {
 	panelHeight := m.height
 	if m.detailFocus {
 		leftStyle = t.Renderer.NewStyle().
-			Border(lipgloss.RoundedBorder()).
+			Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 			BorderForeground(borderColor).
 			Width(leftWidth - 2).
 			Height(panelHeight).
 			MaxHeight(panelHeight)
 		rightStyle = t.Renderer.NewStyle().
-			Border(lipgloss.RoundedBorder()).
+			Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 			BorderForeground(focusBorderColor).
 			Width(rightWidth - 2).
 			Height(panelHeight).
 			MaxHeight(panelHeight)
 	} else {
 		leftStyle = t.Renderer.NewStyle().
-			Border(lipgloss.RoundedBorder()).
+			Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 			BorderForeground(focusBorderColor).
 			Width(leftWidth - 2).
 			Height(panelHeight).
 			MaxHeight(panelHeight)
 		rightStyle = t.Renderer.NewStyle().
-			Border(lipgloss.RoundedBorder()).
+			Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 			BorderForeground(borderColor).
 			Width(rightWidth - 2).
 			Height(panelHeight).
@@ -1856,11 +2174,11 @@ func (m *LensDashboardModel) renderSplitView() string {
 	rightContent := m.detailViewport.View()
 
 	// Add panel headers
-	leftHeader := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary).Render("◆ " + m.labelName)
-	rightHeader := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary).Render("📋 Details")
+	leftHeader := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary).Render(m.theme.Glyph("◆ ") + m.labelName)
+	rightHeader := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary).Render(m.theme.Glyph("📋 Details"))
 
 	if m.detailFocus {
-		rightHeader = t.Renderer.NewStyle().Bold(true).Foreground(t.Primary).Render("📋 Details")
+		rightHeader = t.Renderer.NewStyle().Bold(true).Foreground(t.Primary).Render(m.theme.Glyph("📋 Details"))
 	}
 
 	leftPanel := lipgloss.JoinVertical(lipgloss.Left, leftHeader, leftContent)
@@ -1874,30 +2192,69 @@ func (m *LensDashboardModel) renderSplitView() string {
 	return lipgloss.JoinHorizontal(lipgloss.Top, leftView, rightView)
 }
 
+// splitPanelWidths returns the outer widths of the tree and detail panels
+func (m *LensDashboardModel) splitPanelWidths() (left, right int) {
+	// 45% tree, 55% detail
+	left = (m.width * 45) / 100
+	if left < 40 {
+		left = 40
+	}
+	right = m.width - left - 1 // 1 for separator
+	if right < 30 {
+		right = 30
+	}
+	return left, right
+}
+
 // renderTreeContent renders just the tree portion for split view
 func (m *LensDashboardModel) renderTreeContent(contentWidth int) string {
+	lines := m.renderTreeContentHeader(contentWidth)
+
+	// statsStyle needed for view renders below
+	statsStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
+
+	// Calculate visible area
+	visibleLines := m.height - 10
+	if visibleLines < 5 {
+		visibleLines = 5
+	}
+
+	// Render based on view type
+	if m.viewType == ViewTypeGrouped && len(m.groupedSections) > 0 {
+		lines = append(lines, m.renderGroupedView(contentWidth, visibleLines, statsStyle)...)
+	} else if m.viewType == ViewTypeWorkstream && len(m.workstreams) > 1 {
+		lines = append(lines, m.renderWorkstreamView(contentWidth, visibleLines, statsStyle)...)
+	} else if (m.viewMode == "epic" || m.viewMode == "bead") && m.egoNode != nil {
+		lines = append(lines, m.renderCenteredView(contentWidth, visibleLines, statsStyle)...)
+	} else {
+		// Render flat tree view (reuse the main render function)
+		flatLines := m.renderFlatView(contentWidth, visibleLines, statsStyle)
+		lines = append(lines, flatLines...)
+	}
+
+	// Add sticky keybind bar at bottom
+	lines = append(lines, m.renderKeybindBar())
+
+	return strings.Join(lines, "\n")
+}
+
+// renderTreeContentHeader renders the lines above the tree in split view,
+// ending with the blank spacer line
+func (m *LensDashboardModel) renderTreeContentHeader(contentWidth int) []string {
 	t := m.theme
 	var lines []string
 
 	// Render compact stats header for split view
 	lines = append(lines, m.renderCompactStatsHeader(contentWidth)...)
 
-	// statsStyle needed for view renders below
-	statsStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
-
-	// Scope indicator (if scope is active)
-	if len(m.scopeLabels) > 0 {
-		scopeStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
-		modeStyle := t.Renderer.NewStyle().Foreground(t.InProgress)
-		tagStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	// Path of lenses drilled through
+	if crumbs := m.renderBreadcrumbs(contentWidth); crumbs != "" {
+		lines = append(lines, crumbs)
+	}
 
-		var tags []string
-		for _, label := range m.scopeLabels {
-			tags = append(tags, tagStyle.Render("["+label+"]"))
-		}
-		modeIndicator := m.scopeMode.ShortString()
-		scopeLine := scopeStyle.Render("Scope: ") + strings.Join(tags, " ") + "  " + modeStyle.Render(modeIndicator)
-		lines = append(lines, scopeLine)
+	// Active filters as dismissible pills
+	if pills := m.renderFilterPills(contentWidth); pills != "" {
+		lines = append(lines, pills)
 	}
 
 	// Scope input field (inline, appears when adding scope)
@@ -1906,7 +2263,7 @@ func (m *LensDashboardModel) renderTreeContent(contentWidth int) string {
 		promptStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
 		hintStyle := t.Renderer.NewStyle().Faint(true)
 
-		inputLine := promptStyle.Render("+ Scope: ") + inputStyle.Render(m.scopeInput) + inputStyle.Render("█")
+		inputLine := promptStyle.Render("+ Scope: ") + inputStyle.Render(m.scopeInput) + inputStyle.Render(m.theme.Glyph("█"))
 		lines = append(lines, inputLine)
 
 		// Show matching labels
@@ -1927,7 +2284,7 @@ func (m *LensDashboardModel) renderTreeContent(contentWidth int) string {
 				if maxLen > 0 && len(matchText) > maxLen {
 					matchText = matchText[:maxLen-3] + "..."
 				}
-				lines = append(lines, hintStyle.Render("  → "+matchText))
+				lines = append(lines, hintStyle.Render(m.theme.Glyph("  → ")+matchText))
 			}
 		}
 	}
@@ -1947,33 +2304,10 @@ func (m *LensDashboardModel) renderTreeContent(contentWidth int) string {
 		}
 
 		countText := countStyle.Render(fmt.Sprintf(" (%d matches)", visibleCount))
-		searchLine := promptStyle.Render("/") + inputStyle.Render(m.fuzzyInput) + inputStyle.Render("█") + countText
+		searchLine := promptStyle.Render("/") + inputStyle.Render(m.fuzzyInput) + inputStyle.Render(m.theme.Glyph("█")) + countText
 		lines = append(lines, searchLine)
 	}
 
 	lines = append(lines, "")
-
-	// Calculate visible area
-	visibleLines := m.height - 10
-	if visibleLines < 5 {
-		visibleLines = 5
-	}
-
-	// Render based on view type
-	if m.viewType == ViewTypeGrouped && len(m.groupedSections) > 0 {
-		lines = append(lines, m.renderGroupedView(contentWidth, visibleLines, statsStyle)...)
-	} else if m.viewType == ViewTypeWorkstream && len(m.workstreams) > 1 {
-		lines = append(lines, m.renderWorkstreamView(contentWidth, visibleLines, statsStyle)...)
-	} else if (m.viewMode == "epic" || m.viewMode == "bead") && m.egoNode != nil {
-		lines = append(lines, m.renderCenteredView(contentWidth, visibleLines, statsStyle)...)
-	} else {
-		// Render flat tree view (reuse the main render function)
-		flatLines := m.renderFlatView(contentWidth, visibleLines, statsStyle)
-		lines = append(lines, flatLines...)
-	}
-
-	// Add sticky keybind bar at bottom
-	lines = append(lines, m.renderKeybindBar())
-
-	return strings.Join(lines, "\n")
+	return lines
 }
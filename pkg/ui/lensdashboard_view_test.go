@@ -0,0 +1,395 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestWorkstreamEffortLabel(t *testing.T) {
+	tests := []struct {
+		ws   analysis.Workstream
+		want string
+	}{
+		{analysis.Workstream{}, ""},
+		{analysis.Workstream{RemainingMinutes: 45, FinishMinutes: 45}, "⏱45m"},
+		{analysis.Workstream{RemainingMinutes: 150, FinishMinutes: 90}, "⏱2.5h (1.5h path)"},
+		{analysis.Workstream{RemainingMinutes: 720, FinishMinutes: 240, UnestimatedCount: 2}, "⏱~12h (4h path)"},
+	}
+	for _, tt := range tests {
+		if got := workstreamEffortLabel(tt.ws); got != tt.want {
+			t.Errorf("workstreamEffortLabel(%d/%d) = %q, want %q", tt.ws.RemainingMinutes, tt.ws.FinishMinutes, got, tt.want)
+		}
+	}
+}
+
+func TestWorkstreamColorIsStable(t *testing.T) {
+	if WorkstreamColor("phase-1") != WorkstreamColor("phase-1") {
+		t.Fatal("same ID should always map to the same color")
+	}
+	seen := make(map[string]bool)
+	for _, id := range []string{"phase-1", "phase-2", "phase-3", "group:api", "group:ui", "bv-42"} {
+		c := WorkstreamColor(id)
+		if c.Light == "" || c.Dark == "" {
+			t.Errorf("WorkstreamColor(%q) returned an incomplete color %+v", id, c)
+		}
+		seen[c.Dark] = true
+	}
+	if len(seen) < 3 {
+		t.Errorf("expected workstreams to spread across the palette, got %d distinct colors", len(seen))
+	}
+}
+
+func TestLensDashboardFilterPills(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, Labels: []string{"test", "ui"}},
+		{ID: "b", Status: model.StatusOpen, Labels: []string{"test", "api"}},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	m := NewLensDashboardModel("test", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	m.SetSize(100, 30)
+
+	if _, ok := m.CyclePillFocus(); ok {
+		t.Fatal("no filters, nothing to focus")
+	}
+
+	m.AddScopeLabel("ui")
+	m.AddScopeLabel("api")
+	m.SetArchaeologyMode(true)
+	if view := m.View(); !strings.Contains(view, "Filters:") || !strings.Contains(view, "#api") || !strings.Contains(view, "⛏ closed") {
+		t.Errorf("header missing pills:\n%s", view)
+	}
+
+	// x walks the pills, then back to none
+	for _, want := range []string{"#ui", "#api", "⛏ closed"} {
+		if pill, ok := m.CyclePillFocus(); !ok || pill.Text != want {
+			t.Fatalf("focused %q (ok=%v), want %q", pill.Text, ok, want)
+		}
+	}
+	if _, ok := m.CyclePillFocus(); ok || m.HasPillFocus() {
+		t.Fatal("focus should wrap to none after the last pill")
+	}
+
+	// Removing the second pill leaves focus on the one sliding into place
+	m.CyclePillFocus()
+	m.CyclePillFocus()
+	if pill, ok := m.RemoveFocusedPill(); !ok || pill.Value != "api" {
+		t.Fatalf("removed %+v, want api", pill)
+	}
+	if got := m.GetScopeLabels(); len(got) != 1 || got[0] != "ui" {
+		t.Errorf("scope = %v, want [ui]", got)
+	}
+	if pill, ok := m.RemoveFocusedPill(); !ok || pill.Kind != pillArchaeology || m.IsArchaeologyMode() {
+		t.Errorf("removed %+v, want archaeology off", pill)
+	}
+	if m.HasPillFocus() {
+		t.Error("focus should drop once the last pill in line is gone")
+	}
+}
+
+func TestLensDashboardQuickFilterPills(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Title: "Login form", Status: model.StatusOpen, IssueType: model.TypeTask, Labels: []string{"test"}},
+		{ID: "b", Title: "Login crash", Status: model.StatusOpen, IssueType: model.TypeBug, Labels: []string{"test"}},
+		{ID: "c", Title: "Logout", Status: model.StatusClosed, IssueType: model.TypeBug, Labels: []string{"test"}},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	m := NewLensDashboardModel("test", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	m.SetSize(100, 30)
+	shown := func() []string {
+		var ids []string
+		for _, issue := range m.GetAllDisplayIssues() {
+			ids = append(ids, issue.ID)
+		}
+		slices.Sort(ids)
+		return ids
+	}
+
+	if got := m.CycleStatusFilter(); got != model.StatusOpen {
+		t.Fatalf("first status filter = %q, want open", got)
+	}
+	if got := m.CycleTypeFilter(); got != model.TypeBug {
+		t.Fatalf("first type filter = %q, want bug", got)
+	}
+	if got := shown(); !slices.Equal(got, []string{"b"}) {
+		t.Errorf("open bugs shown = %v, want [b]", got)
+	}
+
+	// tab in a search keeps the query as a pill after the others
+	m.SetStatusFilter("")
+	m.OpenFuzzySearch()
+	for _, k := range []string{"L", "o", "g", "o", "u", "t", "tab"} {
+		m.HandleFuzzySearchKey(k)
+	}
+	if m.ShowFuzzySearch() || !slices.Equal(shown(), []string{"c"}) {
+		t.Errorf("kept search: open=%v shown=%v, want [c]", m.ShowFuzzySearch(), shown())
+	}
+	var texts []string
+	for _, pill := range m.FilterPills() {
+		texts = append(texts, pill.Text)
+	}
+	if want := []string{"type:bug", "/Logout"}; !slices.Equal(texts, want) {
+		t.Errorf("pills = %q, want %q", texts, want)
+	}
+	if view := m.View(); !strings.Contains(view, "type:bug") || !strings.Contains(view, "/Logout") {
+		t.Errorf("header missing pills:\n%s", view)
+	}
+
+	// enter on each pill clears its filter
+	m.CyclePillFocus()
+	m.RemoveFocusedPill()
+	m.RemoveFocusedPill()
+	if len(m.FilterPills()) != 0 || !slices.Equal(shown(), []string{"a", "b", "c"}) {
+		t.Errorf("after removing the pills: pills=%v shown=%v", m.FilterPills(), shown())
+	}
+}
+
+func TestLensDashboardCustomStatuses(t *testing.T) {
+	model.RegisterCustomStatuses([]model.CustomStatus{
+		{Name: "review", Column: model.StatusInProgress, Color: "#FFB86C"},
+		{Name: "done", Column: model.StatusClosed},
+	})
+	defer model.RegisterCustomStatuses(nil)
+
+	issues := []model.Issue{
+		{ID: "a", Title: "Ready", Status: model.StatusOpen, Labels: []string{"test"}},
+		{ID: "b", Title: "Reviewing", Status: "review", Labels: []string{"test"}},
+		{ID: "c", Title: "Shipped", Status: "done", Labels: []string{"test"}},
+		{ID: "d", Title: "Closed", Status: model.StatusClosed, Labels: []string{"test"}},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	m := NewLensDashboardModel("test", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	m.SetSize(100, 30)
+
+	if got := m.getIssueStatus(issues[1]); got != "review" {
+		t.Errorf("status of b = %q, want review", got)
+	}
+	// Custom statuses sort right after the column they map onto
+	order := []int{m.getStatusOrder(issues[0]), m.getStatusOrder(issues[1]), m.getStatusOrder(issues[3]), m.getStatusOrder(issues[2])}
+	for i := 1; i < len(order); i++ {
+		if order[i-1] >= order[i] {
+			t.Fatalf("status order = %v, want ready < review < closed < done", order)
+		}
+	}
+	// done counts toward progress like closed
+	if m.closedCount != 2 || m.readyCount != 1 {
+		t.Errorf("closed/ready = %d/%d, want 2/1", m.closedCount, m.readyCount)
+	}
+	if header := m.renderStatusHeader("review"); !strings.Contains(header, "REVIEW") {
+		t.Errorf("header = %q, want REVIEW", header)
+	}
+}
+
+func TestLensDetailPanelToggle(t *testing.T) {
+	issues := []model.Issue{{
+		ID: "bv-1", Title: "Task", Status: model.StatusOpen, Labels: []string{"api"},
+		Description: "Token refresh", AcceptanceCriteria: "No 401s",
+		Comments: []*model.Comment{{Author: "ana", Text: "Repro attached"}},
+	}}
+	m := NewModel(issues, nil, "")
+	m.width, m.height = 100, 40
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	if m.lensDashboard.IsSplitView() {
+		t.Fatal("100 columns should start without the detail panel")
+	}
+
+	m = m.handleLensDashboardKeys(keyMsg("v"))
+	if !m.lensDashboard.IsSplitView() {
+		t.Fatalf("v should show the detail panel (%q)", m.statusMsg)
+	}
+	view := stripAnsi(m.lensDashboard.View())
+	for _, want := range []string{"Token refresh", "Acceptance Criteria", "Comments (1)", "Repro attached"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("detail panel missing %q", want)
+		}
+	}
+	m = m.handleLensDashboardKeys(keyMsg("tab"))
+	if !m.lensDashboard.IsDetailFocused() {
+		t.Error("tab should focus the detail panel")
+	}
+
+	// The choice carries over to the next dashboard, and v hides it again
+	m.openLensDashboard(lensRef{Type: "bead", Value: "bv-1", Title: "Task"}, nil, ScopeModeUnion)
+	if !m.lensDashboard.IsSplitView() {
+		t.Error("the detail panel should stay shown on the next lens")
+	}
+	m = m.handleLensDashboardKeys(keyMsg("v"))
+	if m.lensDashboard.IsSplitView() || m.lensDashboard.IsDetailFocused() {
+		t.Error("v should hide the detail panel and give focus back to the tree")
+	}
+}
+
+func TestWorkstreamAgeBar(t *testing.T) {
+	tests := []struct {
+		counts []int
+		want   []int
+	}{
+		{[]int{0, 0, 0}, []int{0, 0, 0}},
+		{[]int{3, 0, 0}, []int{6, 0, 0}},
+		{[]int{1, 1, 1}, []int{2, 2, 2}},
+		{[]int{10, 1, 0}, []int{5, 1, 0}}, // The lone aging issue still shows
+		{[]int{20, 1, 1}, []int{4, 1, 1}},
+	}
+	for _, tt := range tests {
+		got := apportionCells(tt.counts, 6)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("apportionCells(%v) = %v, want %v", tt.counts, got, tt.want)
+		}
+	}
+
+	now := time.Now()
+	ws := analysis.Workstream{Issues: []model.Issue{
+		{ID: "a", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -1)},
+		{ID: "b", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -20)},
+		{ID: "c", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -90)},
+		{ID: "d", Status: model.StatusClosed, CreatedAt: now.AddDate(0, 0, -90)},
+	}}
+	if mix := workstreamAgeMix(ws, now, 14); mix != [3]int{1, 1, 1} {
+		t.Errorf("age mix = %v, want one fresh, one aging, one stale", mix)
+	}
+}
+
+func TestLensForecast(t *testing.T) {
+	now := time.Now()
+	closedAt := now.AddDate(0, 0, -3)
+	minutes := func(n int) *int { return &n }
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, Labels: []string{"api"}, EstimatedMinutes: minutes(600)},
+		{ID: "b", Status: model.StatusOpen, Labels: []string{"api"}, EstimatedMinutes: minutes(600)},
+		{ID: "c", Status: model.StatusClosed, Labels: []string{"api"}, EstimatedMinutes: minutes(600), ClosedAt: &closedAt},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	m := NewLensDashboardModel("api", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	m.SetSize(140, 30)
+
+	if m.forecast.OpenCount != 2 || m.forecast.VelocityBasis != "labels" {
+		t.Fatalf("lens forecast = %+v, want the two open api issues at the api velocity", m.forecast)
+	}
+	if header := stripAnsi(strings.Join(m.renderStatsHeader(140), "\n")); !strings.Contains(header, "📅 ") {
+		t.Errorf("expected the forecast in the header:\n%s", header)
+	}
+
+	m.SetWorkstreams(analysis.DetectWorkstreams(issues, map[string]bool{"a": true, "b": true, "c": true}, "api"))
+	for _, ws := range m.workstreams {
+		if ws.Forecast.OpenCount > 0 && ws.Forecast.Expected.IsZero() {
+			t.Errorf("workstream %s has open issues but no forecast date", ws.Name)
+		}
+	}
+
+	day := time.Date(2026, 3, 5, 9, 0, 0, 0, time.Local)
+	for d, want := range map[time.Time]string{
+		day:                   "today",
+		day.AddDate(0, 0, 2):  "Mar 7",
+		day.AddDate(1, 0, 0):  "Mar 5 2027",
+		day.AddDate(0, 0, -1): "Mar 4",
+	} {
+		if got := forecastDate(d, day); got != want {
+			t.Errorf("forecastDate(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestLensBuildOrderGrouping(t *testing.T) {
+	blockedBy := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, Labels: []string{"api"}},
+		{ID: "b", Status: model.StatusOpen, Labels: []string{"api"}, Dependencies: blockedBy("a")},
+		{ID: "c", Status: model.StatusOpen, Labels: []string{"api"}, Dependencies: blockedBy("b")},
+		{ID: "d", Status: model.StatusOpen, Labels: []string{"api"}, Dependencies: blockedBy("e")},
+		{ID: "e", Status: model.StatusOpen, Labels: []string{"api"}, Dependencies: blockedBy("d")},
+		{ID: "f", Status: model.StatusClosed, Labels: []string{"api"}},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	m := NewLensDashboardModel("api", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	m.SetSize(140, 40)
+	m.EnterGroupedView()
+	for m.GetGroupByMode() != GroupByWave {
+		m.CycleGroupByMode()
+	}
+
+	var got []string
+	for _, ws := range m.groupedSections {
+		got = append(got, fmt.Sprintf("%s %v", ws.Name, ws.IssueIDs))
+	}
+	want := []string{"Wave 1 · ready now [a]", "Wave 2 [b]", "Wave 3 [c]", "Waiting (blocked outside the lens or cyclic) [d e]", "Closed [f]"}
+	if !slices.Equal(got, want) {
+		t.Errorf("sections = %q, want %q", got, want)
+	}
+	if view := stripAnsi(m.View()); !strings.Contains(view, "Wave 2") {
+		t.Errorf("expected the waves drawn:\n%s", view)
+	}
+	m.CycleGroupByMode()
+	if m.GetGroupByMode() != GroupByLabel {
+		t.Errorf("build order should cycle back to label, got %s", m.GetGroupByMode())
+	}
+}
+
+func TestStalledWorkstreams(t *testing.T) {
+	now := time.Now()
+	daysAgo := func(d int) time.Time { return now.AddDate(0, 0, -d) }
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, Labels: []string{"api", "ui"}, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(1)},
+		{ID: "b", Status: model.StatusOpen, Labels: []string{"api", "ui"}, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(40)},
+		{ID: "c", Status: model.StatusOpen, Labels: []string{"api", "db"}, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(30)},
+		{ID: "d", Status: model.StatusOpen, Labels: []string{"api", "db"}, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(45)},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	m := NewLensDashboardModel("api", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	m.SetSize(140, 30)
+	if status := m.ToggleStalledOnly(); m.IsStalledOnly() || !strings.Contains(status, "workstream view") {
+		t.Fatalf("the filter needs the workstream view: %q", status)
+	}
+	m.ToggleViewType()
+	if len(m.workstreams) != 2 {
+		t.Fatalf("want two workstreams, got %d", len(m.workstreams))
+	}
+	for _, ws := range m.workstreams {
+		want := map[string]string{"Ui": "", "Db": " ⏸ 30d"}[ws.Name]
+		if got := stripAnsi(m.renderStallBadge(ws)); got != want {
+			t.Errorf("%s badge = %q, want %q", ws.Name, got, want)
+		}
+	}
+
+	m.ToggleStalledOnly()
+	if len(m.workstreams) != 1 || m.workstreams[0].Name != "Db" {
+		t.Fatalf("stalled only: %+v", m.workstreams)
+	}
+	if pill, ok := m.CyclePillFocus(); !ok || pill.Kind != pillStalled {
+		t.Fatalf("focused %+v, want the stalled pill", pill)
+	}
+	m.RemoveFocusedPill()
+	if m.IsStalledOnly() || len(m.workstreams) != 2 {
+		t.Errorf("removing the pill should show every stream, got %d", len(m.workstreams))
+	}
+
+	m.SetStaleDays(60)
+	if status := m.ToggleStalledOnly(); m.IsStalledOnly() || !strings.Contains(status, "No workstream") {
+		t.Errorf("nothing stalls in 60 days: %q", status)
+	}
+}
@@ -2,14 +2,19 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/claims"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/review"
 
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/sahilm/fuzzy"
 )
@@ -23,6 +28,7 @@ type LensItem struct {
 	ClosedCount  int     // closed issues
 	Progress     float64 // completion percentage
 	IsPinned     bool    // is this item pinned
+	IsRecent     bool    // opened recently (and not pinned): listed under Recent
 	OverlapCount int     // issues overlapping with scope (when scope filter is active)
 }
 
@@ -36,8 +42,11 @@ type LensSelectorModel struct {
 	issues        []model.Issue // Reference to issues for scope filtering
 
 	// Stats panel data
-	issueMap   map[string]*model.Issue // Fast lookup by ID for stats panel
-	graphStats *analysis.GraphStats    // Graph metrics for centrality display
+	issueMap   map[string]*model.Issue        // Fast lookup by ID for stats panel
+	graphStats *analysis.GraphStats           // Graph metrics for centrality display
+	centrality *centralityRanks               // Ranks of graphStats, once ranked in the background
+	spinner    spinner.Model                  // Shown in place of the ranks until then
+	coverage   map[string]review.EpicCoverage // Review coverage by epic ID, computed when first shown
 
 	// UI State
 	searchInput    textinput.Model
@@ -58,6 +67,35 @@ type LensSelectorModel struct {
 	scopeAddMode    bool // True when insert mode was triggered by 'l' (adding to scope)
 	reviewRequested bool // True when 'r' pressed (opens review mode vs normal selection)
 
+	// Lens comparison: c picks the first lens, c on another compares them
+	compareLeft      *LensItem // First lens picked, nil when not comparing
+	compareRequested bool      // True when the second lens was picked
+
+	// Saved views (w = save current scope/mode under a name, v = pick a saved view)
+	viewNames       []string // Saved view names available to the picker
+	viewNameMode    bool     // True while typing a name for the view being saved
+	viewNameInput   string   // Name typed so far
+	viewPickerMode  bool     // True while the saved views picker is open
+	viewPickerIndex int      // Selected row in the saved views picker
+	saveViewName    string   // Pending save request (consumed by TakeSaveViewRequest)
+	loadViewName    string   // Pending load request (consumed by TakeLoadViewRequest)
+
+	manageLabel string // Label e was pressed on (consumed by TakeManageLabelRequest)
+
+	// Epic scope changes since kickoff, keyed by epic ID (from .beads/epic_scope.json)
+	epicScope map[string]analysis.EpicScopeChange
+
+	// Workstream claims: epics and beads by issue ID, labels by label name
+	claims      map[string][]claims.Claim
+	labelClaims map[string][]claims.Claim
+
+	// Pinned lens values in display order (from the project config)
+	pinned      []string
+	pinsChanged bool // p toggled a pin (consumed by TakePinsRequest)
+
+	// Recently opened lenses, most recent first (from .beads/bv-recent-lenses.json)
+	recent []lensRef
+
 	// Dimensions
 	width  int
 	height int
@@ -96,7 +134,7 @@ func NewLensSelectorModel(issues []model.Issue, theme Theme, graphStats *analysi
 
 	for _, issue := range issues {
 		// Collect epics
-		if issue.IssueType == model.TypeEpic && issue.Status != model.StatusClosed {
+		if issue.IssueType == model.TypeEpic && !issue.Status.IsClosed() {
 			// Count children for epic progress using pre-built maps
 			childTotal, childClosed := countEpicChildrenWithMaps(issue.ID, childrenMap, statusMap)
 			progress := 0.0
@@ -133,7 +171,7 @@ func NewLensSelectorModel(issues []model.Issue, theme Theme, graphStats *analysi
 		for _, label := range issue.Labels {
 			counts := labelCounts[label]
 			counts.total++
-			if issue.Status == model.StatusClosed {
+			if issue.Status.IsClosed() {
 				counts.closed++
 			}
 			labelCounts[label] = counts
@@ -187,6 +225,8 @@ func NewLensSelectorModel(issues []model.Issue, theme Theme, graphStats *analysi
 		issues:        issues,
 		issueMap:      issueMap,
 		graphStats:    graphStats,
+		coverage:      make(map[string]review.EpicCoverage),
+		spinner:       spinner.New(spinner.WithSpinner(spinner.Dot), spinner.WithStyle(theme.Renderer.NewStyle().Foreground(theme.Primary))),
 		searchInput:   ti,
 		searchMode:    "merged",
 		selectedIndex: 0,
@@ -214,7 +254,7 @@ func countEpicChildrenWithMaps(epicID string, children map[string][]string, issu
 			if !visited[childID] {
 				visited[childID] = true
 				total++
-				if issueStatus[childID] == model.StatusClosed {
+				if issueStatus[childID].IsClosed() {
 					closed++
 				}
 				queue = append(queue, childID)
@@ -239,6 +279,12 @@ func (m *LensSelectorModel) SetSize(width, height int) {
 
 // Update handles input and returns whether the model changed
 func (m *LensSelectorModel) Update(key string) (handled bool) {
+	if m.viewNameMode {
+		return m.updateViewNameMode(key)
+	}
+	if m.viewPickerMode {
+		return m.updateViewPickerMode(key)
+	}
 	// Handle insert mode (all keys go to search except esc/enter)
 	if m.insertMode {
 		return m.updateInsertMode(key)
@@ -272,6 +318,8 @@ func (m *LensSelectorModel) updateInsertMode(key string) bool {
 
 			// Normal selection - confirm and close
 			m.selectedItem = &item
+			// Searching for the second lens of a comparison picked with c
+			m.compareRequested = m.compareLeft != nil
 			// Build scoped labels: all scope labels + selected label
 			if m.scopeMode && len(m.scopeLabels) > 0 && item.Type == "label" {
 				m.scopedLabels = make([]string, 0, len(m.scopeLabels)+1)
@@ -368,6 +416,49 @@ func (m *LensSelectorModel) updateNormalMode(key string) bool {
 		// Cycle search mode: merged -> epic -> label -> bead -> merged
 		m.cycleSearchMode()
 		return true
+	case "w":
+		// Save current scope and search mode as a named view
+		m.viewNameMode = true
+		m.viewNameInput = ""
+		return true
+	case "v":
+		// Open saved views picker
+		if len(m.viewNames) > 0 {
+			m.viewPickerMode = true
+			m.viewPickerIndex = 0
+		}
+		return true
+	case "c":
+		// Pick the first lens to compare, then the one to compare it with
+		if len(m.filteredItems) == 0 || m.selectedIndex >= len(m.filteredItems) {
+			return true
+		}
+		item := m.filteredItems[m.selectedIndex]
+		switch {
+		case m.compareLeft == nil:
+			m.compareLeft = &item
+		case m.compareLeft.Type == item.Type && m.compareLeft.Value == item.Value:
+			m.compareLeft = nil
+		default:
+			m.selectedItem = &item
+			m.compareRequested = true
+			m.confirmed = true
+		}
+		return true
+	case "p":
+		// Pin or unpin the selected lens
+		if len(m.filteredItems) > 0 && m.selectedIndex < len(m.filteredItems) {
+			m.togglePin(m.filteredItems[m.selectedIndex])
+		}
+		return true
+	case "e":
+		// Rename or merge the selected label
+		if len(m.filteredItems) > 0 && m.selectedIndex < len(m.filteredItems) {
+			if item := m.filteredItems[m.selectedIndex]; item.Type == "label" {
+				m.manageLabel = item.Value
+			}
+		}
+		return true
 	case "r":
 		// Open review mode for selected item
 		if len(m.filteredItems) > 0 && m.selectedIndex < len(m.filteredItems) {
@@ -391,6 +482,11 @@ func (m *LensSelectorModel) updateNormalMode(key string) bool {
 		}
 		return true
 	case "esc", "q":
+		// Drop a half-picked comparison first (esc only)
+		if key == "esc" && m.compareLeft != nil {
+			m.compareLeft = nil
+			return true
+		}
 		// If in scope mode, clear scope first (esc only)
 		if key == "esc" && m.scopeMode {
 			m.clearScope()
@@ -421,6 +517,124 @@ func (m *LensSelectorModel) updateNormalMode(key string) bool {
 	return false
 }
 
+// updateViewNameMode handles keys while naming a view to save
+func (m *LensSelectorModel) updateViewNameMode(key string) bool {
+	switch key {
+	case "esc":
+		m.viewNameMode = false
+		m.viewNameInput = ""
+	case "enter":
+		name := strings.TrimSpace(m.viewNameInput)
+		if name != "" {
+			m.saveViewName = name
+		}
+		m.viewNameMode = false
+		m.viewNameInput = ""
+	case "backspace":
+		if runes := []rune(m.viewNameInput); len(runes) > 0 {
+			m.viewNameInput = string(runes[:len(runes)-1])
+		}
+	case " ":
+		m.viewNameInput += " "
+	default:
+		if len([]rune(key)) == 1 {
+			m.viewNameInput += key
+		}
+	}
+	return true
+}
+
+// updateViewPickerMode handles keys while the saved views picker is open
+func (m *LensSelectorModel) updateViewPickerMode(key string) bool {
+	switch key {
+	case "esc", "q", "v":
+		m.viewPickerMode = false
+	case "up", "k":
+		if m.viewPickerIndex > 0 {
+			m.viewPickerIndex--
+		}
+	case "down", "j":
+		if m.viewPickerIndex < len(m.viewNames)-1 {
+			m.viewPickerIndex++
+		}
+	case "enter":
+		if m.viewPickerIndex < len(m.viewNames) {
+			m.loadViewName = m.viewNames[m.viewPickerIndex]
+		}
+		m.viewPickerMode = false
+	}
+	return true
+}
+
+// SetViewNames sets the saved view names offered by the views picker
+func (m *LensSelectorModel) SetViewNames(names []string) {
+	m.viewNames = names
+	if m.viewPickerIndex >= len(names) {
+		m.viewPickerIndex = 0
+	}
+}
+
+// TakeSaveViewRequest returns the name the user chose to save the current view under.
+// The request is cleared once taken.
+func (m *LensSelectorModel) TakeSaveViewRequest() (string, bool) {
+	name := m.saveViewName
+	m.saveViewName = ""
+	return name, name != ""
+}
+
+// TakeManageLabelRequest returns the label the user asked to rename or merge.
+// The request is cleared once taken.
+func (m *LensSelectorModel) TakeManageLabelRequest() (string, bool) {
+	label := m.manageLabel
+	m.manageLabel = ""
+	return label, label != ""
+}
+
+// TakePinsRequest returns the pinned lenses after p changed them, for the
+// owner to persist. The request is cleared once taken.
+func (m *LensSelectorModel) TakePinsRequest() ([]string, bool) {
+	if !m.pinsChanged {
+		return nil, false
+	}
+	m.pinsChanged = false
+	return append([]string(nil), m.pinned...), true
+}
+
+// TakeLoadViewRequest returns the saved view picked by the user.
+// The request is cleared once taken.
+func (m *LensSelectorModel) TakeLoadViewRequest() (string, bool) {
+	name := m.loadViewName
+	m.loadViewName = ""
+	return name, name != ""
+}
+
+// IsViewNameMode returns true while the user is typing a view name
+func (m *LensSelectorModel) IsViewNameMode() bool {
+	return m.viewNameMode
+}
+
+// ApplyView restores scope labels, scope match mode and search mode
+func (m *LensSelectorModel) ApplyView(scopeLabels []string, matchMode ScopeMode, searchMode string) {
+	switch searchMode {
+	case "merged", "epic", "label", "bead":
+		m.searchMode = searchMode
+	default:
+		m.searchMode = "merged"
+	}
+	m.searchInput.SetValue("")
+	m.insertMode = false
+	m.scopeAddMode = false
+	m.scopeMatchMode = matchMode
+	m.scopeLabels = append([]string(nil), scopeLabels...)
+	m.scopeMode = len(m.scopeLabels) > 0
+	if m.scopeMode {
+		m.filterByScope()
+	} else {
+		m.rebuildFilteredItems()
+	}
+	m.selectedIndex = 0
+}
+
 // cycleSearchMode cycles through search modes: merged -> epic -> label -> bead -> merged
 func (m *LensSelectorModel) cycleSearchMode() {
 	switch m.searchMode {
@@ -452,6 +666,155 @@ func (m *LensSelectorModel) rebuildFilteredItems() {
 		m.filteredItems = append([]LensItem{}, m.allEpics...)
 		m.filteredItems = append(m.filteredItems, m.allLabels...)
 	}
+	m.filteredItems = m.leadingSections(m.filteredItems)
+}
+
+// SetPinned marks lenses (label names, epic or issue IDs) as pinned. Pinned
+// lenses are listed first, in the given order, until the user searches.
+func (m *LensSelectorModel) SetPinned(values []string) {
+	m.pinned = values
+	for _, items := range [][]LensItem{m.allLabels, m.allEpics, m.allBeads} {
+		for i := range items {
+			items[i].IsPinned = slices.Contains(values, items[i].Value)
+		}
+	}
+	m.filterItems()
+}
+
+// togglePin pins item (appending it to the pinned section) or unpins it,
+// keeping the cursor on it as the list reorders
+func (m *LensSelectorModel) togglePin(item LensItem) {
+	pinned := slices.Clone(m.pinned)
+	if i := slices.Index(pinned, item.Value); i >= 0 {
+		pinned = slices.Delete(pinned, i, i+1)
+	} else {
+		pinned = append(pinned, item.Value)
+	}
+	m.SetPinned(pinned)
+	m.SetRecent(m.recent) // A lens leaving the pins may rejoin Recent
+	m.pinsChanged = true
+	for i, it := range m.filteredItems {
+		if it.Type == item.Type && it.Value == item.Value {
+			m.selectedIndex = i
+			break
+		}
+	}
+}
+
+// SetRecent marks the first lensRecentShown recently opened lenses that
+// aren't pinned; they're listed after the pinned ones until the user searches
+func (m *LensSelectorModel) SetRecent(refs []lensRef) {
+	m.recent = refs
+	shown := make(map[lensRef]bool)
+	for _, ref := range refs {
+		if len(shown) == lensRecentShown {
+			break
+		}
+		if slices.Contains(m.pinned, ref.Value) || !m.hasLens(ref) {
+			continue
+		}
+		shown[lensRef{Type: ref.Type, Value: ref.Value}] = true
+	}
+	for _, items := range [][]LensItem{m.allLabels, m.allEpics, m.allBeads} {
+		for i := range items {
+			items[i].IsRecent = shown[lensRef{Type: items[i].Type, Value: items[i].Value}]
+		}
+	}
+	m.filterItems()
+}
+
+// lensRecentShown caps the Recent section
+const lensRecentShown = 5
+
+// hasLens reports whether ref is one of the selector's items
+func (m *LensSelectorModel) hasLens(ref lensRef) bool {
+	for _, items := range [][]LensItem{m.allLabels, m.allEpics, m.allBeads} {
+		for _, item := range items {
+			if item.Type == ref.Type && item.Value == ref.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pinnedSectionLen is how many items at the top of the list form the Pinned
+// section: pinned lenses lead the list only while browsing without a search
+// or scope
+func (m *LensSelectorModel) pinnedSectionLen() int {
+	if !m.showsSections() {
+		return 0
+	}
+	n := 0
+	for n < len(m.filteredItems) && m.filteredItems[n].IsPinned {
+		n++
+	}
+	return n
+}
+
+// recentSectionLen is how many items after the Pinned section form the
+// Recent section
+func (m *LensSelectorModel) recentSectionLen() int {
+	if !m.showsSections() {
+		return 0
+	}
+	start := m.pinnedSectionLen()
+	n := 0
+	for start+n < len(m.filteredItems) && m.filteredItems[start+n].IsRecent {
+		n++
+	}
+	return n
+}
+
+// showsSections reports whether the list is being browsed without a search
+// or scope, so the Pinned and Recent sections lead it
+func (m *LensSelectorModel) showsSections() bool {
+	return !m.scopeMode && !m.scopeAddMode && strings.TrimSpace(m.searchInput.Value()) == ""
+}
+
+// leadingSections moves pinned items to the front in pin order, then recent
+// ones, most recent first. In merged mode, pinned and recent issues that are
+// not epics are pulled in from the bead list.
+func (m *LensSelectorModel) leadingSections(items []LensItem) []LensItem {
+	if len(m.pinned) == 0 && len(m.recent) == 0 {
+		return items
+	}
+	var pinned, recent, rest []LensItem
+	seen := make(map[string]bool)
+	for _, item := range items {
+		switch {
+		case item.IsPinned:
+			pinned = append(pinned, item)
+			seen[item.Value] = true
+		case item.IsRecent:
+			recent = append(recent, item)
+			seen[item.Value] = true
+		default:
+			rest = append(rest, item)
+		}
+	}
+	if m.searchMode == "merged" {
+		for _, item := range m.allBeads {
+			if seen[item.Value] {
+				continue
+			}
+			if item.IsPinned {
+				pinned = append(pinned, item)
+			} else if item.IsRecent {
+				recent = append(recent, item)
+			}
+		}
+	}
+	sort.SliceStable(pinned, func(i, j int) bool {
+		return slices.Index(m.pinned, pinned[i].Value) < slices.Index(m.pinned, pinned[j].Value)
+	})
+	recentIndex := func(item LensItem) int {
+		return slices.IndexFunc(m.recent, func(r lensRef) bool { return r.Type == item.Type && r.Value == item.Value })
+	}
+	sort.SliceStable(recent, func(i, j int) bool {
+		return recentIndex(recent[i]) < recentIndex(recent[j])
+	})
+	return append(append(pinned, recent...), rest...)
 }
 
 // HandleTextInput processes a text input message
@@ -979,7 +1342,11 @@ func (m *LensSelectorModel) Reset() {
 	m.insertMode = false
 	m.scopeAddMode = false
 	m.reviewRequested = false
+	m.compareLeft = nil
+	m.compareRequested = false
 	m.hasNavigated = false // Show welcome panel on reset
+	m.viewNameMode = false
+	m.viewPickerMode = false
 }
 
 // ══════════════════════════════════════════════════════════════════════════════
@@ -1114,41 +1481,75 @@ func (m *LensSelectorModel) getEpicChildrenIssues(epicID string) []model.Issue {
 // getCentralityRank returns the rank and score for an issue's centrality metric
 // Returns (rank, score, total) where rank is 1-indexed position
 func (m *LensSelectorModel) getCentralityRank(issueID string) (pageRank int, prScore float64, betweenness int, btScore float64, total int) {
-	if m.graphStats == nil {
+	if m.centrality == nil {
 		return 0, 0, 0, 0, 0
 	}
+	r := m.centrality.ranks[issueID]
+	return r.PageRank, r.PRScore, r.Betweenness, r.BTScore, m.centrality.total
+}
 
-	total = len(m.issues)
-
-	// Get PageRank
-	prScores := m.graphStats.PageRank()
-	if prScores != nil {
-		prScore = prScores[issueID]
-		// Calculate rank
-		rank := 1
-		for id, score := range prScores {
-			if score > prScore && id != issueID {
-				rank++
-			}
+// centralityLines renders the centrality section of the stats panel, with a
+// spinner while the ranks are computed
+func (m *LensSelectorModel) centralityLines(issueID string, sectionStyle, labelStyle lipgloss.Style) []string {
+	if m.CentralityPending() {
+		return []string{
+			sectionStyle.Render(m.theme.Glyph("📊 Centrality")),
+			"   " + m.spinner.View() + labelStyle.Render(m.theme.Glyph(" computing PageRank & betweenness…")),
 		}
-		pageRank = rank
 	}
 
-	// Get Betweenness
-	btScores := m.graphStats.Betweenness()
-	if btScores != nil {
-		btScore = btScores[issueID]
-		// Calculate rank
-		rank := 1
-		for id, score := range btScores {
-			if score > btScore && id != issueID {
-				rank++
-			}
-		}
-		betweenness = rank
+	prRank, prScore, btRank, btScore, total := m.getCentralityRank(issueID)
+	if prRank == 0 && btRank == 0 {
+		return nil
+	}
+	lines := []string{sectionStyle.Render(m.theme.Glyph("📊 Centrality"))}
+	if prRank > 0 {
+		rankBadge := RenderRankBadge(prRank, total)
+		lines = append(lines, fmt.Sprintf("   %s %s (%.3f)",
+			labelStyle.Render("PageRank:"),
+			rankBadge,
+			prScore))
+	}
+	if btRank > 0 {
+		rankBadge := RenderRankBadge(btRank, total)
+		lines = append(lines, fmt.Sprintf("   %s %s (%.3f)",
+			labelStyle.Render("Betweenness:"),
+			rankBadge,
+			btScore))
+	}
+	return lines
+}
+
+// SetCentrality sets the centrality ranks shown in the stats panel. Ranks of
+// another analysis than the selector's are ignored.
+func (m *LensSelectorModel) SetCentrality(c *centralityRanks) {
+	if c != nil && c.stats == m.graphStats {
+		m.centrality = c
 	}
+}
 
-	return
+// CentralityPending reports whether the centrality ranks are still being computed
+func (m *LensSelectorModel) CentralityPending() bool {
+	return m.graphStats != nil && m.centrality == nil
+}
+
+// UpdateSpinner advances the centrality spinner while the ranks are pending
+func (m *LensSelectorModel) UpdateSpinner(msg spinner.TickMsg) tea.Cmd {
+	if !m.CentralityPending() {
+		return nil
+	}
+	var cmd tea.Cmd
+	m.spinner, cmd = m.spinner.Update(msg)
+	return cmd
+}
+
+// CompareRequest returns the two lenses to compare once c was pressed on
+// both, with ok false otherwise
+func (m *LensSelectorModel) CompareRequest() (left, right LensItem, ok bool) {
+	if !m.compareRequested || m.compareLeft == nil || m.selectedItem == nil {
+		return LensItem{}, LensItem{}, false
+	}
+	return *m.compareLeft, *m.selectedItem, true
 }
 
 // IsReviewRequested returns true if 'r' was pressed (review mode requested)
@@ -1175,6 +1576,10 @@ func (m *LensSelectorModel) IsScopeAddMode() bool {
 func (m *LensSelectorModel) View() string {
 	t := m.theme
 
+	if m.viewPickerMode {
+		return m.renderViewPicker()
+	}
+
 	// Check for very narrow terminal - use minimal layout (list only)
 	if m.width < BreakpointNarrow {
 		return m.renderMinimalLayout()
@@ -1185,15 +1590,7 @@ func (m *LensSelectorModel) View() string {
 		return m.renderStackedLayout()
 	}
 
-	// Calculate total width for dual-panel layout
-	totalWidth := 106
-	if m.width < 120 {
-		totalWidth = m.width - 14
-	}
-
-	// Panel dimensions - each panel gets half the width minus separator
-	panelWidth := (totalWidth - 3) / 2 // 3 chars for separator " │ "
-	contentHeight := m.height - 10     // Account for header, footer, borders
+	totalWidth, panelWidth, contentHeight := m.dualPanelLayout()
 
 	// Render header
 	header := m.renderLensHeader(totalWidth)
@@ -1241,7 +1638,7 @@ func (m *LensSelectorModel) View() string {
 			rightLine = rightLine + strings.Repeat(" ", panelWidth-rightVisualWidth)
 		}
 
-		panelLines = append(panelLines, leftLine+" "+sepStyle.Render("│")+" "+rightLine)
+		panelLines = append(panelLines, leftLine+" "+sepStyle.Render(m.theme.Glyph("│"))+" "+rightLine)
 	}
 
 	panels := strings.Join(panelLines, "\n")
@@ -1260,7 +1657,7 @@ func (m *LensSelectorModel) View() string {
 
 	// Box style
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2)
 
@@ -1276,13 +1673,27 @@ func (m *LensSelectorModel) View() string {
 	)
 }
 
+// dualPanelLayout returns the box width, the width of each panel and the
+// panel height for the dual-panel layout
+func (m *LensSelectorModel) dualPanelLayout() (totalWidth, panelWidth, contentHeight int) {
+	totalWidth = 106
+	if m.width < 120 {
+		totalWidth = m.width - 14
+	}
+
+	// Each panel gets half the width minus separator
+	panelWidth = (totalWidth - 3) / 2 // 3 chars for separator m.theme.Glyph(" │ ")
+	contentHeight = m.height - 10     // Account for header, footer, borders
+	return totalWidth, panelWidth, contentHeight
+}
+
 func (m *LensSelectorModel) renderItem(item LensItem, isSelected bool, maxWidth int) string {
 	t := m.theme
 
 	// Selection prefix
 	prefix := "  "
 	if isSelected {
-		prefix = "▸ "
+		prefix = m.theme.Glyph("▸ ")
 	}
 
 	// Type indicator: colored E/L/B
@@ -1307,27 +1718,43 @@ func (m *LensSelectorModel) renderItem(item LensItem, isSelected bool, maxWidth
 		nameStyle = nameStyle.Foreground(t.Base.GetForeground())
 	}
 
+	// Claim badge, which takes its room from the title
+	var claimStr string
+	if item.Type == "label" {
+		claimStr = claimBadge(t, m.labelClaims[item.Value])
+	} else {
+		claimStr = claimBadge(t, m.claims[item.Value])
+	}
+	claimWidth := 0
+	if claimStr != "" {
+		claimWidth = lipgloss.Width(claimStr) + 1
+	}
+
 	// Build display text
 	var displayText string
 	if item.Type == "bead" {
 		// Show ID followed by title
 		idPart := item.Value
 		titlePart := item.Title
-		maxTitleLen := maxWidth - 28 - len(idPart) // Leave room for ID and padding
+		maxTitleLen := maxWidth - 28 - len(idPart) - claimWidth // Leave room for ID and padding
 		if len(titlePart) > maxTitleLen && maxTitleLen > 5 {
-			titlePart = titlePart[:maxTitleLen-1] + "…"
+			titlePart = titlePart[:maxTitleLen-1] + m.theme.Glyph("…")
 		}
 		displayText = idPart + " " + titlePart
 	} else {
 		// Truncate title if needed
 		title := item.Title
-		maxTitleLen := maxWidth - 23 // Leave room for progress bar or overlap
+		maxTitleLen := maxWidth - 23 - claimWidth // Leave room for progress bar or overlap
 		if len(title) > maxTitleLen {
-			title = title[:maxTitleLen-1] + "…"
+			title = title[:maxTitleLen-1] + m.theme.Glyph("…")
 		}
 		displayText = title
 	}
 
+	if item.IsPinned {
+		typeIndicator += t.Renderer.NewStyle().Foreground(t.Feature).Render(m.theme.Glyph("★")) + " "
+	}
+
 	// Build the line with type indicator
 	name := prefix + typeIndicator + nameStyle.Render(displayText)
 
@@ -1339,6 +1766,9 @@ func (m *LensSelectorModel) renderItem(item LensItem, isSelected bool, maxWidth
 	} else if item.IssueCount > 0 {
 		suffix = m.renderProgressBar(item.Progress, item.ClosedCount, item.IssueCount)
 	}
+	if claimStr != "" {
+		suffix = claimStr + " " + suffix
+	}
 
 	// Pad to align using visual width (handles ANSI escape codes correctly)
 	nameWidth := lipgloss.Width(name)
@@ -1374,7 +1804,7 @@ func (m *LensSelectorModel) renderProgressBar(progress float64, closed, total in
 		barColor = t.Open
 	}
 
-	bar := strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled)
+	bar := strings.Repeat(m.theme.Glyph("█"), filled) + strings.Repeat(m.theme.Glyph("░"), barWidth-filled)
 	barStyled := t.Renderer.NewStyle().Foreground(barColor).Render("[" + bar + "]")
 
 	// Count
@@ -1383,7 +1813,7 @@ func (m *LensSelectorModel) renderProgressBar(progress float64, closed, total in
 
 	// Checkmark if complete
 	if progress >= 1.0 {
-		countStr += " ✓"
+		countStr += m.theme.Glyph(" ✓")
 	}
 
 	return barStyled + countStr
@@ -1449,7 +1879,7 @@ func (m *LensSelectorModel) renderLensHeader(width int) string {
 
 	// Add decorative elements
 	iconStyle := t.Renderer.NewStyle().Foreground(GradientPeak)
-	icon := iconStyle.Render("◈")
+	icon := iconStyle.Render(m.theme.Glyph("◈"))
 
 	header := icon + "  " + lensText + "  " + icon
 
@@ -1475,24 +1905,38 @@ func (m *LensSelectorModel) renderKeybindFooter(width int) string {
 		Background(ColorBgSubtle).
 		Padding(0, 1)
 
-	sep := sepStyle.Render(" │ ")
+	sep := sepStyle.Render(m.theme.Glyph(" │ "))
 
 	var line string
 
-	if m.insertMode {
+	if m.viewNameMode {
+		mode := modeStyle.Render("SAVE VIEW")
+		line = mode + "  " +
+			descStyle.Render("name: ") + keyStyle.Render(m.viewNameInput+m.theme.Glyph("▏")) + sep +
+			keyStyle.Render(m.theme.Glyph("⏎")) + descStyle.Render(" save") + sep +
+			keyStyle.Render("esc") + descStyle.Render(" cancel")
+	} else if m.compareLeft != nil && !m.insertMode {
+		mode := modeStyle.Render("COMPARE")
+		line = mode + "  " +
+			descStyle.Render(m.compareLeft.Title+m.theme.Glyph(" vs …")) + sep +
+			keyStyle.Render("j/k") + descStyle.Render(" nav") + sep +
+			keyStyle.Render("i") + descStyle.Render(" search") + sep +
+			keyStyle.Render("c") + descStyle.Render(" compare with this") + sep +
+			keyStyle.Render("esc") + descStyle.Render(" cancel")
+	} else if m.insertMode {
 		if m.scopeAddMode {
 			mode := modeStyle.Render("FILTER+")
 			line = mode + "  " +
 				descStyle.Render("type to filter") + sep +
-				keyStyle.Render("↑↓") + descStyle.Render(" nav") + sep +
-				keyStyle.Render("⏎") + descStyle.Render(" add") + sep +
+				keyStyle.Render(m.theme.Glyph("↑↓")) + descStyle.Render(" nav") + sep +
+				keyStyle.Render(m.theme.Glyph("⏎")) + descStyle.Render(" add") + sep +
 				keyStyle.Render("esc") + descStyle.Render(" back")
 		} else {
 			mode := modeStyle.Render("SEARCH")
 			line = mode + "  " +
 				descStyle.Render("type to search") + sep +
-				keyStyle.Render("↑↓") + descStyle.Render(" nav") + sep +
-				keyStyle.Render("⏎") + descStyle.Render(" select") + sep +
+				keyStyle.Render(m.theme.Glyph("↑↓")) + descStyle.Render(" nav") + sep +
+				keyStyle.Render(m.theme.Glyph("⏎")) + descStyle.Render(" select") + sep +
 				keyStyle.Render("esc") + descStyle.Render(" back")
 		}
 	} else if m.scopeMode {
@@ -1503,12 +1947,12 @@ func (m *LensSelectorModel) renderKeybindFooter(width int) string {
 		var toggleHint string
 		if len(m.scopeLabels) >= 2 {
 			matchModeStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
-			matchModeIndicator = " " + matchModeStyle.Render(m.scopeMatchMode.ShortString())
+			matchModeIndicator = " " + matchModeStyle.Render(m.theme.Glyph(m.scopeMatchMode.ShortString()))
 			// Show what mode Shift+S will toggle to
 			if m.scopeMatchMode == ScopeModeUnion {
-				toggleHint = keyStyle.Render("S") + descStyle.Render(" →all") + sep
+				toggleHint = keyStyle.Render("S") + descStyle.Render(m.theme.Glyph(" →all")) + sep
 			} else {
-				toggleHint = keyStyle.Render("S") + descStyle.Render(" →any") + sep
+				toggleHint = keyStyle.Render("S") + descStyle.Render(m.theme.Glyph(" →any")) + sep
 			}
 		}
 
@@ -1517,8 +1961,9 @@ func (m *LensSelectorModel) renderKeybindFooter(width int) string {
 			toggleHint +
 			keyStyle.Render("s") + descStyle.Render(" +scope") + sep +
 			keyStyle.Render("m") + descStyle.Render(" mode") + sep +
-			keyStyle.Render("⌫") + descStyle.Render(" clear") + sep +
-			keyStyle.Render("⏎") + descStyle.Render(" select") + sep +
+			keyStyle.Render("w") + descStyle.Render(" save view") + sep +
+			keyStyle.Render(m.theme.Glyph("⌫")) + descStyle.Render(" clear") + sep +
+			keyStyle.Render(m.theme.Glyph("⏎")) + descStyle.Render(" select") + sep +
 			keyStyle.Render("q") + descStyle.Render(" exit")
 	} else {
 		mode := modeStyle.Render("BROWSE")
@@ -1528,7 +1973,13 @@ func (m *LensSelectorModel) renderKeybindFooter(width int) string {
 			keyStyle.Render("m") + descStyle.Render(" mode") + sep +
 			keyStyle.Render("s") + descStyle.Render(" scope") + sep +
 			keyStyle.Render("r") + descStyle.Render(" review") + sep +
-			keyStyle.Render("q") + descStyle.Render(" exit")
+			keyStyle.Render("c") + descStyle.Render(" compare") + sep +
+			keyStyle.Render("e") + descStyle.Render(" edit label") + sep +
+			keyStyle.Render("p") + descStyle.Render(" pin") + sep
+		if len(m.viewNames) > 0 {
+			line += keyStyle.Render("v") + descStyle.Render(" views") + sep
+		}
+		line += keyStyle.Render("q") + descStyle.Render(" exit")
 	}
 
 	// Center the footer
@@ -1539,6 +1990,39 @@ func (m *LensSelectorModel) renderKeybindFooter(width int) string {
 	return footerStyle.Render(line)
 }
 
+// renderViewPicker renders the saved views list
+func (m *LensSelectorModel) renderViewPicker() string {
+	t := m.theme
+
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	itemStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	footerStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true)
+
+	lines := []string{titleStyle.Render("Saved Views"), ""}
+	for i, name := range m.viewNames {
+		if i == m.viewPickerIndex {
+			lines = append(lines, selectedStyle.Render(m.theme.Glyph("▸ ")+name))
+		} else {
+			lines = append(lines, itemStyle.Render("  "+name))
+		}
+	}
+	lines = append(lines, "", footerStyle.Render("j/k: navigate | enter: restore | esc: cancel"))
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
+		BorderForeground(t.Primary).
+		Padding(1, 2)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(strings.Join(lines, "\n")),
+	)
+}
+
 // renderWelcomePanel creates a decorative welcome UI when first entering
 func (m *LensSelectorModel) renderWelcomePanel(width, height int) string {
 	t := m.theme
@@ -1557,11 +2041,11 @@ func (m *LensSelectorModel) renderWelcomePanel(width, height int) string {
 		Bold(true)
 	boxWidth := 26 // Fixed width for "Welcome to Lens View"
 
-	topBorder := "╔" + strings.Repeat("═", boxWidth-2) + "╗"
-	bottomBorder := "╚" + strings.Repeat("═", boxWidth-2) + "╝"
+	topBorder := m.theme.Glyph("╔") + strings.Repeat(m.theme.Glyph("═"), boxWidth-2) + m.theme.Glyph("╗")
+	bottomBorder := m.theme.Glyph("╚") + strings.Repeat(m.theme.Glyph("═"), boxWidth-2) + m.theme.Glyph("╝")
 	titleText := "Welcome to Lens View"
 	padding := (boxWidth - 2 - len(titleText)) / 2
-	titleLine := "║" + strings.Repeat(" ", padding) + titleText + strings.Repeat(" ", boxWidth-2-padding-len(titleText)) + "║"
+	titleLine := m.theme.Glyph("║") + strings.Repeat(" ", padding) + titleText + strings.Repeat(" ", boxWidth-2-padding-len(titleText)) + m.theme.Glyph("║")
 
 	lines = append(lines, headerBoxStyle.Render(topBorder))
 	lines = append(lines, headerBoxStyle.Render(titleLine))
@@ -1570,7 +2054,7 @@ func (m *LensSelectorModel) renderWelcomePanel(width, height int) string {
 
 	// Icon and tagline
 	iconStyle := t.Renderer.NewStyle().Foreground(GradientHigh).Bold(true)
-	lines = append(lines, iconStyle.Render("🔮 Explore Your Work"))
+	lines = append(lines, iconStyle.Render(m.theme.Glyph("🔮 Explore Your Work")))
 	lines = append(lines, "")
 
 	// Feature descriptions
@@ -1579,16 +2063,16 @@ func (m *LensSelectorModel) renderWelcomePanel(width, height int) string {
 
 	lines = append(lines, descStyle.Render("Navigate to see stats for:"))
 	lines = append(lines, "")
-	lines = append(lines, labelStyle.Render("► Epics")+"   "+descStyle.Render("Progress & children"))
-	lines = append(lines, labelStyle.Render("► Labels")+"  "+descStyle.Render("Distribution"))
-	lines = append(lines, labelStyle.Render("► Beads")+"   "+descStyle.Render("Details & deps"))
+	lines = append(lines, labelStyle.Render(m.theme.Glyph("► Epics"))+"   "+descStyle.Render("Progress & children"))
+	lines = append(lines, labelStyle.Render(m.theme.Glyph("► Labels"))+"  "+descStyle.Render("Distribution"))
+	lines = append(lines, labelStyle.Render(m.theme.Glyph("► Beads"))+"   "+descStyle.Render("Details & deps"))
 	lines = append(lines, "")
 
 	// Tip
 	tipStyle := t.Renderer.NewStyle().
 		Foreground(ColorInfo).
 		Italic(true)
-	lines = append(lines, tipStyle.Render("💡 Press j/k to navigate"))
+	lines = append(lines, tipStyle.Render(m.theme.Glyph("💡 Press j/k to navigate")))
 
 	// Join content
 	content := strings.Join(lines, "\n")
@@ -1607,6 +2091,91 @@ func (m *LensSelectorModel) renderLeftPanel(width, height int) string {
 	t := m.theme
 	contentWidth := width - 4
 
+	lines := m.renderLeftPanelHeader(width)
+
+	maxVisible := leftPanelMaxVisible(height)
+
+	// The Pinned and Recent sections get headings, and a rule below them
+	pinnedLen := m.pinnedSectionLen()
+	recentLen := m.recentSectionLen()
+	leadLen := pinnedLen + recentLen
+	if pinnedLen > 0 {
+		maxVisible--
+	}
+	if recentLen > 0 {
+		maxVisible--
+	}
+	if leadLen > 0 {
+		maxVisible = max(maxVisible-1, 3)
+	}
+	sectionStyle := t.Renderer.NewStyle().Foreground(t.Feature).Bold(true)
+	ruleStyle := t.Renderer.NewStyle().Foreground(ColorBgHighlight)
+
+	// Render items as unified list
+	if len(m.filteredItems) == 0 {
+		emptyStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true)
+		lines = append(lines, emptyStyle.Render("  No matching items found"))
+	} else {
+		startIdx, endIdx := m.visibleItemRange(maxVisible)
+
+		// Render visible items
+		for i := startIdx; i < endIdx; i++ {
+			switch {
+			case i == startIdx && i < pinnedLen:
+				lines = append(lines, sectionStyle.Render(m.theme.Glyph("  ★ Pinned")))
+			case i >= pinnedLen && i < leadLen && (i == startIdx || i == pinnedLen):
+				lines = append(lines, sectionStyle.Render(m.theme.Glyph("  ↺ Recent")))
+			case leadLen > 0 && i == leadLen:
+				lines = append(lines, ruleStyle.Render("  "+strings.Repeat(m.theme.Glyph("─"), max(contentWidth-4, 1))))
+			}
+			item := m.filteredItems[i]
+			line := m.renderItem(item, i == m.selectedIndex, contentWidth)
+			lines = append(lines, line)
+		}
+
+		// Show "more" indicator if truncated
+		if len(m.filteredItems) > maxVisible {
+			moreStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true)
+			remaining := len(m.filteredItems) - endIdx
+			if remaining > 0 {
+				lines = append(lines, moreStyle.Render(
+					strings.Repeat(" ", 2)+m.theme.Glyph("↓ ")+strconv.Itoa(remaining)+" more"))
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// leftPanelMaxVisible returns how many items fit in a left panel of the given height
+func leftPanelMaxVisible(height int) int {
+	// Fill available height; no upper cap
+	maxVisible := height - 8
+	if maxVisible < 5 {
+		maxVisible = 5
+	}
+	return maxVisible
+}
+
+// visibleItemRange returns the window of filteredItems shown when at most
+// maxVisible fit, keeping the selection on screen
+func (m *LensSelectorModel) visibleItemRange(maxVisible int) (start, end int) {
+	if m.selectedIndex >= maxVisible {
+		start = m.selectedIndex - maxVisible + 1
+	}
+	end = start + maxVisible
+	if end > len(m.filteredItems) {
+		end = len(m.filteredItems)
+	}
+	return start, end
+}
+
+// renderLeftPanelHeader renders the search box and info lines above the item
+// list, ending with the blank spacer line
+func (m *LensSelectorModel) renderLeftPanelHeader(width int) []string {
+	t := m.theme
+	contentWidth := width - 4
+
 	var lines []string
 
 	// Search input first (highlighted when in insert mode)
@@ -1616,7 +2185,7 @@ func (m *LensSelectorModel) renderLeftPanel(width, height int) string {
 	}
 	inputStyle := t.Renderer.NewStyle().
 		Foreground(t.Base.GetForeground()).
-		Border(lipgloss.RoundedBorder()).
+		Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 		BorderForeground(inputBorderColor).
 		Padding(0, 1).
 		Width(contentWidth - 2)
@@ -1644,7 +2213,7 @@ func (m *LensSelectorModel) renderLeftPanel(width, height int) string {
 	default:
 		modeLabel = "ALL"
 	}
-	countInfo := fmt.Sprintf("%s · %d items", modeLabel, len(m.filteredItems))
+	countInfo := fmt.Sprintf(m.theme.Glyph("%s · %d items"), modeLabel, len(m.filteredItems))
 	lines = append(lines, modeStyle.Render(countInfo))
 
 	// Scope indicator and inline input
@@ -1667,7 +2236,7 @@ func (m *LensSelectorModel) renderLeftPanel(width, height int) string {
 		promptStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
 		hintStyle := t.Renderer.NewStyle().Faint(true)
 
-		inputLine := promptStyle.Render("+ Filter: ") + inputStyle.Render(m.searchInput.Value()) + inputStyle.Render("█")
+		inputLine := promptStyle.Render("+ Filter: ") + inputStyle.Render(m.searchInput.Value()) + inputStyle.Render(m.theme.Glyph("█"))
 		lines = append(lines, inputLine)
 
 		// Get matching labels for hint (show on separate line to avoid breaking layout)
@@ -1689,54 +2258,13 @@ func (m *LensSelectorModel) renderLeftPanel(width, height int) string {
 				if len(matchText) > maxLen {
 					matchText = matchText[:maxLen-3] + "..."
 				}
-				lines = append(lines, hintStyle.Render("  → "+matchText))
+				lines = append(lines, hintStyle.Render(m.theme.Glyph("  → ")+matchText))
 			}
 		}
 	}
 
 	lines = append(lines, "")
-
-	// Calculate max visible items - fill available height
-	maxVisible := height - 8
-	if maxVisible < 5 {
-		maxVisible = 5
-	}
-	// No upper cap - items span full available height
-
-	// Render items as unified list
-	if len(m.filteredItems) == 0 {
-		emptyStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true)
-		lines = append(lines, emptyStyle.Render("  No matching items found"))
-	} else {
-		// Calculate scroll window
-		startIdx := 0
-		if m.selectedIndex >= maxVisible {
-			startIdx = m.selectedIndex - maxVisible + 1
-		}
-		endIdx := startIdx + maxVisible
-		if endIdx > len(m.filteredItems) {
-			endIdx = len(m.filteredItems)
-		}
-
-		// Render visible items
-		for i := startIdx; i < endIdx; i++ {
-			item := m.filteredItems[i]
-			line := m.renderItem(item, i == m.selectedIndex, contentWidth)
-			lines = append(lines, line)
-		}
-
-		// Show "more" indicator if truncated
-		if len(m.filteredItems) > maxVisible {
-			moreStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true)
-			remaining := len(m.filteredItems) - endIdx
-			if remaining > 0 {
-				lines = append(lines, moreStyle.Render(
-					strings.Repeat(" ", 2)+"↓ "+strconv.Itoa(remaining)+" more"))
-			}
-		}
-	}
-
-	return strings.Join(lines, "\n")
+	return lines
 }
 
 // renderRightPanel routes to the appropriate stats panel or welcome
@@ -1779,8 +2307,8 @@ func (m *LensSelectorModel) renderEpicStats(item LensItem, width, height int) st
 	if boxWidth < MinBoxWidth {
 		boxWidth = MinBoxWidth
 	}
-	topBorder := "╔" + strings.Repeat("═", boxWidth-2) + "╗"
-	bottomBorder := "╚" + strings.Repeat("═", boxWidth-2) + "╝"
+	topBorder := m.theme.Glyph("╔") + strings.Repeat(m.theme.Glyph("═"), boxWidth-2) + m.theme.Glyph("╗")
+	bottomBorder := m.theme.Glyph("╚") + strings.Repeat(m.theme.Glyph("═"), boxWidth-2) + m.theme.Glyph("╝")
 	lines = append(lines, headerStyle.Render(topBorder))
 
 	// Truncate title
@@ -1790,9 +2318,9 @@ func (m *LensSelectorModel) renderEpicStats(item LensItem, width, height int) st
 		maxTitleLen = 5
 	}
 	if len(title) > maxTitleLen {
-		title = title[:maxTitleLen-1] + "…"
+		title = title[:maxTitleLen-1] + m.theme.Glyph("…")
 	}
-	titleLine := fmt.Sprintf("║ EPIC: %-*s║", boxWidth-9, title)
+	titleLine := fmt.Sprintf(m.theme.Glyph("║ EPIC: %-*s║"), boxWidth-9, title)
 	lines = append(lines, headerStyle.Render(titleLine))
 	lines = append(lines, headerStyle.Render(bottomBorder))
 	lines = append(lines, "")
@@ -1806,8 +2334,8 @@ func (m *LensSelectorModel) renderEpicStats(item LensItem, width, height int) st
 	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
 	valueStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
 
-	lines = append(lines, sectionStyle.Render("📊 Overview"))
-	lines = append(lines, fmt.Sprintf("   %s %s  │  %s %s",
+	lines = append(lines, sectionStyle.Render(m.theme.Glyph("📊 Overview")))
+	lines = append(lines, fmt.Sprintf(m.theme.Glyph("   %s %s  │  %s %s"),
 		labelStyle.Render("Children:"),
 		valueStyle.Render(strconv.Itoa(item.IssueCount)),
 		labelStyle.Render("Closed:"),
@@ -1819,10 +2347,13 @@ func (m *LensSelectorModel) renderEpicStats(item LensItem, width, height int) st
 		labelStyle.Render("Progress:"),
 		progressBar,
 		item.Progress*100))
+	if scopeLine := m.renderEpicScopeLine(item.Value); scopeLine != "" {
+		lines = append(lines, scopeLine)
+	}
 	lines = append(lines, "")
 
 	// Status breakdown
-	lines = append(lines, sectionStyle.Render("📈 Status Breakdown"))
+	lines = append(lines, sectionStyle.Render(m.theme.Glyph("📈 Status Breakdown")))
 
 	openCount := statusCounts[model.StatusOpen]
 	inProgCount := statusCounts[model.StatusInProgress]
@@ -1844,50 +2375,113 @@ func (m *LensSelectorModel) renderEpicStats(item LensItem, width, height int) st
 	closedStyle := t.Renderer.NewStyle().Foreground(t.Closed)
 
 	lines = append(lines, fmt.Sprintf("   %s %-12s %2d %s",
-		openStyle.Render("●"), "Open:", openCount, openBar))
+		openStyle.Render(m.theme.Glyph("●")), "Open:", openCount, openBar))
 	lines = append(lines, fmt.Sprintf("   %s %-12s %2d %s",
-		inProgStyle.Render("●"), "In Progress:", inProgCount, inProgBar))
+		inProgStyle.Render(m.theme.Glyph("●")), "In Progress:", inProgCount, inProgBar))
 	lines = append(lines, fmt.Sprintf("   %s %-12s %2d %s",
-		blockedStyle.Render("●"), "Blocked:", blockedCount, blockedBar))
+		blockedStyle.Render(m.theme.Glyph("●")), "Blocked:", blockedCount, blockedBar))
 	lines = append(lines, fmt.Sprintf("   %s %-12s %2d %s",
-		closedStyle.Render("●"), "Closed:", closedCount, closedBar))
+		closedStyle.Render(m.theme.Glyph("●")), "Closed:", closedCount, closedBar))
 	lines = append(lines, "")
 
 	// Dependencies
 	blockers := m.getBlockers(item.Value)
 	dependents := m.getDependents(item.Value)
-	lines = append(lines, sectionStyle.Render("🔗 Dependencies"))
-	lines = append(lines, fmt.Sprintf("   %s %s  │  %s %s",
+	lines = append(lines, sectionStyle.Render(m.theme.Glyph("🔗 Dependencies")))
+	lines = append(lines, fmt.Sprintf(m.theme.Glyph("   %s %s  │  %s %s"),
 		labelStyle.Render("Blocked by:"),
 		valueStyle.Render(strconv.Itoa(len(blockers))),
 		labelStyle.Render("Blocks:"),
 		valueStyle.Render(strconv.Itoa(len(dependents)))))
 	lines = append(lines, "")
 
+	// Review coverage of the children
+	lines = append(lines, m.reviewCoverageLines(item.Value, sectionStyle, labelStyle)...)
+
 	// Centrality metrics (if available)
-	prRank, prScore, btRank, btScore, total := m.getCentralityRank(item.Value)
-	if prRank > 0 || btRank > 0 {
-		lines = append(lines, sectionStyle.Render("📊 Centrality"))
-		if prRank > 0 {
-			rankBadge := RenderRankBadge(prRank, total)
-			lines = append(lines, fmt.Sprintf("   %s %s (%.3f)",
-				labelStyle.Render("PageRank:"),
-				rankBadge,
-				prScore))
-		}
-		if btRank > 0 {
-			rankBadge := RenderRankBadge(btRank, total)
-			lines = append(lines, fmt.Sprintf("   %s %s (%.3f)",
-				labelStyle.Render("Betweenness:"),
-				rankBadge,
-				btScore))
-		}
-	}
+	lines = append(lines, m.centralityLines(item.Value, sectionStyle, labelStyle)...)
 
 	// Pad to fixed height for consistent layout
 	return padToHeight(strings.Join(lines, "\n"), height, width)
 }
 
+// reviewCoverageLines renders how much of the epic's descendants each review
+// type has approved, sent back or not reached yet; nothing for an empty epic
+func (m *LensSelectorModel) reviewCoverageLines(epicID string, sectionStyle, labelStyle lipgloss.Style) []string {
+	cov, ok := m.coverage[epicID]
+	if !ok {
+		var err error
+		if cov, err = review.ComputeEpicCoverage(epicID, m.issues); err != nil {
+			return nil
+		}
+		m.coverage[epicID] = cov
+	}
+	if cov.Descendants == 0 {
+		return nil
+	}
+	t := m.theme
+	approvedStyle := t.Renderer.NewStyle().Foreground(t.Closed)
+	revisionStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+
+	lines := []string{sectionStyle.Render(m.theme.Glyph("🔍 Review Coverage"))}
+	for _, reviewType := range review.CoverageTypes {
+		c := cov.ByType[reviewType]
+		name := strings.ToUpper(reviewType[:1]) + reviewType[1:] + ":"
+		lines = append(lines, fmt.Sprintf("   %s %s %3.0f%%  %s %s %s",
+			labelStyle.Render(fmt.Sprintf("%-15s", name)),
+			RenderMiniBar(c.Coverage, 10, t),
+			c.Coverage*100,
+			approvedStyle.Render(fmt.Sprintf(m.theme.Glyph("✓%d"), c.Approved)),
+			revisionStyle.Render(fmt.Sprintf(m.theme.Glyph("✎%d"), c.NeedsRevision)),
+			labelStyle.Render(fmt.Sprintf(m.theme.Glyph("·%d"), c.Unreviewed))))
+	}
+	return append(lines, "")
+}
+
+// SetEpicScopeChanges sets per-epic scope changes since kickoff for the stats panel
+func (m *LensSelectorModel) SetEpicScopeChanges(changes map[string]analysis.EpicScopeChange) {
+	m.epicScope = changes
+}
+
+// SetClaims sets the workstream claims badged on epics, beads and labels
+func (m *LensSelectorModel) SetClaims(coverage, byLabel map[string][]claims.Claim) {
+	m.claims = coverage
+	m.labelClaims = byLabel
+}
+
+// renderEpicScopeLine renders the scope-change indicator for an epic,
+// or "" if the epic hasn't kicked off yet
+func (m *LensSelectorModel) renderEpicScopeLine(epicID string) string {
+	change, ok := m.epicScope[epicID]
+	if !ok {
+		return ""
+	}
+	t := m.theme
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	if !change.HasChanges() {
+		return fmt.Sprintf("   %s %s",
+			labelStyle.Render("Scope:"),
+			t.Renderer.NewStyle().Foreground(t.Closed).Render(fmt.Sprintf("unchanged since kickoff (%s)", change.KickoffAt.Format("Jan 02"))))
+	}
+
+	warnStyle := t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true)
+	var parts []string
+	if n := len(change.Added); n > 0 {
+		noun := "issues"
+		if n == 1 {
+			noun = "issue"
+		}
+		parts = append(parts, fmt.Sprintf("+%d %s", n, noun))
+	}
+	if n := len(change.Removed); n > 0 {
+		parts = append(parts, fmt.Sprintf(m.theme.Glyph("−%d removed"), n))
+	}
+	return fmt.Sprintf("   %s %s %s",
+		labelStyle.Render("Scope:"),
+		warnStyle.Render(m.theme.Glyph("⚠ ")+strings.Join(parts, ", ")),
+		labelStyle.Render(fmt.Sprintf("since kickoff (%s, %d planned)", change.KickoffAt.Format("Jan 02"), change.Planned)))
+}
+
 // renderLabelStats renders statistics for a label item
 func (m *LensSelectorModel) renderLabelStats(item LensItem, width, height int) string {
 	t := m.theme
@@ -1901,8 +2495,8 @@ func (m *LensSelectorModel) renderLabelStats(item LensItem, width, height int) s
 	if boxWidth < MinBoxWidth {
 		boxWidth = MinBoxWidth
 	}
-	topBorder := "╔" + strings.Repeat("═", boxWidth-2) + "╗"
-	bottomBorder := "╚" + strings.Repeat("═", boxWidth-2) + "╝"
+	topBorder := m.theme.Glyph("╔") + strings.Repeat(m.theme.Glyph("═"), boxWidth-2) + m.theme.Glyph("╗")
+	bottomBorder := m.theme.Glyph("╚") + strings.Repeat(m.theme.Glyph("═"), boxWidth-2) + m.theme.Glyph("╝")
 	lines = append(lines, headerStyle.Render(topBorder))
 
 	// Truncate title
@@ -1912,9 +2506,9 @@ func (m *LensSelectorModel) renderLabelStats(item LensItem, width, height int) s
 		maxTitleLen = 5
 	}
 	if len(title) > maxTitleLen {
-		title = title[:maxTitleLen-1] + "…"
+		title = title[:maxTitleLen-1] + m.theme.Glyph("…")
 	}
-	titleLine := fmt.Sprintf("║ LABEL: %-*s║", boxWidth-10, title)
+	titleLine := fmt.Sprintf(m.theme.Glyph("║ LABEL: %-*s║"), boxWidth-10, title)
 	lines = append(lines, headerStyle.Render(titleLine))
 	lines = append(lines, headerStyle.Render(bottomBorder))
 	lines = append(lines, "")
@@ -1929,8 +2523,8 @@ func (m *LensSelectorModel) renderLabelStats(item LensItem, width, height int) s
 	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
 	valueStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
 
-	lines = append(lines, sectionStyle.Render("📊 Overview"))
-	lines = append(lines, fmt.Sprintf("   %s %s  │  %s %s",
+	lines = append(lines, sectionStyle.Render(m.theme.Glyph("📊 Overview")))
+	lines = append(lines, fmt.Sprintf(m.theme.Glyph("   %s %s  │  %s %s"),
 		labelStyle.Render("Issues:"),
 		valueStyle.Render(strconv.Itoa(item.IssueCount)),
 		labelStyle.Render("Closed:"),
@@ -1945,7 +2539,7 @@ func (m *LensSelectorModel) renderLabelStats(item LensItem, width, height int) s
 	lines = append(lines, "")
 
 	// Status distribution
-	lines = append(lines, sectionStyle.Render("📈 Status Distribution"))
+	lines = append(lines, sectionStyle.Render(m.theme.Glyph("📈 Status Distribution")))
 
 	openCount := statusCounts[model.StatusOpen]
 	inProgCount := statusCounts[model.StatusInProgress]
@@ -1967,22 +2561,22 @@ func (m *LensSelectorModel) renderLabelStats(item LensItem, width, height int) s
 	closedStyle := t.Renderer.NewStyle().Foreground(t.Closed)
 
 	lines = append(lines, fmt.Sprintf("   %s %-12s %2d %s",
-		openStyle.Render("●"), "Open:", openCount, openBar))
+		openStyle.Render(m.theme.Glyph("●")), "Open:", openCount, openBar))
 	lines = append(lines, fmt.Sprintf("   %s %-12s %2d %s",
-		inProgStyle.Render("●"), "In Progress:", inProgCount, inProgBar))
+		inProgStyle.Render(m.theme.Glyph("●")), "In Progress:", inProgCount, inProgBar))
 	lines = append(lines, fmt.Sprintf("   %s %-12s %2d %s",
-		blockedStyle.Render("●"), "Blocked:", blockedCount, blockedBar))
+		blockedStyle.Render(m.theme.Glyph("●")), "Blocked:", blockedCount, blockedBar))
 	lines = append(lines, fmt.Sprintf("   %s %-12s %2d %s",
-		closedStyle.Render("●"), "Closed:", closedCount, closedBar))
+		closedStyle.Render(m.theme.Glyph("●")), "Closed:", closedCount, closedBar))
 	lines = append(lines, "")
 
 	// Related labels
 	related := m.getRelatedLabels(item.Value, 3)
 	if len(related) > 0 {
-		lines = append(lines, sectionStyle.Render("🏷 Related Labels"))
+		lines = append(lines, sectionStyle.Render(m.theme.Glyph("🏷 Related Labels")))
 		for _, r := range related {
 			lines = append(lines, fmt.Sprintf("   %s %-15s %s",
-				labelStyle.Render("●"),
+				labelStyle.Render(m.theme.Glyph("●")),
 				r.Label+":",
 				valueStyle.Render(strconv.Itoa(r.Count)+" issues")))
 		}
@@ -1990,7 +2584,7 @@ func (m *LensSelectorModel) renderLabelStats(item LensItem, width, height int) s
 	}
 
 	// Type breakdown
-	lines = append(lines, sectionStyle.Render("📦 Types"))
+	lines = append(lines, sectionStyle.Render(m.theme.Glyph("📦 Types")))
 	var typeParts []string
 	if c := typeCounts[model.TypeBug]; c > 0 {
 		typeParts = append(typeParts, fmt.Sprintf("Bug: %d", c))
@@ -2030,8 +2624,8 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 	if boxWidth < MinBoxWidth {
 		boxWidth = MinBoxWidth
 	}
-	topBorder := "╔" + strings.Repeat("═", boxWidth-2) + "╗"
-	bottomBorder := "╚" + strings.Repeat("═", boxWidth-2) + "╝"
+	topBorder := m.theme.Glyph("╔") + strings.Repeat(m.theme.Glyph("═"), boxWidth-2) + m.theme.Glyph("╗")
+	bottomBorder := m.theme.Glyph("╚") + strings.Repeat(m.theme.Glyph("═"), boxWidth-2) + m.theme.Glyph("╝")
 	lines = append(lines, headerStyle.Render(topBorder))
 
 	// Truncate bead ID if needed
@@ -2041,9 +2635,9 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 		maxIDLen = 5
 	}
 	if len(beadID) > maxIDLen {
-		beadID = beadID[:maxIDLen-1] + "…"
+		beadID = beadID[:maxIDLen-1] + m.theme.Glyph("…")
 	}
-	titleLine := fmt.Sprintf("║ BEAD: %-*s║", boxWidth-9, beadID)
+	titleLine := fmt.Sprintf(m.theme.Glyph("║ BEAD: %-*s║"), boxWidth-9, beadID)
 	lines = append(lines, headerStyle.Render(titleLine))
 	lines = append(lines, headerStyle.Render(bottomBorder))
 	lines = append(lines, "")
@@ -2053,7 +2647,7 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 	title := issue.Title
 	maxTitleLen := width - 4
 	if len(title) > maxTitleLen {
-		title = title[:maxTitleLen-1] + "…"
+		title = title[:maxTitleLen-1] + m.theme.Glyph("…")
 	}
 	lines = append(lines, titleStyle.Render(title))
 	lines = append(lines, "")
@@ -2062,7 +2656,7 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 	sectionStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
 	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
 
-	lines = append(lines, sectionStyle.Render("📋 Details"))
+	lines = append(lines, sectionStyle.Render(m.theme.Glyph("📋 Details")))
 
 	// Status badge
 	statusBadge := RenderStatusBadge(string(issue.Status))
@@ -2087,7 +2681,7 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 
 	// Labels - with wrapping and overflow indicator
 	if len(issue.Labels) > 0 {
-		lines = append(lines, sectionStyle.Render("🏷 Labels"))
+		lines = append(lines, sectionStyle.Render(m.theme.Glyph("🏷 Labels")))
 		chipStyle := t.Renderer.NewStyle().Foreground(t.Primary)
 		maxLabelWidth := width - 8 // Leave margin for indentation
 
@@ -2098,11 +2692,11 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 
 		for _, l := range issue.Labels {
 			chipText := l
-			chipWidth := len(l) + 3 // label + " • " separator
+			chipWidth := len(l) + 3 // label + m.theme.Glyph(" • ") separator
 
 			// If adding this chip exceeds width and we have items, start new row
 			if currentRowWidth+chipWidth > maxLabelWidth && len(currentRow) > 0 {
-				labelRows = append(labelRows, "   "+strings.Join(currentRow, " • "))
+				labelRows = append(labelRows, "   "+strings.Join(currentRow, m.theme.Glyph(" • ")))
 				currentRow = nil
 				currentRowWidth = 0
 			}
@@ -2112,7 +2706,7 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 		}
 		// Add remaining row
 		if len(currentRow) > 0 {
-			labelRows = append(labelRows, "   "+strings.Join(currentRow, " • "))
+			labelRows = append(labelRows, "   "+strings.Join(currentRow, m.theme.Glyph(" • ")))
 		}
 
 		// Limit to 2 rows, show overflow indicator
@@ -2133,11 +2727,11 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 	blockers := m.getBlockers(item.Value)
 	dependents := m.getDependents(item.Value)
 
-	lines = append(lines, sectionStyle.Render("🔗 Dependencies"))
+	lines = append(lines, sectionStyle.Render(m.theme.Glyph("🔗 Dependencies")))
 	if len(blockers) > 0 {
 		blockerStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
 		lines = append(lines, fmt.Sprintf("   %s (%d):",
-			blockerStyle.Render("↓ Blocked by"),
+			blockerStyle.Render(m.theme.Glyph("↓ Blocked by")),
 			len(blockers)))
 		for _, b := range blockers {
 			if len(b) > 0 {
@@ -2145,7 +2739,7 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 				if bIssue != nil {
 					bTitle := bIssue.Title
 					if len(bTitle) > 25 {
-						bTitle = bTitle[:24] + "…"
+						bTitle = bTitle[:24] + m.theme.Glyph("…")
 					}
 					lines = append(lines, fmt.Sprintf("     %s %s",
 						labelStyle.Render(b),
@@ -2160,7 +2754,7 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 	if len(dependents) > 0 {
 		dependentStyle := t.Renderer.NewStyle().Foreground(t.Open)
 		lines = append(lines, fmt.Sprintf("   %s (%d):",
-			dependentStyle.Render("↑ Blocks"),
+			dependentStyle.Render(m.theme.Glyph("↑ Blocks")),
 			len(dependents)))
 		// Show up to 3 dependents
 		shown := dependents
@@ -2179,24 +2773,7 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 	lines = append(lines, "")
 
 	// Centrality metrics
-	prRank, prScore, btRank, btScore, total := m.getCentralityRank(item.Value)
-	if prRank > 0 || btRank > 0 {
-		lines = append(lines, sectionStyle.Render("📊 Centrality"))
-		if prRank > 0 {
-			rankBadge := RenderRankBadge(prRank, total)
-			lines = append(lines, fmt.Sprintf("   %s %s (%.3f)",
-				labelStyle.Render("PageRank:"),
-				rankBadge,
-				prScore))
-		}
-		if btRank > 0 {
-			rankBadge := RenderRankBadge(btRank, total)
-			lines = append(lines, fmt.Sprintf("   %s %s (%.3f)",
-				labelStyle.Render("Betweenness:"),
-				rankBadge,
-				btScore))
-		}
-	}
+	lines = append(lines, m.centralityLines(item.Value, sectionStyle, labelStyle)...)
 
 	// Pad to fixed height for consistent layout
 	return padToHeight(strings.Join(lines, "\n"), height, width)
@@ -2206,13 +2783,7 @@ func (m *LensSelectorModel) renderBeadStats(item LensItem, width, height int) st
 func (m *LensSelectorModel) renderStackedLayout() string {
 	t := m.theme
 
-	// Calculate dimensions
-	totalWidth := m.width - 6
-	if totalWidth < 50 {
-		totalWidth = 50
-	}
-
-	listHeight := (m.height * 55) / 100 // 55% for list
+	totalWidth, listHeight := m.stackedLayout()
 	statsHeight := (m.height * 35) / 100 // 35% for stats
 
 	// Render header
@@ -2227,7 +2798,7 @@ func (m *LensSelectorModel) renderStackedLayout() string {
 	// Divider between panels
 	divider := t.Renderer.NewStyle().
 		Foreground(ColorBgHighlight).
-		Render(strings.Repeat("─", totalWidth-4))
+		Render(strings.Repeat(m.theme.Glyph("─"), totalWidth-4))
 
 	// Render footer
 	footer := m.renderKeybindFooter(totalWidth)
@@ -2247,7 +2818,7 @@ func (m *LensSelectorModel) renderStackedLayout() string {
 
 	// Box style
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2)
 
@@ -2263,6 +2834,16 @@ func (m *LensSelectorModel) renderStackedLayout() string {
 	)
 }
 
+// stackedLayout returns the box width and list height for the stacked layout
+func (m *LensSelectorModel) stackedLayout() (totalWidth, listHeight int) {
+	totalWidth = m.width - 6
+	if totalWidth < 50 {
+		totalWidth = 50
+	}
+	listHeight = (m.height * 55) / 100 // 55% for list
+	return totalWidth, listHeight
+}
+
 // renderMinimalLayout renders a minimal list-only view for very narrow terminals
 func (m *LensSelectorModel) renderMinimalLayout() string {
 	t := m.theme
@@ -2303,11 +2884,7 @@ func (m *LensSelectorModel) renderMinimalLayout() string {
 	}
 	lines = append(lines, "")
 
-	// Calculate max visible - account for header(2) + search(2) + footer(2) + box border(2)
-	maxVisible := m.height - 8
-	if maxVisible < 3 {
-		maxVisible = 3
-	}
+	maxVisible := m.minimalMaxVisible()
 
 	// Item list
 	if len(m.filteredItems) == 0 {
@@ -2315,14 +2892,7 @@ func (m *LensSelectorModel) renderMinimalLayout() string {
 		lines = append(lines, emptyStyle.Render("  No items"))
 	} else {
 		// Calculate scroll window to keep selected in view
-		startIdx := 0
-		if m.selectedIndex >= maxVisible {
-			startIdx = m.selectedIndex - maxVisible + 1
-		}
-		endIdx := startIdx + maxVisible
-		if endIdx > len(m.filteredItems) {
-			endIdx = len(m.filteredItems)
-		}
+		startIdx, endIdx := m.visibleItemRange(maxVisible)
 
 		for i := startIdx; i < endIdx; i++ {
 			item := m.filteredItems[i]
@@ -2350,7 +2920,7 @@ func (m *LensSelectorModel) renderMinimalLayout() string {
 
 	// Thin box style
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(0, 1)
 
@@ -2365,12 +2935,26 @@ func (m *LensSelectorModel) renderMinimalLayout() string {
 	)
 }
 
+// minimalMaxVisible returns how many items fit in the minimal layout
+func (m *LensSelectorModel) minimalMaxVisible() int {
+	// Account for header(2) + search(2) + footer(2) + box border(2)
+	maxVisible := m.height - 8
+	if maxVisible < 3 {
+		maxVisible = 3
+	}
+	return maxVisible
+}
+
 // renderMinimalFooter creates a width-responsive footer for minimal layout
 func (m *LensSelectorModel) renderMinimalFooter(width int) string {
 	t := m.theme
 	keyStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
 	descStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
 
+	if m.viewNameMode {
+		return descStyle.Render("save view: ") + keyStyle.Render(m.viewNameInput+m.theme.Glyph("▏"))
+	}
+
 	// Build keybinds based on available width
 	if width >= 60 {
 		// Full descriptions
@@ -2387,7 +2971,7 @@ func (m *LensSelectorModel) renderMinimalFooter(width int) string {
 		// Abbreviated
 		if m.insertMode {
 			return keyStyle.Render("j/k") + descStyle.Render(" nav") + "  " +
-				keyStyle.Render("⏎") + descStyle.Render(" sel") + "  " +
+				keyStyle.Render(m.theme.Glyph("⏎")) + descStyle.Render(" sel") + "  " +
 				keyStyle.Render("esc")
 		}
 		return keyStyle.Render("j/k") + descStyle.Render(" nav") + "  " +
@@ -2396,7 +2980,7 @@ func (m *LensSelectorModel) renderMinimalFooter(width int) string {
 	}
 	// Ultra-compact for very narrow
 	if m.insertMode {
-		return keyStyle.Render("j/k") + " " + keyStyle.Render("⏎") + " " + keyStyle.Render("esc")
+		return keyStyle.Render("j/k") + " " + keyStyle.Render(m.theme.Glyph("⏎")) + " " + keyStyle.Render("esc")
 	}
 	return keyStyle.Render("j/k") + " " + keyStyle.Render("i") + " " + keyStyle.Render("q")
 }
@@ -2407,7 +2991,7 @@ func (m *LensSelectorModel) renderMinimalItem(item LensItem, isSelected bool, ma
 
 	prefix := "  "
 	if isSelected {
-		prefix = "▸ "
+		prefix = m.theme.Glyph("▸ ")
 	}
 
 	// Type indicator
@@ -2425,7 +3009,7 @@ func (m *LensSelectorModel) renderMinimalItem(item LensItem, isSelected bool, ma
 	title := item.Title
 	maxTitleLen := maxWidth - 8
 	if len(title) > maxTitleLen && maxTitleLen > 5 {
-		title = title[:maxTitleLen-1] + "…"
+		title = title[:maxTitleLen-1] + m.theme.Glyph("…")
 	}
 
 	nameStyle := t.Renderer.NewStyle()
@@ -2433,5 +3017,9 @@ func (m *LensSelectorModel) renderMinimalItem(item LensItem, isSelected bool, ma
 		nameStyle = nameStyle.Foreground(t.Primary).Bold(true)
 	}
 
+	if item.IsPinned {
+		typeChar += " " + t.Renderer.NewStyle().Foreground(t.Feature).Render(m.theme.Glyph("★"))
+	}
+
 	return prefix + typeChar + " " + nameStyle.Render(title)
 }
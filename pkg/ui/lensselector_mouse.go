@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// itemRows returns the screen row of the first visible item and how many
+// items fit, for whichever layout View() renders at the current size.
+func (m *LensSelectorModel) itemRows() (top, maxVisible int) {
+	// The box is centered; its top border is the first non-blank line
+	boxTop := 0
+	for i, line := range strings.Split(m.View(), "\n") {
+		if strings.TrimSpace(line) != "" {
+			boxTop = i
+			break
+		}
+	}
+
+	// Border and padding, header, blank line, then the left panel
+	panelTop := func(headerWidth, panelWidth int) int {
+		header := lipgloss.Height(m.renderLensHeader(headerWidth))
+		panelHeader := lipgloss.Height(strings.Join(m.renderLeftPanelHeader(panelWidth), "\n"))
+		return boxTop + 2 + header + 1 + panelHeader
+	}
+
+	switch {
+	case m.width < BreakpointNarrow:
+		// Border, then LENS, blank, search, blank
+		return boxTop + 1 + 4, m.minimalMaxVisible()
+	case m.width < BreakpointMedium:
+		totalWidth, listHeight := m.stackedLayout()
+		return panelTop(totalWidth, totalWidth), leftPanelMaxVisible(listHeight)
+	default:
+		totalWidth, panelWidth, contentHeight := m.dualPanelLayout()
+		return panelTop(totalWidth, panelWidth), leftPanelMaxVisible(contentHeight)
+	}
+}
+
+// HandleMouse scrolls the list with the wheel and selects the clicked item.
+// It returns true when the already selected item is clicked again, which the
+// caller treats like enter.
+func (m *LensSelectorModel) HandleMouse(msg tea.MouseMsg) bool {
+	if m.viewPickerMode || m.viewNameMode {
+		return false
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.moveUp()
+	case tea.MouseButtonWheelDown:
+		m.moveDown()
+	case tea.MouseButtonLeft:
+		if msg.Action != tea.MouseActionPress || len(m.filteredItems) == 0 {
+			return false
+		}
+		top, maxVisible := m.itemRows()
+		start, end := m.visibleItemRange(maxVisible)
+		idx := start + msg.Y - top
+		if msg.Y < top || idx >= end {
+			return false
+		}
+		if idx == m.selectedIndex && m.hasNavigated {
+			return true
+		}
+		m.selectedIndex = idx
+		m.hasNavigated = true
+	}
+	return false
+}
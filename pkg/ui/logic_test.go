@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
 )
@@ -163,6 +165,46 @@ func TestTimeTravel_DiffBadgePropagation(t *testing.T) {
 	}
 }
 
+func TestTimeTravel_AffectedFilter(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "base", Status: model.StatusOpen},
+		{ID: "mid", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "base", Type: model.DepBlocks}}},
+		{ID: "leaf", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "mid", Type: model.DepBlocks}}},
+		{ID: "other", Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "")
+
+	// Without a comparison point there is nothing to tag
+	m = m.handleListKeys(keyMsg("A"))
+	if m.currentFilter != "all" || !m.statusIsError {
+		t.Fatalf("filter = %q, status %q: want a time-travel hint", m.currentFilter, m.statusMsg)
+	}
+
+	// Simulate enterTimeTravelMode with base modified
+	m.timeTravelMode = true
+	m.timeTravelSince = "HEAD~5"
+	m.modifiedIssueIDs = map[string]bool{"base": true}
+	m.affectedIssues = analysis.AffectedCone(m.issues, []string{"base"})
+
+	m = m.handleListKeys(keyMsg("A"))
+	var got []string
+	for _, it := range m.list.Items() {
+		item := it.(IssueItem)
+		got = append(got, item.Issue.ID+":"+item.DiffStatus.Badge())
+	}
+	if strings.Join(got, " ") != "base:~ mid:↳ leaf:↳" {
+		t.Errorf("affected items = %v", got)
+	}
+	if m.statusMsg != "🎯 1 changed since HEAD~5, 2 more downstream" {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+
+	m.exitTimeTravelMode()
+	if m.currentFilter != "all" || m.affectedIssues != nil || len(m.list.Items()) != 4 {
+		t.Errorf("leaving time-travel kept filter %q with %d items", m.currentFilter, len(m.list.Items()))
+	}
+}
+
 func TestFormatTimeRel(t *testing.T) {
 	now := time.Now()
 	tests := []struct {
@@ -689,6 +731,85 @@ func TestLensDashboardDepthBehavior(t *testing.T) {
 	}
 }
 
+func TestLensDashboardSectionSort(t *testing.T) {
+	// Three ready issues: a new P1, a 10-day-old P2 and a 30-day-old P3 that
+	// blocks two others. Only the order within the ready section changes.
+	now := time.Now()
+	blockedBy := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		{ID: "new", Status: model.StatusOpen, Priority: 1, CreatedAt: now, Labels: []string{"l"}},
+		{ID: "mid", Status: model.StatusOpen, Priority: 2, CreatedAt: now.AddDate(0, 0, -10), Labels: []string{"l"}},
+		{ID: "old", Status: model.StatusOpen, Priority: 3, CreatedAt: now.AddDate(0, 0, -30), Labels: []string{"l"}},
+		{ID: "x1", Status: model.StatusOpen, Priority: 1, Labels: []string{"l"}, Dependencies: blockedBy("old")},
+		{ID: "x2", Status: model.StatusOpen, Priority: 1, Labels: []string{"l"}, Dependencies: blockedBy("old")},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	dashboard := NewLensDashboardModel("l", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	dashboard.SetSize(80, 40)
+
+	readyOrder := func() string {
+		var ids []string
+		for _, node := range dashboard.flatNodes {
+			switch id := node.Node.Issue.ID; id {
+			case "new", "mid", "old":
+				ids = append(ids, id)
+			}
+		}
+		return strings.Join(ids, ",")
+	}
+
+	dashboard.cursor = 0
+	dashboard.selectedIssueID = dashboard.flatNodes[0].Node.Issue.ID
+	selected := dashboard.SelectedIssueID()
+
+	for _, want := range []struct {
+		sort  SectionSort
+		order string
+	}{
+		{SectionSortTopo, "new,mid,old"},
+		{SectionSortPriority, "new,mid,old"},
+		{SectionSortCreated, "old,mid,new"},
+		{SectionSortUpdated, "new,mid,old"}, // No update dates: blockers order
+		{SectionSortID, "mid,new,old"},
+		{SectionSortImpact, "old,new,mid"},
+		{SectionSortPageRank, "new,mid,old"}, // No graph metrics yet
+		{SectionSortTopo, "new,mid,old"},
+	} {
+		if dashboard.GetSectionSort() != want.sort {
+			t.Fatalf("sort = %v, want %v", dashboard.GetSectionSort(), want.sort)
+		}
+		if got := readyOrder(); got != want.order {
+			t.Errorf("%v: ready order = %s, want %s", want.sort, got, want.order)
+		}
+		if dashboard.SelectedIssueID() != selected {
+			t.Errorf("%v: selection moved from %s to %s", want.sort, selected, dashboard.SelectedIssueID())
+		}
+		dashboard.CycleSectionSort()
+	}
+
+	// O reverses the key: priority descending puts the P3 first
+	dashboard.SetSectionOrder(SectionSortPriority, false)
+	dashboard.ToggleSectionSortDirection()
+	if got := readyOrder(); got != "old,mid,new" {
+		t.Errorf("priority desc: ready order = %s, want old,mid,new", got)
+	}
+
+	for _, value := range []string{"blockers", "updated", "updated asc", "priority desc", "pagerank"} {
+		s, desc, ok := ParseSectionOrder(value)
+		if !ok || FormatSectionOrder(s, desc) != value {
+			t.Errorf("%q round-trips as %q (ok %v)", value, FormatSectionOrder(s, desc), ok)
+		}
+	}
+	if _, _, ok := ParseSectionOrder("size"); ok {
+		t.Error("unknown sort key should not parse")
+	}
+}
+
 func TestLensSelectorDirectCountsOnly(t *testing.T) {
 	// Setup: parent has label, children do NOT have label
 	// Label selector should count ONLY directly labeled issues (not descendants)
@@ -858,3 +979,295 @@ func TestCrossEpicContextBlockerIsolation(t *testing.T) {
 			"expected max 4 (epic1 tree + blockers only)", total)
 	}
 }
+
+func TestLensDashboardArchaeologyNestsClosedChains(t *testing.T) {
+	// A (closed) blocks B (closed) blocks C (open). Normally closed blockers
+	// don't gate anything, so C is a ready root; archaeology keeps the chain.
+	closedA := time.Date(2025, 3, 1, 12, 0, 0, 0, time.Local)
+	closedB := time.Date(2025, 3, 14, 12, 0, 0, 0, time.Local)
+	issues := []model.Issue{
+		{ID: "A", Title: "Design", Status: model.StatusClosed, ClosedAt: &closedA, Labels: []string{"arch"}},
+		{ID: "B", Title: "Build", Status: model.StatusClosed, ClosedAt: &closedB, Labels: []string{"arch"}, Dependencies: []*model.Dependency{
+			{DependsOnID: "A", Type: model.DepBlocks},
+		}},
+		{ID: "C", Title: "Ship", Status: model.StatusOpen, Labels: []string{"arch"}, Dependencies: []*model.Dependency{
+			{DependsOnID: "B", Type: model.DepBlocks},
+		}},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+
+	dashboard := NewLensDashboardModel("arch", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	dashboard.SetSize(120, 40)
+	dashboard.SetDepth(DepthAll)
+
+	depthOf := func(id string) int {
+		for _, fn := range dashboard.flatNodes {
+			if fn.Node.Issue.ID == id {
+				return fn.Node.Depth
+			}
+		}
+		t.Fatalf("%s not in tree", id)
+		return -1
+	}
+
+	if d := depthOf("C"); d != 0 {
+		t.Errorf("without archaeology C should be a root, got depth %d", d)
+	}
+
+	dashboard.SetArchaeologyMode(true)
+	if !dashboard.IsArchaeologyMode() {
+		t.Fatal("expected archaeology mode on")
+	}
+	for id, want := range map[string]int{"A": 0, "B": 1, "C": 2} {
+		if d := depthOf(id); d != want {
+			t.Errorf("archaeology depth of %s = %d, want %d", id, d, want)
+		}
+	}
+
+	out := dashboard.View()
+	for _, want := range []string{"✓ 2025-03-01", "✓ 2025-03-14"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected closure date %q in view", want)
+		}
+	}
+}
+
+func TestLensSelectorEpicScopeIndicator(t *testing.T) {
+	kickoff := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "epic", Title: "Epic", IssueType: model.TypeEpic, Status: model.StatusOpen},
+		{ID: "a", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{DependsOnID: "epic", Type: model.DepParentChild},
+		}},
+	}
+	selector := NewLensSelectorModel(issues, DefaultTheme(lipgloss.DefaultRenderer()), nil)
+	item := LensItem{Type: "epic", Value: "epic", Title: "Epic", IssueCount: 1}
+
+	if strings.Contains(selector.renderEpicStats(item, 80, 40), "Scope:") {
+		t.Error("epics without a kickoff snapshot should not show a scope line")
+	}
+
+	selector.SetEpicScopeChanges(map[string]analysis.EpicScopeChange{
+		"epic": {KickoffAt: kickoff, Planned: 4, Added: []string{"a", "b", "c", "d", "e", "f"}, Removed: []string{"x"}},
+	})
+	out := selector.renderEpicStats(item, 80, 40)
+	if !strings.Contains(out, "+6 issues, −1 removed") || !strings.Contains(out, "since kickoff (Mar 03, 4 planned)") {
+		t.Errorf("expected scope-change indicator, got:\n%s", out)
+	}
+}
+
+func TestLensSelectorEpicReviewCoverage(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "epic", Title: "Epic", IssueType: model.TypeEpic, Status: model.StatusOpen},
+		{ID: "a", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{DependsOnID: "epic", Type: model.DepParentChild},
+		}},
+	}
+	selector := NewLensSelectorModel(issues, DefaultTheme(lipgloss.DefaultRenderer()), nil)
+	item := LensItem{Type: "epic", Value: "epic", Title: "Epic", IssueCount: 1}
+
+	plain := stripAnsi(selector.renderEpicStats(item, 80, 40))
+	if !strings.Contains(plain, "🔍 Review Coverage") || !strings.Contains(plain, "0%  ✓0 ✎0 ·1") {
+		t.Errorf("expected an unreviewed child in the review coverage, got:\n%s", plain)
+	}
+	if _, ok := selector.coverage["epic"]; !ok {
+		t.Error("coverage should be cached after the first render")
+	}
+}
+
+func TestLensDashboardWorkstreamJumpAndPages(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen, Labels: []string{"l"}},
+		{ID: "B", Status: model.StatusOpen, Labels: []string{"l"}},
+	}
+	issueMap := map[string]*model.Issue{"A": &issues[0], "B": &issues[1]}
+	dashboard := NewLensDashboardModel("l", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	dashboard.SetSize(100, 60)
+	dashboard.ToggleViewType()
+
+	// Three streams; the second holds 25 issues (three pages)
+	var big []model.Issue
+	for i := 1; i <= 25; i++ {
+		big = append(big, model.Issue{ID: fmt.Sprintf("big-%02d", i), Title: "Big", Status: model.StatusOpen})
+	}
+	dashboard.SetWorkstreams([]analysis.Workstream{
+		{ID: "s1", Name: "first", Issues: issues[:1]},
+		{ID: "s2", Name: "second", Issues: big},
+		{ID: "s3", Name: "third", Issues: issues[1:]},
+	})
+
+	if !dashboard.JumpToWorkstream(1) || dashboard.CurrentWorkstreamName() != "second" || !dashboard.IsOnWorkstreamHeader() {
+		t.Fatalf("jump to stream 2 landed on %q", dashboard.CurrentWorkstreamName())
+	}
+	if !dashboard.IsWorkstreamExpanded(1) {
+		t.Error("jumping should expand the target stream")
+	}
+	if dashboard.JumpToWorkstream(3) {
+		t.Error("there is no fourth stream")
+	}
+
+	view := dashboard.View()
+	if !strings.Contains(view, "page 1/3 · 1-10 of 25") || strings.Contains(view, "big-11") {
+		t.Errorf("expected the first page of ten issues, got:\n%s", view)
+	}
+
+	dashboard.NextWorkstreamPage() // From the header: first issue of the shown page
+	dashboard.NextWorkstreamPage()
+	if got := dashboard.SelectedIssueID(); got != "big-11" {
+		t.Errorf("next page selected %q, want big-11", got)
+	}
+	view = dashboard.View()
+	if !strings.Contains(view, "page 2/3 · 11-20 of 25") || strings.Contains(view, "big-01") {
+		t.Errorf("expected the second page, got:\n%s", view)
+	}
+
+	// Moving off the end of a page turns it
+	for i := 0; i < 10; i++ {
+		dashboard.MoveDown()
+	}
+	if page, pages := dashboard.WorkstreamPage(); page != 3 || pages != 3 || dashboard.SelectedIssueID() != "big-21" {
+		t.Errorf("after 10 downs: page %d/%d on %q", page, pages, dashboard.SelectedIssueID())
+	}
+
+	dashboard.PrevWorkstreamPage()
+	if got := dashboard.SelectedIssueID(); got != "big-11" {
+		t.Errorf("previous page selected %q, want big-11", got)
+	}
+}
+
+func TestLensDashboardGroupedRowNavigation(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A1", Status: model.StatusOpen, Labels: []string{"l"}},
+		{ID: "A2", Status: model.StatusOpen, Labels: []string{"l"}},
+		{ID: "A3", Status: model.StatusOpen, Labels: []string{"l"}},
+		{ID: "B1", Status: model.StatusOpen, Labels: []string{"l"}, Dependencies: []*model.Dependency{
+			{IssueID: "B1", DependsOnID: "B2", Type: model.DepBlocks},
+		}},
+		{ID: "B2", Status: model.StatusOpen, Labels: []string{"l"}},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	dashboard := NewLensDashboardModel("l", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	dashboard.SetSize(100, 60)
+	dashboard.EnterGroupedView()
+	dashboard.groupedSections = []analysis.Workstream{
+		{ID: "alpha", Name: "alpha", Issues: issues[:3], SubWorkstreams: []*analysis.Workstream{
+			{ID: "a1", Name: "a1", Issues: issues[:2]},
+			{ID: "a2", Name: "a2", Issues: issues[2:3]},
+		}},
+		{ID: "beta", Name: "beta", Issues: issues[3:]},
+	}
+	dashboard.groupedExpanded = map[int]bool{}
+	dashboard.groupedSubExpanded = map[int]map[int]bool{}
+	dashboard.updateSelectedIssueFromGrouped()
+
+	if dashboard.CursorRow() != LensRowHeader || dashboard.SelectedIssueID() != "A1" {
+		t.Fatalf("group header should select its first issue, got row %d on %q", dashboard.CursorRow(), dashboard.SelectedIssueID())
+	}
+
+	// l expands, then steps in: group -> sub-group -> issue
+	steps := []struct {
+		row  LensRow
+		name string
+	}{
+		{LensRowHeader, "alpha"}, // Expanded
+		{LensRowSubHeader, "a1"}, // Stepped onto the first sub-group
+		{LensRowSubHeader, "a1"}, // Expanded
+		{LensRowIssue, "a1"},     // Stepped onto A1
+	}
+	for i, want := range steps {
+		if !dashboard.ExpandRow() {
+			t.Fatalf("step %d: ExpandRow did nothing", i)
+		}
+		if dashboard.CursorRow() != want.row || dashboard.CursorRowName() != want.name {
+			t.Fatalf("step %d: on row %d of %q, want %d of %q", i, dashboard.CursorRow(), dashboard.CursorRowName(), want.row, want.name)
+		}
+	}
+	if dashboard.ExpandRow() {
+		t.Error("issues have nothing to expand")
+	}
+	dashboard.MoveDown()
+	if got := dashboard.SelectedIssueID(); got != "A2" {
+		t.Fatalf("moved to %q, want A2", got)
+	}
+
+	// h steps out to the sub-group, collapses it, steps out to the group and collapses that
+	dashboard.CollapseRow()
+	if dashboard.CursorRow() != LensRowSubHeader || !dashboard.IsCursorRowExpanded() || dashboard.SelectedIssueID() != "A1" {
+		t.Fatalf("expected the expanded a1 header, got row %d on %q", dashboard.CursorRow(), dashboard.SelectedIssueID())
+	}
+	dashboard.CollapseRow()
+	if dashboard.IsCursorRowExpanded() {
+		t.Error("second h should collapse the sub-group")
+	}
+	dashboard.CollapseRow()
+	if dashboard.CursorRow() != LensRowHeader || !dashboard.IsCursorRowExpanded() {
+		t.Errorf("third h should step out to the expanded group header")
+	}
+	dashboard.CollapseRow()
+	if dashboard.IsCursorRowExpanded() || dashboard.CollapseRow() {
+		t.Error("h on a collapsed group should do nothing")
+	}
+
+	// Tree view selects issues in the order they are drawn: B2 blocks B1
+	dashboard.ToggleGroupedTreeView()
+	dashboard.MoveDown()
+	dashboard.ExpandRow()
+	dashboard.ExpandRow()
+	if got := dashboard.SelectedIssueID(); got != "B2" {
+		t.Errorf("first tree row selected %q, want B2", got)
+	}
+	dashboard.MoveDown()
+	if got := dashboard.SelectedIssueID(); got != "B1" {
+		t.Errorf("second tree row selected %q, want B1", got)
+	}
+	if line, want := dashboard.getGroupedCursorLine(), 4; line != want {
+		t.Errorf("cursor line = %d, want %d", line, want)
+	}
+}
+
+func TestLensDashboardEnterOpensIssueDetail(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Labels: []string{"l"}},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Labels: []string{"l"}},
+	}
+	m := NewModel(issues, nil, "")
+	issueMap := map[string]*model.Issue{"A": &issues[0], "B": &issues[1]}
+	m.lensDashboard = NewLensDashboardModel("l", issues, issueMap, m.theme)
+	m.lensDashboard.SetSize(140, 40)
+	m.lensDashboard.ToggleViewType()
+	m.lensDashboard.SetWorkstreams([]analysis.Workstream{
+		{ID: "s1", Name: "first", Issues: issues[:1]},
+		{ID: "s2", Name: "second", Issues: issues[1:]},
+	})
+	m.showLensDashboard = true
+	m.focused = focusLensDashboard
+
+	// Enter on a header toggles it
+	wasExpanded := m.lensDashboard.IsWorkstreamExpanded(0)
+	m = m.handleLensDashboardKeys(keyMsg("enter"))
+	if m.lensDashboard.IsWorkstreamExpanded(0) == wasExpanded {
+		t.Fatal("enter on a header should toggle it")
+	}
+
+	// Enter on an issue focuses the detail panel instead of collapsing the stream
+	m = m.handleLensDashboardKeys(keyMsg("j"))
+	expanded := m.lensDashboard.IsWorkstreamExpanded(0)
+	m = m.handleLensDashboardKeys(keyMsg("enter"))
+	if !m.lensDashboard.IsDetailFocused() || m.lensDashboard.IsWorkstreamExpanded(0) != expanded {
+		t.Fatalf("enter on an issue should open its detail, status %q", m.statusMsg)
+	}
+	m = m.handleLensDashboardKeys(keyMsg("h"))
+	if m.lensDashboard.IsDetailFocused() {
+		t.Error("h should return focus to the tree")
+	}
+	m = m.handleLensDashboardKeys(keyMsg("h"))
+	if !m.lensDashboard.IsOnWorkstreamHeader() {
+		t.Error("h on an issue should step out to the workstream header")
+	}
+}
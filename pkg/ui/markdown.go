@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"strings"
+
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/glamour/ansi"
 	"github.com/charmbracelet/lipgloss"
@@ -164,6 +166,18 @@ func (mr *MarkdownRenderer) IsDarkMode() bool {
 	return mr.isDark
 }
 
+// RenderPanel renders markdown wrapped to width for a detail panel, without
+// the blank lines glamour puts around the document. If rendering fails the
+// text comes back as it was.
+func (mr *MarkdownRenderer) RenderPanel(markdown string, width int) string {
+	mr.SetWidth(width)
+	out, err := mr.Render(markdown)
+	if err != nil {
+		return markdown
+	}
+	return strings.Trim(out, "\n")
+}
+
 // buildStyleFromTheme creates a glamour StyleConfig that matches the bv Theme.
 func buildStyleFromTheme(theme Theme, isDark bool) ansi.StyleConfig {
 	// Extract hex colors from adaptive colors
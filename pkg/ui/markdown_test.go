@@ -223,3 +223,22 @@ func TestBuildStyleFromTheme(t *testing.T) {
 		t.Errorf("expected light mode BackgroundColor to be nil, got %v", lightConfig.Document.BackgroundColor)
 	}
 }
+
+func TestMarkdownRenderer_RenderPanel(t *testing.T) {
+	mr := NewMarkdownRendererWithTheme(80, DefaultTheme(lipgloss.DefaultRenderer()))
+	out := stripAnsi(mr.RenderPanel("## Goal\n\nRefresh **tokens** early.\n\n- one\n- two", 30))
+	if mr.width != 30 {
+		t.Errorf("expected the renderer rewrapped to 30, got %d", mr.width)
+	}
+	if strings.HasPrefix(out, "\n") || strings.HasSuffix(out, "\n") {
+		t.Errorf("expected no blank lines around the panel text, got %q", out)
+	}
+	if strings.Contains(out, "**") || !strings.Contains(out, "• one") {
+		t.Errorf("expected rendered markdown, got %q", out)
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if lipgloss.Width(line) > 30 {
+			t.Errorf("line wider than the panel: %q", line)
+		}
+	}
+}
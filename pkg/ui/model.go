@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/cass"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/claims"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
@@ -22,11 +25,16 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/search"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/themes"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui/graphview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui/keymap"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/updater"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/views"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/watcher"
 
 	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -67,6 +75,9 @@ const (
 	focusLensSelector   // Lens selector picker
 	focusLensDashboard  // Lens dashboard tree view
 	focusReviewDashboard // Review dashboard for issue review
+	focusStatsDashboard  // Project stats dashboard (aging, flow, velocity, burndown, burnup)
+	focusGraphCanvas     // Layered dependency graph canvas
+	focusTable           // Column table of the list's issues
 )
 
 // SortMode represents the current list sorting mode (bv-3ita)
@@ -78,6 +89,10 @@ const (
 	SortCreatedDesc                 // By creation date, newest first
 	SortPriority                    // By priority only (ascending)
 	SortUpdated                     // By last update, newest first
+	SortDependents                  // By transitive open dependents, most first
+	SortStale                       // Open issues untouched longest first
+	SortEffective                   // By priority inherited from blocked work, blockers first
+	SortLeverage                    // By dependents per estimated hour, small unblockers first
 	numSortModes                    // Keep this last - used for cycling
 )
 
@@ -92,6 +107,14 @@ func (s SortMode) String() string {
 		return "Priority"
 	case SortUpdated:
 		return "Updated"
+	case SortDependents:
+		return "Dependents"
+	case SortStale:
+		return "Stalest"
+	case SortEffective:
+		return "Effective priority"
+	case SortLeverage:
+		return "Leverage"
 	default:
 		return "Default"
 	}
@@ -151,8 +174,12 @@ func WatchFileCmd(w *watcher.Watcher) tea.Cmd {
 	}
 }
 
-// CheckUpdateCmd returns a command that checks for updates
-func CheckUpdateCmd() tea.Cmd {
+// CheckUpdateCmd returns a command that checks for updates, or nil when the
+// startup check is disabled (BV_NO_UPDATE_CHECK, or no_update_check in cfg).
+func CheckUpdateCmd(cfg *config.Config) tea.Cmd {
+	if !updater.StartupCheckEnabled() || (cfg != nil && cfg.NoUpdateCheck) {
+		return nil
+	}
 	return func() tea.Msg {
 		tag, url, err := updater.CheckForUpdates()
 		if err == nil && tag != "" {
@@ -260,8 +287,16 @@ type Model struct {
 	analyzer  *analysis.Analyzer
 	analysis  *analysis.GraphStats
 	beadsPath string           // Path to beads.jsonl for reloading
+	beadsSum  string           // Checksum of beadsPath at last load; unchanged rewrites skip reload
+	stream    *issueStream     // Streaming load still in progress (nil once everything is loaded)
 	watcher   *watcher.Watcher // File watcher for live reload
 
+	inlineHeight int // Row cap when running inline, without the alt screen (0 = full height)
+
+	// Centrality ranks for the lens selector, ranked in the background
+	centrality        *centralityRanks     // Ranks of analysis (stale after a reload)
+	centralityPending *analysis.GraphStats // Analysis currently being ranked
+
 	// UI Components
 	list               list.Model
 	viewport           viewport.Model
@@ -276,7 +311,11 @@ type Model struct {
 	lensDashboard      LensDashboardModel   // Advanced tree-based dashboard with workstream support
 	lensSelector       LensSelectorModel    // Lens picker for selecting label/epic/bead to explore
 	reviewDashboard    *ReviewDashboardModel // Review dashboard for reviewing issues
+	statsDashboard     StatsDashboardModel   // Project-wide stats charts
+	tableView          TableViewModel        // Dense column table of the list's issues
+	graphCanvas        graphview.Model       // 2D layered dependency graph (opened from the graph view)
 	theme              Theme
+	ascii              bool // --ascii: every theme the views get draws glyphs in ASCII
 
 	// Update State
 	updateAvailable bool
@@ -288,11 +327,17 @@ type Model struct {
 	isSplitView              bool
 	isBoardView              bool
 	isGraphView              bool
+	showGraphCanvas          bool
 	isActionableView         bool
 	isHistoryView            bool
 	showDetails              bool
+	showPeek                 bool // Hovercard over the selected list row
 	showHelp                 bool
 	helpScroll               int // Scroll offset for help overlay
+	helpContext              keymap.Context // View whose keys the help overlay lists
+	helpReturnFocus          focus          // Focus restored when the help overlay closes
+	helpQuery                string         // Help filter typed after /
+	helpSearching            bool           // True while the help filter is typed
 	showQuitConfirm          bool
 	ready                    bool
 	width                    int
@@ -357,16 +402,82 @@ type Model struct {
 	quickWinSet   map[string]bool                   // issueID -> true if quick win
 	blockerSet    map[string]bool                   // issueID -> true if significant blocker
 
+	// Transitive open dependents per issue ("↑N" column, SortDependents)
+	dependentsCount map[string]int
+
+	// Transitive open dependents per estimated hour (SortLeverage)
+	leverage map[string]float64
+
+	// Priorities open issues inherit from the more urgent work they block
+	effectivePriority map[string]analysis.EffectivePriority
+
+	// Closed-issue archaeology: closed issues shape lens trees and the graph (A)
+	archaeologyMode bool
+
 	// Recipe picker
 	showRecipePicker bool
 	recipePicker     RecipePickerModel
 	activeRecipe     *recipe.Recipe
 	recipeLoader     *recipe.Loader
 
+	// Saved lens views (~/.config/bv/views.yaml)
+	savedViews      *views.Store
+	savedViewsErr   error          // Load error; saving is disabled so a broken file isn't overwritten
+	pendingLensView *views.View    // Depth/view type to apply when the next lens dashboard opens
+	lensHistory     lensHistory    // Dashboards opened this session, for ctrl+o / ctrl+n
+	lensCurrent     lensRef        // The lens dashboard on screen
+	lensStack       []lensCrumb    // Dashboards drilled out of with L; esc pops one
+	lensDetailMode  lensDetailMode // Detail panel shown or hidden with v, kept across dashboards
+
+	// Completion notices for long background jobs (notify in .bv.yaml)
+	backgroundOps map[string]backgroundOp // Running jobs by key
+	toast         *toast                  // Notice on screen, nil when none
+	toastSeq      int                     // Last toast id, so stale ticks are ignored
+
+	// Per-project defaults (.bv.yaml or .beads/bv.toml)
+	projectConfig *config.Config
+	keymap        *keymap.Keymap // Action bindings, keybindings included; translates keys into the built-in ones
+
+	// Epic membership snapshots for scope-change detection (.beads/epic_scope.json)
+	epicScope *analysis.EpicScopeData
+
+	// Workstream claims (.beads/claims.json), reread with the issues
+	claimCoverage map[string][]claims.Claim // Issue ID -> claims covering it
+	labelClaims   map[string][]claims.Claim // Label -> claims on it
+	claimWarning  string                    // Overlap or load problem, shown in the status bar
+
 	// Label picker (bv-126)
 	showLabelPicker bool
 	labelPicker     LabelPickerModel
 
+	// Command palette (ctrl+p)
+	showCommandPalette bool
+	commandPalette     CommandPaletteModel
+
+	// Jump to issue (: or ctrl+g)
+	showJump bool
+	jump     JumpModel
+
+	// Status quick actions on the selected issue (ctrl+x)
+	showStatusMenu   bool
+	statusMenuID     string
+	statusMenuCursor int
+
+	// Links named in the detail panel's issue text (n/N select, v opens)
+	detailLinks      []issueLink
+	detailLinksID    string
+	detailLinksValid bool // detailLinks are detailLinksID's, collected since the last reload
+	detailLinkCursor int
+	codeRefID        string // Issue ctrl+] last opened a file of
+	codeRefNext      int    // Which of its files ctrl+] opens next
+
+	// Custom actions from the project config, run on the selected issue,
+	// and the result pane of the last captured one
+	customActions      []customAction
+	showActionResult   bool
+	actionResult       *CustomActionDoneMsg
+	actionResultScroll int
+
 	// Repo picker (workspace mode)
 	showRepoPicker bool
 	repoPicker     RepoPickerModel
@@ -379,6 +490,10 @@ type Model struct {
 	closedIssueIDs   map[string]bool // Issues in diff.ClosedIssues
 	modifiedIssueIDs map[string]bool // Issues in diff.ModifiedIssues
 
+	// affectedIssues is the cone downstream of the issues that changed
+	// since timeTravelSince, for the "affected" filter
+	affectedIssues map[string]analysis.AffectedIssue
+
 	// Time-travel input prompt
 	timeTravelInput      textinput.Model
 	showTimeTravelPrompt bool
@@ -389,6 +504,8 @@ type Model struct {
 
 	// Workspace mode state
 	workspaceMode    bool            // True when viewing multiple repos
+	readOnly         bool            // True when the bd CLI is missing: browse only, no writes
+	dryRun           *loader.DryRun  // Non-nil with --dry-run: writes are collected, not persisted
 	availableRepos   []string        // List of repo prefixes available
 	activeRepos      map[string]bool // Which repos are currently shown (nil = all)
 	workspaceSummary string          // Summary text for footer (e.g., "3 repos")
@@ -402,6 +519,28 @@ type Model struct {
 	alertsCursor    int
 	dismissedAlerts map[string]bool
 
+	// Pending changes panel (--dry-run)
+	showPendingChanges bool
+	pendingScroll      int
+
+	// Health panel: tracker integrity problems (E)
+	showHealth   bool
+	health       analysis.IntegrityReport
+	healthCursor int
+
+	// Duplicates panel: issues that read alike (=)
+	showDuplicates   bool
+	duplicates       []analysis.DuplicatePair
+	duplicatesCursor int
+
+	// Color themes: built-in and ~/.config/bv/themes palettes (ctrl+t gallery)
+	themes             []themes.Palette
+	themeIndex         int  // Palette in use
+	darkBackground     bool // Background the adaptive colors resolve for
+	backgroundSet      bool // Set by theme or --theme rather than detected
+	showThemeGallery   bool
+	themeGalleryCursor int // Palette being previewed
+
 	// Sprint view (bv-161)
 	sprints        []model.Sprint
 	selectedSprint *model.Sprint
@@ -421,6 +560,22 @@ type Model struct {
 	showCassModal  bool
 	cassModal      CassSessionModal
 	cassCorrelator *cass.Correlator
+
+	// "Why is this blocked?" explorer over the selected issue (B)
+	showBlockerChain bool
+	blockerChain     BlockerChainModal
+
+	// Side-by-side comparison of two lenses picked with c in the lens selector
+	showLensCompare bool
+	lensCompare     LensCompareModel
+
+	// Two workstreams of the open lens side by side (c twice)
+	showWorkstreamCompare bool
+	workstreamCompare     WorkstreamCompareModel
+
+	// Rename / merge of a label picked with e in the lens selector
+	showLabelManager bool
+	labelManager     LabelManagerModal
 }
 
 // labelCount is a simple label->count pair for display
@@ -555,9 +710,25 @@ func (m *Model) updateListDelegate() {
 		PriorityHints:     m.priorityHints,
 		WorkspaceMode:     m.workspaceMode,
 		ShowSearchScores:  m.shouldShowSearchScores(),
+		StaleDays:         m.staleDays(),
+		Claims:            m.claimCoverage,
+
+		EffectivePriorities: m.effectivePrioritiesColumn(),
 	})
 }
 
+// effectivePrioritiesColumn returns the inherited priorities for the list
+// column, shown only while sorting by them
+func (m *Model) effectivePrioritiesColumn() map[string]analysis.EffectivePriority {
+	if m.sortMode != SortEffective {
+		return nil
+	}
+	if m.effectivePriority == nil {
+		return map[string]analysis.EffectivePriority{}
+	}
+	return m.effectivePriority
+}
+
 func (m *Model) applySemanticScores(term string) {
 	if m.semanticSearch == nil {
 		return
@@ -616,10 +787,29 @@ func (m *Model) clearSemanticScores() {
 
 // NewModel creates a new Model from the given issues
 // beadsPath is the path to the beads.jsonl file for live reload support
-func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath string) Model {
+func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath string, opts ...ModelOption) Model {
+	var options modelOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	// The model saves pins, sorts and names into its own copy, and the flags
+	// override only that copy, so the caller's config is left as loaded
+	projectConfig := &config.Config{}
+	if options.projectConfig != nil {
+		projectConfig = options.projectConfig.Clone()
+	}
+	projectConfig.ASCII = projectConfig.ASCII || options.ascii
+	projectConfig.EpicRollup = projectConfig.EpicRollup || options.epicRollup
+	if options.theme != "" {
+		projectConfig.Theme = options.theme
+	}
+	ids := newIDAliases(projectConfig.IDDisplay, projectConfig.IDPrefix, issues)
+
 	// Graph Analysis - Phase 1 is instant, Phase 2 runs in background
-	analyzer := analysis.NewAnalyzer(issues)
-	graphStats := analyzer.AnalyzeAsync(context.Background())
+	// (or is skipped entirely when the disk cache has this exact data)
+	cachedAnalyzer := newCachedAnalyzer(issues, beadsPath, options.dryRun != nil)
+	analyzer := cachedAnalyzer.Analyzer
+	graphStats := cachedAnalyzer.AnalyzeAsync(context.Background())
 
 	// Sort issues
 	if activeRecipe != nil && activeRecipe.Sort.Field != "" {
@@ -650,8 +840,8 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	} else {
 		// Default Sort: Open first, then by Priority (ascending), then by date (newest first)
 		sort.Slice(issues, func(i, j int) bool {
-			iClosed := issues[i].Status == model.StatusClosed
-			jClosed := issues[j].Status == model.StatusClosed
+			iClosed := issues[i].Status.IsClosed()
+			jClosed := issues[j].Status.IsClosed()
 			if iClosed != jClosed {
 				return !iClosed // Open issues first
 			}
@@ -675,6 +865,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 			GraphScore: graphStats.GetPageRankScore(issues[i].ID),
 			Impact:     graphStats.GetCriticalPathScore(issues[i].ID),
 			RepoPrefix: ExtractRepoPrefix(issues[i].ID),
+			DisplayID:  ids.display(issues[i].ID),
 		}
 	}
 
@@ -682,13 +873,13 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	cOpen, cReady, cBlocked, cClosed := 0, 0, 0, 0
 	for i := range issues {
 		issue := &issues[i]
-		if issue.Status == model.StatusClosed {
+		if issue.Status.IsClosed() {
 			cClosed++
 			continue
 		}
 
 		cOpen++
-		if issue.Status == model.StatusBlocked {
+		if issue.Status.Column() == model.StatusBlocked {
 			cBlocked++
 			continue
 		}
@@ -699,7 +890,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 			if dep == nil || !dep.Type.IsBlocking() {
 				continue
 			}
-			if blocker, exists := issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
+			if blocker, exists := issueMap[dep.DependsOnID]; exists && !blocker.Status.IsClosed() {
 				isBlocked = true
 				break
 			}
@@ -710,7 +901,26 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	}
 
 	// Theme
-	theme := DefaultTheme(lipgloss.NewRenderer(os.Stdout))
+	themeRenderer := lipgloss.NewRenderer(os.Stdout)
+	darkBackground, backgroundSet := projectConfig.DarkBackground()
+	if !backgroundSet {
+		darkBackground = themeRenderer.HasDarkBackground() // Asks the terminal once
+	}
+	themeRenderer.SetHasDarkBackground(darkBackground)
+	lipgloss.SetHasDarkBackground(darkBackground) // Markdown styles read the default renderer
+	palettes, themeWarnings := themes.All(themes.DefaultDir())
+	themeIndex := 0
+	if name := projectConfig.ColorTheme; name != "" {
+		if i := themes.Index(palettes, name); i >= 0 {
+			themeIndex = i
+		} else {
+			themeWarnings = append(themeWarnings, fmt.Sprintf("unknown color_theme %q", name))
+		}
+	}
+	applyPaletteTokens(palettes[themeIndex])
+	theme := paletteTheme(palettes[themeIndex], themeRenderer)
+	theme.ASCII = projectConfig.ASCII
+	theme.ids = &ids
 
 	// Default dimensions for immediate ready state (updated when WindowSizeMsg arrives)
 	// This eliminates the "Initializing..." phase entirely, fixing slow startup issues
@@ -719,7 +929,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	const defaultHeight = 40
 
 	// List setup - initialize with default dimensions so UI is immediately usable
-	delegate := IssueDelegate{Theme: theme, WorkspaceMode: false}
+	delegate := IssueDelegate{Theme: theme, WorkspaceMode: false, StaleDays: projectConfig.StaleDays}
 	l := list.New(items, delegate, defaultWidth, defaultHeight-3)
 	l.Title = ""
 	l.SetShowTitle(false)
@@ -788,6 +998,11 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		blockerSet[bl.ID] = true
 	}
 
+	// Precompute transitive dependents from the graph index
+	dependentsCount := cachedAnalyzer.TransitiveDependentCounts()
+	leverage := cachedAnalyzer.Leverage(dependentsCount)
+	effectivePriority := cachedAnalyzer.EffectivePriorities()
+
 	// Update items with triage data
 	for i := range items {
 		if issueItem, ok := items[i].(IssueItem); ok {
@@ -799,6 +1014,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 			issueItem.IsQuickWin = quickWinSet[issueItem.Issue.ID]
 			issueItem.IsBlocker = blockerSet[issueItem.Issue.ID]
 			issueItem.UnblocksCount = len(unblocksMap[issueItem.Issue.ID])
+			issueItem.DependentsCount = dependentsCount[issueItem.Issue.ID]
 			items[i] = issueItem
 		}
 	}
@@ -808,6 +1024,24 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	_ = recipeLoader.Load() // Load recipes (errors are non-fatal, will just show empty)
 	recipePicker := NewRecipePickerModel(recipeLoader.List(), theme)
 
+	// Load saved lens views (errors surface when saving or restoring)
+	savedViews, savedViewsErr := views.Load(views.DefaultPath())
+
+	// Snapshot epic membership so scope added after kickoff can be flagged.
+	// A streaming load records it once every issue is in.
+	var epicScope *analysis.EpicScopeData
+	if options.stream == nil {
+		epicScope = loadEpicScope(beadsPath, issues, options.dryRun)
+	} else {
+		epicScope = loadEpicScope(beadsPath, nil, options.dryRun)
+	}
+
+	// Checksum the loaded file so rewrites with identical content don't reload
+	var beadsSum string
+	if beadsPath != "" && options.stream == nil {
+		beadsSum, _ = loader.FileChecksum(beadsPath)
+	}
+
 	// Initialize label picker (bv-126)
 	labelExtraction := analysis.ExtractLabels(issues)
 	labelCounts := extractLabelCounts(labelExtraction.Stats)
@@ -856,6 +1090,29 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		initialStatusErr = true
 	}
 
+	km, keymapErr := buildKeymap(projectConfig.Keymap, projectConfig.Keybindings)
+	if keymapErr != nil && initialStatus == "" {
+		initialStatus = fmt.Sprintf("Ignoring keymap entries in %s: %v", filepath.Base(projectConfig.Path), keymapErr)
+		initialStatusErr = true
+	}
+	if conflicts := km.Conflicts(); len(conflicts) > 0 && initialStatus == "" {
+		initialStatus = fmt.Sprintf("Keymap conflicts in %s: %s (listed under ?)", filepath.Base(projectConfig.Path), joinConflicts(conflicts))
+		initialStatusErr = true
+	}
+	customActions, badActions, takenActions := buildCustomActions(projectConfig.Actions, km)
+	if len(badActions) > 0 && initialStatus == "" {
+		initialStatus = fmt.Sprintf("Ignoring actions with unknown keys in %s: %s", filepath.Base(projectConfig.Path), strings.Join(badActions, ", "))
+		initialStatusErr = true
+	}
+	if len(takenActions) > 0 && initialStatus == "" {
+		initialStatus = fmt.Sprintf("Ignoring actions on keys bv uses in %s: %s", filepath.Base(projectConfig.Path), strings.Join(takenActions, ", "))
+		initialStatusErr = true
+	}
+	if len(themeWarnings) > 0 && initialStatus == "" {
+		initialStatus = "Themes: " + strings.Join(themeWarnings, "; ")
+		initialStatusErr = true
+	}
+
 	// Precompute drift/health alerts (bv-168)
 	alerts, alertsCritical, alertsWarning, alertsInfo := computeAlerts(issues, graphStats, analyzer)
 
@@ -868,12 +1125,15 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		}
 	}
 
-	return Model{
+	m := Model{
 		issues:                 issues,
 		issueMap:               issueMap,
 		analyzer:               analyzer,
 		analysis:               graphStats,
 		beadsPath:              beadsPath,
+		beadsSum:               beadsSum,
+		stream:                 options.stream,
+		inlineHeight:           options.inlineHeight,
 		watcher:                fileWatcher,
 		list:                   l,
 		viewport:               vp,
@@ -885,6 +1145,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		graphView:              graphView,
 		insightsPanel:          insightsPanel,
 		theme:                  theme,
+		ascii:                  projectConfig.ASCII,
 		currentFilter:          "all",
 		semanticSearch:         semanticSearch,
 		semanticHybridEnabled:  false,
@@ -906,12 +1167,28 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		triageScores:        triageScores,
 		triageReasons:       triageReasons,
 		unblocksMap:         unblocksMap,
+		dependentsCount:     dependentsCount,
+		leverage:            leverage,
+		effectivePriority:   effectivePriority,
 		quickWinSet:         quickWinSet,
 		blockerSet:          blockerSet,
 		recipeLoader:        recipeLoader,
 		recipePicker:        recipePicker,
 		activeRecipe:        activeRecipe,
+		savedViews:          savedViews,
+		savedViewsErr:       savedViewsErr,
+		projectConfig:       projectConfig,
+		keymap:              km,
+		customActions:       customActions,
+		dryRun:              options.dryRun,
+		epicScope:           epicScope,
 		labelPicker:         labelPicker,
+		commandPalette:      NewCommandPaletteModel(theme),
+		jump:                NewJumpModel(theme),
+		themes:              palettes,
+		themeIndex:          themeIndex,
+		darkBackground:      darkBackground,
+		backgroundSet:       backgroundSet,
 		labelDrilldownCache: make(map[string][]model.Issue),
 		timeTravelInput:     ti,
 		statusMsg:           initialStatus,
@@ -937,6 +1214,19 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		// Tutorial integration (bv-8y31)
 		tutorialModel: NewTutorialModel(theme),
 	}
+	m.refreshClaims()
+	if m.claimWarning != "" && m.statusMsg == "" {
+		m.statusMsg = m.claimWarning
+		m.statusIsError = true
+	}
+	if m.dryRun != nil && m.statusMsg == "" {
+		m.statusMsg = "Dry run: changes are collected, not saved • W to review them"
+	}
+	m.startBackgroundOp(bgMetrics, "Graph metrics ready")
+	if m.historyLoading {
+		m.startBackgroundOp(bgHistory, "Git history loaded")
+	}
+	return m
 }
 
 func (m Model) Init() tea.Cmd {
@@ -944,12 +1234,19 @@ func (m Model) Init() tea.Cmd {
 	// initialized as ready with default dimensions in NewModel().
 	// This eliminates the "Initializing..." phase entirely.
 	cmds := []tea.Cmd{
-		CheckUpdateCmd(),
+		CheckUpdateCmd(m.projectConfig),
 		WaitForPhase2Cmd(m.analysis),
 	}
 	if m.watcher != nil {
 		cmds = append(cmds, WatchFileCmd(m.watcher))
 	}
+	if m.stream != nil {
+		cmds = append(cmds, WaitForIssueBatchCmd(m.stream.batches))
+	}
+	// Rank centrality for a lens selector opened at startup (bv --view)
+	if m.showLensSelector {
+		cmds = append(cmds, m.centralityCmd())
+	}
 	// Start loading history in background
 	if len(m.issues) > 0 {
 		cmds = append(cmds, LoadHistoryCmd(m.issues, m.beadsPath))
@@ -961,10 +1258,206 @@ func (m Model) Init() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+// replaceIssues swaps in a freshly loaded issue set and rebuilds everything
+// derived from it (analysis, lookups, counts, list, sub-views), keeping the
+// list selection. It is shared by live reload and streaming loads.
+func (m *Model) replaceIssues(newIssues []model.Issue) (cacheHit bool, cmds []tea.Cmd) {
+	// Store selected issue ID to restore position after reload
+	var selectedID string
+	if sel := m.list.SelectedItem(); sel != nil {
+		if item, ok := sel.(IssueItem); ok {
+			selectedID = item.Issue.ID
+		}
+	}
+
+	// Apply default sorting (Open first, Priority, Date)
+	sort.Slice(newIssues, func(i, j int) bool {
+		iClosed := newIssues[i].Status.IsClosed()
+		jClosed := newIssues[j].Status.IsClosed()
+		if iClosed != jClosed {
+			return !iClosed
+		}
+		if newIssues[i].Priority != newIssues[j].Priority {
+			return newIssues[i].Priority < newIssues[j].Priority
+		}
+		return newIssues[i].CreatedAt.After(newIssues[j].CreatedAt)
+	})
+
+	// Recompute analysis (async Phase 1/Phase 2) with caching
+	m.issues = newIssues
+	m.setDisplayAliases(newIssues)
+	m.detailLinksValid = false
+	if m.stream == nil {
+		recordEpicScope(m.epicScope, m.beadsPath, newIssues, m.dryRun)
+	}
+	cachedAnalyzer := newCachedAnalyzer(newIssues, m.beadsPath, m.dryRun != nil)
+	m.analyzer = cachedAnalyzer.Analyzer
+	m.analysis = cachedAnalyzer.AnalyzeAsync(context.Background())
+	m.centrality = nil
+	cacheHit = cachedAnalyzer.WasCacheHit()
+	m.dependentsCount = cachedAnalyzer.TransitiveDependentCounts()
+	m.leverage = cachedAnalyzer.Leverage(m.dependentsCount)
+	m.effectivePriority = cachedAnalyzer.EffectivePriorities()
+	m.labelHealthCached = false
+	m.attentionCached = false
+
+	// Rebuild lookup map
+	m.issueMap = make(map[string]*model.Issue, len(newIssues))
+	for i := range m.issues {
+		m.issueMap[m.issues[i].ID] = &m.issues[i]
+	}
+	m.refreshClaims()
+
+	// Clear stale priority hints (will be repopulated after Phase 2)
+	m.priorityHints = make(map[string]*analysis.PriorityRecommendation)
+
+	m.recountIssues()
+
+	// Recompute alerts for refreshed dataset
+	m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
+	m.dismissedAlerts = make(map[string]bool)
+	m.showAlertsPanel = false
+	if m.showHealth {
+		m.health = analysis.CheckIntegrity(m.issues)
+		m.healthCursor = min(m.healthCursor, max(len(m.health.Problems)-1, 0))
+	}
+	if m.showDuplicates {
+		m.refreshDuplicates()
+	}
+
+	// Rebuild list items
+	items := make([]list.Item, len(m.issues))
+	for i := range m.issues {
+		items[i] = IssueItem{
+			Issue:           m.issues[i],
+			GraphScore:      m.analysis.GetPageRankScore(m.issues[i].ID),
+			Impact:          m.analysis.GetCriticalPathScore(m.issues[i].ID),
+			RepoPrefix:      ExtractRepoPrefix(m.issues[i].ID),
+			DisplayID:       m.theme.ID(m.issues[i].ID),
+			DependentsCount: m.dependentsCount[m.issues[i].ID],
+		}
+	}
+	m.updateSemanticIDs(items)
+	m.clearSemanticScores()
+	if m.semanticSearch != nil {
+		m.semanticSearch.ResetCache()
+		m.semanticSearch.SetMetricsCache(nil)
+	}
+	m.semanticHybridReady = false
+	m.semanticHybridBuilding = false
+	if m.semanticHybridEnabled {
+		m.semanticHybridBuilding = true
+		cmds = append(cmds, BuildHybridMetricsCmd(m.issues))
+	}
+	m.list.SetItems(items)
+
+	// Restore selection position
+	if selectedID != "" {
+		for i, item := range m.list.Items() {
+			if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
+				m.list.Select(i)
+				break
+			}
+		}
+	}
+
+	// Regenerate sub-views (with Phase 1 data; Phase 2 will update via Phase2ReadyMsg)
+	ins := m.analysis.GenerateInsights(len(m.issues))
+	m.insightsPanel = NewInsightsModel(ins, m.issueMap, m.theme)
+	bodyHeight := m.height - 1
+	if bodyHeight < 5 {
+		bodyHeight = 5
+	}
+	m.insightsPanel.SetSize(m.width, bodyHeight)
+	m.graphView.SetIssues(m.issues, &ins)
+
+	// Generate priority recommendations now that Phase 2 is ready
+	m.board = NewBoardModel(m.issues, m.theme)
+
+	// Re-apply recipe filter if active
+	if m.activeRecipe != nil {
+		m.applyRecipe(m.activeRecipe)
+	}
+	m.syncGraphCanvas()
+
+	// Reload sprints (bv-161)
+	if m.beadsPath != "" {
+		beadsDir := filepath.Dir(m.beadsPath)
+		if loaded, err := loader.LoadSprintsFromFile(filepath.Join(beadsDir, loader.SprintsFileName)); err == nil {
+			m.sprints = loaded
+			// If we have a selected sprint, try to refresh it
+			if m.selectedSprint != nil {
+				found := false
+				for i := range m.sprints {
+					if m.sprints[i].ID == m.selectedSprint.ID {
+						m.selectedSprint = &m.sprints[i]
+						m.sprintViewText = m.renderSprintDashboard()
+						found = true
+						break
+					}
+				}
+				if !found {
+					m.selectedSprint = nil
+					m.sprintViewText = "Sprint not found"
+				}
+			}
+		}
+	}
+
+	// Keep semantic index current when enabled.
+	if m.semanticSearchEnabled && !m.semanticIndexBuilding {
+		m.semanticIndexBuilding = true
+		cmds = append(cmds, BuildSemanticIndexCmd(m.issues))
+	}
+
+	return cacheHit, cmds
+}
+
+// recountIssues recomputes the open, ready, blocked and closed counts
+func (m *Model) recountIssues() {
+	m.countOpen, m.countReady, m.countBlocked, m.countClosed = 0, 0, 0, 0
+	for i := range m.issues {
+		issue := &m.issues[i]
+		if issue.Status.IsClosed() {
+			m.countClosed++
+			continue
+		}
+		m.countOpen++
+		if issue.Status.Column() == model.StatusBlocked {
+			m.countBlocked++
+			continue
+		}
+		isBlocked := false
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if blocker, exists := m.issueMap[dep.DependsOnID]; exists && !blocker.Status.IsClosed() {
+				isBlocked = true
+				break
+			}
+		}
+		if !isBlocked {
+			m.countReady++
+		}
+	}
+}
+
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
+	// Custom keybindings and the keymap act as the built-in key, except while typing
+	if key, ok := msg.(builtinKeyMsg); ok {
+		msg = tea.KeyMsg(key)
+	} else if key, ok := msg.(tea.KeyMsg); ok {
+		translated, ok := m.translateKey(key)
+		if !ok {
+			return m, nil
+		}
+		msg = translated
+	}
+
 	switch msg := msg.(type) {
 	case UpdateMsg:
 		m.updateAvailable = true
@@ -1072,6 +1565,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case CentralityReadyMsg:
+		return m.handleCentralityReady(msg)
+
+	case spinner.TickMsg:
+		if m.showLensSelector {
+			return m, m.lensSelector.UpdateSpinner(msg)
+		}
+		return m, nil
+
 	case Phase2ReadyMsg:
 		// Ignore stale Phase2 completions (from before a file reload)
 		if msg.Stats != m.analysis {
@@ -1102,6 +1604,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.priorityHints[recommendations[i].IssueID] = &recommendations[i]
 		}
 
+		// PageRank order in an open lens can now be applied
+		if m.showLensDashboard {
+			m.lensDashboard.SetSortSources(m.dependentsCount, m.analysis)
+		}
+
 		// Refresh alerts now that full Phase 2 metrics (cycles, etc.) are available
 		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
 
@@ -1146,6 +1653,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else {
 			m.applyFilter()
 		}
+		cmds = append(cmds, m.finishBackgroundOp(bgMetrics, "", false))
 
 	case HistoryLoadedMsg:
 		// Background history loading completed
@@ -1154,7 +1662,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.historyLoadFailed = true
 			m.statusMsg = fmt.Sprintf("History load failed: %v", msg.Error)
 			m.statusIsError = true
+			cmds = append(cmds, m.finishBackgroundOp(bgHistory, m.statusMsg, true))
 		} else if msg.Report != nil {
+			cmds = append(cmds, m.finishBackgroundOp(bgHistory, "", false))
 			m.historyView = NewHistoryModel(msg.Report, m.theme)
 			m.historyView.SetSize(m.width, m.height-1)
 			// Refresh detail pane if visible
@@ -1163,6 +1673,47 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case StatusChangedMsg:
+		return m.handleStatusChanged(msg), nil
+
+	case CustomActionDoneMsg:
+		return m.handleCustomActionDone(msg)
+
+	case LinkEditorClosedMsg:
+		if msg.Err != nil {
+			m.statusMsg = fmt.Sprintf("❌ Editor failed on %s: %v", filepath.Base(msg.Path), msg.Err)
+			m.statusIsError = true
+		}
+		return m, nil
+
+	case DuplicateMarkedMsg:
+		return m.handleDuplicateMarked(msg), nil
+
+	case FieldChangesLoadedMsg:
+		if m.reviewDashboard != nil {
+			m.reviewDashboard.SetFieldChanges(msg)
+		}
+		return m, nil
+
+	case LabelsEditedMsg:
+		if m.reviewDashboard != nil {
+			m.reviewDashboard.SetLabelsEdited(msg)
+		}
+		return m, nil
+
+	case RelabelProgressMsg:
+		m.statusMsg, m.statusIsError = msg.Status(), false
+		return m, WaitForRelabelCmd(msg)
+
+	case RelabelDoneMsg:
+		m.statusMsg, m.statusIsError = msg.Status()
+		m.statusMsg = m.dryRunStatus(m.statusMsg)
+		cmds = append(cmds, m.finishBackgroundOp(bgRelabel, m.statusMsg, m.statusIsError))
+
+	case toastTickMsg:
+		m = m.handleToastTick(msg)
+		return m, nil
+
 	case AgentFileCheckMsg:
 		// AGENTS.md integration check (bv-i8dk)
 		if msg.ShouldPrompt && msg.FilePath != "" {
@@ -1171,8 +1722,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.focused = focusAgentPrompt
 		}
 
+	case IssueBatchMsg:
+		return m.handleIssueBatch(msg)
+
 	case FileChangedMsg:
 		// File changed on disk - reload issues and recompute analysis
+		if m.stream != nil {
+			// Mid-load: reload once the streaming load finishes
+			m.stream.changed = true
+			if m.watcher != nil {
+				cmds = append(cmds, WatchFileCmd(m.watcher))
+			}
+			return m, tea.Batch(cmds...)
+		}
 		if m.beadsPath == "" {
 			// Re-start watch for next change
 			if m.watcher != nil {
@@ -1181,6 +1743,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
+		// Skip the parse and re-analysis when the file was rewritten with
+		// identical content. A checksum failure just means a full reload.
+		sum, sumErr := loader.FileChecksum(m.beadsPath)
+		if sumErr == nil && sum == m.beadsSum {
+			if m.watcher != nil {
+				cmds = append(cmds, WatchFileCmd(m.watcher))
+			}
+			return m, tea.Batch(cmds...)
+		}
+
 		// Clear ephemeral overlays tied to old data
 		m.clearAttentionOverlay()
 
@@ -1192,6 +1764,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.newIssueIDs = nil
 			m.closedIssueIDs = nil
 			m.modifiedIssueIDs = nil
+			m.affectedIssues = nil
+			if m.currentFilter == "affected" {
+				m.currentFilter = "all"
+			}
 		}
 
 		// Reload issues from disk
@@ -1211,159 +1787,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, tea.Batch(cmds...)
 		}
+		m.beadsSum = sum // Empty on checksum failure, so the next change reloads
 
-		// Store selected issue ID to restore position after reload
-		var selectedID string
-		if sel := m.list.SelectedItem(); sel != nil {
-			if item, ok := sel.(IssueItem); ok {
-				selectedID = item.Issue.ID
-			}
-		}
-
-		// Apply default sorting (Open first, Priority, Date)
-		sort.Slice(newIssues, func(i, j int) bool {
-			iClosed := newIssues[i].Status == model.StatusClosed
-			jClosed := newIssues[j].Status == model.StatusClosed
-			if iClosed != jClosed {
-				return !iClosed
-			}
-			if newIssues[i].Priority != newIssues[j].Priority {
-				return newIssues[i].Priority < newIssues[j].Priority
-			}
-			return newIssues[i].CreatedAt.After(newIssues[j].CreatedAt)
-		})
-
-		// Recompute analysis (async Phase 1/Phase 2) with caching
-		m.issues = newIssues
-		cachedAnalyzer := analysis.NewCachedAnalyzer(newIssues, nil)
-		m.analyzer = cachedAnalyzer.Analyzer
-		m.analysis = cachedAnalyzer.AnalyzeAsync(context.Background())
-		cacheHit := cachedAnalyzer.WasCacheHit()
-		m.labelHealthCached = false
-		m.attentionCached = false
-
-		// Rebuild lookup map
-		m.issueMap = make(map[string]*model.Issue, len(newIssues))
-		for i := range m.issues {
-			m.issueMap[m.issues[i].ID] = &m.issues[i]
-		}
-
-		// Clear stale priority hints (will be repopulated after Phase 2)
-		m.priorityHints = make(map[string]*analysis.PriorityRecommendation)
-
-		// Recompute stats
-		m.countOpen, m.countReady, m.countBlocked, m.countClosed = 0, 0, 0, 0
-		for i := range m.issues {
-			issue := &m.issues[i]
-			if issue.Status == model.StatusClosed {
-				m.countClosed++
-				continue
-			}
-			m.countOpen++
-			if issue.Status == model.StatusBlocked {
-				m.countBlocked++
-				continue
-			}
-			isBlocked := false
-			for _, dep := range issue.Dependencies {
-				if dep == nil || !dep.Type.IsBlocking() {
-					continue
-				}
-				if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
-					isBlocked = true
-					break
-				}
-			}
-			if !isBlocked {
-				m.countReady++
-			}
-		}
-
-		// Recompute alerts for refreshed dataset
-		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
-		m.dismissedAlerts = make(map[string]bool)
-		m.showAlertsPanel = false
-
-		// Rebuild list items
-		items := make([]list.Item, len(m.issues))
-		for i := range m.issues {
-			items[i] = IssueItem{
-				Issue:      m.issues[i],
-				GraphScore: m.analysis.GetPageRankScore(m.issues[i].ID),
-				Impact:     m.analysis.GetCriticalPathScore(m.issues[i].ID),
-				RepoPrefix: ExtractRepoPrefix(m.issues[i].ID),
-			}
-		}
-		m.updateSemanticIDs(items)
-		m.clearSemanticScores()
-		if m.semanticSearch != nil {
-			m.semanticSearch.ResetCache()
-			m.semanticSearch.SetMetricsCache(nil)
-		}
-		m.semanticHybridReady = false
-		m.semanticHybridBuilding = false
-		if m.semanticHybridEnabled {
-			m.semanticHybridBuilding = true
-			cmds = append(cmds, BuildHybridMetricsCmd(m.issues))
-		}
-		m.list.SetItems(items)
-
-		// Restore selection position
-		if selectedID != "" {
-			for i, item := range m.list.Items() {
-				if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
-					m.list.Select(i)
-					break
-				}
-			}
-		}
-
-		// Regenerate sub-views (with Phase 1 data; Phase 2 will update via Phase2ReadyMsg)
-		ins := m.analysis.GenerateInsights(len(m.issues))
-		m.insightsPanel = NewInsightsModel(ins, m.issueMap, m.theme)
-		bodyHeight := m.height - 1
-		if bodyHeight < 5 {
-			bodyHeight = 5
-		}
-		m.insightsPanel.SetSize(m.width, bodyHeight)
-		m.graphView.SetIssues(m.issues, &ins)
-
-		// Generate priority recommendations now that Phase 2 is ready
-		m.board = NewBoardModel(m.issues, m.theme)
-
-		// Re-apply recipe filter if active
-		if m.activeRecipe != nil {
-			m.applyRecipe(m.activeRecipe)
-		}
-
-		// Reload sprints (bv-161)
-		if m.beadsPath != "" {
-			beadsDir := filepath.Dir(m.beadsPath)
-			if loaded, err := loader.LoadSprintsFromFile(filepath.Join(beadsDir, loader.SprintsFileName)); err == nil {
-				m.sprints = loaded
-				// If we have a selected sprint, try to refresh it
-				if m.selectedSprint != nil {
-					found := false
-					for i := range m.sprints {
-						if m.sprints[i].ID == m.selectedSprint.ID {
-							m.selectedSprint = &m.sprints[i]
-							m.sprintViewText = m.renderSprintDashboard()
-							found = true
-							break
-						}
-					}
-					if !found {
-						m.selectedSprint = nil
-						m.sprintViewText = "Sprint not found"
-					}
-				}
-			}
-		}
-
-		// Keep semantic index current when enabled.
-		if m.semanticSearchEnabled && !m.semanticIndexBuilding {
-			m.semanticIndexBuilding = true
-			cmds = append(cmds, BuildSemanticIndexCmd(m.issues))
+		prevClaimWarning := m.claimWarning
+		lensBefore := m.snapshotLens()
+		cacheHit, reloadCmds := m.replaceIssues(newIssues)
+		cmds = append(cmds, reloadCmds...)
+		if toast := blockerToast(m.reloadLens(lensBefore)); toast != "" {
+			cmds = append(cmds, m.showToast(toast, false))
 		}
 
 		if cacheHit {
@@ -1375,6 +1806,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.statusMsg += fmt.Sprintf(" (%d warnings)", len(reloadWarnings))
 		}
 		m.statusIsError = false
+		if m.claimWarning != "" && m.claimWarning != prevClaimWarning {
+			m.statusMsg, m.statusIsError = m.claimWarning, true
+		}
 		// Invalidate label-derived caches
 		m.labelHealthCached = false
 		m.labelDrilldownCache = make(map[string][]model.Issue)
@@ -1384,6 +1818,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.watcher != nil {
 			cmds = append(cmds, WatchFileCmd(m.watcher))
 		}
+		m.startBackgroundOp(bgMetrics, fmt.Sprintf("Reload complete: %d issues", len(newIssues)))
 		cmds = append(cmds, WaitForPhase2Cmd(m.analysis))
 		return m, tea.Batch(cmds...)
 
@@ -1440,34 +1875,130 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
-		// Close label health detail modal if open
-		if m.showLabelHealthDetail {
-			s := msg.String()
-			if s == "esc" || s == "q" || s == "enter" || s == "h" {
-				m.showLabelHealthDetail = false
-				m.labelHealthDetail = nil
-				return m, nil
+		// Handle the help overlay, which can open over the lens dashboard
+		if m.showHelp && m.focused == focusHelp {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
 			}
-			if s == "d" && m.labelHealthDetail != nil {
-				// open drilldown from detail modal
-				m.labelDrilldownLabel = m.labelHealthDetail.Label
-				m.labelDrilldownIssues = m.filterIssuesByLabel(m.labelDrilldownLabel)
-				m.showLabelDrilldown = true
-				m.showLabelHealthDetail = false
-				return m, nil
+			m = m.handleHelpKeys(msg)
+			return m, nil
+		}
+
+		// Handle blocker chain explorer
+		if m.showBlockerChain {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
 			}
+			return m.handleBlockerChainKeys(msg), nil
 		}
 
-		// Handle label drilldown modal if open
-		if m.showLabelDrilldown {
-			s := msg.String()
-			switch s {
-			case "enter":
-				// Apply label filter to main list and close drilldown
-				if m.labelDrilldownLabel != "" {
-					m.currentFilter = "label:" + m.labelDrilldownLabel
-					m.applyFilter()
-					m.focused = focusList
+		// Handle lens comparison
+		if m.showLensCompare {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleLensCompareKeys(msg), nil
+		}
+
+		// Handle workstream comparison
+		if m.showWorkstreamCompare {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleWorkstreamCompareKeys(msg), nil
+		}
+
+		// Handle label rename / merge
+		if m.showLabelManager {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleLabelManagerKeys(msg)
+		}
+
+		// Result pane of a custom action
+		if m.showActionResult {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleActionResultKeys(msg), nil
+		}
+
+		// Handle command palette overlay before everything else it can trigger
+		if m.showCommandPalette {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleCommandPaletteKeys(msg)
+		}
+
+		// Jump overlay moves the cursor of whichever view is on screen
+		if m.showJump {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleJumpKeys(msg), nil
+		}
+		if (msg.String() == ":" || msg.String() == "ctrl+g") && m.jumpAvailable() {
+			m.openJump()
+			return m, nil
+		}
+
+		// Status menu sets the status of whichever issue is selected
+		if m.showStatusMenu {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleStatusMenuKeys(msg)
+		}
+		if m.jumpAvailable() {
+			if cmd, ok := m.startCustomAction(msg.String()); ok {
+				return m, cmd
+			}
+		}
+		if msg.String() == "ctrl+]" && m.jumpAvailable() {
+			if next, cmd, ok := m.openCodeRef(); ok {
+				return next, cmd
+			}
+		}
+		if msg.String() == "ctrl+x" && m.jumpAvailable() && m.openStatusMenu() {
+			return m, nil
+		}
+		if msg.String() == "ctrl+p" && m.commandPaletteAvailable() {
+			m.commandPalette.SetCommands(m.buildPaletteCommands())
+			m.commandPalette.SetSize(m.width, m.height-1)
+			m.showCommandPalette = true
+			return m, nil
+		}
+
+		// Close label health detail modal if open
+		if m.showLabelHealthDetail {
+			s := msg.String()
+			if s == "esc" || s == "q" || s == "enter" || s == "h" {
+				m.showLabelHealthDetail = false
+				m.labelHealthDetail = nil
+				return m, nil
+			}
+			if s == "d" && m.labelHealthDetail != nil {
+				// open drilldown from detail modal
+				m.labelDrilldownLabel = m.labelHealthDetail.Label
+				m.labelDrilldownIssues = m.filterIssuesByLabel(m.labelDrilldownLabel)
+				m.showLabelDrilldown = true
+				m.showLabelHealthDetail = false
+				return m, nil
+			}
+		}
+
+		// Handle label drilldown modal if open
+		if m.showLabelDrilldown {
+			s := msg.String()
+			switch s {
+			case "enter":
+				// Apply label filter to main list and close drilldown
+				if m.labelDrilldownLabel != "" {
+					m.currentFilter = "label:" + m.labelDrilldownLabel
+					m.applyFilter()
+					m.focused = focusList
 				}
 				m.showLabelDrilldown = false
 				m.labelDrilldownLabel = ""
@@ -1599,6 +2130,29 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Pending changes panel (dry run)
+		if m.showPendingChanges {
+			return m.handlePendingChangesKeys(msg), nil
+		}
+
+		// Health panel
+		if m.showHealth {
+			return m.handleHealthKeys(msg), nil
+		}
+
+		// Duplicates panel
+		if m.showDuplicates {
+			return m.handleDuplicatesKeys(msg)
+		}
+
+		// Theme gallery
+		if m.showThemeGallery {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleThemeGalleryKeys(msg), nil
+		}
+
 		// Handle repo picker overlay (workspace mode) before global keys (esc/q/etc.)
 		if m.showRepoPicker {
 			if msg.String() == "ctrl+c" {
@@ -1659,13 +2213,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle help overlay toggle (? or F1)
 		if (msg.String() == "?" || msg.String() == "f1") && m.list.FilterState() != list.Filtering {
-			m.showHelp = !m.showHelp
-			if m.showHelp {
-				m.focused = focusHelp
-				m.helpScroll = 0 // Reset scroll position when opening help
-			} else {
-				m.focused = focusList
-			}
+			m.openHelp()
 			return m, nil
 		}
 
@@ -1809,12 +2357,6 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
-		// If help is showing, handle navigation keys for scrolling
-		if m.focused == focusHelp {
-			m = m.handleHelpKeys(msg)
-			return m, nil
-		}
-
 		// If tutorial is showing, route input to tutorial model (bv-8y31)
 		if m.focused == focusTutorial && m.showTutorial {
 			var tutorialCmd tea.Cmd
@@ -1828,6 +2370,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tutorialCmd
 		}
 
+		// The graph canvas uses hjkl for panning, so it runs before global keys
+		if m.focused == focusGraphCanvas {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleGraphCanvasKeys(msg)
+			return m, nil
+		}
+
 		// Handle time-travel input first (before global keys intercept letters)
 		// But allow ctrl+c to always quit
 		if m.focused == focusTimeTravelInput {
@@ -1868,6 +2419,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.focused = focusList
 					return m, nil
 				}
+				if m.focused == focusStatsDashboard || m.focused == focusTable {
+					m.focused = focusList
+					return m, nil
+				}
 				if m.isGraphView {
 					m.isGraphView = false
 					if m.lensViewOrigin {
@@ -1894,6 +2449,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case "esc":
 				// Escape closes modals and goes back
+				if m.showPeek && m.focused == focusList {
+					m.showPeek = false
+					return m, nil
+				}
 				if m.showDetails && !m.isSplitView {
 					m.showDetails = false
 					return m, nil
@@ -1916,6 +2475,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.focused = focusList
 					return m, nil
 				}
+				if m.focused == focusStatsDashboard || m.focused == focusTable {
+					m.focused = focusList
+					return m, nil
+				}
 				if m.isGraphView {
 					m.isGraphView = false
 					if m.lensViewOrigin {
@@ -2154,6 +2717,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.flowMatrix.SetSize(m.width, panelHeight)
 				return m, nil
 
+			case "D":
+				// Stats dashboard (issue aging, flow, velocity, burndown and burnup charts)
+				m.clearAttentionOverlay()
+				m.isGraphView = false
+				m.isBoardView = false
+				m.isActionableView = false
+				m.isHistoryView = false
+				m.focused = focusStatsDashboard
+				m.statsDashboard = NewStatsDashboardModel(m.theme)
+				m.statsDashboard.SetEpicScope(m.epicScope)
+				m.statsDashboard.SetData(m.issues, time.Now())
+				m.statsDashboard.SetSize(m.width, m.height-1)
+				return m, nil
+
+			case "R":
+				// Table view of the list's issues, in its filter and sort
+				m.clearAttentionOverlay()
+				if m.focused == focusTable {
+					m.focused = focusList
+					return m, nil
+				}
+				m.isGraphView = false
+				m.isBoardView = false
+				m.isActionableView = false
+				m.isHistoryView = false
+				m.openTableView()
+				return m, nil
+
 			case "!":
 				// Toggle alerts panel (bv-168)
 				// Only show if there are active alerts
@@ -2172,6 +2763,26 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 
+			case "W":
+				// Pending changes of a dry run
+				m.togglePendingChanges()
+				return m, nil
+
+			case "E":
+				// Tracker integrity problems
+				m.toggleHealth()
+				return m, nil
+
+			case "=":
+				// Probable duplicate issues
+				m.toggleDuplicates()
+				return m, nil
+
+			case "ctrl+t":
+				// Theme gallery with live preview
+				m.toggleThemeGallery()
+				return m, nil
+
 			case "'", "f5":
 				// Toggle recipe picker overlay
 				m.showRecipePicker = !m.showRecipePicker
@@ -2202,7 +2813,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "x":
-				// Export to Markdown file
+				// Export the stats dashboard's flow data as CSV, otherwise export to Markdown file
+				if m.focused == focusStatsDashboard {
+					m.exportCumulativeFlowCSV()
+					return m, nil
+				}
 				m.exportToMarkdown()
 				return m, nil
 
@@ -2223,20 +2838,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case "L":
 				// Open lens selector (Shift+L) for label/epic/bead exploration
-				m.clearAttentionOverlay()
-				m.isGraphView = false
-				m.isBoardView = false
-				m.isActionableView = false
-				m.isHistoryView = false
-				m.isSprintView = false
-				m.showLensSelector = true
-				m.focused = focusLensSelector
-				// Initialize lens selector with issues and graph stats
-				m.lensSelector = NewLensSelectorModel(m.issues, m.theme, m.analysis)
-				m.lensSelector.SetSize(m.width, m.height-1)
+				cmd := m.openLensSelector()
 				m.statusMsg = "Lens: / search • j/k nav • s scope • enter select • esc cancel"
 				m.statusIsError = false
-				return m, nil
+				return m, cmd
 
 			}
 
@@ -2304,6 +2909,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case focusFlowMatrix:
 				m = m.handleFlowMatrixKeys(msg)
 
+			case focusStatsDashboard:
+				m = m.handleStatsDashboardKeys(msg)
+
+			case focusTable:
+				m = m.handleTableKeys(msg)
+
 			case focusLensSelector:
 				m = m.handleLensSelectorKeys(msg)
 
@@ -2315,15 +2926,32 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmds = append(cmds, cmd)
 
 			case focusList:
+				if m.isSplitView || m.showDetails {
+					if next, linkCmd, ok := m.handleLinkKeys(msg); ok {
+						return next, linkCmd
+					}
+				}
 				m = m.handleListKeys(msg)
 
 			case focusDetail:
+				if next, linkCmd, ok := m.handleLinkKeys(msg); ok {
+					return next, linkCmd
+				}
 				m.viewport, cmd = m.viewport.Update(msg)
 				cmds = append(cmds, cmd)
 			}
 		}
 
 	case tea.MouseMsg:
+		// Views with their own hit-testing; a click on the selected row
+		// (or a workstream header) acts like enter
+		if handled, activate := m.handleViewMouse(msg); handled {
+			if activate {
+				return m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			}
+			return m, nil
+		}
+
 		// Handle mouse wheel scrolling
 		switch msg.Button {
 		case tea.MouseButtonWheelUp:
@@ -2351,6 +2979,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.historyView.MoveUp()
 			case focusFlowMatrix:
 				m.flowMatrix.MoveUp()
+			case focusStatsDashboard:
+				m.statsDashboard.ScrollUp(3)
+			case focusTable:
+				m.tableView.MoveUp(3)
 			}
 			return m, nil
 		case tea.MouseButtonWheelDown:
@@ -2378,13 +3010,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.historyView.MoveDown()
 			case focusFlowMatrix:
 				m.flowMatrix.MoveDown()
+			case focusStatsDashboard:
+				m.statsDashboard.ScrollDown(3)
+			case focusTable:
+				m.tableView.MoveDown(3)
 			}
 			return m, nil
 		}
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
-		m.height = msg.Height
+		m.height = m.fitInlineHeight(msg.Height)
 		m.isSplitView = msg.Width > SplitViewThreshold
 		m.ready = true
 		bodyHeight := m.height - 1 // keep 1 row for footer
@@ -2581,8 +3217,12 @@ func (m Model) handleBoardKeys(msg tea.KeyMsg) Model {
 			m.board.PrevMatch()
 		}
 
-	// Copy ID to clipboard (bv-yg39)
+	// Copy agent context / ID to clipboard (bv-yg39)
 	case "y":
+		if selected := m.board.SelectedIssue(); selected != nil {
+			m = m.copyIssueContext(selected.ID)
+		}
+	case "Y":
 		if selected := m.board.SelectedIssue(); selected != nil {
 			if err := clipboard.WriteAll(selected.ID); err != nil {
 				m.statusMsg = fmt.Sprintf("❌ Clipboard error: %v", err)
@@ -2692,6 +3332,14 @@ func (m Model) handleGraphKeys(msg tea.KeyMsg) Model {
 		m.graphView.ScrollLeft()
 	case "L":
 		m.graphView.ScrollRight()
+	case "A":
+		m.toggleArchaeologyMode()
+	case "c":
+		m.openGraphCanvas()
+	case "y":
+		if selected := m.graphView.SelectedIssue(); selected != nil {
+			m = m.copyIssueContext(selected.ID)
+		}
 	case "enter":
 		if selected := m.graphView.SelectedIssue(); selected != nil {
 			// Find and select in list
@@ -2714,6 +3362,53 @@ func (m Model) handleGraphKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
+// graphIssues returns the issues shown in the graph view for a filtered list.
+// In archaeology mode closed issues are always included so finished chains stay intact.
+func (m *Model) graphIssues(filtered []model.Issue) []model.Issue {
+	if !m.archaeologyMode {
+		return filtered
+	}
+	seen := make(map[string]bool, len(filtered))
+	for _, issue := range filtered {
+		seen[issue.ID] = true
+	}
+	result := append([]model.Issue(nil), filtered...)
+	for _, issue := range m.issues {
+		if issue.Status.IsClosed() && !seen[issue.ID] {
+			result = append(result, issue)
+		}
+	}
+	return result
+}
+
+// toggleArchaeologyMode flips closed-issue archaeology for the lens dashboard and graph view
+func (m *Model) toggleArchaeologyMode() {
+	m.archaeologyMode = !m.archaeologyMode
+	m.lensDashboard.SetArchaeologyMode(m.archaeologyMode)
+	m.graphView.SetArchaeologyMode(m.archaeologyMode)
+
+	// A lens-scoped graph already holds exactly the lens issues; otherwise
+	// rebuild from the current list so closed issues join (or leave) the graph
+	if !m.lensViewOrigin && m.analysis != nil {
+		var filtered []model.Issue
+		for _, item := range m.list.Items() {
+			if issueItem, ok := item.(IssueItem); ok {
+				filtered = append(filtered, issueItem.Issue)
+			}
+		}
+		graphIssues := m.graphIssues(filtered)
+		ins := m.analysis.GenerateInsights(len(graphIssues))
+		m.graphView.SetIssues(graphIssues, &ins)
+	}
+
+	if m.archaeologyMode {
+		m.statusMsg = "Archaeology mode: closed issues shown with closure dates"
+	} else {
+		m.statusMsg = "Archaeology mode off"
+	}
+	m.statusIsError = false
+}
+
 // handleActionableKeys handles keyboard input when actionable view is focused
 func (m Model) handleActionableKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -2721,6 +3416,10 @@ func (m Model) handleActionableKeys(msg tea.KeyMsg) Model {
 		m.actionableView.MoveDown()
 	case "k", "up":
 		m.actionableView.MoveUp()
+	case "y":
+		if id := m.actionableView.SelectedIssueID(); id != "" {
+			m = m.copyIssueContext(id)
+		}
 	case "enter":
 		// Jump to selected issue in list view
 		selectedID := m.actionableView.SelectedIssueID()
@@ -2924,6 +3623,17 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 			m.statusMsg = "❌ No commit selected"
 			m.statusIsError = true
 		}
+	case "Y":
+		// y is the commit SHA here; Y copies the bead's agent context
+		var selectedID string
+		if m.historyView.IsGitMode() {
+			selectedID = m.historyView.SelectedRelatedBeadID()
+		} else {
+			selectedID = m.historyView.SelectedBeadID()
+		}
+		if selectedID != "" {
+			m = m.copyIssueContext(selectedID)
+		}
 	case "c":
 		// Cycle confidence threshold (only in bead mode)
 		if !m.historyView.IsGitMode() {
@@ -3081,6 +3791,109 @@ func openBrowserURL(url string) error {
 	return cmd.Start()
 }
 
+// openTableView shows the table view with the list's issues, in its filter
+// and sort, the cursor on the list's selection. Hidden columns carry over
+// from the last time it was open.
+func (m *Model) openTableView() {
+	if m.tableView.hidden == nil {
+		m.tableView = NewTableViewModel(m.theme)
+	}
+	m.tableView.theme = m.theme
+	var issues []model.Issue
+	for _, item := range m.list.Items() {
+		if issueItem, ok := item.(IssueItem); ok {
+			issues = append(issues, issueItem.Issue)
+		}
+	}
+	m.tableView.SetIssues(issues, m.issueMap, m.dependentsCount)
+	m.tableView.SetSize(m.width, m.height-1)
+	if sel, ok := m.list.SelectedItem().(IssueItem); ok {
+		m.tableView.Select(sel.Issue.ID)
+	}
+	m.focused = focusTable
+}
+
+// handleTableKeys handles keyboard input when the table view is focused
+func (m Model) handleTableKeys(msg tea.KeyMsg) Model {
+	switch key := msg.String(); key {
+	case "R", "q", "esc":
+		m.focused = focusList
+	case "j", "down":
+		m.tableView.MoveDown(1)
+	case "k", "up":
+		m.tableView.MoveUp(1)
+	case "ctrl+d", "pgdown":
+		m.tableView.MoveDown(m.height / 2)
+	case "ctrl+u", "pgup":
+		m.tableView.MoveUp(m.height / 2)
+	case "home":
+		m.tableView.MoveUp(len(m.tableView.rows))
+	case "G", "end":
+		m.tableView.MoveDown(len(m.tableView.rows))
+	case "left":
+		m.tableView.ScrollLeft()
+	case "right":
+		m.tableView.ScrollRight()
+	case "1", "2", "3", "4", "5", "6", "7":
+		name, shown := m.tableView.ToggleColumn(int(key[0] - '0'))
+		if shown {
+			m.statusMsg = fmt.Sprintf("Column %s shown", name)
+		} else {
+			m.statusMsg = fmt.Sprintf("Column %s hidden", name)
+		}
+		m.statusIsError = false
+	case "0":
+		m.tableView.ShowAllColumns()
+		m.statusMsg = "All columns shown"
+		m.statusIsError = false
+	case "y":
+		if id := m.tableView.SelectedIssueID(); id != "" {
+			m = m.copyIssueContext(id)
+		}
+	case "enter":
+		// Open the selected issue in the list
+		selectedID := m.tableView.SelectedIssueID()
+		if selectedID == "" {
+			break
+		}
+		for i, item := range m.list.Items() {
+			if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
+				m.list.Select(i)
+				break
+			}
+		}
+		m.focused = focusList
+		if m.isSplitView {
+			m.focused = focusDetail
+		} else {
+			m.showDetails = true
+		}
+		m.updateViewportContent()
+	}
+	return m
+}
+
+// handleStatsDashboardKeys handles keyboard input when the stats dashboard is focused
+func (m Model) handleStatsDashboardKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "D", "q", "esc":
+		m.focused = focusList
+	case "j", "down":
+		m.statsDashboard.ScrollDown(1)
+	case "k", "up":
+		m.statsDashboard.ScrollUp(1)
+	case "ctrl+d", "pgdown":
+		m.statsDashboard.ScrollDown(m.height / 2)
+	case "ctrl+u", "pgup":
+		m.statsDashboard.ScrollUp(m.height / 2)
+	case "home":
+		m.statsDashboard.ScrollUp(m.statsDashboard.scroll)
+	case "G", "end":
+		m.statsDashboard.ScrollDown(1 << 30)
+	}
+	return m
+}
+
 // handleFlowMatrixKeys handles keyboard input when flow matrix view is focused
 func (m Model) handleFlowMatrixKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -3217,6 +4030,236 @@ func (m Model) handleLabelPickerKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
+// commandPaletteAvailable reports whether ctrl+p may open the command palette.
+// It stays closed while a text input owns the keyboard (where ctrl+p means "up")
+// and while the review dashboard holds unsaved decisions.
+func (m Model) commandPaletteAvailable() bool {
+	switch {
+	case m.showQuitConfirm, m.showAgentPrompt, m.showCassModal, m.showTimeTravelPrompt:
+		return false
+	case m.showLabelPicker, m.showRecipePicker, m.showRepoPicker:
+		return false
+	case m.focused == focusTimeTravelInput || m.focused == focusTutorial:
+		return false
+	case m.list.FilterState() == list.Filtering:
+		return false
+	case m.showReviewDashboard || m.focused == focusReviewDashboard:
+		return false
+	case m.showLensSelector && (m.lensSelector.IsInsertMode() || m.lensSelector.IsScopeAddMode() || m.lensSelector.IsViewNameMode()):
+		return false
+	case m.showLensDashboard && (m.lensDashboard.ShowFuzzySearch() || m.lensDashboard.ShowScopeInput() || m.lensDashboard.IsRenamingWorkstream()):
+		return false
+	}
+	return true
+}
+
+// buildPaletteCommands lists every action reachable from the current context.
+// Lens commands come first when the lens dashboard is open, then views,
+// filters and actions, then one "Go to" entry per issue.
+func (m Model) buildPaletteCommands() []PaletteCommand {
+	var cmds []PaletteCommand
+
+	if m.showLensDashboard {
+		cmds = append(cmds,
+			PaletteCommand{Category: "Lens", Title: "Toggle workstream view", action: paletteActionLensKey, arg: "lens.view"},
+			PaletteCommand{Category: "Lens", Title: "Toggle grouped view", action: paletteActionLensKey, arg: "lens.grouped"},
+			PaletteCommand{Category: "Lens", Title: "Toggle tree view", action: paletteActionLensKey, arg: "lens.tree"},
+			PaletteCommand{Category: "Lens", Title: "Expand all", action: paletteActionLensKey, arg: "lens.expand_all"},
+			PaletteCommand{Category: "Lens", Title: "Collapse all", action: paletteActionLensKey, arg: "lens.collapse_all"},
+			PaletteCommand{Category: "Lens", Title: "Next page of workstream", action: paletteActionLensKey, arg: "lens.next_page"},
+			PaletteCommand{Category: "Lens", Title: "Previous page of workstream", action: paletteActionLensKey, arg: "lens.prev_page"},
+			PaletteCommand{Category: "Lens", Title: "Set depth: 1", action: paletteActionLensDepth, arg: "1"},
+			PaletteCommand{Category: "Lens", Title: "Set depth: 2", action: paletteActionLensDepth, arg: "2"},
+			PaletteCommand{Category: "Lens", Title: "Set depth: 3", action: paletteActionLensDepth, arg: "3"},
+			PaletteCommand{Category: "Lens", Title: "Set depth: all", action: paletteActionLensDepth, arg: "all"},
+			PaletteCommand{Category: "Lens", Title: "Cycle order within status (blockers/priority/created/updated/id/impact/pagerank)", action: paletteActionLensKey, arg: "lens.order"},
+			PaletteCommand{Category: "Lens", Title: "Reverse order within status", action: paletteActionLensKey, arg: "lens.order_direction"},
+			PaletteCommand{Category: "Lens", Title: "Add label to scope", action: paletteActionLensKey, arg: "lens.scope"},
+			PaletteCommand{Category: "Lens", Title: "Search issues in lens", action: paletteActionLensKey, arg: "lens.search"},
+			PaletteCommand{Category: "Lens", Title: "Toggle archaeology mode (closed issues)", action: paletteActionLensKey, arg: "lens.archaeology"},
+			PaletteCommand{Category: "Lens", Title: "Open selected issue as a lens", action: paletteActionLensKey, arg: "lens.drill"},
+			PaletteCommand{Category: "Lens", Title: "Show / hide detail panel", action: paletteActionLensKey, arg: "lens.detail"},
+			PaletteCommand{Category: "Lens", Title: "Back to previous lens", action: paletteActionLensKey, arg: "lens.history_back"},
+			PaletteCommand{Category: "Lens", Title: "Forward to next lens", action: paletteActionLensKey, arg: "lens.history_forward"},
+			PaletteCommand{Category: "Lens", Title: "Export dump to file", action: paletteActionLensDump},
+		)
+	}
+
+	cmds = append(cmds,
+		PaletteCommand{Category: "View", Title: "Issue list", action: paletteActionKey},
+		PaletteCommand{Category: "View", Title: "Kanban board", action: paletteActionKey, arg: "global.board"},
+		PaletteCommand{Category: "View", Title: "Graph view", action: paletteActionKey, arg: "global.graph"},
+		PaletteCommand{Category: "View", Title: "Insights", action: paletteActionKey, arg: "global.insights"},
+		PaletteCommand{Category: "View", Title: "History", action: paletteActionKey, arg: "global.history"},
+		PaletteCommand{Category: "View", Title: "Actionable", action: paletteActionKey, arg: "global.actionable"},
+		PaletteCommand{Category: "View", Title: "Flow matrix", action: paletteActionKey, arg: "global.flow_matrix"},
+		PaletteCommand{Category: "View", Title: "Label dashboard", action: paletteActionKey, arg: "global.label_dashboard"},
+		PaletteCommand{Category: "View", Title: "Attention view", action: paletteActionKey, arg: "global.attention"},
+		PaletteCommand{Category: "View", Title: "Stats dashboard", action: paletteActionKey, arg: "global.stats"},
+		PaletteCommand{Category: "View", Title: "Table view", action: paletteActionKey, arg: "global.table"},
+		PaletteCommand{Category: "View", Title: "Health check", action: paletteActionKey, arg: "global.health"},
+		PaletteCommand{Category: "View", Title: "Possible duplicates", action: paletteActionKey, arg: "global.duplicates"},
+		PaletteCommand{Category: "Action", Title: "Jump to issue", action: paletteActionKey, arg: "global.jump"},
+		PaletteCommand{Category: "Action", Title: "Theme gallery", action: paletteActionKey, arg: "global.themes"},
+		PaletteCommand{Category: "View", Title: "Open lens", action: paletteActionKey, arg: "global.lens"},
+		PaletteCommand{Category: "Filter", Title: "All issues", action: paletteActionFilter, arg: "all"},
+		PaletteCommand{Category: "Filter", Title: "Open issues", Key: m.keyHint("list.filter_open"), action: paletteActionFilter, arg: "open"},
+		PaletteCommand{Category: "Filter", Title: "Closed issues", Key: m.keyHint("list.filter_closed"), action: paletteActionFilter, arg: "closed"},
+		PaletteCommand{Category: "Filter", Title: "Ready (unblocked)", Key: m.keyHint("list.filter_ready"), action: paletteActionFilter, arg: "ready"},
+		PaletteCommand{Category: "Filter", Title: "Affected by time-travel changes", action: paletteActionKey, arg: "list.filter_affected"},
+		PaletteCommand{Category: "Filter", Title: "Filter by label", action: paletteActionKey, arg: "global.label_picker"},
+		PaletteCommand{Category: "Filter", Title: "Recipes", action: paletteActionKey, arg: "global.recipes"},
+		PaletteCommand{Category: "Action", Title: "Cycle sort", action: paletteActionKey, arg: "list.sort"},
+		PaletteCommand{Category: "Action", Title: "Export to Markdown", action: paletteActionKey, arg: "global.export"},
+		PaletteCommand{Category: "Action", Title: "Copy issue to clipboard", action: paletteActionKey, arg: "list.copy"},
+		PaletteCommand{Category: "Action", Title: "Copy agent context", action: paletteActionKey, arg: "list.context"},
+		PaletteCommand{Category: "Action", Title: "Peek at selected issue", action: paletteActionKey, arg: "list.peek"},
+		PaletteCommand{Category: "Action", Title: "Why is this blocked?", action: paletteActionKey, arg: "list.blocker_chain"},
+		PaletteCommand{Category: "Action", Title: "Open in editor", action: paletteActionKey, arg: "list.edit"},
+		PaletteCommand{Category: "Action", Title: "Toggle priority hints", action: paletteActionKey, arg: "global.priority_hints"},
+		PaletteCommand{Category: "Action", Title: "Toggle shortcuts bar", action: paletteActionKey, arg: "global.shortcuts"},
+		PaletteCommand{Category: "Action", Title: "Help", action: paletteActionKey, arg: "global.help"},
+	)
+	if m.dryRun != nil {
+		cmds = append(cmds, PaletteCommand{Category: "Action", Title: "Pending changes (dry run)", action: paletteActionKey, arg: "global.pending_changes"})
+	}
+
+	// Hints show the keys the action is bound to now, not the built-in ones
+	for i, c := range cmds {
+		if c.action == paletteActionKey || c.action == paletteActionLensKey {
+			cmds[i].Key = m.keyHint(c.arg)
+		}
+	}
+
+	for _, issue := range m.issues {
+		// The real ID stays searchable next to its alias
+		title := issue.ID + "  " + issue.Title
+		if alias := m.theme.ID(issue.ID); alias != issue.ID {
+			title = alias + "  " + title
+		}
+		cmds = append(cmds, PaletteCommand{
+			Category: "Go to",
+			Title:    title,
+			action:   paletteActionGotoIssue,
+			arg:      issue.ID,
+		})
+	}
+	return cmds
+}
+
+// handleCommandPaletteKeys handles keyboard input when the command palette is open
+func (m Model) handleCommandPaletteKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "ctrl+p":
+		m.showCommandPalette = false
+	case "down", "ctrl+n", "tab":
+		m.commandPalette.MoveDown()
+	case "up", "shift+tab":
+		m.commandPalette.MoveUp()
+	case "enter":
+		m.showCommandPalette = false
+		if selected := m.commandPalette.Selected(); selected != nil {
+			return m.runPaletteCommand(*selected)
+		}
+	default:
+		m.commandPalette.UpdateInput(msg)
+	}
+	return m, nil
+}
+
+// runPaletteCommand executes a command chosen from the palette
+func (m Model) runPaletteCommand(c PaletteCommand) (Model, tea.Cmd) {
+	switch c.action {
+	case paletteActionLensKey:
+		if key, ok := parseKey(m.activeKeymap().Builtin(c.arg)); ok {
+			m = m.handleLensDashboardKeys(key)
+		}
+
+	case paletteActionLensDepth:
+		depth := DepthAll
+		if n, err := strconv.Atoi(c.arg); err == nil {
+			depth = DepthOption(n)
+		}
+		m.lensDashboard.SetDepth(depth)
+		m.statusMsg = fmt.Sprintf("Depth: %v", depth)
+		m.statusIsError = false
+
+	case paletteActionLensDump:
+		filename, err := m.lensDashboard.DumpToFile()
+		if err != nil {
+			m.statusMsg = fmt.Sprintf("❌ Dump failed: %v", err)
+			m.statusIsError = true
+		} else {
+			m.statusMsg = fmt.Sprintf("✅ Dumped lens to %s", filename)
+			m.statusIsError = false
+		}
+
+	case paletteActionFilter:
+		m.exitToListView()
+		m.currentFilter = c.arg
+		m.applyFilter()
+
+	case paletteActionGotoIssue:
+		m.exitToListView()
+		if !m.selectIssueInList(c.arg) {
+			// The issue may be hidden by the current filter
+			m.clearAllFilters()
+			if !m.selectIssueInList(c.arg) {
+				m.statusMsg = fmt.Sprintf("Issue %s not found", c.arg)
+				m.statusIsError = true
+				return m, nil
+			}
+		}
+		if m.isSplitView {
+			m.focused = focusDetail
+		} else {
+			m.showDetails = true
+		}
+		m.updateViewportContent()
+
+	case paletteActionKey:
+		m.exitToListView()
+		if c.arg == "" {
+			return m, nil
+		}
+		// Replay the action's built-in key so palette and keys never drift
+		// apart; it skips the keymap, which only rewrites what the user presses
+		key, ok := parseKey(m.activeKeymap().Builtin(c.arg))
+		if !ok {
+			return m, nil
+		}
+		updated, cmd := m.Update(builtinKeyMsg(key))
+		return updated.(Model), cmd
+	}
+	return m, nil
+}
+
+// exitToListView closes full-screen views and overlays and returns focus to the issue list
+func (m *Model) exitToListView() {
+	m.clearAttentionOverlay()
+	m.showLensDashboard = false
+	m.showLensSelector = false
+	m.lensViewOrigin = false
+	m.isBoardView = false
+	m.isGraphView = false
+	m.isActionableView = false
+	m.isHistoryView = false
+	m.showDetails = false
+	m.showHelp = false
+	m.focused = focusList
+}
+
+// selectIssueInList moves the list cursor to the given issue, returning false if it is not listed
+func (m *Model) selectIssueInList(id string) bool {
+	for i, item := range m.list.Items() {
+		if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == id {
+			m.list.Select(i)
+			return true
+		}
+	}
+	return false
+}
+
 // handleInsightsKeys handles keyboard input when insights panel is focused
 func (m Model) handleInsightsKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -3245,6 +4288,10 @@ func (m Model) handleInsightsKeys(msg tea.KeyMsg) Model {
 	case "m":
 		// Toggle heatmap view (bv-95) - "m" for heatMap
 		m.insightsPanel.ToggleHeatmap()
+	case "y":
+		if id := m.insightsPanel.SelectedIssueID(); id != "" {
+			m = m.copyIssueContext(id)
+		}
 	case "enter":
 		// Jump to selected issue in list view
 		selectedID := m.insightsPanel.SelectedIssueID()
@@ -3314,6 +4361,8 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 	case "a":
 		m.currentFilter = "all"
 		m.applyFilter()
+	case "A":
+		m.filterAffected()
 	case "t":
 		// Toggle time-travel mode off, or show prompt for custom revision
 		if m.timeTravelMode {
@@ -3355,6 +4404,124 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 	case "V":
 		// Show cass session preview modal (bv-5bqh)
 		m.showCassSessionModal()
+	case "K":
+		// Toggle the peek hovercard for the selected issue
+		m.showPeek = !m.showPeek
+	case "y":
+		// Copy the selected issue's context block for an agent
+		if item, ok := m.list.SelectedItem().(IssueItem); ok {
+			m = m.copyIssueContext(item.Issue.ID)
+		}
+	case "B":
+		// Explore the full upstream blocker chain of the selected issue
+		if item, ok := m.list.SelectedItem().(IssueItem); ok {
+			if issue, ok := m.issueMap[item.Issue.ID]; ok {
+				m.blockerChain = NewBlockerChainModal(issue, m.issueMap, m.theme)
+				m.blockerChain.SetSize(m.width, m.height)
+				m.showBlockerChain = true
+			}
+		}
+	}
+	return m
+}
+
+// handleBlockerChainKeys handles keyboard input for the blocker chain
+// explorer: enter jumps to the selected blocker in the list
+func (m Model) handleBlockerChainKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "esc", "q", "B":
+		m.showBlockerChain = false
+	case "enter":
+		m.showBlockerChain = false
+		id := m.blockerChain.SelectedIssue().ID
+		if !m.selectIssueInList(id) {
+			m.statusMsg = id + " is hidden by the current filter"
+			m.statusIsError = true
+			return m
+		}
+		m.updateViewportContent()
+	default:
+		m.blockerChain, _ = m.blockerChain.Update(msg)
+	}
+	return m
+}
+
+// handleLabelManagerKeys handles keyboard input for the label rename / merge
+// modal: esc closes it back to the lens selector, a confirmed plan is written
+// through bd and the file watcher picks up the result
+func (m Model) handleLabelManagerKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	m.labelManager, cmd = m.labelManager.Update(msg)
+	if m.labelManager.Done() {
+		m.showLabelManager = false
+		return m, nil
+	}
+	if plan, ok := m.labelManager.TakeApplyRequest(); ok {
+		m.showLabelManager = false
+		m.showLensSelector = false
+		m.focused = focusList
+		m.statusMsg = fmt.Sprintf("Relabeling #%s → #%s on %d issues…", plan.From, plan.To, len(plan.IssueIDs))
+		m.statusIsError = false
+		m.startBackgroundOp(bgRelabel, "")
+		return m, ApplyRelabelCmd(m.workDir, plan, m.dryRun)
+	}
+	return m, cmd
+}
+
+// handleLensCompareKeys handles keyboard input for the lens comparison:
+// esc returns to the lens selector, enter jumps to the selected issue
+func (m Model) handleLensCompareKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "esc", "q":
+		m.showLensCompare = false
+		m.showLensSelector = true
+		m.focused = focusLensSelector
+		m.statusMsg = ""
+	case "enter":
+		issue := m.lensCompare.SelectedIssue()
+		if issue == nil {
+			return m
+		}
+		m.showLensCompare = false
+		m.isSplitView = m.width > SplitViewThreshold
+		m.focused = focusList
+		if m.isSplitView {
+			m.focused = focusDetail
+		}
+		if !m.selectIssueInList(issue.ID) {
+			m.statusMsg = issue.ID + " is hidden by the current filter"
+			m.statusIsError = true
+			return m
+		}
+		m.statusMsg = ""
+		m.updateViewportContent()
+	default:
+		m.lensCompare, _ = m.lensCompare.Update(msg)
+	}
+	return m
+}
+
+// handleWorkstreamCompareKeys handles keyboard input for the workstream
+// comparison: esc returns to the lens, enter selects the issue there
+func (m Model) handleWorkstreamCompareKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "esc", "q":
+		m.showWorkstreamCompare = false
+		m.statusMsg = ""
+	case "enter":
+		issue := m.workstreamCompare.SelectedIssue()
+		if issue == nil {
+			return m
+		}
+		m.showWorkstreamCompare = false
+		if !m.lensDashboard.SelectIssue(issue.ID) {
+			m.statusMsg = issue.ID + " is not in the lens any more"
+			m.statusIsError = true
+			return m
+		}
+		m.statusMsg = ""
+	default:
+		m.workstreamCompare, _ = m.workstreamCompare.Update(msg)
 	}
 	return m
 }
@@ -3384,47 +4551,6 @@ func (m Model) handleTimeTravelInputKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
-// handleHelpKeys handles keyboard input when the help overlay is focused
-func (m Model) handleHelpKeys(msg tea.KeyMsg) Model {
-	switch msg.String() {
-	case "j", "down":
-		m.helpScroll++
-	case "k", "up":
-		if m.helpScroll > 0 {
-			m.helpScroll--
-		}
-	case "ctrl+d":
-		m.helpScroll += 10
-	case "ctrl+u":
-		m.helpScroll -= 10
-		if m.helpScroll < 0 {
-			m.helpScroll = 0
-		}
-	case "home", "g":
-		m.helpScroll = 0
-	case "G", "end":
-		// Will be clamped in render
-		m.helpScroll = 999
-	case "q", "esc", "?", "f1":
-		// Close help overlay
-		m.showHelp = false
-		m.helpScroll = 0
-		m.focused = focusList
-	case " ": // Space opens interactive tutorial (bv-0trk, bv-8y31)
-		m.showHelp = false
-		m.helpScroll = 0
-		m.showTutorial = true
-		m.tutorialModel.SetSize(m.width, m.height)
-		m.focused = focusTutorial
-	default:
-		// Any other key dismisses help
-		m.showHelp = false
-		m.helpScroll = 0
-		m.focused = focusList
-	}
-	return m
-}
-
 func (m Model) View() string {
 	if !m.ready {
 		return "Initializing..."
@@ -3435,12 +4561,22 @@ func (m Model) View() string {
 	// Quit confirmation overlay takes highest priority
 	if m.showQuitConfirm {
 		body = m.renderQuitConfirm()
+	} else if m.showCommandPalette {
+		body = m.commandPalette.View()
+	} else if m.showJump {
+		body = m.jump.View()
+	} else if m.showStatusMenu {
+		body = m.renderStatusMenu()
+	} else if m.showActionResult {
+		body = m.renderActionResult()
 	} else if m.showAgentPrompt {
 		// AGENTS.md prompt modal (bv-i8dk)
 		body = m.agentPromptModal.CenterModal(m.width, m.height-1)
 	} else if m.showCassModal {
 		// Cass session preview modal (bv-5bqh)
 		body = m.cassModal.CenterModal(m.width, m.height-1)
+	} else if m.showBlockerChain {
+		body = m.blockerChain.CenterModal(m.width, m.height-1)
 	} else if m.showLabelHealthDetail && m.labelHealthDetail != nil {
 		body = m.renderLabelHealthDetail(*m.labelHealthDetail)
 	} else if m.showLabelGraphAnalysis && m.labelGraphAnalysisResult != nil {
@@ -3449,6 +4585,14 @@ func (m Model) View() string {
 		body = m.renderLabelDrilldown()
 	} else if m.showAlertsPanel {
 		body = m.renderAlertsPanel()
+	} else if m.showPendingChanges {
+		body = m.renderPendingChanges()
+	} else if m.showThemeGallery {
+		body = m.renderThemeGallery()
+	} else if m.showHealth {
+		body = m.renderHealth()
+	} else if m.showDuplicates {
+		body = m.renderDuplicates()
 	} else if m.showTimeTravelPrompt {
 		body = m.renderTimeTravelPrompt()
 	} else if m.showRecipePicker {
@@ -3457,7 +4601,19 @@ func (m Model) View() string {
 		body = m.repoPicker.View()
 	} else if m.showLabelPicker {
 		body = m.labelPicker.View()
+	} else if m.showLabelManager {
+		m.labelManager.SetSize(m.width, m.height-1)
+		body = m.labelManager.CenterModal(m.width, m.height-1)
+	} else if m.showHelp {
+		body = m.renderHelpOverlay()
+	} else if m.showLensCompare {
+		m.lensCompare.SetSize(m.width, m.height-1)
+		body = m.lensCompare.View()
+	} else if m.showWorkstreamCompare {
+		m.workstreamCompare.SetSize(m.width, m.height-1)
+		body = m.workstreamCompare.View()
 	} else if m.showLensSelector {
+		m.lensSelector.SetSize(m.width, m.height-1)
 		body = m.lensSelector.View()
 	} else if m.showLensDashboard {
 		m.lensDashboard.SetSize(m.width, m.height-1)
@@ -3465,8 +4621,6 @@ func (m Model) View() string {
 	} else if m.showReviewDashboard && m.reviewDashboard != nil {
 		m.reviewDashboard.SetSize(m.width, m.height-1)
 		body = m.reviewDashboard.View()
-	} else if m.showHelp {
-		body = m.renderHelpOverlay()
 	} else if m.showTutorial {
 		// Interactive tutorial (bv-8y31) - full screen overlay
 		body = m.tutorialModel.View()
@@ -3476,6 +4630,15 @@ func (m Model) View() string {
 	} else if m.focused == focusFlowMatrix {
 		m.flowMatrix.SetSize(m.width, m.height-1)
 		body = m.flowMatrix.View()
+	} else if m.focused == focusStatsDashboard {
+		m.statsDashboard.SetSize(m.width, m.height-1)
+		body = m.statsDashboard.View()
+	} else if m.focused == focusTable {
+		m.tableView.SetSize(m.width, m.height-1)
+		body = m.tableView.View()
+	} else if m.showGraphCanvas {
+		m.graphCanvas.SetSize(m.width, m.height-1)
+		body = m.graphCanvas.View()
 	} else if m.isGraphView {
 		body = m.graphView.View(m.width, m.height-1)
 	} else if m.isBoardView {
@@ -3490,6 +4653,10 @@ func (m Model) View() string {
 		body = m.sprintViewText
 	} else if m.isSplitView {
 		body = m.renderSplitView()
+		if m.showPeek && m.focused == focusList {
+			// Border, column header, then the list's filter line
+			body = m.overlayPeek(body, 3, 1, m.list.Width()+2)
+		}
 	} else if m.focused == focusLabelDashboard {
 		m.labelDashboard.SetSize(m.width, m.height-1)
 		body = m.labelDashboard.View()
@@ -3499,6 +4666,10 @@ func (m Model) View() string {
 			body = m.viewport.View()
 		} else {
 			body = m.renderListWithHeader()
+			if m.showPeek && m.focused == focusList {
+				// Column header, then the list's filter line
+				body = m.overlayPeek(body, 2, 0, m.width)
+			}
 		}
 	}
 
@@ -3511,6 +4682,7 @@ func (m Model) View() string {
 		body = lipgloss.JoinHorizontal(lipgloss.Top, body, sidebar)
 	}
 
+	body = m.overlayToast(body)
 	footer := m.renderFooter()
 
 	// Ensure the final output fits exactly in the terminal height
@@ -3711,232 +4883,12 @@ func (m Model) renderSplitView() string {
 
 	// Detail Panel Width: Inner + 2 (Padding). Border adds another 2.
 	detailView := detailStyle.
-		Width(m.viewport.Width + 2).
-		Height(panelHeight).
-		MaxHeight(panelHeight).
-		Render(m.viewport.View())
-
-	return lipgloss.JoinHorizontal(lipgloss.Top, listView, detailView)
-}
-
-func (m *Model) renderHelpOverlay() string {
-	t := m.theme
-
-	// Determine layout based on terminal width
-	// 3 columns for wide (≥120), 2 columns for medium (≥80), 1 column for narrow
-	numCols := 3
-	if m.width < 120 {
-		numCols = 2
-	}
-	if m.width < 80 {
-		numCols = 1
-	}
-
-	// Calculate column width (accounting for gaps and outer padding)
-	totalPadding := 8 // outer padding
-	gapWidth := 2     // gap between columns
-	availableWidth := m.width - totalPadding - (gapWidth * (numCols - 1))
-	colWidth := availableWidth / numCols
-	if colWidth < 28 {
-		colWidth = 28
-	}
-
-	// Define color palette (Dracula-inspired gradient)
-	colors := []lipgloss.AdaptiveColor{
-		{Light: "#7D56F4", Dark: "#BD93F9"}, // Purple
-		{Light: "#FF79C6", Dark: "#FF79C6"}, // Pink
-		{Light: "#8BE9FD", Dark: "#8BE9FD"}, // Cyan
-		{Light: "#50FA7B", Dark: "#50FA7B"}, // Green
-		{Light: "#FFB86C", Dark: "#FFB86C"}, // Orange
-		{Light: "#F1FA8C", Dark: "#F1FA8C"}, // Yellow
-	}
-
-	// Helper to render a section panel
-	renderPanel := func(title string, icon string, colorIdx int, shortcuts []struct{ key, desc string }) string {
-		color := colors[colorIdx%len(colors)]
-
-		headerStyle := t.Renderer.NewStyle().
-			Foreground(color).
-			Bold(true).
-			BorderStyle(lipgloss.Border{Bottom: "─"}).
-			BorderBottom(true).
-			BorderForeground(color).
-			Width(colWidth-4).
-			Padding(0, 1)
-
-		keyStyle := t.Renderer.NewStyle().
-			Foreground(color).
-			Bold(true).
-			Width(10)
-
-		descStyle := t.Renderer.NewStyle().
-			Foreground(t.Base.GetForeground()).
-			Width(colWidth - 16)
-
-		var content strings.Builder
-		content.WriteString(headerStyle.Render(icon + " " + title))
-		content.WriteString("\n")
-
-		for _, s := range shortcuts {
-			content.WriteString(keyStyle.Render(s.key))
-			content.WriteString(descStyle.Render(s.desc))
-			content.WriteString("\n")
-		}
-
-		panelStyle := t.Renderer.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(color).
-			Padding(0, 1).
-			Width(colWidth)
-
-		return panelStyle.Render(content.String())
-	}
-
-	// Define all sections
-	navSection := []struct{ key, desc string }{
-		{"j / ↓", "Move down"},
-		{"k / ↑", "Move up"},
-		{"G/end", "Go to last"},
-		{"Ctrl+d", "Page down"},
-		{"Ctrl+u", "Page up"},
-		{"Tab", "Switch focus"},
-		{"Enter", "View details"},
-		{"Esc", "Back / close"},
-	}
-
-	viewsSection := []struct{ key, desc string }{
-		{"b", "Kanban board"},
-		{"g", "Graph view"},
-		{"i", "Insights"},
-		{"h", "History view"},
-		{"a", "Actionable"},
-		{"f", "Flow matrix"},
-		{"[", "Label dashboard"},
-		{"]", "Attention view"},
-	}
-
-	globalSection := []struct{ key, desc string }{
-		{"?", "This help"},
-		{";", "Shortcuts bar"},
-		{"!", "Alerts panel"},
-		{"'", "Recipes"},
-		{"w", "Repo picker"},
-		{"q", "Back / Quit"},
-		{"Ctrl+c", "Force quit"},
-	}
-
-	filterSection := []struct{ key, desc string }{
-		{"/", "Fuzzy search"},
-		{"Ctrl+S", "Semantic search"},
-		{"H", "Hybrid ranking"},
-		{"Alt+H", "Hybrid preset"},
-		{"o", "Open issues"},
-		{"c", "Closed issues"},
-		{"r", "Ready (unblocked)"},
-		{"l", "Filter by label"},
-		{"s", "Cycle sort"},
-		{"S", "Triage sort"},
-	}
-
-	graphSection := []struct{ key, desc string }{
-		{"hjkl", "Navigate nodes"},
-		{"H/L", "Scroll left/right"},
-		{"PgUp/Dn", "Scroll up/down"},
-		{"Enter", "Jump to issue"},
-	}
-
-	insightsSection := []struct{ key, desc string }{
-		{"h/l/Tab", "Switch panels"},
-		{"j/k", "Navigate items"},
-		{"e", "Explanations"},
-		{"x", "Calc details"},
-		{"m", "Toggle heatmap"},
-		{"Enter", "Jump to issue"},
-	}
-
-	historySection := []struct{ key, desc string }{
-		{"j/k", "Navigate beads"},
-		{"J/K", "Navigate commits"},
-		{"Tab", "Toggle focus"},
-		{"y", "Copy SHA"},
-		{"c", "Confidence filter"},
-	}
-
-	actionsSection := []struct{ key, desc string }{
-		{"p", "Priority hints"},
-		{"t", "Time-travel"},
-		{"T", "Quick time-travel"},
-		{"x", "Export markdown"},
-		{"C", "Copy to clipboard"},
-		{"O", "Open in editor"},
-	}
-
-	// Build panels
-	panels := []string{
-		renderPanel("Navigation", "🧭", 0, navSection),
-		renderPanel("Views", "👁", 1, viewsSection),
-		renderPanel("Global", "🌐", 2, globalSection),
-		renderPanel("Filters & Sort", "🔍", 3, filterSection),
-		renderPanel("Graph View", "📊", 4, graphSection),
-		renderPanel("Insights", "💡", 5, insightsSection),
-		renderPanel("History", "📜", 0, historySection),
-		renderPanel("Actions", "⚡", 1, actionsSection),
-	}
-
-	// Arrange panels into columns
-	var columns []string
-	panelsPerCol := (len(panels) + numCols - 1) / numCols
-
-	for col := 0; col < numCols; col++ {
-		start := col * panelsPerCol
-		end := start + panelsPerCol
-		if end > len(panels) {
-			end = len(panels)
-		}
-		if start >= len(panels) {
-			break
-		}
-
-		colPanels := panels[start:end]
-		columns = append(columns, lipgloss.JoinVertical(lipgloss.Left, colPanels...))
-	}
-
-	// Join columns horizontally
-	body := lipgloss.JoinHorizontal(lipgloss.Top, columns...)
-
-	// Title bar
-	titleStyle := t.Renderer.NewStyle().
-		Foreground(t.Primary).
-		Bold(true).
-		Padding(0, 2)
-
-	subtitleStyle := t.Renderer.NewStyle().
-		Foreground(t.Secondary).
-		Italic(true)
-
-	title := titleStyle.Render("⌨️  Keyboard Shortcuts")
-	subtitle := subtitleStyle.Render("Space: Tutorial │ ? or Esc to close")
-	titleBar := lipgloss.JoinHorizontal(lipgloss.Center, title, "  ", subtitle)
-
-	// Combine title and body
-	content := lipgloss.JoinVertical(lipgloss.Center, titleBar, "", body)
-
-	// Outer container
-	containerStyle := t.Renderer.NewStyle().
-		Border(lipgloss.DoubleBorder()).
-		BorderForeground(t.Primary).
-		Padding(1, 2)
-
-	helpBox := containerStyle.Render(content)
-
-	// Center in viewport
-	return lipgloss.Place(
-		m.width,
-		m.height-1,
-		lipgloss.Center,
-		lipgloss.Center,
-		helpBox,
-	)
+		Width(m.viewport.Width + 2).
+		Height(panelHeight).
+		MaxHeight(panelHeight).
+		Render(m.viewport.View())
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, listView, detailView)
 }
 
 func (m Model) renderLabelHealthDetail(lh analysis.LabelHealth) string {
@@ -4187,7 +5139,7 @@ func (m Model) renderLabelDrilldown() string {
 		sb.WriteString(labelStyle.Render("Top issues by PageRank:"))
 		sb.WriteString("\n")
 		for _, si := range scoredIssues {
-			line := fmt.Sprintf("  %s  %-10s  PR=%.3f  %s", getStatusIcon(si.issue.Status), si.issue.ID, si.score, si.issue.Title)
+			line := fmt.Sprintf("  %s  %-10s  PR=%.3f  %s", getStatusIcon(si.issue.Status), m.theme.ID(si.issue.ID), si.score, si.issue.Title)
 			sb.WriteString(valStyle.Render(line))
 			sb.WriteString("\n")
 		}
@@ -4452,6 +5404,9 @@ func (m *Model) renderFooter() string {
 		case "ready":
 			filterTxt = "READY"
 			filterIcon = "🚀"
+		case "affected":
+			filterTxt = "AFFECTED since " + m.timeTravelSince
+			filterIcon = "🎯"
 		default:
 			if strings.HasPrefix(m.currentFilter, "recipe:") {
 				filterTxt = strings.ToUpper(m.currentFilter[7:])
@@ -4668,6 +5623,41 @@ func (m *Model) renderFooter() string {
 		workspaceSection = workspaceStyle.Render(fmt.Sprintf("📦 %s", m.workspaceSummary))
 	}
 
+	// ─────────────────────────────────────────────────────────────────────────
+	// READ-ONLY BADGE - bd CLI missing, writes disabled; in a dry run, the
+	// count of changes held back takes its place
+	// ─────────────────────────────────────────────────────────────────────────
+	readOnlySection := ""
+	if m.readOnly {
+		readOnlyStyle := lipgloss.NewStyle().
+			Background(ColorWarning).
+			Foreground(ColorBg).
+			Bold(true).
+			Padding(0, 1)
+		readOnlySection = readOnlyStyle.Render("🔒 read-only")
+	}
+	if m.dryRun != nil {
+		dryRunStyle := lipgloss.NewStyle().
+			Background(ColorWarning).
+			Foreground(ColorBg).
+			Bold(true).
+			Padding(0, 1)
+		readOnlySection = dryRunStyle.Render(fmt.Sprintf("🧪 dry run: %d pending", m.dryRun.Len()))
+	}
+
+	// ─────────────────────────────────────────────────────────────────────────
+	// LOADING BADGE - Streaming load still adding issues
+	// ─────────────────────────────────────────────────────────────────────────
+	loadingSection := ""
+	if label := m.loadingLabel(); label != "" {
+		loadingStyle := lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorInfo).
+			Bold(true).
+			Padding(0, 1)
+		loadingSection = loadingStyle.Render(label)
+	}
+
 	// ─────────────────────────────────────────────────────────────────────────
 	// REPO FILTER BADGE - Active repo selection (workspace mode)
 	// ─────────────────────────────────────────────────────────────────────────
@@ -4695,7 +5685,7 @@ func (m *Model) renderFooter() string {
 
 	var keyHints []string
 	if m.showHelp {
-		keyHints = append(keyHints, "Press any key to close")
+		keyHints = append(keyHints, keyStyle.Render("/")+" search", keyStyle.Render("j/k")+" scroll", keyStyle.Render("esc")+" close")
 	} else if m.showRecipePicker {
 		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
 	} else if m.showRepoPicker {
@@ -4707,8 +5697,14 @@ func (m *Model) renderFooter() string {
 		keyHints = append(keyHints, keyStyle.Render("A")+" attention", keyStyle.Render("F")+" flow")
 	} else if m.focused == focusFlowMatrix {
 		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("tab")+" panel", keyStyle.Render("⏎")+" drill", keyStyle.Render("esc")+" back", keyStyle.Render("f")+" close")
+	} else if m.focused == focusStatsDashboard {
+		keyHints = append(keyHints, keyStyle.Render("j/k")+" scroll", keyStyle.Render("G")+" bottom", keyStyle.Render("x")+" csv", keyStyle.Render("esc")+" back", keyStyle.Render("D")+" close")
+	} else if m.focused == focusTable {
+		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("1-7")+" columns", keyStyle.Render("←/→")+" scroll", keyStyle.Render("⏎")+" open", keyStyle.Render("R")+" close")
+	} else if m.focused == focusGraphCanvas {
+		keyHints = append(keyHints, keyStyle.Render("←↑↓→")+" select", keyStyle.Render("hjkl")+" pan", keyStyle.Render("+/-")+" zoom", keyStyle.Render("⏎")+" view", keyStyle.Render("esc")+" back")
 	} else if m.isGraphView {
-		keyHints = append(keyHints, keyStyle.Render("hjkl")+" nav", keyStyle.Render("H/L")+" scroll", keyStyle.Render("⏎")+" view", keyStyle.Render("g")+" list")
+		keyHints = append(keyHints, keyStyle.Render("hjkl")+" nav", keyStyle.Render("H/L")+" scroll", keyStyle.Render("⏎")+" view", keyStyle.Render("c")+" canvas", keyStyle.Render("A")+" archaeology", keyStyle.Render("g")+" list")
 	} else if m.isBoardView {
 		keyHints = append(keyHints, keyStyle.Render("hjkl")+" nav", keyStyle.Render("G")+" bottom", keyStyle.Render("⏎")+" view", keyStyle.Render("b")+" list")
 	} else if m.isActionableView {
@@ -4779,6 +5775,12 @@ func (m *Model) renderFooter() string {
 	if repoFilterSection != "" {
 		leftWidth += lipgloss.Width(repoFilterSection) + 1
 	}
+	if readOnlySection != "" {
+		leftWidth += lipgloss.Width(readOnlySection) + 1
+	}
+	if loadingSection != "" {
+		leftWidth += lipgloss.Width(loadingSection) + 1
+	}
 	if updateSection != "" {
 		leftWidth += lipgloss.Width(updateSection) + 1
 	}
@@ -4812,6 +5814,12 @@ func (m *Model) renderFooter() string {
 	if repoFilterSection != "" {
 		parts = append(parts, repoFilterSection)
 	}
+	if readOnlySection != "" {
+		parts = append(parts, readOnlySection)
+	}
+	if loadingSection != "" {
+		parts = append(parts, loadingSection)
+	}
 	if updateSection != "" {
 		parts = append(parts, updateSection)
 	}
@@ -4847,6 +5855,9 @@ func (m Model) getDiffStatus(id string) DiffStatus {
 	if m.modifiedIssueIDs[id] {
 		return DiffStatusModified
 	}
+	if _, ok := m.affectedIssues[id]; ok {
+		return DiffStatusAffected
+	}
 	return DiffStatusNone
 }
 
@@ -4891,16 +5902,16 @@ func (m *Model) applyFilter() {
 		case "all":
 			include = true
 		case "open":
-			include = issue.Status != model.StatusClosed
+			include = !issue.Status.IsClosed()
 		case "closed":
-			include = issue.Status == model.StatusClosed
+			include = issue.Status.IsClosed()
 		case "ready":
 			// Ready = Open/InProgress AND NO Open Blockers
-			if issue.Status != model.StatusClosed && issue.Status != model.StatusBlocked {
+			if !issue.Status.IsClosed() && issue.Status.Column() != model.StatusBlocked {
 				isBlocked := false
 				for _, dep := range issue.Dependencies {
 					if dep.Type == model.DepBlocks {
-						if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
+						if blocker, exists := m.issueMap[dep.DependsOnID]; exists && !blocker.Status.IsClosed() {
 							isBlocked = true
 							break
 						}
@@ -4908,6 +5919,8 @@ func (m *Model) applyFilter() {
 				}
 				include = !isBlocked
 			}
+		case "affected":
+			_, include = m.affectedIssues[issue.ID]
 		default:
 			if strings.HasPrefix(m.currentFilter, "label:") {
 				label := strings.TrimPrefix(m.currentFilter, "label:")
@@ -4928,6 +5941,7 @@ func (m *Model) applyFilter() {
 				Impact:     m.analysis.GetCriticalPathScore(issue.ID),
 				DiffStatus: m.getDiffStatus(issue.ID),
 				RepoPrefix: ExtractRepoPrefix(issue.ID),
+				DisplayID:  m.theme.ID(issue.ID),
 			}
 			// Add triage data (bv-151)
 			item.TriageScore = m.triageScores[issue.ID]
@@ -4938,6 +5952,7 @@ func (m *Model) applyFilter() {
 			item.IsQuickWin = m.quickWinSet[issue.ID]
 			item.IsBlocker = m.blockerSet[issue.ID]
 			item.UnblocksCount = len(m.unblocksMap[issue.ID])
+			item.DependentsCount = m.dependentsCount[issue.ID]
 			filteredItems = append(filteredItems, item)
 			filteredIssues = append(filteredIssues, issue)
 		}
@@ -4950,8 +5965,10 @@ func (m *Model) applyFilter() {
 	m.updateSemanticIDs(filteredItems)
 	m.board.SetIssues(filteredIssues)
 	// Generate insights for graph view (for metric rankings and sorting)
-	filterIns := m.analysis.GenerateInsights(len(filteredIssues))
-	m.graphView.SetIssues(filteredIssues, &filterIns)
+	graphIssues := m.graphIssues(filteredIssues)
+	filterIns := m.analysis.GenerateInsights(len(graphIssues))
+	m.graphView.SetIssues(graphIssues, &filterIns)
+	m.syncGraphCanvas()
 
 	// Keep selection in bounds
 	if len(filteredItems) > 0 && m.list.Index() >= len(filteredItems) {
@@ -4963,7 +5980,17 @@ func (m *Model) applyFilter() {
 // cycleSortMode cycles through available sort modes (bv-3ita)
 func (m *Model) cycleSortMode() {
 	m.sortMode = (m.sortMode + 1) % numSortModes
-	m.applyFilter() // Re-apply filter with new sort
+	m.updateListDelegate() // The effective priority column follows its sort
+	m.applyFilter()        // Re-apply filter with new sort
+}
+
+// effectivePriorityOf returns the issue's own priority or the more urgent
+// one it inherits from the work it blocks
+func (m *Model) effectivePriorityOf(issue model.Issue) int {
+	if ep, ok := m.effectivePriority[issue.ID]; ok {
+		return ep.Priority
+	}
+	return issue.Priority
 }
 
 // sortFilteredItems sorts the filtered items based on current sortMode (bv-3ita)
@@ -4995,10 +6022,55 @@ func (m *Model) sortFilteredItems(items []list.Item, issues []model.Issue) {
 		case SortUpdated:
 			// Most recently updated first
 			return iItem.Issue.UpdatedAt.After(jItem.Issue.UpdatedAt)
+		case SortDependents:
+			// Highest leverage first, then priority
+			if iItem.DependentsCount != jItem.DependentsCount {
+				return iItem.DependentsCount > jItem.DependentsCount
+			}
+			return iItem.Issue.Priority < jItem.Issue.Priority
+		case SortStale:
+			// Open first, then longest without an update
+			iClosed := iItem.Issue.Status.IsClosed()
+			jClosed := jItem.Issue.Status.IsClosed()
+			if iClosed != jClosed {
+				return !iClosed
+			}
+			return analysis.LastUpdate(iItem.Issue).Before(analysis.LastUpdate(jItem.Issue))
+		case SortEffective:
+			// Open first, then inherited priority; blockers gating the most
+			// work lead their priority band
+			iClosed := iItem.Issue.Status.IsClosed()
+			jClosed := jItem.Issue.Status.IsClosed()
+			if iClosed != jClosed {
+				return !iClosed
+			}
+			iEff, jEff := m.effectivePriorityOf(iItem.Issue), m.effectivePriorityOf(jItem.Issue)
+			if iEff != jEff {
+				return iEff < jEff
+			}
+			if iItem.DependentsCount != jItem.DependentsCount {
+				return iItem.DependentsCount > jItem.DependentsCount
+			}
+			return iItem.Issue.Priority < jItem.Issue.Priority
+		case SortLeverage:
+			// Open first, then the most work unblocked per hour of effort
+			iClosed := iItem.Issue.Status.IsClosed()
+			jClosed := jItem.Issue.Status.IsClosed()
+			if iClosed != jClosed {
+				return !iClosed
+			}
+			iLev, jLev := m.leverage[iItem.Issue.ID], m.leverage[jItem.Issue.ID]
+			if iLev != jLev {
+				return iLev > jLev
+			}
+			if iItem.DependentsCount != jItem.DependentsCount {
+				return iItem.DependentsCount > jItem.DependentsCount
+			}
+			return iItem.Issue.Priority < jItem.Issue.Priority
 		default:
 			// Default: Open first, then priority, then newest
-			iClosed := iItem.Issue.Status == model.StatusClosed
-			jClosed := jItem.Issue.Status == model.StatusClosed
+			iClosed := iItem.Issue.Status.IsClosed()
+			jClosed := jItem.Issue.Status.IsClosed()
 			if iClosed != jClosed {
 				return !iClosed
 			}
@@ -5084,7 +6156,7 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 			isBlocked := false
 			for _, dep := range issue.Dependencies {
 				if dep.Type == model.DepBlocks {
-					if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
+					if blocker, exists := m.issueMap[dep.DependsOnID]; exists && !blocker.Status.IsClosed() {
 						isBlocked = true
 						break
 					}
@@ -5100,6 +6172,7 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 				Impact:     m.analysis.GetCriticalPathScore(issue.ID),
 				DiffStatus: m.getDiffStatus(issue.ID),
 				RepoPrefix: ExtractRepoPrefix(issue.ID),
+				DisplayID:  m.theme.ID(issue.ID),
 			}
 			// Add triage data (bv-151)
 			item.TriageScore = m.triageScores[issue.ID]
@@ -5110,6 +6183,7 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 			item.IsQuickWin = m.quickWinSet[issue.ID]
 			item.IsBlocker = m.blockerSet[issue.ID]
 			item.UnblocksCount = len(m.unblocksMap[issue.ID])
+			item.DependentsCount = m.dependentsCount[issue.ID]
 			filteredItems = append(filteredItems, item)
 			filteredIssues = append(filteredIssues, issue)
 		}
@@ -5176,8 +6250,10 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 	m.updateSemanticIDs(filteredItems)
 	m.board.SetIssues(filteredIssues)
 	// Generate insights for graph view (for metric rankings and sorting)
-	recipeIns := m.analysis.GenerateInsights(len(filteredIssues))
-	m.graphView.SetIssues(filteredIssues, &recipeIns)
+	graphIssues := m.graphIssues(filteredIssues)
+	recipeIns := m.analysis.GenerateInsights(len(graphIssues))
+	m.graphView.SetIssues(graphIssues, &recipeIns)
+	m.syncGraphCanvas()
 
 	// Update filter indicator
 	m.currentFilter = "recipe:" + r.Name
@@ -5203,6 +6279,7 @@ func (m *Model) updateViewportContent() {
 		return
 	}
 	item := issueItem.Issue
+	m.refreshDetailLinks(&item)
 
 	var sb strings.Builder
 
@@ -5216,7 +6293,7 @@ func (m *Model) updateViewportContent() {
 	// Meta Table
 	sb.WriteString("| ID | Status | Priority | Assignee | Created |\n|---|---|---|---|---|\n")
 	sb.WriteString(fmt.Sprintf("| **%s** | **%s** | %s | @%s | %s |\n\n",
-		item.ID,
+		m.theme.ID(item.ID),
 		strings.ToUpper(string(item.Status)),
 		GetPriorityIcon(item.Priority),
 		item.Assignee,
@@ -5228,6 +6305,11 @@ func (m *Model) updateViewportContent() {
 		sb.WriteString(fmt.Sprintf("**Labels:** %s\n\n", strings.Join(item.Labels, ", ")))
 	}
 
+	// Workstream claims covering this issue
+	if cs := m.claimCoverage[item.ID]; len(cs) > 0 {
+		sb.WriteString(fmt.Sprintf("**Claimed:** %s\n\n", describeClaims(cs)))
+	}
+
 	// Triage Insights (bv-151)
 	if issueItem.TriageScore > 0 || issueItem.TriageReason != "" || issueItem.UnblocksCount > 0 || issueItem.IsQuickWin || issueItem.IsBlocker {
 		sb.WriteString("### 🎯 Triage Insights\n")
@@ -5318,6 +6400,9 @@ func (m *Model) updateViewportContent() {
 		sb.WriteString(item.Notes + "\n\n")
 	}
 
+	// URLs and files named above
+	sb.WriteString(m.detailLinksMD())
+
 	// Dependency Graph (Tree)
 	if len(item.Dependencies) > 0 {
 		rootNode := BuildDependencyTree(item.ID, m.issueMap, 3) // Max depth 3
@@ -5500,6 +6585,20 @@ func (m *Model) EnableWorkspaceMode(info WorkspaceInfo) {
 	m.updateListDelegate()
 }
 
+// EnableReadOnlyMode marks the session read-only because the bd CLI is not
+// installed: issues are read straight from the JSONL, but writes that go
+// through bd (saving reviews) are refused with an explanation.
+func (m *Model) EnableReadOnlyMode() {
+	m.readOnly = true
+	m.statusMsg = "bd not found: read-only mode (browsing .beads JSONL directly)"
+	m.statusIsError = false
+}
+
+// IsReadOnly returns whether the session is read-only
+func (m Model) IsReadOnly() bool {
+	return m.readOnly
+}
+
 // IsWorkspaceMode returns whether workspace mode is active
 func (m Model) IsWorkspaceMode() bool {
 	return m.workspaceMode
@@ -5602,6 +6701,15 @@ func (m *Model) enterTimeTravelMode(revision string) {
 		m.modifiedIssueIDs[mod.IssueID] = true
 	}
 
+	// Everything downstream of a change may need another look
+	var changed []string
+	for _, ids := range []map[string]bool{m.newIssueIDs, m.closedIssueIDs, m.modifiedIssueIDs} {
+		for id := range ids {
+			changed = append(changed, id)
+		}
+	}
+	m.affectedIssues = analysis.AffectedCone(m.issues, changed)
+
 	m.timeTravelMode = true
 	m.timeTravelDiff = diff
 	m.timeTravelSince = revision
@@ -5623,6 +6731,10 @@ func (m *Model) exitTimeTravelMode() {
 	m.newIssueIDs = nil
 	m.closedIssueIDs = nil
 	m.modifiedIssueIDs = nil
+	m.affectedIssues = nil
+	if m.currentFilter == "affected" {
+		m.currentFilter = "all"
+	}
 
 	// Feedback
 	m.statusMsg = "⏱️ Time-travel mode disabled"
@@ -5641,6 +6753,28 @@ func (m *Model) rebuildListWithDiffInfo() {
 	}
 }
 
+// filterAffected narrows the list to the issues changed since the
+// time-travel revision and everything downstream of them
+func (m *Model) filterAffected() {
+	if !m.timeTravelMode {
+		m.statusMsg = "Affected filter compares against a revision: press t or T to time-travel first"
+		m.statusIsError = true
+		return
+	}
+	m.activeRecipe = nil
+	m.currentFilter = "affected"
+	m.applyFilter()
+
+	changed := 0
+	for _, a := range m.affectedIssues {
+		if a.Depth == 0 {
+			changed++
+		}
+	}
+	m.statusMsg = fmt.Sprintf("🎯 %d changed since %s, %d more downstream", changed, m.timeTravelSince, len(m.affectedIssues)-changed)
+	m.statusIsError = false
+}
+
 // IsTimeTravelMode returns whether time-travel mode is active
 func (m Model) IsTimeTravelMode() bool {
 	return m.timeTravelMode
@@ -5668,8 +6802,28 @@ func (m *Model) exportToMarkdown() {
 	m.statusIsError = false
 }
 
+// exportCumulativeFlowCSV writes the stats dashboard's cumulative flow series to CSV
+func (m *Model) exportCumulativeFlowCSV() {
+	filename := fmt.Sprintf("beads_flow_%s_%s.csv", exportProjectName(), time.Now().Format("2006-01-02"))
+	if err := m.statsDashboard.ExportCumulativeFlowCSV(filename); err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Export failed: %v", err)
+		m.statusIsError = true
+		return
+	}
+
+	m.statusMsg = fmt.Sprintf("✅ Exported cumulative flow to %s", filename)
+	m.statusIsError = false
+}
+
 // generateExportFilename creates a smart filename based on project and date
 func (m *Model) generateExportFilename() string {
+	// Format: beads_report_<project>_YYYY-MM-DD.md
+	timestamp := time.Now().Format("2006-01-02")
+	return fmt.Sprintf("beads_report_%s_%s.md", exportProjectName(), timestamp)
+}
+
+// exportProjectName returns the sanitized current directory name for export filenames
+func exportProjectName() string {
 	// Get project name from current directory
 	projectName := "beads"
 	if cwd, err := os.Getwd(); err == nil {
@@ -5682,10 +6836,7 @@ func (m *Model) generateExportFilename() string {
 			return '_'
 		}, projectName)
 	}
-
-	// Format: beads_report_<project>_YYYY-MM-DD.md
-	timestamp := time.Now().Format("2006-01-02")
-	return fmt.Sprintf("beads_report_%s_%s.md", projectName, timestamp)
+	return projectName
 }
 
 // renderTimeTravelPrompt renders the time-travel revision input overlay
@@ -6177,6 +7328,34 @@ func (m Model) handleLensSelectorKeys(msg tea.KeyMsg) Model {
 	// Pass key to lens selector
 	handled := m.lensSelector.Update(msg.String())
 
+	if name, ok := m.lensSelector.TakeSaveViewRequest(); ok {
+		m.saveLensView(name)
+		return m
+	}
+	if name, ok := m.lensSelector.TakeLoadViewRequest(); ok {
+		if err := m.restoreLensView(name); err != nil {
+			m.statusMsg = err.Error()
+			m.statusIsError = true
+		}
+		return m
+	}
+	if pinned, ok := m.lensSelector.TakePinsRequest(); ok {
+		m.savePinnedLenses(pinned)
+		return m
+	}
+	if label, ok := m.lensSelector.TakeManageLabelRequest(); ok {
+		if !loader.BdAvailable() && m.dryRun == nil {
+			m.statusMsg = "Renaming labels needs the bd CLI on PATH"
+			m.statusIsError = true
+			return m
+		}
+		m.labelManager = NewLabelManagerModal(label, m.issues, m.theme)
+		m.labelManager.SetSize(m.width, m.height-1)
+		m.showLabelManager = true
+		m.statusMsg = ""
+		return m
+	}
+
 	// Check if selection was made
 	if m.lensSelector.IsConfirmed() {
 		selectedItem := m.lensSelector.SelectedItem()
@@ -6190,21 +7369,28 @@ func (m Model) handleLensSelectorKeys(msg tea.KeyMsg) Model {
 			}
 			m.issueMap = issueMap
 
+			// Two lenses picked with c: compare them side by side
+			if left, right, ok := m.lensSelector.CompareRequest(); ok {
+				m.lensCompare = NewLensCompareModel(left, right, m.issues, m.theme)
+				m.lensCompare.SetSize(m.width, m.height-1)
+				m.showLensCompare = true
+				m.lensSelector.Reset()
+				m.statusMsg = fmt.Sprintf("Compare: %s ⇄ %s • tab switch pane • enter jump • esc back", left.Title, right.Title)
+				m.statusIsError = false
+				return m
+			}
+
 			// Check if review mode was requested
 			if m.lensSelector.IsReviewRequested() {
-				// Open review dashboard for the selected item
-				// Review dashboard works best with epics/beads that have a tree structure
-				rootID := selectedItem.Value
+				// Open review dashboard for the selected item: the issue tree
+				// under an epic/bead, or every labeled issue grouped by epic
+				var reviewDash *ReviewDashboardModel
+				var err error
 				if selectedItem.Type == "label" {
-					// For labels, we can't really review - show a message
-					m.statusMsg = "Review mode works best with epics or beads"
-					m.statusIsError = true
-					m.lensSelector.Reset()
-					return m
+					reviewDash, err = NewLabelReviewDashboardModel(selectedItem.Value, m.issues, "", string(model.ReviewTypePlan), m.theme, m.workDir)
+				} else {
+					reviewDash, err = NewReviewDashboardModel(selectedItem.Value, m.issues, "", string(model.ReviewTypePlan), m.theme, m.workDir)
 				}
-
-				// Create review dashboard
-				reviewDash, err := NewReviewDashboardModel(rootID, m.issues, "", string(model.ReviewTypePlan), m.theme, m.workDir)
 				if err != nil {
 					m.statusMsg = fmt.Sprintf("Error opening review: %v", err)
 					m.statusIsError = true
@@ -6212,6 +7398,10 @@ func (m Model) handleLensSelectorKeys(msg tea.KeyMsg) Model {
 					return m
 				}
 				m.reviewDashboard = reviewDash
+				if m.projectConfig != nil {
+					m.reviewDashboard.SetNoteTemplates(m.projectConfig.ReviewTemplates)
+				}
+				m.trackReviewSession()
 				m.reviewDashboard.SetSize(m.width, m.height-1)
 				m.showReviewDashboard = true
 				m.reviewDashboardOrigin = "lens_selector"
@@ -6222,29 +7412,10 @@ func (m Model) handleLensSelectorKeys(msg tea.KeyMsg) Model {
 			}
 
 			// Normal selection - open lens dashboard
-			m.showLensDashboard = true
-			m.focused = focusLensDashboard
-
-			// Initialize lens dashboard with selected label/epic/bead
-			switch selectedItem.Type {
-			case "epic":
-				m.lensDashboard = NewEpicLensModel(selectedItem.Value, selectedItem.Title, m.issues, issueMap, m.theme)
-			case "bead":
-				m.lensDashboard = NewBeadLensModel(selectedItem.Value, m.issues, issueMap, m.theme)
-			default: // "label"
-				m.lensDashboard = NewLensDashboardModel(selectedItem.Value, m.issues, issueMap, m.theme)
-			}
-
-			// Apply scope labels and scope mode from lens selector to lens dashboard for smooth UX
-			if scopeLabels := m.lensSelector.ScopeLabels(); len(scopeLabels) > 0 {
-				for _, label := range scopeLabels {
-					m.lensDashboard.AddScopeLabel(label)
-				}
-				// Also apply scope match mode (union/intersection)
-				m.lensDashboard.SetScopeMode(m.lensSelector.ScopeMatchMode())
-			}
-
-			m.lensDashboard.SetSize(m.width, m.height-1)
+			ref := lensRef{Type: selectedItem.Type, Value: selectedItem.Value, Title: selectedItem.Title}
+			m.lensStack = nil
+			m.openLensDashboard(ref, m.lensSelector.ScopeLabels(), m.lensSelector.ScopeMatchMode())
+			m.recordLensVisit(ref)
 			m.statusMsg = fmt.Sprintf("Lens: %s • j/k nav • w workstreams • d depth • c centered", selectedItem.Title)
 			m.statusIsError = false
 		}
@@ -6254,6 +7425,7 @@ func (m Model) handleLensSelectorKeys(msg tea.KeyMsg) Model {
 	// Check if cancelled
 	if m.lensSelector.IsCancelled() {
 		m.showLensSelector = false
+		m.pendingLensView = nil
 		m.isSplitView = m.width > SplitViewThreshold
 		m.focused = focusList
 		if m.isSplitView {
@@ -6279,6 +7451,55 @@ func (m Model) handleLensSelectorKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
+// openLensDashboard shows the dashboard for ref, scoped to scopeLabels
+// (matched per scopeMode) and laid out per the project config and any
+// saved view being applied
+func (m *Model) openLensDashboard(ref lensRef, scopeLabels []string, scopeMode ScopeMode) {
+	m.showLensSelector = false
+	m.showLensDashboard = true
+	m.focused = focusLensDashboard
+
+	// Initialize lens dashboard with selected label/epic/bead
+	switch ref.Type {
+	case "epic":
+		m.lensDashboard = NewEpicLensModel(ref.Value, ref.Title, m.issues, m.issueMap, m.theme)
+	case "bead":
+		m.lensDashboard = NewBeadLensModel(ref.Value, m.issues, m.issueMap, m.theme)
+	default: // "label"
+		m.lensDashboard = NewLensDashboardModel(ref.Value, m.issues, m.issueMap, m.theme)
+	}
+
+	// Apply scope labels and scope mode from lens selector to lens dashboard for smooth UX
+	if len(scopeLabels) > 0 {
+		for _, label := range scopeLabels {
+			m.lensDashboard.AddScopeLabel(label)
+		}
+		// Also apply scope match mode (union/intersection)
+		m.lensDashboard.SetScopeMode(scopeMode)
+	}
+	m.lensDashboard.SetArchaeologyMode(m.archaeologyMode)
+	m.lensDashboard.SetStaleDays(m.staleDays())
+	m.lensDashboard.SetClaims(m.claimCoverage)
+	if m.projectConfig != nil {
+		m.lensDashboard.SetWorkstreamOverrides(m.projectConfig.WorkstreamOverrides)
+		m.lensDashboard.SetWorkstreamNames(m.projectConfig.WorkstreamNames)
+	}
+	if m.projectConfig != nil && m.projectConfig.EpicRollup {
+		m.lensDashboard.SetEpicRollups(analysis.ComputeEpicRollups(m.issues))
+	}
+	if m.projectConfig != nil {
+		m.applyLensLayout(m.projectConfig.Depth, m.projectConfig.ViewType)
+	}
+	m.applyPendingLensView()
+	m.lensDashboard.SetSortSources(m.dependentsCount, m.analysis)
+	m.applyLensSort()
+
+	m.lensCurrent = ref
+	m.lensDashboard.SetBreadcrumbs(m.lensBreadcrumbs())
+	m.lensDashboard.SetDetailMode(m.lensDetailMode)
+	m.lensDashboard.SetSize(m.width, m.height-1)
+}
+
 // handleLensDashboardKeys handles keyboard input when lens dashboard is focused
 func (m Model) handleLensDashboardKeys(msg tea.KeyMsg) Model {
 	// Handle fuzzy search mode first (when searching with /)
@@ -6305,10 +7526,23 @@ func (m Model) handleLensDashboardKeys(msg tea.KeyMsg) Model {
 		}
 	}
 
+	// Handle a workstream rename (n)
+	if m.lensDashboard.IsRenamingWorkstream() {
+		rename, statusMsg := m.lensDashboard.HandleWorkstreamRenameKey(msg.String())
+		if rename != nil {
+			m.saveWorkstreamName(*rename)
+		} else if statusMsg != "" {
+			m.statusMsg = statusMsg
+			m.statusIsError = false
+		}
+		return m
+	}
+
 	switch msg.String() {
 	case "w":
 		// Toggle between flat and workstream views
 		m.lensDashboard.ToggleViewType()
+		m.applyLensSort()
 		if m.lensDashboard.IsWorkstreamView() {
 			m.statusMsg = "Switched to workstream view"
 		} else {
@@ -6336,6 +7570,7 @@ func (m Model) handleLensDashboardKeys(msg tea.KeyMsg) Model {
 			m.lensDashboard.EnterGroupedView()
 			m.statusMsg = fmt.Sprintf("Grouped view (by %s)", m.lensDashboard.GetGroupByMode())
 		}
+		m.applyLensSort()
 		m.statusIsError = false
 	case "G":
 		// Cycle group-by mode when in grouped view
@@ -6357,6 +7592,15 @@ func (m Model) handleLensDashboardKeys(msg tea.KeyMsg) Model {
 				m.statusIsError = false
 			}
 		}
+	case "A":
+		m.toggleArchaeologyMode()
+	case "L":
+		m.drillIntoLens()
+	case "ctrl+o":
+		// Back to the previously opened lens (ctrl+i is Tab in terminals, so forward is ctrl+n)
+		m.stepLensHistory(-1)
+	case "ctrl+n":
+		m.stepLensHistory(1)
 	case "I":
 		// Open insights view scoped to lens dashboard items
 		scopedIssues := m.lensDashboard.GetAllDisplayIssues()
@@ -6453,6 +7697,37 @@ func (m Model) handleLensDashboardKeys(msg tea.KeyMsg) Model {
 		}
 		m.statusMsg = fmt.Sprintf("Depth: %v", m.lensDashboard.GetDepth())
 		m.statusIsError = false
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		// Jump to a workstream by its number
+		if m.lensDashboard.IsWorkstreamView() {
+			n := int(msg.String()[0] - '1')
+			if m.lensDashboard.JumpToWorkstream(n) {
+				m.statusMsg = fmt.Sprintf("Workstream %d: %s", n+1, m.lensDashboard.CurrentWorkstreamName())
+			} else {
+				m.statusMsg = fmt.Sprintf("No workstream %d (%d streams)", n+1, m.lensDashboard.WorkstreamCount())
+			}
+			m.statusIsError = false
+		}
+	case ">", "<":
+		// Page through the current workstream's issues
+		if m.lensDashboard.IsWorkstreamView() {
+			if msg.String() == ">" {
+				m.lensDashboard.NextWorkstreamPage()
+			} else {
+				m.lensDashboard.PrevWorkstreamPage()
+			}
+			page, pages := m.lensDashboard.WorkstreamPage()
+			m.statusMsg = fmt.Sprintf("%s: page %d/%d", m.lensDashboard.CurrentWorkstreamName(), page, pages)
+			m.statusIsError = false
+		}
+	case "o":
+		// Cycle the order within status sections
+		m.lensDashboard.CycleSectionSort()
+		m.saveLensSort()
+	case "O":
+		// Reverse the order within status sections
+		m.lensDashboard.ToggleSectionSortDirection()
+		m.saveLensSort()
 	case "T":
 		// Toggle tree view within workstreams or grouped view
 		if m.lensDashboard.IsWorkstreamView() {
@@ -6477,6 +7752,19 @@ func (m Model) handleLensDashboardKeys(msg tea.KeyMsg) Model {
 	case "d":
 		// Go to bottom
 		m.lensDashboard.GoToBottom()
+	case "v":
+		// Show or hide the detail panel
+		shown := m.lensDashboard.ToggleDetailPanel()
+		m.lensDetailMode = m.lensDashboard.DetailMode()
+		switch {
+		case shown:
+			m.statusMsg = "Detail panel shown (tab to focus it, v to hide)"
+		case m.lensDetailMode == lensDetailShown:
+			m.statusMsg = fmt.Sprintf("The detail panel needs %d columns", LensSplitViewMinWidth)
+		default:
+			m.statusMsg = "Detail panel hidden (v to show)"
+		}
+		m.statusIsError = false
 	case "tab":
 		// Toggle focus between tree and detail panels in split view
 		if m.lensDashboard.IsSplitView() {
@@ -6521,6 +7809,11 @@ func (m Model) handleLensDashboardKeys(msg tea.KeyMsg) Model {
 				m.statusIsError = false
 			}
 		}
+	case "y":
+		// Copy the selected issue's context block, with its workstream
+		if id := m.lensDashboard.SelectedIssueID(); id != "" {
+			m = m.copyIssueContext(id)
+		}
 	case "P":
 		// Copy work prompt to clipboard for agents
 		id := m.lensDashboard.SelectedIssueID()
@@ -6560,7 +7853,7 @@ func (m Model) handleLensDashboardKeys(msg tea.KeyMsg) Model {
 	case "/":
 		// Open fuzzy search to quickly find and jump to an issue
 		m.lensDashboard.OpenFuzzySearch()
-		m.statusMsg = "Search: type to filter • ↑/↓ select • Enter jump • Esc cancel"
+		m.statusMsg = "Search: type to filter • ↑/↓ select • Enter jump • Tab keep as filter • Esc cancel"
 		m.statusIsError = false
 	case "r":
 		// Open review dashboard for selected bead
@@ -6572,12 +7865,7 @@ func (m Model) handleLensDashboardKeys(msg tea.KeyMsg) Model {
 				m.statusIsError = true
 				return m
 			}
-			m.reviewDashboard = reviewDash
-			m.reviewDashboard.SetSize(m.width, m.height-1)
-			m.showLensDashboard = false
-			m.showReviewDashboard = true
-			m.reviewDashboardOrigin = "lens_dashboard"
-			m.focused = focusReviewDashboard
+			m.showLensReview(reviewDash)
 			// Get issue title for status message
 			issueTitle := id
 			if issue := m.lensDashboard.issueMap[id]; issue != nil {
@@ -6586,74 +7874,231 @@ func (m Model) handleLensDashboardKeys(msg tea.KeyMsg) Model {
 			m.statusMsg = fmt.Sprintf("Review: %s • j/k nav • a approve • x reject • d defer • ? help", issueTitle)
 			m.statusIsError = false
 		}
+	case "c":
+		// Mark a workstream, then open it beside a second one
+		pair, ok, status := m.lensDashboard.MarkWorkstreamForCompare()
+		if ok {
+			m.workstreamCompare = NewWorkstreamCompareModel(pair[0], pair[1], m.theme)
+			m.workstreamCompare.SetSize(m.width, m.height-1)
+			m.showWorkstreamCompare = true
+			status = fmt.Sprintf("Compare: %s ⇄ %s • tab switch column • enter open • esc back", pair[0].Name, pair[1].Name)
+		}
+		m.statusMsg = status
+		m.statusIsError = false
+	case "X":
+		// Cut the issue under the cursor, to move it to another workstream
+		m.statusMsg = m.lensDashboard.CutWorkstreamIssue()
+		m.statusIsError = false
+	case "p":
+		// Move the cut issue into the workstream under the cursor
+		change, status := m.lensDashboard.PasteWorkstreamIssue()
+		m.statusMsg = status
+		m.statusIsError = false
+		if change != nil {
+			m.saveWorkstreamOverride(*change, status)
+		}
+	case "f":
+		// Step the status quick filter
+		if status := m.lensDashboard.CycleStatusFilter(); status != "" {
+			m.statusMsg = fmt.Sprintf("Status filter: %s", status)
+		} else {
+			m.statusMsg = "Status filter cleared"
+		}
+		m.statusIsError = false
+	case "F":
+		// Step the type filter
+		if issueType := m.lensDashboard.CycleTypeFilter(); issueType != "" {
+			m.statusMsg = fmt.Sprintf("Type filter: %s", issueType)
+		} else {
+			m.statusMsg = "Type filter cleared"
+		}
+		m.statusIsError = false
+	case "W":
+		// Show only the workstreams that have stalled, or all again
+		m.statusMsg = m.lensDashboard.ToggleStalledOnly()
+		m.statusIsError = false
+	case "n":
+		// Rename the workstream under the cursor
+		m.statusMsg = m.lensDashboard.StartWorkstreamRename()
+		m.statusIsError = false
+	case "R":
+		// Review exactly the issues the lens shows, at its depth and scope
+		visible := m.lensDashboard.GetAllDisplayIssues()
+		ids := make([]string, len(visible))
+		for i, issue := range visible {
+			ids[i] = issue.ID
+		}
+		name := m.lensCurrent.Title
+		if name == "" {
+			name = m.lensDashboard.labelName
+		}
+		if m.lensDashboard.HasScope() {
+			name += " + " + strings.Join(m.lensDashboard.GetScopeLabels(), ", ")
+		}
+		reviewDash, err := NewScopedReviewDashboardModel(name, ids, m.issues, "", string(model.ReviewTypePlan), m.theme, m.workDir)
+		if err != nil {
+			m.statusMsg = fmt.Sprintf("Error opening review: %v", err)
+			m.statusIsError = true
+			return m
+		}
+		m.showLensReview(reviewDash)
+		m.statusMsg = fmt.Sprintf("Review: %d issues from lens • j/k nav • a approve • x reject • d defer • ? help", len(reviewDash.tree.Scope))
+		m.statusIsError = false
 	case "?", "f1":
-		// Toggle help overlay
-		m.showHelp = !m.showHelp
-		if m.showHelp {
-			m.focused = focusHelp
-			m.helpScroll = 0
+		// Every lens key, from the keymap
+		m.openHelp()
+	case "x":
+		// Step through the filter pills in the header
+		if pill, ok := m.lensDashboard.CyclePillFocus(); ok {
+			m.statusMsg = fmt.Sprintf("Filter %s • enter remove • x next", pill.Text)
 		} else {
-			m.focused = focusLensDashboard
+			m.statusMsg = ""
 		}
+		m.statusIsError = false
 	case "esc", "q":
-		// Go back to lens selector instead of closing entirely
+		if m.lensDashboard.HasPillFocus() {
+			m.lensDashboard.ClearPillFocus()
+			m.statusMsg = ""
+			break
+		}
+		if m.lensDashboard.CutIssueID() != "" {
+			m.lensDashboard.CancelCut()
+			m.statusMsg = "Cut cancelled"
+			m.statusIsError = false
+			break
+		}
+		// Step back out of a drilled-into lens, else go back to the lens
+		// selector instead of closing entirely
+		if len(m.lensStack) > 0 {
+			m.popLens()
+			break
+		}
 		m.showLensDashboard = false
 		m.showLensSelector = true
 		m.focused = focusLensSelector
 		m.lensSelector.Reset()
 		m.lensSelector.SetSize(m.width, m.height-1)
 	case "enter":
-		// In workstream view: toggle expand/collapse of current workstream
-		// In grouped view: toggle expand/collapse of current group
-		// In flat view: do nothing (enter is a no-op)
-		if m.lensDashboard.IsWorkstreamView() {
-			m.lensDashboard.ToggleWorkstreamExpand()
-			if m.lensDashboard.IsWorkstreamExpanded(m.lensDashboard.GetWsCursor()) {
-				m.statusMsg = fmt.Sprintf("Expanded: %s", m.lensDashboard.CurrentWorkstreamName())
-			} else {
-				m.statusMsg = fmt.Sprintf("Collapsed: %s", m.lensDashboard.CurrentWorkstreamName())
+		// A highlighted filter pill is removed
+		if pill, ok := m.lensDashboard.RemoveFocusedPill(); ok {
+			if pill.Kind == pillArchaeology {
+				m.toggleArchaeologyMode() // Keeps the graph in step
 			}
+			m.statusMsg = fmt.Sprintf("Removed filter %s", pill.Text)
 			m.statusIsError = false
-		} else if m.lensDashboard.IsGroupedView() {
-			m.lensDashboard.ToggleGroupedExpand()
-			if m.lensDashboard.IsGroupExpanded(m.lensDashboard.GetGroupedCursor()) {
-				m.statusMsg = fmt.Sprintf("Expanded: %s", m.lensDashboard.CurrentGroupName())
-			} else {
-				m.statusMsg = fmt.Sprintf("Collapsed: %s", m.lensDashboard.CurrentGroupName())
-			}
+			break
+		}
+		// Headers (workstreams, groups, sub-groups) expand and collapse;
+		// issues open their detail, in every view
+		if m.lensDashboard.CursorRow() == LensRowIssue {
+			m = m.openLensDetail()
+			break
+		}
+		m.lensDashboard.ToggleRowExpand()
+		if m.lensDashboard.IsCursorRowExpanded() {
+			m.statusMsg = fmt.Sprintf("Expanded: %s", m.lensDashboard.CursorRowName())
+		} else {
+			m.statusMsg = fmt.Sprintf("Collapsed: %s", m.lensDashboard.CursorRowName())
+		}
+		m.statusIsError = false
+	case "l", "right":
+		// Expand a header or step into it; on an issue, open its detail
+		if m.lensDashboard.IsDetailFocused() {
+			break
+		}
+		if m.lensDashboard.CursorRow() == LensRowIssue {
+			m = m.openLensDetail()
+		} else {
+			m.lensDashboard.ExpandRow()
+		}
+	case "h", "left":
+		// Leave the detail panel, step out to the parent header, or collapse
+		if m.lensDashboard.IsDetailFocused() {
+			m.lensDashboard.SetDetailFocus(false)
+			m.statusMsg = "Tree panel focused"
 			m.statusIsError = false
+			break
 		}
-		// In flat view, do nothing
+		m.lensDashboard.CollapseRow()
+	}
+	return m
+}
+
+// openLensDetail opens the selected lens issue. In split view the detail
+// panel takes focus; narrower terminals get a summary in the status bar.
+func (m Model) openLensDetail() Model {
+	id := m.lensDashboard.SelectedIssueID()
+	issue := m.lensDashboard.issueMap[id]
+	if issue == nil {
+		return m
+	}
+	if m.lensDashboard.IsSplitView() {
+		m.lensDashboard.SetDetailFocus(true)
+		m.statusMsg = fmt.Sprintf("Detail: %s (j/k scroll, h back)", id)
+	} else {
+		m.statusMsg = fmt.Sprintf("%s [%s] %s • v for the detail panel", id, issue.Status, issue.Title)
 	}
+	m.statusIsError = false
 	return m
 }
 
+// showLensReview opens a review dashboard over the lens dashboard; leaving the
+// review returns to the lens
+func (m *Model) showLensReview(reviewDash *ReviewDashboardModel) {
+	m.reviewDashboard = reviewDash
+	if m.projectConfig != nil {
+		m.reviewDashboard.SetNoteTemplates(m.projectConfig.ReviewTemplates)
+	}
+	m.trackReviewSession()
+	m.reviewDashboard.SetSize(m.width, m.height-1)
+	m.showLensDashboard = false
+	m.showReviewDashboard = true
+	m.reviewDashboardOrigin = "lens_dashboard"
+	m.focused = focusReviewDashboard
+}
+
 // handleReviewDashboardKeys handles keyboard input when review dashboard is focused
 func (m Model) handleReviewDashboardKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 	if m.reviewDashboard == nil {
 		return m, nil
 	}
 
+	// y copies the selected issue's agent context, as in every other view
+	rd := m.reviewDashboard
+	if msg.String() == "y" && !rd.IsCapturingInput() && !rd.HasActiveModal() && !rd.IsShowingSummary() {
+		if issue := rd.SelectedIssue(); issue != nil {
+			m = m.copyIssueContext(issue.ID)
+			rd.SetStatus(m.statusMsg)
+		}
+		return m, nil
+	}
+
 	// Pass all keys to the review dashboard (including q/esc for proper quit flow)
 	var cmd tea.Cmd
 	m.reviewDashboard, cmd = m.reviewDashboard.Update(msg)
 
+	// Save mid-session (w) and keep reviewing
+	if m.reviewDashboard.TakeSaveRequest() {
+		msg, _ := m.saveReviewDashboard()
+		m.reviewDashboard.SetStatus(msg)
+	}
+
 	// Check if the review dashboard wants to quit
 	if m.reviewDashboard.IsQuitting() {
 		// Save reviews if requested
 		if m.reviewDashboard.ShouldSave() {
-			result := m.reviewDashboard.SaveReviews()
-			if result.Failed > 0 {
-				m.statusMsg = fmt.Sprintf("Saved %d reviews, %d failed", result.Saved, result.Failed)
-				m.statusIsError = true
-			} else if result.Saved > 0 {
-				m.statusMsg = fmt.Sprintf("Saved %d reviews to comments", result.Saved)
-				m.statusIsError = false
+			if msg, isErr := m.saveReviewDashboard(); msg != "" {
+				m.statusMsg = msg
+				m.statusIsError = isErr
 			}
 		} else if m.reviewDashboard.PendingSaveCount() > 0 {
 			m.statusMsg = "Reviews discarded"
+			if m.reviewDashboard.sessionPath != "" {
+				m.statusMsg += " (bv review --resume restores them)"
+			}
 			m.statusIsError = false
 		}
+		m.reviewDashboard.FinishSession()
 
 		// Close the review dashboard
 		m.showReviewDashboard = false
@@ -6675,3 +8120,88 @@ func (m Model) handleReviewDashboardKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 	return m, cmd
 }
+
+// trackReviewSession keeps the open review dashboard's session next to the
+// beads file so `bv review --resume` can restore it, holds its writes back in
+// a dry run and refuses its saves when bd is missing
+func (m *Model) trackReviewSession() {
+	m.reviewDashboard.SetDryRun(m.dryRun)
+	m.reviewDashboard.SetReadOnly(m.readOnly && m.dryRun == nil)
+	if m.beadsPath != "" && !m.workspaceMode {
+		m.reviewDashboard.SetSessionPath(ReviewSessionPath(filepath.Dir(m.beadsPath)))
+	}
+}
+
+// saveReviewDashboard saves the review dashboard's pending reviews and
+// describes the outcome ("" when there was nothing to save)
+func (m Model) saveReviewDashboard() (msg string, isErr bool) {
+	return saveReviews(m.reviewDashboard)
+}
+
+// saveReviews saves dashboard's pending reviews and describes the outcome
+// ("" when there was nothing to save)
+func saveReviews(dashboard *ReviewDashboardModel) (msg string, isErr bool) {
+	if dashboard.readOnly {
+		msg = fmt.Sprintf("Read-only (bd not installed): %d reviews not saved", dashboard.PendingSaveCount())
+		if dashboard.sessionPath != "" {
+			msg += " (bv review --resume restores them)"
+		}
+		return msg, true
+	}
+	result := dashboard.SaveReviews()
+	if result.Failed > 0 {
+		return fmt.Sprintf("Saved %d reviews, %d failed", result.Saved, result.Failed), true
+	}
+	if result.Saved > 0 && dashboard.dryRun != nil {
+		return fmt.Sprintf("Dry run: %d reviews kept as pending changes, not saved", result.Saved), false
+	}
+	if result.Saved > 0 {
+		return fmt.Sprintf("Saved %d reviews to comments", result.Saved), false
+	}
+	return "", false
+}
+
+// newCachedAnalyzer builds an analyzer backed by the in-memory cache and, when
+// viewing a real beads file, the persistent disk cache so unchanged data skips
+// Phase 2 on the next launch. A dry run only reads the disk cache.
+func newCachedAnalyzer(issues []model.Issue, beadsPath string, dryRun bool) *analysis.CachedAnalyzer {
+	ca := analysis.NewCachedAnalyzer(issues, nil)
+	if beadsPath != "" {
+		disk := analysis.NewDiskCache(analysis.DefaultDiskCacheDir())
+		if dryRun {
+			disk = disk.ReadOnly()
+		}
+		ca.SetDiskCache(disk)
+	}
+	return ca
+}
+
+// loadEpicScope loads epic scope snapshots from the beads directory and records
+// the current membership. Returns nil in workspace mode or if the file is unreadable.
+func loadEpicScope(beadsPath string, issues []model.Issue, dry *loader.DryRun) *analysis.EpicScopeData {
+	if beadsPath == "" {
+		return nil
+	}
+	scope, err := analysis.LoadEpicScope(filepath.Dir(beadsPath))
+	if err != nil {
+		return nil
+	}
+	recordEpicScope(scope, beadsPath, issues, dry)
+	return scope
+}
+
+// recordEpicScope snapshots epic membership and saves it when anything
+// changed, or records the save in dry when that is not nil
+func recordEpicScope(scope *analysis.EpicScopeData, beadsPath string, issues []model.Issue, dry *loader.DryRun) {
+	if scope == nil || beadsPath == "" {
+		return
+	}
+	if !scope.Observe(issues) {
+		return
+	}
+	if dry != nil {
+		dry.RecordFile(filepath.Join(filepath.Dir(beadsPath), analysis.EpicScopeFile), "epic membership snapshot")
+		return
+	}
+	_ = scope.Save(filepath.Dir(beadsPath)) // Best effort: a read-only repo just loses history
+}
@@ -0,0 +1,89 @@
+package ui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// handleViewMouse routes mouse events to the views that hit-test their own
+// rows. It reports whether the event was consumed, and whether the click
+// landed on an already selected row (or a header) and should act like enter.
+func (m *Model) handleViewMouse(msg tea.MouseMsg) (handled, activate bool) {
+	// Overlays draw over the view and take no clicks; swallow everything so
+	// the view underneath doesn't move
+	if m.overlayOpen() {
+		return true, false
+	}
+
+	switch m.focused {
+	case focusLensSelector:
+		m.lensSelector.SetSize(m.width, m.height-1)
+		return true, m.lensSelector.HandleMouse(msg)
+	case focusLensDashboard:
+		m.lensDashboard.SetSize(m.width, m.height-1)
+		return true, m.lensDashboard.HandleMouse(msg)
+	case focusReviewDashboard:
+		if m.reviewDashboard != nil {
+			m.reviewDashboard.SetSize(m.width, m.height-1)
+			m.reviewDashboard, _ = m.reviewDashboard.Update(msg)
+		}
+		return true, false
+	case focusLabelDashboard:
+		return true, m.labelDashboard.HandleMouse(msg)
+	case focusList, focusDetail:
+		if msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionPress {
+			return false, false
+		}
+		return true, m.handleListClick(msg)
+	}
+	return false, false
+}
+
+// overlayOpen reports whether a modal, picker or panel is drawn over the
+// focused view (see View)
+func (m *Model) overlayOpen() bool {
+	return m.showQuitConfirm || m.showCommandPalette || m.showJump || m.showStatusMenu ||
+		m.showActionResult || m.showAgentPrompt || m.showCassModal || m.showBlockerChain ||
+		m.showLabelHealthDetail || m.showLabelGraphAnalysis || m.showLabelDrilldown ||
+		m.showAlertsPanel || m.showPendingChanges || m.showThemeGallery || m.showHealth ||
+		m.showDuplicates || m.showTimeTravelPrompt || m.showRecipePicker || m.showRepoPicker ||
+		m.showLabelPicker || m.showLabelManager || m.showHelp || m.showLensCompare ||
+		m.showWorkstreamCompare || m.showTutorial
+}
+
+// handleListClick selects the clicked issue row in the list view and split
+// view, and focuses the detail panel when it is clicked.
+func (m *Model) handleListClick(msg tea.MouseMsg) bool {
+	if m.isGraphView || m.isBoardView || m.isActionableView || m.isHistoryView ||
+		m.isSprintView || m.showGraphCanvas {
+		return false
+	}
+
+	// Column header and the list's filter line sit above the rows; split
+	// view adds the panel border
+	top := 2
+	if m.isSplitView {
+		top = 3
+		if msg.X >= m.list.Width()+4 {
+			m.focused = focusDetail
+			return false
+		}
+		m.focused = focusList
+	} else if m.showDetails {
+		return false
+	}
+
+	row := msg.Y - top
+	if row < 0 || row >= m.list.Paginator.PerPage {
+		return false
+	}
+	idx := m.list.Paginator.Page*m.list.Paginator.PerPage + row
+	if idx >= len(m.list.VisibleItems()) {
+		return false
+	}
+	if idx == m.list.Index() {
+		return true
+	}
+	m.list.Select(idx)
+	if m.isSplitView {
+		m.updateViewportContent()
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// viewRow returns the first row of view containing needle, so clicks are
+// aimed at what is actually rendered.
+func viewRow(t *testing.T, view, needle string) int {
+	t.Helper()
+	for i, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, needle) {
+			return i
+		}
+	}
+	t.Fatalf("%q not rendered:\n%s", needle, view)
+	return -1
+}
+
+func click(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+}
+
+func wheel(button tea.MouseButton) tea.MouseMsg {
+	return tea.MouseMsg{Button: button, Action: tea.MouseActionPress}
+}
+
+func newMouseLensDashboard(t *testing.T) LensDashboardModel {
+	t.Helper()
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Labels: []string{"test-label", "ws1"}},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Labels: []string{"test-label", "ws1"},
+			Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepBlocks}}},
+		{ID: "C", Title: "Gamma", Status: model.StatusOpen, Labels: []string{"test-label", "ws2"}},
+	}
+	issueMap := make(map[string]*model.Issue)
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	d := NewLensDashboardModel("test-label", issues, issueMap, DefaultTheme(lipgloss.DefaultRenderer()))
+	d.SetSize(80, 30)
+	return d
+}
+
+func TestMouseLensDashboardFlat(t *testing.T) {
+	for _, width := range []int{80, 140} {
+		d := newMouseLensDashboard(t)
+		d.SetSize(width, 30)
+
+		if d.HandleMouse(click(4, viewRow(t, d.View(), "C Gamma"))) {
+			t.Errorf("width %d: clicking an issue should not activate", width)
+		}
+		if got := d.SelectedIssueID(); got != "C" {
+			t.Fatalf("width %d: click selected %q, want C", width, got)
+		}
+
+		d.HandleMouse(wheel(tea.MouseButtonWheelUp))
+		if got := d.SelectedIssueID(); got != "B" {
+			t.Errorf("width %d: wheel up selected %q, want B", width, got)
+		}
+	}
+}
+
+func TestMouseLensDashboardSplitDetailFocus(t *testing.T) {
+	d := newMouseLensDashboard(t)
+	d.SetSize(140, 30)
+
+	d.HandleMouse(click(120, 10))
+	if !d.IsDetailFocused() {
+		t.Error("clicking the detail panel should focus it")
+	}
+	d.HandleMouse(click(4, viewRow(t, d.View(), "A Alpha")))
+	if d.IsDetailFocused() || d.SelectedIssueID() != "A" {
+		t.Errorf("clicking the tree should focus it and select A, got %q", d.SelectedIssueID())
+	}
+}
+
+func TestMouseLensDashboardWorkstreamHeader(t *testing.T) {
+	d := newMouseLensDashboard(t)
+	d.ToggleViewType()
+
+	if d.HandleMouse(click(6, viewRow(t, d.View(), "C Gamma"))) {
+		t.Error("clicking an issue should not activate")
+	}
+	if got := d.SelectedIssueID(); got != "C" {
+		t.Fatalf("click selected %q, want C", got)
+	}
+
+	if !d.HandleMouse(click(4, viewRow(t, d.View(), "Ws1"))) {
+		t.Error("clicking a workstream header should activate it")
+	}
+	if d.wsCursor != 0 || d.wsIssueCursor != -1 {
+		t.Errorf("cursor = ws %d issue %d, want header of ws 0", d.wsCursor, d.wsIssueCursor)
+	}
+}
+
+func TestMouseLensSelectorClick(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Labels: []string{"frontend"}},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Labels: []string{"backend"}},
+		{ID: "C", Title: "Gamma", Status: model.StatusOpen, Labels: []string{"backend"}},
+	}
+	for _, width := range []int{50, 80, 140} {
+		s := NewLensSelectorModel(issues, DefaultTheme(lipgloss.DefaultRenderer()), nil)
+		s.SetSize(width, 40)
+
+		row := viewRow(t, s.View(), "frontend")
+		if s.HandleMouse(click(width/2, row)) {
+			t.Errorf("width %d: first click should only select", width)
+		}
+		if got := s.filteredItems[s.selectedIndex].Value; got != "frontend" {
+			t.Fatalf("width %d: click selected %q, want frontend", width, got)
+		}
+		if !s.HandleMouse(click(width/2, row)) {
+			t.Errorf("width %d: second click should activate", width)
+		}
+	}
+}
+
+func TestMouseReviewDashboard(t *testing.T) {
+	m := newTestReviewDashboard(t)
+
+	m, _ = m.Update(click(4, viewRow(t, m.View(), "T2")))
+	if got := m.SelectedIssue(); got == nil || got.ID != "T2" {
+		t.Fatalf("click selected %+v, want T2", got)
+	}
+
+	m, _ = m.Update(click(100, 10))
+	if !m.detailFocus {
+		t.Error("clicking the detail panel should focus it")
+	}
+}
+
+func TestMouseReviewDashboardIgnoredUnderModal(t *testing.T) {
+	m := newTestReviewDashboard(t)
+	before := m.SelectedIssue()
+	row := viewRow(t, m.View(), "T2")
+	m.showHelp = true
+
+	m, _ = m.Update(click(4, row))
+	if got := m.SelectedIssue(); got != before {
+		t.Errorf("click under the help modal selected %+v", got)
+	}
+}
+
+func TestMouseLabelDashboardFiltersList(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Labels: []string{"api"}},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Labels: []string{"ui"}},
+	}
+	m := NewModel(issues, nil, "")
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = newM.(Model)
+	m.focused = focusLabelDashboard
+	m.labelDashboard.SetData([]analysis.LabelHealth{{Label: "api"}, {Label: "ui"}})
+
+	row := viewRow(t, m.labelDashboard.View(), "ui")
+	newM, _ = m.Update(click(2, row))
+	m = newM.(Model)
+	if m.labelDashboard.cursor != 1 || m.focused != focusLabelDashboard {
+		t.Fatalf("first click should select the row, cursor=%d", m.labelDashboard.cursor)
+	}
+
+	newM, _ = m.Update(click(2, row))
+	m = newM.(Model)
+	if m.focused != focusList || m.currentFilter != "label:ui" {
+		t.Errorf("second click should filter by ui, got focus %v filter %q", m.focused, m.currentFilter)
+	}
+}
+
+func TestMouseListClick(t *testing.T) {
+	m := peekTestModel(t)
+	m.isSplitView = false
+	selectIssue(t, &m, "A")
+
+	row := viewRow(t, m.View(), "Lexer tokens")
+	newM, _ := m.Update(click(10, row))
+	m = newM.(Model)
+	if item, ok := m.list.SelectedItem().(IssueItem); !ok || item.Issue.ID != "B" {
+		t.Fatalf("click should select B, got %+v", m.list.SelectedItem())
+	}
+	if m.showDetails {
+		t.Fatal("first click should only select")
+	}
+
+	newM, _ = m.Update(click(10, row))
+	m = newM.(Model)
+	if !m.showDetails {
+		t.Error("clicking the selected row should open its details")
+	}
+}
+
+func TestMouseSwallowedByOverlays(t *testing.T) {
+	for name, open := range map[string]func(*Model){
+		"status menu":     func(m *Model) { m.showStatusMenu = true },
+		"label picker":    func(m *Model) { m.showLabelPicker = true },
+		"recipe picker":   func(m *Model) { m.showRecipePicker = true },
+		"command palette": func(m *Model) { m.showCommandPalette = true },
+	} {
+		m := peekTestModel(t)
+		m.isSplitView = false
+		selectIssue(t, &m, "A")
+		row := viewRow(t, m.View(), "Lexer tokens")
+		open(&m)
+
+		for _, msg := range []tea.MouseMsg{click(10, row), wheel(tea.MouseButtonWheelDown)} {
+			newM, _ := m.Update(msg)
+			m = newM.(Model)
+		}
+		if item, ok := m.list.SelectedItem().(IssueItem); !ok || item.Issue.ID != "A" {
+			t.Errorf("%s: mouse moved the list underneath to %+v", name, m.list.SelectedItem())
+		}
+	}
+}
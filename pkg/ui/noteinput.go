@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/textarea"
@@ -18,12 +19,25 @@ type NoteInputModel struct {
 	height   int
 	theme    Theme
 
+	// Canned notes inserted with alt+1..alt+9
+	templates []string
+
 	// Result
 	submitted bool
 	cancelled bool
 	notes     string
 }
 
+// defaultNoteTemplates are the canned review notes offered when the project
+// config sets no review_templates
+var defaultNoteTemplates = []string{
+	"Missing acceptance criteria",
+	"Split into smaller beads",
+	"Needs a design note before work starts",
+	"Dependencies look wrong or incomplete",
+	"Priority seems off",
+}
+
 // NewNoteInputModel creates a new note input modal
 func NewNoteInputModel(title, action, issueID string, theme Theme) NoteInputModel {
 	ta := textarea.New()
@@ -57,6 +71,9 @@ func (m NoteInputModel) Update(msg tea.Msg) (NoteInputModel, tea.Cmd) {
 		case "esc":
 			m.cancelled = true
 			return m, nil
+		case "alt+1", "alt+2", "alt+3", "alt+4", "alt+5", "alt+6", "alt+7", "alt+8", "alt+9":
+			m.insertTemplate(int(msg.String()[len("alt+")] - '1'))
+			return m, nil
 		case "ctrl+enter", "ctrl+s", "ctrl+j":
 			// ctrl+j is alternate for terminals that don't support ctrl+enter
 			m.submitted = true
@@ -105,6 +122,15 @@ func (m NoteInputModel) View() string {
 	b.WriteString(m.textarea.View())
 	b.WriteString("\n\n")
 
+	// Templates
+	if len(m.templates) > 0 {
+		keyStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Primary)
+		for i, tpl := range m.templates {
+			b.WriteString(keyStyle.Render(fmt.Sprintf("alt+%d", i+1)) + " " + promptStyle.Render(tpl) + "\n")
+		}
+		b.WriteString("\n")
+	}
+
 	// Hints
 	hintStyle := m.theme.Renderer.NewStyle().Faint(true)
 	b.WriteString(hintStyle.Render("[Ctrl+Enter/Ctrl+J] Submit  [Esc] Cancel"))
@@ -119,6 +145,22 @@ func (m NoteInputModel) View() string {
 	return boxStyle.Render(b.String())
 }
 
+// SetTemplates sets the canned notes offered; only the first nine get a key
+func (m *NoteInputModel) SetTemplates(templates []string) {
+	m.templates = templates[:min(len(templates), 9)]
+}
+
+// insertTemplate adds template i to the note, on its own line
+func (m *NoteInputModel) insertTemplate(i int) {
+	if i >= len(m.templates) {
+		return
+	}
+	if value := m.textarea.Value(); value != "" && !strings.HasSuffix(value, "\n") {
+		m.textarea.InsertString("\n")
+	}
+	m.textarea.InsertString(m.templates[i])
+}
+
 // SetSize sets the modal dimensions
 func (m *NoteInputModel) SetSize(width, height int) {
 	m.width = width
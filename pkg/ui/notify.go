@@ -0,0 +1,188 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Background jobs tracked for completion notices
+const (
+	bgMetrics = "metrics" // Phase 2 analysis, after startup or a reload
+	bgHistory = "history"
+	bgRelabel = "relabel"
+)
+
+const (
+	defaultNotifyAfter = 5 * time.Second        // A shorter job finishes before you could look away
+	toastDuration      = 4 * time.Second        // How long a completion toast stays up
+	flashDuration      = 600 * time.Millisecond // How long a flashing toast stays inverted
+)
+
+// bellOut is where the terminal bell is rung. bubbletea owns stdout, so the
+// bell goes to stderr, which is the same terminal.
+var bellOut io.Writer = os.Stderr
+
+// backgroundOp is a running job and where the user was when it started
+type backgroundOp struct {
+	label   string // What finishing means, e.g. "Graph metrics ready"
+	started time.Time
+	where   string // uiLocation at the start
+}
+
+// toast is a short-lived completion notice drawn over the top-right corner
+type toast struct {
+	id       int
+	text     string
+	isError  bool
+	flashing bool // Drawn inverted until flashDuration passes
+}
+
+// toastTickMsg ends a toast's flash, or the toast itself when expire is set.
+// Ticks for a toast that has since been replaced are ignored.
+type toastTickMsg struct {
+	id     int
+	expire bool
+}
+
+// notifyMode returns how completions are announced: flash, bell, both or off
+func (m Model) notifyMode() string {
+	if m.projectConfig == nil || m.projectConfig.Notify == "" {
+		return "flash"
+	}
+	return strings.ToLower(m.projectConfig.Notify)
+}
+
+// notifyAfter returns how long a job must run before its completion is announced
+func (m Model) notifyAfter() time.Duration {
+	if m.projectConfig == nil || m.projectConfig.NotifyAfterSeconds <= 0 {
+		return defaultNotifyAfter
+	}
+	return time.Duration(m.projectConfig.NotifyAfterSeconds) * time.Second
+}
+
+// uiLocation names the screen the user is on, to tell whether they moved
+// away while a job ran
+func (m Model) uiLocation() string {
+	return fmt.Sprintf("%s/%d/%t", m.keyContext(), m.focused, m.showDetails)
+}
+
+// startBackgroundOp notes that the job called key started now. Restarting a
+// job that is still running (a reload before the metrics were ready) keeps
+// the original start: the user has been waiting since then.
+func (m *Model) startBackgroundOp(key, label string) {
+	if m.backgroundOps == nil {
+		m.backgroundOps = make(map[string]backgroundOp)
+	}
+	op, running := m.backgroundOps[key]
+	if !running {
+		op = backgroundOp{started: time.Now(), where: m.uiLocation()}
+	}
+	op.label = label
+	m.backgroundOps[key] = op
+}
+
+// finishBackgroundOp announces the end of the job called key when it ran
+// long enough and the user has moved to another screen since it started.
+// outcome overrides the label set at the start, e.g. with an error.
+func (m *Model) finishBackgroundOp(key, outcome string, isErr bool) tea.Cmd {
+	op, ok := m.backgroundOps[key]
+	if !ok {
+		return nil
+	}
+	delete(m.backgroundOps, key)
+	elapsed := time.Since(op.started)
+	if elapsed < m.notifyAfter() || op.where == m.uiLocation() {
+		return nil
+	}
+	if outcome == "" {
+		outcome = op.label
+	}
+	return m.showToast(fmt.Sprintf("%s (%s)", outcome, elapsed.Round(time.Second)), isErr)
+}
+
+// showToast puts up a toast, ringing the bell, flashing it and sending it
+// to the desktop as the notify settings ask
+func (m *Model) showToast(text string, isErr bool) tea.Cmd {
+	mode := m.notifyMode()
+	if mode == "off" {
+		return nil
+	}
+	if mode == "bell" || mode == "both" {
+		fmt.Fprint(bellOut, "\a")
+	}
+	if m.projectConfig != nil && m.projectConfig.NotifyDesktop {
+		fmt.Fprint(bellOut, desktopNotification(text))
+	}
+	m.toastSeq++
+	id := m.toastSeq
+	flashing := mode == "flash" || mode == "both"
+	m.toast = &toast{id: id, text: text, isError: isErr, flashing: flashing}
+
+	cmds := []tea.Cmd{tea.Tick(toastDuration, func(time.Time) tea.Msg {
+		return toastTickMsg{id: id, expire: true}
+	})}
+	if flashing {
+		cmds = append(cmds, tea.Tick(flashDuration, func(time.Time) tea.Msg {
+			return toastTickMsg{id: id}
+		}))
+	}
+	return tea.Batch(cmds...)
+}
+
+// desktopNotification is the OSC 9 sequence that asks the terminal to show
+// text as a desktop notification. Control characters would end the sequence
+// early, so they are dropped.
+func desktopNotification(text string) string {
+	text = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, text)
+	return "\x1b]9;bv: " + text + "\a"
+}
+
+// handleToastTick ends the flash or the toast msg belongs to
+func (m Model) handleToastTick(msg toastTickMsg) Model {
+	if m.toast == nil || m.toast.id != msg.id {
+		return m
+	}
+	if msg.expire {
+		m.toast = nil
+		return m
+	}
+	settled := *m.toast
+	settled.flashing = false
+	m.toast = &settled
+	return m
+}
+
+// overlayToast draws the toast, if any, over the top-right corner of body
+func (m Model) overlayToast(body string) string {
+	if m.toast == nil || m.width < 20 {
+		return body
+	}
+	t := m.theme
+	color := t.Open
+	if m.toast.isError {
+		color = t.Blocked
+	}
+	style := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(color).
+		Padding(0, 1).
+		MaxWidth(m.width - 2)
+	text := "🔔 " + m.toast.text
+	if m.toast.flashing {
+		text = t.Renderer.NewStyle().Bold(true).Reverse(true).Foreground(color).Render(text)
+	}
+	box := style.Render(text)
+	col := max(m.width-lipgloss.Width(box)-1, 0)
+	return overlayAt(body, box, 1, col)
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestBackgroundCompletionNotice(t *testing.T) {
+	var bell bytes.Buffer
+	oldBell := bellOut
+	bellOut = &bell
+	defer func() { bellOut = oldBell }()
+
+	issues := []model.Issue{{ID: "bv-1", Title: "Task", Status: model.StatusOpen, Labels: []string{"api"}}}
+	m := NewModel(issues, nil, "")
+	m.width, m.height = 120, 40
+	m.projectConfig = &config.Config{Notify: "both"}
+
+	relabel := func() Model {
+		t.Helper()
+		newM, _ := m.Update(RelabelDoneMsg{Plan: loader.Relabel{From: "api", To: "backend", IssueIDs: []string{"bv-1"}}, Done: 1})
+		return newM.(Model)
+	}
+
+	// Still on the screen it started from: nothing to announce
+	m.startBackgroundOp(bgRelabel, "")
+	m.backgroundOps[bgRelabel] = backgroundOp{started: time.Now().Add(-time.Minute), where: m.uiLocation()}
+	m = relabel()
+	if m.toast != nil || bell.Len() != 0 {
+		t.Fatalf("announced a job finishing where the user was waiting (%+v)", m.toast)
+	}
+
+	// Moved to the board while it ran
+	m.startBackgroundOp(bgRelabel, "")
+	m.backgroundOps[bgRelabel] = backgroundOp{started: time.Now().Add(-time.Minute), where: m.uiLocation()}
+	m.focused = focusBoard
+	m = relabel()
+	if m.toast == nil || !m.toast.flashing || bell.String() != "\a" {
+		t.Fatalf("toast = %+v, bell = %q; want a flashing toast and one bell", m.toast, bell.String())
+	}
+	if view := stripAnsi(m.View()); !strings.Contains(view, "#api") || !strings.Contains(view, "(1m0s)") {
+		t.Errorf("toast missing from the view:\n%s", view)
+	}
+
+	// The flash ends, then the toast; ticks of an older toast are ignored
+	m = m.handleToastTick(toastTickMsg{id: m.toast.id - 1, expire: true})
+	m = m.handleToastTick(toastTickMsg{id: m.toast.id})
+	if m.toast == nil || m.toast.flashing {
+		t.Fatalf("after the flash tick: %+v", m.toast)
+	}
+	m = m.handleToastTick(toastTickMsg{id: m.toast.id, expire: true})
+	if m.toast != nil {
+		t.Error("toast should expire")
+	}
+
+	// Quick jobs and notify: off stay quiet
+	m.startBackgroundOp(bgMetrics, "Graph metrics ready")
+	m.focused = focusList
+	if m.finishBackgroundOp(bgMetrics, "", false) != nil {
+		t.Error("a job under notify_after_seconds was announced")
+	}
+	m.projectConfig.Notify = "off"
+	m.backgroundOps[bgMetrics] = backgroundOp{started: time.Now().Add(-time.Minute), where: "elsewhere"}
+	if m.finishBackgroundOp(bgMetrics, "", false) != nil || m.toast != nil {
+		t.Error("notify: off still announced")
+	}
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	peekMaxWidth         = 60 // Card width including border
+	peekDescriptionLines = 4  // Non-blank description lines shown
+	peekMaxBlockers      = 3  // Blockers listed before "+N more"
+)
+
+// peekBlockers returns the open issues blocking issue, in dependency order.
+func (m Model) peekBlockers(issue model.Issue) []*model.Issue {
+	return openBlockers(&issue, m.issueMap)
+}
+
+// renderPeekCard renders the hovercard for issue, at most width cells wide.
+func (m Model) renderPeekCard(issue model.Issue, width int) string {
+	t := m.theme
+	inner := width - 4 // Border and padding
+	if inner < 10 {
+		inner = 10
+	}
+
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	statusStyle := t.Renderer.NewStyle().Foreground(t.GetStatusColor(string(issue.Status)))
+
+	var lines []string
+	lines = append(lines, titleStyle.Render(truncate(issue.ID+" "+issue.Title, inner)))
+	lines = append(lines, statusStyle.Render(truncate(fmt.Sprintf("%s %s · P%d · %s",
+		GetStatusIcon(string(issue.Status)), issue.Status, issue.Priority, issue.IssueType), inner)))
+
+	var desc []string
+	for _, line := range strings.Split(issue.Description, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if len(desc) == peekDescriptionLines {
+			desc[len(desc)-1] = truncate(desc[len(desc)-1]+" …", inner)
+			break
+		}
+		desc = append(desc, truncate(line, inner))
+	}
+	lines = append(lines, "")
+	if len(desc) == 0 {
+		lines = append(lines, mutedStyle.Render("No description"))
+	} else {
+		lines = append(lines, desc...)
+	}
+
+	blockers := m.peekBlockers(issue)
+	lines = append(lines, "")
+	if len(blockers) == 0 {
+		lines = append(lines, labelStyle.Render("Blockers: ")+mutedStyle.Render("none"))
+	} else {
+		lines = append(lines, labelStyle.Render(fmt.Sprintf("Blockers (%d):", len(blockers))))
+		for i, b := range blockers {
+			if i == peekMaxBlockers {
+				lines = append(lines, mutedStyle.Render(fmt.Sprintf("  +%d more", len(blockers)-i)))
+				break
+			}
+			lines = append(lines, truncate(fmt.Sprintf("  %s %s %s", GetStatusIcon(string(b.Status)), m.theme.ID(b.ID), b.Title), inner))
+		}
+	}
+
+	labels := mutedStyle.Render("none")
+	if len(issue.Labels) > 0 {
+		labels = truncate(strings.Join(issue.Labels, ", "), inner-len("Labels: "))
+	}
+	lines = append(lines, labelStyle.Render("Labels: ")+labels)
+
+	return t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(0, 1).
+		Width(inner + 2).
+		Render(strings.Join(lines, "\n"))
+}
+
+// overlayPeek draws the peek card for the selected issue over body, next to
+// the selected row. top is the body row of the first list item; left and
+// width bound the list area the card is placed in.
+func (m Model) overlayPeek(body string, top, left, width int) string {
+	item, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		return body
+	}
+
+	cardWidth := min(peekMaxWidth, width-2)
+	if cardWidth < 20 {
+		return body
+	}
+	card := m.renderPeekCard(item.Issue, cardWidth)
+	cardHeight := lipgloss.Height(card)
+	bodyHeight := lipgloss.Height(body)
+
+	// Below the selected row when it fits, otherwise above it
+	selectedRow := top + m.list.Cursor()
+	row := selectedRow + 1
+	if row+cardHeight > bodyHeight {
+		row = max(0, selectedRow-cardHeight)
+	}
+	col := left + width - lipgloss.Width(card) - 1
+	if col < left {
+		col = left
+	}
+	return overlayAt(body, card, row, col)
+}
@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func peekTestModel(t *testing.T) Model {
+	t.Helper()
+	issues := []model.Issue{
+		{
+			ID: "A", Title: "Parser rewrite", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask,
+			Description: "First line\n\nSecond line\nThird\nFourth\nFifth is hidden",
+			Labels:      []string{"backend", "parser"},
+			Dependencies: []*model.Dependency{
+				{IssueID: "A", DependsOnID: "B", Type: model.DepBlocks},
+				{IssueID: "A", DependsOnID: "C", Type: model.DepBlocks},
+			},
+		},
+		{ID: "B", Title: "Lexer tokens", Status: model.StatusInProgress},
+		{ID: "C", Title: "Done already", Status: model.StatusClosed},
+	}
+	m := NewModel(issues, nil, "")
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	return newM.(Model)
+}
+
+func selectIssue(t *testing.T, m *Model, id string) {
+	t.Helper()
+	for i, item := range m.list.Items() {
+		if it, ok := item.(IssueItem); ok && it.Issue.ID == id {
+			m.list.Select(i)
+			return
+		}
+	}
+	t.Fatalf("issue %s not in list", id)
+}
+
+func TestPeekToggleAndEsc(t *testing.T) {
+	m := peekTestModel(t)
+	m.isSplitView = false
+	selectIssue(t, &m, "A")
+
+	newM, _ := m.Update(keyMsg("K"))
+	m = newM.(Model)
+	if !m.showPeek {
+		t.Fatal("K should open the peek card")
+	}
+	view := m.View()
+	for _, want := range []string{"First line", "Fourth …", "Blockers (1):", "B Lexer tokens", "Labels: backend, parser"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("peek view missing %q", want)
+		}
+	}
+	if strings.Contains(view, "Fifth is hidden") || strings.Contains(view, "⚫ C") {
+		t.Error("peek should hide extra description lines and closed blockers")
+	}
+	if m.showDetails {
+		t.Error("peek must not open the detail view")
+	}
+
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = newM.(Model)
+	if m.showPeek {
+		t.Error("esc should close the peek card")
+	}
+	if strings.Contains(m.View(), "Blockers (1):") {
+		t.Error("closed peek should not render")
+	}
+}
+
+func TestPeekFollowsSelection(t *testing.T) {
+	m := peekTestModel(t)
+	m.isSplitView = false
+	m.showPeek = true
+	selectIssue(t, &m, "B")
+
+	view := m.View()
+	if !strings.Contains(view, "Blockers: none") || !strings.Contains(view, "No description") {
+		t.Errorf("peek should describe the selected issue B:\n%s", view)
+	}
+}
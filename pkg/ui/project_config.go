@@ -0,0 +1,257 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ModelOption customizes NewModel
+type ModelOption func(*modelOptions)
+
+type modelOptions struct {
+	projectConfig *config.Config
+	stream        *issueStream
+	inlineHeight  int
+	ascii         bool
+	epicRollup    bool
+	theme         string
+	dryRun        *loader.DryRun
+}
+
+// WithProjectConfig applies per-project defaults (.bv.yaml or .beads/bv.toml):
+// theme, lens depth and layout, pinned lenses and custom keybindings
+func WithProjectConfig(cfg *config.Config) ModelOption {
+	return func(o *modelOptions) {
+		o.projectConfig = cfg
+	}
+}
+
+// WithASCII draws glyphs in ASCII whatever the project config says (--ascii)
+func WithASCII(on bool) ModelOption {
+	return func(o *modelOptions) {
+		o.ascii = on
+	}
+}
+
+// WithEpicRollup shows derived epic statuses whatever the project config
+// says (--epic-rollup)
+func WithEpicRollup(on bool) ModelOption {
+	return func(o *modelOptions) {
+		o.epicRollup = on
+	}
+}
+
+// WithTheme overrides the project config's theme: auto, dark or light
+// (--theme). Empty keeps the config's.
+func WithTheme(theme string) ModelOption {
+	return func(o *modelOptions) {
+		o.theme = theme
+	}
+}
+
+// savePinnedLenses records the pins set with p in the lens selector and
+// writes them to the project config so they survive restarts
+func (m *Model) savePinnedLenses(pinned []string) {
+	if m.projectConfig == nil {
+		m.projectConfig = &config.Config{}
+	}
+	m.projectConfig.PinnedLenses = pinned
+	if m.workDir == "" && m.projectConfig.Path == "" {
+		m.statusMsg = "Pins kept for this session only (no project directory)"
+		m.statusIsError = false
+		return
+	}
+	path := m.projectConfig.WritePath(m.workDir)
+	if m.dryRun != nil {
+		m.dryRun.RecordFile(path, fmt.Sprintf("pin %d lens(es): %s", len(pinned), strings.Join(pinned, ", ")))
+		m.statusMsg = m.dryRunStatus(fmt.Sprintf("%d pinned lens(es) kept for this session", len(pinned)))
+		m.statusIsError = false
+		return
+	}
+	if err := config.SavePinnedLenses(path, pinned); err != nil {
+		m.statusMsg = fmt.Sprintf("Saving pins failed: %v", err)
+		m.statusIsError = true
+		return
+	}
+	m.projectConfig.Path = path
+	m.statusMsg = fmt.Sprintf("%d pinned lens(es) saved to %s", len(pinned), path)
+	m.statusIsError = false
+}
+
+// applyLensSort restores the order within status sections saved for the
+// lens dashboard's current layout, or the default blockers order
+func (m *Model) applyLensSort() {
+	layout := viewTypeToView(m.lensDashboard.GetViewType())
+	s, desc := SectionSortTopo, false
+	if m.projectConfig != nil {
+		if parsed, parsedDesc, ok := ParseSectionOrder(m.projectConfig.Sort[layout]); ok {
+			s, desc = parsed, parsedDesc
+		}
+	}
+	if cur, curDesc := m.lensDashboard.SectionOrder(); cur != s || curDesc != desc {
+		m.lensDashboard.SetSectionOrder(s, desc)
+	}
+}
+
+// saveLensSort records the order within status sections of the lens
+// dashboard's current layout and writes it to the project config's sort
+// map, so each layout keeps its own order across restarts
+func (m *Model) saveLensSort() {
+	layout := viewTypeToView(m.lensDashboard.GetViewType())
+	order := FormatSectionOrder(m.lensDashboard.SectionOrder())
+	if m.projectConfig == nil {
+		m.projectConfig = &config.Config{}
+	}
+	if m.projectConfig.Sort == nil {
+		m.projectConfig.Sort = make(map[string]string)
+	}
+	m.projectConfig.Sort[layout] = order
+	m.statusIsError = false
+	if m.workDir == "" && m.projectConfig.Path == "" {
+		m.statusMsg = fmt.Sprintf("Order within status: %s", order)
+		return
+	}
+	path := m.projectConfig.WritePath(m.workDir)
+	if m.dryRun != nil {
+		m.dryRun.RecordFile(path, fmt.Sprintf("sort %s: %s", layout, order))
+		m.statusMsg = m.dryRunStatus(fmt.Sprintf("Order within status: %s", order))
+		return
+	}
+	if err := config.SaveSortOrder(path, layout, order); err != nil {
+		m.statusMsg = fmt.Sprintf("Order within status: %s (saving failed: %v)", order, err)
+		m.statusIsError = true
+		return
+	}
+	m.projectConfig.Path = path
+	m.statusMsg = fmt.Sprintf("Order within status: %s (saved for the %s layout)", order, layout)
+}
+
+// saveWorkstreamName records a workstream renamed in the lens dashboard and
+// writes it to the project config's workstream_names map, so the stream
+// keeps its name across restarts
+func (m *Model) saveWorkstreamName(rename workstreamRename) {
+	if m.projectConfig == nil {
+		m.projectConfig = &config.Config{}
+	}
+	if m.projectConfig.WorkstreamNames == nil {
+		m.projectConfig.WorkstreamNames = make(map[string]string)
+	}
+	label := "Workstream renamed to " + rename.Name
+	if rename.Name == "" {
+		delete(m.projectConfig.WorkstreamNames, rename.Key)
+		label = "Workstream name reset"
+	} else {
+		m.projectConfig.WorkstreamNames[rename.Key] = rename.Name
+	}
+	m.statusIsError = false
+	if m.workDir == "" && m.projectConfig.Path == "" {
+		m.statusMsg = label
+		return
+	}
+	path := m.projectConfig.WritePath(m.workDir)
+	if m.dryRun != nil {
+		m.dryRun.RecordFile(path, fmt.Sprintf("workstream_names %s: %q", rename.Key, rename.Name))
+		m.statusMsg = m.dryRunStatus(label)
+		return
+	}
+	if err := config.SaveWorkstreamName(path, rename.Key, rename.Name); err != nil {
+		m.statusMsg = fmt.Sprintf("%s (saving failed: %v)", label, err)
+		m.statusIsError = true
+		return
+	}
+	m.projectConfig.Path = path
+	m.statusMsg = label + " (saved)"
+}
+
+// staleDays returns the project's stale threshold in days (0 = the default)
+func (m Model) staleDays() int {
+	if m.projectConfig == nil {
+		return 0
+	}
+	return m.projectConfig.StaleDays
+}
+
+// parseKey turns a key name as printed by tea.KeyMsg.String() ("j", "G",
+// "ctrl+n", "alt+x", "pgdown", "space") back into a key message
+func parseKey(s string) (tea.KeyMsg, bool) {
+	s = strings.TrimSpace(s)
+	alt := false
+	if rest, ok := strings.CutPrefix(s, "alt+"); ok && rest != "" {
+		alt, s = true, rest
+	}
+	switch {
+	case s == "space" || s == " ":
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}, Alt: alt}, true
+	case utf8.RuneCountInString(s) == 1:
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s), Alt: alt}, true
+	}
+	// Named keys: KeyType values span a small range around zero
+	for k := tea.KeyType(-128); k < 128; k++ {
+		if k != tea.KeyRunes && k.String() == s {
+			return tea.KeyMsg{Type: k, Alt: alt}, true
+		}
+	}
+	return tea.KeyMsg{}, false
+}
+
+// textInputActive reports whether a text input owns the keyboard, so custom
+// keybindings and the keymap must not rewrite what the user types
+func (m Model) textInputActive() bool {
+	switch {
+	case m.showLabelPicker, m.showRecipePicker, m.showRepoPicker, m.showTimeTravelPrompt, m.showCommandPalette, m.showJump:
+		return true
+	case m.focused == focusTimeTravelInput, m.showHelp && m.helpSearching:
+		return true
+	case m.list.FilterState() == list.Filtering:
+		return true
+	case m.showLensSelector && (m.lensSelector.IsInsertMode() || m.lensSelector.IsScopeAddMode() || m.lensSelector.IsViewNameMode()):
+		return true
+	case m.showLensDashboard && (m.lensDashboard.ShowFuzzySearch() || m.lensDashboard.ShowScopeInput() || m.lensDashboard.IsRenamingWorkstream()):
+		return true
+	case m.focused == focusReviewDashboard && m.reviewDashboard != nil && m.reviewDashboard.IsCapturingInput():
+		return true
+	case m.focused == focusBoard && m.board.IsSearchMode():
+		return true
+	case m.isHistoryView && m.historyView.IsSearchActive():
+		return true
+	}
+	return false
+}
+
+// saveWorkstreamOverride records an issue moved between lens workstreams and
+// writes it to the project config's workstream_overrides map, so detection
+// keeps it there on later loads; status is the move's status line
+func (m *Model) saveWorkstreamOverride(change workstreamOverride, status string) {
+	if m.projectConfig == nil {
+		m.projectConfig = &config.Config{}
+	}
+	if m.projectConfig.WorkstreamOverrides == nil {
+		m.projectConfig.WorkstreamOverrides = make(map[string]string)
+	}
+	if change.WSID == "" {
+		delete(m.projectConfig.WorkstreamOverrides, change.Key)
+	} else {
+		m.projectConfig.WorkstreamOverrides[change.Key] = change.WSID
+	}
+	if m.workDir == "" && m.projectConfig.Path == "" {
+		return
+	}
+	path := m.projectConfig.WritePath(m.workDir)
+	if m.dryRun != nil {
+		m.dryRun.RecordFile(path, fmt.Sprintf("workstream_overrides %s: %q", change.Key, change.WSID))
+		m.statusMsg = m.dryRunStatus(status)
+		return
+	}
+	if err := config.SaveWorkstreamOverride(path, change.Key, change.WSID); err != nil {
+		m.statusMsg = fmt.Sprintf("%s (saving failed: %v)", status, err)
+		m.statusIsError = true
+		return
+	}
+	m.projectConfig.Path = path
+}
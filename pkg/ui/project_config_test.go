@@ -0,0 +1,433 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func newProjectConfigModel(t *testing.T, cfg *config.Config) Model {
+	t.Helper()
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "API one", Status: model.StatusOpen, Labels: []string{"api", "db"}},
+		{ID: "bv-2", Title: "API two", Status: model.StatusOpen, Labels: []string{"api"}},
+		{ID: "bv-3", Title: "UI", Status: model.StatusOpen, Labels: []string{"ui"}},
+	}
+	m := NewModel(issues, nil, "", WithProjectConfig(cfg))
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 30})
+	return updated.(Model)
+}
+
+func TestParseKey(t *testing.T) {
+	for _, name := range []string{"j", "G", "ctrl+n", "alt+x", "pgdown", "enter", "f5"} {
+		key, ok := parseKey(name)
+		if !ok || key.String() != name {
+			t.Errorf("parseKey(%q) = %q, %v", name, key.String(), ok)
+		}
+	}
+	if key, ok := parseKey("space"); !ok || key.Type != tea.KeySpace {
+		t.Errorf("parseKey(space) = %+v, %v", key, ok)
+	}
+	if _, ok := parseKey("hyper+q"); ok {
+		t.Error("unknown key names should not parse")
+	}
+}
+
+func TestProjectConfigKeybindings(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{
+		Keybindings: map[string]string{"ctrl+n": "j", "bogus+k": "k"},
+	})
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "bogus+k") {
+		t.Errorf("unknown binding should be reported, status %q", m.statusMsg)
+	}
+
+	before := m.list.Index()
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m = updated.(Model)
+	if m.list.Index() != before+1 {
+		t.Errorf("ctrl+n bound to j should move down: index %d -> %d", before, m.list.Index())
+	}
+}
+
+func TestProjectConfigKeybindingConflicts(t *testing.T) {
+	// r is the list's ready filter; acting as j too, one of them loses it
+	m := newProjectConfigModel(t, &config.Config{Keybindings: map[string]string{"r": "j"}})
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "Keymap conflicts") || !strings.Contains(m.statusMsg, "list.filter_ready") {
+		t.Errorf("status %q should report the r conflict", m.statusMsg)
+	}
+}
+
+func TestProjectConfigKeybindingsSkipTextInput(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{Keybindings: map[string]string{"n": "j"}})
+	m.openLensSelector()
+	m.lensSelector.insertMode = true
+	if !m.textInputActive() {
+		t.Fatal("lens selector insert mode should count as text input")
+	}
+
+	updated, _ := m.Update(keyMsg("n"))
+	m = updated.(Model)
+	if m.lensSelector.searchInput.Value() != "n" {
+		t.Errorf("typed n should reach the search box, got %q", m.lensSelector.searchInput.Value())
+	}
+}
+
+func TestProjectConfigKeymap(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{
+		Keymap: map[string][]string{
+			"list.bottom":  {"ctrl+e"},
+			"global.board": {"B"},
+			"list.nope":    {"x"},
+			"list.sort":    {"hyper+s"},
+		},
+	})
+	for _, want := range []string{`"list.nope"`, `list.sort: unknown key "hyper+s"`} {
+		if !m.statusIsError || !strings.Contains(m.statusMsg, want) {
+			t.Errorf("status %q should report %s", m.statusMsg, want)
+		}
+	}
+
+	// G no longer jumps to the end; ctrl+e does
+	updated, _ := m.Update(keyMsg("G"))
+	m = updated.(Model)
+	if m.list.Index() != 0 {
+		t.Errorf("G was rebound away but moved the cursor to %d", m.list.Index())
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	m = updated.(Model)
+	if m.list.Index() != len(m.list.Items())-1 {
+		t.Errorf("ctrl+e should jump to the last issue, index %d", m.list.Index())
+	}
+
+	updated, _ = m.Update(keyMsg("b"))
+	m = updated.(Model)
+	if m.isBoardView {
+		t.Error("b was rebound away but opened the board")
+	}
+	updated, _ = m.Update(keyMsg("B"))
+	m = updated.(Model)
+	if !m.isBoardView || m.focused != focusBoard {
+		t.Error("B should open the board")
+	}
+
+	m.showHelp = true
+	m.focused = focusHelp
+	help := m.renderHelpOverlay()
+	for _, want := range []string{"Ctrl+e", "Go to last", "Kanban board"} {
+		if !strings.Contains(help, want) {
+			t.Errorf("help overlay should show %q", want)
+		}
+	}
+}
+
+func TestProjectConfigKeymapConflicts(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{
+		Keymap: map[string][]string{"list.copy": {"r"}},
+	})
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "r: list.filter_ready shadows list.copy") {
+		t.Errorf("status %q should report the conflict", m.statusMsg)
+	}
+
+	m.showHelp = true
+	m.focused = focusHelp
+	if help := m.renderHelpOverlay(); !strings.Contains(help, "Conflicts") {
+		t.Error("help overlay should list the conflicts")
+	}
+
+	// Without conflicts the startup status and help stay quiet
+	m = newProjectConfigModel(t, &config.Config{
+		Keymap: map[string][]string{"list.copy": {"Y"}},
+	})
+	m.showHelp = true
+	m.focused = focusHelp
+	if strings.Contains(m.statusMsg, "conflict") || strings.Contains(m.renderHelpOverlay(), "Conflicts") {
+		t.Errorf("no conflict expected, status %q", m.statusMsg)
+	}
+}
+
+func TestProjectConfigLensDefaults(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{Depth: "3", ViewType: "grouped"})
+	m.openLensSelector()
+	m = m.handleLensSelectorKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.showLensDashboard {
+		t.Fatal("expected lens dashboard to open")
+	}
+	if m.lensDashboard.GetDepth() != Depth3 {
+		t.Errorf("depth = %v, want 3", m.lensDashboard.GetDepth())
+	}
+	if m.lensDashboard.GetViewType() != ViewTypeGrouped {
+		t.Errorf("view type = %v, want grouped", m.lensDashboard.GetViewType())
+	}
+}
+
+func TestLensSelectorPinned(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "bv-2", Title: "Task", Status: model.StatusOpen, Labels: []string{"api", "ui"}},
+	}
+	s := NewLensSelectorModel(issues, DefaultTheme(lipgloss.DefaultRenderer()), nil)
+	s.SetSize(120, 40)
+	s.SetPinned([]string{"ui", "bv-2"})
+
+	var got []string
+	for _, item := range s.filteredItems {
+		got = append(got, item.Value)
+	}
+	if strings.Join(got, ",") != "ui,bv-2,bv-1,api" {
+		t.Errorf("items = %v, want pinned ui and bv-2 first", got)
+	}
+	if !strings.Contains(s.View(), "★") {
+		t.Error("pinned lenses should be marked")
+	}
+
+	// Searching ranks by match, not pin
+	s.HandleTextInput("api")
+	if len(s.filteredItems) == 0 || s.filteredItems[0].Value != "api" {
+		t.Errorf("search should not be reordered by pins, got %+v", s.filteredItems)
+	}
+}
+
+func TestLensSelectorTogglePin(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{})
+	m.workDir = t.TempDir()
+	m.openLensSelector()
+
+	// Pin the label under the cursor
+	for i, item := range m.lensSelector.filteredItems {
+		if item.Value == "ui" {
+			m.lensSelector.selectedIndex = i
+		}
+	}
+	m = m.handleLensSelectorKeys(keyMsg("p"))
+	if got := m.lensSelector.filteredItems[0]; got.Value != "ui" || !got.IsPinned {
+		t.Fatalf("first item = %+v, want pinned ui", got)
+	}
+	if m.lensSelector.selectedIndex != 0 {
+		t.Errorf("cursor = %d, want it to follow ui to the top", m.lensSelector.selectedIndex)
+	}
+	if !strings.Contains(m.lensSelector.View(), "Pinned") {
+		t.Error("pinned section heading missing")
+	}
+
+	cfg, err := config.Load(m.workDir)
+	if err != nil || strings.Join(cfg.PinnedLenses, ",") != "ui" {
+		t.Fatalf("saved pins = %v, err = %v", cfg.PinnedLenses, err)
+	}
+
+	// p again unpins and saves the empty list
+	m = m.handleLensSelectorKeys(keyMsg("p"))
+	if m.lensSelector.pinnedSectionLen() != 0 {
+		t.Error("ui should be unpinned")
+	}
+	if cfg, _ := config.Load(m.workDir); len(cfg.PinnedLenses) != 0 {
+		t.Errorf("saved pins after unpin = %v", cfg.PinnedLenses)
+	}
+}
+
+func TestLensSortSavedPerLayout(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, config.YAMLFilename), []byte("sort:\n  workstream: id desc\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := config.Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := newProjectConfigModel(t, cfg)
+	m.workDir = dir
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	if s, desc := m.lensDashboard.SectionOrder(); s != SectionSortTopo || desc {
+		t.Fatalf("flat order = %v desc %v, want the blockers default", s, desc)
+	}
+
+	// o then O in the flat layout: priority, reversed
+	m = m.handleLensDashboardKeys(keyMsg("o"))
+	m = m.handleLensDashboardKeys(keyMsg("O"))
+	if !strings.Contains(m.statusMsg, "priority desc") {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+	cfg, err = config.Load(m.workDir)
+	if err != nil || cfg.Sort["flat"] != "priority desc" || cfg.Sort["workstream"] != "id desc" {
+		t.Fatalf("saved sort = %v, err = %v", cfg.Sort, err)
+	}
+
+	// The workstream layout keeps its own order, and flat gets its back
+	m = m.handleLensDashboardKeys(keyMsg("w"))
+	if s, desc := m.lensDashboard.SectionOrder(); s != SectionSortID || !desc {
+		t.Errorf("workstream order = %v desc %v, want id desc", s, desc)
+	}
+	m = m.handleLensDashboardKeys(keyMsg("w"))
+	if s, desc := m.lensDashboard.SectionOrder(); s != SectionSortPriority || !desc {
+		t.Errorf("flat order = %v desc %v, want priority desc", s, desc)
+	}
+}
+
+func TestLensEpicRollup(t *testing.T) {
+	child := func(id string) model.Issue {
+		return model.Issue{ID: id, Title: "Child " + id, Status: model.StatusClosed, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: id, DependsOnID: "bv-e", Type: model.DepParentChild}}}
+	}
+	issues := []model.Issue{
+		{ID: "bv-e", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic, Labels: []string{"api"}},
+		child("bv-1"),
+		child("bv-2"),
+		{ID: "bv-3", Title: "Other", Status: model.StatusOpen, Labels: []string{"api"}},
+	}
+	for _, on := range []bool{false, true} {
+		m := NewModel(issues, nil, "", WithProjectConfig(&config.Config{EpicRollup: on, ViewType: "flat"}))
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 30})
+		m = updated.(Model)
+		m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+
+		view := stripAnsi(m.lensDashboard.View())
+		if flagged := strings.Contains(view, "⚠ open, children closed 2/2"); flagged != on {
+			t.Errorf("epic_rollup %v: mismatch flagged %v in:\n%s", on, flagged, view)
+		}
+		m.refreshLensDashboard()
+		if flagged := strings.Contains(stripAnsi(m.lensDashboard.View()), "⚠ open, children closed 2/2"); flagged != on {
+			t.Errorf("epic_rollup %v: mismatch flagged %v after a reload", on, flagged)
+		}
+	}
+}
+
+func TestWorkstreamRenameSaved(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{})
+	m.workDir = t.TempDir()
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	m = m.handleLensDashboardKeys(keyMsg("w"))
+	detected, wsID := m.lensDashboard.workstreams[0].Name, m.lensDashboard.workstreams[0].ID
+	m = m.handleLensDashboardKeys(keyMsg("n"))
+	m = m.handleLensDashboardKeys(tea.KeyMsg{Type: tea.KeyCtrlU})
+	for _, k := range []string{"C", "o", "r", "e"} {
+		m = m.handleLensDashboardKeys(keyMsg(k))
+	}
+	if !strings.Contains(stripAnsi(m.lensDashboard.View()), "✎ Core") {
+		t.Error("the name being typed should replace the header's")
+	}
+	m = m.handleLensDashboardKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	key := config.WorkstreamNameKey("api", wsID)
+	cfg, err := config.Load(m.workDir)
+	if err != nil || cfg.WorkstreamNames[key] != "Core" || m.lensDashboard.workstreams[0].Name != "Core" {
+		t.Fatalf("saved names = %v, err = %v, shown %q", cfg.WorkstreamNames, err, m.lensDashboard.workstreams[0].Name)
+	}
+
+	// The name holds when the lens is opened again
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	if got := m.lensDashboard.workstreams[0].Name; got != "Core" {
+		t.Errorf("reopened name = %q", got)
+	}
+
+	// An empty name goes back to the detected one
+	m = m.handleLensDashboardKeys(keyMsg("w"))
+	m = m.handleLensDashboardKeys(keyMsg("n"))
+	m = m.handleLensDashboardKeys(tea.KeyMsg{Type: tea.KeyCtrlU})
+	m = m.handleLensDashboardKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if cfg, _ := config.Load(m.workDir); len(cfg.WorkstreamNames) != 0 || m.lensDashboard.workstreams[0].Name != detected {
+		t.Errorf("after reset: saved %v, shown %q", cfg.WorkstreamNames, m.lensDashboard.workstreams[0].Name)
+	}
+}
+
+func TestWorkstreamOverrideSaved(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{})
+	m.workDir = t.TempDir()
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	m = m.handleLensDashboardKeys(keyMsg("w"))
+	ld := m.lensDashboard
+	if len(ld.workstreams) < 2 {
+		t.Fatalf("want two workstreams, got %d", len(ld.workstreams))
+	}
+	from, to := ld.workstreams[1], ld.workstreams[0]
+	id := from.Issues[0].ID
+
+	m.lensDashboard.SelectIssue(id)
+	m = m.handleLensDashboardKeys(keyMsg("X"))
+	if m.lensDashboard.CutIssueID() != id || !strings.Contains(stripAnsi(m.lensDashboard.View()), "✂") {
+		t.Fatalf("X should cut %s", id)
+	}
+	m.lensDashboard.SelectIssue(to.Issues[0].ID)
+	m = m.handleLensDashboardKeys(keyMsg("p"))
+	key := config.WorkstreamOverrideKey("api", id)
+	cfg, err := config.Load(m.workDir)
+	if err != nil || cfg.WorkstreamOverrides[key] != to.ID {
+		t.Fatalf("saved overrides = %v, err = %v", cfg.WorkstreamOverrides, err)
+	}
+	if ws := m.lensDashboard.WorkstreamOf(id); ws == nil || ws.ID != to.ID {
+		t.Fatalf("%s should now be in %s", id, to.ID)
+	}
+
+	// The move holds when the lens is opened again
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	m = m.handleLensDashboardKeys(keyMsg("w"))
+	if ws := m.lensDashboard.WorkstreamOf(id); ws == nil || ws.ID != to.ID {
+		t.Fatalf("reopened: %s should stay in %s", id, to.ID)
+	}
+	if !strings.Contains(stripAnsi(m.lensDashboard.View()), "📌") {
+		t.Error("a pinned issue should be marked")
+	}
+
+	// Moving it back to the detected stream unpins it
+	m.lensDashboard.SelectIssue(id)
+	m = m.handleLensDashboardKeys(keyMsg("X"))
+	m.lensDashboard.wsCursor, m.lensDashboard.wsIssueCursor = 1, -1
+	m = m.handleLensDashboardKeys(keyMsg("p"))
+	if cfg, _ := config.Load(m.workDir); len(cfg.WorkstreamOverrides) != 0 {
+		t.Errorf("after moving back: saved %v", cfg.WorkstreamOverrides)
+	}
+	if ws := m.lensDashboard.WorkstreamOf(id); ws == nil || ws.ID != from.ID {
+		t.Errorf("%s should be back in %s", id, from.ID)
+	}
+}
+
+func TestModelOptionsLeaveConfigUntouched(t *testing.T) {
+	cfg := &config.Config{PinnedLenses: []string{"api"}}
+	m := NewModel(nil, nil, "", WithProjectConfig(cfg), WithASCII(true), WithEpicRollup(true), WithTheme("light"))
+	if !m.ascii || !m.theme.ASCII || !m.projectConfig.EpicRollup || m.projectConfig.Theme != "light" {
+		t.Errorf("flags not applied: ascii %v, epic_rollup %v, theme %q", m.ascii, m.projectConfig.EpicRollup, m.projectConfig.Theme)
+	}
+
+	m.workDir = t.TempDir()
+	m.savePinnedLenses([]string{"ui"})
+	if cfg.ASCII || cfg.EpicRollup || cfg.Theme != "" || cfg.Path != "" || strings.Join(cfg.PinnedLenses, ",") != "api" {
+		t.Errorf("loaded config changed: %+v", cfg)
+	}
+}
+
+func TestProjectConfigNoUpdateCheck(t *testing.T) {
+	t.Setenv("BV_NO_UPDATE_CHECK", "")
+	if CheckUpdateCmd(&config.Config{NoUpdateCheck: true}) != nil {
+		t.Error("no_update_check should skip the startup check")
+	}
+	t.Setenv("BV_NO_UPDATE_CHECK", "1")
+	if CheckUpdateCmd(&config.Config{}) != nil {
+		t.Error("BV_NO_UPDATE_CHECK=1 should skip the startup check")
+	}
+}
+
+func TestProjectConfigKeymapPaletteUsesActions(t *testing.T) {
+	// Board moves to ctrl+b and graph takes its old b
+	m := newProjectConfigModel(t, &config.Config{Keymap: map[string][]string{
+		"global.board": {"ctrl+b"},
+		"global.graph": {"b"},
+	}})
+
+	var board PaletteCommand
+	for _, c := range m.buildPaletteCommands() {
+		if c.Title == "Kanban board" {
+			board = c
+		}
+	}
+	if board.Key != "ctrl+b" {
+		t.Errorf("palette hint = %q, want the rebound ctrl+b", board.Key)
+	}
+
+	m, _ = m.runPaletteCommand(board)
+	if !m.isBoardView || m.isGraphView {
+		t.Errorf("palette should open the board, got board=%v graph=%v", m.isBoardView, m.isGraphView)
+	}
+}
@@ -0,0 +1,219 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RetroModel is a standalone scrollable view of an epic retrospective (bv retro <epic>).
+type RetroModel struct {
+	retro *analysis.EpicRetro
+
+	scroll        int
+	width         int
+	height        int
+	theme         Theme
+	statusMsg     string
+	statusIsError bool
+}
+
+// NewRetroModel creates a retro view for a computed retrospective
+func NewRetroModel(retro *analysis.EpicRetro, theme Theme) RetroModel {
+	return RetroModel{retro: retro, theme: theme, width: 80, height: 24}
+}
+
+// Init implements tea.Model
+func (m RetroModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m RetroModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.clampScroll()
+	case tea.KeyMsg:
+		m.statusMsg = ""
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		case "j", "down":
+			m.scroll++
+		case "k", "up":
+			m.scroll--
+		case "ctrl+d", "pgdown":
+			m.scroll += m.bodyHeight() / 2
+		case "ctrl+u", "pgup":
+			m.scroll -= m.bodyHeight() / 2
+		case "g", "home":
+			m.scroll = 0
+		case "G", "end":
+			m.scroll = len(m.renderLines())
+		case "x":
+			filename := RetroExportFilename(m.retro, time.Now())
+			if err := export.SaveRetroMarkdown(m.retro, filename); err != nil {
+				m.statusMsg = fmt.Sprintf("Export failed: %v", err)
+				m.statusIsError = true
+			} else {
+				m.statusMsg = "Exported retro to " + filename
+				m.statusIsError = false
+			}
+		}
+		m.clampScroll()
+	}
+	return m, nil
+}
+
+// RetroExportFilename returns the default Markdown filename for a retro export
+func RetroExportFilename(retro *analysis.EpicRetro, now time.Time) string {
+	return fmt.Sprintf("retro_%s_%s.md", retro.EpicID, now.Format("2006-01-02"))
+}
+
+// bodyHeight is the number of report lines visible above the status bar
+func (m RetroModel) bodyHeight() int {
+	return max(1, m.height-1)
+}
+
+func (m *RetroModel) clampScroll() {
+	maxScroll := max(0, len(m.renderLines())-m.bodyHeight())
+	m.scroll = max(0, min(m.scroll, maxScroll))
+}
+
+// View implements tea.Model
+func (m RetroModel) View() string {
+	t := m.theme
+	lines := m.renderLines()
+	if len(lines) > m.bodyHeight() {
+		lines = lines[m.scroll : m.scroll+m.bodyHeight()]
+	}
+	for len(lines) < m.bodyHeight() {
+		lines = append(lines, "")
+	}
+
+	status := t.Renderer.NewStyle().Foreground(t.Subtext).Render("j/k scroll • x export markdown • q quit")
+	if m.statusMsg != "" {
+		color := t.Open
+		if m.statusIsError {
+			color = t.Blocked
+		}
+		status = t.Renderer.NewStyle().Foreground(color).Render(m.statusMsg)
+	}
+	return strings.Join(lines, "\n") + "\n" + status
+}
+
+// renderLines renders the full report
+func (m RetroModel) renderLines() []string {
+	t := m.theme
+	r := m.retro
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	valueStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground()).Bold(true)
+	emptyStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+
+	row := func(label, value string) string {
+		return "  " + labelStyle.Render(fmt.Sprintf("%-22s", label)) + valueStyle.Render(value)
+	}
+	titleWidth := max(20, min(m.width-30, 60))
+
+	lines := []string{
+		titleStyle.Render(fmt.Sprintf("🔎 Retro: %s %s", r.EpicID, truncateRunesHelper(r.EpicTitle, titleWidth, "…"))),
+		"",
+	}
+
+	// Summary
+	lines = append(lines, m.renderSectionHeader("Planned vs Actual")...)
+	completion := fmt.Sprintf("%d / %d closed", r.Closed, r.Total)
+	if r.IsComplete() {
+		completion += " ✓"
+	}
+	lines = append(lines,
+		row("Epic status", string(r.EpicStatus)),
+		row("Completion", completion),
+		row("Planned up front", fmt.Sprintf("%d", r.Planned)),
+		row("Added mid-flight", fmt.Sprintf("%d", len(r.AddedMidFlight))),
+	)
+	if !r.FirstClosure.IsZero() {
+		lines = append(lines,
+			row("First → last closure", fmt.Sprintf("%s → %s (%s)",
+				r.FirstClosure.Format("2006-01-02"), r.LastClosure.Format("2006-01-02"), formatDuration(r.Span))),
+		)
+	}
+	lines = append(lines, "")
+
+	// Scope changes
+	lines = append(lines, m.renderSectionHeader(fmt.Sprintf("Added Mid-Flight (%d)", len(r.AddedMidFlight)))...)
+	if len(r.AddedMidFlight) == 0 {
+		lines = append(lines, emptyStyle.Render("  No issues were added after work started"))
+	}
+	for _, iss := range r.AddedMidFlight {
+		lines = append(lines, fmt.Sprintf("  %s %s %s",
+			labelStyle.Render(iss.CreatedAt.Format("2006-01-02")),
+			idStyle.Render(iss.ID),
+			truncateRunesHelper(iss.Title, titleWidth, "…")))
+	}
+	lines = append(lines, "")
+
+	// Blocked time
+	lines = append(lines, m.renderSectionHeader("Longest Blocked")...)
+	if len(r.LongestBlocked) == 0 {
+		lines = append(lines, emptyStyle.Render("  Nothing waited on a blocker"))
+	}
+	blockedStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+	for _, b := range r.LongestBlocked {
+		blocker := "◄ " + b.BlockedBy
+		if b.StillBlocked {
+			blocker += " (still blocked)"
+		}
+		lines = append(lines, fmt.Sprintf("  %s %s %s %s",
+			blockedStyle.Render(fmt.Sprintf("%-6s", formatDuration(b.Waited))),
+			idStyle.Render(b.ID),
+			truncateRunesHelper(b.Title, titleWidth-10, "…"),
+			labelStyle.Render(blocker)))
+	}
+	lines = append(lines, "")
+
+	// Cycle times
+	lines = append(lines, m.renderSectionHeader("Cycle Time (created → closed)")...)
+	if r.Closed == 0 {
+		return append(lines, emptyStyle.Render("  No closed issues yet"))
+	}
+	barWidth := max(10, min(m.width-20, 40))
+	maxCount := r.MaxCycleBucket()
+	barStyle := t.Renderer.NewStyle().Foreground(t.Closed)
+	for _, b := range r.CycleTimes {
+		n := 0
+		if maxCount > 0 {
+			n = b.Count * barWidth / maxCount
+		}
+		if b.Count > 0 && n == 0 {
+			n = 1
+		}
+		lines = append(lines, fmt.Sprintf("  %s %s%s %s",
+			labelStyle.Render(fmt.Sprintf("%-5s", b.Label)),
+			barStyle.Render(strings.Repeat("█", n)),
+			emptyStyle.Render(strings.Repeat("·", barWidth-n)),
+			valueStyle.Render(fmt.Sprintf("%d", b.Count))))
+	}
+	lines = append(lines, "", row("Median / P90", formatDuration(r.MedianCycle)+" / "+formatDuration(r.P90Cycle)))
+	return lines
+}
+
+// renderSectionHeader renders a section title with an underline sized to the content
+func (m RetroModel) renderSectionHeader(title string) []string {
+	t := m.theme
+	headerStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	ruleStyle := t.Renderer.NewStyle().Foreground(t.Border)
+	width := max(20, min(m.width-4, 72))
+	return []string{
+		headerStyle.Render(title),
+		ruleStyle.Render(strings.Repeat("─", width)),
+	}
+}
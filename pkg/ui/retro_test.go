@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestRetroModelViewAndExport(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	closedAt := base.Add(48 * time.Hour)
+	issues := []model.Issue{
+		{ID: "E", Title: "Epic", IssueType: model.TypeEpic, Status: model.StatusOpen, CreatedAt: base},
+		{ID: "a", Title: "First", Status: model.StatusClosed, CreatedAt: base, ClosedAt: &closedAt,
+			Dependencies: []*model.Dependency{{DependsOnID: "E", Type: model.DepParentChild}}},
+		{ID: "b", Title: "Late addition", Status: model.StatusOpen, CreatedAt: base.Add(72 * time.Hour),
+			Dependencies: []*model.Dependency{{DependsOnID: "E", Type: model.DepParentChild}}},
+	}
+	retro, err := analysis.ComputeEpicRetro("E", issues, base.Add(96*time.Hour))
+	if err != nil {
+		t.Fatalf("ComputeEpicRetro: %v", err)
+	}
+
+	m := NewRetroModel(retro, DefaultTheme(lipgloss.DefaultRenderer()))
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 60})
+	m = updated.(RetroModel)
+
+	view := m.View()
+	for _, want := range []string{"Retro: E", "Planned vs Actual", "Added Mid-Flight (1)", "Late addition", "Cycle Time"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q", want)
+		}
+	}
+
+	dir := t.TempDir()
+	wd, _ := os.Getwd()
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	updated, _ = m.Update(keyMsg("x"))
+	m = updated.(RetroModel)
+	if !strings.Contains(m.View(), "Exported retro") {
+		t.Errorf("expected export status, got %q", m.View())
+	}
+	if _, err := os.Stat(filepath.Join(dir, RetroExportFilename(retro, time.Now()))); err != nil {
+		t.Errorf("export file missing: %v", err)
+	}
+}
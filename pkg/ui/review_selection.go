@@ -0,0 +1,353 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Bulk review selection. Space marks the issue under the cursor, V marks a
+// visual range from where it was pressed to the cursor, * marks everything
+// the current filter shows and + the cursor issue's subtree. While anything
+// is selected, approve, revise, defer and unapprove act on the whole
+// selection: the collector gets one action per issue and u undoes the batch.
+// l and L add a label to, or remove one from, the selection (or the cursor
+// issue without one), written straight through bd.
+
+// toggleMark marks or unmarks the issue under the cursor and moves down.
+func (m *ReviewDashboardModel) toggleMark() {
+	issue := m.SelectedIssue()
+	if issue == nil {
+		return
+	}
+	if m.marked[issue.ID] {
+		delete(m.marked, issue.ID)
+	} else {
+		m.marked[issue.ID] = true
+	}
+	if m.cursor < len(m.flatNodes)-1 {
+		m.cursor++
+		m.ensureVisible()
+		m.detailScroll = 0
+	}
+}
+
+// toggleVisual starts a visual range at the cursor, or ends the running one
+// and keeps its issues marked.
+func (m *ReviewDashboardModel) toggleVisual() {
+	if m.visualAnchor == "" {
+		if issue := m.SelectedIssue(); issue != nil {
+			m.visualAnchor = issue.ID
+		}
+		return
+	}
+	for i, node := range m.flatNodes {
+		if m.inVisualRange(i) {
+			m.marked[node.Issue.ID] = true
+		}
+	}
+	m.visualAnchor = ""
+}
+
+// markAll marks every visible issue in indexes, or unmarks them all if they
+// already are.
+func (m *ReviewDashboardModel) markAll(indexes []int) {
+	all := true
+	for _, i := range indexes {
+		all = all && m.marked[m.flatNodes[i].Issue.ID]
+	}
+	for _, i := range indexes {
+		if all {
+			delete(m.marked, m.flatNodes[i].Issue.ID)
+		} else {
+			m.marked[m.flatNodes[i].Issue.ID] = true
+		}
+	}
+}
+
+// markFiltered toggles the mark on everything the current filter shows.
+func (m *ReviewDashboardModel) markFiltered() {
+	indexes := make([]int, len(m.flatNodes))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	m.markAll(indexes)
+}
+
+// markSubtree toggles the mark on the cursor issue and its visible
+// descendants.
+func (m *ReviewDashboardModel) markSubtree() {
+	issue := m.SelectedIssue()
+	if issue == nil {
+		return
+	}
+	inSubtree := map[string]bool{issue.ID: true}
+	var walk func(id string)
+	walk = func(id string) {
+		for _, child := range m.tree.Children(id) {
+			if !inSubtree[child.ID] {
+				inSubtree[child.ID] = true
+				walk(child.ID)
+			}
+		}
+	}
+	walk(issue.ID)
+
+	var indexes []int
+	for i, node := range m.flatNodes {
+		if inSubtree[node.Issue.ID] {
+			indexes = append(indexes, i)
+		}
+	}
+	m.markAll(indexes)
+}
+
+// inVisualRange reports whether row i lies between the visual anchor and the
+// cursor. An anchor the current filter hides pins the range to the cursor.
+func (m *ReviewDashboardModel) inVisualRange(i int) bool {
+	if m.visualAnchor == "" {
+		return false
+	}
+	anchor := m.cursor
+	for j, node := range m.flatNodes {
+		if node.Issue.ID == m.visualAnchor {
+			anchor = j
+			break
+		}
+	}
+	return i >= min(anchor, m.cursor) && i <= max(anchor, m.cursor)
+}
+
+// isSelected reports whether row i is marked or in the visual range.
+func (m *ReviewDashboardModel) isSelected(i int) bool {
+	return m.marked[m.flatNodes[i].Issue.ID] || m.inVisualRange(i)
+}
+
+// hasSelection reports whether bulk actions are armed.
+func (m *ReviewDashboardModel) hasSelection() bool {
+	return m.visualAnchor != "" || len(m.marked) > 0
+}
+
+// selectedIssues returns the selected issues the current filter shows, in
+// tree order. Marks on hidden issues are kept but not acted on.
+func (m *ReviewDashboardModel) selectedIssues() []*model.Issue {
+	var issues []*model.Issue
+	for i, node := range m.flatNodes {
+		if m.isSelected(i) {
+			issues = append(issues, node.Issue)
+		}
+	}
+	return issues
+}
+
+// clearSelection drops every mark and ends the visual range.
+func (m *ReviewDashboardModel) clearSelection() {
+	m.marked = make(map[string]bool)
+	m.visualAnchor = ""
+}
+
+// reviewSelection gives every selected issue status (unreviewed resets
+// them), records one collector action per issue and one undo entry for the
+// batch, then clears the selection.
+func (m *ReviewDashboardModel) reviewSelection(issues []*model.Issue, label, status, note string) {
+	items := make([]reviewUndoItem, 0, len(issues))
+	for _, issue := range issues {
+		before := m.captureReview(issue)
+		if status == model.ReviewStatusUnreviewed {
+			m.resetReview(issue)
+		} else {
+			m.setReview(issue, status, note)
+		}
+		items = append(items, reviewUndoItem{issue: issue, before: before, after: m.captureReview(issue)})
+	}
+	m.pushUndoItems(label, items)
+	m.clearSelection()
+
+	verb := map[string]string{
+		"approve": "Approved",
+		"revise":  "Requested revision on",
+		"defer":   "Deferred",
+		"reset":   "Unapproved",
+	}[label]
+	m.undoMsg = fmt.Sprintf("%s %d issues", verb, len(items))
+}
+
+// selectionHint describes the selection for the footer, or "" without one.
+func (m *ReviewDashboardModel) selectionHint() string {
+	if !m.hasSelection() {
+		return ""
+	}
+	hint := fmt.Sprintf("%d selected", len(m.selectedIssues()))
+	if m.visualAnchor != "" {
+		hint = "VISUAL " + hint
+	}
+	return hint
+}
+
+// renderGutter draws the two columns before tree row i: the cursor and the
+// selection mark.
+func (m *ReviewDashboardModel) renderGutter(i int) string {
+	cursor, mark := " ", " "
+	if i == m.cursor {
+		cursor = m.theme.Renderer.NewStyle().Foreground(m.theme.Primary).Render("▸")
+	}
+	if m.isSelected(i) {
+		mark = m.theme.Renderer.NewStyle().Foreground(m.theme.Secondary).Bold(true).Render("•")
+	}
+	return cursor + mark
+}
+
+// LabelsEditedMsg reports a label added to or removed from several issues
+// through bd
+type LabelsEditedMsg struct {
+	Label  string
+	Remove bool
+	IDs    []string
+	Errs   []error
+}
+
+// EditLabelsCmd adds label to the issues in ids, or removes it with remove,
+// through bd in the background. A dry run (dry not nil) records the writes.
+func EditLabelsCmd(workDir string, ids []string, label string, remove bool, dry *loader.DryRun) tea.Cmd {
+	return func() tea.Msg {
+		errs := loader.EditLabel(workDir, ids, label, remove, dry)
+		return LabelsEditedMsg{Label: label, Remove: remove, IDs: ids, Errs: errs}
+	}
+}
+
+// labelTargets returns the issues a label edit applies to: the selection,
+// or the cursor issue without one.
+func (m *ReviewDashboardModel) labelTargets() []*model.Issue {
+	if m.hasSelection() {
+		return m.selectedIssues()
+	}
+	if issue := m.SelectedIssue(); issue != nil {
+		return []*model.Issue{issue}
+	}
+	return nil
+}
+
+// openLabelEdit prompts for a label to add to the targets, or to remove
+// from them with remove.
+func (m *ReviewDashboardModel) openLabelEdit(remove bool) {
+	if len(m.labelTargets()) == 0 {
+		return
+	}
+	m.showLabelEdit = true
+	m.labelEditRemove = remove
+	m.labelEdit = ""
+}
+
+// updateLabelEdit handles a key while the label prompt is open.
+func (m *ReviewDashboardModel) updateLabelEdit(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.showLabelEdit = false
+	case "enter":
+		m.showLabelEdit = false
+		if m.labelEdit != "" {
+			return m.editLabel(m.labelEdit, m.labelEditRemove)
+		}
+	case "backspace":
+		if len(m.labelEdit) > 0 {
+			m.labelEdit = m.labelEdit[:len(m.labelEdit)-1]
+		}
+	default:
+		if IsPrintableKey(msg.String()) && msg.String() != " " {
+			m.labelEdit += msg.String()
+		}
+	}
+	return nil
+}
+
+// editLabel adds label to (or removes it from) every target that lacks (or
+// has) it, shows the change at once and writes it through bd. The
+// selection is cleared.
+func (m *ReviewDashboardModel) editLabel(label string, remove bool) tea.Cmd {
+	var ids []string
+	for _, issue := range m.labelTargets() {
+		if slices.Contains(issue.Labels, label) != remove {
+			continue
+		}
+		setLabel(issue, label, !remove)
+		ids = append(ids, issue.ID)
+	}
+	m.clearSelection()
+	if len(ids) == 0 {
+		if remove {
+			m.undoMsg = fmt.Sprintf("No selected issue has #%s", label)
+		} else {
+			m.undoMsg = fmt.Sprintf("Every selected issue already has #%s", label)
+		}
+		return nil
+	}
+	return EditLabelsCmd(m.workspaceRoot, ids, label, remove, m.dryRun)
+}
+
+// SetLabelsEdited reports a finished label edit, putting back the label on
+// the issues bd could not write
+func (m *ReviewDashboardModel) SetLabelsEdited(msg LabelsEditedMsg) {
+	failed := loader.FailedIssues(msg.Errs)
+	done := 0
+	for _, id := range msg.IDs {
+		if !failed[id] {
+			done++
+			continue
+		}
+		if issue := m.findIssueByID(id); issue != nil {
+			setLabel(issue, msg.Label, msg.Remove)
+		}
+	}
+
+	if msg.Remove {
+		m.undoMsg = fmt.Sprintf("Removed #%s from %d issues", msg.Label, done)
+	} else {
+		m.undoMsg = fmt.Sprintf("Labeled %d issues #%s", done, msg.Label)
+	}
+	if len(msg.Errs) > 0 {
+		m.undoMsg += fmt.Sprintf("; %d failed: %v", len(failed), msg.Errs[0])
+	}
+}
+
+// setLabel adds label to issue, or with on false removes it
+func setLabel(issue *model.Issue, label string, on bool) {
+	if on {
+		if !slices.Contains(issue.Labels, label) {
+			issue.Labels = append(issue.Labels, label)
+		}
+		return
+	}
+	issue.Labels = slices.DeleteFunc(slices.Clone(issue.Labels), func(l string) bool { return l == label })
+}
+
+// renderLabelEdit renders the bulk label prompt
+func (m *ReviewDashboardModel) renderLabelEdit() string {
+	titleStyle := m.theme.Renderer.NewStyle().Bold(true).Foreground(m.theme.Primary)
+	labelStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
+	inputStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Primary)
+	hintStyle := m.theme.Renderer.NewStyle().Faint(true)
+
+	title := "Add Label to %d Issues"
+	if m.labelEditRemove {
+		title = "Remove Label from %d Issues"
+	}
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf(title, len(m.labelTargets()))) + "\n\n")
+	b.WriteString(labelStyle.Render("Label:") + "\n")
+	b.WriteString(inputStyle.Render(m.labelEdit+m.theme.Glyph("█")) + "\n\n")
+	b.WriteString(hintStyle.Render("[Enter] Apply  [Esc] Cancel"))
+
+	boxStyle := m.theme.Renderer.NewStyle().
+		Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
+		BorderForeground(m.theme.Primary).
+		Padding(1, 3).
+		Width(45)
+
+	return boxStyle.Render(b.String())
+}
@@ -0,0 +1,210 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/review"
+)
+
+// ReviewSessionFile is where an in-progress review is kept, in the beads
+// directory, so `bv review --resume` can pick it up after a crash or an
+// accidental discard.
+const ReviewSessionFile = "bv-session.json"
+
+// ReviewSession is everything needed to put a review dashboard back where
+// it was: what is being reviewed, the view state and the unsaved actions.
+type ReviewSession struct {
+	RootID     string    `json:"root_id,omitempty"` // Issue the review tree hangs off
+	Label      string    `json:"label,omitempty"`   // Set instead of RootID for label and lens reviews
+	Scope      []string  `json:"scope,omitempty"`   // Issues of a lens review
+	ReviewType string    `json:"review_type"`
+	Reviewer   string    `json:"reviewer,omitempty"`
+	Started    time.Time `json:"started"`
+
+	Cursor string   `json:"cursor,omitempty"` // ID of the issue under the cursor
+	Filter string   `json:"filter"`
+	Search string   `json:"search,omitempty"`
+	Labels []string `json:"labels,omitempty"`
+	Marked []string `json:"marked,omitempty"`
+
+	Pending []review.ReviewAction `json:"pending"` // Unsaved review actions, oldest first
+}
+
+// ReviewSessionPath returns the session file in beadsDir
+func ReviewSessionPath(beadsDir string) string {
+	return filepath.Join(beadsDir, ReviewSessionFile)
+}
+
+// LoadReviewSession reads a session written by the review dashboard
+func LoadReviewSession(path string) (*ReviewSession, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("no review session to resume (%s not found)", path)
+		}
+		return nil, fmt.Errorf("reading review session: %w", err)
+	}
+	var s ReviewSession
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parsing review session %s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// NewReviewDashboardFromSession rebuilds the dashboard a session was saved
+// from and restores its cursor, filters, selection and unsaved actions.
+// Actions on issues that no longer exist are dropped.
+func NewReviewDashboardFromSession(s *ReviewSession, issues []model.Issue, theme Theme, workspaceRoot string) (*ReviewDashboardModel, error) {
+	var tree *loader.ReviewTree
+	var err error
+	switch {
+	case len(s.Scope) > 0:
+		tree, err = loader.LoadScopedReviewTree(s.Label, s.Scope, issues)
+	case s.Label != "":
+		tree, err = loader.LoadLabelReviewTree(s.Label, issues)
+	default:
+		tree, err = loader.LoadReviewTree(s.RootID, issues)
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := newReviewDashboard(tree, s.Reviewer, s.ReviewType, theme, workspaceRoot)
+	if !s.Started.IsZero() {
+		m.sessionStarted = s.Started
+	}
+
+	for _, action := range s.Pending {
+		issue := m.findIssueByID(action.IssueID)
+		if issue == nil {
+			continue
+		}
+		if action.Status == model.ReviewStatusUnreviewed || action.Status == "" {
+			m.resetReview(issue)
+		} else {
+			m.setReview(issue, action.Status, action.Notes)
+			issue.ReviewedBy = action.Reviewer
+			issue.ReviewedAt = action.Timestamp
+		}
+		m.collector.Restore(action)
+	}
+	for _, id := range s.Marked {
+		if m.findIssueByID(id) != nil {
+			m.marked[id] = true
+		}
+	}
+
+	if s.Filter != "" {
+		m.showFilter = s.Filter
+	}
+	m.searchQuery = s.Search
+	m.activeLabels = s.Labels
+	m.rebuildFlatNodes()
+	m.selectIssue(s.Cursor)
+	return m, nil
+}
+
+// Session snapshots the dashboard for ReviewSessionFile
+func (m *ReviewDashboardModel) Session() ReviewSession {
+	s := ReviewSession{
+		ReviewType: m.reviewType,
+		Reviewer:   m.reviewer,
+		Started:    m.sessionStarted,
+		Filter:     m.showFilter,
+		Search:     m.searchQuery,
+		Labels:     m.activeLabels,
+		Pending:    m.collector.Actions(),
+	}
+	if m.tree.Label != "" {
+		s.Label = m.tree.Label
+		s.Scope = m.tree.Scope
+	} else {
+		s.RootID = m.tree.Root.ID
+	}
+	if issue := m.SelectedIssue(); issue != nil {
+		s.Cursor = issue.ID
+	}
+	for id, on := range m.marked {
+		if on {
+			s.Marked = append(s.Marked, id)
+		}
+	}
+	sort.Strings(s.Marked)
+	return s
+}
+
+// SetSessionPath turns on session persistence: from now on every change to
+// the review is written to path. The current state counts as written, so
+// merely opening a dashboard leaves an earlier session alone.
+func (m *ReviewDashboardModel) SetSessionPath(path string) {
+	m.sessionPath = path
+	m.sessionData, _ = json.Marshal(m.Session())
+}
+
+// persistSession writes the session file if anything changed since the
+// last write. Errors are shown in the footer; reviewing carries on.
+func (m *ReviewDashboardModel) persistSession() {
+	if m.sessionPath == "" {
+		return
+	}
+	data, err := json.Marshal(m.Session())
+	if err != nil || bytes.Equal(data, m.sessionData) {
+		return
+	}
+	if m.dryRun != nil {
+		// One pending change stands for the whole session, not every keypress
+		if !m.sessionRecorded {
+			m.dryRun.RecordFile(m.sessionPath, "review session for bv review --resume")
+			m.sessionRecorded = true
+		}
+		m.sessionData = data
+		return
+	}
+	if err := writeReviewSession(m.sessionPath, data); err != nil {
+		m.undoMsg = fmt.Sprintf("Session not saved: %v", err)
+		return
+	}
+	m.sessionData = data
+}
+
+// FinishSession is called once the dashboard has closed and its reviews
+// have been saved or discarded. A review that ended with nothing unsaved
+// is finished and its session file removed; otherwise the file is kept up
+// to date so the review can be resumed.
+func (m *ReviewDashboardModel) FinishSession() {
+	if m.sessionPath == "" {
+		return
+	}
+	if m.saveOnQuit && m.collector.Count() == 0 {
+		if m.dryRun == nil {
+			_ = os.Remove(m.sessionPath)
+		}
+		return
+	}
+	m.persistSession()
+}
+
+// writeReviewSession replaces the session file, via a temp file so a crash
+// mid-write never leaves a truncated session behind
+func writeReviewSession(path string, data []byte) error {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return fmt.Errorf("encoding review session: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("writing review session: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("replacing review session: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/review"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// teamSummary splits the whole tree's reviews of this session's type by
+// reviewer, counting this session's unsaved reviews as the current reviewer's
+func (m *ReviewDashboardModel) teamSummary() review.TeamSummary {
+	var ids []string
+	if m.tree.Root != nil && m.tree.Label == "" {
+		ids = append(ids, m.tree.Root.ID)
+	}
+	for _, issue := range m.tree.Descendants {
+		ids = append(ids, issue.ID)
+	}
+
+	history := make(map[string][]review.ReviewEvent, len(m.reviewHistory))
+	for id, events := range m.reviewHistory {
+		history[id] = events
+	}
+	for _, a := range m.collector.Actions() {
+		history[a.IssueID] = append(append([]review.ReviewEvent(nil), history[a.IssueID]...), review.ReviewEvent{
+			Status:     a.Status,
+			Reviewer:   a.Reviewer,
+			ReviewType: a.ReviewType,
+			At:         a.Timestamp,
+		})
+	}
+	return review.ComputeTeamSummary(ids, history, m.reviewType)
+}
+
+// renderTeamSummary renders the per-reviewer overlay (T)
+func (m *ReviewDashboardModel) renderTeamSummary() string {
+	t := m.theme
+	s := m.teamSummary()
+
+	headerStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	infoStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	approvedStyle := t.Renderer.NewStyle().Foreground(t.Open)
+	revisionStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Team Review Progress") + "\n")
+	b.WriteString(strings.Repeat("─", 48) + "\n")
+	b.WriteString(infoStyle.Render(fmt.Sprintf("%s review · %d issues", m.reviewType, s.Total)) + "\n\n")
+
+	if len(s.Reviewers) == 0 {
+		b.WriteString(infoStyle.Render("Nobody has reviewed anything here yet") + "\n")
+	} else {
+		nameWidth := len("Reviewer")
+		for _, r := range s.Reviewers {
+			nameWidth = max(nameWidth, lipgloss.Width(r.Reviewer))
+		}
+		b.WriteString(t.Renderer.NewStyle().Bold(true).Render(
+			fmt.Sprintf("%-*s  %8s  %3s  %3s  %3s  %6s", nameWidth, "Reviewer", "Reviewed", "✓", "!", "?", "Shared")) + "\n")
+		for _, r := range s.Reviewers {
+			name := fmt.Sprintf("%-*s", nameWidth, r.Reviewer)
+			if r.Reviewer == m.reviewer {
+				name = headerStyle.Render(name)
+			}
+			b.WriteString(name + fmt.Sprintf("  %8d  ", r.Reviewed) +
+				approvedStyle.Render(fmt.Sprintf("%3d", r.Approved)) + "  " +
+				revisionStyle.Render(fmt.Sprintf("%3d", r.NeedsRevision)) + "  " +
+				infoStyle.Render(fmt.Sprintf("%3d", r.Deferred)) +
+				fmt.Sprintf("  %6d", r.Shared) + "\n")
+		}
+	}
+	b.WriteString("\n")
+	b.WriteString(infoStyle.Render(fmt.Sprintf("Overlap:    %d reviewed by more than one person", s.Overlap)) + "\n")
+
+	// Name the first few unreviewed issues so the rest can be handed out
+	const maxListed = 8
+	unreviewed := fmt.Sprintf("Unreviewed: %d", len(s.Unreviewed))
+	if len(s.Unreviewed) > 0 {
+		var listed []string
+		for i, id := range s.Unreviewed {
+			if i == maxListed {
+				listed = append(listed, fmt.Sprintf("+%d more", len(s.Unreviewed)-maxListed))
+				break
+			}
+			listed = append(listed, m.theme.ID(id))
+		}
+		unreviewed += " (" + strings.Join(listed, ", ") + ")"
+	}
+	for _, line := range wrapTextLines(unreviewed, 56) {
+		b.WriteString(infoStyle.Render(line) + "\n")
+	}
+
+	b.WriteString("\n" + t.Renderer.NewStyle().Faint(true).Render("Press any key to close"))
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxStyle.Render(b.String()))
+}
@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/review"
+)
+
+// reviewSnapshot captures everything a review action touches for one issue,
+// so undo/redo can restore it exactly.
+type reviewSnapshot struct {
+	status     string
+	reviewedBy string
+	reviewedAt time.Time
+	note       string
+	hasNote    bool
+	action     review.ReviewAction
+	hasAction  bool // Whether the collector had an action for the issue
+	counters   [4]int
+}
+
+// reviewUndoItem is the state of one issue before and after an action.
+type reviewUndoItem struct {
+	issue  *model.Issue
+	before reviewSnapshot
+	after  reviewSnapshot
+}
+
+// reviewUndoEntry is one reversible review action. A bulk action over a
+// selection holds one item per issue, in the order they were reviewed, and
+// undoes as a single step.
+type reviewUndoEntry struct {
+	label string // "approve", "revise", "defer", "reset"
+	items []reviewUndoItem
+}
+
+// target describes what the entry acted on, for undo/redo feedback.
+func (e reviewUndoEntry) target() string {
+	if len(e.items) == 1 {
+		return e.items[0].issue.ID
+	}
+	return fmt.Sprintf("%d issues", len(e.items))
+}
+
+// captureReview snapshots the review state of issue and the session counters.
+func (m *ReviewDashboardModel) captureReview(issue *model.Issue) reviewSnapshot {
+	snap := reviewSnapshot{
+		status:     issue.ReviewStatus,
+		reviewedBy: issue.ReviewedBy,
+		reviewedAt: issue.ReviewedAt,
+		counters:   [4]int{m.itemsReviewed, m.itemsApproved, m.itemsNeedsRevision, m.itemsDeferred},
+	}
+	snap.note, snap.hasNote = m.reviewNotes[issue.ID]
+	snap.action, snap.hasAction = m.collector.Lookup(issue.ID)
+	return snap
+}
+
+// restoreReview applies a snapshot taken by captureReview.
+func (m *ReviewDashboardModel) restoreReview(issue *model.Issue, snap reviewSnapshot) {
+	issue.ReviewStatus = snap.status
+	issue.ReviewedBy = snap.reviewedBy
+	issue.ReviewedAt = snap.reviewedAt
+	if snap.hasNote {
+		m.reviewNotes[issue.ID] = snap.note
+	} else {
+		delete(m.reviewNotes, issue.ID)
+	}
+	if snap.hasAction {
+		m.collector.Restore(snap.action)
+	} else {
+		m.collector.Remove(issue.ID)
+	}
+	m.itemsReviewed, m.itemsApproved, m.itemsNeedsRevision, m.itemsDeferred =
+		snap.counters[0], snap.counters[1], snap.counters[2], snap.counters[3]
+}
+
+// pushUndo records a completed action whose prior state is before. Any new
+// action invalidates the redo history.
+func (m *ReviewDashboardModel) pushUndo(issue *model.Issue, label string, before reviewSnapshot) {
+	m.pushUndoItems(label, []reviewUndoItem{{
+		issue:  issue,
+		before: before,
+		after:  m.captureReview(issue),
+	}})
+}
+
+// pushUndoItems records a completed action over several issues as one entry.
+func (m *ReviewDashboardModel) pushUndoItems(label string, items []reviewUndoItem) {
+	if len(items) == 0 {
+		return
+	}
+	m.undoStack = append(m.undoStack, reviewUndoEntry{label: label, items: items})
+	m.redoStack = nil
+}
+
+// undoReview reverses the most recent review action.
+func (m *ReviewDashboardModel) undoReview() {
+	if len(m.undoStack) == 0 {
+		m.undoMsg = "Nothing to undo"
+		return
+	}
+	entry := m.undoStack[len(m.undoStack)-1]
+	m.undoStack = m.undoStack[:len(m.undoStack)-1]
+	// Unwind in reverse so the session counters end at the first snapshot
+	for i := len(entry.items) - 1; i >= 0; i-- {
+		m.restoreReview(entry.items[i].issue, entry.items[i].before)
+	}
+	m.redoStack = append(m.redoStack, entry)
+	m.selectIssue(entry.items[0].issue.ID)
+	m.undoMsg = fmt.Sprintf("Undid %s on %s", entry.label, entry.target())
+}
+
+// redoReview re-applies the most recently undone review action.
+func (m *ReviewDashboardModel) redoReview() {
+	if len(m.redoStack) == 0 {
+		m.undoMsg = "Nothing to redo"
+		return
+	}
+	entry := m.redoStack[len(m.redoStack)-1]
+	m.redoStack = m.redoStack[:len(m.redoStack)-1]
+	for _, item := range entry.items {
+		m.restoreReview(item.issue, item.after)
+	}
+	m.undoStack = append(m.undoStack, entry)
+	m.selectIssue(entry.items[0].issue.ID)
+	m.undoMsg = fmt.Sprintf("Redid %s on %s", entry.label, entry.target())
+}
+
+// selectIssue moves the cursor to issueID if it is visible under the current filter.
+func (m *ReviewDashboardModel) selectIssue(issueID string) {
+	for i, node := range m.flatNodes {
+		if node.Issue != nil && node.Issue.ID == issueID {
+			m.cursor = i
+			m.ensureVisible()
+			return
+		}
+	}
+}
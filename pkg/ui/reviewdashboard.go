@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -42,11 +43,12 @@ type ReviewDashboardModel struct {
 	reviewer    string
 
 	// Filtering
-	showFilter  string // "all", "unreviewed", "needs_revision"
+	showFilter  string // "all", "unreviewed", "needs_revision", "changed"
 
 	// Focus state for split panel
-	detailFocus  bool // true when detail panel has focus
-	detailScroll int  // scroll offset for detail panel
+	detailFocus  bool              // true when detail panel has focus
+	detailScroll int               // scroll offset for detail panel
+	markdown     *MarkdownRenderer // Renders issue text in the detail panel
 
 	// Note input modal
 	noteInput     NoteInputModel
@@ -79,6 +81,9 @@ type ReviewDashboardModel struct {
 	// Help
 	showHelp bool
 
+	// Per-reviewer progress overlay (T)
+	showTeam bool
+
 	// Label filtering
 	showLabelInput bool
 	labelInput     string
@@ -87,9 +92,43 @@ type ReviewDashboardModel struct {
 	// Review persistence
 	collector     *review.ReviewActionCollector
 	workspaceRoot string
+	newSaver      func(workspaceRoot string, dry *loader.DryRun) review.ReviewSaver
+	saveRequested bool           // w pressed; the host saves and reports back
+	dryRun        *loader.DryRun // Saves and session writes are recorded here instead; nil when live
+	readOnly      bool           // bd is not installed: saves are refused
 
 	// Review notes stored separately from issue.Notes to avoid conflicts
 	reviewNotes map[string]string // issue ID -> review notes
+
+	// Reviews saved in earlier sessions, oldest first, from issue comments
+	reviewHistory map[string][]review.ReviewEvent
+
+	// Edits to text fields from git history (c), read per issue on demand
+	showChanges  bool
+	fieldChanges map[string]*fieldHistory
+
+	// Undo/redo of review actions (u / ctrl+r)
+	undoStack []reviewUndoEntry
+	redoStack []reviewUndoEntry
+	undoMsg   string // Feedback for the last undo/redo or save, shown in the footer
+
+	// Bulk selection (space / V / * / +), see review_selection.go
+	marked       map[string]bool // issue ID -> marked
+	visualAnchor string          // Issue the running visual range started at, "" when off
+	noteTargets  []*model.Issue  // Selection the open note modal applies to, nil for the cursor issue
+
+	// Bulk label prompt (l adds, L removes)
+	showLabelEdit   bool
+	labelEdit       string
+	labelEditRemove bool
+
+	// Canned notes offered in the note modal (alt+1..alt+9)
+	noteTemplates []string
+
+	// Session persistence for `bv review --resume`, see review_session.go
+	sessionPath     string // "" when off
+	sessionData     []byte // Session as last written, to skip unchanged writes
+	sessionRecorded bool   // A dry run has recorded the session write
 }
 
 // NewReviewDashboardModel creates a new review dashboard
@@ -98,7 +137,31 @@ func NewReviewDashboardModel(rootID string, issues []model.Issue, reviewer strin
 	if err != nil {
 		return nil, err
 	}
+	return newReviewDashboard(tree, reviewer, reviewType, theme, workspaceRoot), nil
+}
+
+// NewLabelReviewDashboardModel creates a review dashboard over every issue
+// carrying label, grouped under their epics (see loader.LoadLabelReviewTree)
+func NewLabelReviewDashboardModel(label string, issues []model.Issue, reviewer string, reviewType string, theme Theme, workspaceRoot string) (*ReviewDashboardModel, error) {
+	tree, err := loader.LoadLabelReviewTree(label, issues)
+	if err != nil {
+		return nil, err
+	}
+	return newReviewDashboard(tree, reviewer, reviewType, theme, workspaceRoot), nil
+}
 
+// NewScopedReviewDashboardModel creates a review dashboard over exactly the
+// issues in ids, such as a lens dashboard's visible set, under a placeholder
+// named name (see loader.LoadScopedReviewTree)
+func NewScopedReviewDashboardModel(name string, ids []string, issues []model.Issue, reviewer string, reviewType string, theme Theme, workspaceRoot string) (*ReviewDashboardModel, error) {
+	tree, err := loader.LoadScopedReviewTree(name, ids, issues)
+	if err != nil {
+		return nil, err
+	}
+	return newReviewDashboard(tree, reviewer, reviewType, theme, workspaceRoot), nil
+}
+
+func newReviewDashboard(tree *loader.ReviewTree, reviewer string, reviewType string, theme Theme, workspaceRoot string) *ReviewDashboardModel {
 	m := &ReviewDashboardModel{
 		tree:           tree,
 		reviewer:       reviewer,
@@ -108,41 +171,38 @@ func NewReviewDashboardModel(rootID string, issues []model.Issue, reviewer strin
 		sessionStarted: time.Now(),
 		collector:      review.NewReviewActionCollector(reviewer, reviewType),
 		workspaceRoot:  workspaceRoot,
+		newSaver:       review.NewReviewSaver,
 		reviewNotes:    make(map[string]string),
+		reviewHistory:  make(map[string][]review.ReviewEvent),
+		fieldChanges:   make(map[string]*fieldHistory),
+		marked:         make(map[string]bool),
+		noteTemplates:  defaultNoteTemplates,
 	}
 
 	m.rebuildFlatNodes()
 	m.loadReviewStateFromComments()
-	return m, nil
+	return m
 }
 
 // rebuildFlatNodes flattens the tree into a list for display
 func (m *ReviewDashboardModel) rebuildFlatNodes() {
 	m.flatNodes = make([]ReviewFlatNode, 0)
 
-	// Add root
-	m.flatNodes = append(m.flatNodes, ReviewFlatNode{
-		Issue:      m.tree.Root,
-		TreePrefix: "",
-		Depth:      0,
-		IsLast:     true,
-		ParentPath: []bool{},
-	})
-
-	// Build children map for traversal
-	childrenMap := make(map[string][]*model.Issue)
-	for _, desc := range m.tree.Descendants {
-		for _, dep := range desc.Dependencies {
-			if dep.Type == model.DepParentChild {
-				childrenMap[dep.DependsOnID] = append(childrenMap[dep.DependsOnID], desc)
-			}
-		}
+	// Add root, unless it is a label tree's placeholder (the header names it)
+	if m.tree.Label == "" {
+		m.flatNodes = append(m.flatNodes, ReviewFlatNode{
+			Issue:      m.tree.Root,
+			TreePrefix: "",
+			Depth:      0,
+			IsLast:     true,
+			ParentPath: []bool{},
+		})
 	}
 
 	// DFS to flatten tree
 	var flatten func(issue *model.Issue, depth int, parentPath []bool)
 	flatten = func(issue *model.Issue, depth int, parentPath []bool) {
-		children := childrenMap[issue.ID]
+		children := m.tree.Children(issue.ID)
 		for i, child := range children {
 			isLast := i == len(children)-1
 			newPath := append([]bool{}, parentPath...)
@@ -157,14 +217,14 @@ func (m *ReviewDashboardModel) rebuildFlatNodes() {
 				if wasLast {
 					prefix += "   "
 				} else {
-					prefix += "│  "
+					prefix += m.theme.Glyph("│  ")
 				}
 			}
 			if depth > 0 {
 				if isLast {
-					prefix += "└─ "
+					prefix += m.theme.Glyph("└─ ")
 				} else {
-					prefix += "├─ "
+					prefix += m.theme.Glyph("├─ ")
 				}
 			}
 
@@ -198,6 +258,10 @@ func (m *ReviewDashboardModel) shouldShow(issue *model.Issue) bool {
 		if issue.ReviewStatus != model.ReviewStatusNeedsRevision {
 			return false
 		}
+	case "changed":
+		if len(m.changedSinceApproval(issue)) == 0 {
+			return false
+		}
 	}
 
 	// Check search filter
@@ -243,6 +307,17 @@ func (m *ReviewDashboardModel) Init() tea.Cmd {
 
 // Update implements tea.Model
 func (m *ReviewDashboardModel) Update(msg tea.Msg) (*ReviewDashboardModel, tea.Cmd) {
+	defer m.persistSession()
+
+	if msg, ok := msg.(FieldChangesLoadedMsg); ok {
+		m.SetFieldChanges(msg)
+		return m, nil
+	}
+	if msg, ok := msg.(LabelsEditedMsg); ok {
+		m.SetLabelsEdited(msg)
+		return m, nil
+	}
+
 	// Handle summary screen
 	if m.showSummary {
 		switch msg := msg.(type) {
@@ -288,6 +363,14 @@ func (m *ReviewDashboardModel) Update(msg tea.Msg) (*ReviewDashboardModel, tea.C
 		return m, nil
 	}
 
+	// Any key closes the team overlay
+	if m.showTeam {
+		if _, ok := msg.(tea.KeyMsg); ok {
+			m.showTeam = false
+		}
+		return m, nil
+	}
+
 	// Handle search input when active
 	if m.showSearch {
 		switch msg := msg.(type) {
@@ -369,6 +452,14 @@ func (m *ReviewDashboardModel) Update(msg tea.Msg) (*ReviewDashboardModel, tea.C
 		return m, nil
 	}
 
+	// Handle the bulk label prompt when active
+	if m.showLabelEdit {
+		if msg, ok := msg.(tea.KeyMsg); ok {
+			return m, m.updateLabelEdit(msg)
+		}
+		return m, nil
+	}
+
 	// Handle assignee input when active
 	if m.showAssigneeInput {
 		switch msg := msg.(type) {
@@ -408,49 +499,34 @@ func (m *ReviewDashboardModel) Update(msg tea.Msg) (*ReviewDashboardModel, tea.C
 		m.noteInput, cmd = m.noteInput.Update(msg)
 
 		if m.noteInput.IsSubmitted() {
-			// Apply note and status to current issue
-			if issue := m.SelectedIssue(); issue != nil {
-				note := m.noteInput.Notes()
-				action := m.noteInput.Action()
-
-				// Store review notes separately for display
-				if note != "" && (action == "revision" || action == "defer") {
-					m.reviewNotes[issue.ID] = note
-				}
-
-				// Set review status based on action
-				wasUnreviewed := issue.ReviewStatus == "" || issue.ReviewStatus == model.ReviewStatusUnreviewed
-				switch action {
-				case "revision":
-					issue.ReviewStatus = model.ReviewStatusNeedsRevision
-					issue.ReviewedBy = m.reviewer
-					issue.ReviewedAt = time.Now()
-					if wasUnreviewed {
-						m.itemsReviewed++
-						m.itemsNeedsRevision++
-					}
-					// Record for persistence
-					m.collector.Record(issue.ID, model.ReviewStatusNeedsRevision, note)
-				case "defer":
-					issue.ReviewStatus = model.ReviewStatusDeferred
-					issue.ReviewedBy = m.reviewer
-					issue.ReviewedAt = time.Now()
-					if wasUnreviewed {
-						m.itemsReviewed++
-						m.itemsDeferred++
-					}
-					// Record for persistence
-					m.collector.Record(issue.ID, model.ReviewStatusDeferred, note)
+			note := m.noteInput.Notes()
+			label, status := "revise", model.ReviewStatusNeedsRevision
+			if m.noteInput.Action() == "defer" {
+				label, status = "defer", model.ReviewStatusDeferred
+			}
+			switch {
+			case m.noteInput.Action() == "note":
 				// "note" action doesn't change status
+			case m.noteTargets != nil:
+				// One note for the whole selection
+				m.reviewSelection(m.noteTargets, label, status, note)
+			default:
+				// Apply note and status to current issue
+				if issue := m.SelectedIssue(); issue != nil {
+					before := m.captureReview(issue)
+					m.setReview(issue, status, note)
+					m.pushUndo(issue, label, before)
 				}
 			}
 			m.showNoteInput = false
+			m.noteTargets = nil
 			m.noteInput.Reset()
 			return m, nil
 		}
 
 		if m.noteInput.IsCancelled() {
 			m.showNoteInput = false
+			m.noteTargets = nil
 			m.noteInput.Reset()
 			return m, nil
 		}
@@ -460,6 +536,7 @@ func (m *ReviewDashboardModel) Update(msg tea.Msg) (*ReviewDashboardModel, tea.C
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		m.undoMsg = ""
 		switch msg.String() {
 		case "j", "down":
 			if m.detailFocus {
@@ -545,69 +622,64 @@ func (m *ReviewDashboardModel) Update(msg tea.Msg) (*ReviewDashboardModel, tea.C
 		case "n":
 			// Add note without changing status
 			if issue := m.SelectedIssue(); issue != nil {
-				m.noteInput = NewNoteInputModel(issue.Title, "note", issue.ID, m.theme)
-				m.noteInput.SetSize(m.width, m.height)
-				m.showNoteInput = true
-				return m, m.noteInput.Init()
+				return m, m.openNote(issue.Title, "note", issue.ID)
 			}
+		case " ":
+			m.toggleMark()
+		case "V":
+			m.toggleVisual()
+		case "*":
+			m.markFiltered()
+		case "+":
+			m.markSubtree()
+		case "l":
+			m.openLabelEdit(false)
+		case "L":
+			m.openLabelEdit(true)
 		case "a":
 			// Approve - sets status directly, no note required
-			if issue := m.SelectedIssue(); issue != nil {
-				// Only count if not already reviewed
-				wasUnreviewed := issue.ReviewStatus == "" || issue.ReviewStatus == model.ReviewStatusUnreviewed
-				issue.ReviewStatus = model.ReviewStatusApproved
-				issue.ReviewedBy = m.reviewer
-				issue.ReviewedAt = time.Now()
-				if wasUnreviewed {
-					m.itemsReviewed++
-					m.itemsApproved++
-				}
-				// Record for persistence
-				m.collector.Record(issue.ID, model.ReviewStatusApproved, "")
+			if m.hasSelection() {
+				m.reviewSelection(m.selectedIssues(), "approve", model.ReviewStatusApproved, "")
+			} else if issue := m.SelectedIssue(); issue != nil {
+				before := m.captureReview(issue)
+				m.setReview(issue, model.ReviewStatusApproved, "")
+				m.pushUndo(issue, "approve", before)
 			}
 		case "r":
 			// Request revision - opens note modal
+			if m.hasSelection() {
+				return m, m.openBulkNote("revision")
+			}
 			if issue := m.SelectedIssue(); issue != nil {
-				m.noteInput = NewNoteInputModel(issue.Title, "revision", issue.ID, m.theme)
-				m.noteInput.SetSize(m.width, m.height)
-				m.showNoteInput = true
-				return m, m.noteInput.Init()
+				return m, m.openNote(issue.Title, "revision", issue.ID)
 			}
 		case "d":
 			// Defer - opens note modal
+			if m.hasSelection() {
+				return m, m.openBulkNote("defer")
+			}
 			if issue := m.SelectedIssue(); issue != nil {
-				m.noteInput = NewNoteInputModel(issue.Title, "defer", issue.ID, m.theme)
-				m.noteInput.SetSize(m.width, m.height)
-				m.showNoteInput = true
-				return m, m.noteInput.Init()
+				return m, m.openNote(issue.Title, "defer", issue.ID)
 			}
 		case "u":
+			// Undo the most recent review action
+			m.undoReview()
+		case "ctrl+r":
+			// Redo the most recently undone action
+			m.redoReview()
+		case "U":
 			// Unapprove - reset review status to unreviewed
-			if issue := m.SelectedIssue(); issue != nil {
-				// Only count if it was previously reviewed
-				wasReviewed := issue.ReviewStatus != "" && issue.ReviewStatus != model.ReviewStatusUnreviewed
-				if wasReviewed {
-					// Decrement the appropriate counter
-					switch issue.ReviewStatus {
-					case model.ReviewStatusApproved:
-						m.itemsApproved--
-					case model.ReviewStatusNeedsRevision:
-						m.itemsNeedsRevision--
-					case model.ReviewStatusDeferred:
-						m.itemsDeferred--
-					}
-					m.itemsReviewed--
-				}
-				issue.ReviewStatus = model.ReviewStatusUnreviewed
-				issue.ReviewedBy = ""
-				issue.ReviewedAt = time.Time{}
-				// Clear review notes
-				delete(m.reviewNotes, issue.ID)
-				// Record for persistence (empty status = unreviewed)
-				m.collector.Record(issue.ID, model.ReviewStatusUnreviewed, "")
+			if m.hasSelection() {
+				m.reviewSelection(m.selectedIssues(), "reset", model.ReviewStatusUnreviewed, "")
+			} else if issue := m.SelectedIssue(); issue != nil {
+				before := m.captureReview(issue)
+				m.resetReview(issue)
+				m.pushUndo(issue, "reset", before)
 			}
 		case "?":
 			m.showHelp = true
+		case "T":
+			m.showTeam = true
 		case "/":
 			m.showSearch = true
 			m.searchQuery = ""
@@ -626,7 +698,22 @@ func (m *ReviewDashboardModel) Update(msg tea.Msg) (*ReviewDashboardModel, tea.C
 				m.assigneeInput = issue.Assignee // Pre-fill with current assignee
 				m.showAssigneeInput = true
 			}
+		case "c":
+			// Show what changed in the text fields, per commit
+			m.showChanges = !m.showChanges
+		case "w":
+			// Save pending reviews and keep going
+			if m.collector.Count() == 0 {
+				m.undoMsg = "No reviews to save"
+			} else {
+				m.saveRequested = true
+			}
 		case "q", "esc":
+			// Esc drops a selection before it leaves the review
+			if msg.String() == "esc" && m.hasSelection() {
+				m.clearSelection()
+				return m, nil
+			}
 			// Only show summary if there are pending review actions
 			if m.collector.Count() > 0 {
 				m.showSummary = true
@@ -636,8 +723,158 @@ func (m *ReviewDashboardModel) Update(msg tea.Msg) (*ReviewDashboardModel, tea.C
 				return m, tea.Quit
 			}
 		}
+	case tea.MouseMsg:
+		m.handleMouse(msg)
+	}
+	return m, m.fieldChangesCmd()
+}
+
+// setReview gives issue status with note, and records it for saving. The
+// note is kept for display on revisions and deferrals. The session counters
+// only count an issue the first time it is reviewed.
+func (m *ReviewDashboardModel) setReview(issue *model.Issue, status, note string) {
+	wasUnreviewed := issue.ReviewStatus == "" || issue.ReviewStatus == model.ReviewStatusUnreviewed
+	if note != "" && status != model.ReviewStatusApproved {
+		m.reviewNotes[issue.ID] = note
+	}
+	issue.ReviewStatus = status
+	issue.ReviewedBy = m.reviewer
+	issue.ReviewedAt = time.Now()
+	if wasUnreviewed {
+		m.itemsReviewed++
+		switch status {
+		case model.ReviewStatusApproved:
+			m.itemsApproved++
+		case model.ReviewStatusNeedsRevision:
+			m.itemsNeedsRevision++
+		case model.ReviewStatusDeferred:
+			m.itemsDeferred++
+		}
+	}
+	// Record for persistence
+	m.collector.Record(issue.ID, status, note)
+	if status == model.ReviewStatusApproved {
+		m.collector.SetContent(issue.ID, review.ContentFingerprint(issue))
+	}
+}
+
+// resetReview returns issue to unreviewed, drops its review notes and
+// records the reset for saving.
+func (m *ReviewDashboardModel) resetReview(issue *model.Issue) {
+	// Only count if it was previously reviewed
+	if issue.ReviewStatus != "" && issue.ReviewStatus != model.ReviewStatusUnreviewed {
+		// Decrement the appropriate counter
+		switch issue.ReviewStatus {
+		case model.ReviewStatusApproved:
+			m.itemsApproved--
+		case model.ReviewStatusNeedsRevision:
+			m.itemsNeedsRevision--
+		case model.ReviewStatusDeferred:
+			m.itemsDeferred--
+		}
+		m.itemsReviewed--
+	}
+	issue.ReviewStatus = model.ReviewStatusUnreviewed
+	issue.ReviewedBy = ""
+	issue.ReviewedAt = time.Time{}
+	delete(m.reviewNotes, issue.ID)
+	// Record for persistence (empty status = unreviewed)
+	m.collector.Record(issue.ID, model.ReviewStatusUnreviewed, "")
+}
+
+// openBulkNote opens the note modal for action ("revision" or "defer") over
+// the selection; the one note applies to every selected issue.
+func (m *ReviewDashboardModel) openBulkNote(action string) tea.Cmd {
+	m.noteTargets = m.selectedIssues()
+	what := fmt.Sprintf("%d selected issues", len(m.noteTargets))
+	return m.openNote(what, action, what)
+}
+
+// openNote opens the note modal with the review templates on offer
+func (m *ReviewDashboardModel) openNote(title, action, issueID string) tea.Cmd {
+	m.noteInput = NewNoteInputModel(title, action, issueID, m.theme)
+	m.noteInput.SetTemplates(m.noteTemplates)
+	m.noteInput.SetSize(m.width, m.height)
+	m.showNoteInput = true
+	return m.noteInput.Init()
+}
+
+// SetNoteTemplates replaces the canned notes the note modal offers; an empty
+// list keeps the built-in ones
+func (m *ReviewDashboardModel) SetNoteTemplates(templates []string) {
+	if len(templates) > 0 {
+		m.noteTemplates = templates
+	}
+}
+
+// SetReadOnly refuses to save reviews, for when bd is not installed. They
+// stay in the session file, if any, for bv review --resume.
+func (m *ReviewDashboardModel) SetReadOnly(readOnly bool) {
+	m.readOnly = readOnly
+}
+
+// SetDryRun records the dashboard's review saves and session file in d
+// instead of writing them; nil writes them
+func (m *ReviewDashboardModel) SetDryRun(d *loader.DryRun) {
+	m.dryRun = d
+}
+
+// handleMouse scrolls whichever panel is under the pointer and selects the
+// clicked tree row; clicking the detail panel focuses it. Ignored while a
+// modal is open.
+func (m *ReviewDashboardModel) handleMouse(msg tea.MouseMsg) {
+	if m.HasActiveModal() {
+		return
+	}
+
+	// Tree rows start below title, progress and separator (split) or
+	// title, progress and a blank line (single column)
+	top := 3
+	visible := m.height - 6
+	inDetail := false
+	if m.width >= BreakpointMedium {
+		leftWidth := (m.width * 45) / 100
+		inDetail = msg.X >= leftWidth
+		if m.showSearch {
+			top++
+		}
+		visible = max(m.height-5-(top-3), 5)
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		if inDetail {
+			m.detailScroll = max(m.detailScroll-1, 0)
+		} else if m.cursor > 0 {
+			m.cursor--
+			m.ensureVisible()
+			m.detailScroll = 0
+		}
+	case tea.MouseButtonWheelDown:
+		if inDetail {
+			m.detailScroll++
+		} else if m.cursor < len(m.flatNodes)-1 {
+			m.cursor++
+			m.ensureVisible()
+			m.detailScroll = 0
+		}
+	case tea.MouseButtonLeft:
+		if msg.Action != tea.MouseActionPress {
+			return
+		}
+		m.detailFocus = inDetail
+		if inDetail {
+			return
+		}
+		idx := m.scroll + msg.Y - top
+		if msg.Y < top || msg.Y >= top+visible || idx >= len(m.flatNodes) {
+			return
+		}
+		if idx != m.cursor {
+			m.cursor = idx
+			m.detailScroll = 0
+		}
 	}
-	return m, nil
 }
 
 // cycleFilter cycles through filter options
@@ -648,6 +885,8 @@ func (m *ReviewDashboardModel) cycleFilter() {
 	case "unreviewed":
 		m.showFilter = "needs_revision"
 	case "needs_revision":
+		m.showFilter = "changed"
+	case "changed":
 		m.showFilter = "all"
 	}
 	m.rebuildFlatNodes()
@@ -701,6 +940,19 @@ func (m *ReviewDashboardModel) jumpToPrevUnreviewed() {
 	}
 }
 
+// SelectIssue moves the cursor to id, reporting whether the current filters
+// show it
+func (m *ReviewDashboardModel) SelectIssue(id string) bool {
+	for i, node := range m.flatNodes {
+		if node.Issue.ID == id {
+			m.cursor = i
+			m.ensureVisible()
+			return true
+		}
+	}
+	return false
+}
+
 // isUnreviewed returns true if the issue is unreviewed
 func (m *ReviewDashboardModel) isUnreviewed(issue *model.Issue) bool {
 	return issue.ReviewStatus == "" || issue.ReviewStatus == model.ReviewStatusUnreviewed
@@ -745,6 +997,9 @@ func (m *ReviewDashboardModel) View() string {
 	if m.showHelp {
 		return m.renderHelp()
 	}
+	if m.showTeam {
+		return m.renderTeamSummary()
+	}
 	// Show session summary if quitting
 	if m.showSummary {
 		return m.renderSummary()
@@ -768,6 +1023,9 @@ func (m *ReviewDashboardModel) View() string {
 	if m.showLabelInput {
 		return m.renderModalOverlay(base, m.renderLabelInput())
 	}
+	if m.showLabelEdit {
+		return m.renderModalOverlay(base, m.renderLabelEdit())
+	}
 
 	return base
 }
@@ -781,11 +1039,17 @@ func (m *ReviewDashboardModel) renderSummary() string {
 	// Header
 	headerStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
 	b.WriteString(headerStyle.Render("Review Session Summary") + "\n")
-	b.WriteString(strings.Repeat("─", 40) + "\n\n")
+	b.WriteString(strings.Repeat(m.theme.Glyph("─"), 40) + "\n\n")
 
 	// Session info
 	infoStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
-	b.WriteString(infoStyle.Render(fmt.Sprintf("Root:     %s", m.tree.Root.ID)) + "\n")
+	if m.tree.Scope != nil {
+		b.WriteString(infoStyle.Render(fmt.Sprintf("Lens:     %s (%d issues)", m.tree.Label, len(m.tree.Scope))) + "\n")
+	} else if m.tree.Label != "" {
+		b.WriteString(infoStyle.Render(fmt.Sprintf("Label:    %s", m.tree.Label)) + "\n")
+	} else {
+		b.WriteString(infoStyle.Render(fmt.Sprintf("Root:     %s", m.theme.ID(m.tree.Root.ID))) + "\n")
+	}
 	b.WriteString(infoStyle.Render(fmt.Sprintf("Reviewer: %s", m.reviewer)) + "\n")
 	b.WriteString(infoStyle.Render(fmt.Sprintf("Duration: %s", duration)) + "\n\n")
 
@@ -798,7 +1062,7 @@ func (m *ReviewDashboardModel) renderSummary() string {
 	deferredStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
 
 	b.WriteString(fmt.Sprintf("  Total:          %d\n", m.itemsReviewed))
-	b.WriteString(approvedStyle.Render(fmt.Sprintf("  ✓ Approved:     %d", m.itemsApproved)) + "\n")
+	b.WriteString(approvedStyle.Render(fmt.Sprintf(m.theme.Glyph("  ✓ Approved:     %d"), m.itemsApproved)) + "\n")
 	b.WriteString(revisionStyle.Render(fmt.Sprintf("  ! Needs Revision: %d", m.itemsNeedsRevision)) + "\n")
 	b.WriteString(deferredStyle.Render(fmt.Sprintf("  ? Deferred:     %d", m.itemsDeferred)) + "\n\n")
 
@@ -820,13 +1084,27 @@ func (m *ReviewDashboardModel) renderSummary() string {
 	// Copy feedback
 	if m.promptCopied && time.Since(m.promptCopiedAt) < 2*time.Second {
 		copiedStyle := t.Renderer.NewStyle().Foreground(t.Open).Bold(true)
-		b.WriteString(copiedStyle.Render("✓ Copied to clipboard!") + "\n\n")
+		b.WriteString(copiedStyle.Render(m.theme.Glyph("✓ Copied to clipboard!")) + "\n\n")
+	}
+
+	// Without bd nothing can be saved: say where the reviews go before quitting
+	saveHint := " save & quit  "
+	if m.readOnly {
+		warnStyle := t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true)
+		warning := "bd not installed: reviews can't be saved. "
+		if m.sessionPath != "" {
+			warning += "Quitting keeps them for bv review --resume."
+		} else {
+			warning += "Quitting loses them."
+		}
+		b.WriteString(warnStyle.Render(warning) + "\n\n")
+		saveHint = " quit  "
 	}
 
 	// Hints
 	hintStyle := t.Renderer.NewStyle().Faint(true)
 	keyStyle := t.Renderer.NewStyle().Foreground(t.Primary)
-	b.WriteString(keyStyle.Render("q") + hintStyle.Render(" save & quit  "))
+	b.WriteString(keyStyle.Render("q") + hintStyle.Render(saveHint))
 	b.WriteString(keyStyle.Render("Q") + hintStyle.Render(" discard & quit\n"))
 	b.WriteString(keyStyle.Render("p") + hintStyle.Render(" copy ID list  "))
 	b.WriteString(keyStyle.Render("P") + hintStyle.Render(" copy AI prompt\n"))
@@ -834,7 +1112,7 @@ func (m *ReviewDashboardModel) renderSummary() string {
 
 	// Wrap in centered box
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2).
 		Width(55)
@@ -857,15 +1135,15 @@ func (m *ReviewDashboardModel) renderHelp() string {
 
 	var b strings.Builder
 	b.WriteString(titleStyle.Render("Review Dashboard Help") + "\n")
-	b.WriteString(strings.Repeat("─", width-4) + "\n\n")
+	b.WriteString(strings.Repeat(m.theme.Glyph("─"), width-4) + "\n\n")
 
 	// Navigation
 	b.WriteString(sectionStyle.Render("Navigation") + "\n")
-	b.WriteString(keyStyle.Render("  j/k, ↑/↓") + descStyle.Render("   Move cursor / scroll detail") + "\n")
+	b.WriteString(keyStyle.Render(m.theme.Glyph("  j/k, ↑/↓")) + descStyle.Render("   Move cursor / scroll detail") + "\n")
 	b.WriteString(keyStyle.Render("  g/G") + descStyle.Render("        Go to first/last item") + "\n")
 	b.WriteString(keyStyle.Render("  Ctrl+u/d") + descStyle.Render("   Page up/down (half page)") + "\n")
 	b.WriteString(keyStyle.Render("  [/]") + descStyle.Render("        Jump to prev/next unreviewed") + "\n")
-	b.WriteString(keyStyle.Render("  Tab") + descStyle.Render("        Switch focus: tree ↔ detail") + "\n")
+	b.WriteString(keyStyle.Render("  Tab") + descStyle.Render(m.theme.Glyph("        Switch focus: tree ↔ detail")) + "\n")
 	b.WriteString(keyStyle.Render("  /") + descStyle.Render("          Search issues") + "\n\n")
 
 	// Review Actions
@@ -873,18 +1151,34 @@ func (m *ReviewDashboardModel) renderHelp() string {
 	b.WriteString(keyStyle.Render("  a") + descStyle.Render("          Approve current item") + "\n")
 	b.WriteString(keyStyle.Render("  r") + descStyle.Render("          Request revision (+ note)") + "\n")
 	b.WriteString(keyStyle.Render("  d") + descStyle.Render("          Defer review (+ note)") + "\n")
-	b.WriteString(keyStyle.Render("  u") + descStyle.Render("          Unapprove (reset to unreviewed)") + "\n")
+	b.WriteString(keyStyle.Render("  u") + descStyle.Render("          Undo last approve/revise/defer") + "\n")
+	b.WriteString(keyStyle.Render("  Ctrl+r") + descStyle.Render("     Redo") + "\n")
+	b.WriteString(keyStyle.Render("  U") + descStyle.Render("          Unapprove (reset to unreviewed)") + "\n")
 	b.WriteString(keyStyle.Render("  n") + descStyle.Render("          Add note (no status change)") + "\n")
+	b.WriteString(keyStyle.Render("  Alt+1-9") + descStyle.Render("    Insert a note template (in note box)") + "\n")
 	b.WriteString(keyStyle.Render("  A") + descStyle.Render("          Assign to reviewer") + "\n\n")
 
+	// Bulk Selection
+	b.WriteString(sectionStyle.Render("Bulk Selection") + "\n")
+	b.WriteString(keyStyle.Render("  Space") + descStyle.Render("      Mark/unmark current item") + "\n")
+	b.WriteString(keyStyle.Render("  V") + descStyle.Render("          Start/end visual range") + "\n")
+	b.WriteString(keyStyle.Render("  +") + descStyle.Render("          Mark current subtree") + "\n")
+	b.WriteString(keyStyle.Render("  *") + descStyle.Render("          Mark everything shown") + "\n")
+	b.WriteString(keyStyle.Render("  l/L") + descStyle.Render("        Add/remove a label") + "\n")
+	b.WriteString(descStyle.Render("  a/r/d/U/l/L act on every marked item; Esc clears") + "\n\n")
+
 	// Filters
 	b.WriteString(sectionStyle.Render("Filters") + "\n")
-	b.WriteString(keyStyle.Render("  f") + descStyle.Render("          Cycle: all → unreviewed → needs_revision") + "\n")
+	b.WriteString(keyStyle.Render("  f") + descStyle.Render(m.theme.Glyph("          Cycle: all → unreviewed → needs_revision → changed")) + "\n")
 	b.WriteString(keyStyle.Render("  s") + descStyle.Render("          Add scope filter") + "\n")
 	b.WriteString(keyStyle.Render("  S") + descStyle.Render("          Clear all scope filters") + "\n\n")
 
 	// Other
 	b.WriteString(sectionStyle.Render("Other") + "\n")
+	b.WriteString(keyStyle.Render("  w") + descStyle.Render("          Save reviews, keep reviewing") + "\n")
+	b.WriteString(keyStyle.Render("  y") + descStyle.Render("          Copy issue context for an agent") + "\n")
+	b.WriteString(keyStyle.Render("  c") + descStyle.Render("          Show field changes from git history") + "\n")
+	b.WriteString(keyStyle.Render("  T") + descStyle.Render("          Progress per reviewer") + "\n")
 	b.WriteString(keyStyle.Render("  ?") + descStyle.Render("          Show this help") + "\n")
 	b.WriteString(keyStyle.Render("  q") + descStyle.Render("          Show summary / quit") + "\n")
 	b.WriteString(keyStyle.Render("  Esc") + descStyle.Render("        Close modal / cancel") + "\n\n")
@@ -894,7 +1188,7 @@ func (m *ReviewDashboardModel) renderHelp() string {
 
 	// Wrap in box
 	boxStyle := m.theme.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 		BorderForeground(m.theme.Primary).
 		Padding(1, 2).
 		Width(width)
@@ -909,9 +1203,6 @@ func (m *ReviewDashboardModel) renderModalOverlay(base, modal string) string {
 	modalWidth := lipgloss.Width(modal)
 	modalHeight := lipgloss.Height(modal)
 
-	baseLines := strings.Split(base, "\n")
-	modalLines := strings.Split(modal, "\n")
-
 	// Calculate centered position
 	startRow := (m.height - modalHeight) / 2
 	startCol := (m.width - modalWidth) / 2
@@ -922,6 +1213,15 @@ func (m *ReviewDashboardModel) renderModalOverlay(base, modal string) string {
 		startCol = 0
 	}
 
+	return overlayAt(base, modal, startRow, startCol)
+}
+
+// overlayAt draws overlay onto base with its top-left corner at (startRow,
+// startCol), keeping the base content to the left and right of each line
+func overlayAt(base, overlay string, startRow, startCol int) string {
+	baseLines := strings.Split(base, "\n")
+	modalLines := strings.Split(overlay, "\n")
+
 	// Overlay modal onto base, preserving left and right portions
 	for i, modalLine := range modalLines {
 		row := startRow + i
@@ -1022,11 +1322,11 @@ func (m *ReviewDashboardModel) renderAssigneeInput() string {
 	var b strings.Builder
 	b.WriteString(titleStyle.Render("Assign "+issueID) + "\n\n")
 	b.WriteString(labelStyle.Render("Assignee:") + "\n")
-	b.WriteString(inputStyle.Render(m.assigneeInput+"█") + "\n\n")
+	b.WriteString(inputStyle.Render(m.assigneeInput+m.theme.Glyph("█")) + "\n\n")
 	b.WriteString(hintStyle.Render("[Enter] Save  [Esc] Cancel"))
 
 	boxStyle := m.theme.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 		BorderForeground(m.theme.Primary).
 		Padding(1, 3).
 		Width(40)
@@ -1058,11 +1358,11 @@ func (m *ReviewDashboardModel) renderLabelInput() string {
 	}
 
 	b.WriteString(labelStyle.Render("Label:") + "\n")
-	b.WriteString(inputStyle.Render(m.labelInput+"█") + "\n\n")
+	b.WriteString(inputStyle.Render(m.labelInput+m.theme.Glyph("█")) + "\n\n")
 	b.WriteString(hintStyle.Render("[Enter] Add  [Esc] Cancel  [Backspace] Remove last  [S] Clear all"))
 
 	boxStyle := m.theme.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(m.theme.BorderOf(lipgloss.RoundedBorder())).
 		BorderForeground(m.theme.Primary).
 		Padding(1, 3).
 		Width(45)
@@ -1082,7 +1382,7 @@ func (m *ReviewDashboardModel) renderDetailPanel() string {
 	// Header: ID and Title
 	headerStyle := m.theme.Renderer.NewStyle().Bold(true).Foreground(m.theme.Primary)
 	b.WriteString(headerStyle.Render(issue.ID+": "+issue.Title) + "\n")
-	b.WriteString(strings.Repeat("─", 40) + "\n")
+	b.WriteString(strings.Repeat(m.theme.Glyph("─"), 40) + "\n")
 
 	// Status line
 	statusStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
@@ -1119,6 +1419,11 @@ func (m *ReviewDashboardModel) renderDetailPanel() string {
 	}
 	b.WriteString(reviewStyle.Render(reviewLine) + "\n")
 
+	// Review history across sessions
+	if timeline := m.reviewTimelineLines(issue, m.width); len(timeline) > 0 {
+		b.WriteString(strings.Join(timeline, "\n") + "\n")
+	}
+
 	// Labels section
 	if len(issue.Labels) > 0 {
 		tagStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Secondary)
@@ -1198,7 +1503,7 @@ func (m *ReviewDashboardModel) renderSplitView() string {
 	if len(title) > maxTitleLen {
 		title = title[:maxTitleLen-3] + "..."
 	}
-	output.WriteString(titleStyle.Render("◆ " + title) + "\n")
+	output.WriteString(titleStyle.Render(m.theme.Glyph("◆ ") + title) + "\n")
 
 	// Progress bar and stats
 	total := len(m.flatNodes)
@@ -1216,7 +1521,7 @@ func (m *ReviewDashboardModel) renderSplitView() string {
 	// Visual progress bar
 	barWidth := 20
 	filled := (pct * barWidth) / 100
-	progressBar := strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled)
+	progressBar := strings.Repeat(m.theme.Glyph("█"), filled) + strings.Repeat(m.theme.Glyph("░"), barWidth-filled)
 
 	progressStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Open)
 	statsStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
@@ -1227,7 +1532,7 @@ func (m *ReviewDashboardModel) renderSplitView() string {
 	// Filter indicator
 	if m.showFilter != "all" {
 		filterStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Secondary)
-		output.WriteString(filterStyle.Render("  ◇ " + m.showFilter))
+		output.WriteString(filterStyle.Render(m.theme.Glyph("  ◇ ") + m.showFilter))
 	}
 
 	// Active labels
@@ -1235,14 +1540,14 @@ func (m *ReviewDashboardModel) renderSplitView() string {
 		tagStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Secondary)
 		output.WriteString("  ")
 		for _, l := range m.activeLabels {
-			output.WriteString(tagStyle.Render("⬡ "+l) + " ")
+			output.WriteString(tagStyle.Render(m.theme.Glyph("⬡ ")+l) + " ")
 		}
 	}
 	output.WriteString("\n")
 
 	// Separator
 	sepStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Border)
-	output.WriteString(sepStyle.Render(strings.Repeat("─", m.width)) + "\n")
+	output.WriteString(sepStyle.Render(strings.Repeat(m.theme.Glyph("─"), m.width)) + "\n")
 
 	// ══════════════════════════════════════════════════════════════════
 	// SEARCH BAR (if active)
@@ -1250,7 +1555,7 @@ func (m *ReviewDashboardModel) renderSplitView() string {
 	if m.showSearch {
 		searchStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Primary)
 		queryStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Secondary)
-		output.WriteString(searchStyle.Render(" / ") + queryStyle.Render(m.searchQuery+"█") + "\n")
+		output.WriteString(searchStyle.Render(" / ") + queryStyle.Render(m.searchQuery+m.theme.Glyph("█")) + "\n")
 	}
 
 	// ══════════════════════════════════════════════════════════════════
@@ -1263,7 +1568,7 @@ func (m *ReviewDashboardModel) renderSplitView() string {
 	leftLines := strings.Split(leftPanel, "\n")
 	rightLines := strings.Split(rightPanel, "\n")
 
-	dividerChar := "│"
+	dividerChar := m.theme.Glyph("│")
 	dividerStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Border)
 	if m.detailFocus {
 		dividerStyle = m.theme.Renderer.NewStyle().Foreground(m.theme.Primary)
@@ -1294,7 +1599,7 @@ func (m *ReviewDashboardModel) renderSplitView() string {
 	// ══════════════════════════════════════════════════════════════════
 	// FOOTER
 	// ══════════════════════════════════════════════════════════════════
-	output.WriteString(sepStyle.Render(strings.Repeat("─", m.width)) + "\n")
+	output.WriteString(sepStyle.Render(strings.Repeat(m.theme.Glyph("─"), m.width)) + "\n")
 
 	// Keybinds - elegant and concise
 	keyStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Primary)
@@ -1306,15 +1611,22 @@ func (m *ReviewDashboardModel) renderSplitView() string {
 		focusIndicator = "detail"
 	}
 
-	output.WriteString(focusStyle.Render("◆"+focusIndicator) + " ")
+	output.WriteString(focusStyle.Render(m.theme.Glyph("◆")+focusIndicator) + " ")
 	output.WriteString(keyStyle.Render("j/k") + hintStyle.Render(" nav "))
 	output.WriteString(keyStyle.Render("[/]") + hintStyle.Render(" jump "))
 	output.WriteString(keyStyle.Render("a") + hintStyle.Render("pprove "))
 	output.WriteString(keyStyle.Render("r") + hintStyle.Render("evise "))
 	output.WriteString(keyStyle.Render("d") + hintStyle.Render("efer "))
-	output.WriteString(keyStyle.Render("u") + hintStyle.Render("napprove "))
+	output.WriteString(keyStyle.Render("u") + hintStyle.Render("ndo "))
+	output.WriteString(keyStyle.Render("w") + hintStyle.Render("rite "))
 	output.WriteString(keyStyle.Render("?") + hintStyle.Render("help "))
 	output.WriteString(keyStyle.Render("q") + hintStyle.Render("uit"))
+	if hint := m.selectionHint(); hint != "" {
+		output.WriteString("  " + focusStyle.Render(hint))
+	}
+	if m.undoMsg != "" {
+		output.WriteString("  " + focusStyle.Render(m.undoMsg))
+	}
 
 	return output.String()
 }
@@ -1332,13 +1644,8 @@ func (m *ReviewDashboardModel) renderTreePanelFixed(width, height int) string {
 		node := m.flatNodes[i]
 		var line strings.Builder
 
-		// Cursor indicator
-		if i == m.cursor {
-			cursorStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Primary)
-			line.WriteString(cursorStyle.Render("▸ "))
-		} else {
-			line.WriteString("  ")
-		}
+		// Cursor and selection mark
+		line.WriteString(m.renderGutter(i))
 
 		// Review status indicator
 		var statusIndicator string
@@ -1346,7 +1653,7 @@ func (m *ReviewDashboardModel) renderTreePanelFixed(width, height int) string {
 		switch node.Issue.ReviewStatus {
 		case model.ReviewStatusApproved:
 			statusStyle = m.theme.Renderer.NewStyle().Foreground(m.theme.Open)
-			statusIndicator = "✓"
+			statusIndicator = m.theme.Glyph("✓")
 		case model.ReviewStatusNeedsRevision:
 			statusStyle = m.theme.Renderer.NewStyle().Foreground(m.theme.Blocked)
 			statusIndicator = "!"
@@ -1355,13 +1662,13 @@ func (m *ReviewDashboardModel) renderTreePanelFixed(width, height int) string {
 			statusIndicator = "?"
 		default:
 			statusStyle = m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext).Faint(true)
-			statusIndicator = "○"
+			statusIndicator = m.theme.Glyph("○")
 		}
 		line.WriteString(statusStyle.Render(statusIndicator) + " ")
 
 		// Tree prefix (indentation)
 		if node.TreePrefix != "" {
-			prefixStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Border)
+			prefixStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
 			line.WriteString(prefixStyle.Render(node.TreePrefix))
 		}
 
@@ -1370,7 +1677,12 @@ func (m *ReviewDashboardModel) renderTreePanelFixed(width, height int) string {
 		if i == m.cursor {
 			idStyle = idStyle.Bold(true)
 		}
-		line.WriteString(idStyle.Render(node.Issue.ID) + " ")
+		line.WriteString(idStyle.Render(m.theme.ID(node.Issue.ID)) + " ")
+
+		// Approved, then edited
+		if len(m.changedSinceApproval(node.Issue)) > 0 {
+			line.WriteString(m.theme.Renderer.NewStyle().Foreground(ColorWarning).Render(m.theme.Glyph("Δ changed")) + " ")
+		}
 
 		// Title - truncate to fit
 		titleStyle := m.theme.Renderer.NewStyle()
@@ -1389,7 +1701,7 @@ func (m *ReviewDashboardModel) renderTreePanelFixed(width, height int) string {
 
 		title := node.Issue.Title
 		if len(title) > titleWidth {
-			title = title[:titleWidth-1] + "…"
+			title = title[:titleWidth-1] + m.theme.Glyph("…")
 		}
 		line.WriteString(titleStyle.Render(title))
 
@@ -1415,8 +1727,8 @@ func (m *ReviewDashboardModel) renderDetailPanelFixed(width, height int) string
 
 	// Header
 	headerStyle := m.theme.Renderer.NewStyle().Bold(true).Foreground(m.theme.Primary)
-	lines = append(lines, headerStyle.Render(issue.ID))
-	lines = append(lines, strings.Repeat("─", width-2))
+	lines = append(lines, headerStyle.Render(m.theme.ID(issue.ID)))
+	lines = append(lines, strings.Repeat(m.theme.Glyph("─"), width-2))
 
 	// Title (may wrap)
 	titleLines := wrapTextLines(issue.Title, width-2)
@@ -1448,22 +1760,29 @@ func (m *ReviewDashboardModel) renderDetailPanelFixed(width, height int) string
 		reviewStyle = m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
 	}
 	lines = append(lines, reviewStyle.Render("Review: "+strings.ToUpper(reviewStatus)))
-
-	// Review notes (from comment-based persistence)
-	if notes, ok := m.reviewNotes[issue.ID]; ok && notes != "" {
-		notesStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext).Italic(true)
-		notesLines := wrapTextLines(notes, width-4)
-		for _, nl := range notesLines {
-			lines = append(lines, notesStyle.Render("  "+nl))
-		}
+	if changed := m.changedSinceApproval(issue); len(changed) > 0 {
+		changedStyle := m.theme.Renderer.NewStyle().Foreground(ColorWarning)
+		lines = append(lines, changedStyle.Render(m.theme.Glyph("Δ Changed since approval: ")+strings.Join(changed, ", ")))
 	}
 	lines = append(lines, "")
 
+	// Review history across sessions, with notes
+	if timeline := m.reviewTimelineLines(issue, width); len(timeline) > 0 {
+		lines = append(lines, timeline...)
+		lines = append(lines, "")
+	}
+
+	// Field edits from git history
+	if m.showChanges {
+		lines = append(lines, m.fieldChangeLines(issue, width)...)
+		lines = append(lines, "")
+	}
+
 	// Description
 	if issue.Description != "" {
 		sectionStyle := m.theme.Renderer.NewStyle().Bold(true)
 		lines = append(lines, sectionStyle.Render("Description:"))
-		descLines := wrapTextLines(issue.Description, width-2)
+		descLines := m.markdownLines(issue.Description, width-2)
 		lines = append(lines, descLines...)
 		lines = append(lines, "")
 	}
@@ -1472,7 +1791,7 @@ func (m *ReviewDashboardModel) renderDetailPanelFixed(width, height int) string
 	if issue.Design != "" {
 		sectionStyle := m.theme.Renderer.NewStyle().Bold(true)
 		lines = append(lines, sectionStyle.Render("Design:"))
-		designLines := wrapTextLines(issue.Design, width-2)
+		designLines := m.markdownLines(issue.Design, width-2)
 		lines = append(lines, designLines...)
 		lines = append(lines, "")
 	}
@@ -1481,7 +1800,7 @@ func (m *ReviewDashboardModel) renderDetailPanelFixed(width, height int) string
 	if issue.AcceptanceCriteria != "" {
 		sectionStyle := m.theme.Renderer.NewStyle().Bold(true)
 		lines = append(lines, sectionStyle.Render("Acceptance:"))
-		acLines := wrapTextLines(issue.AcceptanceCriteria, width-2)
+		acLines := m.markdownLines(issue.AcceptanceCriteria, width-2)
 		lines = append(lines, acLines...)
 		lines = append(lines, "")
 	}
@@ -1490,7 +1809,7 @@ func (m *ReviewDashboardModel) renderDetailPanelFixed(width, height int) string
 	if issue.Notes != "" {
 		sectionStyle := m.theme.Renderer.NewStyle().Bold(true)
 		lines = append(lines, sectionStyle.Render("Notes:"))
-		noteLines := wrapTextLines(issue.Notes, width-2)
+		noteLines := m.markdownLines(issue.Notes, width-2)
 		lines = append(lines, noteLines...)
 	}
 
@@ -1518,6 +1837,15 @@ func (m *ReviewDashboardModel) renderDetailPanelFixed(width, height int) string
 	return strings.Join(visibleLines, "\n")
 }
 
+// markdownLines renders issue text as markdown wrapped to width, one
+// entry per line
+func (m *ReviewDashboardModel) markdownLines(text string, width int) []string {
+	if m.markdown == nil {
+		m.markdown = NewMarkdownRendererWithTheme(width, m.theme)
+	}
+	return strings.Split(m.markdown.RenderPanel(text, width), "\n")
+}
+
 // wrapTextLines wraps text to fit within width, returning slice of lines
 func wrapTextLines(text string, width int) []string {
 	if width <= 0 {
@@ -1569,13 +1897,8 @@ func (m *ReviewDashboardModel) renderTreePanel() string {
 		node := m.flatNodes[i]
 		var line strings.Builder
 
-		// Cursor indicator
-		if i == m.cursor {
-			cursorStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Primary)
-			line.WriteString(cursorStyle.Render("▸ "))
-		} else {
-			line.WriteString("  ")
-		}
+		// Cursor and selection mark
+		line.WriteString(m.renderGutter(i))
 
 		// Review status indicator
 		var statusIndicator string
@@ -1583,7 +1906,7 @@ func (m *ReviewDashboardModel) renderTreePanel() string {
 		switch node.Issue.ReviewStatus {
 		case model.ReviewStatusApproved:
 			statusStyle = m.theme.Renderer.NewStyle().Foreground(m.theme.Open)
-			statusIndicator = "[✓]"
+			statusIndicator = m.theme.Glyph("[✓]")
 		case model.ReviewStatusNeedsRevision:
 			statusStyle = m.theme.Renderer.NewStyle().Foreground(m.theme.Blocked)
 			statusIndicator = "[!]"
@@ -1607,7 +1930,10 @@ func (m *ReviewDashboardModel) renderTreePanel() string {
 		if i == m.cursor {
 			idStyle = idStyle.Bold(true)
 		}
-		line.WriteString(idStyle.Render(node.Issue.ID))
+		line.WriteString(idStyle.Render(m.theme.ID(node.Issue.ID)))
+		if len(m.changedSinceApproval(node.Issue)) > 0 {
+			line.WriteString(" " + m.theme.Renderer.NewStyle().Foreground(ColorWarning).Render(m.theme.Glyph("Δ changed")))
+		}
 
 		b.WriteString(line.String() + "\n")
 	}
@@ -1683,13 +2009,8 @@ func (m *ReviewDashboardModel) renderBaseView() string {
 		node := m.flatNodes[i]
 		var line strings.Builder
 
-		// Cursor indicator
-		if i == m.cursor {
-			cursorStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Primary)
-			line.WriteString(cursorStyle.Render("▸ "))
-		} else {
-			line.WriteString("  ")
-		}
+		// Cursor and selection mark
+		line.WriteString(m.renderGutter(i))
 
 		// Review status indicator with color
 		var statusIndicator string
@@ -1697,7 +2018,7 @@ func (m *ReviewDashboardModel) renderBaseView() string {
 		switch node.Issue.ReviewStatus {
 		case model.ReviewStatusApproved:
 			statusStyle = m.theme.Renderer.NewStyle().Foreground(m.theme.Open)
-			statusIndicator = "[✓]"
+			statusIndicator = m.theme.Glyph("[✓]")
 		case model.ReviewStatusNeedsRevision:
 			statusStyle = m.theme.Renderer.NewStyle().Foreground(m.theme.Blocked)
 			statusIndicator = "[!]"
@@ -1721,7 +2042,7 @@ func (m *ReviewDashboardModel) renderBaseView() string {
 		if i == m.cursor {
 			idStyle = idStyle.Bold(true)
 		}
-		line.WriteString(idStyle.Render(node.Issue.ID) + " ")
+		line.WriteString(idStyle.Render(m.theme.ID(node.Issue.ID)) + " ")
 
 		titleStyle := m.theme.Renderer.NewStyle()
 		if i == m.cursor {
@@ -1739,7 +2060,7 @@ func (m *ReviewDashboardModel) renderBaseView() string {
 		b.WriteString(blockerHeaderStyle.Render("BLOCKERS (external)") + "\n")
 		for _, blocker := range m.tree.Blockers {
 			blockerStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Blocked)
-			b.WriteString(blockerStyle.Render("  └─ "+blocker.ID+" "+blocker.Title) + "\n")
+			b.WriteString(blockerStyle.Render(m.theme.Glyph("  └─ ")+blocker.ID+" "+blocker.Title) + "\n")
 		}
 	}
 
@@ -1787,29 +2108,77 @@ func (m *ReviewDashboardModel) IsQuitting() bool {
 	return m.quitting
 }
 
-// SaveReviews persists all collected review actions to beads
+// IsEditingNote returns true while the note input overlay is capturing keystrokes
+func (m *ReviewDashboardModel) IsEditingNote() bool {
+	return m.showNoteInput
+}
+
+// TakeSaveRequest reports whether the user asked to save mid-session (w),
+// clearing the request
+func (m *ReviewDashboardModel) TakeSaveRequest() bool {
+	requested := m.saveRequested
+	m.saveRequested = false
+	return requested
+}
+
+// SetStatus shows msg in the footer until the next key
+func (m *ReviewDashboardModel) SetStatus(msg string) {
+	m.undoMsg = msg
+}
+
+// SaveReviews persists all collected review actions to beads. Saved actions
+// leave the collector for the review history, so a later save skips them;
+// failed ones stay pending. Undo stops at a save, since it cannot take back
+// a posted comment.
 func (m *ReviewDashboardModel) SaveReviews() *review.ReviewSaveResult {
 	if m.collector.Count() == 0 {
 		return &review.ReviewSaveResult{Saved: 0, Failed: 0, Errors: nil}
 	}
 
-	saver := review.NewReviewSaver(m.workspaceRoot)
+	saver := m.newSaver(m.workspaceRoot, m.dryRun)
 	defer saver.Close()
 
 	actions := m.collector.Actions()
-	saved, errors := saver.Save(actions)
+	saved, errs := saver.Save(actions)
+
+	failed := make(map[string]bool, len(errs))
+	for _, err := range errs {
+		var saveErr *review.SaveError
+		if errors.As(err, &saveErr) {
+			failed[saveErr.IssueID] = true
+		}
+	}
+	if saved > 0 && len(failed) == len(actions)-saved {
+		for _, a := range actions {
+			if failed[a.IssueID] {
+				continue
+			}
+			m.collector.Remove(a.IssueID)
+			m.reviewHistory[a.IssueID] = append(m.reviewHistory[a.IssueID], review.ReviewEvent{
+				Status:     a.Status,
+				Reviewer:   a.Reviewer,
+				ReviewType: a.ReviewType,
+				Notes:      a.Notes,
+				At:         a.Timestamp,
+				Content:    a.Content,
+			})
+		}
+		m.undoStack = nil
+		m.redoStack = nil
+	}
+	m.persistSession()
 
 	return &review.ReviewSaveResult{
 		Saved:  saved,
 		Failed: len(actions) - saved,
-		Errors: errors,
+		Errors: errs,
 	}
 }
 
 // loadReviewStateFromComments parses existing comments to load review state
 func (m *ReviewDashboardModel) loadReviewStateFromComments() {
 	// Load state for root issue
-	if m.tree.Root != nil {
+	if m.tree.Root != nil && m.tree.Label == "" {
 		m.loadIssueReviewState(m.tree.Root)
 	}
 
@@ -1825,28 +2194,93 @@ func (m *ReviewDashboardModel) loadIssueReviewState(issue *model.Issue) {
 		return
 	}
 
-	// Find the latest review comment, capturing notes
-	var latestTime time.Time
-	var latestStatus, latestReviewer, latestNotes string
+	// Keep every review for the timeline; the latest sets the current state
+	history := review.ReviewHistory(issue.Comments)
+	if len(history) == 0 {
+		return
+	}
+	m.reviewHistory[issue.ID] = history
+
+	latest := history[len(history)-1]
+	issue.ReviewStatus = latest.Status
+	issue.ReviewedBy = latest.Reviewer
+	issue.ReviewedAt = latest.At
+	if latest.Notes != "" {
+		m.reviewNotes[issue.ID] = latest.Notes
+	}
+}
 
-	for _, c := range issue.Comments {
-		status, reviewer, reviewedAt, notes, ok := review.ParseReviewFromComment(c.Text)
-		if ok && (reviewedAt.After(latestTime) || latestTime.IsZero()) {
-			latestStatus = status
-			latestReviewer = reviewer
-			latestTime = reviewedAt
-			latestNotes = notes
-		}
+// changedSinceApproval names the reviewed fields of issue edited since its
+// saved approval. An approval made this session is current by definition.
+func (m *ReviewDashboardModel) changedSinceApproval(issue *model.Issue) []string {
+	if issue.ReviewStatus != model.ReviewStatusApproved {
+		return nil
+	}
+	if _, pending := m.collector.Lookup(issue.ID); pending {
+		return nil
+	}
+	events := m.reviewHistory[issue.ID]
+	if len(events) == 0 {
+		return nil
+	}
+	return review.ChangedFields(events[len(events)-1].Content, issue)
+}
+
+// reviewTimelineLines renders an issue's reviews from earlier sessions,
+// oldest first, followed by this session's unsaved review
+func (m *ReviewDashboardModel) reviewTimelineLines(issue *model.Issue, width int) []string {
+	events := m.reviewHistory[issue.ID]
+	pending, hasPending := m.collector.Lookup(issue.ID)
+	if len(events) == 0 && !hasPending {
+		return nil
 	}
 
-	if latestStatus != "" {
-		issue.ReviewStatus = latestStatus
-		issue.ReviewedBy = latestReviewer
-		issue.ReviewedAt = latestTime
-		if latestNotes != "" {
-			m.reviewNotes[issue.ID] = latestNotes
+	sectionStyle := m.theme.Renderer.NewStyle().Bold(true)
+	metaStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
+	notesStyle := metaStyle.Italic(true)
+
+	count := len(events)
+	if hasPending {
+		count++
+	}
+	lines := []string{sectionStyle.Render(fmt.Sprintf("Review history (%d):", count))}
+	add := func(e review.ReviewEvent, suffix string) {
+		statusStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
+		switch e.Status {
+		case model.ReviewStatusApproved:
+			statusStyle = statusStyle.Foreground(m.theme.Open)
+		case model.ReviewStatusNeedsRevision:
+			statusStyle = statusStyle.Foreground(m.theme.Blocked)
+		}
+		meta := e.At.Format("2006-01-02 15:04")
+		line := metaStyle.Render(m.theme.Glyph("● ")+meta+" ") + statusStyle.Bold(true).Render(strings.ToUpper(e.Status))
+		var who string
+		if e.Reviewer != "" {
+			who = " by " + e.Reviewer
 		}
+		if e.ReviewType != "" {
+			who += " (" + e.ReviewType + ")"
+		}
+		lines = append(lines, line+metaStyle.Render(who+suffix))
+		if e.Notes != "" {
+			for _, nl := range wrapTextLines(e.Notes, width-4) {
+				lines = append(lines, notesStyle.Render(m.theme.Glyph("│ ")+nl))
+			}
+		}
+	}
+	for _, e := range events {
+		add(e, "")
+	}
+	if hasPending {
+		add(review.ReviewEvent{
+			Status:     pending.Status,
+			Reviewer:   pending.Reviewer,
+			ReviewType: pending.ReviewType,
+			Notes:      pending.Notes,
+			At:         pending.Timestamp,
+		}, m.theme.Glyph(" · unsaved"))
 	}
+	return lines
 }
 
 // PendingSaveCount returns the number of reviews pending save
@@ -1859,9 +2293,28 @@ func (m *ReviewDashboardModel) WorkspaceRoot() string {
 	return m.workspaceRoot
 }
 
+// IsCapturingInput returns true while a note, search, label or assignee input has the keyboard
+func (m *ReviewDashboardModel) IsCapturingInput() bool {
+	return m.showNoteInput || m.showSearch || m.showLabelInput || m.showLabelEdit || m.showAssigneeInput
+}
+
+// IsShowingSummary returns true while the end-of-session summary is shown
+func (m *ReviewDashboardModel) IsShowingSummary() bool {
+	return m.showSummary
+}
+
+// SetIssueStatus mirrors a status change made from outside the dashboard
+func (m *ReviewDashboardModel) SetIssueStatus(id string, status model.Status) {
+	for _, issue := range append([]*model.Issue{m.tree.Root}, m.tree.Descendants...) {
+		if issue != nil && issue.ID == id {
+			issue.Status = status
+		}
+	}
+}
+
 // HasActiveModal returns true if any modal/dialog is currently shown
 func (m *ReviewDashboardModel) HasActiveModal() bool {
-	return m.showHelp || m.showAssigneeInput || m.showLabelInput
+	return m.showHelp || m.showTeam || m.showAssigneeInput || m.showLabelInput || m.showLabelEdit
 }
 
 // generateSimplePrompt creates a simple summary of reviewed beads and their status
@@ -1906,7 +2359,13 @@ func (m *ReviewDashboardModel) generateFullPrompt() string {
 	b.WriteString("Go over the review feedback and suggest changes.\n\n")
 
 	// Root context
-	b.WriteString(fmt.Sprintf("**Review Root:** `%s` - %s\n", m.tree.Root.ID, m.tree.Root.Title))
+	if m.tree.Scope != nil {
+		b.WriteString(fmt.Sprintf("**Review Lens:** `%s` (%d issues)\n", m.tree.Label, len(m.tree.Scope)))
+	} else if m.tree.Label != "" {
+		b.WriteString(fmt.Sprintf("**Review Label:** `%s` (%d issues)\n", m.tree.Label, len(m.tree.Descendants)))
+	} else {
+		b.WriteString(fmt.Sprintf("**Review Root:** `%s` - %s\n", m.tree.Root.ID, m.tree.Root.Title))
+	}
 	b.WriteString(fmt.Sprintf("**Review Type:** %s\n", m.reviewType))
 	b.WriteString(fmt.Sprintf("**Reviewer:** %s\n\n", m.reviewer))
 
@@ -2069,7 +2528,7 @@ func (m *ReviewDashboardModel) filterNonReviewComments(comments []*model.Comment
 
 // findIssueByID finds an issue in the tree by ID
 func (m *ReviewDashboardModel) findIssueByID(id string) *model.Issue {
-	if m.tree.Root.ID == id {
+	if m.tree.Root.ID == id && m.tree.Label == "" {
 		return m.tree.Root
 	}
 	for _, desc := range m.tree.Descendants {
@@ -2105,9 +2564,34 @@ func (p *ReviewProgram) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	var cmd tea.Cmd
 	p.dashboard, cmd = p.dashboard.Update(msg)
+	if p.dashboard.TakeSaveRequest() {
+		status, _ := saveReviews(p.dashboard)
+		p.dashboard.SetStatus(status)
+	}
 	return p, cmd
 }
 
+// SetReadOnly refuses to save reviews, for when bd is not installed
+func (p *ReviewProgram) SetReadOnly(readOnly bool) {
+	p.dashboard.SetReadOnly(readOnly)
+}
+
+// Finish saves or discards the reviews once the program has exited, as the
+// user chose on the summary screen, and describes the outcome ("" when
+// there was nothing to save)
+func (p *ReviewProgram) Finish() (msg string, isErr bool) {
+	if p.dashboard.ShouldSave() {
+		msg, isErr = saveReviews(p.dashboard)
+	} else if p.dashboard.PendingSaveCount() > 0 {
+		msg = "Reviews discarded"
+		if p.dashboard.sessionPath != "" && p.dashboard.dryRun == nil {
+			msg += " (bv review --resume restores them)"
+		}
+	}
+	p.dashboard.FinishSession()
+	return msg, isErr
+}
+
 // View implements tea.Model
 func (p *ReviewProgram) View() string {
 	return p.dashboard.View()
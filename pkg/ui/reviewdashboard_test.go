@@ -0,0 +1,591 @@
+package ui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/review"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// testReviewIssues is an epic with children T1 and T2
+func testReviewIssues() []model.Issue {
+	child := func(id string) model.Issue {
+		return model.Issue{ID: id, Title: id, Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: id, DependsOnID: "EPIC", Type: model.DepParentChild}}}
+	}
+	return []model.Issue{
+		{ID: "EPIC", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		child("T1"),
+		child("T2"),
+	}
+}
+
+func newTestReviewDashboard(t *testing.T) *ReviewDashboardModel {
+	t.Helper()
+	m, err := NewReviewDashboardModel("EPIC", testReviewIssues(), "alice", string(model.ReviewTypePlan), DefaultTheme(lipgloss.DefaultRenderer()), "")
+	if err != nil {
+		t.Fatalf("NewReviewDashboardModel: %v", err)
+	}
+	m.SetSize(120, 40)
+	return m
+}
+
+func pressReview(m *ReviewDashboardModel, keys ...string) *ReviewDashboardModel {
+	for _, k := range keys {
+		m, _ = m.Update(keyMsg(k))
+	}
+	return m
+}
+
+func TestReviewDashboardUndoRedo(t *testing.T) {
+	m := newTestReviewDashboard(t)
+
+	// Approve the root, then (mis)approve the next item
+	m = pressReview(m, "a", "j", "a")
+	if m.PendingSaveCount() != 2 {
+		t.Fatalf("expected 2 pending actions, got %d", m.PendingSaveCount())
+	}
+	second := m.SelectedIssue()
+
+	m = pressReview(m, "k", "u")
+	if got := m.SelectedIssue(); got != second {
+		t.Errorf("undo should move the cursor to the undone issue")
+	}
+	if second.ReviewStatus != "" {
+		t.Errorf("undo should restore the prior status, got %q", second.ReviewStatus)
+	}
+	if m.PendingSaveCount() != 1 {
+		t.Errorf("undo should drop the action from the collector, got %d", m.PendingSaveCount())
+	}
+	if m.itemsReviewed != 1 || m.itemsApproved != 1 {
+		t.Errorf("counters not restored: reviewed=%d approved=%d", m.itemsReviewed, m.itemsApproved)
+	}
+
+	m.Update(keyMsg("ctrl+r"))
+	if second.ReviewStatus != model.ReviewStatusApproved || m.PendingSaveCount() != 2 || m.itemsApproved != 2 {
+		t.Errorf("redo should re-apply the approval: status=%q pending=%d approved=%d",
+			second.ReviewStatus, m.PendingSaveCount(), m.itemsApproved)
+	}
+
+	// Undo both, then a new action clears the redo history
+	m = pressReview(m, "u", "u", "u")
+	if m.PendingSaveCount() != 0 || m.itemsReviewed != 0 {
+		t.Errorf("expected clean session after undoing everything, pending=%d reviewed=%d", m.PendingSaveCount(), m.itemsReviewed)
+	}
+	if m.undoMsg != "Nothing to undo" {
+		t.Errorf("undoMsg = %q", m.undoMsg)
+	}
+	m = pressReview(m, "a", "ctrl+r")
+	if m.undoMsg != "Nothing to redo" {
+		t.Errorf("new action should clear redo history, undoMsg = %q", m.undoMsg)
+	}
+}
+
+func TestReviewDashboardUndoRestoresPreviousReview(t *testing.T) {
+	m := newTestReviewDashboard(t)
+	issue := m.SelectedIssue()
+
+	m = pressReview(m, "a", "U")
+	if issue.ReviewStatus != model.ReviewStatusUnreviewed {
+		t.Fatalf("U should reset to unreviewed, got %q", issue.ReviewStatus)
+	}
+
+	m = pressReview(m, "u")
+	if issue.ReviewStatus != model.ReviewStatusApproved || issue.ReviewedBy != "alice" {
+		t.Errorf("undoing a reset should restore the approval, got %q by %q", issue.ReviewStatus, issue.ReviewedBy)
+	}
+	if action, ok := m.collector.Lookup(issue.ID); !ok || action.Status != model.ReviewStatusApproved {
+		t.Errorf("collector should hold the approval again, got %+v (ok=%v)", action, ok)
+	}
+}
+
+func TestReviewDashboardBulkActions(t *testing.T) {
+	m := newTestReviewDashboard(t)
+	epic := m.SelectedIssue()
+
+	// + marks the whole subtree; a approves it as one undoable step
+	m = pressReview(m, "+", "a")
+	if m.PendingSaveCount() != 3 || m.itemsApproved != 3 {
+		t.Fatalf("bulk approve: pending=%d approved=%d, want 3/3", m.PendingSaveCount(), m.itemsApproved)
+	}
+	if m.hasSelection() {
+		t.Error("a bulk action should clear the selection")
+	}
+	m = pressReview(m, "u")
+	if m.PendingSaveCount() != 0 || m.itemsReviewed != 0 || epic.ReviewStatus != "" {
+		t.Errorf("one undo should reverse the batch: pending=%d reviewed=%d epic=%q",
+			m.PendingSaveCount(), m.itemsReviewed, epic.ReviewStatus)
+	}
+	if m.undoMsg != "Undid approve on 3 issues" {
+		t.Errorf("undoMsg = %q", m.undoMsg)
+	}
+
+	// Space marks and moves on; a V range from T1 to T2 adds to the marks.
+	// Deferring asks for one note and records it on every selected issue.
+	m = pressReview(m, " ", "V", "j", "d")
+	if !m.showNoteInput || len(m.noteTargets) != 3 {
+		t.Fatalf("d should open one note for the 3 selected issues, targets=%d", len(m.noteTargets))
+	}
+	m.noteInput.submitted = true
+	m.noteInput.notes = "next sprint"
+	m.Update(keyMsg("x"))
+	for _, id := range []string{"EPIC", "T1", "T2"} {
+		action, ok := m.collector.Lookup(id)
+		if !ok || action.Status != model.ReviewStatusDeferred || action.Notes != "next sprint" {
+			t.Errorf("%s: collector has %+v (ok=%v), want deferred with the note", id, action, ok)
+		}
+	}
+	if m.PendingSaveCount() != 3 || m.itemsDeferred != 3 {
+		t.Errorf("pending=%d deferred=%d, want 3/3", m.PendingSaveCount(), m.itemsDeferred)
+	}
+
+	// * toggles everything shown; esc drops a selection without quitting
+	m = pressReview(m, "*")
+	if got := len(m.selectedIssues()); got != 3 {
+		t.Errorf("* selected %d issues, want 3", got)
+	}
+	m = pressReview(m, "*", " ")
+	m.Update(keyMsg("esc"))
+	if m.hasSelection() || m.showSummary || m.quitting {
+		t.Errorf("esc should only clear the selection: selection=%v summary=%v", m.hasSelection(), m.showSummary)
+	}
+}
+
+func TestReviewDashboardNoteTemplates(t *testing.T) {
+	m := newTestReviewDashboard(t)
+	m.SetNoteTemplates(nil)
+	if len(m.noteTemplates) != len(defaultNoteTemplates) {
+		t.Fatalf("an empty config should keep the built-in templates, got %v", m.noteTemplates)
+	}
+	m.SetNoteTemplates([]string{"Needs tests", "Too big"})
+	issue := m.SelectedIssue()
+
+	m = pressReview(m, "r")
+	if view := m.noteInput.View(); !strings.Contains(view, "alt+2 Too big") {
+		t.Errorf("note modal should list the templates:\n%s", view)
+	}
+	alt := func(k string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k), Alt: true} }
+	m.Update(alt("2"))
+	m.Update(alt("1"))
+	m.Update(alt("9")) // No ninth template: ignored
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+
+	action, ok := m.collector.Lookup(issue.ID)
+	if !ok || action.Status != model.ReviewStatusNeedsRevision || action.Notes != "Too big\nNeeds tests" {
+		t.Errorf("collector has %+v (ok=%v), want a revision noting both templates", action, ok)
+	}
+}
+
+func TestReviewDashboardBulkLabels(t *testing.T) {
+	m := newTestReviewDashboard(t)
+	dry := loader.NewDryRun()
+	m.SetDryRun(dry)
+
+	// + then l labels the subtree; the change shows before bd answers
+	m = pressReview(m, "+", "l", "a", "p", "i")
+	if !m.IsCapturingInput() {
+		t.Fatal("l should open the label prompt")
+	}
+	m, cmd := m.Update(keyMsg("enter"))
+	if cmd == nil {
+		t.Fatal("enter should write the label through bd")
+	}
+	for _, issue := range m.selectedIssues() {
+		t.Errorf("%s still selected after the edit", issue.ID)
+	}
+	for _, node := range m.flatNodes {
+		if !slices.Contains(node.Issue.Labels, "api") {
+			t.Errorf("%s labels = %v, want api", node.Issue.ID, node.Issue.Labels)
+		}
+	}
+	m, _ = m.Update(cmd())
+	if m.undoMsg != "Labeled 3 issues #api" {
+		t.Errorf("undoMsg = %q", m.undoMsg)
+	}
+	if changes := dry.Changes(); len(changes) != 3 || changes[0].Summary != "bd label add EPIC api" {
+		t.Errorf("dry-run changes = %+v", changes)
+	}
+
+	// L without a selection acts on the cursor issue; a failed write puts
+	// the label back
+	m = pressReview(m, "L", "a", "p", "i", "enter")
+	epic := m.SelectedIssue()
+	if slices.Contains(epic.Labels, "api") {
+		t.Fatalf("L should drop api from %s at once", epic.ID)
+	}
+	m.Update(LabelsEditedMsg{Label: "api", Remove: true, IDs: []string{"EPIC"}, Errs: []error{
+		&loader.BdWriteError{Write: loader.BdWrite{IssueID: "EPIC"}, Err: errors.New("exit status 1")},
+	}})
+	if !slices.Contains(epic.Labels, "api") || !strings.Contains(m.undoMsg, "1 failed") {
+		t.Errorf("failed removal: labels=%v msg=%q", epic.Labels, m.undoMsg)
+	}
+}
+
+func TestLabelReviewDashboardGroupsByEpic(t *testing.T) {
+	child := func(id, parent string, labels ...string) model.Issue {
+		return model.Issue{ID: id, Title: id, Status: model.StatusOpen, IssueType: model.TypeTask, Labels: labels,
+			Dependencies: []*model.Dependency{{IssueID: id, DependsOnID: parent, Type: model.DepParentChild}}}
+	}
+	issues := []model.Issue{
+		{ID: "E1", Title: "Epic one", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		child("F1", "E1"),
+		child("T1", "F1", "api"), // Nearest epic is two levels up
+		child("T2", "E1", "ui"),
+		{ID: "T3", Title: "T3", Status: model.StatusOpen, IssueType: model.TypeTask, Labels: []string{"api"}},
+		child("T4", "E1", "api"),
+	}
+	m, err := NewLabelReviewDashboardModel("api", issues, "alice", string(model.ReviewTypePlan), DefaultTheme(lipgloss.DefaultRenderer()), "")
+	if err != nil {
+		t.Fatalf("NewLabelReviewDashboardModel: %v", err)
+	}
+	m.SetSize(120, 40)
+
+	var rows []string
+	for _, node := range m.flatNodes {
+		rows = append(rows, node.TreePrefix+node.Issue.ID)
+	}
+	want := []string{"├─ E1", "│  ├─ T1", "│  └─ T4", "└─ T3"}
+	if len(rows) != len(want) {
+		t.Fatalf("rows = %q, want %q", rows, want)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Fatalf("rows = %q, want %q", rows, want)
+		}
+	}
+
+	// The placeholder root is never selected, so every action lands on an issue
+	m = pressReview(m, "j", "a", "G", "a")
+	if m.PendingSaveCount() != 2 || m.tree.IssueMap["T1"].ReviewStatus != model.ReviewStatusApproved ||
+		m.tree.IssueMap["T3"].ReviewStatus != model.ReviewStatusApproved {
+		t.Errorf("expected T1 and T3 approved, pending=%d", m.PendingSaveCount())
+	}
+
+	if _, err := NewLabelReviewDashboardModel("missing", issues, "", "", DefaultTheme(lipgloss.DefaultRenderer()), ""); err == nil {
+		t.Error("expected an error for a label no issue carries")
+	}
+}
+
+func TestReviewDashboardHistoryTimeline(t *testing.T) {
+	day := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	issues := []model.Issue{{
+		ID: "T1", Title: "Task", Status: model.StatusOpen, IssueType: model.TypeTask,
+		Comments: []*model.Comment{
+			{Author: "carol", CreatedAt: day.Add(48 * time.Hour), Text: "[REVIEW]\nstatus: approved\nreviewer: carol\ndate: " + day.Add(48*time.Hour).Format(time.RFC3339) + "\n[/REVIEW]"},
+			{Author: "bob", CreatedAt: day, Text: "[REVIEW]\nstatus: needs_revision\ntype: plan\nnotes: split the migration\n[/REVIEW]"},
+			{Author: "bob", CreatedAt: day.Add(time.Hour), Text: "looks better now"},
+		},
+	}}
+	m, err := NewReviewDashboardModel("T1", issues, "alice", string(model.ReviewTypePlan), DefaultTheme(lipgloss.DefaultRenderer()), "")
+	if err != nil {
+		t.Fatalf("NewReviewDashboardModel: %v", err)
+	}
+	m.SetSize(140, 40)
+
+	issue := m.SelectedIssue()
+	if issue.ReviewStatus != model.ReviewStatusApproved || issue.ReviewedBy != "carol" {
+		t.Errorf("latest review should set the status, got %q by %q", issue.ReviewStatus, issue.ReviewedBy)
+	}
+
+	m = pressReview(m, "a")
+	panel := stripAnsi(m.renderDetailPanelFixed(60, 40))
+	for _, want := range []string{
+		"Review history (3):",
+		"2026-03-01 09:00 NEEDS_REVISION by bob (plan)", // Reviewer and date from the comment
+		"│ split the migration",
+		"2026-03-03 09:00 APPROVED by carol",
+		"APPROVED by alice (plan) · unsaved",
+	} {
+		if !strings.Contains(panel, want) {
+			t.Errorf("timeline missing %q:\n%s", want, panel)
+		}
+	}
+	if strings.Index(panel, "NEEDS_REVISION") > strings.Index(panel, "by carol") {
+		t.Error("timeline should be oldest first")
+	}
+}
+
+func TestReviewDashboardChangedSinceApproval(t *testing.T) {
+	approval := func(issue model.Issue) *model.Comment {
+		return &model.Comment{Author: "bob", Text: "[REVIEW]\nstatus: approved\nreviewer: bob\ncontent: " +
+			review.ContentFingerprint(&issue) + "\n[/REVIEW]"}
+	}
+	issues := testReviewIssues()
+	issues[1].Description = "Add login"
+	issues[1].Comments = []*model.Comment{approval(issues[1])}
+	issues[1].Description = "Add login and logout" // Edited after approval
+	issues[2].Design = "JWT"
+	issues[2].Comments = []*model.Comment{approval(issues[2])}
+
+	m, err := NewReviewDashboardModel("EPIC", issues, "alice", string(model.ReviewTypePlan), DefaultTheme(lipgloss.DefaultRenderer()), "")
+	if err != nil {
+		t.Fatalf("NewReviewDashboardModel: %v", err)
+	}
+	m.SetSize(120, 40)
+
+	tree := stripAnsi(m.renderTreePanelFixed(60, 10))
+	if strings.Count(tree, "Δ changed") != 1 {
+		t.Errorf("only T1 should be flagged:\n%s", tree)
+	}
+
+	// f cycles all → unreviewed → needs_revision → changed; the root stays
+	// as the tree's anchor
+	m = pressReview(m, "f", "f", "f", "j")
+	if m.showFilter != "changed" || len(m.flatNodes) != 2 || m.SelectedIssue().ID != "T1" {
+		t.Fatalf("changed filter shows %d nodes (filter %q)", len(m.flatNodes), m.showFilter)
+	}
+	panel := stripAnsi(m.renderDetailPanelFixed(60, 40))
+	if !strings.Contains(panel, "Δ Changed since approval: description") {
+		t.Errorf("detail panel missing changed fields:\n%s", panel)
+	}
+
+	// Re-approving vouches for the new text
+	m = pressReview(m, "a")
+	if got := m.changedSinceApproval(m.SelectedIssue()); got != nil {
+		t.Errorf("re-approved issue still reports %v", got)
+	}
+}
+
+func TestReviewDashboardTeamSummary(t *testing.T) {
+	issues := testReviewIssues()
+	issues[1].Comments = []*model.Comment{{Author: "bob", Text: "[REVIEW]\nstatus: approved\ntype: plan\n[/REVIEW]"}}
+	m, err := NewReviewDashboardModel("EPIC", issues, "alice", string(model.ReviewTypePlan), DefaultTheme(lipgloss.DefaultRenderer()), "")
+	if err != nil {
+		t.Fatalf("NewReviewDashboardModel: %v", err)
+	}
+	m.SetSize(120, 40)
+
+	// alice, this session: the epic and T1, which bob already approved
+	m = pressReview(m, "a", "j", "a", "T")
+
+	view := stripAnsi(m.View())
+	for _, want := range []string{
+		"plan review · 3 issues",
+		"alice            2    2    0    0       1",
+		"bob              1    1    0    0       1",
+		"Overlap:    1 reviewed by more than one person",
+		"Unreviewed: 1 (T2)",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("team overlay missing %q:\n%s", want, view)
+		}
+	}
+
+	m = pressReview(m, "x")
+	if m.showTeam {
+		t.Error("any key should close the overlay")
+	}
+}
+
+// stubReviewSaver records saved actions and fails the listed issues
+type stubReviewSaver struct {
+	saved []string
+	fail  map[string]bool
+}
+
+func (s *stubReviewSaver) Save(actions []review.ReviewAction) (int, []error) {
+	var errs []error
+	for _, a := range actions {
+		if s.fail[a.IssueID] {
+			errs = append(errs, &review.SaveError{IssueID: a.IssueID, Err: errors.New("bd failed")})
+			continue
+		}
+		s.saved = append(s.saved, a.IssueID)
+	}
+	return len(actions) - len(errs), errs
+}
+
+func (s *stubReviewSaver) Close() error { return nil }
+
+func TestReviewDashboardPartialSave(t *testing.T) {
+	saver := &stubReviewSaver{fail: map[string]bool{"T1": true}}
+	host := NewModel(nil, nil, "")
+	host.reviewDashboard = newTestReviewDashboard(t)
+	host.reviewDashboard.newSaver = func(string, *loader.DryRun) review.ReviewSaver { return saver }
+	host.showReviewDashboard = true
+
+	host.reviewDashboard = pressReview(host.reviewDashboard, "a", "j", "a")
+	host, _ = host.handleReviewDashboardKeys(keyMsg("w"))
+	m := host.reviewDashboard
+	if m == nil || m.IsQuitting() {
+		t.Fatal("w should keep the session open")
+	}
+	if strings.Join(saver.saved, ",") != "EPIC" || m.PendingSaveCount() != 1 {
+		t.Fatalf("saved %v, %d pending; want EPIC saved and T1 pending", saver.saved, m.PendingSaveCount())
+	}
+	if m.undoMsg != "Saved 1 reviews, 1 failed" {
+		t.Errorf("footer = %q", m.undoMsg)
+	}
+	if events := m.reviewHistory["EPIC"]; len(events) != 1 || events[0].Status != model.ReviewStatusApproved {
+		t.Errorf("saved review should join the history, got %+v", events)
+	}
+	if m = pressReview(m, "u"); m.undoMsg != "Nothing to undo" {
+		t.Errorf("undo should stop at a save, got %q", m.undoMsg)
+	}
+
+	// The retry saves only what is still pending
+	saver.fail = nil
+	host, _ = host.handleReviewDashboardKeys(keyMsg("w"))
+	if strings.Join(saver.saved, ",") != "EPIC,T1" || host.reviewDashboard.PendingSaveCount() != 0 {
+		t.Errorf("retry saved %v, %d pending", saver.saved, host.reviewDashboard.PendingSaveCount())
+	}
+	host, _ = host.handleReviewDashboardKeys(keyMsg("w"))
+	if host.reviewDashboard.undoMsg != "No reviews to save" {
+		t.Errorf("footer = %q", host.reviewDashboard.undoMsg)
+	}
+}
+
+func TestReviewDashboardSessionResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ReviewSessionFile)
+	m := newTestReviewDashboard(t)
+	m.SetSessionPath(path)
+	m.FinishSession()
+	if _, err := os.Stat(path); err == nil {
+		t.Fatal("a dashboard that was only opened should not write a session")
+	}
+
+	// Approve the epic, mark T1, narrow to unreviewed, then discard with Q
+	m = pressReview(m, "a", "j", " ", "f", "k")
+	cursor := m.SelectedIssue().ID
+	program := NewReviewProgram(m)
+	for _, k := range []string{"q", "Q"} {
+		program.Update(keyMsg(k))
+	}
+	if msg, _ := program.Finish(); msg != "Reviews discarded (bv review --resume restores them)" {
+		t.Errorf("finish = %q", msg)
+	}
+
+	session, err := LoadReviewSession(path)
+	if err != nil {
+		t.Fatalf("LoadReviewSession: %v", err)
+	}
+	resumed, err := NewReviewDashboardFromSession(session, testReviewIssues(), DefaultTheme(lipgloss.DefaultRenderer()), "")
+	if err != nil {
+		t.Fatalf("NewReviewDashboardFromSession: %v", err)
+	}
+	if got := resumed.SelectedIssue().ID; got != cursor {
+		t.Errorf("cursor on %s, want %s", got, cursor)
+	}
+	if resumed.showFilter != m.showFilter || !resumed.marked["T1"] || resumed.reviewer != "alice" {
+		t.Errorf("filter %q marked %v reviewer %q not restored", resumed.showFilter, resumed.marked, resumed.reviewer)
+	}
+	epic := resumed.findIssueByID("EPIC")
+	if resumed.PendingSaveCount() != 1 || epic.ReviewStatus != model.ReviewStatusApproved || resumed.itemsApproved != 1 {
+		t.Errorf("pending %d, epic %q, approved %d", resumed.PendingSaveCount(), epic.ReviewStatus, resumed.itemsApproved)
+	}
+
+	// Saving everything on the way out finishes the session
+	saver := &stubReviewSaver{}
+	resumed.newSaver = func(string, *loader.DryRun) review.ReviewSaver { return saver }
+	resumed.SetSessionPath(path)
+	program = NewReviewProgram(resumed)
+	for _, k := range []string{"q", "q"} {
+		program.Update(keyMsg(k))
+	}
+	if msg, isErr := program.Finish(); msg != "Saved 1 reviews to comments" || isErr {
+		t.Errorf("finish = %q", msg)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("finished session should be removed, stat: %v", err)
+	}
+
+	if _, err := LoadReviewSession(path); err == nil || !strings.Contains(err.Error(), "no review session") {
+		t.Errorf("missing session error = %v", err)
+	}
+}
+
+func TestReviewDashboardReadOnlyKeepsSession(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ReviewSessionFile)
+	m := newTestReviewDashboard(t)
+	m.SetSessionPath(path)
+	program := NewReviewProgram(m)
+	program.SetReadOnly(true)
+
+	m = pressReview(m, "a", "q")
+	if view := stripAnsi(m.View()); !strings.Contains(view, "bd not installed") || !strings.Contains(view, "--resume") {
+		t.Errorf("summary should warn that bd is missing:\n%s", view)
+	}
+	program.Update(keyMsg("q"))
+	msg, isErr := program.Finish()
+	if !isErr || msg != "Read-only (bd not installed): 1 reviews not saved (bv review --resume restores them)" {
+		t.Errorf("finish = %q, %v", msg, isErr)
+	}
+	if session, err := LoadReviewSession(path); err != nil || len(session.Pending) != 1 {
+		t.Errorf("session should keep the review, got %+v, %v", session, err)
+	}
+}
+
+func TestScopedReviewDashboard(t *testing.T) {
+	issues := testReviewIssues()
+	issues = append(issues,
+		model.Issue{ID: "T3", Title: "T3", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "T3", DependsOnID: "T1", Type: model.DepParentChild}}},
+		model.Issue{ID: "X", Title: "X", Status: model.StatusOpen, IssueType: model.TypeTask})
+
+	// T2 is left out; T3 hangs off T1, T1 off EPIC, X off the placeholder
+	m, err := NewScopedReviewDashboardModel("api", []string{"X", "T3", "EPIC", "T1", "gone"}, issues, "alice",
+		string(model.ReviewTypePlan), DefaultTheme(lipgloss.DefaultRenderer()), "")
+	if err != nil {
+		t.Fatalf("NewScopedReviewDashboardModel: %v", err)
+	}
+	m.SetSize(120, 40)
+	var rows []string
+	for _, node := range m.flatNodes {
+		rows = append(rows, node.TreePrefix+node.Issue.ID)
+	}
+	want := []string{"├─ X", "└─ EPIC", "   └─ T1", "      └─ T3"}
+	if strings.Join(rows, "|") != strings.Join(want, "|") {
+		t.Fatalf("rows = %q, want %q", rows, want)
+	}
+
+	// The session brings back the same set, not the whole label or epic
+	path := filepath.Join(t.TempDir(), ReviewSessionFile)
+	m.SetSessionPath(path)
+	m = pressReview(m, "a")
+	session, err := LoadReviewSession(path)
+	if err != nil {
+		t.Fatalf("LoadReviewSession: %v", err)
+	}
+	resumed, err := NewReviewDashboardFromSession(session, issues, DefaultTheme(lipgloss.DefaultRenderer()), "")
+	if err != nil {
+		t.Fatalf("NewReviewDashboardFromSession: %v", err)
+	}
+	if len(resumed.flatNodes) != 4 || resumed.findIssueByID("T2") != nil || resumed.PendingSaveCount() != 1 {
+		t.Errorf("resumed %d rows, T2 %v, pending %d", len(resumed.flatNodes), resumed.findIssueByID("T2"), resumed.PendingSaveCount())
+	}
+
+	if _, err := NewScopedReviewDashboardModel("none", []string{"gone"}, issues, "", "", DefaultTheme(lipgloss.DefaultRenderer()), ""); err == nil {
+		t.Error("expected an error when no issue is left to review")
+	}
+}
+
+func TestLensScopedReview(t *testing.T) {
+	m := newProjectConfigModel(t, &config.Config{})
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	var want []string
+	for _, issue := range m.lensDashboard.GetAllDisplayIssues() {
+		want = append(want, issue.ID)
+	}
+
+	updated, _ := m.Update(keyMsg("R"))
+	m = updated.(Model)
+	if m.focused != focusReviewDashboard || m.reviewDashboard == nil {
+		t.Fatalf("R should open a review, focus %v", m.focused)
+	}
+	if got := m.reviewDashboard.tree.Scope; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("review covers %v, want the lens's %v", got, want)
+	}
+	if m.reviewDashboardOrigin != "lens_dashboard" {
+		t.Errorf("origin = %q", m.reviewDashboardOrigin)
+	}
+}
@@ -0,0 +1,203 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/views"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// depthToView converts a lens depth to its saved-view representation
+func depthToView(d DepthOption) string {
+	return strings.ToLower(d.String())
+}
+
+// depthFromView parses a saved-view depth ("1", "2", "3", "all")
+func depthFromView(s string) (DepthOption, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "1":
+		return Depth1, true
+	case "2":
+		return Depth2, true
+	case "3":
+		return Depth3, true
+	case "all":
+		return DepthAll, true
+	}
+	return 0, false
+}
+
+// viewTypeToView converts a lens view type to its saved-view representation
+func viewTypeToView(v ViewType) string {
+	switch v {
+	case ViewTypeWorkstream:
+		return "workstream"
+	case ViewTypeGrouped:
+		return "grouped"
+	default:
+		return "flat"
+	}
+}
+
+// scopeModeToView converts a scope match mode to its saved-view representation
+func scopeModeToView(s ScopeMode) string {
+	if s == ScopeModeUnion {
+		return "any"
+	}
+	return "all"
+}
+
+// scopeModeFromView parses a saved-view scope mode; anything but "any" means ALL
+func scopeModeFromView(s string) ScopeMode {
+	if strings.EqualFold(strings.TrimSpace(s), "any") {
+		return ScopeModeUnion
+	}
+	return ScopeModeIntersection
+}
+
+// openLensSelector shows a fresh lens selector over the list. The returned
+// command ranks centrality for its stats panel unless already cached.
+func (m *Model) openLensSelector() tea.Cmd {
+	m.clearAttentionOverlay()
+	m.isGraphView = false
+	m.isBoardView = false
+	m.isActionableView = false
+	m.isHistoryView = false
+	m.isSprintView = false
+	m.showLensSelector = true
+	m.focused = focusLensSelector
+	// Initialize lens selector with issues and graph stats
+	m.lensSelector = NewLensSelectorModel(m.issues, m.theme, m.analysis)
+	m.lensSelector.SetSize(m.width, m.height-1)
+	m.lensSelector.SetEpicScopeChanges(m.epicScope.Changes(m.issues))
+	m.lensSelector.SetClaims(m.claimCoverage, m.labelClaims)
+	if m.projectConfig != nil {
+		m.lensSelector.SetPinned(m.projectConfig.PinnedLenses)
+	}
+	if path := m.recentLensesPath(); path != "" {
+		m.lensSelector.SetRecent(loadRecentLenses(path))
+	}
+	if m.savedViews != nil {
+		m.lensSelector.SetViewNames(m.savedViews.Names())
+	}
+	return m.centralityCmd()
+}
+
+// captureLensView snapshots the selector's scope and search mode plus the lens
+// depth and layout (from a pending restored view, else the last lens dashboard)
+func (m *Model) captureLensView() views.View {
+	v := views.View{
+		ScopeLabels: append([]string(nil), m.lensSelector.ScopeLabels()...),
+		ScopeMode:   scopeModeToView(m.lensSelector.ScopeMatchMode()),
+		SearchMode:  m.lensSelector.SearchMode(),
+	}
+	switch {
+	case m.pendingLensView != nil:
+		v.Depth = m.pendingLensView.Depth
+		v.ViewType = m.pendingLensView.ViewType
+	case m.lensDashboard.issueMap != nil:
+		v.Depth = depthToView(m.lensDashboard.GetDepth())
+		v.ViewType = viewTypeToView(m.lensDashboard.GetViewType())
+	}
+	return v
+}
+
+// saveLensView persists the current lens configuration under name
+func (m *Model) saveLensView(name string) {
+	if m.savedViewsErr != nil || m.savedViews == nil {
+		m.statusMsg = fmt.Sprintf("Saved views unavailable: %v", m.savedViewsErr)
+		m.statusIsError = true
+		return
+	}
+	if err := m.savedViews.Put(name, m.captureLensView()); err != nil {
+		m.statusMsg = fmt.Sprintf("Save view failed: %v", err)
+		m.statusIsError = true
+		return
+	}
+	if m.dryRun != nil {
+		m.dryRun.RecordFile(m.savedViews.Path(), fmt.Sprintf("save view %q", name))
+		m.lensSelector.SetViewNames(m.savedViews.Names())
+		m.statusMsg = m.dryRunStatus(fmt.Sprintf("View %q kept for this session", name))
+		m.statusIsError = false
+		return
+	}
+	if err := m.savedViews.Save(); err != nil {
+		m.statusMsg = fmt.Sprintf("Save view failed: %v", err)
+		m.statusIsError = true
+		return
+	}
+	m.lensSelector.SetViewNames(m.savedViews.Names())
+	m.statusMsg = fmt.Sprintf("Saved view %q to %s", name, m.savedViews.Path())
+	m.statusIsError = false
+}
+
+// restoreLensView applies a saved view to the open lens selector. Depth and
+// layout are held until the user picks a lens to open.
+func (m *Model) restoreLensView(name string) error {
+	if m.savedViewsErr != nil {
+		return fmt.Errorf("saved views unavailable: %w", m.savedViewsErr)
+	}
+	if m.savedViews == nil {
+		return fmt.Errorf("no saved views")
+	}
+	v, ok := m.savedViews.Get(name)
+	if !ok {
+		names := m.savedViews.Names()
+		if len(names) == 0 {
+			return fmt.Errorf("unknown view %q (no saved views)", name)
+		}
+		return fmt.Errorf("unknown view %q (available: %s)", name, strings.Join(names, ", "))
+	}
+
+	m.lensSelector.ApplyView(v.ScopeLabels, scopeModeFromView(v.ScopeMode), v.SearchMode)
+	m.pendingLensView = &v
+
+	m.statusMsg = fmt.Sprintf("View %q restored • enter to open a lens", name)
+	m.statusIsError = false
+	return nil
+}
+
+// OpenSavedView opens the lens selector with a saved view restored (bv --view=name)
+func (m *Model) OpenSavedView(name string) error {
+	m.openLensSelector()
+	if err := m.restoreLensView(name); err != nil {
+		m.showLensSelector = false
+		m.focused = focusList
+		return err
+	}
+	return nil
+}
+
+// applyPendingLensView applies the depth and layout of a restored view to the
+// lens dashboard that was just opened
+func (m *Model) applyPendingLensView() {
+	v := m.pendingLensView
+	if v == nil {
+		return
+	}
+	m.pendingLensView = nil
+	m.applyLensLayout(v.Depth, v.ViewType)
+}
+
+// applyLensLayout sets the lens dashboard depth ("1", "2", "3", "all") and
+// layout ("flat", "workstream", "grouped"); empty or unknown values are kept
+func (m *Model) applyLensLayout(depth, viewType string) {
+	if d, ok := depthFromView(depth); ok {
+		m.lensDashboard.SetDepth(d)
+	}
+	switch strings.ToLower(viewType) {
+	case "flat":
+		if m.lensDashboard.IsGroupedView() {
+			m.lensDashboard.ExitGroupedView()
+		} else if m.lensDashboard.IsWorkstreamView() {
+			m.lensDashboard.ToggleViewType()
+		}
+	case "workstream":
+		if !m.lensDashboard.IsWorkstreamView() {
+			m.lensDashboard.ToggleViewType()
+		}
+	case "grouped":
+		m.lensDashboard.EnterGroupedView()
+	}
+}
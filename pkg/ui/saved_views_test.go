@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/views"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newSavedViewsModel(t *testing.T) Model {
+	t.Helper()
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "API one", Status: model.StatusOpen, Labels: []string{"api", "db"}},
+		{ID: "bv-2", Title: "API two", Status: model.StatusOpen, Labels: []string{"api"}},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	store, err := views.Load(filepath.Join(t.TempDir(), "views.yaml"))
+	if err != nil {
+		t.Fatalf("views.Load: %v", err)
+	}
+	m.savedViews = store
+	m.savedViewsErr = nil
+	return m
+}
+
+func TestSaveLensViewFromSelector(t *testing.T) {
+	m := newSavedViewsModel(t)
+	m.openLensSelector()
+	m.lensSelector.ApplyView([]string{"api"}, ScopeModeUnion, "label")
+
+	for _, key := range []string{"w", "m", "i", "n", "e"} {
+		m = m.handleLensSelectorKeys(keyMsg(key))
+	}
+	m = m.handleLensSelectorKeys(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.statusIsError {
+		t.Fatalf("unexpected error: %s", m.statusMsg)
+	}
+	reloaded, err := views.Load(m.savedViews.Path())
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	got, ok := reloaded.Get("mine")
+	if !ok {
+		t.Fatalf("view not persisted, have %v", reloaded.Names())
+	}
+	want := views.View{ScopeLabels: []string{"api"}, ScopeMode: "any", SearchMode: "label"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("saved view = %+v, want %+v", got, want)
+	}
+	if !reflect.DeepEqual(m.lensSelector.viewNames, []string{"mine"}) {
+		t.Errorf("picker names = %v", m.lensSelector.viewNames)
+	}
+}
+
+func TestOpenSavedViewRestoresLens(t *testing.T) {
+	m := newSavedViewsModel(t)
+	if err := m.savedViews.Put("deep", views.View{
+		ScopeLabels: []string{"db"},
+		SearchMode:  "label",
+		Depth:       "all",
+		ViewType:    "workstream",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.OpenSavedView("missing"); err == nil {
+		t.Error("expected error for unknown view")
+	}
+
+	if err := m.OpenSavedView("deep"); err != nil {
+		t.Fatalf("OpenSavedView: %v", err)
+	}
+	if !m.showLensSelector {
+		t.Fatal("expected lens selector to be open")
+	}
+	if got := m.lensSelector.ScopeLabels(); !reflect.DeepEqual(got, []string{"db"}) {
+		t.Errorf("scope labels = %v", got)
+	}
+	if m.lensSelector.SearchMode() != "label" {
+		t.Errorf("search mode = %q", m.lensSelector.SearchMode())
+	}
+
+	// Opening a lens applies the saved depth and layout
+	m = m.handleLensSelectorKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.showLensDashboard {
+		t.Fatal("expected lens dashboard to open")
+	}
+	if m.lensDashboard.GetDepth() != DepthAll {
+		t.Errorf("depth = %v, want All", m.lensDashboard.GetDepth())
+	}
+	if m.lensDashboard.GetViewType() != ViewTypeWorkstream {
+		t.Errorf("view type = %v, want workstream", m.lensDashboard.GetViewType())
+	}
+	if m.pendingLensView != nil {
+		t.Error("pending view should be consumed")
+	}
+}
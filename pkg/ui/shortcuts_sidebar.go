@@ -121,6 +121,8 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"H/L", "Scroll ←/→"},
 				{"PgUp/Dn", "Scroll ↑/↓"},
 				{"Enter", "Jump to issue"},
+				{"c", "Canvas view"},
+				{"y", "Agent context"},
 			},
 		},
 		{
@@ -133,6 +135,7 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"e", "Explanations"},
 				{"x", "Calc proof"},
 				{"m", "Heatmap"},
+				{"y", "Agent context"},
 				{"Enter", "Jump to issue"},
 			},
 		},
@@ -146,6 +149,7 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"J/K", "Detail ↓/↑"},
 				{"Tab", "Focus toggle"},
 				{"y", "Copy SHA"},
+				{"Y", "Agent context"},
 				{"o", "Open in browser"},
 				{"g", "Graph view"},
 				{"c", "Cycle filter"},
@@ -159,6 +163,7 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"j/k", "Items ↓/↑"},
 				{"Tab", "Toggle detail"},
 				{"^j/^k", "Scroll detail"},
+				{"y/Y", "Agent context / ID"},
 				{"Enter", "Full view"},
 			},
 		},
@@ -169,6 +174,7 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"o", "Open only"},
 				{"c", "Closed only"},
 				{"r", "Ready (no blocks)"},
+				{"A", "Affected (time-travel)"},
 				{"L", "Label picker"},
 				{"/", "Search"},
 			},
@@ -180,6 +186,9 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"t/T", "Time-travel"},
 				{"x", "Export .md"},
 				{"C", "Copy"},
+				{"y", "Agent context"},
+				{"K", "Peek"},
+				{"B", "Blocker chain"},
 				{"O", "Open in $EDITOR"},
 				{"R", "Recipe picker"},
 			},
@@ -86,7 +86,7 @@ func (m Model) renderSprintDashboard() string {
 		if beadIDSet[iss.ID] {
 			totalBeads++
 			sprintIssues = append(sprintIssues, iss)
-			switch iss.Status {
+			switch iss.Status.Column() {
 			case model.StatusClosed:
 				closedBeads++
 			case model.StatusBlocked:
@@ -178,7 +178,7 @@ func (m Model) renderSprintDashboard() string {
 	const staleThresholdDays = 3
 	var atRisk []model.Issue
 	for _, iss := range sprintIssues {
-		if iss.Status == model.StatusInProgress {
+		if iss.Status.Column() == model.StatusInProgress {
 			daysSinceUpdate := int(now.Sub(iss.UpdatedAt).Hours() / 24)
 			if daysSinceUpdate >= staleThresholdDays {
 				atRisk = append(atRisk, iss)
@@ -210,7 +210,7 @@ func (m Model) renderSprintDashboard() string {
 		iss := sprintIssues[i]
 		statusIcon := "○"
 		statusStyle := valStyle
-		switch iss.Status {
+		switch iss.Status.Column() {
 		case model.StatusClosed:
 			statusIcon = "✓"
 			statusStyle = t.Renderer.NewStyle().Foreground(t.Open)
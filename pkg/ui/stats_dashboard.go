@@ -0,0 +1,495 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// StatsDashboardModel renders project-wide charts computed from issue timestamps.
+// Sections are stacked vertically and the whole dashboard scrolls as one page.
+type StatsDashboardModel struct {
+	issues    []model.Issue
+	aging     analysis.AgingHistogram
+	flow      analysis.CumulativeFlow
+	trend     analysis.WeeklyTrend
+	burndowns []analysis.LabelBurndown
+	burnups   []analysis.EpicBurnup
+	epicScope *analysis.EpicScopeData // Kickoff snapshots for the burnup scope line
+
+	scroll int
+	width  int
+	height int
+	theme  Theme
+}
+
+// NewStatsDashboardModel creates an empty stats dashboard
+func NewStatsDashboardModel(theme Theme) StatsDashboardModel {
+	return StatsDashboardModel{theme: theme}
+}
+
+// SetData recomputes all charts for the given issues
+func (m *StatsDashboardModel) SetData(issues []model.Issue, now time.Time) {
+	m.issues = issues
+	m.aging = analysis.ComputeAgingHistogram(issues, analysis.DefaultAgeBuckets, now)
+	m.flow = analysis.ComputeCumulativeFlow(issues, analysis.DefaultCumulativeFlowDays, now)
+	m.trend = analysis.ComputeWeeklyTrend(issues, analysis.DefaultTrendWeeks, now)
+	m.burndowns = analysis.ComputeLabelBurndowns(issues, analysis.DefaultTrendWeeks, now, analysis.DefaultBurndownLabels)
+	m.burnups = analysis.ComputeEpicBurnups(issues, m.epicScope, analysis.DefaultTrendWeeks, now, analysis.DefaultBurnupEpics)
+	m.scroll = 0
+}
+
+// SetEpicScope sets the kickoff snapshots used to split epic burnups into
+// planned and added scope; call it before SetData
+func (m *StatsDashboardModel) SetEpicScope(scope *analysis.EpicScopeData) {
+	m.epicScope = scope
+}
+
+// SetSize updates the dashboard dimensions
+func (m *StatsDashboardModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// ScrollDown scrolls the dashboard by n lines
+func (m *StatsDashboardModel) ScrollDown(n int) {
+	m.scroll += n
+	if maxScroll := len(m.renderLines()) - m.height; m.scroll > maxScroll {
+		m.scroll = max(0, maxScroll)
+	}
+}
+
+// ScrollUp scrolls the dashboard by n lines
+func (m *StatsDashboardModel) ScrollUp(n int) {
+	m.scroll -= n
+	if m.scroll < 0 {
+		m.scroll = 0
+	}
+}
+
+// View renders the visible window of the dashboard
+func (m *StatsDashboardModel) View() string {
+	lines := m.renderLines()
+	if m.height > 0 && len(lines) > m.height {
+		start := min(m.scroll, len(lines)-m.height)
+		lines = lines[start : start+m.height]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderLines renders every section of the dashboard
+func (m *StatsDashboardModel) renderLines() []string {
+	t := m.theme
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	hintStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+
+	lines := []string{
+		titleStyle.Render("📊 Stats Dashboard") + hintStyle.Render("  j/k scroll • G bottom • x export CSV • D/esc close"),
+		"",
+	}
+	lines = append(lines, m.renderAgingSection()...)
+	lines = append(lines, m.renderCumulativeFlowSection()...)
+	lines = append(lines, m.renderVelocitySection()...)
+	lines = append(lines, m.renderTrendSection()...)
+	lines = append(lines, m.renderLabelBurndownSection()...)
+	lines = append(lines, m.renderEpicBurnupSection()...)
+	return lines
+}
+
+// renderSectionHeader renders a section title with an underline sized to the content
+func (m *StatsDashboardModel) renderSectionHeader(title string) []string {
+	t := m.theme
+	headerStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	ruleStyle := t.Renderer.NewStyle().Foreground(t.Border)
+	width := max(20, min(m.width-4, 72))
+	return []string{
+		headerStyle.Render(title),
+		ruleStyle.Render(strings.Repeat("─", width)),
+	}
+}
+
+// renderAgingSection renders open issues bucketed by age, as stacked bars split by status
+func (m *StatsDashboardModel) renderAgingSection() []string {
+	t := m.theme
+	h := m.aging
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	countStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground()).Bold(true)
+	emptyStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+
+	lines := m.renderSectionHeader(fmt.Sprintf("Issue Aging (%d open)", h.Total))
+	if h.Total == 0 {
+		return append(lines, emptyStyle.Render("  No open issues with a creation date"), "")
+	}
+
+	labelWidth := 0
+	for _, b := range h.Buckets {
+		labelWidth = max(labelWidth, len(b.Label))
+	}
+	barWidth := max(10, min(m.width-labelWidth-14, 50))
+	maxTotal := h.MaxBucketTotal()
+
+	for i, bucket := range h.Buckets {
+		total := h.BucketTotal(i)
+		var bar strings.Builder
+		used := 0
+		for _, status := range h.Statuses {
+			n := h.Counts[status][i]
+			if n == 0 {
+				continue
+			}
+			seg := n * barWidth / maxTotal
+			if seg == 0 {
+				seg = 1 // keep small but non-zero segments visible
+			}
+			if used+seg > barWidth {
+				seg = barWidth - used
+			}
+			used += seg
+			bar.WriteString(t.Renderer.NewStyle().Foreground(t.GetStatusColor(string(status))).Render(strings.Repeat("█", seg)))
+		}
+		bar.WriteString(emptyStyle.Render(strings.Repeat("·", barWidth-used)))
+
+		lines = append(lines, fmt.Sprintf("  %s %s %s",
+			labelStyle.Render(fmt.Sprintf("%-*s", labelWidth, bucket.Label)),
+			bar.String(),
+			countStyle.Render(fmt.Sprintf("%d", total))))
+	}
+
+	// Legend with per-status totals
+	var legend []string
+	for _, status := range h.Statuses {
+		sum := 0
+		for _, n := range h.Counts[status] {
+			sum += n
+		}
+		style := t.Renderer.NewStyle().Foreground(t.GetStatusColor(string(status)))
+		legend = append(legend, style.Render("█")+labelStyle.Render(fmt.Sprintf(" %s %d", status, sum)))
+	}
+	lines = append(lines, "  "+strings.Join(legend, "  "))
+	if h.OldestID != "" {
+		lines = append(lines, labelStyle.Render(fmt.Sprintf("  Oldest open: %s (%dd)", h.OldestID, h.OldestAgeDays)))
+	}
+	return append(lines, "")
+}
+
+// cumulativeFlowChartHeight is the number of rows used by the cumulative flow chart
+const cumulativeFlowChartHeight = 10
+
+// renderCumulativeFlowSection renders created vs closed totals over time as a
+// stacked area chart: closed issues at the bottom, then in-progress, blocked
+// and the rest of the open backlog above them.
+func (m *StatsDashboardModel) renderCumulativeFlowSection() []string {
+	t := m.theme
+	points := m.flow.Points
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	emptyStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+	closedStyle := t.Renderer.NewStyle().Foreground(t.Closed)
+	progressStyle := t.Renderer.NewStyle().Foreground(t.InProgress)
+	blockedStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+	openStyle := t.Renderer.NewStyle().Foreground(t.Open)
+
+	lines := m.renderSectionHeader(fmt.Sprintf("Cumulative Flow (last %d days)", len(points)))
+	maxCreated := m.flow.MaxCreated()
+	if len(points) == 0 || maxCreated == 0 {
+		return append(lines, emptyStyle.Render("  No issues with a creation date"), "")
+	}
+
+	// Downsample to the available width, always keeping the most recent day
+	axisWidth := len(fmt.Sprintf("%d", maxCreated))
+	chartWidth := max(10, min(m.width-axisWidth-6, len(points)))
+	sampled := points
+	if len(points) > chartWidth {
+		sampled = make([]analysis.FlowPoint, chartWidth)
+		for i := range sampled {
+			sampled[i] = points[(i+1)*len(points)/chartWidth-1]
+		}
+	}
+
+	height := cumulativeFlowChartHeight
+	scale := func(v int) int {
+		return (v*height + maxCreated/2) / maxCreated
+	}
+	for row := height; row >= 1; row-- {
+		axis := strings.Repeat(" ", axisWidth)
+		if row == height {
+			axis = fmt.Sprintf("%*d", axisWidth, maxCreated)
+		} else if row == 1 {
+			axis = fmt.Sprintf("%*d", axisWidth, 0)
+		}
+		var sb strings.Builder
+		for _, p := range sampled {
+			switch {
+			case scale(p.Closed) >= row:
+				sb.WriteString(closedStyle.Render("█"))
+			case scale(p.Closed+p.InProgress) >= row:
+				sb.WriteString(progressStyle.Render("█"))
+			case scale(p.Closed+p.InProgress+p.Blocked) >= row:
+				sb.WriteString(blockedStyle.Render("█"))
+			case scale(p.Created) >= row:
+				sb.WriteString(openStyle.Render("█"))
+			default:
+				sb.WriteString(" ")
+			}
+		}
+		lines = append(lines, "  "+labelStyle.Render(axis)+" │"+sb.String())
+	}
+
+	first, last := points[0], points[len(points)-1]
+	startLabel := first.Date.Format("Jan 02")
+	endLabel := last.Date.Format("Jan 02")
+	gap := max(1, len(sampled)-len(startLabel)-len(endLabel))
+	lines = append(lines, "  "+strings.Repeat(" ", axisWidth)+"  "+labelStyle.Render(startLabel+strings.Repeat(" ", gap)+endLabel))
+
+	lines = append(lines, "  "+closedStyle.Render("█")+labelStyle.Render(fmt.Sprintf(" closed %d", last.Closed))+
+		"  "+progressStyle.Render("█")+labelStyle.Render(fmt.Sprintf(" in progress %d", last.InProgress))+
+		"  "+blockedStyle.Render("█")+labelStyle.Render(fmt.Sprintf(" blocked %d", last.Blocked))+
+		"  "+openStyle.Render("█")+labelStyle.Render(fmt.Sprintf(" open %d", last.Open-last.InProgress-last.Blocked)))
+
+	net := m.flow.NetChange()
+	trend := "steady"
+	if net > 0 {
+		trend = fmt.Sprintf("growing (+%d)", net)
+	} else if net < 0 {
+		trend = fmt.Sprintf("shrinking (%d)", net)
+	}
+	lines = append(lines, labelStyle.Render(fmt.Sprintf("  Backlog %s: %d → %d open", trend, first.Open, last.Open)))
+	return append(lines, "")
+}
+
+// renderVelocitySection renders closures per week as horizontal bars, newest last
+func (m *StatsDashboardModel) renderVelocitySection() []string {
+	t := m.theme
+	weeks := m.trend.Weeks
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	countStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground()).Bold(true)
+	emptyStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+	barStyle := t.Renderer.NewStyle().Foreground(t.Closed)
+
+	lines := m.renderSectionHeader(fmt.Sprintf("Velocity (closed per week, last %d weeks)", len(weeks)))
+	maxClosed := 0
+	for _, w := range weeks {
+		maxClosed = max(maxClosed, w.Closed)
+	}
+	if maxClosed == 0 {
+		return append(lines, emptyStyle.Render("  No issues closed in this window"), "")
+	}
+
+	barWidth := max(10, min(m.width-20, 50))
+	for i, w := range weeks {
+		n := w.Closed * barWidth / maxClosed
+		if w.Closed > 0 && n == 0 {
+			n = 1
+		}
+		label := w.WeekStart.Format("Jan 02")
+		if i == len(weeks)-1 {
+			label = "now   "
+		}
+		lines = append(lines, fmt.Sprintf("  %s %s%s %s",
+			labelStyle.Render(label),
+			barStyle.Render(strings.Repeat("█", n)),
+			emptyStyle.Render(strings.Repeat("·", barWidth-n)),
+			countStyle.Render(fmt.Sprintf("%d", w.Closed))))
+	}
+	lines = append(lines, labelStyle.Render(fmt.Sprintf("  Average: %.1f/week (last 4 weeks), %.1f/week (last %d)",
+		m.trend.AverageClosed(4), m.trend.AverageClosed(len(weeks)), len(weeks)-1)))
+	return append(lines, "")
+}
+
+// renderTrendSection renders opened vs closed per week as sparklines, plus the backlog size
+func (m *StatsDashboardModel) renderTrendSection() []string {
+	t := m.theme
+	weeks := m.trend.Weeks
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	emptyStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+
+	lines := m.renderSectionHeader("Open vs Closed Trend (per week)")
+	if len(weeks) == 0 || (m.trend.MaxWeekly() == 0 && weeks[len(weeks)-1].OpenAtEnd == 0) {
+		return append(lines, emptyStyle.Render("  No activity in this window"), "")
+	}
+
+	sum := func(series []int) int {
+		total := 0
+		for _, v := range series {
+			total += v
+		}
+		return total
+	}
+	maxWeekly := m.trend.MaxWeekly()
+	opened, closed, backlog := m.trend.OpenedSeries(), m.trend.ClosedSeries(), m.trend.BacklogSeries()
+	maxBacklog := 0
+	for _, v := range backlog {
+		maxBacklog = max(maxBacklog, v)
+	}
+
+	row := func(name string, style lipgloss.Style, spark, summary string) string {
+		return "  " + labelStyle.Render(fmt.Sprintf("%-8s", name)) + style.Render(spark) + "  " + labelStyle.Render(summary)
+	}
+	lines = append(lines,
+		row("opened", t.Renderer.NewStyle().Foreground(t.Open), buildSparkline(opened, maxWeekly), fmt.Sprintf("%d total", sum(opened))),
+		row("closed", t.Renderer.NewStyle().Foreground(t.Closed), buildSparkline(closed, maxWeekly), fmt.Sprintf("%d total", sum(closed))),
+		row("backlog", t.Renderer.NewStyle().Foreground(t.InProgress), buildSparkline(backlog, maxBacklog),
+			fmt.Sprintf("%d → %d open", backlog[0], backlog[len(backlog)-1])),
+	)
+
+	diff := sum(closed) - sum(opened)
+	verdict := "Closing as fast as work arrives"
+	if diff > 0 {
+		verdict = fmt.Sprintf("Closing faster than work arrives (+%d)", diff)
+	} else if diff < 0 {
+		verdict = fmt.Sprintf("Work arriving faster than it closes (%d)", diff)
+	}
+	lines = append(lines, labelStyle.Render("  "+verdict))
+	return append(lines, "")
+}
+
+// renderLabelBurndownSection renders remaining open issues per label, week by week
+func (m *StatsDashboardModel) renderLabelBurndownSection() []string {
+	t := m.theme
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	emptyStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+	nameStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	sparkStyle := t.Renderer.NewStyle().Foreground(t.Primary)
+	downStyle := t.Renderer.NewStyle().Foreground(t.Closed)
+	upStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+
+	lines := m.renderSectionHeader("Burndown by Label (remaining open per week)")
+	if len(m.burndowns) == 0 {
+		return append(lines, emptyStyle.Render("  No labeled work in this window"), "")
+	}
+
+	nameWidth := 0
+	for _, lb := range m.burndowns {
+		nameWidth = max(nameWidth, len([]rune(lb.Label)))
+	}
+	nameWidth = min(nameWidth, 20)
+
+	for _, lb := range m.burndowns {
+		peak := 0
+		for _, v := range lb.Remaining {
+			peak = max(peak, v)
+		}
+		first, last := lb.Remaining[0], lb.Remaining[len(lb.Remaining)-1]
+		change := labelStyle.Render("=")
+		if burned := lb.Burned(); burned > 0 {
+			change = downStyle.Render(fmt.Sprintf("▼%d", burned))
+		} else if burned < 0 {
+			change = upStyle.Render(fmt.Sprintf("▲%d", -burned))
+		}
+		lines = append(lines, fmt.Sprintf("  %s %s  %s %s %s",
+			nameStyle.Render(fmt.Sprintf("%-*s", nameWidth, truncateRunesHelper(lb.Label, nameWidth, "…"))),
+			sparkStyle.Render(buildSparkline(lb.Remaining, peak)),
+			labelStyle.Render(fmt.Sprintf("%3d → %-3d", first, last)),
+			change,
+			labelStyle.Render(fmt.Sprintf("(%d closed)", lb.Closed))))
+	}
+	return append(lines, "")
+}
+
+// burnupChartHeight is the number of rows used by each epic burnup chart
+const burnupChartHeight = 6
+
+// renderEpicBurnupSection renders each active epic's closed issues against its
+// scope, week by week; scope added since kickoff is drawn in the blocked color
+func (m *StatsDashboardModel) renderEpicBurnupSection() []string {
+	t := m.theme
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	emptyStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+	nameStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	doneStyle := t.Renderer.NewStyle().Foreground(t.Closed)
+	plannedStyle := t.Renderer.NewStyle().Foreground(t.Open)
+	addedStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+
+	lines := m.renderSectionHeader("Burnup by Epic (done vs scope per week)")
+	if len(m.burnups) == 0 {
+		return append(lines, emptyStyle.Render("  No epics with work in this window"), "")
+	}
+
+	for _, b := range m.burnups {
+		peak := 0
+		for _, v := range b.Scope {
+			peak = max(peak, v)
+		}
+		last := len(b.Scope) - 1
+		lines = append(lines, "  "+nameStyle.Render(b.EpicID)+" "+
+			truncateRunesHelper(b.Title, max(m.width-len(b.EpicID)-24, 10), "…")+
+			labelStyle.Render(fmt.Sprintf("  %d/%d done", b.Done[last], b.Scope[last])))
+		if peak == 0 {
+			lines = append(lines, emptyStyle.Render("  No issues with a creation date"), "")
+			continue
+		}
+
+		axisWidth := len(fmt.Sprintf("%d", peak))
+		colWidth := 1
+		if len(b.Scope)*3 <= m.width-axisWidth-8 {
+			colWidth = 2 // Room for wider weeks with a gap between them
+		}
+		scale := func(v int) int {
+			return (v*burnupChartHeight + peak/2) / peak
+		}
+		planned := burnupChartHeight + 1 // Above the chart: no kickoff split
+		if b.Planned > 0 {
+			planned = scale(b.Planned)
+		}
+		for row := burnupChartHeight; row >= 1; row-- {
+			axis := strings.Repeat(" ", axisWidth)
+			if row == burnupChartHeight {
+				axis = fmt.Sprintf("%*d", axisWidth, peak)
+			} else if row == 1 {
+				axis = fmt.Sprintf("%*d", axisWidth, 0)
+			}
+			var sb strings.Builder
+			for i := range b.Scope {
+				cell := strings.Repeat(" ", colWidth)
+				switch {
+				case scale(b.Done[i]) >= row:
+					cell = doneStyle.Render(strings.Repeat("█", colWidth))
+				case scale(b.Scope[i]) >= row && row > planned:
+					cell = addedStyle.Render(strings.Repeat("░", colWidth))
+				case scale(b.Scope[i]) >= row:
+					cell = plannedStyle.Render(strings.Repeat("░", colWidth))
+				}
+				sb.WriteString(cell)
+				if colWidth > 1 {
+					sb.WriteString(" ")
+				}
+			}
+			lines = append(lines, "  "+labelStyle.Render(axis)+" │"+sb.String())
+		}
+
+		summary := "no kickoff snapshot yet"
+		if b.Planned > 0 {
+			summary = fmt.Sprintf("%d planned at kickoff (%s)", b.Planned, b.KickoffAt.Format("Jan 02"))
+			if added := b.Added(); added > 0 {
+				summary += addedStyle.Render(fmt.Sprintf(", +%d added since", added))
+			} else if added < 0 {
+				summary += fmt.Sprintf(", %d removed since", -added)
+			}
+		}
+		lines = append(lines, "  "+strings.Repeat(" ", axisWidth)+"  "+labelStyle.Render(
+			fmt.Sprintf("%s → now · ", b.WeekStarts[0].Format("Jan 02")))+labelStyle.Render(summary), "")
+	}
+
+	lines = append(lines, "  "+doneStyle.Render("█")+labelStyle.Render(" done")+
+		"  "+plannedStyle.Render("░")+labelStyle.Render(" planned scope")+
+		"  "+addedStyle.Render("░")+labelStyle.Render(" added since kickoff"))
+	return append(lines, "")
+}
+
+// ExportCumulativeFlowCSV writes the cumulative flow series to a CSV file
+func (m *StatsDashboardModel) ExportCumulativeFlowCSV(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", filename, err)
+	}
+	if err := m.flow.WriteCSV(f); err != nil {
+		f.Close()
+		return fmt.Errorf("writing %s: %w", filename, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,146 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestStatsDashboardAgingSection(t *testing.T) {
+	theme := Theme{Renderer: lipgloss.DefaultRenderer()}
+	m := NewStatsDashboardModel(theme)
+	m.SetSize(100, 40)
+
+	now := time.Now().UTC()
+	issues := []model.Issue{
+		{ID: "young", Status: model.StatusOpen, CreatedAt: now.Add(-2 * 24 * time.Hour)},
+		{ID: "ancient", Status: model.StatusBlocked, CreatedAt: now.Add(-120 * 24 * time.Hour)},
+		{ID: "done", Status: model.StatusClosed, CreatedAt: now.Add(-400 * 24 * time.Hour)},
+	}
+	m.SetData(issues, now)
+
+	out := m.View()
+	for _, want := range []string{"Issue Aging (2 open)", "0-7d", "90d+", "Oldest open: ancient"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in view:\n%s", want, out)
+		}
+	}
+}
+
+func TestStatsDashboardEmptyAndScroll(t *testing.T) {
+	theme := Theme{Renderer: lipgloss.DefaultRenderer()}
+	m := NewStatsDashboardModel(theme)
+	m.SetSize(80, 3)
+	m.SetData(nil, time.Now())
+
+	if !strings.Contains(strings.Join(m.renderLines(), "\n"), "No open issues") {
+		t.Error("expected empty-state message")
+	}
+
+	m.ScrollDown(1000)
+	if want := len(m.renderLines()) - 3; m.scroll != want {
+		t.Errorf("scroll = %d, want clamp to %d", m.scroll, want)
+	}
+	m.ScrollUp(1000)
+	if m.scroll != 0 {
+		t.Errorf("scroll = %d, want 0", m.scroll)
+	}
+	if got := strings.Count(m.View(), "\n") + 1; got != 3 {
+		t.Errorf("view height = %d lines, want 3", got)
+	}
+}
+
+func TestStatsDashboardCumulativeFlow(t *testing.T) {
+	theme := Theme{Renderer: lipgloss.DefaultRenderer()}
+	m := NewStatsDashboardModel(theme)
+	m.SetSize(100, 60)
+
+	now := time.Now().UTC()
+	closedAt := now.Add(-5 * 24 * time.Hour)
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, CreatedAt: now.Add(-20 * 24 * time.Hour)},
+		{ID: "b", Status: model.StatusClosed, CreatedAt: now.Add(-10 * 24 * time.Hour), ClosedAt: &closedAt},
+		{ID: "c", Status: model.StatusOpen, CreatedAt: now.Add(-1 * 24 * time.Hour)},
+		{ID: "d", Status: model.StatusInProgress, CreatedAt: now.Add(-3 * 24 * time.Hour), UpdatedAt: now.Add(-2 * 24 * time.Hour)},
+		{ID: "e", Status: model.StatusBlocked, CreatedAt: now.Add(-3 * 24 * time.Hour)},
+	}
+	m.SetData(issues, now)
+
+	out := m.View()
+	for _, want := range []string{"Cumulative Flow", "closed 1", "in progress 1", "blocked 1", "open 2", "Backlog growing (+4)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in view:\n%s", want, out)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "flow.csv")
+	if err := m.ExportCumulativeFlowCSV(path); err != nil {
+		t.Fatalf("ExportCumulativeFlowCSV: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading csv: %v", err)
+	}
+	rows := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(rows) != 1+60 {
+		t.Errorf("csv rows = %d, want header + 60 days", len(rows))
+	}
+	if rows[len(rows)-1] != now.Format("2006-01-02")+",5,1,4,1,1" {
+		t.Errorf("last csv row = %q", rows[len(rows)-1])
+	}
+}
+
+func TestStatsDashboardVelocityAndBurndown(t *testing.T) {
+	theme := Theme{Renderer: lipgloss.DefaultRenderer()}
+	m := NewStatsDashboardModel(theme)
+	m.SetSize(100, 200)
+
+	now := time.Now().UTC()
+	closedAt := now.Add(-14 * 24 * time.Hour)
+	issues := []model.Issue{
+		{ID: "a", Labels: []string{"api"}, Status: model.StatusClosed, CreatedAt: now.Add(-100 * 24 * time.Hour), ClosedAt: &closedAt},
+		{ID: "b", Labels: []string{"api"}, Status: model.StatusOpen, CreatedAt: now.Add(-100 * 24 * time.Hour)},
+	}
+	m.SetData(issues, now)
+
+	out := m.View()
+	for _, want := range []string{"Velocity (closed per week", "Open vs Closed Trend", "Burndown by Label", "api", "2 → 1", "▼1", "(1 closed)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in view:\n%s", want, out)
+		}
+	}
+}
+
+func TestStatsDashboardEpicBurnup(t *testing.T) {
+	theme := Theme{Renderer: lipgloss.DefaultRenderer()}
+	m := NewStatsDashboardModel(theme)
+	m.SetSize(100, 300)
+
+	now := time.Now().UTC()
+	kickoff := now.Add(-30 * 24 * time.Hour)
+	closedAt := now.Add(-14 * 24 * time.Hour)
+	childOf := []*model.Dependency{{DependsOnID: "E", Type: model.DepParentChild}}
+	issues := []model.Issue{
+		{ID: "E", Title: "Checkout revamp", IssueType: model.TypeEpic, Status: model.StatusOpen, CreatedAt: now.Add(-60 * 24 * time.Hour)},
+		{ID: "a", Status: model.StatusClosed, CreatedAt: now.Add(-40 * 24 * time.Hour), ClosedAt: &closedAt, Dependencies: childOf},
+		{ID: "b", Status: model.StatusOpen, CreatedAt: now.Add(-40 * 24 * time.Hour), Dependencies: childOf},
+		{ID: "c", Status: model.StatusOpen, CreatedAt: now.Add(-3 * 24 * time.Hour), Dependencies: childOf},
+	}
+	m.SetEpicScope(&analysis.EpicScopeData{Epics: map[string]*analysis.EpicScopeSnapshot{
+		"E": {KickoffAt: kickoff, Members: []string{"a", "b"}},
+	}})
+	m.SetData(issues, now)
+
+	out := m.View()
+	for _, want := range []string{"Burnup by Epic", "E Checkout revamp", "1/3 done", "2 planned at kickoff", "+1 added since", "added since kickoff"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in view:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// statusMenuOptions are the statuses ctrl+x offers, each with its key
+var statusMenuOptions = []struct {
+	key    string
+	status model.Status
+}{
+	{"o", model.StatusOpen},
+	{"i", model.StatusInProgress},
+	{"b", model.StatusBlocked},
+	{"c", model.StatusClosed},
+}
+
+// StatusChangedMsg reports the outcome of writing a status through bd
+type StatusChangedMsg struct {
+	IssueID  string
+	Status   model.Status
+	Previous model.Status // Restored if the write failed
+	Err      error
+}
+
+// SetStatusCmd writes issueID's new status through the bd CLI, or records
+// it in dry when that is not nil
+func SetStatusCmd(workDir, issueID string, status, previous model.Status, dry *loader.DryRun) tea.Cmd {
+	return func() tea.Msg {
+		err := loader.SetStatus(workDir, issueID, status, dry)
+		return StatusChangedMsg{IssueID: issueID, Status: status, Previous: previous, Err: err}
+	}
+}
+
+// statusTargetID is the issue under the cursor of the view on screen, or ""
+// when the view has none
+func (m Model) statusTargetID() string {
+	switch {
+	case m.showReviewDashboard || m.focused == focusReviewDashboard:
+		if m.reviewDashboard != nil {
+			if issue := m.reviewDashboard.SelectedIssue(); issue != nil {
+				return issue.ID
+			}
+		}
+		return ""
+	case m.showLensDashboard || m.focused == focusLensDashboard:
+		return m.lensDashboard.SelectedIssueID()
+	}
+
+	switch m.focused {
+	case focusList, focusDetail:
+		if item, ok := m.list.SelectedItem().(IssueItem); ok {
+			return item.Issue.ID
+		}
+	case focusBoard:
+		if issue := m.board.SelectedIssue(); issue != nil {
+			return issue.ID
+		}
+	case focusGraph:
+		if issue := m.graphView.SelectedIssue(); issue != nil {
+			return issue.ID
+		}
+	case focusInsights:
+		return m.insightsPanel.SelectedIssueID()
+	case focusActionable:
+		return m.actionableView.SelectedIssueID()
+	case focusTable:
+		return m.tableView.SelectedIssueID()
+	}
+	return ""
+}
+
+// openStatusMenu opens the status menu on the selected issue, or explains
+// why the change can't be written. It returns false when the view has no
+// selected issue, leaving the key to the view.
+func (m *Model) openStatusMenu() bool {
+	id := m.statusTargetID()
+	issue, ok := m.issueMap[id]
+	if !ok {
+		return false
+	}
+	if reason := m.editRefusal("Status changes"); reason != "" {
+		m.statusMsg = reason
+		m.statusIsError = true
+		return true
+	}
+	m.statusMenuID = id
+	m.statusMenuCursor = 0
+	for i, o := range statusMenuOptions {
+		if o.status == issue.Status {
+			m.statusMenuCursor = i
+		}
+	}
+	m.showStatusMenu = true
+	return true
+}
+
+// editRefusal explains why edits written through bd can't be made right
+// now, or returns "" when they can. what names the edits, e.g. "Status
+// changes".
+func (m Model) editRefusal(what string) string {
+	switch {
+	case m.timeTravelMode:
+		return what + " are off while time-traveling"
+	case !loader.BdAvailable() && m.dryRun == nil:
+		return what + " need the bd CLI on PATH"
+	}
+	return ""
+}
+
+// handleStatusMenuKeys moves through the menu and sets the status picked,
+// by enter or by its key
+func (m Model) handleStatusMenuKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch key := msg.String(); key {
+	case "esc", "q", "ctrl+x":
+		m.showStatusMenu = false
+	case "j", "down":
+		m.statusMenuCursor = min(m.statusMenuCursor+1, len(statusMenuOptions)-1)
+	case "k", "up":
+		m.statusMenuCursor = max(m.statusMenuCursor-1, 0)
+	case "enter":
+		return m.setIssueStatus(m.statusMenuID, statusMenuOptions[m.statusMenuCursor].status)
+	default:
+		for _, o := range statusMenuOptions {
+			if key == o.key {
+				return m.setIssueStatus(m.statusMenuID, o.status)
+			}
+		}
+	}
+	return m, nil
+}
+
+// setIssueStatus applies the change at once and writes it through bd in
+// the background; StatusChangedMsg undoes it if the write fails
+func (m Model) setIssueStatus(id string, status model.Status) (Model, tea.Cmd) {
+	m.showStatusMenu = false
+	issue, ok := m.issueMap[id]
+	if !ok {
+		return m, nil
+	}
+	if issue.Status == status {
+		m.statusMsg = fmt.Sprintf("%s is already %s", m.theme.ID(id), status)
+		m.statusIsError = false
+		return m, nil
+	}
+	previous := issue.Status
+	m.applyStatus(id, status)
+	m.statusMsg = fmt.Sprintf("%s → %s…", m.theme.ID(id), status)
+	m.statusIsError = false
+	return m, SetStatusCmd(m.workDir, id, status, previous, m.dryRun)
+}
+
+// handleStatusChanged reports a status write, rolling the change back if it
+// failed
+func (m Model) handleStatusChanged(msg StatusChangedMsg) Model {
+	if msg.Err != nil {
+		m.applyStatus(msg.IssueID, msg.Previous)
+		m.statusMsg = fmt.Sprintf("Could not set %s to %s: %v", m.theme.ID(msg.IssueID), msg.Status, msg.Err)
+		m.statusIsError = true
+		return m
+	}
+	m.statusMsg = m.dryRunStatus(fmt.Sprintf("%s is now %s", m.theme.ID(msg.IssueID), msg.Status))
+	m.statusIsError = false
+	return m
+}
+
+// applyStatus changes id's status in memory and refreshes what derives from
+// it (see refreshAfterEdit)
+func (m *Model) applyStatus(id string, status model.Status) {
+	issue, ok := m.issueMap[id]
+	if !ok {
+		return
+	}
+	now := time.Now()
+	issue.Status = status
+	issue.UpdatedAt = now
+	if status.IsClosed() {
+		issue.ClosedAt = &now
+	} else {
+		issue.ClosedAt = nil
+	}
+	m.refreshAfterEdit(id)
+	if m.reviewDashboard != nil {
+		m.reviewDashboard.SetIssueStatus(id, status)
+	}
+}
+
+// refreshAfterEdit updates what derives from an issue changed in place:
+// counts, the filtered list, board and graph, and any open lens, keeping
+// the cursor on id. Analysis scores are left for the next reload.
+func (m *Model) refreshAfterEdit(id string) {
+	m.recountIssues()
+	if m.activeRecipe != nil {
+		m.applyRecipe(m.activeRecipe)
+	} else {
+		m.applyFilter()
+	}
+	m.selectIssueInList(id)
+	m.updateViewportContent()
+
+	if m.showLensDashboard {
+		if m.projectConfig != nil && m.projectConfig.EpicRollup {
+			m.lensDashboard.SetEpicRollups(analysis.ComputeEpicRollups(m.issues))
+		}
+		m.lensDashboard.RefreshIssues()
+	}
+}
+
+// renderStatusMenu renders the ctrl+x menu over the current view
+func (m Model) renderStatusMenu() string {
+	t := m.theme
+	issue := m.issueMap[m.statusMenuID]
+	if issue == nil {
+		return ""
+	}
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	keyStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	dimStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+
+	lines := []string{
+		titleStyle.Render("Set status"),
+		dimStyle.Render(m.theme.ID(issue.ID) + " " + truncateRunesHelper(issue.Title, 36, "...")),
+		"",
+	}
+	for i, o := range statusMenuOptions {
+		prefix := "  "
+		label := t.Renderer.NewStyle().Foreground(t.GetStatusColor(string(o.status))).Render(strings.ReplaceAll(string(o.status), "_", " "))
+		if i == m.statusMenuCursor {
+			prefix = "> "
+		}
+		line := prefix + keyStyle.Render(o.key) + "  " + label
+		if o.status == issue.Status {
+			line += dimStyle.Render(" (current)")
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "", dimStyle.Render("key or enter: set | esc: cancel"))
+
+	box := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestStatusMenuFromLens(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Gate", Status: model.StatusOpen, Labels: []string{"api"}},
+		{ID: "bv-2", Title: "Behind", Status: model.StatusOpen, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+	}
+	m := NewModel(issues, nil, "", WithDryRun(loader.NewDryRun())) // Writes are recorded, so bd isn't needed
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updated.(Model)
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+	if !m.lensDashboard.SelectIssue("bv-1") {
+		t.Fatal("bv-1 should be in the lens")
+	}
+	if len(m.lensDashboard.blockedByMap["bv-2"]) == 0 || m.countReady != 1 {
+		t.Fatalf("bv-2 should start blocked (ready = %d)", m.countReady)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlX})
+	m = updated.(Model)
+	if !m.showStatusMenu || !strings.Contains(stripAnsi(m.View()), "o  open (current)") {
+		t.Fatalf("ctrl+x should open the menu on bv-1:\n%s", stripAnsi(m.View()))
+	}
+
+	// Closing the gate frees bv-2 at once, before bd answers
+	updated, cmd := m.Update(keyMsg("c"))
+	m = updated.(Model)
+	if m.showStatusMenu || cmd == nil {
+		t.Fatal("c should close the menu and start the write")
+	}
+	if m.issueMap["bv-1"].Status != model.StatusClosed || m.countClosed != 1 || m.countReady != 1 || m.countOpen != 1 {
+		t.Errorf("counts open/ready/closed = %d/%d/%d", m.countOpen, m.countReady, m.countClosed)
+	}
+	if len(m.lensDashboard.blockedByMap["bv-2"]) != 0 || m.lensDashboard.SelectedIssueID() != "bv-1" {
+		t.Errorf("lens not rebuilt: blockers %v, cursor on %q", m.lensDashboard.blockedByMap["bv-2"], m.lensDashboard.SelectedIssueID())
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if changes := m.dryRun.Changes(); len(changes) != 1 || changes[0].Summary != "bd update bv-1 --status closed" {
+		t.Errorf("pending changes = %+v", changes)
+	}
+	if !strings.Contains(m.statusMsg, "bv-1 is now closed") {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+
+	// A failed write puts the old status back
+	updated, _ = m.Update(StatusChangedMsg{IssueID: "bv-1", Status: model.StatusClosed, Previous: model.StatusOpen, Err: errors.New("database locked")})
+	m = updated.(Model)
+	if m.issueMap["bv-1"].Status != model.StatusOpen || len(m.lensDashboard.blockedByMap["bv-2"]) == 0 || !m.statusIsError {
+		t.Errorf("rollback: bv-1 is %s, status %q", m.issueMap["bv-1"].Status, m.statusMsg)
+	}
+}
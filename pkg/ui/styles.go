@@ -59,6 +59,7 @@ var (
 	ColorPrioHigh     = lipgloss.Color("#FFB86C")
 	ColorPrioMedium   = lipgloss.Color("#F1FA8C")
 	ColorPrioLow      = lipgloss.Color("#50FA7B")
+	ColorPrioBacklog  = lipgloss.Color("#6272A4")
 
 	// Priority background colors
 	ColorPrioCriticalBg = lipgloss.Color("#3D1A1A")
@@ -114,13 +115,13 @@ func RenderPriorityBadge(priority int) string {
 	case 3:
 		fg, bg, label = ColorPrioLow, ColorPrioLowBg, "P3"
 	case 4:
-		fg, bg, label = ColorMuted, ColorBgSubtle, "P4"
+		fg, bg, label = ColorPrioBacklog, ColorBgSubtle, "P4"
 	default:
 		fg, bg, label = ColorMuted, ColorBgSubtle, "P?"
 	}
 
 	return lipgloss.NewStyle().
-		Foreground(fg).
+		Foreground(readableColor(fg, bg, minTextContrast)).
 		Background(bg).
 		Bold(true).
 		Padding(0, 0).
@@ -146,7 +147,7 @@ func RenderStatusBadge(status string) string {
 	}
 
 	return lipgloss.NewStyle().
-		Foreground(fg).
+		Foreground(readableColor(fg, bg, minTextContrast)).
 		Background(bg).
 		Padding(0, 0).
 		Render(label)
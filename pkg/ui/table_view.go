@@ -0,0 +1,370 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// tableColumn is one column of the table view
+type tableColumn struct {
+	name  string
+	width int // Cells; the title column grows into spare room
+}
+
+// tableColumns are the table view's columns in order. ID is always shown and
+// stays put while the rest scroll sideways.
+var tableColumns = []tableColumn{
+	{"ID", 12},
+	{"Title", 36},
+	{"Status", 11},
+	{"Pri", 3},
+	{"Assignee", 12},
+	{"Labels", 20},
+	{"Age", 8},
+	{"Deps", 9},
+}
+
+const (
+	tableColID = iota
+	tableColTitle
+	tableColStatus
+	tableColPriority
+	tableColAssignee
+	tableColLabels
+	tableColAge
+	tableColDeps
+)
+
+// tableRow is one issue of the table with the counts the Deps column shows
+type tableRow struct {
+	issue        model.Issue
+	openBlockers int // Open issues blocking this one
+	dependents   int // Open issues it blocks transitively (↑N)
+}
+
+// TableViewModel is a dense one-line-per-issue table for triage. Columns can
+// be hidden, and on terminals too narrow for the rest the columns after ID
+// scroll sideways.
+type TableViewModel struct {
+	rows   []tableRow
+	hidden map[int]bool
+	cursor int
+	scroll int // First row shown
+	colOff int // Scrollable columns skipped on the left
+	width  int
+	height int
+	theme  Theme
+}
+
+// NewTableViewModel creates an empty table view
+func NewTableViewModel(theme Theme) TableViewModel {
+	return TableViewModel{theme: theme, hidden: make(map[int]bool)}
+}
+
+// SetIssues fills the table with issues in the order given (the list's
+// filter and sort). issueMap resolves blockers; dependents are the
+// transitive open dependent counts.
+func (m *TableViewModel) SetIssues(issues []model.Issue, issueMap map[string]*model.Issue, dependents map[string]int) {
+	m.rows = make([]tableRow, len(issues))
+	for i, issue := range issues {
+		row := tableRow{issue: issue, dependents: dependents[issue.ID]}
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if blocker, ok := issueMap[dep.DependsOnID]; ok && !blocker.Status.IsClosed() {
+				row.openBlockers++
+			}
+		}
+		m.rows[i] = row
+	}
+	m.cursor = min(m.cursor, max(0, len(m.rows)-1))
+	m.clampScroll()
+}
+
+// SetSize updates the table dimensions
+func (m *TableViewModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.clampScroll()
+}
+
+// SelectedIssueID returns the ID of the issue under the cursor
+func (m *TableViewModel) SelectedIssueID() string {
+	if m.cursor < 0 || m.cursor >= len(m.rows) {
+		return ""
+	}
+	return m.rows[m.cursor].issue.ID
+}
+
+// Select moves the cursor to the issue with the given ID
+func (m *TableViewModel) Select(id string) bool {
+	for i, row := range m.rows {
+		if row.issue.ID == id {
+			m.cursor = i
+			m.clampScroll()
+			return true
+		}
+	}
+	return false
+}
+
+// MoveDown moves the cursor down n rows
+func (m *TableViewModel) MoveDown(n int) {
+	m.cursor = min(m.cursor+n, len(m.rows)-1)
+	m.cursor = max(m.cursor, 0)
+	m.clampScroll()
+}
+
+// MoveUp moves the cursor up n rows
+func (m *TableViewModel) MoveUp(n int) {
+	m.cursor = max(m.cursor-n, 0)
+	m.clampScroll()
+}
+
+// ScrollRight shows the next column on the right, if any are cut off
+func (m *TableViewModel) ScrollRight() {
+	if m.lastVisibleColumn() < m.lastShownColumn() {
+		m.colOff++
+	}
+}
+
+// ScrollLeft shows the previous column on the left
+func (m *TableViewModel) ScrollLeft() {
+	m.colOff = max(0, m.colOff-1)
+}
+
+// ToggleColumn shows or hides column n (1-based, ID excluded: 1 is Title).
+// It returns the column's name and whether it is now shown.
+func (m *TableViewModel) ToggleColumn(n int) (string, bool) {
+	if n < 1 || n >= len(tableColumns) {
+		return "", false
+	}
+	m.hidden[n] = !m.hidden[n]
+	m.colOff = min(m.colOff, max(0, len(m.scrollableColumns())-1))
+	return tableColumns[n].name, !m.hidden[n]
+}
+
+// ShowAllColumns shows every column again
+func (m *TableViewModel) ShowAllColumns() {
+	clear(m.hidden)
+}
+
+// rowsHeight is how many issue rows fit under the title, header and rule
+func (m *TableViewModel) rowsHeight() int {
+	return max(1, m.height-3)
+}
+
+func (m *TableViewModel) clampScroll() {
+	h := m.rowsHeight()
+	if m.cursor < m.scroll {
+		m.scroll = m.cursor
+	}
+	if m.cursor >= m.scroll+h {
+		m.scroll = m.cursor - h + 1
+	}
+	m.scroll = max(0, min(m.scroll, len(m.rows)-h))
+}
+
+// scrollableColumns returns the shown columns after ID
+func (m *TableViewModel) scrollableColumns() []int {
+	var cols []int
+	for i := 1; i < len(tableColumns); i++ {
+		if !m.hidden[i] {
+			cols = append(cols, i)
+		}
+	}
+	return cols
+}
+
+// lastShownColumn is the rightmost column not hidden
+func (m *TableViewModel) lastShownColumn() int {
+	cols := m.scrollableColumns()
+	if len(cols) == 0 {
+		return tableColID
+	}
+	return cols[len(cols)-1]
+}
+
+// lastVisibleColumn is the rightmost column that fits at the current offset
+func (m *TableViewModel) lastVisibleColumn() int {
+	cols, _ := m.layout()
+	return cols[len(cols)-1]
+}
+
+// layout picks the columns that fit the width from the scroll offset on,
+// ID first, and their widths. Spare room goes to the title.
+func (m *TableViewModel) layout() ([]int, []int) {
+	cols := []int{tableColID}
+	widths := []int{tableColumns[tableColID].width}
+	used := widths[0]
+	scrollable := m.scrollableColumns()
+	for i, col := range scrollable {
+		if i < m.colOff {
+			continue
+		}
+		w := tableColumns[col].width
+		if used+1+w > m.width && len(cols) > 1 {
+			break
+		}
+		cols = append(cols, col)
+		widths = append(widths, w)
+		used += 1 + w
+	}
+	if spare := m.width - used; spare > 0 {
+		for i, col := range cols {
+			if col == tableColTitle {
+				widths[i] += spare
+			}
+		}
+	}
+	return cols, widths
+}
+
+// View renders the table
+func (m *TableViewModel) View() string {
+	t := m.theme
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	hintStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Faint(true)
+	headerStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	ruleStyle := t.Renderer.NewStyle().Foreground(t.Border)
+
+	cols, widths := m.layout()
+
+	title := titleStyle.Render(fmt.Sprintf("▦ Table (%d)", len(m.rows)))
+	hint := "  1-7 toggle columns • 0 all • ←/→ scroll • ⏎ open • R/esc close"
+	if hidden := m.hiddenNames(); hidden != "" {
+		hint = "  hidden: " + hidden + " •" + hint[1:]
+	}
+	if m.colOff > 0 || m.lastVisibleColumn() < m.lastShownColumn() {
+		hint = fmt.Sprintf("  ◂ %d/%d ▸", m.colOff+1, len(m.scrollableColumns())) + hint
+	}
+	lines := []string{title + hintStyle.Render(truncateRunesHelper(hint, m.width-lipgloss.Width(title), "…"))}
+
+	var header []string
+	for i, col := range cols {
+		header = append(header, headerStyle.Render(fitCell(tableColumns[col].name, widths[i])))
+	}
+	lines = append(lines, strings.Join(header, " "))
+	lines = append(lines, ruleStyle.Render(strings.Repeat("─", max(0, min(m.width, sumWidths(widths))))))
+
+	if len(m.rows) == 0 {
+		lines = append(lines, hintStyle.Render("  No issues match the current filter"))
+		return strings.Join(lines, "\n")
+	}
+	end := min(len(m.rows), m.scroll+m.rowsHeight())
+	for i := m.scroll; i < end; i++ {
+		lines = append(lines, m.renderRow(m.rows[i], cols, widths, i == m.cursor))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderRow renders one issue across the given columns
+func (m *TableViewModel) renderRow(row tableRow, cols, widths []int, selected bool) string {
+	t := m.theme
+	issue := row.issue
+	base := t.Renderer.NewStyle()
+	if selected {
+		base = base.Background(t.Highlight).Bold(true)
+	} else if issue.Status.IsClosed() {
+		base = base.Foreground(t.Subtext).Faint(true)
+	}
+
+	cells := make([]string, len(cols))
+	for i, col := range cols {
+		style := base
+		var text string
+		switch col {
+		case tableColID:
+			text = m.theme.ID(issue.ID)
+			if !selected {
+				style = style.Foreground(t.Secondary)
+			}
+		case tableColTitle:
+			text = issue.Title
+		case tableColStatus:
+			text = strings.ReplaceAll(string(issue.Status), "_", " ")
+			if !selected && !issue.Status.IsClosed() {
+				style = style.Foreground(t.GetStatusColor(string(issue.Status)))
+			}
+		case tableColPriority:
+			text = fmt.Sprintf("P%d", issue.Priority)
+			if !selected && !issue.Status.IsClosed() {
+				style = style.Foreground(priorityColor(issue.Priority))
+			}
+		case tableColAssignee:
+			if issue.Assignee != "" {
+				text = "@" + issue.Assignee
+			}
+		case tableColLabels:
+			text = strings.Join(issue.Labels, ",")
+		case tableColAge:
+			text = FormatTimeRel(issue.CreatedAt)
+		case tableColDeps:
+			text = depsCell(row)
+		}
+		cells[i] = style.Render(fitCell(text, widths[i]))
+	}
+	sep := base.Render(" ")
+	return strings.Join(cells, sep)
+}
+
+// depsCell shows open blockers (✕N) and transitive dependents (↑N)
+func depsCell(row tableRow) string {
+	var parts []string
+	if row.openBlockers > 0 {
+		parts = append(parts, fmt.Sprintf("✕%d", row.openBlockers))
+	}
+	if row.dependents > 0 {
+		parts = append(parts, fmt.Sprintf("↑%d", row.dependents))
+	}
+	return strings.Join(parts, " ")
+}
+
+// priorityColor is the badge color of a priority
+func priorityColor(priority int) lipgloss.Color {
+	switch priority {
+	case 0:
+		return ColorPrioCritical
+	case 1:
+		return ColorPrioHigh
+	case 2:
+		return ColorPrioMedium
+	case 3:
+		return ColorPrioLow
+	default:
+		return ColorPrioBacklog
+	}
+}
+
+// hiddenNames lists the hidden columns, comma-separated
+func (m *TableViewModel) hiddenNames() string {
+	var names []string
+	for i := 1; i < len(tableColumns); i++ {
+		if m.hidden[i] {
+			names = append(names, tableColumns[i].name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// fitCell truncates or pads s to exactly width cells
+func fitCell(s string, width int) string {
+	s = truncateRunesHelper(s, width, "…")
+	if w := lipgloss.Width(s); w < width {
+		s += strings.Repeat(" ", width-w)
+	}
+	return s
+}
+
+func sumWidths(widths []int) int {
+	total := max(0, len(widths)-1)
+	for _, w := range widths {
+		total += w
+	}
+	return total
+}
@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestTableViewColumns(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Schema", Status: model.StatusOpen, Priority: 1, Assignee: "ana", Labels: []string{"db"}},
+		{ID: "bv-2", Title: "API", Status: model.StatusOpen, Priority: 2, Dependencies: []*model.Dependency{
+			{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepBlocks},
+		}},
+	}
+	issueMap := map[string]*model.Issue{"bv-1": &issues[0], "bv-2": &issues[1]}
+	m := NewTableViewModel(DefaultTheme(lipgloss.DefaultRenderer()))
+	m.SetIssues(issues, issueMap, map[string]int{"bv-1": 1})
+	m.SetSize(140, 10)
+
+	view := stripAnsi(m.View())
+	for _, want := range []string{"ID", "Title", "Status", "Pri", "Assignee", "Labels", "Age", "Deps", "@ana", "✕1", "↑1"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("missing %q in:\n%s", want, view)
+		}
+	}
+	for i, line := range strings.Split(view, "\n")[1:] {
+		if w := lipgloss.Width(line); w > 140 {
+			t.Errorf("line %d is %d cells wide, over 140", i+1, w)
+		}
+	}
+
+	// Hiding Assignee (column 4) drops it, 0 brings it back
+	if name, shown := m.ToggleColumn(4); name != "Assignee" || shown {
+		t.Errorf("toggle 4 = %q shown %v", name, shown)
+	}
+	if view := stripAnsi(m.View()); strings.Contains(view, "@ana") || !strings.Contains(view, "hidden: Assignee") {
+		t.Errorf("assignee should be hidden:\n%s", view)
+	}
+	m.ShowAllColumns()
+	if !strings.Contains(stripAnsi(m.View()), "@ana") {
+		t.Error("0 should show every column")
+	}
+
+	// On a narrow terminal ID stays and the rest scroll sideways
+	m.SetSize(50, 10)
+	if view := stripAnsi(m.View()); !strings.Contains(view, "Title") || strings.Contains(view, "Deps") {
+		t.Errorf("narrow table should start at the title:\n%s", view)
+	}
+	for range tableColumns {
+		m.ScrollRight()
+	}
+	view = stripAnsi(m.View())
+	if !strings.Contains(view, "bv-1") || !strings.Contains(view, "Deps") || strings.Contains(view, "Title") {
+		t.Errorf("scrolled table should keep ID and reach Deps:\n%s", view)
+	}
+	for i, line := range strings.Split(view, "\n")[1:] {
+		if w := lipgloss.Width(line); w > 50 {
+			t.Errorf("line %d is %d cells wide, over 50", i+1, w)
+		}
+	}
+}
+
+func TestTableViewOpensFromList(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "One", Status: model.StatusOpen, Priority: 2},
+		{ID: "bv-2", Title: "Two", Status: model.StatusOpen, Priority: 1},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m = updated.(Model)
+
+	updated, _ = m.Update(keyMsg("R"))
+	m = updated.(Model)
+	if m.focused != focusTable || !strings.Contains(stripAnsi(m.View()), "Table (2)") {
+		t.Fatalf("R should open the table, focus %v", m.focused)
+	}
+	updated, _ = m.Update(keyMsg("j"))
+	m = updated.(Model)
+	selected := m.tableView.SelectedIssueID()
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.focused == focusTable {
+		t.Fatal("enter should leave the table")
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != selected {
+		t.Errorf("list selection = %v, want %s", m.list.SelectedItem(), selected)
+	}
+}
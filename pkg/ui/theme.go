@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -30,6 +31,12 @@ type Theme struct {
 	Highlight lipgloss.AdaptiveColor
 	Muted     lipgloss.AdaptiveColor
 
+	// ASCII draws the UI's glyphs and borders in ASCII (see Glyph)
+	ASCII bool
+
+	// ids are the on-screen issue IDs (see ID), shared by every copy
+	ids *idAliases
+
 	// Styles
 	Base     lipgloss.Style
 	Selected lipgloss.Style
@@ -63,8 +70,15 @@ func DefaultTheme(r *lipgloss.Renderer) Theme {
 		Highlight: lipgloss.AdaptiveColor{Light: "#E0E0E0", Dark: "#44475A"}, // Slightly darker
 		Muted:     lipgloss.AdaptiveColor{Light: "#555555", Dark: "#6272A4"}, // Dimmed text (was #888888, now ~7:1)
 	}
+	t.initStyles(lipgloss.AdaptiveColor{Light: "#000000", Dark: "#F8F8F2"})
+	return t
+}
 
-	t.Base = r.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#000000", Dark: "#F8F8F2"})
+// initStyles derives the shared styles from the theme's colors, with text
+// as the base foreground
+func (t *Theme) initStyles(text lipgloss.AdaptiveColor) {
+	r := t.Renderer
+	t.Base = r.NewStyle().Foreground(text)
 
 	t.Selected = r.NewStyle().
 		Background(t.Highlight).
@@ -78,11 +92,17 @@ func DefaultTheme(r *lipgloss.Renderer) Theme {
 		Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#282A36"}).
 		Bold(true).
 		Padding(0, 1)
-
-	return t
 }
 
+// GetStatusColor returns the color for a status. Custom statuses use their
+// configured color, else the color of the column they map onto.
 func (t Theme) GetStatusColor(s string) lipgloss.AdaptiveColor {
+	if def, ok := model.LookupCustomStatus(model.Status(s)); ok {
+		if def.Color != "" {
+			return lipgloss.AdaptiveColor{Light: def.Color, Dark: def.Color}
+		}
+		s = string(def.Column)
+	}
 	switch s {
 	case "open":
 		return t.Open
@@ -98,21 +118,21 @@ func (t Theme) GetStatusColor(s string) lipgloss.AdaptiveColor {
 }
 
 func (t Theme) GetTypeIcon(typ string) (string, lipgloss.AdaptiveColor) {
+	icon, color := "•", t.Subtext
 	switch typ {
 	case "bug":
-		return "🐛", t.Bug
+		icon, color = "🐛", t.Bug
 	case "feature":
-		return "✨", t.Feature
+		icon, color = "✨", t.Feature
 	case "task":
-		return "📋", t.Task
+		icon, color = "📋", t.Task
 	case "epic":
 		// Use 🚀 instead of 🏔️ - the snow-capped mountain has a variation selector
 		// (U+FE0F) that causes inconsistent width calculations across terminals
-		return "🚀", t.Epic
+		icon, color = "🚀", t.Epic
 	case "chore":
-		return "🧹", t.Chore
-	default:
-		return "•", t.Subtext
+		icon, color = "🧹", t.Chore
 	}
+	return t.Glyph(icon), color
 }
 
@@ -0,0 +1,271 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/themes"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// paletteTheme builds the Theme for p: the default theme with every color
+// the palette sets replaced, tuned for contrast on light and dark terminals
+func paletteTheme(p themes.Palette, r *lipgloss.Renderer) Theme {
+	t := DefaultTheme(r)
+	set := func(dst *lipgloss.AdaptiveColor, hex string) {
+		if hex != "" {
+			*dst = lipgloss.AdaptiveColor{Light: hex, Dark: hex}
+		}
+	}
+	set(&t.Primary, p.Primary)
+	set(&t.Secondary, p.Secondary)
+	set(&t.Subtext, p.Subtext)
+	set(&t.Muted, p.Muted)
+	set(&t.Border, p.Border)
+	set(&t.Highlight, p.Highlight)
+	set(&t.Open, p.Status["open"])
+	set(&t.InProgress, p.Status["in_progress"])
+	set(&t.Blocked, p.Status["blocked"])
+	set(&t.Closed, p.Status["closed"])
+	set(&t.Bug, p.Type["bug"])
+	set(&t.Feature, p.Type["feature"])
+	set(&t.Task, p.Type["task"])
+	set(&t.Epic, p.Type["epic"])
+	set(&t.Chore, p.Type["chore"])
+
+	t.tuneContrast()
+
+	text := lipgloss.AdaptiveColor{Light: "#000000", Dark: "#F8F8F2"}
+	set(&text, p.Text)
+	text.Light = readableOn(text.Light, lightTerminalBg, minTextContrast)
+	text.Dark = readableOn(text.Dark, darkTerminalBg, minTextContrast)
+	t.initStyles(text)
+	return t
+}
+
+// paletteTokens ties the package color tokens, which badges and panels
+// read directly, to the palette color that overrides each
+var paletteTokens = []struct {
+	token *lipgloss.Color
+	color func(themes.Palette) string
+}{
+	{&ColorPrimary, func(p themes.Palette) string { return p.Primary }},
+	{&ColorSecondary, func(p themes.Palette) string { return p.Secondary }},
+	{&ColorSubtext, func(p themes.Palette) string { return p.Subtext }},
+	{&ColorText, func(p themes.Palette) string { return p.Text }},
+	{&ColorMuted, func(p themes.Palette) string { return p.Muted }},
+	{&ColorBgHighlight, func(p themes.Palette) string { return p.Highlight }},
+	{&ColorStatusOpen, func(p themes.Palette) string { return p.Status["open"] }},
+	{&ColorStatusInProgress, func(p themes.Palette) string { return p.Status["in_progress"] }},
+	{&ColorStatusBlocked, func(p themes.Palette) string { return p.Status["blocked"] }},
+	{&ColorStatusClosed, func(p themes.Palette) string { return p.Status["closed"] }},
+	{&ColorPrioCritical, func(p themes.Palette) string { return p.Priority["p0"] }},
+	{&ColorPrioHigh, func(p themes.Palette) string { return p.Priority["p1"] }},
+	{&ColorPrioMedium, func(p themes.Palette) string { return p.Priority["p2"] }},
+	{&ColorPrioLow, func(p themes.Palette) string { return p.Priority["p3"] }},
+	{&ColorPrioBacklog, func(p themes.Palette) string { return p.Priority["p4"] }},
+	{&ColorTypeBug, func(p themes.Palette) string { return p.Type["bug"] }},
+	{&ColorTypeFeature, func(p themes.Palette) string { return p.Type["feature"] }},
+	{&ColorTypeTask, func(p themes.Palette) string { return p.Type["task"] }},
+	{&ColorTypeEpic, func(p themes.Palette) string { return p.Type["epic"] }},
+	{&ColorTypeChore, func(p themes.Palette) string { return p.Type["chore"] }},
+}
+
+// defaultTokens holds the tokens' built-in values, restored before each
+// palette is applied
+var defaultTokens = func() []lipgloss.Color {
+	colors := make([]lipgloss.Color, len(paletteTokens))
+	for i, pt := range paletteTokens {
+		colors[i] = *pt.token
+	}
+	return colors
+}()
+
+// applyPaletteTokens points the package color tokens and panel borders at p
+func applyPaletteTokens(p themes.Palette) {
+	for i, pt := range paletteTokens {
+		*pt.token = defaultTokens[i]
+		if hex := pt.color(p); hex != "" {
+			*pt.token = lipgloss.Color(hex)
+		}
+	}
+	border := lipgloss.Color("#44475A")
+	if p.Border != "" {
+		border = lipgloss.Color(p.Border)
+	}
+	PanelStyle = PanelStyle.BorderForeground(border)
+	FocusedPanelStyle = FocusedPanelStyle.BorderForeground(ColorPrimary)
+}
+
+// usePalette switches the whole UI to palette i of m.themes
+func (m *Model) usePalette(i int) {
+	p := m.themes[i]
+	applyPaletteTokens(p)
+	m.setTheme(paletteTheme(p, m.theme.Renderer))
+}
+
+// setTheme hands t to every view that renders with a theme
+func (m *Model) setTheme(t Theme) {
+	t.ASCII = m.ascii
+	t.ids = m.theme.ids
+	m.theme = t
+	m.updateListDelegate()
+	m.list.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(t.Primary)
+	m.list.Styles.FilterCursor = lipgloss.NewStyle().Foreground(t.Primary)
+	if m.renderer != nil {
+		m.renderer.SetWidthWithTheme(m.renderer.width, t)
+	}
+
+	m.board.theme = t
+	m.labelDashboard.theme = t
+	m.velocityComparison.theme = t
+	m.shortcutsSidebar.theme = t
+	m.graphView.theme = t
+	m.insightsPanel.theme = t
+	m.flowMatrix.theme = t
+	m.lensDashboard.theme = t
+	m.lensSelector.theme = t
+	m.statsDashboard.theme = t
+	m.actionableView.theme = t
+	m.historyView.theme = t
+	m.recipePicker.theme = t
+	m.labelPicker.theme = t
+	m.repoPicker.theme = t
+	m.commandPalette.theme = t
+	m.jump.theme = t
+	m.tutorialModel.theme = t
+	if m.reviewDashboard != nil {
+		m.reviewDashboard.theme = t
+	}
+	m.updateViewportContent()
+}
+
+// toggleThemeGallery opens the theme gallery on the palette in use, or closes it
+func (m *Model) toggleThemeGallery() {
+	if m.showThemeGallery {
+		m.closeThemeGallery()
+		return
+	}
+	m.showThemeGallery = true
+	m.themeGalleryCursor = m.themeIndex
+}
+
+// closeThemeGallery closes the gallery, undoing an unconfirmed preview
+func (m *Model) closeThemeGallery() {
+	m.showThemeGallery = false
+	if m.themeGalleryCursor != m.themeIndex {
+		m.usePalette(m.themeIndex)
+	}
+}
+
+// handleThemeGalleryKeys previews each palette as the cursor reaches it;
+// enter keeps the previewed one for this session
+func (m Model) handleThemeGalleryKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		if m.themeGalleryCursor < len(m.themes)-1 {
+			m.themeGalleryCursor++
+			m.usePalette(m.themeGalleryCursor)
+		}
+	case "k", "up":
+		if m.themeGalleryCursor > 0 {
+			m.themeGalleryCursor--
+			m.usePalette(m.themeGalleryCursor)
+		}
+	case "enter":
+		m.themeIndex = m.themeGalleryCursor
+		m.showThemeGallery = false
+		name := m.themes[m.themeIndex].Name
+		m.statusMsg = fmt.Sprintf("Theme %s (add color_theme: %s to .bv.yaml to keep it)", name, name)
+		m.statusIsError = false
+	case "esc", "q", "ctrl+t":
+		m.closeThemeGallery()
+	}
+	return m
+}
+
+// renderThemeGallery renders the gallery: the palettes on the left and a
+// preview of the one under the cursor, already applied, on the right
+func (m Model) renderThemeGallery() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(min(80, m.width-4)).
+		MaxHeight(m.height - 4)
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	var names []string
+	for i, p := range m.themes {
+		line := "  " + p.Name
+		if i == m.themeGalleryCursor {
+			line = titleStyle.Render("▸ " + p.Name)
+		}
+		if i == m.themeIndex {
+			line += mutedStyle.Render(" ✓")
+		}
+		if p.Source != "builtin" {
+			line += mutedStyle.Render(" (user)")
+		}
+		names = append(names, line)
+	}
+
+	var preview []string
+	preview = append(preview, t.Header.Render("Preview"), "")
+	var statuses, priorities, types []string
+	for _, s := range themes.StatusKeys {
+		statuses = append(statuses, RenderStatusBadge(s))
+	}
+	for p := 0; p < len(themes.PriorityKeys); p++ {
+		priorities = append(priorities, RenderPriorityBadge(p))
+	}
+	for _, typ := range themes.TypeKeys {
+		icon, color := t.GetTypeIcon(typ)
+		types = append(types, icon+" "+t.Renderer.NewStyle().Foreground(color).Render(typ))
+	}
+	preview = append(preview,
+		strings.Join(statuses, " "),
+		strings.Join(priorities, " "),
+		strings.Join(types, " "),
+		"",
+		t.Selected.Render("bv-42 Fix the login redirect"),
+		t.Base.Render("  bv-43 Add dark mode toggle"),
+		mutedStyle.Render("  bv-17 Closed and done"),
+		"",
+		t.Renderer.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(t.Border).Padding(0, 1).
+			Render(t.Renderer.NewStyle().Foreground(t.Secondary).Render("Panel border")),
+	)
+
+	body := lipgloss.JoinHorizontal(lipgloss.Top,
+		t.Renderer.NewStyle().Width(22).Render(strings.Join(names, "\n")),
+		strings.Join(preview, "\n"),
+	)
+
+	background, how := "dark", "detected"
+	if !m.darkBackground {
+		background = "light"
+	}
+	if m.backgroundSet {
+		how = "set"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("🎨 Themes"))
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("  %s background (%s; --theme dark|light overrides)", background, how)))
+	sb.WriteString("\n\n")
+	sb.WriteString(body)
+	sb.WriteString("\n\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k: preview • Enter: use • Esc: cancel • custom themes: ~/.config/bv/themes/*.yaml"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/themes"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestThemeGalleryPreviewsAndRestores(t *testing.T) {
+	t.Cleanup(func() { applyPaletteTokens(themes.Palette{}) })
+
+	m := NewModel([]model.Issue{{ID: "bv-1", Title: "One", Status: model.StatusOpen}}, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updated.(Model)
+	m.themes = []themes.Palette{
+		{Name: themes.Default, Source: "builtin"},
+		{Name: "mine", Primary: "#FF00AA", Priority: map[string]string{"p0": "#AA0000"}, Source: "/tmp/mine.yaml"},
+	}
+	defaultPrimary, defaultP0 := m.theme.Primary, ColorPrioCritical
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	m = updated.(Model)
+	if !m.showThemeGallery {
+		t.Fatal("ctrl+t should open the theme gallery")
+	}
+	if view := stripAnsi(m.View()); !strings.Contains(view, "🎨 Themes") || !strings.Contains(view, "mine (user)") {
+		t.Errorf("expected the palettes listed, got:\n%s", view)
+	}
+
+	// Moving previews the palette at once
+	updated, _ = m.Update(keyMsg("j"))
+	m = updated.(Model)
+	if want := (lipgloss.AdaptiveColor{Light: "#FF00AA", Dark: "#FF00AA"}); m.theme.Primary != want || m.board.theme.Primary != want {
+		t.Errorf("preview primary = %v, want %v", m.theme.Primary, want)
+	}
+	if ColorPrioCritical != "#AA0000" {
+		t.Errorf("preview P0 = %v, want #AA0000", ColorPrioCritical)
+	}
+
+	// Esc undoes the preview
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.showThemeGallery || m.theme.Primary != defaultPrimary || ColorPrioCritical != defaultP0 {
+		t.Errorf("esc should restore the default palette, got primary %v, P0 %v", m.theme.Primary, ColorPrioCritical)
+	}
+
+	// Enter keeps it
+	for _, key := range []tea.KeyMsg{{Type: tea.KeyCtrlT}, keyMsg("j"), {Type: tea.KeyEnter}} {
+		updated, _ = m.Update(key)
+		m = updated.(Model)
+	}
+	if m.showThemeGallery || m.themeIndex != 1 || ColorPrioCritical != "#AA0000" {
+		t.Errorf("enter should keep mine, got index %d, P0 %v", m.themeIndex, ColorPrioCritical)
+	}
+	if !strings.Contains(m.statusMsg, "color_theme: mine") {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+}
@@ -345,6 +345,7 @@ func structuredTutorialPages() []StructuredTutorialPage {
 					{Key: "o", Desc: "Open issues only"},
 					{Key: "c", Desc: "Closed issues only"},
 					{Key: "r", Desc: "Ready (no blockers)"},
+					{Key: "A", Desc: "Affected by changes (time-travel)"},
 					{Key: "a", Desc: "All (reset filter)"},
 				}},
 				Spacer{Lines: 1},
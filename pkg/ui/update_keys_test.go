@@ -1,7 +1,9 @@
 package ui
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	tea "github.com/charmbracelet/bubbletea"
@@ -137,3 +139,145 @@ func TestHistoryViewKeys(t *testing.T) {
 		t.Fatalf("expected confidence to change after 'c' key")
 	}
 }
+
+func TestSortDependentsPutsHighestLeverageFirst(t *testing.T) {
+	blocks := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		{ID: "leaf", Title: "Leaf", Status: model.StatusOpen, Priority: 0},
+		{ID: "mid", Title: "Mid", Status: model.StatusOpen, Priority: 2, Dependencies: blocks("root")},
+		{ID: "top", Title: "Top", Status: model.StatusOpen, Priority: 2, Dependencies: blocks("mid")},
+		{ID: "root", Title: "Root", Status: model.StatusOpen, Priority: 3},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	for m.sortMode != SortDependents {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+		m = updated.(Model)
+		if m.sortMode == SortDefault {
+			t.Fatal("sort cycle never reached SortDependents")
+		}
+	}
+
+	want := []string{"root", "mid", "leaf", "top"}
+	items := m.list.Items()
+	for i, id := range want {
+		item := items[i].(IssueItem)
+		if item.Issue.ID != id {
+			t.Fatalf("position %d = %s, want %s", i, item.Issue.ID, id)
+		}
+	}
+	if got := items[0].(IssueItem).DependentsCount; got != 2 {
+		t.Errorf("root DependentsCount = %d, want 2", got)
+	}
+}
+
+func TestSortStalePutsLongestIdleFirst(t *testing.T) {
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "recent", Title: "Recent", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -2)},
+		{ID: "done", Title: "Done", Status: model.StatusClosed, CreatedAt: now.AddDate(0, 0, -90)},
+		{ID: "idle", Title: "Idle", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -60), UpdatedAt: now.AddDate(0, 0, -40)},
+		{ID: "aging", Title: "Aging", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -20)},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	for m.sortMode != SortStale {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+		m = updated.(Model)
+		if m.sortMode == SortDefault {
+			t.Fatal("sort cycle never reached SortStale")
+		}
+	}
+
+	want := []string{"idle", "aging", "recent", "done"}
+	items := m.list.Items()
+	for i, id := range want {
+		if got := items[i].(IssueItem).Issue.ID; got != id {
+			t.Fatalf("position %d = %s, want %s", i, got, id)
+		}
+	}
+	if !strings.Contains(m.View(), "idle ⚠ Idle") {
+		t.Error("stale issue should carry a warning badge")
+	}
+}
+
+func TestSortEffectiveFloatsBlockersOfUrgentWork(t *testing.T) {
+	blocks := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		{ID: "p1", Title: "High", Priority: 1, Status: model.StatusOpen},
+		{ID: "gate", Title: "Gate", Priority: 3, Status: model.StatusOpen},
+		{ID: "urgent", Title: "Urgent", Priority: 0, Status: model.StatusOpen, Dependencies: blocks("gate")},
+		{ID: "p2", Title: "Medium", Priority: 2, Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	if strings.Contains(m.View(), "→P0") {
+		t.Error("effective priority column should be hidden outside its sort")
+	}
+
+	for m.sortMode != SortEffective {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+		m = updated.(Model)
+		if m.sortMode == SortDefault {
+			t.Fatal("sort cycle never reached SortEffective")
+		}
+	}
+
+	want := []string{"gate", "urgent", "p1", "p2"}
+	items := m.list.Items()
+	for i, id := range want {
+		if got := items[i].(IssueItem).Issue.ID; got != id {
+			t.Fatalf("position %d = %s, want %s", i, got, id)
+		}
+	}
+	if !strings.Contains(m.View(), "P3 →P0") {
+		t.Error("the gating issue should show its inherited priority")
+	}
+}
+
+func TestSortLeverageFloatsSmallUnblockers(t *testing.T) {
+	blocks := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	minutes := func(n int) *int { return &n }
+	issues := []model.Issue{
+		{ID: "big", Title: "Big", Priority: 0, Status: model.StatusOpen, EstimatedMinutes: minutes(960)},
+		{ID: "small", Title: "Small", Priority: 3, Status: model.StatusOpen, EstimatedMinutes: minutes(30)},
+		{ID: "a", Title: "A", Priority: 2, Status: model.StatusOpen, EstimatedMinutes: minutes(60), Dependencies: blocks("big", "small")},
+		{ID: "b", Title: "B", Priority: 3, Status: model.StatusOpen, EstimatedMinutes: minutes(60), Dependencies: blocks("big")},
+		{ID: "done", Title: "Done", Priority: 0, Status: model.StatusClosed, Dependencies: blocks("small")},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	for m.sortMode != SortLeverage {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+		m = updated.(Model)
+		if m.sortMode == SortDefault {
+			t.Fatal("sort cycle never reached SortLeverage")
+		}
+	}
+
+	// small frees one issue in 30m, big two in 16h
+	want := []string{"small", "big", "a", "b", "done"}
+	items := m.list.Items()
+	for i, id := range want {
+		if got := items[i].(IssueItem).Issue.ID; got != id {
+			t.Fatalf("position %d = %s, want %s", i, got, id)
+		}
+	}
+}
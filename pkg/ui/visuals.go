@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"hash/fnv"
 	"math"
 	"strings"
 
@@ -154,6 +155,30 @@ func GetRepoColor(prefix string) lipgloss.Color {
 	return RepoColors[hash%len(RepoColors)]
 }
 
+// WorkstreamColors is the accent palette for workstreams. Each entry has light
+// and dark variants so accents stay readable on either background.
+var WorkstreamColors = []lipgloss.AdaptiveColor{
+	{Light: "#C0392B", Dark: "#FF6B6B"}, // Red
+	{Light: "#16A085", Dark: "#4ECDC4"}, // Teal
+	{Light: "#2471A3", Dark: "#45B7D1"}, // Blue
+	{Light: "#7D3C98", Dark: "#BB8FCE"}, // Purple
+	{Light: "#B7950B", Dark: "#F7DC6F"}, // Gold
+	{Light: "#1E8449", Dark: "#82E0AA"}, // Green
+	{Light: "#CA6F1E", Dark: "#F0B27A"}, // Orange
+	{Light: "#A93226", Dark: "#F1948A"}, // Salmon
+	{Light: "#1F618D", Dark: "#85C1E9"}, // Light blue
+	{Light: "#884EA0", Dark: "#DDA0DD"}, // Plum
+}
+
+// WorkstreamColor returns the accent color for a workstream. It hashes the
+// workstream's stable ID, so a stream keeps its color across sessions and
+// reloads regardless of how many other streams exist or their order.
+func WorkstreamColor(id string) lipgloss.AdaptiveColor {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	return WorkstreamColors[h.Sum32()%uint32(len(WorkstreamColors))]
+}
+
 // RenderRepoBadge creates a compact colored badge for a repository prefix
 // Example: "api" -> "[API]" with distinctive color
 func RenderRepoBadge(prefix string) string {
@@ -0,0 +1,282 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// MarkWorkstreamForCompare marks the workstream under the cursor for a side
+// by side comparison (c). With another one already marked it returns the
+// pair and clears the mark; on the marked one it clears the mark. status
+// says what happened.
+func (m *LensDashboardModel) MarkWorkstreamForCompare() (pair [2]analysis.Workstream, ok bool, status string) {
+	if !m.IsWorkstreamView() || m.wsCursor >= len(m.workstreams) {
+		return pair, false, "Switch to the workstream view (w) to compare workstreams"
+	}
+	current := m.workstreams[m.wsCursor]
+	switch {
+	case m.compareMarkID == "":
+		m.compareMarkID = current.ID
+		return pair, false, fmt.Sprintf("Compare: %s marked • c on another workstream to compare", current.Name)
+	case m.compareMarkID == current.ID:
+		m.compareMarkID = ""
+		return pair, false, "Compare: mark cleared"
+	}
+	i := slices.IndexFunc(m.workstreams, func(ws analysis.Workstream) bool { return ws.ID == m.compareMarkID })
+	m.compareMarkID = ""
+	if i < 0 {
+		// The marked stream went away in a rebuild: start over from this one
+		m.compareMarkID = current.ID
+		return pair, false, fmt.Sprintf("Compare: %s marked • c on another workstream to compare", current.Name)
+	}
+	return [2]analysis.Workstream{m.workstreams[i], current}, true, ""
+}
+
+// WorkstreamCompareModel shows two workstreams of a lens in columns that
+// scroll together, with the dependencies running between them, to balance
+// the work before handing each to its own agent
+type WorkstreamCompareModel struct {
+	streams [2]analysis.Workstream
+	columns [2][]model.Issue       // Unfinished work first, by status then priority
+	waitsOn [2]map[string][]string // Per column: issue -> issues in the other column blocking it
+	blocks  [2]map[string]bool     // Per column: issues blocking something in the other column
+	pane    int                    // Column with the cursor
+	row     int                    // Cursor row, shared so the columns stay aligned
+	scroll  int                    // First row shown in both columns
+	theme   Theme
+	width   int
+	height  int
+}
+
+// NewWorkstreamCompareModel lays out a and b side by side
+func NewWorkstreamCompareModel(a, b analysis.Workstream, theme Theme) WorkstreamCompareModel {
+	m := WorkstreamCompareModel{streams: [2]analysis.Workstream{a, b}, theme: theme, width: 100, height: 30}
+	var members [2]map[string]bool
+	for side, ws := range m.streams {
+		members[side] = make(map[string]bool, len(ws.Issues))
+		for _, issue := range ws.Issues {
+			members[side][issue.ID] = true
+		}
+	}
+	m.blocks = [2]map[string]bool{{}, {}}
+	for side, ws := range m.streams {
+		other := 1 - side
+		m.waitsOn[side] = make(map[string][]string)
+		m.columns[side] = slices.Clone(ws.Issues)
+		for _, issue := range ws.Issues {
+			for _, dep := range issue.Dependencies {
+				// An issue in both streams doesn't wait across
+				if dep == nil || !dep.Type.IsBlocking() || !members[other][dep.DependsOnID] || members[side][dep.DependsOnID] {
+					continue
+				}
+				m.waitsOn[side][issue.ID] = append(m.waitsOn[side][issue.ID], dep.DependsOnID)
+				m.blocks[other][dep.DependsOnID] = true
+			}
+		}
+		sortCompareColumn(m.columns[side])
+	}
+	return m
+}
+
+// sortCompareColumn orders a column: in progress, open, blocked, then
+// custom statuses and closed, each by priority
+func sortCompareColumn(issues []model.Issue) {
+	order := []model.Status{model.StatusInProgress, model.StatusOpen, model.StatusBlocked}
+	rank := func(s model.Status) int {
+		if s.IsClosed() {
+			return len(order) + 1
+		}
+		if i := slices.Index(order, s); i >= 0 {
+			return i
+		}
+		return len(order)
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		if ri, rj := rank(issues[i].Status), rank(issues[j].Status); ri != rj {
+			return ri < rj
+		}
+		if issues[i].Priority != issues[j].Priority {
+			return issues[i].Priority < issues[j].Priority
+		}
+		return issues[i].ID < issues[j].ID
+	})
+}
+
+// Update moves the cursor; both columns scroll together. The owner handles
+// closing and opening issues.
+func (m WorkstreamCompareModel) Update(msg tea.Msg) (WorkstreamCompareModel, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch key.String() {
+	case "tab", "l", "right", "shift+tab", "h", "left":
+		m.pane = 1 - m.pane
+	case "j", "down":
+		m.row++
+	case "k", "up":
+		m.row--
+	case "ctrl+d":
+		m.row += m.rows() / 2
+	case "ctrl+u":
+		m.row -= m.rows() / 2
+	case "g", "home":
+		m.row = 0
+	case "G", "end":
+		m.row = len(m.columns[m.pane]) - 1
+	}
+	m.row = max(min(m.row, len(m.columns[m.pane])-1), 0)
+	if m.row < m.scroll {
+		m.scroll = m.row
+	} else if m.row >= m.scroll+m.rows() {
+		m.scroll = m.row - m.rows() + 1
+	}
+	return m, nil
+}
+
+// SelectedIssue returns the issue under the cursor, or nil when its column
+// is empty
+func (m WorkstreamCompareModel) SelectedIssue() *model.Issue {
+	if m.row >= len(m.columns[m.pane]) {
+		return nil
+	}
+	return &m.columns[m.pane][m.row]
+}
+
+// SetSize sets the area the comparison fills
+func (m *WorkstreamCompareModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// rows is how many issue rows fit under the column headers
+func (m WorkstreamCompareModel) rows() int {
+	// Title, blank, four header lines, blank, then blank, balance line,
+	// blank and footer below the rows
+	return max(m.height-11, 3)
+}
+
+// View renders the two columns and the balance between them
+func (m WorkstreamCompareModel) View() string {
+	t := m.theme
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+
+	a, b := m.streams[0], m.streams[1]
+	lines := []string{
+		titleStyle.Render(truncate("Compare workstreams: "+a.Name+" ⇄ "+b.Name, max(m.width-2, 10))),
+		"",
+	}
+
+	half := max((m.width-3)/2, 20)
+	left, right := m.renderColumn(0, half), m.renderColumn(1, half)
+	sep := mutedStyle.Render(strings.TrimSuffix(strings.Repeat(" │ \n", len(left)), "\n"))
+	lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+		strings.Join(left, "\n"), sep, strings.Join(right, "\n")), "")
+
+	lines = append(lines, mutedStyle.Render(truncate(m.balanceLine(), max(m.width-2, 10))), "")
+	lines = append(lines, mutedStyle.Render("tab/h/l switch column · j/k scroll both · enter open in lens · esc back"))
+	return strings.Join(lines, "\n")
+}
+
+// balanceLine compares what is left in each stream and how they wait on
+// each other
+func (m WorkstreamCompareModel) balanceLine() string {
+	var parts []string
+	for side, ws := range m.streams {
+		left := 0
+		for _, issue := range m.columns[side] {
+			if !issue.Status.IsClosed() {
+				left++
+			}
+		}
+		part := fmt.Sprintf("%s: %d unfinished", ws.Name, left)
+		if ws.RemainingMinutes > 0 {
+			part += " (" + formatEffortMinutes(ws.RemainingMinutes) + ")"
+		}
+		parts = append(parts, part)
+	}
+	return fmt.Sprintf("%s  vs  %s  ·  %s waits on %s ×%d, %s on %s ×%d",
+		parts[0], parts[1],
+		m.streams[0].Name, m.streams[1].Name, len(m.waitsOn[0]),
+		m.streams[1].Name, m.streams[0].Name, len(m.waitsOn[1]))
+}
+
+// renderColumn renders a stream's header and its rows in the shared scroll
+// window, padded to width
+func (m WorkstreamCompareModel) renderColumn(side, width int) []string {
+	t := m.theme
+	ws := m.streams[side]
+	other := m.streams[1-side]
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	nameStyle := t.Renderer.NewStyle().Bold(true).Foreground(WorkstreamColor(ws.ID))
+	if side == m.pane {
+		nameStyle = nameStyle.Underline(true)
+	}
+
+	effort := workstreamEffortLabel(ws)
+	if effort == "" {
+		effort = "no estimates"
+	}
+	crossing := mutedStyle.Render("no dependencies on " + other.Name)
+	if n := len(m.waitsOn[side]); n > 0 {
+		crossing = t.Renderer.NewStyle().Foreground(t.Blocked).Render(fmt.Sprintf("⇠ %d waiting on %s", n, other.Name))
+	}
+	lines := []string{
+		nameStyle.Render(truncate(ws.Name, width)),
+		mutedStyle.Render(truncate(fmt.Sprintf("%d issues · ○%d ●%d ◈%d ✓%d", len(ws.Issues),
+			ws.ReadyCount, ws.InProgressCount, ws.BlockedCount, ws.ClosedCount), width)),
+		mutedStyle.Render(truncate(effort, width)),
+		crossing,
+		"",
+	}
+
+	issues := m.columns[side]
+	if len(issues) == 0 {
+		lines = append(lines, mutedStyle.Render("  (none)"))
+	}
+	end := min(m.scroll+m.rows(), len(issues))
+	for i := m.scroll; i < end; i++ {
+		issue := issues[i]
+		cursor := "  "
+		idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+		titleStyle := t.Renderer.NewStyle()
+		if side == m.pane && i == m.row {
+			cursor = "▸ "
+			idStyle = idStyle.Bold(true)
+			titleStyle = titleStyle.Foreground(t.Primary).Bold(true)
+		}
+		status := t.Renderer.NewStyle().Foreground(t.GetStatusColor(string(issue.Status))).
+			Render(GetStatusIcon(string(issue.Status)))
+
+		// ⇠ waits on the other stream, ⇢ holds it up
+		marks := ""
+		if len(m.waitsOn[side][issue.ID]) > 0 {
+			marks += t.Renderer.NewStyle().Foreground(t.Blocked).Render("⇠")
+		}
+		if m.blocks[side][issue.ID] {
+			marks += t.Renderer.NewStyle().Foreground(ColorWarning).Render("⇢")
+		}
+		if marks != "" {
+			marks = " " + marks
+		}
+		fixed := lipgloss.Width(cursor+m.theme.ID(issue.ID)+" ") + lipgloss.Width(status) + 1 + lipgloss.Width(marks)
+		lines = append(lines, cursor+status+" "+idStyle.Render(m.theme.ID(issue.ID))+" "+
+			titleStyle.Render(truncate(issue.Title, max(width-fixed, 5)))+marks)
+	}
+	for len(lines) < m.rows()+5 {
+		lines = append(lines, "")
+	}
+	for i, line := range lines {
+		lines[i] = line + strings.Repeat(" ", max(width-lipgloss.Width(line), 0))
+	}
+	return lines
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestWorkstreamCompare(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Schema", Status: model.StatusOpen, Priority: 1, Labels: []string{"api"}},
+		{ID: "bv-2", Title: "Endpoints", Status: model.StatusInProgress, Priority: 2, Labels: []string{"api"}},
+		{ID: "bv-3", Title: "Client", Status: model.StatusOpen, Priority: 1, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "bv-3", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+		{ID: "bv-4", Title: "Docs", Status: model.StatusClosed, Priority: 0, Labels: []string{"api"}},
+	}
+	byID := make(map[string]model.Issue)
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updated.(Model)
+	m.openLensDashboard(lensRef{Type: "label", Value: "api", Title: "api"}, nil, ScopeModeUnion)
+
+	// Outside the workstream view c only explains itself
+	updated, _ = m.Update(keyMsg("c"))
+	m = updated.(Model)
+	if m.showWorkstreamCompare || !strings.Contains(m.statusMsg, "workstream view") {
+		t.Fatalf("c in the flat view: status %q", m.statusMsg)
+	}
+
+	m.lensDashboard.viewType = ViewTypeWorkstream
+	m.lensDashboard.workstreams = []analysis.Workstream{
+		{ID: "ws-a", Name: "Backend", Issues: []model.Issue{byID["bv-1"], byID["bv-2"]}},
+		{ID: "ws-b", Name: "Frontend", Issues: []model.Issue{byID["bv-3"], byID["bv-4"]}},
+	}
+	m.lensDashboard.wsCursor = 0
+	updated, _ = m.Update(keyMsg("c"))
+	m = updated.(Model)
+	if m.lensDashboard.compareMarkID != "ws-a" || m.showWorkstreamCompare {
+		t.Fatalf("first c should mark Backend, status %q", m.statusMsg)
+	}
+	m.lensDashboard.wsCursor = 1
+	updated, _ = m.Update(keyMsg("c"))
+	m = updated.(Model)
+	if !m.showWorkstreamCompare || m.lensDashboard.compareMarkID != "" {
+		t.Fatalf("second c should open the comparison, status %q", m.statusMsg)
+	}
+
+	cmp := m.workstreamCompare
+	if got := cmp.waitsOn[1]["bv-3"]; len(got) != 1 || got[0] != "bv-1" || !cmp.blocks[0]["bv-1"] {
+		t.Errorf("crossing deps: waits %v, blocks %v", cmp.waitsOn, cmp.blocks)
+	}
+	// In progress first, closed last
+	if cmp.columns[0][0].ID != "bv-2" || cmp.columns[1][1].ID != "bv-4" {
+		t.Errorf("column order: %v / %v", cmp.columns[0], cmp.columns[1])
+	}
+	view := stripAnsi(m.View())
+	for _, want := range []string{"Compare workstreams: Backend ⇄ Frontend", "⇠ 1 waiting on Backend", "no dependencies on Frontend",
+		"Backend: 2 unfinished  vs  Frontend: 1 unfinished  ·  Backend waits on Frontend ×0, Frontend on Backend ×1"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q:\n%s", want, view)
+		}
+	}
+
+	// The row is shared, so switching columns keeps the place
+	updated, _ = m.Update(keyMsg("j"))
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = updated.(Model)
+	if got := m.workstreamCompare.SelectedIssue(); got == nil || got.ID != "bv-4" {
+		t.Fatalf("after j, tab: selected %v", got)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.showWorkstreamCompare || !m.showLensDashboard {
+		t.Errorf("enter should return to the lens")
+	}
+}
@@ -648,6 +648,24 @@ func Rollback() error {
 	return nil
 }
 
+// StartupCheckEnabled reports whether the TUI should check for a newer
+// release when it starts. Set BV_NO_UPDATE_CHECK=1 to disable the check, e.g.
+// on air-gapped machines or in CI; `bv version --check` still works.
+func StartupCheckEnabled() bool {
+	v := strings.TrimSpace(os.Getenv("BV_NO_UPDATE_CHECK"))
+	return v == "" || v == "0" || strings.EqualFold(v, "false")
+}
+
+// UpgradeInstructions returns human-readable steps for moving to newVersion.
+func UpgradeInstructions(newVersion string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "To upgrade to %s:\n", newVersion)
+	b.WriteString("  bv --update              # replace this binary in place (backup kept; undo with --rollback)\n")
+	b.WriteString("  curl -fsSL \"https://raw.githubusercontent.com/Dicklesworthstone/beads_viewer/main/install.sh\" | bash\n")
+	b.WriteString("  go install github.com/Dicklesworthstone/beads_viewer/cmd/bv@latest\n")
+	return b.String()
+}
+
 // CheckUpdateAvailable is a convenience wrapper that checks and returns update info
 func CheckUpdateAvailable() (available bool, newVersion string, releaseURL string, err error) {
 	newVersion, releaseURL, err = CheckForUpdates()
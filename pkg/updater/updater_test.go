@@ -325,4 +325,13 @@ func TestCompareVersions_SemVerNumericIdentifiers(t *testing.T) {
 	if got != expected {
 		t.Errorf("compareVersions(%q, %q) = %d; want %d (numeric prerelease comparison failure)", v1, v2, got, expected)
 	}
-}
\ No newline at end of file
+}
+
+func TestStartupCheckEnabled(t *testing.T) {
+	for value, want := range map[string]bool{"": true, "0": true, "false": true, "1": false, "yes": false} {
+		t.Setenv("BV_NO_UPDATE_CHECK", value)
+		if got := StartupCheckEnabled(); got != want {
+			t.Errorf("BV_NO_UPDATE_CHECK=%q: StartupCheckEnabled() = %v, want %v", value, got, want)
+		}
+	}
+}
@@ -1,4 +1,81 @@
 package version
 
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
 // Version is the current application version
 const Version = "v0.11.1"
+
+// Build metadata, injected at link time:
+//
+//	go build -ldflags "-X github.com/Dicklesworthstone/beads_viewer/pkg/version.Commit=$(git rev-parse --short HEAD) \
+//	  -X github.com/Dicklesworthstone/beads_viewer/pkg/version.Date=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// When left empty, Info falls back to the VCS stamp Go embeds in module builds.
+var (
+	Commit = ""
+	Date   = ""
+)
+
+// BuildInfo identifies the exact build, for bug reports.
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	Date      string `json:"date,omitempty"`
+	Dirty     bool   `json:"dirty,omitempty"`
+	GoVersion string `json:"go_version"`
+	Platform  string `json:"platform"`
+}
+
+// Info returns the build metadata for the running binary.
+func Info() BuildInfo {
+	info := BuildInfo{
+		Version:   Version,
+		Commit:    Commit,
+		Date:      Date,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range bi.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				if info.Commit == "" {
+					info.Commit = s.Value
+				}
+			case "vcs.time":
+				if info.Date == "" {
+					info.Date = s.Value
+				}
+			case "vcs.modified":
+				info.Dirty = s.Value == "true"
+			}
+		}
+	}
+	if len(info.Commit) > 12 {
+		info.Commit = info.Commit[:12]
+	}
+	return info
+}
+
+// String renders the build as one line, e.g.
+// "v0.11.1 (commit 1a2b3c4d5e6f, built 2025-01-02T03:04:05Z, go1.22.1 linux/amd64)".
+func (b BuildInfo) String() string {
+	parts := make([]string, 0, 3)
+	if b.Commit != "" {
+		commit := "commit " + b.Commit
+		if b.Dirty {
+			commit += "-dirty"
+		}
+		parts = append(parts, commit)
+	}
+	if b.Date != "" {
+		parts = append(parts, "built "+b.Date)
+	}
+	parts = append(parts, b.GoVersion+" "+b.Platform)
+	return fmt.Sprintf("%s (%s)", b.Version, strings.Join(parts, ", "))
+}
@@ -0,0 +1,33 @@
+package version
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildInfoString(t *testing.T) {
+	b := BuildInfo{Version: "v1.2.3", Commit: "abc1234", Date: "2025-01-02T03:04:05Z", Dirty: true, GoVersion: "go1.22.1", Platform: "linux/amd64"}
+	want := "v1.2.3 (commit abc1234-dirty, built 2025-01-02T03:04:05Z, go1.22.1 linux/amd64)"
+	if got := b.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	bare := BuildInfo{Version: "v1.2.3", GoVersion: "go1.22.1", Platform: "darwin/arm64"}
+	if got := bare.String(); got != "v1.2.3 (go1.22.1 darwin/arm64)" {
+		t.Errorf("String() without VCS info = %q", got)
+	}
+}
+
+func TestInfoPrefersLinkerValues(t *testing.T) {
+	origCommit, origDate := Commit, Date
+	t.Cleanup(func() { Commit, Date = origCommit, origDate })
+	Commit, Date = "0123456789abcdef", "2025-06-01T00:00:00Z"
+
+	info := Info()
+	if info.Commit != "0123456789ab" {
+		t.Errorf("Commit = %q, want it truncated to 12 chars", info.Commit)
+	}
+	if info.Date != Date || info.Version != Version || !strings.HasPrefix(info.GoVersion, "go") {
+		t.Errorf("unexpected info %+v", info)
+	}
+}
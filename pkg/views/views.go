@@ -0,0 +1,142 @@
+// Package views persists named lens views: a saved combination of scope labels,
+// search mode, dependency depth and layout that can be restored by name.
+package views
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// View is a saved lens configuration. Empty fields mean "use the default".
+type View struct {
+	ScopeLabels []string `yaml:"scope_labels,omitempty"`
+	ScopeMode   string   `yaml:"scope_mode,omitempty"`  // "all" (default) or "any"
+	SearchMode  string   `yaml:"search_mode,omitempty"` // merged, epic, label, bead
+	Depth       string   `yaml:"depth,omitempty"`       // 1, 2, 3 or all
+	ViewType    string   `yaml:"view_type,omitempty"`   // flat, workstream, grouped
+}
+
+// File represents the structure of the views YAML file
+type File struct {
+	Views map[string]*View `yaml:"views"`
+}
+
+// Store holds saved views and the file they are persisted to
+type Store struct {
+	path  string
+	views map[string]View
+}
+
+// DefaultPath returns the user views file (~/.config/bv/views.yaml),
+// or "" if the home directory cannot be determined.
+func DefaultPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "bv", "views.yaml")
+}
+
+// Load reads saved views from path. A missing file yields an empty store.
+func Load(path string) (*Store, error) {
+	s := &Store{path: path, views: make(map[string]View)}
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("reading views file: %w", err)
+	}
+
+	var file File
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for name, v := range file.Views {
+		if v != nil {
+			s.views[name] = *v
+		}
+	}
+	return s, nil
+}
+
+// Path returns the file the store saves to
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Names returns saved view names in alphabetical order
+func (s *Store) Names() []string {
+	names := make([]string, 0, len(s.views))
+	for name := range s.views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the view saved under name
+func (s *Store) Get(name string) (View, bool) {
+	v, ok := s.views[name]
+	return v, ok
+}
+
+// Put adds or replaces a view. Names are trimmed and must not be empty.
+func (s *Store) Put(name string, v View) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("view name cannot be empty")
+	}
+	s.views[name] = v
+	return nil
+}
+
+// Delete removes a view, reporting whether it existed
+func (s *Store) Delete(name string) bool {
+	if _, ok := s.views[name]; !ok {
+		return false
+	}
+	delete(s.views, name)
+	return true
+}
+
+// Save writes all views to the store's file, creating its directory if needed
+func (s *Store) Save() error {
+	if s.path == "" {
+		return errors.New("no views file path (home directory unknown)")
+	}
+
+	file := File{Views: make(map[string]*View, len(s.views))}
+	for name := range s.views {
+		v := s.views[name]
+		file.Views[name] = &v
+	}
+
+	data, err := yaml.Marshal(&file)
+	if err != nil {
+		return fmt.Errorf("encoding views: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("creating views directory: %w", err)
+	}
+
+	// Write to a temp file and rename so a crash never truncates existing views
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("writing views file: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("replacing views file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package views
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadMissingFileIsEmpty(t *testing.T) {
+	s, err := Load(filepath.Join(t.TempDir(), "nope", "views.yaml"))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(s.Names()) != 0 {
+		t.Errorf("expected no views, got %v", s.Names())
+	}
+}
+
+func TestSaveAndReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bv", "views.yaml")
+	s, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	backend := View{
+		ScopeLabels: []string{"api", "db"},
+		ScopeMode:   "any",
+		SearchMode:  "label",
+		Depth:       "all",
+		ViewType:    "workstream",
+	}
+	if err := s.Put("  backend ", backend); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := s.Put("alpha", View{Depth: "1"}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := s.Put("   ", View{}); err == nil {
+		t.Error("expected error for blank name")
+	}
+	if err := s.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	reloaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if got := reloaded.Names(); !reflect.DeepEqual(got, []string{"alpha", "backend"}) {
+		t.Errorf("Names() = %v", got)
+	}
+	got, ok := reloaded.Get("backend")
+	if !ok || !reflect.DeepEqual(got, backend) {
+		t.Errorf("Get(backend) = %+v, %v", got, ok)
+	}
+
+	if !reloaded.Delete("alpha") || reloaded.Delete("alpha") {
+		t.Error("Delete should report whether the view existed")
+	}
+}
+
+func TestLoadInvalidYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "views.yaml")
+	if err := os.WriteFile(path, []byte("views: [not, a, map"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("expected parse error")
+	}
+}
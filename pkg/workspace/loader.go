@@ -246,6 +246,33 @@ func LoadAllFromConfig(ctx context.Context, configPath string) ([]model.Issue, [
 	return loader.LoadAll(ctx)
 }
 
+// ConfigFromPaths builds an ad-hoc workspace config from repository paths,
+// e.g. from repeated --repo flags. Each repo is named after its directory and
+// gets the default prefix (name + "-").
+func ConfigFromPaths(paths []string) (*Config, error) {
+	config := &Config{}
+	for _, path := range paths {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return nil, fmt.Errorf("resolving repo path %q: %w", path, err)
+		}
+		config.Repos = append(config.Repos, RepoConfig{Path: abs})
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// LoadAllFromPaths loads and merges issues from the given repository paths
+func LoadAllFromPaths(ctx context.Context, paths []string) ([]model.Issue, []LoadResult, error) {
+	config, err := ConfigFromPaths(paths)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid repo list: %w", err)
+	}
+	return NewAggregateLoader(config, "").LoadAll(ctx)
+}
+
 // Summary returns a summary of load results
 type LoadSummary struct {
 	TotalRepos      int
@@ -489,3 +489,48 @@ func TestAggregateLoaderCustomBeadsPath(t *testing.T) {
 		t.Errorf("expected namespaced ID svc-CUST-1, got %s", issues[0].ID)
 	}
 }
+
+func TestLoadAllFromPaths(t *testing.T) {
+	tmpDir := t.TempDir()
+	apiRepo := filepath.Join(tmpDir, "api")
+	webRepo := filepath.Join(tmpDir, "web")
+	createTestBeadsFile(t, apiRepo, []model.Issue{
+		{ID: "AUTH-1", Title: "Auth endpoint", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	})
+	createTestBeadsFile(t, webRepo, []model.Issue{
+		{ID: "UI-1", Title: "Login page", CreatedAt: time.Now(), UpdatedAt: time.Now(),
+			Dependencies: []*model.Dependency{{IssueID: "UI-1", DependsOnID: "api-AUTH-1", Type: model.DepBlocks}}},
+	})
+
+	issues, results, err := workspace.LoadAllFromPaths(context.Background(), []string{apiRepo, webRepo})
+	if err != nil {
+		t.Fatalf("LoadAllFromPaths: %v", err)
+	}
+	if len(results) != 2 || len(issues) != 2 {
+		t.Fatalf("expected 2 repos and 2 issues, got %d repos, %d issues", len(results), len(issues))
+	}
+
+	byID := make(map[string]model.Issue)
+	for _, iss := range issues {
+		byID[iss.ID] = iss
+	}
+	ui, ok := byID["web-UI-1"]
+	if !ok {
+		t.Fatalf("expected IDs prefixed by directory name, got %v", byID)
+	}
+	if _, ok := byID["api-AUTH-1"]; !ok {
+		t.Fatalf("expected api-AUTH-1, got %v", byID)
+	}
+	if len(ui.Dependencies) != 1 || ui.Dependencies[0].DependsOnID != "api-AUTH-1" {
+		t.Errorf("cross-repo blocker should point at api-AUTH-1, got %+v", ui.Dependencies)
+	}
+}
+
+func TestConfigFromPathsDuplicateNames(t *testing.T) {
+	tmpDir := t.TempDir()
+	a := filepath.Join(tmpDir, "one", "api")
+	b := filepath.Join(tmpDir, "two", "api")
+	if _, err := workspace.ConfigFromPaths([]string{a, b}); err == nil {
+		t.Error("expected duplicate prefix error for two repos named api")
+	}
+}
@@ -4,6 +4,7 @@
 #   ./scripts/benchmark.sh          # Run all benchmarks
 #   ./scripts/benchmark.sh baseline # Save as baseline
 #   ./scripts/benchmark.sh compare  # Compare against baseline
+#   ./scripts/benchmark.sh budgets  # Fail if large dataset benchmarks exceed their budgets
 
 set -e
 
@@ -60,6 +61,12 @@ run_quick() {
             -benchmem -count=1 "${BENCH_PACKAGES[@]}" 2>&1 | tee "$CURRENT_FILE"
 }
 
+# Large dataset benchmarks checked against their regression budgets
+run_budgets() {
+    echo "Checking large dataset benchmark budgets..."
+    BV_BENCH_BUDGETS=1 go test -run 'Test(LargeDatasetBudgets|LensBuildTreeBudget)' -v ./pkg/analysis/ ./pkg/ui/
+}
+
 case "${1:-run}" in
     baseline)
         save_baseline
@@ -70,6 +77,9 @@ case "${1:-run}" in
     quick)
         run_quick
         ;;
+    budgets)
+        run_budgets
+        ;;
     run|*)
         run_benchmarks
         ;;
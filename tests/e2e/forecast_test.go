@@ -118,6 +118,11 @@ func TestRobotForecast_AllAndLabelFilter(t *testing.T) {
 		Forecasts     []struct {
 			IssueID string `json:"issue_id"`
 		} `json:"forecasts"`
+		Quality struct {
+			Grade            string  `json:"grade"`
+			EstimateCoverage float64 `json:"estimate_coverage"`
+			VelocitySamples  int     `json:"velocity_samples"`
+		} `json:"quality"`
 		Summary any `json:"summary"`
 	}
 	if err := json.Unmarshal(out, &all); err != nil {
@@ -132,6 +137,9 @@ func TestRobotForecast_AllAndLabelFilter(t *testing.T) {
 	if all.Summary == nil {
 		t.Fatalf("expected summary to be present when forecasting multiple issues")
 	}
+	if all.Quality.Grade == "" || all.Quality.EstimateCoverage != 0.5 || all.Quality.VelocitySamples != 2 {
+		t.Fatalf("unexpected forecast quality: %+v", all.Quality)
+	}
 
 	// Label filter: only backend issues should remain (OPEN-1).
 	cmd = exec.Command(bv, "--robot-forecast", "all", "--forecast-label", "backend")
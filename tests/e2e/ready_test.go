@@ -0,0 +1,72 @@
+package main_test
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func createReadyRepo(t *testing.T) string {
+	t.Helper()
+	repoDir := t.TempDir()
+	beadsDir := filepath.Join(repoDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0o755); err != nil {
+		t.Fatalf("mkdir .beads: %v", err)
+	}
+
+	beads := `{"id":"API-1","title":"Design API","status":"open","priority":1,"issue_type":"task","labels":["backend"],"assignee":"alice"}` + "\n" +
+		`{"id":"API-2","title":"Implement API","status":"open","priority":0,"issue_type":"task","labels":["backend"],"dependencies":[{"depends_on_id":"API-1","type":"blocks"}]}` + "\n" +
+		`{"id":"UI-1","title":"Polish UI","status":"in_progress","priority":2,"issue_type":"feature","labels":["frontend"],"assignee":"bob"}` + "\n" +
+		`{"id":"OLD-1","title":"Shipped","status":"closed","priority":0,"issue_type":"task"}` + "\n"
+	if err := os.WriteFile(filepath.Join(beadsDir, "beads.jsonl"), []byte(beads), 0o644); err != nil {
+		t.Fatalf("write beads.jsonl: %v", err)
+	}
+	return repoDir
+}
+
+func runReady(t *testing.T, bv, repoDir string, args ...string) []byte {
+	t.Helper()
+	cmd := exec.Command(bv, append([]string{"ready"}, args...)...)
+	cmd.Dir = repoDir
+	cmd.Env = append(os.Environ(), "BV_NO_CACHE=1")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("bv ready %v failed: %v\n%s", args, err, out)
+	}
+	return out
+}
+
+func TestReadyCommand(t *testing.T) {
+	bv := buildBvBinary(t)
+	repoDir := createReadyRepo(t)
+
+	var all []struct {
+		ID       string  `json:"id"`
+		Priority int     `json:"priority"`
+		PageRank float64 `json:"pagerank"`
+	}
+	if err := json.Unmarshal(runReady(t, bv, repoDir, "--json"), &all); err != nil {
+		t.Fatalf("json decode: %v", err)
+	}
+	if len(all) != 2 || all[0].ID != "API-1" || all[1].ID != "UI-1" {
+		t.Fatalf("expected [API-1 UI-1] (API-2 blocked, OLD-1 closed), got %+v", all)
+	}
+	if all[0].PageRank <= 0 {
+		t.Errorf("expected PageRank to be populated, got %+v", all[0])
+	}
+
+	text := string(runReady(t, bv, repoDir, "--label", "frontend"))
+	if !strings.Contains(text, "UI-1") || strings.Contains(text, "API-1") {
+		t.Errorf("--label frontend should list only UI-1, got:\n%s", text)
+	}
+	if !strings.Contains(text, "@bob") || !strings.Contains(text, "[frontend]") {
+		t.Errorf("text output missing assignee/labels:\n%s", text)
+	}
+
+	if out := runReady(t, bv, repoDir, "--assignee", "nobody", "--json"); strings.TrimSpace(string(out)) != "[]" {
+		t.Errorf("expected empty JSON array, got %s", out)
+	}
+}